@@ -106,6 +106,9 @@ create_subscription "chime-delivery-topic-id" "chime-delivery-sub-id" "delivery-
 create_topic "webhook-delivery-topic-id"
 create_subscription "webhook-delivery-topic-id" "webhook-delivery-sub-id" "delivery-dead-letter-topic-id"
 
+create_topic "data-updated-topic-id"
+create_subscription "data-updated-topic-id" "backend-cache-invalidation-sub-id"
+
 echo "Pub/Sub setup for webstatus.dev finished"
 
 wait
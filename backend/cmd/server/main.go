@@ -22,12 +22,15 @@ import (
 	"net/url"
 	"os"
 	"slices"
+	"strconv"
 	"time"
 
 	firebase "firebase.google.com/go/v4"
 	"github.com/GoogleChrome/webstatus.dev/backend/pkg/httpserver"
 	"github.com/GoogleChrome/webstatus.dev/lib/auth"
 	"github.com/GoogleChrome/webstatus.dev/lib/cachetypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpgcs"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpgcs/gcpgcsadapters"
 	"github.com/GoogleChrome/webstatus.dev/lib/gcppubsub"
 	"github.com/GoogleChrome/webstatus.dev/lib/gcppubsub/gcppubsubadapters"
 	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner"
@@ -53,6 +56,21 @@ func parseEnvVarDuration(key string) time.Duration {
 	return duration
 }
 
+// parseEnvVarOrDefault parses an optional environment variable. The default value is used if it is not set.
+func parseEnvVarOrDefault[T any](key string, defaultValue T, parse func(string) (T, error)) T {
+	raw, found := os.LookupEnv(key)
+	if !found || raw == "" {
+		return defaultValue
+	}
+	value, err := parse(raw)
+	if err != nil {
+		slog.ErrorContext(context.TODO(), "unable to parse env var", "key", key, "input value", raw)
+		os.Exit(1)
+	}
+
+	return value
+}
+
 func main() {
 	var datastoreDB *string
 	if value, found := os.LookupEnv("DATASTORE_DATABASE"); found {
@@ -101,6 +119,7 @@ func main() {
 	cacheKeyPrefix := cmp.Or[string](os.Getenv("K_REVISION"), "test-revision")
 	aggregatedFeaturesStatsTTL := parseEnvVarDuration("AGGREGATED_FEATURE_STATS_TTL")
 	routeCacheOptions := httpserver.RouteCacheOptions{
+		DefaultTTL: cacheTTL,
 		AggregatedFeatureStatsOptions: []cachetypes.CacheOption{
 			cachetypes.WithTTL(aggregatedFeaturesStatsTTL),
 		},
@@ -120,6 +139,34 @@ func main() {
 		os.Exit(1)
 	}
 
+	rateLimitCapacity := parseEnvVarOrDefault("RATE_LIMIT_CAPACITY", int64(300),
+		func(v string) (int64, error) { return strconv.ParseInt(v, 10, 64) })
+	rateLimitRefillPerSecond := parseEnvVarOrDefault("RATE_LIMIT_REFILL_PER_SECOND", 5.0,
+		func(v string) (float64, error) { return strconv.ParseFloat(v, 64) })
+	rateLimitTrustedProxies := parseEnvVarOrDefault("RATE_LIMIT_TRUSTED_PROXIES", 0, strconv.Atoi)
+	slog.InfoContext(ctx, "rate limit settings", "capacity", rateLimitCapacity,
+		"refillPerSecond", rateLimitRefillPerSecond, "trustedProxies", rateLimitTrustedProxies)
+
+	slog.InfoContext(ctx, "BOOT: Creating Valkey rate limiter...")
+	// Unlike the cache, the buckets are not prefixed with the revision so they survive deployments.
+	rateLimiter, err := valkeycache.NewValkeyTokenBucketLimiter(
+		"ratelimit",
+		valkeyHost,
+		valkeyPort,
+		rateLimitCapacity,
+		rateLimitRefillPerSecond,
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to create valkey rate limiter instance", "error", err)
+		os.Exit(1)
+	}
+	rateLimitMiddleware := httpmiddlewares.NewRateLimitMiddleware(
+		rateLimiter,
+		httpserver.GenericErrorFn,
+		httpmiddlewares.WithRateLimitCost(httpserver.RateLimitCost),
+		httpmiddlewares.WithTrustedProxies(rateLimitTrustedProxies),
+	)
+
 	// nolint:exhaustruct // WONTFIX - will rely on the defaults on this third party struct.
 	firebaseApp, err := firebase.NewApp(context.Background(), &firebase.Config{
 		ProjectID: projectID,
@@ -154,6 +201,8 @@ func main() {
 		backend.BearerAuthScopes,
 		backend.NoAuthScopes,
 		httpserver.GenericErrorFn,
+		httpmiddlewares.WithPrefixedTokenAuthenticator(auth.PersonalAccessTokenPrefix,
+			auth.NewPersonalAccessTokenAuthenticator(spanneradapters.NewPersonalAccessTokenStore(spannerClient))),
 	)
 
 	preRequestMiddlewares := []func(http.Handler) http.Handler{
@@ -163,6 +212,7 @@ func main() {
 				AllowedOrigins:   []string{allowedOrigin, "http://*"},
 				AllowedMethods:   []string{"GET", "OPTIONS", "PATCH", "DELETE", "PUT", "POST"},
 				AllowedHeaders:   []string{"Authorization"},
+				ExposedHeaders:   []string{"Retry-After", "RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset"},
 				AllowCredentials: true, // Remove after UbP
 				MaxAge:           300,  // Maximum value not ignored by any of major browsers
 			}),
@@ -217,12 +267,40 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Optional: Drop the cached responses when an ingestion workflow updates the data they are derived from.
+	// Otherwise, they are only refreshed when their TTL expires or a new revision is deployed.
+	// The subscriber runs outside of requests, so the service needs CPU that is always allocated
+	// and at least one instance (see infra/backend/service.tf).
+	if cacheInvalidationSubID := os.Getenv("CACHE_INVALIDATION_SUBSCRIPTION_ID"); cacheInvalidationSubID != "" {
+		slog.InfoContext(ctx, "starting cache invalidation subscriber", "subscription", cacheInvalidationSubID)
+		listener := gcppubsubadapters.NewCacheInvalidationSubscriberAdapter(
+			httpserver.NewCacheInvalidator(cache), queueClient, cacheInvalidationSubID)
+		go func() {
+			if err := listener.Subscribe(ctx); err != nil {
+				slog.ErrorContext(ctx, "cache invalidation subscriber failed", "error", err)
+			}
+		}()
+	}
+
+	stateBlobBucket := os.Getenv("STATE_BLOB_BUCKET")
+	if stateBlobBucket == "" {
+		slog.ErrorContext(ctx, "missing state blob bucket")
+		os.Exit(1)
+	}
+
+	blobClient, err := gcpgcs.NewClient(ctx, stateBlobBucket)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to create gcs client", "error", err)
+		os.Exit(1)
+	}
+
 	srv := httpserver.NewHTTPServer(
 		"8080",
 		baseURL,
 		datastoreadapters.NewBackend(fs),
 		spanneradapters.NewBackend(spannerClient),
 		gcppubsubadapters.NewBackendAdapter(queueClient, ingestionTopicID),
+		gcpgcsadapters.NewBackend(blobClient),
 		cache,
 		routeCacheOptions,
 		func(token string) *httpserver.UserGitHubClient {
@@ -232,6 +310,7 @@ func main() {
 		},
 		preRequestMiddlewares,
 		authMiddleware,
+		rateLimitMiddleware,
 	)
 
 	err = srv.ListenAndServe()
@@ -0,0 +1,148 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+const (
+	badgeLabel = "Baseline"
+	// badgeHeight, badgeCharWidth and badgePadding are in pixels. The width of the text is estimated
+	// because the fonts available to the client are unknown.
+	badgeHeight    = 20
+	badgeCharWidth = 7
+	badgePadding   = 6
+
+	badgeColorLabel       = "#555"
+	badgeColorWidely      = "#1e8e3e"
+	badgeColorNewly       = "#1a73e8"
+	badgeColorLimited     = "#e37400"
+	badgeColorUnavailable = "#9e9e9e"
+)
+
+// badgeSegment is a colored part of a badge.
+type badgeSegment struct {
+	text  string
+	color string
+}
+
+// renderBadge renders the segments side by side, in the flat style of shields.io.
+func renderBadge(segments []badgeSegment) string {
+	texts := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		texts = append(texts, segment.text)
+	}
+	title := html.EscapeString(strings.Join(texts, ": "))
+
+	var rects, labels strings.Builder
+	x := 0
+	for _, segment := range segments {
+		width := utf8.RuneCountInString(segment.text)*badgeCharWidth + 2*badgePadding
+		fmt.Fprintf(&rects, `<rect x="%d" width="%d" height="%d" fill="%s"/>`,
+			x, width, badgeHeight, segment.color)
+		fmt.Fprintf(&labels, `<text x="%d" y="14">%s</text>`, x+width/2, html.EscapeString(segment.text))
+		x += width
+	}
+
+	return fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" role="img" aria-label="%s">`+
+		`<title>%s</title>`+
+		`<g shape-rendering="crispEdges">%s</g>`+
+		`<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">`+
+		`%s</g></svg>`,
+		x, badgeHeight, title, title, rects.String(), labels.String())
+}
+
+// featureBadgeSegments returns the segments of the badge of a feature. The browser segments are only added
+// when showBrowsers is set.
+func featureBadgeSegments(feature *backend.Feature, showBrowsers bool) []badgeSegment {
+	status := badgeSegment{text: "unknown", color: badgeColorUnavailable}
+	if feature.Baseline != nil && feature.Baseline.Status != nil {
+		switch *feature.Baseline.Status {
+		case backend.Widely:
+			status = badgeSegment{text: "widely available", color: badgeColorWidely}
+		case backend.Newly:
+			status = badgeSegment{text: "newly available", color: badgeColorNewly}
+		case backend.Limited:
+			status = badgeSegment{text: "limited availability", color: badgeColorLimited}
+		}
+	}
+	segments := []badgeSegment{{text: badgeLabel, color: badgeColorLabel}, status}
+	if !showBrowsers {
+		return segments
+	}
+
+	for _, browser := range backendtypes.DefaultBrowsers() {
+		color := badgeColorUnavailable
+		if feature.BrowserImplementations != nil {
+			implementation, found := (*feature.BrowserImplementations)[string(browser)]
+			if found && implementation.Status != nil && *implementation.Status == backend.Available {
+				color = badgeColorWidely
+			}
+		}
+		segments = append(segments, badgeSegment{text: badgeBrowserName(browser), color: color})
+	}
+
+	return segments
+}
+
+// savedSearchBadgeSegments returns the segments of the badge of a saved search, from the number of matching
+// features per baseline status.
+func savedSearchBadgeSegments(counts []backend.FeatureSearchFacetCount) []badgeSegment {
+	var total, widely int64
+	for _, count := range counts {
+		total += count.Count
+		if count.Value == string(backend.Widely) {
+			widely += count.Count
+		}
+	}
+	status := badgeSegment{text: "no features", color: badgeColorUnavailable}
+	if total > 0 {
+		color := badgeColorLimited
+		if widely == total {
+			color = badgeColorWidely
+		}
+		status = badgeSegment{text: fmt.Sprintf("%d/%d widely available", widely, total), color: color}
+	}
+
+	return []badgeSegment{{text: badgeLabel, color: badgeColorLabel}, status}
+}
+
+func badgeBrowserName(browser backend.BrowserPathParam) string {
+	switch browser {
+	case backend.Chrome:
+		return "Chrome"
+	case backend.ChromeAndroid:
+		return "Chrome Android"
+	case backend.Edge:
+		return "Edge"
+	case backend.Firefox:
+		return "Firefox"
+	case backend.FirefoxAndroid:
+		return "Firefox Android"
+	case backend.Safari:
+		return "Safari"
+	case backend.SafariIos:
+		return "Safari iOS"
+	}
+
+	return string(browser)
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestRenderBadge(t *testing.T) {
+	svg := renderBadge([]badgeSegment{
+		{text: "Baseline", color: badgeColorLabel},
+		{text: "a<b", color: badgeColorWidely},
+	})
+	expected := `<svg xmlns="http://www.w3.org/2000/svg" width="101" height="20" role="img" ` +
+		`aria-label="Baseline: a&lt;b"><title>Baseline: a&lt;b</title>` +
+		`<g shape-rendering="crispEdges"><rect x="0" width="68" height="20" fill="#555"/>` +
+		`<rect x="68" width="33" height="20" fill="#1e8e3e"/></g>` +
+		`<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">` +
+		`<text x="34" y="14">Baseline</text><text x="84" y="14">a&lt;b</text></g></svg>`
+	if svg != expected {
+		t.Errorf("unexpected svg\nexpected: %s\nreceived: %s", expected, svg)
+	}
+}
+
+func TestFeatureBadgeSegments(t *testing.T) {
+	testCases := []struct {
+		name         string
+		feature      *backend.Feature
+		showBrowsers bool
+		expected     []badgeSegment
+	}{
+		{
+			name: "newly available",
+			// nolint:exhaustruct // WONTFIX - only the baseline is used.
+			feature: &backend.Feature{
+				Baseline: &backend.BaselineInfo{Status: new(backend.Newly), LowDate: nil, HighDate: nil},
+			},
+			showBrowsers: false,
+			expected: []badgeSegment{
+				{text: "Baseline", color: badgeColorLabel},
+				{text: "newly available", color: badgeColorNewly},
+			},
+		},
+		{
+			name: "unknown status with browsers",
+			// nolint:exhaustruct // WONTFIX - only the browser implementations are used.
+			feature: &backend.Feature{
+				Baseline: nil,
+				BrowserImplementations: &map[string]backend.BrowserImplementation{
+					"chrome":  {Status: new(backend.Available), Date: nil, Version: nil},
+					"firefox": {Status: new(backend.Unavailable), Date: nil, Version: nil},
+				},
+			},
+			showBrowsers: true,
+			expected: []badgeSegment{
+				{text: "Baseline", color: badgeColorLabel},
+				{text: "unknown", color: badgeColorUnavailable},
+				{text: "Chrome", color: badgeColorWidely},
+				{text: "Edge", color: badgeColorUnavailable},
+				{text: "Firefox", color: badgeColorUnavailable},
+				{text: "Safari", color: badgeColorUnavailable},
+				{text: "Chrome Android", color: badgeColorUnavailable},
+				{text: "Firefox Android", color: badgeColorUnavailable},
+				{text: "Safari iOS", color: badgeColorUnavailable},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			segments := featureBadgeSegments(tc.feature, tc.showBrowsers)
+			if !slices.Equal(segments, tc.expected) {
+				t.Errorf("expected %v, received %v", tc.expected, segments)
+			}
+		})
+	}
+}
+
+func TestSavedSearchBadgeSegments(t *testing.T) {
+	testCases := []struct {
+		name     string
+		counts   []backend.FeatureSearchFacetCount
+		expected badgeSegment
+	}{
+		{
+			name:     "no features",
+			counts:   nil,
+			expected: badgeSegment{text: "no features", color: badgeColorUnavailable},
+		},
+		{
+			name: "all widely available",
+			counts: []backend.FeatureSearchFacetCount{
+				{Value: "widely", Count: 3},
+			},
+			expected: badgeSegment{text: "3/3 widely available", color: badgeColorWidely},
+		},
+		{
+			name: "partially widely available",
+			counts: []backend.FeatureSearchFacetCount{
+				{Value: "widely", Count: 3},
+				{Value: "newly", Count: 2},
+				{Value: "limited", Count: 1},
+			},
+			expected: badgeSegment{text: "3/6 widely available", color: badgeColorLimited},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			segments := savedSearchBadgeSegments(tc.counts)
+			expected := []badgeSegment{{text: "Baseline", color: badgeColorLabel}, tc.expected}
+			if !slices.Equal(segments, expected) {
+				t.Errorf("expected %v, received %v", expected, segments)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+const (
+	// batchGetFeaturesMaxIDs is the maximum number of feature IDs in a single batch lookup.
+	batchGetFeaturesMaxIDs = 100
+)
+
+var (
+	errBatchGetFeaturesNoIDs      = errors.New("feature_ids must contain at least one value")
+	errBatchGetFeaturesTooManyIDs = fmt.Errorf("feature_ids must contain at most %d values", batchGetFeaturesMaxIDs)
+	errBatchGetFeaturesEmptyID    = errors.New("feature_ids must not contain empty values")
+)
+
+// batchGetFeatureResultVisitor converts the result of a single feature lookup into a batch entry.
+type batchGetFeatureResultVisitor struct {
+	result backend.BatchGetFeatureResult
+}
+
+func (v *batchGetFeatureResultVisitor) VisitRegularFeature(_ context.Context,
+	result backendtypes.RegularFeatureResult) error {
+	v.result.Status = backend.BatchGetFeatureFound
+	v.result.Feature = result.Feature()
+
+	return nil
+}
+
+func (v *batchGetFeatureResultVisitor) VisitMovedFeature(_ context.Context,
+	result backendtypes.MovedFeatureResult) error {
+	newFeatureID := result.NewFeatureID()
+	v.result.Status = backend.BatchGetFeatureMoved
+	v.result.NewFeatureId = &newFeatureID
+
+	return nil
+}
+
+func (v *batchGetFeatureResultVisitor) VisitSplitFeature(_ context.Context,
+	result backendtypes.SplitFeatureResult) error {
+	newFeatures := result.SplitFeature().Features
+	v.result.Status = backend.BatchGetFeatureSplit
+	v.result.NewFeatures = &newFeatures
+
+	return nil
+}
+
+// validateBatchGetFeatureIDs checks the requested IDs and returns them without duplicates, in the original order.
+func validateBatchGetFeatureIDs(featureIDs []string, fieldErrors *fieldValidationErrors) []string {
+	if len(featureIDs) == 0 {
+		fieldErrors.addFieldError("feature_ids", errBatchGetFeaturesNoIDs)
+
+		return nil
+	}
+	if len(featureIDs) > batchGetFeaturesMaxIDs {
+		fieldErrors.addFieldError("feature_ids", errBatchGetFeaturesTooManyIDs)
+
+		return nil
+	}
+	seen := make(map[string]struct{}, len(featureIDs))
+	ids := make([]string, 0, len(featureIDs))
+	for _, id := range featureIDs {
+		if id == "" {
+			fieldErrors.addFieldError("feature_ids", errBatchGetFeaturesEmptyID)
+
+			return nil
+		}
+		if _, found := seen[id]; found {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+// BatchGetFeatures implements backend.StrictServerInterface.
+// nolint: revive, ireturn // Name generated from openapi
+func (s *Server) BatchGetFeatures(
+	ctx context.Context,
+	request backend.BatchGetFeaturesRequestObject,
+) (backend.BatchGetFeaturesResponseObject, error) {
+	fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+	var featureIDs []string
+	if request.Body == nil {
+		fieldErrors.addFieldError("feature_ids", errBatchGetFeaturesNoIDs)
+	} else {
+		featureIDs = validateBatchGetFeatureIDs(request.Body.FeatureIds, fieldErrors)
+	}
+	if fieldErrors.hasErrors() {
+		return backend.BatchGetFeatures400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: errMsgInputValidationErrors,
+			Errors:  fieldErrors.fieldErrorMap,
+		}, nil
+	}
+
+	results, err := s.wptMetricsStorer.BatchGetFeatures(ctx, featureIDs,
+		getWPTMetricViewOrDefault(request.Params.WptMetricView),
+		backendtypes.DefaultBrowsers(),
+	)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to batch get features", "error", err)
+
+		return backend.BatchGetFeatures500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to get features",
+		}, nil
+	}
+
+	data := make([]backend.BatchGetFeatureResult, 0, len(featureIDs))
+	for _, featureID := range featureIDs {
+		v := &batchGetFeatureResultVisitor{
+			result: backend.BatchGetFeatureResult{
+				FeatureId:    featureID,
+				Status:       backend.BatchGetFeatureNotFound,
+				Feature:      nil,
+				NewFeatureId: nil,
+				NewFeatures:  nil,
+			},
+		}
+		if result, found := results[featureID]; found {
+			err := result.Visit(ctx, v)
+			if err != nil {
+				slog.ErrorContext(ctx, "unable to determine if feature is regular, split, or moved",
+					"error", err, "featureID", featureID)
+
+				return backend.BatchGetFeatures500JSONResponse{
+					Code:    http.StatusInternalServerError,
+					Message: "unable to determine if feature is regular, split, or moved",
+				}, nil
+			}
+		}
+		data = append(data, v.result)
+	}
+
+	return backend.BatchGetFeatures200JSONResponse{
+		Data: data,
+	}, nil
+}
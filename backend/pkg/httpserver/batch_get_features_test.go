@@ -0,0 +1,147 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestBatchGetFeatures(t *testing.T) {
+	defaultExpectedBrowsers := []backend.BrowserPathParam{
+		backend.Chrome,
+		backend.Edge,
+		backend.Firefox,
+		backend.Safari,
+		backend.ChromeAndroid,
+		backend.FirefoxAndroid,
+		backend.SafariIos,
+	}
+	tooManyIDs := make([]string, 0, batchGetFeaturesMaxIDs+1)
+	for i := range batchGetFeaturesMaxIDs + 1 {
+		tooManyIDs = append(tooManyIDs, fmt.Sprintf(`"feature%d"`, i))
+	}
+	testCases := []struct {
+		name              string
+		cfg               *MockBatchGetFeaturesConfig
+		expectedCallCount int
+		url               string
+		body              string
+		expectedResponse  *http.Response
+	}{
+		{
+			name: "success - found, moved, split and not found",
+			cfg: &MockBatchGetFeaturesConfig{
+				expectedFeatureIDs:    []string{"grid", "old", "gone", "unknown"},
+				expectedWPTMetricView: backend.SubtestCounts,
+				expectedBrowsers:      defaultExpectedBrowsers,
+				data: map[string]*backendtypes.GetFeatureResult{
+					"grid": backendtypes.NewGetFeatureResult(backendtypes.NewRegularFeatureResult(&backend.Feature{
+						Baseline:                   nil,
+						BrowserImplementations:     nil,
+						DeveloperSignals:           nil,
+						Discouraged:                nil,
+						FeatureId:                  "grid",
+						Name:                       "Grid",
+						Spec:                       nil,
+						Usage:                      nil,
+						Wpt:                        nil,
+						VendorPositions:            nil,
+						SystemManagedSavedSearchId: nil,
+					})),
+					"old": backendtypes.NewGetFeatureResult(backendtypes.NewMovedFeatureResult("new")),
+					"gone": backendtypes.NewGetFeatureResult(backendtypes.NewSplitFeatureResult(
+						backend.FeatureEvolutionSplit{
+							Features: []backend.FeatureSplitInfo{{Id: "part1"}, {Id: "part2"}},
+						})),
+				},
+				err: nil,
+			},
+			expectedCallCount: 1,
+			url:               "/v1/features:batchGet?wpt_metric_view=subtest_counts",
+			body:              `{"feature_ids": ["grid", "old", "grid", "gone", "unknown"]}`,
+			expectedResponse: testJSONResponse(http.StatusOK, `{
+				"data":[
+					{"feature_id":"grid","status":"found","feature":{"feature_id":"grid","name":"Grid"}},
+					{"feature_id":"old","status":"moved","new_feature_id":"new"},
+					{"feature_id":"gone","status":"split","new_features":[{"id":"part1"},{"id":"part2"}]},
+					{"feature_id":"unknown","status":"not_found"}
+				]
+			}`),
+		},
+		{
+			name:              "bad request - too many feature ids",
+			cfg:               nil,
+			expectedCallCount: 0,
+			url:               "/v1/features:batchGet",
+			body:              `{"feature_ids": [` + strings.Join(tooManyIDs, ",") + `]}`,
+			expectedResponse: testJSONResponse(http.StatusBadRequest, `{
+				"code":400,
+				"message":"input validation errors",
+				"errors":{"feature_ids":"`+errBatchGetFeaturesTooManyIDs.Error()+`"}
+			}`),
+		},
+		{
+			name:              "bad request - no feature ids",
+			cfg:               nil,
+			expectedCallCount: 0,
+			url:               "/v1/features:batchGet",
+			body:              `{"feature_ids": []}`,
+			expectedResponse: testJSONResponse(http.StatusBadRequest, `{
+				"code":400,
+				"message":"input validation errors",
+				"errors":{"feature_ids":"`+errBatchGetFeaturesNoIDs.Error()+`"}
+			}`),
+		},
+		{
+			name: "internal server error",
+			cfg: &MockBatchGetFeaturesConfig{
+				expectedFeatureIDs:    []string{"grid"},
+				expectedWPTMetricView: backend.TestCounts,
+				expectedBrowsers:      defaultExpectedBrowsers,
+				data:                  nil,
+				err:                   errors.New("database error"),
+			},
+			expectedCallCount: 1,
+			url:               "/v1/features:batchGet",
+			body:              `{"feature_ids": ["grid"]}`,
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to get features"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				batchGetFeaturesCfg: tc.cfg,
+				t:                   t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			req := httptest.NewRequestWithContext(t.Context(),
+				http.MethodPost, tc.url, strings.NewReader(tc.body))
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse)
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountBatchGetFeatures,
+				"BatchGetFeatures", nil)
+		})
+	}
+}
@@ -110,6 +110,25 @@ func (c operationResponseCache[Key, Response]) Lookup(ctx context.Context, key K
 	return true
 }
 
+// Operation IDs of the operationResponseCache instances. They prefix the cache keys of each operation.
+const (
+	cacheOperationIDGetFeature                          = "getFeature"
+	cacheOperationIDListFeatures                        = "listFeatures"
+	cacheOperationIDGetFeatureMetadata                  = "getFeatureMetadata"
+	cacheOperationIDGetFeatureTimeline                  = "getFeatureTimeline"
+	cacheOperationIDGetFeatureBadge                     = "getFeatureBadge"
+	cacheOperationIDGetSavedSearchBadge                 = "getSavedSearchBadge"
+	cacheOperationIDListFeatureWPTMetrics               = "listFeatureWPTMetrics"
+	cacheOperationIDListChromeDailyUsageStats           = "listChromeDailyUsageStats"
+	cacheOperationIDListAggregatedFeatureSupport        = "listAggregatedFeatureSupport"
+	cacheOperationIDListMissingOneImplementationCounts  = "ListMissingOneImplementationCounts"
+	cacheOperationIDListAggregatedWPTMetrics            = "listAggregatedWPTMetrics"
+	cacheOperationIDListAggregatedBaselineStatusCounts  = "listAggregatedBaselineStatusCounts"
+	cacheOperationIDListGlobalSavedSearches             = "listGlobalSavedSearches"
+	cacheOperationIDListSavedSearchBaselineStatusCounts = "listSavedSearchBaselineStatusCounts"
+	cacheOperationIDListSavedSearchBrowserFeatureCounts = "listSavedSearchBrowserFeatureCounts"
+)
+
 // operationResponseCaches is a struct that holds multiple instances of
 // operationResponseCache, each managing caching for a specific API operation.
 // Each operationResponseCache instance wraps the underlying RawBytesDataCacher
@@ -127,6 +146,13 @@ type operationResponseCaches struct {
 		backend.GetFeatureMetadataRequestObject,
 		backend.GetFeatureMetadata200JSONResponse,
 	]
+	getFeatureTimelineCache operationResponseCache[
+		backend.GetFeatureTimelineRequestObject,
+		backend.GetFeatureTimeline200JSONResponse,
+	]
+	// The badge caches hold the rendered SVG documents.
+	getFeatureBadgeCache       operationResponseCache[backend.GetFeatureBadgeRequestObject, string]
+	getSavedSearchBadgeCache   operationResponseCache[backend.GetSavedSearchBadgeRequestObject, string]
 	listFeatureWPTMetricsCache operationResponseCache[
 		backend.ListFeatureWPTMetricsRequestObject,
 		backend.ListFeatureWPTMetrics200JSONResponse,
@@ -155,6 +181,14 @@ type operationResponseCaches struct {
 		backend.ListGlobalSavedSearchesRequestObject,
 		backend.ListGlobalSavedSearches200JSONResponse,
 	]
+	listSavedSearchBaselineStatusCountsCache operationResponseCache[
+		backend.ListSavedSearchBaselineStatusCountsRequestObject,
+		backend.ListSavedSearchBaselineStatusCounts200JSONResponse,
+	]
+	listSavedSearchBrowserFeatureCountsCache operationResponseCache[
+		backend.ListSavedSearchBrowserFeatureCountsRequestObject,
+		backend.ListSavedSearchBrowserFeatureCounts200JSONResponse,
+	]
 }
 
 // initOperationResponseCaches initializes and configures each
@@ -167,55 +201,79 @@ func initOperationResponseCaches(dataCacher RawBytesDataCacher,
 		getFeatureCache: operationResponseCache[
 			backend.GetFeatureRequestObject,
 			backend.GetFeature200JSONResponse,
-		]{cacher: dataCacher, operationID: "getFeature", overrideCacheOptions: nil},
+		]{cacher: dataCacher, operationID: cacheOperationIDGetFeature, overrideCacheOptions: nil},
 
 		listFeaturesCache: operationResponseCache[
 			backend.ListFeaturesRequestObject,
 			backend.ListFeatures200JSONResponse,
-		]{cacher: dataCacher, operationID: "listFeatures", overrideCacheOptions: nil},
+		]{cacher: dataCacher, operationID: cacheOperationIDListFeatures, overrideCacheOptions: nil},
 
 		getFeatureMetadataCache: operationResponseCache[
 			backend.GetFeatureMetadataRequestObject,
 			backend.GetFeatureMetadata200JSONResponse,
-		]{cacher: dataCacher, operationID: "getFeatureMetadata", overrideCacheOptions: nil},
+		]{cacher: dataCacher, operationID: cacheOperationIDGetFeatureMetadata, overrideCacheOptions: nil},
+
+		getFeatureTimelineCache: operationResponseCache[
+			backend.GetFeatureTimelineRequestObject,
+			backend.GetFeatureTimeline200JSONResponse,
+		]{cacher: dataCacher, operationID: cacheOperationIDGetFeatureTimeline, overrideCacheOptions: nil},
+
+		getFeatureBadgeCache: operationResponseCache[backend.GetFeatureBadgeRequestObject, string]{
+			cacher: dataCacher, operationID: cacheOperationIDGetFeatureBadge, overrideCacheOptions: nil},
+
+		getSavedSearchBadgeCache: operationResponseCache[backend.GetSavedSearchBadgeRequestObject, string]{
+			cacher: dataCacher, operationID: cacheOperationIDGetSavedSearchBadge, overrideCacheOptions: nil},
 
 		listFeatureWPTMetricsCache: operationResponseCache[
 			backend.ListFeatureWPTMetricsRequestObject,
 			backend.ListFeatureWPTMetrics200JSONResponse,
-		]{cacher: dataCacher, operationID: "listFeatureWPTMetrics", overrideCacheOptions: nil},
+		]{cacher: dataCacher, operationID: cacheOperationIDListFeatureWPTMetrics, overrideCacheOptions: nil},
 
 		listChromeDailyUsageStatsCache: operationResponseCache[
 			backend.ListChromeDailyUsageStatsRequestObject,
 			backend.ListChromeDailyUsageStats200JSONResponse,
-		]{cacher: dataCacher, operationID: "listChromeDailyUsageStats", overrideCacheOptions: nil},
+		]{cacher: dataCacher, operationID: cacheOperationIDListChromeDailyUsageStats, overrideCacheOptions: nil},
 
 		listAggregatedFeatureSupportCache: operationResponseCache[
 			backend.ListAggregatedFeatureSupportRequestObject,
 			backend.ListAggregatedFeatureSupport200JSONResponse,
-		]{cacher: dataCacher, operationID: "listAggregatedFeatureSupport",
+		]{cacher: dataCacher, operationID: cacheOperationIDListAggregatedFeatureSupport,
 			overrideCacheOptions: routeCacheOptions.AggregatedFeatureStatsOptions},
 
 		ListMissingOneImplementationCountsCache: operationResponseCache[
 			backend.ListMissingOneImplementationCountsRequestObject,
 			backend.ListMissingOneImplementationCounts200JSONResponse,
-		]{cacher: dataCacher, operationID: "ListMissingOneImplementationCounts",
+		]{cacher: dataCacher, operationID: cacheOperationIDListMissingOneImplementationCounts,
 			overrideCacheOptions: routeCacheOptions.AggregatedFeatureStatsOptions},
 
 		listAggregatedWPTMetricsCache: operationResponseCache[
 			backend.ListAggregatedWPTMetricsRequestObject,
 			backend.ListAggregatedWPTMetrics200JSONResponse,
-		]{cacher: dataCacher, operationID: "listAggregatedWPTMetrics", overrideCacheOptions: nil},
+		]{cacher: dataCacher, operationID: cacheOperationIDListAggregatedWPTMetrics, overrideCacheOptions: nil},
 
 		listAggregatedBaselineStatusCountsCache: operationResponseCache[
 			backend.ListAggregatedBaselineStatusCountsRequestObject,
 			backend.ListAggregatedBaselineStatusCounts200JSONResponse,
-		]{cacher: dataCacher, operationID: "listAggregatedBaselineStatusCounts",
+		]{cacher: dataCacher, operationID: cacheOperationIDListAggregatedBaselineStatusCounts,
 			overrideCacheOptions: routeCacheOptions.AggregatedFeatureStatsOptions},
 
 		listGlobalSavedSearchesCache: operationResponseCache[
 			backend.ListGlobalSavedSearchesRequestObject,
 			backend.ListGlobalSavedSearches200JSONResponse,
-		]{cacher: dataCacher, operationID: "listGlobalSavedSearches",
+		]{cacher: dataCacher, operationID: cacheOperationIDListGlobalSavedSearches,
 			overrideCacheOptions: routeCacheOptions.AggregatedFeatureStatsOptions},
+
+		// Saved searches can be edited at any time, so their stats use the default cache options.
+		listSavedSearchBaselineStatusCountsCache: operationResponseCache[
+			backend.ListSavedSearchBaselineStatusCountsRequestObject,
+			backend.ListSavedSearchBaselineStatusCounts200JSONResponse,
+		]{cacher: dataCacher, operationID: cacheOperationIDListSavedSearchBaselineStatusCounts,
+			overrideCacheOptions: nil},
+
+		listSavedSearchBrowserFeatureCountsCache: operationResponseCache[
+			backend.ListSavedSearchBrowserFeatureCountsRequestObject,
+			backend.ListSavedSearchBrowserFeatureCounts200JSONResponse,
+		]{cacher: dataCacher, operationID: cacheOperationIDListSavedSearchBrowserFeatureCounts,
+			overrideCacheOptions: nil},
 	}
 }
@@ -0,0 +1,91 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/cachetypes"
+)
+
+// RawBytesDataPrefixDeleter deletes every entry in the cache whose key starts with a prefix.
+type RawBytesDataPrefixDeleter interface {
+	DeleteByPrefix(ctx context.Context, prefix string) (int64, error)
+}
+
+// cacheOperationIDsForDataSource returns the operations whose cached responses are derived from the data source.
+func cacheOperationIDsForDataSource(source cachetypes.DataSource) []string {
+	switch source {
+	case cachetypes.DataSourceWebFeatures:
+		// Everything about features except the Chrome usage, which comes from UMA, and the global saved
+		// searches, which are only defined by us.
+		return []string{
+			cacheOperationIDGetFeature,
+			cacheOperationIDListFeatures,
+			cacheOperationIDGetFeatureMetadata,
+			cacheOperationIDGetFeatureTimeline,
+			cacheOperationIDGetFeatureBadge,
+			cacheOperationIDListFeatureWPTMetrics,
+			cacheOperationIDListAggregatedFeatureSupport,
+			cacheOperationIDListMissingOneImplementationCounts,
+			cacheOperationIDListAggregatedWPTMetrics,
+			cacheOperationIDListAggregatedBaselineStatusCounts,
+			cacheOperationIDListSavedSearchBaselineStatusCounts,
+			cacheOperationIDListSavedSearchBrowserFeatureCounts,
+			cacheOperationIDGetSavedSearchBadge,
+		}
+	case cachetypes.DataSourceWPT:
+		return []string{
+			cacheOperationIDGetFeature,
+			cacheOperationIDListFeatures,
+			cacheOperationIDListFeatureWPTMetrics,
+			cacheOperationIDListAggregatedWPTMetrics,
+		}
+	}
+
+	return nil
+}
+
+// CacheInvalidator drops the cached operation responses that become stale when a data source is updated.
+type CacheInvalidator struct {
+	deleter RawBytesDataPrefixDeleter
+}
+
+func NewCacheInvalidator(deleter RawBytesDataPrefixDeleter) *CacheInvalidator {
+	return &CacheInvalidator{deleter: deleter}
+}
+
+// InvalidateDataSource deletes the cached responses of every operation derived from the data source.
+// It attempts every operation before returning the errors so that one failure does not leave the other
+// operations stale.
+func (c *CacheInvalidator) InvalidateDataSource(ctx context.Context, source cachetypes.DataSource) error {
+	var errs []error
+	for _, operationID := range cacheOperationIDsForDataSource(source) {
+		// Same format as operationResponseCache.key.
+		deleted, err := c.deleter.DeleteByPrefix(ctx, operationID+"-")
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to invalidate cache for operation %s: %w", operationID, err))
+
+			continue
+		}
+		slog.InfoContext(ctx, "invalidated cached responses", "source", source, "operation", operationID,
+			"deleted", deleted)
+	}
+
+	return errors.Join(errs...)
+}
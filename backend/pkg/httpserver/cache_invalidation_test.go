@@ -0,0 +1,104 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/cachetypes"
+)
+
+type mockRawBytesDataPrefixDeleter struct {
+	prefixes []string
+	errs     map[string]error
+}
+
+func (m *mockRawBytesDataPrefixDeleter) DeleteByPrefix(_ context.Context, prefix string) (int64, error) {
+	m.prefixes = append(m.prefixes, prefix)
+
+	return 1, m.errs[prefix]
+}
+
+var errTestDeleteByPrefix = errors.New("delete by prefix error")
+
+func TestCacheInvalidatorInvalidateDataSource(t *testing.T) {
+	testCases := []struct {
+		name             string
+		source           cachetypes.DataSource
+		errs             map[string]error
+		expectedPrefixes []string
+		expectedError    error
+	}{
+		{
+			name:   "wpt",
+			source: cachetypes.DataSourceWPT,
+			errs:   nil,
+			expectedPrefixes: []string{
+				"getFeature-",
+				"listFeatures-",
+				"listFeatureWPTMetrics-",
+				"listAggregatedWPTMetrics-",
+			},
+			expectedError: nil,
+		},
+		{
+			name:   "failures do not stop the other operations",
+			source: cachetypes.DataSourceWPT,
+			errs: map[string]error{
+				"getFeature-": errTestDeleteByPrefix,
+			},
+			expectedPrefixes: []string{
+				"getFeature-",
+				"listFeatures-",
+				"listFeatureWPTMetrics-",
+				"listAggregatedWPTMetrics-",
+			},
+			expectedError: errTestDeleteByPrefix,
+		},
+		{
+			name:             "unknown source",
+			source:           "unknown",
+			errs:             nil,
+			expectedPrefixes: nil,
+			expectedError:    nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			deleter := &mockRawBytesDataPrefixDeleter{prefixes: nil, errs: tc.errs}
+			err := NewCacheInvalidator(deleter).InvalidateDataSource(context.Background(), tc.source)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("expected error %v, got %v", tc.expectedError, err)
+			}
+			if !slices.Equal(deleter.prefixes, tc.expectedPrefixes) {
+				t.Errorf("expected prefixes %v, got %v", tc.expectedPrefixes, deleter.prefixes)
+			}
+		})
+	}
+}
+
+func TestCacheOperationIDsForDataSourceWebFeatures(t *testing.T) {
+	operationIDs := cacheOperationIDsForDataSource(cachetypes.DataSourceWebFeatures)
+	// Chrome usage does not come from web features.
+	if slices.Contains(operationIDs, cacheOperationIDListChromeDailyUsageStats) {
+		t.Error("unexpected chrome usage operation")
+	}
+	if !slices.Contains(operationIDs, cacheOperationIDListAggregatedBaselineStatusCounts) {
+		t.Error("expected baseline status counts operation")
+	}
+}
@@ -60,6 +60,7 @@ func getTestAggregatedCacheConfig() *cachetypes.CacheConfig {
 
 func getTestRouteCacheOptions() RouteCacheOptions {
 	return RouteCacheOptions{
+		DefaultTTL: 0,
 		AggregatedFeatureStatsOptions: []cachetypes.CacheOption{
 			cachetypes.WithTTL(10),
 		},
@@ -0,0 +1,427 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+const (
+	// compatReportMaxQueryFeatures is the maximum number of features a search query may match.
+	compatReportMaxQueryFeatures = 1000
+	// compatReportPageSize is the number of features fetched per page while reading the matches of a query.
+	compatReportPageSize = 100
+)
+
+var (
+	errCompatReportNoTargets        = errors.New("targets must contain at least one value")
+	errCompatReportUnknownBrowser   = errors.New("targets must only contain supported browsers")
+	errCompatReportDuplicateBrowser = errors.New("targets must not contain the same browser twice")
+	errCompatReportInvalidVersion   = errors.New(
+		"min_version must be a version such as 110 or 16.4, or a release channel such as esr")
+	errCompatReportUnknownChannel   = errors.New("min_version must name a release channel that the browser has")
+	errCompatReportFeatureSelection = errors.New("exactly one of feature_ids and q must be set")
+)
+
+// compatReportTarget is a target of the browser support matrix with its parsed minimum version.
+// When the minimum version names a release channel, resolvedMinVersion is the release the channel resolved to.
+type compatReportTarget struct {
+	browser            backend.SupportedBrowsers
+	minVersion         string
+	resolvedMinVersion *string
+	version            []int
+}
+
+// isCompatReportChannel reports whether a minimum version names a release channel instead of a version.
+// The channels match the BCD statuses of the browser releases.
+func isCompatReportChannel(minVersion string) bool {
+	return minVersion == "current" || minVersion == "esr"
+}
+
+// parseBrowserVersion parses a version made of dot separated numbers, such as 16.4.
+func parseBrowserVersion(version string) ([]int, bool) {
+	parts := strings.Split(version, ".")
+	ret := make([]int, 0, len(parts))
+	for _, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil, false
+		}
+		ret = append(ret, n)
+	}
+
+	return ret, true
+}
+
+// compareBrowserVersions compares two parsed versions. Missing trailing numbers count as zeros so that 16 and
+// 16.0 are equal.
+func compareBrowserVersions(a, b []int) int {
+	for i := range max(len(a), len(b)) {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// validateCompatReportTargets checks the browser support matrix and returns the parsed targets.
+func validateCompatReportTargets(targets []backend.CompatReportTarget,
+	fieldErrors *fieldValidationErrors) []compatReportTarget {
+	if len(targets) == 0 {
+		fieldErrors.addFieldError("targets", errCompatReportNoTargets)
+
+		return nil
+	}
+	seen := make(map[backend.SupportedBrowsers]struct{}, len(targets))
+	ret := make([]compatReportTarget, 0, len(targets))
+	for _, target := range targets {
+		if !target.Browser.Valid() {
+			fieldErrors.addFieldError("targets", errCompatReportUnknownBrowser)
+
+			return nil
+		}
+		if _, found := seen[target.Browser]; found {
+			fieldErrors.addFieldError("targets", errCompatReportDuplicateBrowser)
+
+			return nil
+		}
+		seen[target.Browser] = struct{}{}
+		if isCompatReportChannel(target.MinVersion) {
+			// The version is filled in by resolveCompatReportChannels.
+			ret = append(ret, compatReportTarget{
+				browser: target.Browser, minVersion: target.MinVersion, resolvedMinVersion: nil, version: nil})
+
+			continue
+		}
+		version, ok := parseBrowserVersion(target.MinVersion)
+		if !ok {
+			fieldErrors.addFieldError("targets", errCompatReportInvalidVersion)
+
+			return nil
+		}
+		ret = append(ret, compatReportTarget{
+			browser: target.Browser, minVersion: target.MinVersion, resolvedMinVersion: nil, version: version})
+	}
+
+	return ret
+}
+
+// resolveCompatReportChannels looks up the releases that the release channels of the targets point to.
+// A channel that the browser does not have is reported as a field error.
+func (s *Server) resolveCompatReportChannels(ctx context.Context, targets []compatReportTarget,
+	fieldErrors *fieldValidationErrors) error {
+	for idx := range targets {
+		target := &targets[idx]
+		if !isCompatReportChannel(target.minVersion) {
+			continue
+		}
+		resolved, err := s.wptMetricsStorer.GetBrowserReleaseChannelVersion(ctx, target.browser, target.minVersion)
+		if err != nil {
+			if errors.Is(err, backendtypes.ErrEntityDoesNotExist) {
+				fieldErrors.addFieldError("targets", errCompatReportUnknownChannel)
+
+				return nil
+			}
+
+			return err
+		}
+		version, ok := parseBrowserVersion(resolved)
+		if !ok {
+			return fmt.Errorf("release channel %s of %s resolved to the unexpected version %q",
+				target.minVersion, target.browser, resolved)
+		}
+		target.resolvedMinVersion = &resolved
+		target.version = version
+	}
+
+	return nil
+}
+
+// compatReportBlockerReason returns why the target does not support a feature that the browser shipped in the
+// given release, or false if the target supports it.
+func compatReportBlockerReason(availableSince *string,
+	target compatReportTarget) (backend.CompatReportBlockerReason, bool) {
+	if availableSince == nil {
+		return backend.NotAvailable, true
+	}
+	if version, ok := parseBrowserVersion(*availableSince); ok {
+		if compareBrowserVersions(version, target.version) <= 0 {
+			return "", false
+		}
+
+		return backend.NewerVersion, true
+	}
+	// A ranged version such as ≤79 means the feature shipped in that release or an older unknown one. That is
+	// only enough when the upper bound is not newer than the target.
+	if upperBound, found := strings.CutPrefix(*availableSince, "≤"); found {
+		version, ok := parseBrowserVersion(upperBound)
+		if ok && compareBrowserVersions(version, target.version) <= 0 {
+			return "", false
+		}
+	}
+
+	return backend.UnknownVersion, true
+}
+
+// compatReportBlockers returns the targets that do not support the feature. A target supports the feature when
+// the browser shipped it in a release that is not newer than the minimum version of the target.
+func compatReportBlockers(feature *backend.Feature, targets []compatReportTarget) []backend.CompatReportBlocker {
+	blockers := make([]backend.CompatReportBlocker, 0)
+	for _, target := range targets {
+		var availableSince *string
+		if feature.BrowserImplementations != nil {
+			implementation, found := (*feature.BrowserImplementations)[string(target.browser)]
+			if found && implementation.Status != nil && *implementation.Status == backend.Available {
+				availableSince = implementation.Version
+			}
+		}
+		reason, blocked := compatReportBlockerReason(availableSince, target)
+		if !blocked {
+			continue
+		}
+		blockers = append(blockers, backend.CompatReportBlocker{
+			Browser:               target.browser,
+			MinVersion:            target.minVersion,
+			ResolvedMinVersion:    target.resolvedMinVersion,
+			AvailableSinceVersion: availableSince,
+			Reason:                reason,
+		})
+	}
+
+	return blockers
+}
+
+// newCompatReportFeatureResult checks a feature against the targets.
+func newCompatReportFeatureResult(feature *backend.Feature,
+	targets []compatReportTarget) backend.CompatReportFeatureResult {
+	blockers := compatReportBlockers(feature, targets)
+
+	return backend.CompatReportFeatureResult{
+		FeatureId:    feature.FeatureId,
+		Status:       backend.BatchGetFeatureFound,
+		Name:         &feature.Name,
+		Supported:    len(blockers) == 0,
+		Blockers:     blockers,
+		NewFeatureId: nil,
+		NewFeatures:  nil,
+	}
+}
+
+// GetCompatReport implements backend.StrictServerInterface.
+// nolint: revive, ireturn // Name generated from openapi
+func (s *Server) GetCompatReport(
+	ctx context.Context,
+	request backend.GetCompatReportRequestObject,
+) (backend.GetCompatReportResponseObject, error) {
+	fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+	var targets []compatReportTarget
+	var featureIDs []string
+	if request.Body == nil {
+		fieldErrors.addFieldError("targets", errCompatReportNoTargets)
+	} else {
+		targets = validateCompatReportTargets(request.Body.Targets, fieldErrors)
+		switch {
+		case (request.Body.FeatureIds == nil) == (request.Body.Q == nil):
+			fieldErrors.addFieldError("feature_ids", errCompatReportFeatureSelection)
+		case request.Body.FeatureIds != nil:
+			featureIDs = validateBatchGetFeatureIDs(*request.Body.FeatureIds, fieldErrors)
+		}
+	}
+	if fieldErrors.hasErrors() {
+		return backend.GetCompatReport400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: errMsgInputValidationErrors,
+			Errors:  fieldErrors.fieldErrorMap,
+		}, nil
+	}
+
+	err := s.resolveCompatReportChannels(ctx, targets, fieldErrors)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to resolve release channels for compat report", "error", err)
+
+		return backend.GetCompatReport500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to get compat report",
+		}, nil
+	}
+	if fieldErrors.hasErrors() {
+		return backend.GetCompatReport400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: errMsgInputValidationErrors,
+			Errors:  fieldErrors.fieldErrorMap,
+		}, nil
+	}
+
+	browsers := make([]backend.BrowserPathParam, 0, len(targets))
+	for _, target := range targets {
+		browsers = append(browsers, target.browser)
+	}
+
+	if featureIDs != nil {
+		return s.getCompatReportForFeatureIDs(ctx, featureIDs, targets, browsers)
+	}
+
+	node, errModel := parseFeaturesSearchQuery(ctx, request.Body.Q)
+	if errModel != nil {
+		return backend.GetCompatReport400JSONResponse{
+			Code:    errModel.Code,
+			Message: errModel.Message,
+			Errors:  nil,
+		}, nil
+	}
+
+	return s.getCompatReportForQuery(ctx, node, targets, browsers)
+}
+
+// nolint: ireturn // WONTFIX - generated response type
+func (s *Server) getCompatReportForFeatureIDs(
+	ctx context.Context,
+	featureIDs []string,
+	targets []compatReportTarget,
+	browsers []backend.BrowserPathParam,
+) (backend.GetCompatReportResponseObject, error) {
+	results, err := s.wptMetricsStorer.BatchGetFeatures(ctx, featureIDs, getWPTMetricViewOrDefault(nil), browsers)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to get features for compat report", "error", err)
+
+		return backend.GetCompatReport500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to get compat report",
+		}, nil
+	}
+
+	resp := backend.GetCompatReport200JSONResponse{
+		Supported: true,
+		Data:      make([]backend.CompatReportFeatureResult, 0, len(featureIDs)),
+	}
+	for _, featureID := range featureIDs {
+		v := &batchGetFeatureResultVisitor{
+			result: backend.BatchGetFeatureResult{
+				FeatureId:    featureID,
+				Status:       backend.BatchGetFeatureNotFound,
+				Feature:      nil,
+				NewFeatureId: nil,
+				NewFeatures:  nil,
+			},
+		}
+		if result, found := results[featureID]; found {
+			err := result.Visit(ctx, v)
+			if err != nil {
+				slog.ErrorContext(ctx, "unable to determine if feature is regular, split, or moved",
+					"error", err, "featureID", featureID)
+
+				return backend.GetCompatReport500JSONResponse{
+					Code:    http.StatusInternalServerError,
+					Message: "unable to determine if feature is regular, split, or moved",
+				}, nil
+			}
+		}
+
+		entry := backend.CompatReportFeatureResult{
+			FeatureId:    featureID,
+			Status:       v.result.Status,
+			Name:         nil,
+			Supported:    false,
+			Blockers:     []backend.CompatReportBlocker{},
+			NewFeatureId: v.result.NewFeatureId,
+			NewFeatures:  v.result.NewFeatures,
+		}
+		if v.result.Feature != nil {
+			entry = newCompatReportFeatureResult(v.result.Feature, targets)
+			// Keep the requested ID, which may differ in case from the stored one.
+			entry.FeatureId = featureID
+		}
+		resp.Supported = resp.Supported && entry.Supported
+		resp.Data = append(resp.Data, entry)
+	}
+
+	return resp, nil
+}
+
+// nolint: ireturn // WONTFIX - generated response type
+func (s *Server) getCompatReportForQuery(
+	ctx context.Context,
+	node *searchtypes.SearchNode,
+	targets []compatReportTarget,
+	browsers []backend.BrowserPathParam,
+) (backend.GetCompatReportResponseObject, error) {
+	resp := backend.GetCompatReport200JSONResponse{
+		Supported: true,
+		Data:      []backend.CompatReportFeatureResult{},
+	}
+	var pageToken *string
+	for {
+		page, err := s.wptMetricsStorer.FeaturesSearch(ctx, pageToken, compatReportPageSize, node, nil,
+			getWPTMetricViewOrDefault(nil), browsers, nil)
+		if err != nil {
+			if isInvalidSavedSearchQueryError(err) {
+				slog.WarnContext(ctx, "invalid saved search query", "error", err)
+
+				return backend.GetCompatReport400JSONResponse{
+					Code:    http.StatusBadRequest,
+					Message: invalidSavedSearchQueryMessage(err),
+					Errors:  nil,
+				}, nil
+			}
+			slog.ErrorContext(ctx, "unable to search features for compat report", "error", err)
+
+			return backend.GetCompatReport500JSONResponse{
+				Code:    http.StatusInternalServerError,
+				Message: "unable to get compat report",
+			}, nil
+		}
+		if page.Metadata.Total > compatReportMaxQueryFeatures {
+			return backend.GetCompatReport400JSONResponse{
+				Code: http.StatusBadRequest,
+				Message: fmt.Sprintf("query matches %d features, which is more than the limit of %d",
+					page.Metadata.Total, compatReportMaxQueryFeatures),
+				Errors: nil,
+			}, nil
+		}
+
+		for idx := range page.Data {
+			entry := newCompatReportFeatureResult(&page.Data[idx], targets)
+			resp.Supported = resp.Supported && entry.Supported
+			resp.Data = append(resp.Data, entry)
+		}
+
+		if page.Metadata.NextPageToken == nil {
+			return resp, nil
+		}
+		pageToken = page.Metadata.NextPageToken
+	}
+}
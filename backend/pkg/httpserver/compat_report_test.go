@@ -0,0 +1,424 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCompareBrowserVersions(t *testing.T) {
+	testCases := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{a: "110", b: "110", expected: 0},
+		{a: "16", b: "16.0", expected: 0},
+		{a: "16.4", b: "16.10", expected: -1},
+		{a: "111", b: "110", expected: 1},
+		{a: "17", b: "16.4", expected: 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.a+" vs "+tc.b, func(t *testing.T) {
+			a, okA := parseBrowserVersion(tc.a)
+			b, okB := parseBrowserVersion(tc.b)
+			if !okA || !okB {
+				t.Fatalf("unable to parse versions %s and %s", tc.a, tc.b)
+			}
+			if got := compareBrowserVersions(a, b); got != tc.expected {
+				t.Errorf("expected %d, received %d", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseBrowserVersionInvalid(t *testing.T) {
+	for _, version := range []string{"", "16.", "≤79", "preview", "-1"} {
+		if _, ok := parseBrowserVersion(version); ok {
+			t.Errorf("expected %q to be invalid", version)
+		}
+	}
+}
+
+func TestCompatReportBlockerReason(t *testing.T) {
+	target := compatReportTarget{browser: backend.Chrome, minVersion: "110", resolvedMinVersion: nil,
+		version: []int{110}}
+	testCases := []struct {
+		name            string
+		availableSince  *string
+		expectedReason  backend.CompatReportBlockerReason
+		expectedBlocked bool
+	}{
+		{name: "not available", availableSince: nil, expectedReason: backend.NotAvailable, expectedBlocked: true},
+		{name: "older release", availableSince: new("100"), expectedReason: "", expectedBlocked: false},
+		{name: "same release", availableSince: new("110"), expectedReason: "", expectedBlocked: false},
+		{name: "newer release", availableSince: new("111"), expectedReason: backend.NewerVersion,
+			expectedBlocked: true},
+		{name: "ranged release not newer than the target", availableSince: new("≤79"), expectedReason: "",
+			expectedBlocked: false},
+		{name: "ranged release newer than the target", availableSince: new("≤120"),
+			expectedReason: backend.UnknownVersion, expectedBlocked: true},
+		{name: "unparsable release", availableSince: new("preview"), expectedReason: backend.UnknownVersion,
+			expectedBlocked: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reason, blocked := compatReportBlockerReason(tc.availableSince, target)
+			if reason != tc.expectedReason || blocked != tc.expectedBlocked {
+				t.Errorf("expected (%q, %t), received (%q, %t)", tc.expectedReason, tc.expectedBlocked, reason, blocked)
+			}
+		})
+	}
+}
+
+func TestGetCompatReport(t *testing.T) {
+	targetBrowsers := []backend.BrowserPathParam{backend.Chrome, backend.Safari}
+	targets := `"targets": [{"browser": "chrome", "min_version": "110"}, {"browser": "safari", "min_version": "16.4"}]`
+	newFeature := func(id string, chromeVersion, safariVersion string) *backend.Feature {
+		// nolint:exhaustruct // WONTFIX - only the implementations are used.
+		return &backend.Feature{
+			FeatureId: id,
+			Name:      id + " name",
+			BrowserImplementations: &map[string]backend.BrowserImplementation{
+				"chrome": {Status: new(backend.Available), Date: nil, Version: new(chromeVersion)},
+				"safari": {Status: new(backend.Available), Date: nil, Version: new(safariVersion)},
+			},
+		}
+	}
+	newFirefoxFeature := func(id string, firefoxVersion string) *backend.Feature {
+		// nolint:exhaustruct // WONTFIX - only the implementations are used.
+		return &backend.Feature{
+			FeatureId: id,
+			Name:      id + " name",
+			BrowserImplementations: &map[string]backend.BrowserImplementation{
+				"firefox": {Status: new(backend.Available), Date: nil, Version: new(firefoxVersion)},
+			},
+		}
+	}
+	testCases := []struct {
+		name                   string
+		batchCfg               *MockBatchGetFeaturesConfig
+		channelCfg             *MockGetBrowserReleaseChannelVersionConfig
+		expectedBatchCallCount int
+		body                   string
+		expectedResponse       *http.Response
+	}{
+		{
+			name: "feature ids - supported, blocked, moved and not found",
+			batchCfg: &MockBatchGetFeaturesConfig{
+				expectedFeatureIDs:    []string{"grid", "has", "old", "unknown"},
+				expectedWPTMetricView: backend.TestCounts,
+				expectedBrowsers:      targetBrowsers,
+				data: map[string]*backendtypes.GetFeatureResult{
+					"grid": backendtypes.NewGetFeatureResult(
+						backendtypes.NewRegularFeatureResult(newFeature("grid", "57", "10.1"))),
+					"has": backendtypes.NewGetFeatureResult(
+						backendtypes.NewRegularFeatureResult(newFeature("has", "105", "17"))),
+					"old": backendtypes.NewGetFeatureResult(backendtypes.NewMovedFeatureResult("new")),
+				},
+				err: nil,
+			},
+			channelCfg:             nil,
+			expectedBatchCallCount: 1,
+			body:                   `{` + targets + `, "feature_ids": ["grid", "has", "old", "unknown", "grid"]}`,
+			expectedResponse: testJSONResponse(http.StatusOK, `{"supported":false,"data":[
+{"feature_id":"grid","name":"grid name","status":"found","supported":true,"blockers":[]},
+{"feature_id":"has","name":"has name","status":"found","supported":false,"blockers":[
+{"browser":"safari","min_version":"16.4","available_since_version":"17","reason":"newer_version"}]},
+{"feature_id":"old","status":"moved","new_feature_id":"new","supported":false,"blockers":[]},
+{"feature_id":"unknown","status":"not_found","supported":false,"blockers":[]}]}`),
+		},
+		{
+			name:                   "invalid - both feature ids and query",
+			batchCfg:               nil,
+			channelCfg:             nil,
+			expectedBatchCallCount: 0,
+			body:                   `{` + targets + `, "feature_ids": ["grid"], "q": "baseline_status:newly"}`,
+			expectedResponse: testJSONResponse(http.StatusBadRequest, `{"code":400,
+"message":"input validation errors","errors":{"feature_ids":"exactly one of feature_ids and q must be set"}}`),
+		},
+		{
+			name:                   "invalid - duplicate browser",
+			batchCfg:               nil,
+			channelCfg:             nil,
+			expectedBatchCallCount: 0,
+			body: `{"targets": [{"browser": "chrome", "min_version": "110"},
+{"browser": "chrome", "min_version": "111"}], "feature_ids": ["grid"]}`,
+			expectedResponse: testJSONResponse(http.StatusBadRequest, `{"code":400,
+"message":"input validation errors","errors":{"targets":"targets must not contain the same browser twice"}}`),
+		},
+		{
+			name:                   "invalid - version",
+			batchCfg:               nil,
+			channelCfg:             nil,
+			expectedBatchCallCount: 0,
+			body: `{"targets": [{"browser": "firefox", "min_version": "nightly"}],
+"feature_ids": ["grid"]}`,
+			expectedResponse: testJSONResponse(http.StatusBadRequest, `{"code":400,
+"message":"input validation errors",
+"errors":{"targets":"min_version must be a version such as 110 or 16.4, or a release channel such as esr"}}`),
+		},
+		{
+			name: "release channel - resolved to a release",
+			batchCfg: &MockBatchGetFeaturesConfig{
+				expectedFeatureIDs:    []string{"grid", "has"},
+				expectedWPTMetricView: backend.TestCounts,
+				expectedBrowsers:      []backend.BrowserPathParam{backend.Firefox},
+				data: map[string]*backendtypes.GetFeatureResult{
+					"grid": backendtypes.NewGetFeatureResult(
+						backendtypes.NewRegularFeatureResult(newFirefoxFeature("grid", "52"))),
+					"has": backendtypes.NewGetFeatureResult(
+						backendtypes.NewRegularFeatureResult(newFirefoxFeature("has", "121"))),
+				},
+				err: nil,
+			},
+			channelCfg: &MockGetBrowserReleaseChannelVersionConfig{
+				expectedBrowser: backend.Firefox,
+				expectedChannel: "esr",
+				result:          "115",
+				err:             nil,
+			},
+			expectedBatchCallCount: 1,
+			body: `{"targets": [{"browser": "firefox", "min_version": "esr"}],
+"feature_ids": ["grid", "has"]}`,
+			expectedResponse: testJSONResponse(http.StatusOK, `{"supported":false,"data":[
+{"feature_id":"grid","name":"grid name","status":"found","supported":true,"blockers":[]},
+{"feature_id":"has","name":"has name","status":"found","supported":false,"blockers":[
+{"browser":"firefox","min_version":"esr","resolved_min_version":"115","available_since_version":"121",
+"reason":"newer_version"}]}]}`),
+		},
+		{
+			name:     "invalid - release channel the browser does not have",
+			batchCfg: nil,
+			channelCfg: &MockGetBrowserReleaseChannelVersionConfig{
+				expectedBrowser: backend.Chrome,
+				expectedChannel: "esr",
+				result:          "",
+				err:             backendtypes.ErrEntityDoesNotExist,
+			},
+			expectedBatchCallCount: 0,
+			body: `{"targets": [{"browser": "chrome", "min_version": "esr"}],
+"feature_ids": ["grid"]}`,
+			expectedResponse: testJSONResponse(http.StatusBadRequest, `{"code":400,
+"message":"input validation errors",
+"errors":{"targets":"min_version must name a release channel that the browser has"}}`),
+		},
+		{
+			name:     "release channel - internal server error",
+			batchCfg: nil,
+			channelCfg: &MockGetBrowserReleaseChannelVersionConfig{
+				expectedBrowser: backend.Firefox,
+				expectedChannel: "current",
+				result:          "",
+				err:             errors.New("database error"),
+			},
+			expectedBatchCallCount: 0,
+			body: `{"targets": [{"browser": "firefox", "min_version": "current"}],
+"feature_ids": ["grid"]}`,
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to get compat report"}`),
+		},
+		{
+			name:                   "invalid - no targets",
+			batchCfg:               nil,
+			channelCfg:             nil,
+			expectedBatchCallCount: 0,
+			body:                   `{"targets": [], "feature_ids": ["grid"]}`,
+			expectedResponse: testJSONResponse(http.StatusBadRequest, `{"code":400,
+"message":"input validation errors","errors":{"targets":"targets must contain at least one value"}}`),
+		},
+		{
+			name: "internal server error",
+			batchCfg: &MockBatchGetFeaturesConfig{
+				expectedFeatureIDs:    []string{"grid"},
+				expectedWPTMetricView: backend.TestCounts,
+				expectedBrowsers:      targetBrowsers,
+				data:                  nil,
+				err:                   errors.New("database error"),
+			},
+			channelCfg:             nil,
+			expectedBatchCallCount: 1,
+			body:                   `{` + targets + `, "feature_ids": ["grid"]}`,
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to get compat report"}`),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				batchGetFeaturesCfg:                tc.batchCfg,
+				getBrowserReleaseChannelVersionCfg: tc.channelCfg,
+				t:                                  t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			req := httptest.NewRequestWithContext(t.Context(),
+				http.MethodPost, "/v1/compat:report", strings.NewReader(tc.body))
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse)
+			assertMocksExpectations(t, tc.expectedBatchCallCount, mockStorer.callCountBatchGetFeatures,
+				"BatchGetFeatures", nil)
+			expectedChannelCallCount := 0
+			if tc.channelCfg != nil {
+				expectedChannelCallCount = 1
+			}
+			assertMocksExpectations(t, expectedChannelCallCount, mockStorer.callCountGetBrowserReleaseChannelVersion,
+				"GetBrowserReleaseChannelVersion", nil)
+		})
+	}
+}
+
+func TestGetCompatReportForQuery(t *testing.T) {
+	targetBrowsers := []backend.BrowserPathParam{backend.Chrome, backend.Safari}
+	targets := []compatReportTarget{
+		{browser: backend.Chrome, minVersion: "110", resolvedMinVersion: nil, version: []int{110}},
+		{browser: backend.Safari, minVersion: "16.4", resolvedMinVersion: nil, version: []int{16, 4}},
+	}
+	searchNode := &searchtypes.SearchNode{
+		Keyword: searchtypes.KeywordRoot,
+		Term:    nil,
+		Children: []*searchtypes.SearchNode{
+			{
+				Keyword: searchtypes.KeywordNone,
+				Term: &searchtypes.SearchTerm{
+					Identifier: searchtypes.IdentifierBaselineStatus,
+					Operator:   searchtypes.OperatorEq,
+					Value:      "newly",
+				},
+				Children: nil,
+			},
+		},
+	}
+	searchCfg := func(page *backend.FeaturePage, err error) *MockFeaturesSearchConfig {
+		return &MockFeaturesSearchConfig{
+			expectedPageToken:     nil,
+			expectedPageSize:      compatReportPageSize,
+			expectedSearchNode:    searchNode,
+			expectedSortBy:        nil,
+			expectedWPTMetricView: backend.TestCounts,
+			expectedBrowsers:      targetBrowsers,
+			expectedQueryParams:   nil,
+			page:                  page,
+			err:                   err,
+		}
+	}
+	testCases := []struct {
+		name             string
+		cfg              *MockFeaturesSearchConfig
+		expectedResponse backend.GetCompatReportResponseObject
+	}{
+		{
+			name: "blocked by a newer release and a missing implementation",
+			cfg: searchCfg(&backend.FeaturePage{
+				Metadata: backend.PageMetadataWithTotal{NextPageToken: nil, Total: 2},
+				Data: []backend.Feature{
+					// nolint:exhaustruct // WONTFIX - only the implementations are used.
+					{
+						FeatureId: "grid",
+						Name:      "Grid",
+						BrowserImplementations: &map[string]backend.BrowserImplementation{
+							"chrome": {Status: new(backend.Available), Date: nil, Version: new("57")},
+							"safari": {Status: new(backend.Available), Date: nil, Version: new("10.1")},
+						},
+					},
+					// nolint:exhaustruct // WONTFIX - only the implementations are used.
+					{
+						FeatureId: "popover",
+						Name:      "Popover",
+						BrowserImplementations: &map[string]backend.BrowserImplementation{
+							"chrome": {Status: new(backend.Available), Date: nil, Version: new("114")},
+						},
+					},
+				},
+				Facets: nil,
+			}, nil),
+			expectedResponse: backend.GetCompatReport200JSONResponse{
+				Supported: false,
+				Data: []backend.CompatReportFeatureResult{
+					{
+						FeatureId:    "grid",
+						Status:       backend.BatchGetFeatureFound,
+						Name:         new("Grid"),
+						Supported:    true,
+						Blockers:     []backend.CompatReportBlocker{},
+						NewFeatureId: nil,
+						NewFeatures:  nil,
+					},
+					{
+						FeatureId: "popover",
+						Status:    backend.BatchGetFeatureFound,
+						Name:      new("Popover"),
+						Supported: false,
+						Blockers: []backend.CompatReportBlocker{
+							{Browser: backend.Chrome, MinVersion: "110", ResolvedMinVersion: nil,
+								AvailableSinceVersion: new("114"), Reason: backend.NewerVersion},
+							{Browser: backend.Safari, MinVersion: "16.4", ResolvedMinVersion: nil,
+								AvailableSinceVersion: nil, Reason: backend.NotAvailable},
+						},
+						NewFeatureId: nil,
+						NewFeatures:  nil,
+					},
+				},
+			},
+		},
+		{
+			name: "too many features",
+			cfg: searchCfg(&backend.FeaturePage{
+				Metadata: backend.PageMetadataWithTotal{NextPageToken: new("next"), Total: 1001},
+				Data:     []backend.Feature{},
+				Facets:   nil,
+			}, nil),
+			expectedResponse: backend.GetCompatReport400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: "query matches 1001 features, which is more than the limit of 1000",
+				Errors:  nil,
+			},
+		},
+		{
+			name: "internal server error",
+			cfg:  searchCfg(nil, errors.New("database error")),
+			expectedResponse: backend.GetCompatReport500JSONResponse{
+				Code:    http.StatusInternalServerError,
+				Message: "unable to get compat report",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				featuresSearchCfg: tc.cfg,
+				t:                 t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			resp, err := myServer.getCompatReportForQuery(t.Context(), searchNode, targets, targetBrowsers)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.expectedResponse, resp); diff != "" {
+				t.Errorf("unexpected response (-want +got):\n%s", diff)
+			}
+			assertMocksExpectations(t, 1, mockStorer.callCountFeaturesSearch, "FeaturesSearch", nil)
+		})
+	}
+}
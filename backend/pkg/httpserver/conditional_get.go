@@ -0,0 +1,178 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/cachetypes"
+)
+
+// routeMaxAges returns how long clients may reuse the responses of the public GET routes that are backed by an
+// operationResponseCache, keyed by route pattern. The durations match the TTLs of the respective caches so that
+// clients and CDNs never hold on to a response longer than the server would.
+// Routes that are not listed are not eligible for conditional requests.
+func routeMaxAges(routeCacheOptions RouteCacheOptions) map[string]time.Duration {
+	defaultTTL := routeCacheOptions.DefaultTTL
+	aggregatedConfig := cachetypes.NewCacheConfig(defaultTTL)
+	for _, opt := range routeCacheOptions.AggregatedFeatureStatsOptions {
+		opt(aggregatedConfig)
+	}
+	aggregatedTTL := aggregatedConfig.GetTTL()
+
+	maxAges := make(map[string]time.Duration)
+	for _, pattern := range []string{
+		"GET /v1/features",
+		"GET /v1/features/{feature_id}",
+		"GET /v1/features/{feature_id}/feature-metadata",
+		"GET /v1/features/{feature_id}/timeline",
+		"GET /v1/features/{feature_id}/badge.svg",
+		"GET /v1/features/{feature_id}/stats/usage/chrome/daily_stats",
+		"GET /v1/features/{feature_id}/stats/wpt/browsers/{browser}/channels/{channel}/{metric_view}",
+		"GET /v1/stats/wpt/browsers/{browser}/channels/{channel}/{metric_view}",
+		"GET /v1/saved-searches/{search_id}/stats/baseline_counts",
+		"GET /v1/saved-searches/{search_id}/stats/browser_counts",
+		"GET /v1/saved-searches/{search_id}/badge.svg",
+	} {
+		maxAges[pattern] = defaultTTL
+	}
+	for _, pattern := range []string{
+		"GET /v1/stats/features/browsers/{browser}/feature_counts",
+		"GET /v1/stats/features/browsers/{browser}/missing_one_implementation_counts",
+		"GET /v1/stats/baseline_status/low_date_feature_counts",
+		"GET /v1/global-saved-searches",
+	} {
+		maxAges[pattern] = aggregatedTTL
+	}
+
+	return maxAges
+}
+
+// newConditionalGetMiddleware returns a middleware that adds validators to the successful responses of the routes
+// in maxAges. It buffers the response in order to compute a strong ETag from the serialized body, replies with
+// 304 Not Modified when the ETag matches the If-None-Match request header and emits a Cache-Control header with
+// the max-age of the route.
+// It relies on the route pattern that matched the request so it must be applied after routing.
+func newConditionalGetMiddleware(maxAges map[string]time.Duration) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			maxAge, found := maxAges[r.Pattern]
+			if !found || (r.Method != http.MethodGet && r.Method != http.MethodHead) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			bw := &bufferedResponseWriter{ResponseWriter: w, statusCode: http.StatusOK, body: bytes.Buffer{}}
+			next.ServeHTTP(bw, r)
+
+			if bw.statusCode != http.StatusOK {
+				w.WriteHeader(bw.statusCode)
+				bw.flushBody(r)
+
+				return
+			}
+
+			etag := computeETag(bw.body.Bytes())
+			w.Header().Set("ETag", etag)
+			w.Header().Set("Cache-Control", cacheControlValue(maxAge))
+
+			if etagMatches(r.Header.Get("If-None-Match"), etag) {
+				w.Header().Del("Content-Type")
+				w.Header().Del("Content-Length")
+				w.WriteHeader(http.StatusNotModified)
+
+				return
+			}
+
+			w.WriteHeader(http.StatusOK)
+			bw.flushBody(r)
+		})
+	}
+}
+
+// bufferedResponseWriter holds on to the status code and body of a response until they are explicitly written.
+// Headers are written directly to the underlying http.ResponseWriter.
+type bufferedResponseWriter struct {
+	http.ResponseWriter
+	statusCode  int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) WriteHeader(statusCode int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.statusCode = statusCode
+}
+
+func (w *bufferedResponseWriter) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+
+	return w.body.Write(b)
+}
+
+// flushBody writes the buffered body to the underlying http.ResponseWriter.
+func (w *bufferedResponseWriter) flushBody(r *http.Request) {
+	if _, err := w.ResponseWriter.Write(w.body.Bytes()); err != nil {
+		slog.WarnContext(r.Context(), "unable to write buffered response", "error", err)
+	}
+}
+
+// computeETag returns a strong entity tag for the response body.
+func computeETag(body []byte) string {
+	sum := sha256.Sum256(body)
+
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// cacheControlValue returns the Cache-Control header value for a response that can be reused for maxAge.
+// Responses without a freshness lifetime can still be stored but must be revalidated before each reuse.
+func cacheControlValue(maxAge time.Duration) string {
+	seconds := int64(maxAge / time.Second)
+	if seconds <= 0 {
+		return "public, no-cache"
+	}
+
+	return fmt.Sprintf("public, max-age=%d", seconds)
+}
+
+// etagMatches reports whether the If-None-Match header value matches the entity tag.
+// As required by RFC 9110 for If-None-Match, it uses the weak comparison function.
+func etagMatches(ifNoneMatch string, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	if strings.TrimSpace(ifNoneMatch) == "*" {
+		return true
+	}
+	for candidate := range strings.SplitSeq(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,203 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/cachetypes"
+)
+
+const testConditionalGetBody = `{"data":[]}`
+
+func newConditionalGetTestHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/features", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(testConditionalGetBody))
+	})
+	mux.HandleFunc("GET /v1/features/{feature_id}", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"code":404,"message":"feature not found"}`))
+	})
+	mux.HandleFunc("GET /v1/users/me/tokens", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(testConditionalGetBody))
+	})
+
+	middleware := newConditionalGetMiddleware(map[string]time.Duration{
+		"GET /v1/features":              time.Hour,
+		"GET /v1/features/{feature_id}": time.Hour,
+	})
+
+	// Mimic the generated router which applies the route middlewares after routing.
+	routed := http.NewServeMux()
+	routed.Handle("/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, pattern := mux.Handler(r)
+		r.Pattern = pattern
+		middleware(mux).ServeHTTP(w, r)
+	}))
+
+	return routed
+}
+
+func TestConditionalGetMiddleware(t *testing.T) {
+	expectedETag := computeETag([]byte(testConditionalGetBody))
+	tests := []struct {
+		name                 string
+		path                 string
+		ifNoneMatch          string
+		expectedStatusCode   int
+		expectedBody         string
+		expectedETag         string
+		expectedCacheControl string
+	}{
+		{
+			name:                 "no validator",
+			path:                 "/v1/features",
+			ifNoneMatch:          "",
+			expectedStatusCode:   http.StatusOK,
+			expectedBody:         testConditionalGetBody,
+			expectedETag:         expectedETag,
+			expectedCacheControl: "public, max-age=3600",
+		},
+		{
+			name:                 "matching validator",
+			path:                 "/v1/features",
+			ifNoneMatch:          expectedETag,
+			expectedStatusCode:   http.StatusNotModified,
+			expectedBody:         "",
+			expectedETag:         expectedETag,
+			expectedCacheControl: "public, max-age=3600",
+		},
+		{
+			name:                 "matching weak validator in list",
+			path:                 "/v1/features",
+			ifNoneMatch:          `"other", W/` + expectedETag,
+			expectedStatusCode:   http.StatusNotModified,
+			expectedBody:         "",
+			expectedETag:         expectedETag,
+			expectedCacheControl: "public, max-age=3600",
+		},
+		{
+			name:                 "wildcard validator",
+			path:                 "/v1/features",
+			ifNoneMatch:          "*",
+			expectedStatusCode:   http.StatusNotModified,
+			expectedBody:         "",
+			expectedETag:         expectedETag,
+			expectedCacheControl: "public, max-age=3600",
+		},
+		{
+			name:                 "stale validator",
+			path:                 "/v1/features",
+			ifNoneMatch:          `"stale"`,
+			expectedStatusCode:   http.StatusOK,
+			expectedBody:         testConditionalGetBody,
+			expectedETag:         expectedETag,
+			expectedCacheControl: "public, max-age=3600",
+		},
+		{
+			name:                 "unsuccessful response",
+			path:                 "/v1/features/foo",
+			ifNoneMatch:          "*",
+			expectedStatusCode:   http.StatusNotFound,
+			expectedBody:         `{"code":404,"message":"feature not found"}`,
+			expectedETag:         "",
+			expectedCacheControl: "",
+		},
+		{
+			name:                 "route not eligible",
+			path:                 "/v1/users/me/tokens",
+			ifNoneMatch:          "*",
+			expectedStatusCode:   http.StatusOK,
+			expectedBody:         testConditionalGetBody,
+			expectedETag:         "",
+			expectedCacheControl: "",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, tc.path, nil)
+			if tc.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tc.ifNoneMatch)
+			}
+			w := httptest.NewRecorder()
+			newConditionalGetTestHandler().ServeHTTP(w, req)
+
+			if w.Code != tc.expectedStatusCode {
+				t.Errorf("expected status code %d, got %d", tc.expectedStatusCode, w.Code)
+			}
+			if w.Body.String() != tc.expectedBody {
+				t.Errorf("expected body %q, got %q", tc.expectedBody, w.Body.String())
+			}
+			if etag := w.Header().Get("ETag"); etag != tc.expectedETag {
+				t.Errorf("expected ETag %q, got %q", tc.expectedETag, etag)
+			}
+			if cacheControl := w.Header().Get("Cache-Control"); cacheControl != tc.expectedCacheControl {
+				t.Errorf("expected Cache-Control %q, got %q", tc.expectedCacheControl, cacheControl)
+			}
+		})
+	}
+}
+
+func TestRouteMaxAges(t *testing.T) {
+	maxAges := routeMaxAges(RouteCacheOptions{
+		DefaultTTL: 5 * time.Minute,
+		AggregatedFeatureStatsOptions: []cachetypes.CacheOption{
+			cachetypes.WithTTL(time.Hour),
+		},
+	})
+
+	tests := []struct {
+		pattern        string
+		expectedMaxAge time.Duration
+		expectedFound  bool
+	}{
+		{pattern: "GET /v1/features", expectedMaxAge: 5 * time.Minute, expectedFound: true},
+		{pattern: "GET /v1/features/{feature_id}", expectedMaxAge: 5 * time.Minute, expectedFound: true},
+		{
+			pattern:        "GET /v1/stats/baseline_status/low_date_feature_counts",
+			expectedMaxAge: time.Hour,
+			expectedFound:  true,
+		},
+		{pattern: "GET /v1/features:export", expectedMaxAge: 0, expectedFound: false},
+		{pattern: "GET /v1/users/me/saved-searches", expectedMaxAge: 0, expectedFound: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.pattern, func(t *testing.T) {
+			maxAge, found := maxAges[tc.pattern]
+			if found != tc.expectedFound {
+				t.Errorf("expected found %t, got %t", tc.expectedFound, found)
+			}
+			if maxAge != tc.expectedMaxAge {
+				t.Errorf("expected max age %s, got %s", tc.expectedMaxAge, maxAge)
+			}
+		})
+	}
+}
+
+func TestCacheControlValue(t *testing.T) {
+	if got := cacheControlValue(90 * time.Second); got != "public, max-age=90" {
+		t.Errorf("unexpected Cache-Control %q", got)
+	}
+	if got := cacheControlValue(0); got != "public, no-cache" {
+		t.Errorf("unexpected Cache-Control %q", got)
+	}
+}
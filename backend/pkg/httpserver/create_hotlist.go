@@ -0,0 +1,96 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func validateCreateHotlistRequest(input *backend.CreateHotlistRequest) *fieldValidationErrors {
+	fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+
+	validateSavedSearchName(&input.Name, fieldErrors)
+	validateSavedSearchDescription(input.Description, fieldErrors)
+	if input.FeatureIds != nil {
+		validateHotlistFeatureIDs(*input.FeatureIds, fieldErrors)
+	}
+
+	if fieldErrors.hasErrors() {
+		return fieldErrors
+	}
+
+	return nil
+}
+
+// CreateHotlist handles the POST request to /v1/users/me/hotlists.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) CreateHotlist(
+	ctx context.Context,
+	request backend.CreateHotlistRequestObject,
+) (backend.CreateHotlistResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "CreateHotlist",
+		func(code int, message string) backend.CreateHotlist500JSONResponse {
+			return backend.CreateHotlist500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	validationErr := validateCreateHotlistRequest(request.Body)
+	if validationErr != nil {
+		return backend.CreateHotlist400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: errMsgInputValidationErrors,
+			Errors:  validationErr.fieldErrorMap,
+		}, nil
+	}
+
+	hotlist, err := s.wptMetricsStorer.CreateHotlist(ctx, userCheckResult.User.ID, *request.Body)
+	if err != nil {
+		if errors.Is(err, backendtypes.ErrUserMaxSavedSearches) {
+			return backend.CreateHotlist403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "user has reached the maximum number of allowed saved searches",
+			}, nil
+		} else if errors.Is(err, backendtypes.ErrInvalidHotlistFeatures) {
+			fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+			fieldErrors.addFieldError("feature_ids", errHotlistUnknownFeatures)
+
+			return backend.CreateHotlist400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: errMsgInputValidationErrors,
+				Errors:  fieldErrors.fieldErrorMap,
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to create hotlist", "error", err)
+
+		return backend.CreateHotlist500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to create hotlist",
+		}, nil
+	}
+
+	return backend.CreateHotlist201JSONResponse(*hotlist), nil
+}
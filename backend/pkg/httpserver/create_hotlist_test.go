@@ -0,0 +1,141 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestCreateHotlist(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	expectedRequest := backend.CreateHotlistRequest{
+		Name:        "Interop priorities",
+		Description: nil,
+		FeatureIds:  &[]string{"grid", "subgrid"},
+	}
+	body := `{"name": "Interop priorities", "feature_ids": ["grid", "subgrid"]}`
+
+	testCases := []struct {
+		name              string
+		cfg               *MockCreateHotlistConfig
+		expectedCallCount int
+		request           *http.Request
+		expectedResponse  *http.Response
+	}{
+		{
+			name: "success",
+			cfg: &MockCreateHotlistConfig{
+				expectedUserID:  "test-user",
+				expectedRequest: expectedRequest,
+				output: &backend.Hotlist{
+					Id:         "hotlist-id",
+					Version:    1,
+					FeatureIds: []string{"grid", "subgrid"},
+				},
+				err: nil,
+			},
+			expectedCallCount: 1,
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodPost, "/v1/users/me/hotlists", strings.NewReader(body)),
+			expectedResponse: testJSONResponse(http.StatusCreated,
+				`{"id":"hotlist-id","version":1,"feature_ids":["grid","subgrid"]}`),
+		},
+		{
+			name:              "bad request - duplicate features",
+			cfg:               nil,
+			expectedCallCount: 0,
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodPost, "/v1/users/me/hotlists",
+				strings.NewReader(`{"name": "Interop priorities", "feature_ids": ["grid", "grid"]}`)),
+			expectedResponse: testJSONResponse(http.StatusBadRequest, `{
+				"code":400,
+				"message":"input validation errors",
+				"errors":{"feature_ids":"`+errHotlistDuplicateFeature.Error()+`"}
+			}`),
+		},
+		{
+			name: "bad request - unknown features",
+			cfg: &MockCreateHotlistConfig{
+				expectedUserID:  "test-user",
+				expectedRequest: expectedRequest,
+				output:          nil,
+				err:             backendtypes.ErrInvalidHotlistFeatures,
+			},
+			expectedCallCount: 1,
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodPost, "/v1/users/me/hotlists", strings.NewReader(body)),
+			expectedResponse: testJSONResponse(http.StatusBadRequest, `{
+				"code":400,
+				"message":"input validation errors",
+				"errors":{"feature_ids":"`+errHotlistUnknownFeatures.Error()+`"}
+			}`),
+		},
+		{
+			name: "forbidden - user max saved searches",
+			cfg: &MockCreateHotlistConfig{
+				expectedUserID:  "test-user",
+				expectedRequest: expectedRequest,
+				output:          nil,
+				err:             backendtypes.ErrUserMaxSavedSearches,
+			},
+			expectedCallCount: 1,
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodPost, "/v1/users/me/hotlists", strings.NewReader(body)),
+			expectedResponse: testJSONResponse(http.StatusForbidden,
+				`{"code":403,"message":"user has reached the maximum number of allowed saved searches"}`),
+		},
+		{
+			name: "internal server error",
+			cfg: &MockCreateHotlistConfig{
+				expectedUserID:  "test-user",
+				expectedRequest: expectedRequest,
+				output:          nil,
+				err:             errors.New("database error"),
+			},
+			expectedCallCount: 1,
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodPost, "/v1/users/me/hotlists", strings.NewReader(body)),
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to create hotlist"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				createHotlistCfg: tc.cfg,
+				t:                t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			assertTestServerRequest(t, myServer, tc.request, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountCreateHotlist,
+				"CreateHotlist", nil)
+		})
+	}
+}
@@ -30,6 +30,7 @@ func TestCreateNotificationChannel(t *testing.T) {
 	testUser := &auth.User{
 		ID:           "testUserID1",
 		GitHubUserID: nil,
+		TokenScope:   nil,
 	}
 
 	testCases := []struct {
@@ -0,0 +1,99 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+const (
+	personalAccessTokenNameMinLength = 1
+	personalAccessTokenNameMaxLength = 64
+)
+
+var (
+	errPersonalAccessTokenInvalidNameLength = fmt.Errorf("name must be between %d and %d characters long",
+		personalAccessTokenNameMinLength, personalAccessTokenNameMaxLength)
+	errPersonalAccessTokenInvalidScope = errors.New("invalid scope")
+)
+
+func validatePersonalAccessToken(input *backend.CreatePersonalAccessTokenRequest) *fieldValidationErrors {
+	fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+
+	if len(input.Name) < personalAccessTokenNameMinLength || len(input.Name) > personalAccessTokenNameMaxLength {
+		fieldErrors.addFieldError("name", errPersonalAccessTokenInvalidNameLength)
+	}
+	if !input.Scope.Valid() {
+		fieldErrors.addFieldError("scope", errPersonalAccessTokenInvalidScope)
+	}
+
+	if fieldErrors.hasErrors() {
+		return fieldErrors
+	}
+
+	return nil
+}
+
+// CreatePersonalAccessToken implements backend.StrictServerInterface.
+// nolint: ireturn // Name generated from openapi
+func (s *Server) CreatePersonalAccessToken(
+	ctx context.Context,
+	request backend.CreatePersonalAccessTokenRequestObject) (
+	backend.CreatePersonalAccessTokenResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "CreatePersonalAccessToken",
+		func(code int, message string) backend.CreatePersonalAccessToken500JSONResponse {
+			return backend.CreatePersonalAccessToken500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	if validationErr := validatePersonalAccessToken(request.Body); validationErr != nil {
+		return backend.CreatePersonalAccessToken400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: errMsgInputValidationErrors,
+			Errors:  validationErr.fieldErrorMap,
+		}, nil
+	}
+
+	output, err := s.wptMetricsStorer.CreatePersonalAccessToken(ctx, userCheckResult.User.ID, *request.Body)
+	if err != nil {
+		if errors.Is(err, backendtypes.ErrUserMaxPersonalAccessTokens) {
+			return backend.CreatePersonalAccessToken429JSONResponse{
+				Code:    http.StatusTooManyRequests,
+				Message: "user has reached the maximum number of allowed personal access tokens (20)",
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to create personal access token", "error", err)
+
+		return backend.CreatePersonalAccessToken500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to create personal access token",
+		}, nil
+	}
+
+	return backend.CreatePersonalAccessToken201JSONResponse(*output), nil
+}
@@ -0,0 +1,168 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestCreatePersonalAccessToken(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	manageSubscriptions := auth.TokenScopeManageSubscriptions
+	tokenUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   &manageSubscriptions,
+	}
+	testCases := []struct {
+		name              string
+		user              *auth.User
+		requestBody       string
+		storerCfg         *MockCreatePersonalAccessTokenConfig
+		expectedCallCount int
+		expectedResponse  *http.Response
+	}{
+		{
+			name:        "success",
+			user:        testUser,
+			requestBody: `{"name": "ci", "scope": "read_only"}`,
+			storerCfg: &MockCreatePersonalAccessTokenConfig{
+				expectedUserID: testUser.ID,
+				expectedRequest: backend.CreatePersonalAccessTokenRequest{
+					Name:  "ci",
+					Scope: backend.PersonalAccessTokenScopeReadOnly,
+				},
+				output: &backend.CreatedPersonalAccessToken{
+					Id:         "token1",
+					Name:       "ci",
+					Scope:      backend.PersonalAccessTokenScopeReadOnly,
+					CreatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					LastUsedAt: nil,
+					Token:      "wsdpat_secret",
+				},
+				err: nil,
+			},
+			expectedCallCount: 1,
+			expectedResponse: testJSONResponse(201, `
+{
+	"id": "token1",
+	"name": "ci",
+	"scope": "read_only",
+	"created_at": "2000-01-01T00:00:00Z",
+	"token": "wsdpat_secret"
+}`),
+		},
+		{
+			name:              "invalid name and scope",
+			user:              testUser,
+			requestBody:       `{"name": "", "scope": "admin"}`,
+			storerCfg:         nil,
+			expectedCallCount: 0,
+			expectedResponse: testJSONResponse(400, `
+{
+	"code": 400,
+	"message": "input validation errors",
+	"errors": {
+		"name": "name must be between 1 and 64 characters long",
+		"scope": "invalid scope"
+	}
+}`),
+		},
+		{
+			name:        "max tokens failure",
+			user:        testUser,
+			requestBody: `{"name": "ci", "scope": "manage_subscriptions"}`,
+			storerCfg: &MockCreatePersonalAccessTokenConfig{
+				expectedUserID: testUser.ID,
+				expectedRequest: backend.CreatePersonalAccessTokenRequest{
+					Name:  "ci",
+					Scope: backend.PersonalAccessTokenScopeManageSubscriptions,
+				},
+				output: nil,
+				err:    backendtypes.ErrUserMaxPersonalAccessTokens,
+			},
+			expectedCallCount: 1,
+			expectedResponse: testJSONResponse(429, `
+{
+	"code": 429,
+	"message": "user has reached the maximum number of allowed personal access tokens (20)"
+}`),
+		},
+		{
+			name:              "personal access tokens cannot create tokens",
+			user:              tokenUser,
+			requestBody:       `{"name": "ci", "scope": "read_only"}`,
+			storerCfg:         nil,
+			expectedCallCount: 0,
+			expectedResponse: testJSONResponse(403, `
+{
+	"code": 403,
+	"message": "personal access tokens cannot be used to manage personal access tokens"
+}`),
+		},
+		{
+			name:        "500 error",
+			user:        testUser,
+			requestBody: `{"name": "ci", "scope": "read_only"}`,
+			storerCfg: &MockCreatePersonalAccessTokenConfig{
+				expectedUserID: testUser.ID,
+				expectedRequest: backend.CreatePersonalAccessTokenRequest{
+					Name:  "ci",
+					Scope: backend.PersonalAccessTokenScopeReadOnly,
+				},
+				output: nil,
+				err:    errTest,
+			},
+			expectedCallCount: 1,
+			expectedResponse: testJSONResponse(500, `
+{
+	"code": 500,
+	"message": "unable to create personal access token"
+}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost,
+				"/v1/users/me/tokens", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				createPersonalAccessTokenCfg: tc.storerCfg,
+				t:                            t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(tc.user)))
+			assertMocksExpectations(t, tc.expectedCallCount,
+				mockStorer.callCountCreatePersonalAccessToken, "CreatePersonalAccessToken", nil)
+		})
+	}
+}
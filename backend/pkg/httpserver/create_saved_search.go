@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"regexp"
 
 	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
 	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
@@ -35,6 +36,11 @@ const (
 	// savedSearchQueryMaxLength allows bookmarking long lists of feature IDs (issue #2604).
 	savedSearchQueryMaxLength = 2048
 	savedSearchQueryMinLength = 1
+	savedSearchMaxTags        = 10
+	savedSearchTagMaxLength   = 32
+	// savedSearchParameterNameMaxLength and savedSearchParameterValueMaxLength bound the declared query parameters.
+	savedSearchParameterNameMaxLength  = 32
+	savedSearchParameterValueMaxLength = 64
 	// maxASTNodes caps query structural complexity after deduplication to protect Cloud Spanner parameter limits.
 	maxASTNodes = backendtypes.MaxASTNodes
 )
@@ -47,6 +53,15 @@ var (
 	errSavedSearchInvalidDescriptionLength = fmt.Errorf("description must be between %d and %d characters long",
 		savedSearchNameDescriptionMinLength, savedSearchNameDescriptionMaxLength)
 	errQueryDoesNotMatchGrammar = errors.New("query does not match grammar")
+	errSavedSearchTooManyTags   = fmt.Errorf("at most %d tags are allowed", savedSearchMaxTags)
+	errSavedSearchInvalidTag    = fmt.Errorf(
+		"tags must be unique, at most %d characters long and only contain lowercase letters, digits and dashes",
+		savedSearchTagMaxLength)
+	errSavedSearchInvalidParameters = fmt.Errorf(
+		"at most %d parameters are allowed, with unique names and single word default values",
+		backendtypes.MaxSavedSearchQueryParameters)
+
+	savedSearchTagRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
 )
 
 // validateSavedSearchName checks the validity of the saved search name.
@@ -65,7 +80,9 @@ func validateSavedSearchName(name *string, fieldErrors *fieldValidationErrors) {
 
 // validateSavedSearchQuery checks the validity of the saved search query.
 // It expects a pointer to handle potential nil values during updates.
-func validateSavedSearchQuery(query *string, fieldErrors *fieldValidationErrors) {
+// The variables of the query must be declared in params and are checked with their default values.
+func validateSavedSearchQuery(
+	query *string, params *backend.SavedSearchQueryParameters, fieldErrors *fieldValidationErrors) {
 	if query == nil {
 		fieldErrors.addFieldError("query", errSavedSearchInvalidQueryLength)
 
@@ -79,16 +96,24 @@ func validateSavedSearchQuery(query *string, fieldErrors *fieldValidationErrors)
 		return
 	}
 
-	// 2. Upstream ANTLR grammar parse check
+	// 2. Bind the query variables to the default values of the parameters
+	boundQuery, err := searchtypes.BindQueryVariables(*query, backendtypes.SearchQueryParameters(params), nil)
+	if err != nil {
+		fieldErrors.addFieldError("query", err)
+
+		return
+	}
+
+	// 3. Upstream ANTLR grammar parse check
 	parser := searchtypes.FeaturesSearchQueryParser{}
-	node, err := parser.Parse(*query)
+	node, err := parser.Parse(boundQuery)
 	if err != nil {
 		fieldErrors.addFieldError("query", errQueryDoesNotMatchGrammar)
 
 		return
 	}
 
-	// 3. Upstream AST deduplication and structural complexity validation
+	// 4. Upstream AST deduplication and structural complexity validation
 	dedupNode := searchtypes.Deduplicate(node)
 	if searchtypes.CountNodes(dedupNode) > maxASTNodes {
 		fieldErrors.addFieldError("query", backendtypes.ErrQueryComplexityExceeded)
@@ -111,6 +136,54 @@ func validateSavedSearchDescription(description *string, fieldErrors *fieldValid
 	}
 }
 
+// validateSavedSearchTags checks the validity of the saved search tags.
+// Tags are optional, so nil is allowed.
+func validateSavedSearchTags(tags *backend.SavedSearchTags, fieldErrors *fieldValidationErrors) {
+	if tags == nil {
+		return
+	}
+	if len(*tags) > savedSearchMaxTags {
+		fieldErrors.addFieldError("tags", errSavedSearchTooManyTags)
+
+		return
+	}
+	seen := make(map[string]bool, len(*tags))
+	for _, tag := range *tags {
+		if seen[tag] || len(tag) > savedSearchTagMaxLength || !savedSearchTagRegex.MatchString(tag) {
+			fieldErrors.addFieldError("tags", errSavedSearchInvalidTag)
+
+			return
+		}
+		seen[tag] = true
+	}
+}
+
+// validateSavedSearchParameters checks the validity of the parameters declared by the saved search query.
+// Parameters are optional, so nil is allowed.
+func validateSavedSearchParameters(params *backend.SavedSearchQueryParameters, fieldErrors *fieldValidationErrors) {
+	if params == nil {
+		return
+	}
+	if len(*params) > backendtypes.MaxSavedSearchQueryParameters {
+		fieldErrors.addFieldError("parameters", errSavedSearchInvalidParameters)
+
+		return
+	}
+	seen := make(map[string]bool, len(*params))
+	for _, param := range *params {
+		if seen[param.Name] ||
+			len(param.Name) > savedSearchParameterNameMaxLength ||
+			len(param.DefaultValue) > savedSearchParameterValueMaxLength ||
+			!searchtypes.IsValidQueryParameterName(param.Name) ||
+			!searchtypes.IsValidQueryParameterValue(param.DefaultValue) {
+			fieldErrors.addFieldError("parameters", errSavedSearchInvalidParameters)
+
+			return
+		}
+		seen[param.Name] = true
+	}
+}
+
 func validateSavedSearch(input *backend.SavedSearch) *fieldValidationErrors {
 	fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
 
@@ -118,11 +191,15 @@ func validateSavedSearch(input *backend.SavedSearch) *fieldValidationErrors {
 	validateSavedSearchName(&input.Name, fieldErrors)
 
 	// Validate Query (using address of the string field)
-	validateSavedSearchQuery(&input.Query, fieldErrors)
+	validateSavedSearchQuery(&input.Query, input.Parameters, fieldErrors)
 
 	// Validate Description (already a pointer)
 	validateSavedSearchDescription(input.Description, fieldErrors)
 
+	validateSavedSearchTags(input.Tags, fieldErrors)
+
+	validateSavedSearchParameters(input.Parameters, fieldErrors)
+
 	if fieldErrors.hasErrors() {
 		return fieldErrors
 	}
@@ -150,6 +227,10 @@ func sanitizeValidationError(err error) error {
 		return backendtypes.ErrQueryComplexityExceeded
 	case errors.Is(err, errQueryDoesNotMatchGrammar):
 		return errQueryDoesNotMatchGrammar
+	case errors.Is(err, searchtypes.ErrUnboundQueryVariable):
+		return searchtypes.ErrUnboundQueryVariable
+	case errors.Is(err, searchtypes.ErrInvalidQueryParameter):
+		return searchtypes.ErrInvalidQueryParameter
 	default:
 		return nil
 	}
@@ -181,7 +262,7 @@ func (s *Server) CreateSavedSearch(ctx context.Context, request backend.CreateSa
 		}, nil
 	}
 
-	err := s.wptMetricsStorer.ValidateQueryReferences(ctx, request.Body.Query, nil)
+	err := s.wptMetricsStorer.ValidateQueryReferences(ctx, request.Body.Query, request.Body.Parameters, nil)
 	if err != nil {
 		if safeErr := sanitizeValidationError(err); safeErr != nil {
 			// nolint:nilerr // WONTFIX - false positive when returning structured 400 response instead of Go error.
@@ -41,6 +41,7 @@ func TestCreateSavedSearch(t *testing.T) {
 	testUser := &auth.User{
 		ID:           "testID1",
 		GitHubUserID: nil,
+		TokenScope:   nil,
 	}
 	testCases := []struct {
 		name                            string
@@ -192,6 +193,9 @@ func TestCreateSavedSearch(t *testing.T) {
 					Name:        "test name",
 					Query:       `name:"test"`,
 					Description: new(createStringOfNLength(1024)),
+					Tags:        nil,
+					Listed:      nil,
+					Parameters:  nil,
 				},
 				expectedUserID: "testID1",
 				output: &backend.SavedSearchResponse{
@@ -203,6 +207,10 @@ func TestCreateSavedSearch(t *testing.T) {
 					BookmarkStatus: nil,
 					CreatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
 					UpdatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					ForkedFrom:     nil,
+					Tags:           nil,
+					Listed:         nil,
+					Parameters:     nil,
 				},
 				err: nil,
 			},
@@ -216,6 +224,10 @@ func TestCreateSavedSearch(t *testing.T) {
 					BookmarkStatus: nil,
 					CreatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
 					UpdatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					ForkedFrom:     nil,
+					Tags:           nil,
+					Listed:         nil,
+					Parameters:     nil,
 				},
 				expectedUserID:     "testID1",
 				expectedIsCreation: true,
@@ -254,6 +266,9 @@ func TestCreateSavedSearch(t *testing.T) {
 						Name:        "test name",
 						Query:       q,
 						Description: nil,
+						Tags:        nil,
+						Listed:      nil,
+						Parameters:  nil,
 					},
 					expectedUserID: "testID1",
 					output: &backend.SavedSearchResponse{
@@ -265,6 +280,10 @@ func TestCreateSavedSearch(t *testing.T) {
 						BookmarkStatus: nil,
 						CreatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
 						UpdatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						ForkedFrom:     nil,
+						Tags:           nil,
+						Listed:         nil,
+						Parameters:     nil,
 					},
 					err: nil,
 				}
@@ -286,6 +305,10 @@ func TestCreateSavedSearch(t *testing.T) {
 						BookmarkStatus: nil,
 						CreatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
 						UpdatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						ForkedFrom:     nil,
+						Tags:           nil,
+						Listed:         nil,
+						Parameters:     nil,
 					},
 					expectedUserID:     "testID1",
 					expectedIsCreation: true,
@@ -324,6 +347,46 @@ func TestCreateSavedSearch(t *testing.T) {
 				}(),
 			),
 		},
+		{
+			name:                            "query variable not declared in parameters",
+			mockCreateUserSavedSearchConfig: nil,
+			mockPublishConfig:               nil,
+			authMiddlewareOption:            withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodPost,
+				"/v1/saved-searches",
+				strings.NewReader(`{"query": "baseline_status:$status", "name" : "test name"}`),
+			),
+			expectedResponse: testJSONResponse(400,
+				`{
+					"code":400,
+					"errors":{
+						"query":"query variable is not bound: $status"
+					},
+					"message":"input validation errors"
+				}`),
+		},
+		{
+			name:                            "invalid parameters",
+			mockCreateUserSavedSearchConfig: nil,
+			mockPublishConfig:               nil,
+			authMiddlewareOption:            withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodPost,
+				"/v1/saved-searches",
+				strings.NewReader(`{"query": "baseline_status:$status", "name" : "test name",
+					"parameters": [{"name": "status", "default_value": "newly OR name:grid"}]}`),
+			),
+			expectedResponse: testJSONResponse(400,
+				`{
+					"code":400,
+					"errors":{
+						"parameters":"at most 10 parameters are allowed, with unique names and single word default values",
+						"query":"invalid query parameter: value of $status"
+					},
+					"message":"input validation errors"
+				}`),
+		},
 		{
 			name:                            "query has bad syntax",
 			mockCreateUserSavedSearchConfig: nil,
@@ -384,6 +447,9 @@ func TestCreateSavedSearch(t *testing.T) {
 						Name:        "test name",
 						Query:       q,
 						Description: nil,
+						Tags:        nil,
+						Listed:      nil,
+						Parameters:  nil,
 					},
 					expectedUserID: "testID1",
 					output: &backend.SavedSearchResponse{
@@ -395,6 +461,10 @@ func TestCreateSavedSearch(t *testing.T) {
 						BookmarkStatus: nil,
 						CreatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
 						UpdatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						ForkedFrom:     nil,
+						Tags:           nil,
+						Listed:         nil,
+						Parameters:     nil,
 					},
 					err: nil,
 				}
@@ -416,6 +486,10 @@ func TestCreateSavedSearch(t *testing.T) {
 						BookmarkStatus: nil,
 						CreatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
 						UpdatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						ForkedFrom:     nil,
+						Tags:           nil,
+						Listed:         nil,
+						Parameters:     nil,
 					},
 					expectedUserID:     "testID1",
 					expectedIsCreation: true,
@@ -482,6 +556,9 @@ func TestCreateSavedSearch(t *testing.T) {
 					Name:        "test name",
 					Query:       `name:"test"`,
 					Description: nil,
+					Tags:        nil,
+					Listed:      nil,
+					Parameters:  nil,
 				},
 				expectedUserID: "testID1",
 				output:         nil,
@@ -508,6 +585,9 @@ func TestCreateSavedSearch(t *testing.T) {
 					Name:        "test name",
 					Query:       `name:"test"`,
 					Description: nil,
+					Tags:        nil,
+					Listed:      nil,
+					Parameters:  nil,
 				},
 				expectedUserID: "testID1",
 				output:         nil,
@@ -534,6 +614,9 @@ func TestCreateSavedSearch(t *testing.T) {
 					Name:        "test name",
 					Query:       `name:"test"`,
 					Description: nil,
+					Tags:        nil,
+					Listed:      nil,
+					Parameters:  nil,
 				},
 				expectedUserID: "testID1",
 				output: &backend.SavedSearchResponse{
@@ -547,8 +630,12 @@ func TestCreateSavedSearch(t *testing.T) {
 					BookmarkStatus: &backend.UserSavedSearchBookmark{
 						Status: backend.BookmarkActive,
 					},
-					CreatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					CreatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					ForkedFrom: nil,
+					Tags:       nil,
+					Listed:     nil,
+					Parameters: nil,
 				},
 				err: nil,
 			},
@@ -564,8 +651,12 @@ func TestCreateSavedSearch(t *testing.T) {
 					BookmarkStatus: &backend.UserSavedSearchBookmark{
 						Status: backend.BookmarkActive,
 					},
-					CreatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					CreatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					ForkedFrom: nil,
+					Tags:       nil,
+					Listed:     nil,
+					Parameters: nil,
 				},
 				expectedUserID:     "testID1",
 				expectedIsCreation: true,
@@ -596,6 +687,9 @@ func TestCreateSavedSearch(t *testing.T) {
 					Name:        "test name",
 					Query:       `name:"test"`,
 					Description: new("test description"),
+					Tags:        nil,
+					Listed:      nil,
+					Parameters:  nil,
 				},
 				expectedUserID: "testID1",
 				output: &backend.SavedSearchResponse{
@@ -609,8 +703,12 @@ func TestCreateSavedSearch(t *testing.T) {
 					BookmarkStatus: &backend.UserSavedSearchBookmark{
 						Status: backend.BookmarkActive,
 					},
-					CreatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					CreatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					ForkedFrom: nil,
+					Tags:       nil,
+					Listed:     nil,
+					Parameters: nil,
 				},
 				err: nil,
 			},
@@ -626,8 +724,12 @@ func TestCreateSavedSearch(t *testing.T) {
 					BookmarkStatus: &backend.UserSavedSearchBookmark{
 						Status: backend.BookmarkActive,
 					},
-					CreatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					CreatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					ForkedFrom: nil,
+					Tags:       nil,
+					Listed:     nil,
+					Parameters: nil,
 				},
 				expectedUserID:     "testID1",
 				expectedIsCreation: true,
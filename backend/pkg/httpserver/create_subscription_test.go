@@ -34,6 +34,7 @@ func TestCreateSubscription(t *testing.T) {
 	testUser := &auth.User{
 		ID:           "test-user",
 		GitHubUserID: nil,
+		TokenScope:   nil,
 	}
 
 	testCases := []struct {
@@ -0,0 +1,63 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"net/http"
+	"path"
+	"strings"
+)
+
+// customMethodMux routes custom methods on a resource, such as POST /v1/saved-searches/{search_id}:fork, with
+// http.ServeMux. ServeMux only accepts wildcards that span a whole path segment, so those routes are registered
+// with the method in a segment of its own and the request paths are rewritten to match.
+type customMethodMux struct {
+	*http.ServeMux
+	// methods are the custom methods that follow a wildcard in a registered route.
+	methods map[string]struct{}
+}
+
+func newCustomMethodMux() *customMethodMux {
+	return &customMethodMux{ServeMux: http.NewServeMux(), methods: make(map[string]struct{})}
+}
+
+// HandleFunc implements backend.ServeMux.
+func (m *customMethodMux) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	dir, segment := path.Split(pattern)
+	wildcard, method, found := strings.Cut(segment, "}:")
+	if !found || !strings.HasPrefix(wildcard, "{") {
+		m.ServeMux.HandleFunc(pattern, handler)
+
+		return
+	}
+	m.methods[method] = struct{}{}
+	m.ServeMux.HandleFunc(dir+wildcard+"}/:"+method, func(w http.ResponseWriter, r *http.Request) {
+		// The route middlewares look up the pattern of the specification.
+		r.Pattern = pattern
+		handler(w, r)
+	})
+}
+
+// ServeHTTP implements http.Handler.
+func (m *customMethodMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	dir, segment := path.Split(r.URL.Path)
+	resource, method, found := strings.Cut(segment, ":")
+	if _, registered := m.methods[method]; found && resource != "" && registered {
+		r = r.Clone(r.Context())
+		r.URL.Path = dir + resource + "/:" + method
+		r.URL.RawPath = ""
+	}
+	m.ServeMux.ServeHTTP(w, r)
+}
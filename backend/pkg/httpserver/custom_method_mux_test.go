@@ -0,0 +1,91 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCustomMethodMux(t *testing.T) {
+	mux := newCustomMethodMux()
+	mux.HandleFunc("POST /v1/items/{item_id}:fork", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("fork " + r.PathValue("item_id") + " " + r.Pattern))
+	})
+	mux.HandleFunc("POST /v1/items:export", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("export " + r.Pattern))
+	})
+	mux.HandleFunc("GET /v1/items/{item_id}", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("get " + r.PathValue("item_id")))
+	})
+
+	testCases := []struct {
+		name           string
+		method         string
+		path           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "custom method on a resource",
+			method:         http.MethodPost,
+			path:           "/v1/items/abc:fork",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "fork abc POST /v1/items/{item_id}:fork",
+		},
+		{
+			name:           "custom method on a collection",
+			method:         http.MethodPost,
+			path:           "/v1/items:export",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "export POST /v1/items:export",
+		},
+		{
+			name:           "regular route",
+			method:         http.MethodGet,
+			path:           "/v1/items/abc",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "get abc",
+		},
+		{
+			name:           "unknown custom method is left to the regular routes",
+			method:         http.MethodGet,
+			path:           "/v1/items/abc:copy",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "get abc:copy",
+		},
+		{
+			name:           "method segment is not reachable directly",
+			method:         http.MethodPost,
+			path:           "/v1/items/abc/fork",
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   "404 page not found\n",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequestWithContext(t.Context(), tc.method, tc.path, nil)
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, received %d", tc.expectedStatus, rec.Code)
+			}
+			if rec.Body.String() != tc.expectedBody {
+				t.Errorf("expected body %q, received %q", tc.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -27,6 +27,7 @@ func TestDeleteNotificationChannel(t *testing.T) {
 	testUser := &auth.User{
 		ID:           "listUserID1",
 		GitHubUserID: nil,
+		TokenScope:   nil,
 	}
 	testCases := []struct {
 		name                 string
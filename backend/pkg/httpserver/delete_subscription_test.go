@@ -27,6 +27,7 @@ func TestDeleteSubscription(t *testing.T) {
 	testUser := &auth.User{
 		ID:           "test-user",
 		GitHubUserID: nil,
+		TokenScope:   nil,
 	}
 
 	testCases := []struct {
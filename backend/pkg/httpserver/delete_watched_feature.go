@@ -0,0 +1,63 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) DeleteWatchedFeature(
+	ctx context.Context,
+	request backend.DeleteWatchedFeatureRequestObject,
+) (backend.DeleteWatchedFeatureResponseObject, error) {
+	userCheck := CheckAuthenticatedUser[backend.DeleteWatchedFeatureResponseObject](ctx, "DeleteWatchedFeature",
+		func(code int, message string) backend.DeleteWatchedFeatureResponseObject {
+			return backend.DeleteWatchedFeature500JSONResponse(backend.BasicErrorModel{Code: code, Message: message})
+		})
+	if userCheck.User == nil {
+		return userCheck.Response, nil
+	}
+
+	err := s.wptMetricsStorer.DeleteWatchedFeature(
+		ctx, userCheck.User.ID, request.FeatureId, request.Params.ChannelId)
+	if err != nil {
+		if errors.Is(err, backendtypes.ErrEntityDoesNotExist) {
+			return backend.DeleteWatchedFeature404JSONResponse(
+				backend.BasicErrorModel{
+					Code:    http.StatusNotFound,
+					Message: "watched feature not found",
+				},
+			), nil
+		}
+
+		slog.ErrorContext(ctx, "unable to unwatch feature", "err", err, "feature_id", request.FeatureId)
+
+		return backend.DeleteWatchedFeature500JSONResponse(
+			backend.BasicErrorModel{
+				Code:    http.StatusInternalServerError,
+				Message: "could not unwatch feature",
+			},
+		), nil
+	}
+
+	return backend.DeleteWatchedFeature204Response{}, nil
+}
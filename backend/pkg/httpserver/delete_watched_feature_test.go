@@ -0,0 +1,129 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+)
+
+func TestDeleteWatchedFeature(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+
+	testCases := []struct {
+		name                 string
+		cfg                  *MockDeleteWatchedFeatureConfig
+		expectedCallCount    int
+		authMiddlewareOption testServerOption
+		request              *http.Request
+		expectedResponse     *http.Response
+	}{
+		{
+			name: "success - all channels",
+			cfg: &MockDeleteWatchedFeatureConfig{
+				expectedUserID:    "test-user",
+				expectedFeatureID: "grid",
+				expectedChannelID: nil,
+				err:               nil,
+			},
+			expectedCallCount:    1,
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodDelete,
+				"/v1/users/me/watched-features/grid",
+				nil,
+			),
+			expectedResponse: createEmptyBodyResponse(http.StatusNoContent),
+		},
+		{
+			name: "success - single channel",
+			cfg: &MockDeleteWatchedFeatureConfig{
+				expectedUserID:    "test-user",
+				expectedFeatureID: "grid",
+				expectedChannelID: new("channel-id"),
+				err:               nil,
+			},
+			expectedCallCount:    1,
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodDelete,
+				"/v1/users/me/watched-features/grid?channel_id=channel-id",
+				nil,
+			),
+			expectedResponse: createEmptyBodyResponse(http.StatusNoContent),
+		},
+		{
+			name: "not found",
+			cfg: &MockDeleteWatchedFeatureConfig{
+				expectedUserID:    "test-user",
+				expectedFeatureID: "grid",
+				expectedChannelID: nil,
+				err:               backendtypes.ErrEntityDoesNotExist,
+			},
+			expectedCallCount:    1,
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodDelete,
+				"/v1/users/me/watched-features/grid",
+				nil,
+			),
+			expectedResponse: testJSONResponse(http.StatusNotFound,
+				`{"code":404,"message":"watched feature not found"}`),
+		},
+		{
+			name: "internal error",
+			cfg: &MockDeleteWatchedFeatureConfig{
+				expectedUserID:    "test-user",
+				expectedFeatureID: "grid",
+				expectedChannelID: nil,
+				err:               errTest,
+			},
+			expectedCallCount:    1,
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodDelete,
+				"/v1/users/me/watched-features/grid",
+				nil,
+			),
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"could not unwatch feature"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				deleteWatchedFeatureCfg: tc.cfg,
+				t:                       t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			assertTestServerRequest(t, myServer, tc.request, tc.expectedResponse, tc.authMiddlewareOption)
+			assertMocksExpectations(t,
+				tc.expectedCallCount,
+				mockStorer.callCountDeleteWatchedFeature,
+				"DeleteWatchedFeature",
+				nil)
+		})
+	}
+}
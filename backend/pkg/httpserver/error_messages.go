@@ -19,5 +19,7 @@ const (
 	errMsgInternalServerError   = "internal server error"
 	errMsgInvalidPageToken      = "invalid page token"
 	errMsgSavedSearchNotFound   = "saved search not found"
+	errMsgSavedSearchReferenced = "saved search is referenced by other saved searches, use force to remove it anyway"
+	errMsgSnapshotNotFound      = "snapshot not found"
 	errMsgSubscriptionNotFound  = "subscription not found"
 )
@@ -0,0 +1,324 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// exportFeaturesPageSize is the number of features fetched per page while exporting.
+const exportFeaturesPageSize = 100
+
+// exportColumn is a flattened column of an exported feature.
+// value returns nil when the feature has no data for the column.
+type exportColumn struct {
+	name  string
+	value func(backend.Feature) any
+}
+
+// ExportFeatures implements backend.StrictServerInterface.
+// nolint:ireturn // Expected ireturn for openapi generation.
+func (s *Server) ExportFeatures(
+	ctx context.Context,
+	req backend.ExportFeaturesRequestObject,
+) (backend.ExportFeaturesResponseObject, error) {
+	if !req.Params.Format.Valid() {
+		return backend.ExportFeatures400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: "format must be csv or ndjson",
+		}, nil
+	}
+
+	var sortOrder *backend.ListFeaturesParamsSort
+	if req.Params.Sort != nil {
+		sort := backend.ListFeaturesParamsSort(*req.Params.Sort)
+		if !sort.Valid() {
+			return backend.ExportFeatures400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: "invalid sort order",
+			}, nil
+		}
+		sortOrder = &sort
+	}
+
+	node, errModel := parseFeaturesSearchQuery(ctx, req.Params.Q)
+	if errModel != nil {
+		return backend.ExportFeatures400JSONResponse(*errModel), nil
+	}
+	queryParams, err := parseQueryParams(req.Params.Params)
+	if err != nil {
+		slog.WarnContext(ctx, "unable to parse query params", "params", req.Params.Params, "error", err)
+
+		return backend.ExportFeatures400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		}, nil
+	}
+
+	browsers := backendtypes.DefaultBrowsers()
+	wptMetricView := getWPTMetricViewOrDefault(req.Params.WptMetricView)
+	fetchPage := func(pageToken *string) (*backend.FeaturePage, error) {
+		return s.wptMetricsStorer.FeaturesSearch(
+			ctx, pageToken, exportFeaturesPageSize, node, sortOrder, wptMetricView, browsers, queryParams)
+	}
+
+	// Fetch the first page before streaming so that invalid queries still get a proper status code.
+	firstPage, err := fetchPage(nil)
+	if err != nil {
+		if isInvalidSavedSearchQueryError(err) {
+			slog.WarnContext(ctx, "invalid saved search query", "error", err)
+
+			return backend.ExportFeatures400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: invalidSavedSearchQueryMessage(err),
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to export features", "error", err)
+
+		return backend.ExportFeatures500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to export features",
+		}, nil
+	}
+
+	columns := exportFeatureColumns(browsers)
+	pr, pw := io.Pipe()
+	go func() {
+		err := writeFeaturesExport(pw, req.Params.Format, columns, firstPage, fetchPage)
+		if err != nil && !errors.Is(err, io.ErrClosedPipe) {
+			slog.ErrorContext(ctx, "unable to stream exported features", "error", err)
+		}
+		_ = pw.CloseWithError(err)
+	}()
+
+	if req.Params.Format == backend.ExportFormatNDJSON {
+		return backend.ExportFeatures200ApplicationxNdjsonResponse{Body: pr, ContentLength: 0}, nil
+	}
+
+	return backend.ExportFeatures200TextcsvResponse{Body: pr, ContentLength: 0}, nil
+}
+
+// writeFeaturesExport writes the features of every page, following the page tokens until the last page.
+func writeFeaturesExport(
+	w io.Writer,
+	format backend.ExportFeaturesParamsFormat,
+	columns []exportColumn,
+	page *backend.FeaturePage,
+	fetchPage func(pageToken *string) (*backend.FeaturePage, error),
+) error {
+	var writeFeature func(backend.Feature) error
+	var flush func() error
+	switch format {
+	case backend.ExportFormatCSV:
+		csvWriter := csv.NewWriter(w)
+		header := make([]string, 0, len(columns))
+		for _, column := range columns {
+			header = append(header, column.name)
+		}
+		if err := csvWriter.Write(header); err != nil {
+			return err
+		}
+		writeFeature = func(feature backend.Feature) error {
+			return csvWriter.Write(exportCSVRecord(columns, feature))
+		}
+		flush = func() error {
+			csvWriter.Flush()
+
+			return csvWriter.Error()
+		}
+	case backend.ExportFormatNDJSON:
+		encoder := json.NewEncoder(w)
+		writeFeature = func(feature backend.Feature) error {
+			return encoder.Encode(exportJSONRecord(columns, feature))
+		}
+		flush = func() error { return nil }
+	}
+
+	for {
+		for _, feature := range page.Data {
+			if err := writeFeature(feature); err != nil {
+				return err
+			}
+		}
+		// Flush after every page so that the client receives the rows while the next page is fetched.
+		if err := flush(); err != nil {
+			return err
+		}
+		if page.Metadata.NextPageToken == nil {
+			return nil
+		}
+
+		var err error
+		page, err = fetchPage(page.Metadata.NextPageToken)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func exportCSVRecord(columns []exportColumn, feature backend.Feature) []string {
+	record := make([]string, 0, len(columns))
+	for _, column := range columns {
+		var cell string
+		switch v := column.value(feature).(type) {
+		case string:
+			cell = v
+		case float64:
+			cell = strconv.FormatFloat(v, 'f', -1, 64)
+		case int64:
+			cell = strconv.FormatInt(v, 10)
+		}
+		record = append(record, cell)
+	}
+
+	return record
+}
+
+func exportJSONRecord(columns []exportColumn, feature backend.Feature) map[string]any {
+	record := make(map[string]any, len(columns))
+	for _, column := range columns {
+		record[column.name] = column.value(feature)
+	}
+
+	return record
+}
+
+// exportFeatureColumns returns the flattened columns of an exported feature, in order.
+func exportFeatureColumns(browsers []backend.BrowserPathParam) []exportColumn {
+	columns := []exportColumn{
+		{name: "feature_id", value: func(f backend.Feature) any { return f.FeatureId }},
+		{name: "name", value: func(f backend.Feature) any { return f.Name }},
+		{name: "baseline_status", value: func(f backend.Feature) any {
+			if f.Baseline == nil || f.Baseline.Status == nil {
+				return nil
+			}
+
+			return string(*f.Baseline.Status)
+		}},
+		{name: "baseline_low_date", value: func(f backend.Feature) any {
+			if f.Baseline == nil || f.Baseline.LowDate == nil {
+				return nil
+			}
+
+			return f.Baseline.LowDate.String()
+		}},
+		{name: "baseline_high_date", value: func(f backend.Feature) any {
+			if f.Baseline == nil || f.Baseline.HighDate == nil {
+				return nil
+			}
+
+			return f.Baseline.HighDate.String()
+		}},
+	}
+
+	for _, browser := range browsers {
+		columns = append(columns, exportBrowserColumns(string(browser))...)
+	}
+
+	return append(columns,
+		exportColumn{name: "chrome_usage_daily", value: func(f backend.Feature) any {
+			if f.Usage == nil || f.Usage.Chrome == nil || f.Usage.Chrome.Daily == nil {
+				return nil
+			}
+
+			return *f.Usage.Chrome.Daily
+		}},
+		exportColumn{name: "developer_signal_upvotes", value: func(f backend.Feature) any {
+			if f.DeveloperSignals == nil || f.DeveloperSignals.Upvotes == nil {
+				return nil
+			}
+
+			return *f.DeveloperSignals.Upvotes
+		}},
+	)
+}
+
+// exportBrowserColumns returns the implementation and WPT columns of a browser.
+func exportBrowserColumns(browser string) []exportColumn {
+	implementation := func(f backend.Feature) *backend.BrowserImplementation {
+		if f.BrowserImplementations == nil {
+			return nil
+		}
+		impl, found := (*f.BrowserImplementations)[browser]
+		if !found {
+			return nil
+		}
+
+		return &impl
+	}
+	wptScore := func(snapshots *map[string]backend.WPTFeatureData) any {
+		if snapshots == nil {
+			return nil
+		}
+		data, found := (*snapshots)[browser]
+		if !found || data.Score == nil {
+			return nil
+		}
+
+		return *data.Score
+	}
+
+	return []exportColumn{
+		{name: browser + "_implementation_status", value: func(f backend.Feature) any {
+			impl := implementation(f)
+			if impl == nil || impl.Status == nil {
+				return nil
+			}
+
+			return string(*impl.Status)
+		}},
+		{name: browser + "_implementation_date", value: func(f backend.Feature) any {
+			impl := implementation(f)
+			if impl == nil || impl.Date == nil {
+				return nil
+			}
+
+			return impl.Date.String()
+		}},
+		{name: browser + "_implementation_version", value: func(f backend.Feature) any {
+			impl := implementation(f)
+			if impl == nil || impl.Version == nil {
+				return nil
+			}
+
+			return *impl.Version
+		}},
+		{name: browser + "_wpt_stable_score", value: func(f backend.Feature) any {
+			if f.Wpt == nil {
+				return nil
+			}
+
+			return wptScore(f.Wpt.Stable)
+		}},
+		{name: browser + "_wpt_experimental_score", value: func(f backend.Feature) any {
+			if f.Wpt == nil {
+				return nil
+			}
+
+			return wptScore(f.Wpt.Experimental)
+		}},
+	}
+}
@@ -0,0 +1,353 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// pagedFeaturesSearchStorer returns a different page of features for each page token.
+type pagedFeaturesSearchStorer struct {
+	*MockWPTMetricsStorer
+	// pages is keyed by the page token. The first page uses the empty key.
+	pages      map[string]*backend.FeaturePage
+	sortOrders []*backend.ListFeaturesParamsSort
+}
+
+func (s *pagedFeaturesSearchStorer) FeaturesSearch(
+	_ context.Context,
+	pageToken *string,
+	pageSize int,
+	_ *searchtypes.SearchNode,
+	sortBy *backend.ListFeaturesParamsSort,
+	_ backend.WPTMetricView,
+	_ []backend.BrowserPathParam,
+	_ map[string]string,
+) (*backend.FeaturePage, error) {
+	if pageSize != exportFeaturesPageSize {
+		s.t.Errorf("unexpected page size %d", pageSize)
+	}
+	s.sortOrders = append(s.sortOrders, sortBy)
+	key := ""
+	if pageToken != nil {
+		key = *pageToken
+	}
+	page, found := s.pages[key]
+	if !found {
+		return nil, errors.New("unexpected page token")
+	}
+
+	return page, nil
+}
+
+func exportTestFeature() backend.Feature {
+	return backend.Feature{
+		FeatureId: "feature1",
+		Name:      "feature 1",
+		Baseline: &backend.BaselineInfo{
+			Status: new(backend.Widely),
+			LowDate: new(
+				openapi_types.Date{Time: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)},
+			),
+			HighDate: nil,
+		},
+		BrowserImplementations: &map[string]backend.BrowserImplementation{
+			"chrome": {
+				Status: new(backend.Available),
+				Date: new(
+					openapi_types.Date{Time: time.Date(2020, time.March, 2, 0, 0, 0, 0, time.UTC)},
+				),
+				Version: new("80"),
+			},
+		},
+		Wpt: &backend.FeatureWPTSnapshots{
+			Stable: &map[string]backend.WPTFeatureData{
+				"chrome": {Score: new(0.5), Metadata: nil},
+			},
+			Experimental: nil,
+		},
+		Usage: &backend.BrowserUsage{
+			Chrome: &backend.ChromeUsageInfo{Daily: new(0.1)},
+		},
+		DeveloperSignals: &backend.FeatureDeveloperSignals{
+			Upvotes: new(int64(12)),
+			Link:    nil,
+		},
+		Discouraged:                nil,
+		Spec:                       nil,
+		VendorPositions:            nil,
+		SystemManagedSavedSearchId: nil,
+	}
+}
+
+func exportTestEmptyFeature() backend.Feature {
+	// nolint:exhaustruct // WONTFIX - only for test purposes
+	return backend.Feature{
+		FeatureId: "feature2",
+		Name:      "feature 2",
+	}
+}
+
+func serveExportFeatures(t *testing.T, storer WPTMetricsStorer, target string) *http.Response {
+	t.Helper()
+	myServer := setupTestServer(t, withCustomStorer(storer))
+	srv := createOpenAPIServerServer("", myServer, nil, noopMiddleware, nil, nil)
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, target, nil)
+	w := httptest.NewRecorder()
+	srv.Handler.ServeHTTP(w, req)
+
+	return w.Result()
+}
+
+func TestExportFeatures_CSV(t *testing.T) {
+	storer := &pagedFeaturesSearchStorer{
+		MockWPTMetricsStorer: &MockWPTMetricsStorer{t: t},
+		pages: map[string]*backend.FeaturePage{
+			"": {
+				Facets:   nil,
+				Metadata: backend.PageMetadataWithTotal{NextPageToken: new("token"), Total: 2},
+				Data:     []backend.Feature{exportTestFeature()},
+			},
+			"token": {
+				Facets:   nil,
+				Metadata: backend.PageMetadataWithTotal{NextPageToken: nil, Total: 2},
+				Data:     []backend.Feature{exportTestEmptyFeature()},
+			},
+		},
+		sortOrders: nil,
+	}
+
+	resp := serveExportFeatures(t, storer, "/v1/features:export?format=csv&sort=chrome_usage_desc")
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType != "text/csv" {
+		t.Errorf("unexpected content type %s", contentType)
+	}
+	expectedSort := backend.ChromeUsageDesc
+	if !reflect.DeepEqual(storer.sortOrders, []*backend.ListFeaturesParamsSort{&expectedSort, &expectedSort}) {
+		t.Errorf("unexpected sort orders %v", storer.sortOrders)
+	}
+
+	records, err := csv.NewReader(resp.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("unable to read csv: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected a header and 2 rows, got %d records", len(records))
+	}
+	header := records[0]
+	expectedRows := []map[string]string{
+		{
+			"feature_id":                     "feature1",
+			"name":                           "feature 1",
+			"baseline_status":                "widely",
+			"baseline_low_date":              "2000-01-01",
+			"baseline_high_date":             "",
+			"chrome_implementation_status":   "available",
+			"chrome_implementation_date":     "2020-03-02",
+			"chrome_implementation_version":  "80",
+			"chrome_wpt_stable_score":        "0.5",
+			"chrome_wpt_experimental_score":  "",
+			"safari_ios_implementation_date": "",
+			"chrome_usage_daily":             "0.1",
+			"developer_signal_upvotes":       "12",
+		},
+		{
+			"feature_id":                     "feature2",
+			"name":                           "feature 2",
+			"baseline_status":                "",
+			"chrome_implementation_status":   "",
+			"chrome_wpt_stable_score":        "",
+			"chrome_usage_daily":             "",
+			"developer_signal_upvotes":       "",
+			"firefox_wpt_experimental_score": "",
+		},
+	}
+	for i, expected := range expectedRows {
+		for column, value := range expected {
+			idx := slices.Index(header, column)
+			if idx == -1 {
+				t.Fatalf("missing column %s", column)
+			}
+			if records[i+1][idx] != value {
+				t.Errorf("row %d column %s: expected %q, got %q", i, column, value, records[i+1][idx])
+			}
+		}
+	}
+}
+
+func TestExportFeatures_NDJSON(t *testing.T) {
+	storer := &pagedFeaturesSearchStorer{
+		MockWPTMetricsStorer: &MockWPTMetricsStorer{t: t},
+		pages: map[string]*backend.FeaturePage{
+			"": {
+				Facets:   nil,
+				Metadata: backend.PageMetadataWithTotal{NextPageToken: nil, Total: 2},
+				Data:     []backend.Feature{exportTestFeature(), exportTestEmptyFeature()},
+			},
+		},
+		sortOrders: nil,
+	}
+
+	resp := serveExportFeatures(t, storer, "/v1/features:export?format=ndjson")
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status code %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType != "application/x-ndjson" {
+		t.Errorf("unexpected content type %s", contentType)
+	}
+	if !reflect.DeepEqual(storer.sortOrders, []*backend.ListFeaturesParamsSort{nil}) {
+		t.Errorf("unexpected sort orders %v", storer.sortOrders)
+	}
+
+	var rows []map[string]any
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		var row map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &row); err != nil {
+			t.Fatalf("unable to decode line %q: %v", scanner.Text(), err)
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+	expectedRows := []map[string]any{
+		{
+			"feature_id":                    "feature1",
+			"baseline_low_date":             "2000-01-01",
+			"baseline_high_date":            nil,
+			"chrome_implementation_version": "80",
+			"chrome_wpt_stable_score":       0.5,
+			"chrome_usage_daily":            0.1,
+			"developer_signal_upvotes":      float64(12),
+		},
+		{
+			"feature_id":                   "feature2",
+			"baseline_status":              nil,
+			"chrome_implementation_status": nil,
+			"chrome_usage_daily":           nil,
+		},
+	}
+	for i, expected := range expectedRows {
+		for column, value := range expected {
+			actual, found := rows[i][column]
+			if !found {
+				t.Fatalf("row %d is missing column %s", i, column)
+			}
+			if !reflect.DeepEqual(actual, value) {
+				t.Errorf("row %d column %s: expected %v, got %v", i, column, value, actual)
+			}
+		}
+	}
+}
+
+func TestExportFeatures_Errors(t *testing.T) {
+	testCases := []struct {
+		name              string
+		mockConfig        *MockFeaturesSearchConfig
+		expectedCallCount int
+		request           *http.Request
+		expectedResponse  *http.Response
+	}{
+		{
+			name:              "invalid format",
+			mockConfig:        nil,
+			expectedCallCount: 0,
+			request:           httptest.NewRequest(http.MethodGet, "/v1/features:export?format=xml", nil),
+			expectedResponse: testJSONResponse(400,
+				`{"code":400,"message":"format must be csv or ndjson"}`),
+		},
+		{
+			name:              "invalid sort",
+			mockConfig:        nil,
+			expectedCallCount: 0,
+			request:           httptest.NewRequest(http.MethodGet, "/v1/features:export?format=csv&sort=foo", nil),
+			expectedResponse:  testJSONResponse(400, `{"code":400,"message":"invalid sort order"}`),
+		},
+		{
+			name: "saved search not found",
+			mockConfig: &MockFeaturesSearchConfig{
+				expectedPageToken:     nil,
+				expectedPageSize:      exportFeaturesPageSize,
+				expectedSearchNode:    nil,
+				expectedSortBy:        nil,
+				expectedWPTMetricView: backend.TestCounts,
+				expectedBrowsers:      backendtypes.DefaultBrowsers(),
+				expectedQueryParams:   nil,
+				page:                  nil,
+				err:                   backendtypes.ErrSavedSearchNotFound,
+			},
+			expectedCallCount: 1,
+			request:           httptest.NewRequest(http.MethodGet, "/v1/features:export?format=csv", nil),
+			expectedResponse: testJSONResponse(400,
+				`{"code":400,"message":"`+backendtypes.ErrSavedSearchNotFound.Error()+`"}`),
+		},
+		{
+			name: "storage error",
+			mockConfig: &MockFeaturesSearchConfig{
+				expectedPageToken:     nil,
+				expectedPageSize:      exportFeaturesPageSize,
+				expectedSearchNode:    nil,
+				expectedSortBy:        nil,
+				expectedWPTMetricView: backend.SubtestCounts,
+				expectedBrowsers:      backendtypes.DefaultBrowsers(),
+				expectedQueryParams:   nil,
+				page:                  nil,
+				err:                   errors.New("GetFeaturesError"),
+			},
+			expectedCallCount: 1,
+			request: httptest.NewRequest(http.MethodGet,
+				"/v1/features:export?format=ndjson&wpt_metric_view=subtest_counts", nil),
+			expectedResponse: testJSONResponse(500, `{"code":500,"message":"unable to export features"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// nolint:exhaustruct // WONTFIX - only for test purposes
+			mockStorer := &MockWPTMetricsStorer{
+				featuresSearchCfg: tc.mockConfig,
+				t:                 t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			assertTestServerRequest(t, myServer, tc.request, tc.expectedResponse)
+			if mockStorer.callCountFeaturesSearch != tc.expectedCallCount {
+				t.Errorf("expected %d calls to FeaturesSearch, got %d",
+					tc.expectedCallCount, mockStorer.callCountFeaturesSearch)
+			}
+		})
+	}
+}
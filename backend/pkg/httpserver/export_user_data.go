@@ -0,0 +1,53 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// ExportUserData handles the GET request to /v1/users/me:export.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) ExportUserData(
+	ctx context.Context,
+	_ backend.ExportUserDataRequestObject,
+) (backend.ExportUserDataResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "ExportUserData",
+		func(code int, message string) backend.ExportUserData500JSONResponse {
+			return backend.ExportUserData500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	export, err := s.wptMetricsStorer.ExportUserData(ctx, userCheckResult.User.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to export user data", "error", err)
+
+		return backend.ExportUserData500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to export user data",
+		}, nil
+	}
+
+	return backend.ExportUserData200JSONResponse(*export), nil
+}
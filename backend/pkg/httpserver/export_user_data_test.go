@@ -0,0 +1,132 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestExportUserData(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	testCases := []struct {
+		name              string
+		cfg               *MockExportUserDataConfig
+		expectedCallCount int
+		request           *http.Request
+		expectedResponse  *http.Response
+	}{
+		{
+			name: "success",
+			cfg: &MockExportUserDataConfig{
+				expectedUserID: "test-user",
+				output: &backend.UserDataExport{
+					Version:    1,
+					ExportedAt: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+					SavedSearches: []backend.UserDataSavedSearch{
+						{
+							Id:                "search-id",
+							Name:              "CSS",
+							Description:       nil,
+							Query:             "group:css",
+							Tags:              &backend.SavedSearchTags{"css"},
+							Listed:            true,
+							Parameters:        nil,
+							HotlistFeatureIds: nil,
+						},
+					},
+					Bookmarks: []backend.UserDataBookmark{{SavedSearchId: "other-id"}},
+					NotificationChannels: []backend.UserDataNotificationChannel{
+						{
+							Id:           "channel-id",
+							Name:         "Slack",
+							Type:         backend.UserDataNotificationChannelTypeWebhook,
+							EmailAddress: nil,
+							WebhookUrl:   nil,
+						},
+					},
+					Subscriptions: []backend.UserDataSubscription{
+						{
+							Id:            "sub-id",
+							SavedSearchId: "search-id",
+							ChannelId:     "channel-id",
+							Triggers: []backend.SubscriptionTriggerWritable{
+								backend.SubscriptionTriggerFeatureBaselineToWidely,
+							},
+							Frequency: backend.SubscriptionFrequencyWeekly,
+						},
+					},
+				},
+				err: nil,
+			},
+			expectedCallCount: 1,
+			request:           httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/users/me:export", nil),
+			expectedResponse: testJSONResponse(http.StatusOK, `{
+				"version":1,
+				"exported_at":"2026-01-01T00:00:00Z",
+				"saved_searches":[
+					{"id":"search-id","name":"CSS","query":"group:css","tags":["css"],"listed":true}
+				],
+				"bookmarks":[{"saved_search_id":"other-id"}],
+				"notification_channels":[{"id":"channel-id","name":"Slack","type":"webhook"}],
+				"subscriptions":[
+					{
+						"id":"sub-id",
+						"saved_search_id":"search-id",
+						"channel_id":"channel-id",
+						"triggers":["feature_baseline_to_widely"],
+						"frequency":"weekly"
+					}
+				]
+			}`),
+		},
+		{
+			name: "internal server error",
+			cfg: &MockExportUserDataConfig{
+				expectedUserID: "test-user",
+				output:         nil,
+				err:            errTest,
+			},
+			expectedCallCount: 1,
+			request:           httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/users/me:export", nil),
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to export user data"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				exportUserDataCfg: tc.cfg,
+				t:                 t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			assertTestServerRequest(t, myServer, tc.request, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountExportUserData,
+				"ExportUserData", nil)
+		})
+	}
+}
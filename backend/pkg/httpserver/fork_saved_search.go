@@ -0,0 +1,89 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// ForkSavedSearch handles the POST request to /v1/saved-searches/{search_id}:fork.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) ForkSavedSearch(
+	ctx context.Context,
+	request backend.ForkSavedSearchRequestObject,
+) (backend.ForkSavedSearchResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "ForkSavedSearch",
+		func(code int, message string) backend.ForkSavedSearch500JSONResponse {
+			return backend.ForkSavedSearch500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	if request.Body != nil {
+		fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+		if request.Body.Name != nil {
+			validateSavedSearchName(request.Body.Name, fieldErrors)
+		}
+		validateSavedSearchDescription(request.Body.Description, fieldErrors)
+		if fieldErrors.hasErrors() {
+			return backend.ForkSavedSearch400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: errMsgInputValidationErrors,
+				Errors:  fieldErrors.fieldErrorMap,
+			}, nil
+		}
+	}
+
+	output, err := s.wptMetricsStorer.ForkSavedSearch(ctx, userCheckResult.User.ID, request.SearchId, request.Body)
+	if err != nil {
+		switch {
+		case errors.Is(err, backendtypes.ErrEntityDoesNotExist):
+			return backend.ForkSavedSearch404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: errMsgSavedSearchNotFound,
+			}, nil
+		case errors.Is(err, backendtypes.ErrUserMaxSavedSearches):
+			return backend.ForkSavedSearch403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "user has reached the maximum number of allowed saved searches",
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to fork saved search", "error", err, "searchID", request.SearchId)
+
+		return backend.ForkSavedSearch500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to fork saved search",
+		}, nil
+	}
+
+	err = s.eventPublisher.PublishSearchConfigurationChanged(ctx, output, userCheckResult.User.ID, true)
+	if err != nil {
+		// We should not mark this as a failure. Only log it.
+		slog.WarnContext(ctx, "unable to publish search configuration changed event during fork", "error", err)
+	}
+
+	return backend.ForkSavedSearch201JSONResponse(*output), nil
+}
@@ -0,0 +1,206 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestForkSavedSearch(t *testing.T) {
+	now := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	fork := &backend.SavedSearchResponse{
+		Id:          "fork-id",
+		Name:        "my fork",
+		Query:       "group:css",
+		Description: nil,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Permissions: &backend.UserSavedSearchPermissions{
+			Role: new(backend.SavedSearchOwner),
+		},
+		BookmarkStatus: &backend.UserSavedSearchBookmark{
+			Status: backend.BookmarkActive,
+		},
+		ForkedFrom: &backend.SavedSearchForkSource{
+			Id:             "search-id",
+			Name:           new("interop"),
+			RevisionNumber: new(int64(2)),
+		},
+		Tags:       nil,
+		Listed:     nil,
+		Parameters: nil,
+	}
+	expectedFork := `{
+		"id":"fork-id",
+		"name":"my fork",
+		"query":"group:css",
+		"created_at":"2026-01-01T00:00:00Z",
+		"updated_at":"2026-01-01T00:00:00Z",
+		"permissions":{"role":"saved_search_owner"},
+		"bookmark_status":{"status":"bookmark_active"},
+		"forked_from":{"id":"search-id","name":"interop","revision_number":2}
+	}`
+	errCfg := func(err error) *MockForkSavedSearchConfig {
+		return &MockForkSavedSearchConfig{
+			expectedUserID:        "test-user",
+			expectedSavedSearchID: "search-id",
+			expectedRequest:       nil,
+			output:                nil,
+			err:                   err,
+		}
+	}
+
+	testCases := []struct {
+		name                 string
+		cfg                  *MockForkSavedSearchConfig
+		expectedCallCount    int
+		publishErr           error
+		expectedPublishCalls int
+		body                 string
+		expectedResponse     *http.Response
+	}{
+		{
+			name: "success - with name",
+			cfg: &MockForkSavedSearchConfig{
+				expectedUserID:        "test-user",
+				expectedSavedSearchID: "search-id",
+				expectedRequest:       &backend.ForkSavedSearchRequest{Name: new("my fork"), Description: nil},
+				output:                fork,
+				err:                   nil,
+			},
+			expectedCallCount:    1,
+			publishErr:           nil,
+			expectedPublishCalls: 1,
+			body:                 `{"name": "my fork"}`,
+			expectedResponse:     testJSONResponse(http.StatusCreated, expectedFork),
+		},
+		{
+			name: "success - without body",
+			cfg: &MockForkSavedSearchConfig{
+				expectedUserID:        "test-user",
+				expectedSavedSearchID: "search-id",
+				expectedRequest:       nil,
+				output:                fork,
+				err:                   nil,
+			},
+			expectedCallCount:    1,
+			publishErr:           nil,
+			expectedPublishCalls: 1,
+			body:                 "",
+			expectedResponse:     testJSONResponse(http.StatusCreated, expectedFork),
+		},
+		{
+			name: "publish failure does not fail the fork",
+			cfg: &MockForkSavedSearchConfig{
+				expectedUserID:        "test-user",
+				expectedSavedSearchID: "search-id",
+				expectedRequest:       nil,
+				output:                fork,
+				err:                   nil,
+			},
+			expectedCallCount:    1,
+			publishErr:           errors.New("publish error"),
+			expectedPublishCalls: 1,
+			body:                 "",
+			expectedResponse:     testJSONResponse(http.StatusCreated, expectedFork),
+		},
+		{
+			name:                 "bad request - name too long",
+			cfg:                  nil,
+			expectedCallCount:    0,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			body:                 `{"name": "` + strings.Repeat("a", 33) + `"}`,
+			expectedResponse: testJSONResponse(http.StatusBadRequest, `{
+				"code":400,
+				"message":"input validation errors",
+				"errors":{"name":"`+errSavedSearchInvalidNameLength.Error()+`"}
+			}`),
+		},
+		{
+			name:                 "forbidden - saved search limit",
+			cfg:                  errCfg(backendtypes.ErrUserMaxSavedSearches),
+			expectedCallCount:    1,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			body:                 "",
+			expectedResponse: testJSONResponse(http.StatusForbidden,
+				`{"code":403,"message":"user has reached the maximum number of allowed saved searches"}`),
+		},
+		{
+			name:                 "not found",
+			cfg:                  errCfg(backendtypes.ErrEntityDoesNotExist),
+			expectedCallCount:    1,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			body:                 "",
+			expectedResponse: testJSONResponse(http.StatusNotFound,
+				`{"code":404,"message":"saved search not found"}`),
+		},
+		{
+			name:                 "internal server error",
+			cfg:                  errCfg(errors.New("database error")),
+			expectedCallCount:    1,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			body:                 "",
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to fork saved search"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				forkSavedSearchCfg: tc.cfg,
+				t:                  t,
+			}
+			mockPublisher := &MockEventPublisher{
+				t: t,
+				callCountPublishSearchConfigurationChanged: 0,
+				publishSearchConfigurationChangedCfg: &MockPublishSearchConfigurationChangedConfig{
+					expectedResp:       fork,
+					expectedUserID:     "test-user",
+					expectedIsCreation: true,
+					err:                tc.publishErr,
+				},
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer), withCustomEventPublisher(mockPublisher))
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost,
+				"/v1/saved-searches/search-id:fork", strings.NewReader(tc.body))
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountForkSavedSearch,
+				"ForkSavedSearch", nil)
+			assertMocksExpectations(t, tc.expectedPublishCalls,
+				mockPublisher.callCountPublishSearchConfigurationChanged, "PublishSearchConfigurationChanged", nil)
+		})
+	}
+}
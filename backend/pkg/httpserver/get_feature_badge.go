@@ -0,0 +1,134 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// getFeatureBadgeResultVisitor renders the badge of a regular feature and redirects or reports the features that
+// have moved or have been split.
+type getFeatureBadgeResultVisitor struct {
+	resp                 backend.GetFeatureBadgeResponseObject
+	getFeatureBadgeCache operationResponseCache[backend.GetFeatureBadgeRequestObject, string]
+	request              backend.GetFeatureBadgeRequestObject
+	baseURL              *url.URL
+}
+
+func (v *getFeatureBadgeResultVisitor) VisitRegularFeature(ctx context.Context,
+	result backendtypes.RegularFeatureResult) error {
+	showBrowsers := v.request.Params.ShowBrowsers != nil && *v.request.Params.ShowBrowsers
+	svg := renderBadge(featureBadgeSegments(result.Feature(), showBrowsers))
+	v.getFeatureBadgeCache.AttemptCache(ctx, v.request, &svg)
+	v.resp = newGetFeatureBadgeSVGResponse(svg)
+
+	return nil
+}
+
+func (v *getFeatureBadgeResultVisitor) VisitMovedFeature(_ context.Context,
+	result backendtypes.MovedFeatureResult) error {
+	location := v.baseURL.JoinPath("v1", "features", result.NewFeatureID(), "badge.svg")
+	if v.request.Params.ShowBrowsers != nil {
+		location.RawQuery = url.Values{"show_browsers": {strconv.FormatBool(*v.request.Params.ShowBrowsers)}}.Encode()
+	}
+	locationStr := location.String()
+	v.resp = backend.GetFeatureBadge301Response{
+		Headers: backend.GetFeatureBadge301ResponseHeaders{
+			Location: &locationStr,
+		},
+	}
+
+	return nil
+}
+
+func (v *getFeatureBadgeResultVisitor) VisitSplitFeature(_ context.Context,
+	result backendtypes.SplitFeatureResult) error {
+	v.resp = backend.GetFeatureBadge410JSONResponse{
+		Code:        http.StatusGone,
+		Message:     "feature is split",
+		NewFeatures: result.SplitFeature().Features,
+		Type:        backend.Split,
+	}
+
+	return nil
+}
+
+func newGetFeatureBadgeSVGResponse(svg string) backend.GetFeatureBadge200ImagesvgXmlResponse {
+	return backend.GetFeatureBadge200ImagesvgXmlResponse{
+		Body:          strings.NewReader(svg),
+		ContentLength: int64(len(svg)),
+	}
+}
+
+// GetFeatureBadge implements backend.StrictServerInterface.
+// nolint: revive, ireturn // Name generated from openapi
+func (s *Server) GetFeatureBadge(
+	ctx context.Context,
+	request backend.GetFeatureBadgeRequestObject,
+) (backend.GetFeatureBadgeResponseObject, error) {
+	var cachedSVG string
+	found := s.operationResponseCaches.getFeatureBadgeCache.Lookup(ctx, request, &cachedSVG)
+	if found {
+		return newGetFeatureBadgeSVGResponse(cachedSVG), nil
+	}
+
+	result, err := s.wptMetricsStorer.GetFeature(ctx, request.FeatureId,
+		backend.SubtestCounts,
+		backendtypes.DefaultBrowsers(),
+	)
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrQueryReturnedNoResults) {
+			return backend.GetFeatureBadge404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: fmt.Sprintf("feature id %s is not found", request.FeatureId),
+			}, nil
+		}
+		slog.ErrorContext(ctx, "unable to get feature for badge", "error", err, "featureID", request.FeatureId)
+
+		return backend.GetFeatureBadge500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to get feature badge",
+		}, nil
+	}
+
+	v := &getFeatureBadgeResultVisitor{
+		resp:                 nil,
+		getFeatureBadgeCache: s.operationResponseCaches.getFeatureBadgeCache,
+		request:              request,
+		baseURL:              s.baseURL,
+	}
+	err = result.Visit(ctx, v)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to determine if feature is regular, split, or moved", "error", err)
+
+		return backend.GetFeatureBadge500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to determine if feature is regular, split, or moved",
+		}, nil
+	}
+
+	return v.resp, nil
+}
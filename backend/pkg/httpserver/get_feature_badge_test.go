@@ -0,0 +1,197 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/cachetypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func testSVGResponse(svg string) *http.Response {
+	// nolint:exhaustruct // WONTFIX - only for test purposes
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"Content-Type":   []string{"image/svg+xml"},
+			"Content-Length": []string{strconv.Itoa(len(svg))},
+		},
+		Body: io.NopCloser(strings.NewReader(svg)),
+	}
+}
+
+func testCachedSVG(t *testing.T, svg string) []byte {
+	value, err := json.Marshal(svg)
+	if err != nil {
+		t.Fatalf("unable to marshal svg: %v", err)
+	}
+
+	return value
+}
+
+func TestGetFeatureBadge(t *testing.T) {
+	// nolint:exhaustruct // WONTFIX - only the baseline and the browser implementations are used.
+	feature := &backend.Feature{
+		Baseline: &backend.BaselineInfo{Status: new(backend.Widely), LowDate: nil, HighDate: nil},
+		BrowserImplementations: &map[string]backend.BrowserImplementation{
+			"chrome": {Status: new(backend.Available), Date: nil, Version: nil},
+		},
+		FeatureId: "grid",
+		Name:      "Grid",
+	}
+	regular := backendtypes.NewGetFeatureResult(backendtypes.NewRegularFeatureResult(feature))
+	svg := renderBadge(featureBadgeSegments(feature, false))
+	svgWithBrowsers := renderBadge(featureBadgeSegments(feature, true))
+	cacheKey := `getFeatureBadge-{"feature_id":"grid","Params":{}}`
+	cacheKeyWithBrowsers := `getFeatureBadge-{"feature_id":"grid","Params":{"show_browsers":true}}`
+	cacheMiss := func(key string) []*ExpectedGetCall {
+		return []*ExpectedGetCall{{Key: key, Value: nil, Err: cachetypes.ErrCachedDataNotFound}}
+	}
+	mockConfig := func(result *backendtypes.GetFeatureResult, err error) *MockGetFeatureByIDConfig {
+		return &MockGetFeatureByIDConfig{
+			expectedFeatureID:     "grid",
+			expectedWPTMetricView: backend.SubtestCounts,
+			expectedBrowsers:      backendtypes.DefaultBrowsers(),
+			data:                  result,
+			err:                   err,
+		}
+	}
+	testCases := []struct {
+		name               string
+		mockConfig         *MockGetFeatureByIDConfig
+		path               string
+		expectedCallCount  int
+		expectedGetCalls   []*ExpectedGetCall
+		expectedCacheCalls []*ExpectedCacheCall
+		expectedResponse   *http.Response
+	}{
+		{
+			name:              "success",
+			mockConfig:        mockConfig(regular, nil),
+			path:              "/v1/features/grid/badge.svg",
+			expectedCallCount: 1,
+			expectedGetCalls:  cacheMiss(cacheKey),
+			expectedCacheCalls: []*ExpectedCacheCall{
+				{Key: cacheKey, Value: testCachedSVG(t, svg), CacheCfg: getDefaultCacheConfig()},
+			},
+			expectedResponse: testSVGResponse(svg),
+		},
+		{
+			name:              "success with browsers",
+			mockConfig:        mockConfig(regular, nil),
+			path:              "/v1/features/grid/badge.svg?show_browsers=true",
+			expectedCallCount: 1,
+			expectedGetCalls:  cacheMiss(cacheKeyWithBrowsers),
+			expectedCacheCalls: []*ExpectedCacheCall{
+				{
+					Key:      cacheKeyWithBrowsers,
+					Value:    testCachedSVG(t, svgWithBrowsers),
+					CacheCfg: getDefaultCacheConfig(),
+				},
+			},
+			expectedResponse: testSVGResponse(svgWithBrowsers),
+		},
+		{
+			name:              "success (cached)",
+			mockConfig:        nil,
+			path:              "/v1/features/grid/badge.svg",
+			expectedCallCount: 0,
+			expectedGetCalls: []*ExpectedGetCall{
+				{Key: cacheKey, Value: testCachedSVG(t, svg), Err: nil},
+			},
+			expectedCacheCalls: nil,
+			expectedResponse:   testSVGResponse(svg),
+		},
+		{
+			name: "moved",
+			mockConfig: mockConfig(backendtypes.NewGetFeatureResult(
+				backendtypes.NewMovedFeatureResult("grid2")), nil),
+			path:               "/v1/features/grid/badge.svg?show_browsers=true",
+			expectedCallCount:  1,
+			expectedGetCalls:   cacheMiss(cacheKeyWithBrowsers),
+			expectedCacheCalls: nil,
+			// nolint:exhaustruct // WONTFIX - only for test purposes
+			expectedResponse: &http.Response{
+				StatusCode: http.StatusMovedPermanently,
+				Header: http.Header{
+					"Location": {"http://localhost:8080/v1/features/grid2/badge.svg?show_browsers=true"},
+				},
+				Body: io.NopCloser(strings.NewReader("")),
+			},
+		},
+		{
+			name: "split",
+			mockConfig: mockConfig(backendtypes.NewGetFeatureResult(backendtypes.NewSplitFeatureResult(
+				backend.FeatureEvolutionSplit{
+					Features: []backend.FeatureSplitInfo{{Id: "grid2"}, {Id: "grid3"}},
+				})), nil),
+			path:               "/v1/features/grid/badge.svg",
+			expectedCallCount:  1,
+			expectedGetCalls:   cacheMiss(cacheKey),
+			expectedCacheCalls: nil,
+			expectedResponse: testJSONResponse(http.StatusGone,
+				`{"code":410,"message":"feature is split",`+
+					`"new_features":[{"id":"grid2"},{"id":"grid3"}],"type":"split"}`),
+		},
+		{
+			name:               "not found",
+			mockConfig:         mockConfig(nil, gcpspanner.ErrQueryReturnedNoResults),
+			path:               "/v1/features/grid/badge.svg",
+			expectedCallCount:  1,
+			expectedGetCalls:   cacheMiss(cacheKey),
+			expectedCacheCalls: nil,
+			expectedResponse: testJSONResponse(http.StatusNotFound,
+				`{"code":404,"message":"feature id grid is not found"}`),
+		},
+		{
+			name:               "internal server error",
+			mockConfig:         mockConfig(nil, errors.New("database error")),
+			path:               "/v1/features/grid/badge.svg",
+			expectedCallCount:  1,
+			expectedGetCalls:   cacheMiss(cacheKey),
+			expectedCacheCalls: nil,
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to get feature badge"}`),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				getFeatureByIDConfig: tc.mockConfig,
+				t:                    t,
+			}
+			mockCacher := NewMockRawBytesDataCacher(t, tc.expectedCacheCalls, tc.expectedGetCalls)
+			myServer := setupTestServer(t,
+				withCustomStorer(mockStorer),
+				withCustomCaches(initOperationResponseCaches(mockCacher, getTestRouteCacheOptions())),
+			)
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, tc.path, nil)
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse)
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountGetFeature,
+				"GetFeature", mockCacher)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// GetFeatureTimeline implements backend.StrictServerInterface.
+// nolint: revive, ireturn // Name generated from openapi
+func (s *Server) GetFeatureTimeline(
+	ctx context.Context,
+	request backend.GetFeatureTimelineRequestObject,
+) (backend.GetFeatureTimelineResponseObject, error) {
+	var cachedResponse backend.GetFeatureTimeline200JSONResponse
+	found := s.operationResponseCaches.getFeatureTimelineCache.Lookup(ctx, request, &cachedResponse)
+	if found {
+		return cachedResponse, nil
+	}
+
+	timeline, err := s.wptMetricsStorer.GetFeatureTimeline(ctx, request.FeatureId)
+	if err != nil {
+		if errors.Is(err, backendtypes.ErrEntityDoesNotExist) {
+			return backend.GetFeatureTimeline404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: fmt.Sprintf("feature id %s is not found", request.FeatureId),
+			}, nil
+		}
+		slog.ErrorContext(ctx, "unable to get feature timeline", "error", err, "featureID", request.FeatureId)
+
+		return backend.GetFeatureTimeline500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to get feature timeline",
+		}, nil
+	}
+
+	resp := backend.GetFeatureTimeline200JSONResponse(*timeline)
+	s.operationResponseCaches.getFeatureTimelineCache.AttemptCache(ctx, request, &resp)
+
+	return resp, nil
+}
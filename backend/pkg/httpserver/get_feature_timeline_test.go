@@ -0,0 +1,149 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/cachetypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestGetFeatureTimeline(t *testing.T) {
+	cacheKey := `getFeatureTimeline-{"feature_id":"grid"}`
+	cacheMiss := []*ExpectedGetCall{
+		{
+			Key:   cacheKey,
+			Value: nil,
+			Err:   cachetypes.ErrCachedDataNotFound,
+		},
+	}
+	timelineJSON := `{"events":[` +
+		`{"browser":"chrome","browser_version":"57","date":"2017-03-09T00:00:00Z","type":"browser_implementation"},` +
+		`{"previous_feature_id":"layout","type":"split_from"}],"feature_id":"grid"}`
+	testCases := []struct {
+		name               string
+		cfg                *MockGetFeatureTimelineConfig
+		expectedCallCount  int
+		expectedCacheCalls []*ExpectedCacheCall
+		expectedGetCalls   []*ExpectedGetCall
+		expectedResponse   *http.Response
+	}{
+		{
+			name: "success",
+			cfg: &MockGetFeatureTimelineConfig{
+				expectedFeatureID: "grid",
+				result: &backend.FeatureTimeline{
+					FeatureId: "grid",
+					Events: []backend.FeatureTimelineEvent{
+						{
+							Type:              backend.TimelineBrowserImplementation,
+							Date:              new(time.Date(2017, 3, 9, 0, 0, 0, 0, time.UTC)),
+							Browser:           new("chrome"),
+							BrowserVersion:    new("57"),
+							OldName:           nil,
+							NewName:           nil,
+							PreviousFeatureId: nil,
+						},
+						{
+							Type:              backend.TimelineSplitFrom,
+							Date:              nil,
+							Browser:           nil,
+							BrowserVersion:    nil,
+							OldName:           nil,
+							NewName:           nil,
+							PreviousFeatureId: new("layout"),
+						},
+					},
+				},
+				err: nil,
+			},
+			expectedCallCount: 1,
+			expectedGetCalls:  cacheMiss,
+			expectedCacheCalls: []*ExpectedCacheCall{
+				{
+					Key:      cacheKey,
+					Value:    []byte(timelineJSON),
+					CacheCfg: getDefaultCacheConfig(),
+				},
+			},
+			expectedResponse: testJSONResponse(http.StatusOK, timelineJSON),
+		},
+		{
+			name:              "success (cached)",
+			cfg:               nil,
+			expectedCallCount: 0,
+			expectedGetCalls: []*ExpectedGetCall{
+				{
+					Key:   cacheKey,
+					Value: []byte(timelineJSON),
+					Err:   nil,
+				},
+			},
+			expectedCacheCalls: nil,
+			expectedResponse:   testJSONResponse(http.StatusOK, timelineJSON),
+		},
+		{
+			name: "not found",
+			cfg: &MockGetFeatureTimelineConfig{
+				expectedFeatureID: "grid",
+				result:            nil,
+				err:               backendtypes.ErrEntityDoesNotExist,
+			},
+			expectedCallCount:  1,
+			expectedGetCalls:   cacheMiss,
+			expectedCacheCalls: nil,
+			expectedResponse: testJSONResponse(http.StatusNotFound,
+				`{"code":404,"message":"feature id grid is not found"}`),
+		},
+		{
+			name: "internal server error",
+			cfg: &MockGetFeatureTimelineConfig{
+				expectedFeatureID: "grid",
+				result:            nil,
+				err:               errors.New("database error"),
+			},
+			expectedCallCount:  1,
+			expectedGetCalls:   cacheMiss,
+			expectedCacheCalls: nil,
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to get feature timeline"}`),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				getFeatureTimelineCfg: tc.cfg,
+				t:                     t,
+			}
+			mockCacher := NewMockRawBytesDataCacher(t, tc.expectedCacheCalls, tc.expectedGetCalls)
+			myServer := setupTestServer(t,
+				withCustomStorer(mockStorer),
+				withCustomCaches(initOperationResponseCaches(mockCacher, getTestRouteCacheOptions())),
+			)
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/features/grid/timeline", nil)
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse)
+			mockCacher.AssertExpectations()
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountGetFeatureTimeline,
+				"GetFeatureTimeline", nil)
+		})
+	}
+}
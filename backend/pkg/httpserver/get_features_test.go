@@ -56,7 +56,9 @@ func TestListFeatures(t *testing.T) {
 					backend.FirefoxAndroid,
 					backend.SafariIos,
 				},
+				expectedQueryParams: nil,
 				page: &backend.FeaturePage{
+					Facets: nil,
 					Metadata: backend.PageMetadataWithTotal{
 						NextPageToken: nil,
 						Total:         100,
@@ -197,6 +199,7 @@ func TestListFeatures(t *testing.T) {
 					backend.FirefoxAndroid,
 					backend.SafariIos,
 				},
+				expectedQueryParams: nil,
 				expectedSearchNode: &searchtypes.SearchNode{
 					Keyword: searchtypes.KeywordRoot,
 					Term:    nil,
@@ -229,6 +232,7 @@ func TestListFeatures(t *testing.T) {
 				},
 				expectedSortBy: new(backend.NameDesc),
 				page: &backend.FeaturePage{
+					Facets: nil,
 					Metadata: backend.PageMetadataWithTotal{
 						NextPageToken: nextPageToken,
 						Total:         100,
@@ -433,6 +437,7 @@ func TestListFeatures(t *testing.T) {
 					backend.FirefoxAndroid,
 					backend.SafariIos,
 				},
+				expectedQueryParams:   nil,
 				expectedWPTMetricView: backend.TestCounts,
 				page:                  nil,
 				err:                   errTest,
@@ -460,6 +465,7 @@ func TestListFeatures(t *testing.T) {
 				expectedSortBy:        nil,
 				expectedWPTMetricView: backend.TestCounts,
 				expectedBrowsers:      nil,
+				expectedQueryParams:   nil,
 				page:                  nil,
 				err:                   errTest,
 			},
@@ -486,6 +492,7 @@ func TestListFeatures(t *testing.T) {
 				expectedSortBy:        nil,
 				expectedWPTMetricView: backend.TestCounts,
 				expectedBrowsers:      nil,
+				expectedQueryParams:   nil,
 				page:                  nil,
 				err:                   errTest,
 			},
@@ -524,6 +531,7 @@ func TestListFeatures(t *testing.T) {
 					backend.FirefoxAndroid,
 					backend.SafariIos,
 				},
+				expectedQueryParams:   nil,
 				expectedWPTMetricView: backend.TestCounts,
 				page:                  nil,
 				err:                   backendtypes.ErrInvalidPageToken,
@@ -547,6 +555,63 @@ func TestListFeatures(t *testing.T) {
 				nil,
 			),
 		},
+		{
+			name:       "400 case - malformed query params",
+			mockConfig: nil,
+			expectedGetCalls: []*ExpectedGetCall{
+				{
+					Key:   `listFeatures-{"Params":{"params":["status"]}}`,
+					Value: nil,
+					Err:   cachetypes.ErrCachedDataNotFound,
+				},
+			},
+			expectedCacheCalls: nil,
+			expectedCallCount:  0,
+			expectedResponse: testJSONResponse(400,
+				`{"code":400,"message":"invalid query parameter: params must have the form name:value"}`,
+			),
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/features?params=status", nil),
+		},
+		{
+			name: "400 case - query variable without value",
+			mockConfig: &MockFeaturesSearchConfig{
+				expectedPageToken:     nil,
+				expectedPageSize:      100,
+				expectedSearchNode:    nil,
+				expectedSortBy:        nil,
+				expectedWPTMetricView: backend.TestCounts,
+				expectedBrowsers: []backend.BrowserPathParam{
+					backend.Chrome,
+					backend.Edge,
+					backend.Firefox,
+					backend.Safari,
+					backend.ChromeAndroid,
+					backend.FirefoxAndroid,
+					backend.SafariIos,
+				},
+				expectedQueryParams: map[string]string{"browser": "chrome", "status": "newly"},
+				page:                nil,
+				err:                 fmt.Errorf("%w: $other", searchtypes.ErrUnboundQueryVariable),
+			},
+			expectedGetCalls: []*ExpectedGetCall{
+				{
+					Key:   `listFeatures-{"Params":{"params":["browser:chrome","status:newly"]}}`,
+					Value: nil,
+					Err:   cachetypes.ErrCachedDataNotFound,
+				},
+			},
+			expectedCacheCalls: nil,
+			expectedCallCount:  1,
+			expectedResponse: testJSONResponse(400,
+				`{"code":400,"message":"query variable is not bound"}`,
+			),
+			request: httptest.NewRequestWithContext(
+				t.Context(),
+				http.MethodGet,
+				"/v1/features?params=browser:chrome&params=status:newly",
+				nil,
+			),
+		},
 		{
 			name: "400 case - query consists entirely of saved search",
 			mockConfig: &MockFeaturesSearchConfig{
@@ -564,8 +629,9 @@ func TestListFeatures(t *testing.T) {
 					backend.FirefoxAndroid,
 					backend.SafariIos,
 				},
-				page: nil,
-				err:  backendtypes.ErrQueryConsistsEntirelyOfSavedSearch,
+				expectedQueryParams: nil,
+				page:                nil,
+				err:                 backendtypes.ErrQueryConsistsEntirelyOfSavedSearch,
 			},
 			expectedGetCalls: []*ExpectedGetCall{
 				{
@@ -600,3 +666,161 @@ func TestListFeatures(t *testing.T) {
 		})
 	}
 }
+
+func TestListFeatures_Facets(t *testing.T) {
+	emptyPage := &backend.FeaturePage{
+		Facets: nil,
+		Metadata: backend.PageMetadataWithTotal{
+			NextPageToken: nil,
+			Total:         7,
+		},
+		Data: []backend.Feature{},
+	}
+	testCases := []struct {
+		name                   string
+		mockConfig             *MockFeaturesSearchConfig
+		mockFacetsConfig       *MockFeaturesSearchFacetsConfig
+		expectedCallCount      int
+		expectedFacetCallCount int
+		expectedCacheCalls     []*ExpectedCacheCall
+		expectedGetCalls       []*ExpectedGetCall
+		request                *http.Request
+		expectedResponse       *http.Response
+	}{
+		{
+			name: "Success Case - facets are counted and cached with the page",
+			mockConfig: &MockFeaturesSearchConfig{
+				expectedPageToken:     nil,
+				expectedPageSize:      100,
+				expectedSearchNode:    nil,
+				expectedSortBy:        nil,
+				expectedWPTMetricView: backend.TestCounts,
+				expectedBrowsers:      backendtypes.DefaultBrowsers(),
+				expectedQueryParams:   nil,
+				page:                  emptyPage,
+				err:                   nil,
+			},
+			mockFacetsConfig: &MockFeaturesSearchFacetsConfig{
+				expectedSearchNode: nil,
+				// Duplicates are removed.
+				expectedFacets:      []backend.FeatureSearchFacet{backend.FacetBaselineStatus, backend.FacetGroup},
+				expectedQueryParams: nil,
+				facets: &backend.FeatureSearchFacets{
+					AvailableOn: nil,
+					BaselineStatus: &[]backend.FeatureSearchFacetCount{
+						{Value: "widely", Count: 5},
+						{Value: "limited", Count: 2},
+					},
+					Group: &[]backend.FeatureSearchFacetCount{},
+				},
+				err: nil,
+			},
+			expectedCallCount:      1,
+			expectedFacetCallCount: 1,
+			expectedGetCalls: []*ExpectedGetCall{
+				{
+					Key:   `listFeatures-{"Params":{"facets":["baseline_status","group","group"]}}`,
+					Value: nil,
+					Err:   cachetypes.ErrCachedDataNotFound,
+				},
+			},
+			expectedCacheCalls: []*ExpectedCacheCall{
+				{
+					Key: `listFeatures-{"Params":{"facets":["baseline_status","group","group"]}}`,
+					Value: []byte(
+						`{"data":[],"facets":{"baseline_status":[{"count":5,"value":"widely"},` +
+							`{"count":2,"value":"limited"}],"group":[]},"metadata":{"total":7}}`,
+					),
+					CacheCfg: getDefaultCacheConfig()},
+			},
+			request: httptest.NewRequest(http.MethodGet,
+				"/v1/features?facets=baseline_status,group,group", nil),
+			expectedResponse: testJSONResponse(200, `
+{
+	"data":[],
+	"facets":{
+		"baseline_status":[
+			{"count":5,"value":"widely"},
+			{"count":2,"value":"limited"}
+		],
+		"group":[]
+	},
+	"metadata":{
+		"total":7
+	}
+}`),
+		},
+		{
+			name:                   "400 case - invalid facet",
+			mockConfig:             nil,
+			mockFacetsConfig:       nil,
+			expectedCallCount:      0,
+			expectedFacetCallCount: 0,
+			expectedGetCalls: []*ExpectedGetCall{
+				{
+					Key:   `listFeatures-{"Params":{"facets":["browser"]}}`,
+					Value: nil,
+					Err:   cachetypes.ErrCachedDataNotFound,
+				},
+			},
+			expectedCacheCalls: nil,
+			request:            httptest.NewRequest(http.MethodGet, "/v1/features?facets=browser", nil),
+			expectedResponse:   testJSONResponse(400, `{"code":400,"message":"invalid facet \"browser\""}`),
+		},
+		{
+			name: "500 case - facets error",
+			mockConfig: &MockFeaturesSearchConfig{
+				expectedPageToken:     nil,
+				expectedPageSize:      100,
+				expectedSearchNode:    nil,
+				expectedSortBy:        nil,
+				expectedWPTMetricView: backend.TestCounts,
+				expectedBrowsers:      backendtypes.DefaultBrowsers(),
+				expectedQueryParams:   nil,
+				page:                  emptyPage,
+				err:                   nil,
+			},
+			mockFacetsConfig: &MockFeaturesSearchFacetsConfig{
+				expectedSearchNode:  nil,
+				expectedFacets:      []backend.FeatureSearchFacet{backend.FacetAvailableOn},
+				expectedQueryParams: nil,
+				facets:              nil,
+				err:                 errTest,
+			},
+			expectedCallCount:      1,
+			expectedFacetCallCount: 1,
+			expectedGetCalls: []*ExpectedGetCall{
+				{
+					Key:   `listFeatures-{"Params":{"facets":["available_on"]}}`,
+					Value: nil,
+					Err:   cachetypes.ErrCachedDataNotFound,
+				},
+			},
+			expectedCacheCalls: nil,
+			request:            httptest.NewRequest(http.MethodGet, "/v1/features?facets=available_on", nil),
+			expectedResponse:   testJSONResponse(500, `{"code":500,"message":"unable to get list of features"}`),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// nolint: exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				featuresSearchCfg:       tc.mockConfig,
+				featuresSearchFacetsCfg: tc.mockFacetsConfig,
+				t:                       t,
+			}
+			mockCacher := NewMockRawBytesDataCacher(t, tc.expectedCacheCalls, tc.expectedGetCalls)
+			myServer := setupTestServer(t,
+				withCustomStorer(mockStorer),
+				withCustomCaches(initOperationResponseCaches(mockCacher, getTestRouteCacheOptions())),
+			)
+			assertTestServerRequest(t, myServer, tc.request, tc.expectedResponse)
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountFeaturesSearch,
+				"FeaturesSearch", mockCacher)
+			if mockStorer.callCountFeaturesSearchFacets != tc.expectedFacetCallCount {
+				t.Errorf("expected %d calls to FeaturesSearchFacets, got %d",
+					tc.expectedFacetCallCount, mockStorer.callCountFeaturesSearchFacets)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// GetHotlist handles the GET request to /v1/saved-searches/{search_id}/hotlist.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) GetHotlist(
+	ctx context.Context,
+	request backend.GetHotlistRequestObject,
+) (backend.GetHotlistResponseObject, error) {
+	hotlist, err := s.wptMetricsStorer.GetHotlist(ctx, request.SearchId)
+	if err != nil {
+		if errors.Is(err, backendtypes.ErrEntityDoesNotExist) {
+			return backend.GetHotlist404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: "hotlist not found",
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to get hotlist", "error", err, "searchID", request.SearchId)
+
+		return backend.GetHotlist500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to get hotlist",
+		}, nil
+	}
+
+	return backend.GetHotlist200JSONResponse(*hotlist), nil
+}
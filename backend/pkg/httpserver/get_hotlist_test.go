@@ -0,0 +1,82 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestGetHotlist(t *testing.T) {
+	testCases := []struct {
+		name             string
+		cfg              *MockGetHotlistConfig
+		expectedResponse *http.Response
+	}{
+		{
+			name: "success",
+			cfg: &MockGetHotlistConfig{
+				expectedSavedSearchID: "hotlist-id",
+				output: &backend.Hotlist{
+					Id:         "hotlist-id",
+					Version:    3,
+					FeatureIds: []string{"subgrid", "grid"},
+				},
+				err: nil,
+			},
+			expectedResponse: testJSONResponse(http.StatusOK,
+				`{"id":"hotlist-id","version":3,"feature_ids":["subgrid","grid"]}`),
+		},
+		{
+			name: "not found",
+			cfg: &MockGetHotlistConfig{
+				expectedSavedSearchID: "hotlist-id",
+				output:                nil,
+				err:                   backendtypes.ErrEntityDoesNotExist,
+			},
+			expectedResponse: testJSONResponse(http.StatusNotFound, `{"code":404,"message":"hotlist not found"}`),
+		},
+		{
+			name: "internal server error",
+			cfg: &MockGetHotlistConfig{
+				expectedSavedSearchID: "hotlist-id",
+				output:                nil,
+				err:                   errors.New("database error"),
+			},
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to get hotlist"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				getHotlistCfg: tc.cfg,
+				t:             t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			req := httptest.NewRequestWithContext(t.Context(),
+				http.MethodGet, "/v1/saved-searches/hotlist-id/hotlist", nil)
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse)
+			assertMocksExpectations(t, 1, mockStorer.callCountGetHotlist, "GetHotlist", nil)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestGetNotificationChannelDeliverySettings(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "listUserID1",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	testCases := []struct {
+		name                 string
+		cfg                  *MockGetNotificationChannelDeliverySettingsConfig
+		expectedCallCount    int
+		authMiddlewareOption testServerOption
+		request              *http.Request
+		expectedResponse     *http.Response
+	}{
+		{
+			name: "success",
+			cfg: &MockGetNotificationChannelDeliverySettingsConfig{
+				expectedUserID:    "listUserID1",
+				expectedChannelID: "channel1",
+				output: &backend.NotificationChannelDeliverySettings{
+					QuietHours: &backend.QuietHours{
+						Start:    "22:00",
+						End:      "07:00",
+						TimeZone: "Europe/Berlin",
+					},
+					MaxDeliveriesPerHour: new(10),
+				},
+				err: nil,
+			},
+			expectedCallCount:    1,
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+				"/v1/users/me/notification-channels/channel1/delivery-settings", nil),
+			expectedResponse: testJSONResponse(200, `
+{
+	"quiet_hours": {
+		"start": "22:00",
+		"end": "07:00",
+		"time_zone": "Europe/Berlin"
+	},
+	"max_deliveries_per_hour": 10
+}`),
+		},
+		{
+			name: "not found",
+			cfg: &MockGetNotificationChannelDeliverySettingsConfig{
+				expectedUserID:    "listUserID1",
+				expectedChannelID: "channel1",
+				output:            nil,
+				err:               backendtypes.ErrEntityDoesNotExist,
+			},
+			expectedCallCount:    1,
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+				"/v1/users/me/notification-channels/channel1/delivery-settings", nil),
+			expectedResponse: testJSONResponse(404, `
+			{
+				"code":404,
+				"message":"Notification channel not found or not owned by user"
+			}`),
+		},
+		{
+			name: "500 error",
+			cfg: &MockGetNotificationChannelDeliverySettingsConfig{
+				expectedUserID:    "listUserID1",
+				expectedChannelID: "channel1",
+				output:            nil,
+				err:               errTest,
+			},
+			expectedCallCount:    1,
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+				"/v1/users/me/notification-channels/channel1/delivery-settings", nil),
+			expectedResponse: testJSONResponse(500, `
+			{
+				"code":500,
+				"message":"Could not retrieve notification channel delivery settings"
+			}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				getNotificationChannelDeliverySettingsCfg: tc.cfg,
+				t: t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			assertTestServerRequest(t, myServer, tc.request, tc.expectedResponse,
+				[]testServerOption{tc.authMiddlewareOption}...)
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountGetNotificationChannelDeliverySettings,
+				"GetNotificationChannelDeliverySettings", nil)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func newGetSavedSearchBadgeSVGResponse(svg string) backend.GetSavedSearchBadge200ImagesvgXmlResponse {
+	return backend.GetSavedSearchBadge200ImagesvgXmlResponse{
+		Body:          strings.NewReader(svg),
+		ContentLength: int64(len(svg)),
+	}
+}
+
+// GetSavedSearchBadge implements backend.StrictServerInterface.
+// nolint: revive, ireturn // Name generated from openapi
+func (s *Server) GetSavedSearchBadge(
+	ctx context.Context,
+	request backend.GetSavedSearchBadgeRequestObject,
+) (backend.GetSavedSearchBadgeResponseObject, error) {
+	var cachedSVG string
+	found := s.operationResponseCaches.getSavedSearchBadgeCache.Lookup(ctx, request, &cachedSVG)
+	if found {
+		return newGetSavedSearchBadgeSVGResponse(cachedSVG), nil
+	}
+
+	counts, err := s.wptMetricsStorer.CountSavedSearchFeaturesByBaselineStatus(ctx, request.SearchId)
+	if err != nil {
+		if errors.Is(err, backendtypes.ErrEntityDoesNotExist) {
+			return backend.GetSavedSearchBadge404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: errMsgSavedSearchNotFound,
+			}, nil
+		}
+
+		if safeErr := sanitizeValidationError(err); safeErr != nil {
+			slog.WarnContext(ctx, "invalid saved search query", "error", err)
+
+			return backend.GetSavedSearchBadge400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: safeErr.Error(),
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to get saved search for badge", "error", err, "searchID", request.SearchId)
+
+		return backend.GetSavedSearchBadge500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to get saved search badge",
+		}, nil
+	}
+
+	svg := renderBadge(savedSearchBadgeSegments(counts))
+	s.operationResponseCaches.getSavedSearchBadgeCache.AttemptCache(ctx, request, &svg)
+
+	return newGetSavedSearchBadgeSVGResponse(svg), nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/cachetypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestGetSavedSearchBadge(t *testing.T) {
+	counts := []backend.FeatureSearchFacetCount{
+		{Value: "widely", Count: 2},
+		{Value: "limited", Count: 1},
+	}
+	svg := renderBadge(savedSearchBadgeSegments(counts))
+	cacheKey := `getSavedSearchBadge-{"search_id":"search1"}`
+	cacheMiss := []*ExpectedGetCall{{Key: cacheKey, Value: nil, Err: cachetypes.ErrCachedDataNotFound}}
+	testCases := []struct {
+		name               string
+		cfg                *MockCountSavedSearchFeaturesByBaselineStatusConfig
+		expectedCallCount  int
+		expectedGetCalls   []*ExpectedGetCall
+		expectedCacheCalls []*ExpectedCacheCall
+		expectedResponse   *http.Response
+	}{
+		{
+			name: "success",
+			cfg: &MockCountSavedSearchFeaturesByBaselineStatusConfig{
+				expectedSavedSearchID: "search1",
+				result:                counts,
+				err:                   nil,
+			},
+			expectedCallCount: 1,
+			expectedGetCalls:  cacheMiss,
+			expectedCacheCalls: []*ExpectedCacheCall{
+				{Key: cacheKey, Value: testCachedSVG(t, svg), CacheCfg: getDefaultCacheConfig()},
+			},
+			expectedResponse: testSVGResponse(svg),
+		},
+		{
+			name:               "success (cached)",
+			cfg:                nil,
+			expectedCallCount:  0,
+			expectedGetCalls:   []*ExpectedGetCall{{Key: cacheKey, Value: testCachedSVG(t, svg), Err: nil}},
+			expectedCacheCalls: nil,
+			expectedResponse:   testSVGResponse(svg),
+		},
+		{
+			name: "not found",
+			cfg: &MockCountSavedSearchFeaturesByBaselineStatusConfig{
+				expectedSavedSearchID: "search1",
+				result:                nil,
+				err:                   backendtypes.ErrEntityDoesNotExist,
+			},
+			expectedCallCount:  1,
+			expectedGetCalls:   cacheMiss,
+			expectedCacheCalls: nil,
+			expectedResponse: testJSONResponse(http.StatusNotFound,
+				`{"code":404,"message":"`+errMsgSavedSearchNotFound+`"}`),
+		},
+		{
+			name: "invalid query",
+			cfg: &MockCountSavedSearchFeaturesByBaselineStatusConfig{
+				expectedSavedSearchID: "search1",
+				result:                nil,
+				err:                   backendtypes.ErrSavedSearchCycleDetected,
+			},
+			expectedCallCount:  1,
+			expectedGetCalls:   cacheMiss,
+			expectedCacheCalls: nil,
+			expectedResponse: testJSONResponse(http.StatusBadRequest,
+				`{"code":400,"message":"`+backendtypes.ErrSavedSearchCycleDetected.Error()+`"}`),
+		},
+		{
+			name: "internal server error",
+			cfg: &MockCountSavedSearchFeaturesByBaselineStatusConfig{
+				expectedSavedSearchID: "search1",
+				result:                nil,
+				err:                   errors.New("database error"),
+			},
+			expectedCallCount:  1,
+			expectedGetCalls:   cacheMiss,
+			expectedCacheCalls: nil,
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to get saved search badge"}`),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				countSavedSearchFeaturesByBaselineStatusCfg: tc.cfg,
+				t: t,
+			}
+			mockCacher := NewMockRawBytesDataCacher(t, tc.expectedCacheCalls, tc.expectedGetCalls)
+			myServer := setupTestServer(t,
+				withCustomStorer(mockStorer),
+				withCustomCaches(initOperationResponseCaches(mockCacher, getTestRouteCacheOptions())),
+			)
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+				"/v1/saved-searches/search1/badge.svg", nil)
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse)
+			assertMocksExpectations(t, tc.expectedCallCount,
+				mockStorer.callCountCountSavedSearchFeaturesByBaselineStatus,
+				"CountSavedSearchFeaturesByBaselineStatus", mockCacher)
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+	"github.com/GoogleChrome/webstatus.dev/lib/httpmiddlewares"
+)
+
+// GetSavedSearchReferences implements backend.StrictServerInterface.
+// Unauthenticated users only see the referencing saved searches that are listed.
+// nolint:ireturn // Expected ireturn for openapi generation.
+func (s *Server) GetSavedSearchReferences(
+	ctx context.Context, req backend.GetSavedSearchReferencesRequestObject) (
+	backend.GetSavedSearchReferencesResponseObject, error) {
+	var userID *string
+	user, found := httpmiddlewares.AuthenticatedUserFromContext(ctx)
+	if found {
+		userID = &user.ID
+	}
+
+	references, err := s.wptMetricsStorer.GetSavedSearchReferences(ctx, req.SearchId, userID)
+	if err != nil {
+		if errors.Is(err, backendtypes.ErrEntityDoesNotExist) {
+			return backend.GetSavedSearchReferences404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: errMsgSavedSearchNotFound,
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to get saved search references", "error", err)
+
+		return backend.GetSavedSearchReferences500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to get saved search references",
+		}, nil
+	}
+
+	return backend.GetSavedSearchReferences200JSONResponse(*references), nil
+}
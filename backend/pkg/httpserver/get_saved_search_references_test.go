@@ -0,0 +1,139 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestGetSavedSearchReferences(t *testing.T) {
+	testCases := []basicHTTPTestCase[MockGetSavedSearchReferencesConfig]{
+		{
+			name: "success",
+			cfg: &MockGetSavedSearchReferencesConfig{
+				expectedSavedSearchID: "saved-search-id",
+				expectedUserID:        new("test-user"),
+				output: &backend.SavedSearchReferences{
+					Inbound: []backend.SavedSearchReference{
+						{
+							Id:     "parent",
+							Name:   new("Parent"),
+							Kind:   backend.SavedSearchReferenceKindSavedSearch,
+							Depth:  1,
+							Exists: true,
+						},
+					},
+					Outbound: []backend.SavedSearchReference{
+						{
+							Id:     "top",
+							Name:   new("Top"),
+							Kind:   backend.SavedSearchReferenceKindHotlist,
+							Depth:  1,
+							Exists: true,
+						},
+						{
+							Id:     "missing",
+							Name:   nil,
+							Kind:   backend.SavedSearchReferenceKindSavedSearch,
+							Depth:  2,
+							Exists: false,
+						},
+					},
+				},
+				err: nil,
+			},
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodGet,
+				"/v1/saved-searches/saved-search-id/references",
+				nil,
+			),
+			expectedResponse: testJSONResponse(200,
+				`{
+					"inbound":[
+						{"id":"parent","name":"Parent","kind":"saved_search","depth":1,"exists":true}
+					],
+					"outbound":[
+						{"id":"top","name":"Top","kind":"hotlist","depth":1,"exists":true},
+						{"id":"missing","kind":"saved_search","depth":2,"exists":false}
+					]
+				}`,
+			),
+		},
+		{
+			name: "not found",
+			cfg: &MockGetSavedSearchReferencesConfig{
+				expectedSavedSearchID: "saved-search-id",
+				expectedUserID:        nil,
+				output:                nil,
+				err:                   backendtypes.ErrEntityDoesNotExist,
+			},
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodGet,
+				"/v1/saved-searches/saved-search-id/references",
+				nil,
+			),
+			expectedResponse: testJSONResponse(404,
+				`{
+					"code":404,
+					"message":"saved search not found"
+				}`,
+			),
+		},
+		{
+			name: "general error",
+			cfg: &MockGetSavedSearchReferencesConfig{
+				expectedSavedSearchID: "saved-search-id",
+				expectedUserID:        nil,
+				output:                nil,
+				err:                   errTest,
+			},
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodGet,
+				"/v1/saved-searches/saved-search-id/references",
+				nil,
+			),
+			expectedResponse: testJSONResponse(500,
+				`{
+					"code":500,
+					"message":"unable to get saved search references"
+				}`,
+			),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct // WONTFIX
+			mockStorer := &MockWPTMetricsStorer{
+				getSavedSearchReferencesCfg: tc.cfg,
+				t:                           t,
+			}
+			var user *auth.User
+			if tc.cfg.expectedUserID != nil {
+				user = &auth.User{ID: *tc.cfg.expectedUserID, GitHubUserID: nil, TokenScope: nil}
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			assertTestServerRequest(t, myServer, tc.request, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(user)))
+			assertMocksExpectations(t, 1, mockStorer.callCountGetSavedSearchReferences,
+				"GetSavedSearchReferences", nil)
+		})
+	}
+}
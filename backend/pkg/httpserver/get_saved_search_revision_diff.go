@@ -0,0 +1,80 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+var errSavedSearchInvalidRevisionNumber = errors.New("revision numbers start at 1")
+
+// GetSavedSearchRevisionDiff handles the GET request to
+// /v1/saved-searches/{search_id}/revisions/{revision_number}/diff.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) GetSavedSearchRevisionDiff(
+	ctx context.Context,
+	request backend.GetSavedSearchRevisionDiffRequestObject,
+) (backend.GetSavedSearchRevisionDiffResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "GetSavedSearchRevisionDiff",
+		func(code int, message string) backend.GetSavedSearchRevisionDiff500JSONResponse {
+			return backend.GetSavedSearchRevisionDiff500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	if request.RevisionNumber < 1 || (request.Params.BaseRevision != nil && *request.Params.BaseRevision < 1) {
+		return backend.GetSavedSearchRevisionDiff400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: errSavedSearchInvalidRevisionNumber.Error(),
+		}, nil
+	}
+
+	diff, err := s.wptMetricsStorer.GetSavedSearchRevisionDiff(ctx, userCheckResult.User.ID, request.SearchId,
+		request.RevisionNumber, request.Params.BaseRevision)
+	if err != nil {
+		switch {
+		case errors.Is(err, backendtypes.ErrEntityDoesNotExist):
+			return backend.GetSavedSearchRevisionDiff404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: "revision not found",
+			}, nil
+		case errors.Is(err, backendtypes.ErrUserNotAuthorizedForAction):
+			return backend.GetSavedSearchRevisionDiff403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "forbidden",
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to diff saved search revisions", "error", err,
+			"searchID", request.SearchId, "revision", request.RevisionNumber)
+
+		return backend.GetSavedSearchRevisionDiff500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to compare revisions",
+		}, nil
+	}
+
+	return backend.GetSavedSearchRevisionDiff200JSONResponse(*diff), nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestGetSavedSearchRevisionDiff(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	errCfg := func(err error) *MockGetSavedSearchRevisionDiffConfig {
+		return &MockGetSavedSearchRevisionDiffConfig{
+			expectedUserID:             "test-user",
+			expectedSavedSearchID:      "search-id",
+			expectedRevisionNumber:     3,
+			expectedBaseRevisionNumber: nil,
+			output:                     nil,
+			err:                        err,
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		cfg               *MockGetSavedSearchRevisionDiffConfig
+		expectedCallCount int
+		path              string
+		expectedResponse  *http.Response
+	}{
+		{
+			name: "success",
+			cfg: &MockGetSavedSearchRevisionDiffConfig{
+				expectedUserID:             "test-user",
+				expectedSavedSearchID:      "search-id",
+				expectedRevisionNumber:     3,
+				expectedBaseRevisionNumber: new(int64(1)),
+				output: &backend.SavedSearchRevisionDiff{
+					BaseRevision: new(int64(1)),
+					Revision:     3,
+					Name:         nil,
+					Query: &backend.SavedSearchRevisionFieldChange{
+						OldValue: new("group:css"),
+						NewValue: new("group:html"),
+					},
+					Description: &backend.SavedSearchRevisionFieldChange{
+						OldValue: nil,
+						NewValue: new("description"),
+					},
+				},
+				err: nil,
+			},
+			expectedCallCount: 1,
+			path:              "/v1/saved-searches/search-id/revisions/3/diff?base_revision=1",
+			expectedResponse: testJSONResponse(http.StatusOK, `{
+				"base_revision":1,
+				"revision":3,
+				"query":{"old_value":"group:css","new_value":"group:html"},
+				"description":{"new_value":"description"}
+			}`),
+		},
+		{
+			name:              "bad request - invalid base revision",
+			cfg:               nil,
+			expectedCallCount: 0,
+			path:              "/v1/saved-searches/search-id/revisions/3/diff?base_revision=0",
+			expectedResponse: testJSONResponse(http.StatusBadRequest,
+				`{"code":400,"message":"`+errSavedSearchInvalidRevisionNumber.Error()+`"}`),
+		},
+		{
+			name:              "forbidden",
+			cfg:               errCfg(backendtypes.ErrUserNotAuthorizedForAction),
+			expectedCallCount: 1,
+			path:              "/v1/saved-searches/search-id/revisions/3/diff",
+			expectedResponse:  testJSONResponse(http.StatusForbidden, `{"code":403,"message":"forbidden"}`),
+		},
+		{
+			name:              "not found",
+			cfg:               errCfg(backendtypes.ErrEntityDoesNotExist),
+			expectedCallCount: 1,
+			path:              "/v1/saved-searches/search-id/revisions/3/diff",
+			expectedResponse: testJSONResponse(http.StatusNotFound,
+				`{"code":404,"message":"revision not found"}`),
+		},
+		{
+			name:              "internal server error",
+			cfg:               errCfg(errors.New("database error")),
+			expectedCallCount: 1,
+			path:              "/v1/saved-searches/search-id/revisions/3/diff",
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to compare revisions"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				getSavedSearchRevisionDiffCfg: tc.cfg,
+				t:                             t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, tc.path, nil)
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountGetSavedSearchRevisionDiff,
+				"GetSavedSearchRevisionDiff", nil)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// GetSavedSearchSnapshot handles the GET request to /v1/saved-searches/{search_id}/snapshots/{snapshot_id}.
+// The snapshot ID "latest" returns the most recent snapshot of the requested type, or the most recent one
+// stored at or before the at parameter when it is set.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) GetSavedSearchSnapshot(
+	ctx context.Context,
+	request backend.GetSavedSearchSnapshotRequestObject,
+) (backend.GetSavedSearchSnapshotResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "GetSavedSearchSnapshot",
+		func(code int, message string) backend.GetSavedSearchSnapshot500JSONResponse {
+			return backend.GetSavedSearchSnapshot500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	if request.Params.At != nil && request.SnapshotId != gcpspanner.LatestSavedSearchSnapshotID {
+		return backend.GetSavedSearchSnapshot400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: "at is only allowed with the latest snapshot",
+		}, nil
+	}
+
+	snapshot, err := s.getSavedSearchSnapshot(ctx, userCheckResult.User.ID, request)
+	if err != nil {
+		switch {
+		case errors.Is(err, backendtypes.ErrEntityDoesNotExist):
+			return backend.GetSavedSearchSnapshot404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: errMsgSnapshotNotFound,
+			}, nil
+		case errors.Is(err, backendtypes.ErrUserNotAuthorizedForAction):
+			return backend.GetSavedSearchSnapshot403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "forbidden",
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to get saved search snapshot", "error", err,
+			"searchID", request.SearchId, "snapshotID", request.SnapshotId)
+
+		return backend.GetSavedSearchSnapshot500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to get snapshot",
+		}, nil
+	}
+
+	return backend.GetSavedSearchSnapshot200JSONResponse(*snapshot), nil
+}
+
+// getSavedSearchSnapshot resolves the snapshot of the request and reads its contents.
+func (s *Server) getSavedSearchSnapshot(ctx context.Context, userID string,
+	request backend.GetSavedSearchSnapshotRequestObject) (*backend.SavedSearchSnapshot, error) {
+	ref, err := s.wptMetricsStorer.GetSavedSearchSnapshotRef(
+		ctx, userID, request.SearchId, request.SnapshotId, getSnapshotTypeOrDefault(request.Params.SnapshotType),
+		request.Params.At)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.snapshotReader.GetSavedSearchSnapshot(ctx, *ref)
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// GetSavedSearchSnapshotDiff handles the GET request to
+// /v1/saved-searches/{search_id}/snapshots/{snapshot_id}/diff.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) GetSavedSearchSnapshotDiff(
+	ctx context.Context,
+	request backend.GetSavedSearchSnapshotDiffRequestObject,
+) (backend.GetSavedSearchSnapshotDiffResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "GetSavedSearchSnapshotDiff",
+		func(code int, message string) backend.GetSavedSearchSnapshotDiff500JSONResponse {
+			return backend.GetSavedSearchSnapshotDiff500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	diff, err := s.diffSavedSearchSnapshots(ctx, userCheckResult.User.ID, request)
+	if err != nil {
+		switch {
+		case errors.Is(err, backendtypes.ErrEntityDoesNotExist):
+			return backend.GetSavedSearchSnapshotDiff404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: errMsgSnapshotNotFound,
+			}, nil
+		case errors.Is(err, backendtypes.ErrUserNotAuthorizedForAction):
+			return backend.GetSavedSearchSnapshotDiff403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "forbidden",
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to diff saved search snapshots", "error", err,
+			"searchID", request.SearchId, "snapshotID", request.SnapshotId,
+			"baseSnapshotID", request.Params.BaseSnapshot)
+
+		return backend.GetSavedSearchSnapshotDiff500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to diff snapshots",
+		}, nil
+	}
+
+	return backend.GetSavedSearchSnapshotDiff200JSONResponse(*diff), nil
+}
+
+// diffSavedSearchSnapshots resolves both snapshots of the request and compares them.
+func (s *Server) diffSavedSearchSnapshots(ctx context.Context, userID string,
+	request backend.GetSavedSearchSnapshotDiffRequestObject) (*backend.SavedSearchSnapshotDiff, error) {
+	snapshotType := getSnapshotTypeOrDefault(request.Params.SnapshotType)
+	base, err := s.wptMetricsStorer.GetSavedSearchSnapshotRef(
+		ctx, userID, request.SearchId, request.Params.BaseSnapshot, snapshotType, nil)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.wptMetricsStorer.GetSavedSearchSnapshotRef(
+		ctx, userID, request.SearchId, request.SnapshotId, snapshotType, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.snapshotReader.DiffSavedSearchSnapshots(ctx, *base, *target)
+}
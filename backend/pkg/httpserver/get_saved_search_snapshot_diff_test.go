@@ -0,0 +1,163 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestGetSavedSearchSnapshotDiff(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	baseRef := &backendtypes.SavedSearchSnapshotRef{
+		ID:           "event-1",
+		SnapshotType: backend.SubscriptionFrequencyMonthly,
+		BlobPath:     "path/1",
+	}
+	targetRef := &backendtypes.SavedSearchSnapshotRef{
+		ID:           "event-2",
+		SnapshotType: backend.SubscriptionFrequencyMonthly,
+		BlobPath:     "path/2",
+	}
+	refCfg := func(err error) *MockGetSavedSearchSnapshotRefConfig {
+		return &MockGetSavedSearchSnapshotRefConfig{
+			expectedUserID:        "test-user",
+			expectedSavedSearchID: "search-id",
+			expectedSnapshotType:  backend.SubscriptionFrequencyMonthly,
+			expectedAt:            nil,
+			outputs: map[string]*backendtypes.SavedSearchSnapshotRef{
+				"event-1": baseRef,
+				"event-2": targetRef,
+			},
+			err: err,
+		}
+	}
+	diffCfg := func(output *backend.SavedSearchSnapshotDiff, err error) *MockDiffSavedSearchSnapshotsConfig {
+		return &MockDiffSavedSearchSnapshotsConfig{
+			expectedBase:   *baseRef,
+			expectedTarget: *targetRef,
+			output:         output,
+			err:            err,
+		}
+	}
+	defaultURL := "/v1/saved-searches/search-id/snapshots/event-2/diff?base_snapshot=event-1&snapshot_type=monthly"
+
+	testCases := []struct {
+		name                    string
+		refCfg                  *MockGetSavedSearchSnapshotRefConfig
+		diffCfg                 *MockDiffSavedSearchSnapshotsConfig
+		url                     string
+		expectedRefCallCount    int
+		expectedReaderCallCount int
+		expectedResponse        *http.Response
+	}{
+		{
+			name:   "success",
+			refCfg: refCfg(nil),
+			diffCfg: diffCfg(&backend.SavedSearchSnapshotDiff{
+				BaseSnapshotId: "event-1",
+				SnapshotId:     "event-2",
+				SnapshotType:   backend.SubscriptionFrequencyMonthly,
+				Added: []backend.SavedSearchSnapshotFeatureReference{
+					{FeatureId: "anchor", Name: "Anchor positioning"},
+				},
+				Removed: []backend.SavedSearchSnapshotFeatureReference{},
+				Modified: []backend.SavedSearchSnapshotFeatureChange{
+					{
+						FeatureId:              "grid",
+						Name:                   "CSS Grid",
+						PreviousName:           new("Grid"),
+						Baseline:               nil,
+						BrowserImplementations: nil,
+						DocsChanged:            nil,
+					},
+				},
+			}, nil),
+			url:                     defaultURL,
+			expectedRefCallCount:    2,
+			expectedReaderCallCount: 1,
+			expectedResponse: testJSONResponse(http.StatusOK, `{
+				"base_snapshot_id":"event-1",
+				"snapshot_id":"event-2",
+				"snapshot_type":"monthly",
+				"added":[{"feature_id":"anchor","name":"Anchor positioning"}],
+				"removed":[],
+				"modified":[{"feature_id":"grid","name":"CSS Grid","previous_name":"Grid"}]
+			}`),
+		},
+		{
+			name:                    "not found - unknown base snapshot",
+			refCfg:                  refCfg(nil),
+			diffCfg:                 nil,
+			url:                     "/v1/saved-searches/search-id/snapshots/event-2/diff?base_snapshot=x&snapshot_type=monthly",
+			expectedRefCallCount:    1,
+			expectedReaderCallCount: 0,
+			expectedResponse: testJSONResponse(http.StatusNotFound,
+				`{"code":404,"message":"snapshot not found"}`),
+		},
+		{
+			name:                    "forbidden",
+			refCfg:                  refCfg(backendtypes.ErrUserNotAuthorizedForAction),
+			diffCfg:                 nil,
+			url:                     defaultURL,
+			expectedRefCallCount:    1,
+			expectedReaderCallCount: 0,
+			expectedResponse:        testJSONResponse(http.StatusForbidden, `{"code":403,"message":"forbidden"}`),
+		},
+		{
+			name:                    "internal server error",
+			refCfg:                  refCfg(nil),
+			diffCfg:                 diffCfg(nil, errors.New("storage error")),
+			url:                     defaultURL,
+			expectedRefCallCount:    2,
+			expectedReaderCallCount: 1,
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to diff snapshots"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				getSavedSearchSnapshotRefCfg: tc.refCfg,
+				t:                            t,
+			}
+			//nolint:exhaustruct
+			mockReader := &MockSavedSearchSnapshotReader{
+				diffSavedSearchSnapshotsCfg: tc.diffCfg,
+				t:                           t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer), withCustomSnapshotReader(mockReader))
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, tc.url, nil)
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, tc.expectedRefCallCount, mockStorer.callCountGetSavedSearchSnapshotRef,
+				"GetSavedSearchSnapshotRef", nil)
+			assertMocksExpectations(t, tc.expectedReaderCallCount, mockReader.callCountDiffSavedSearchSnapshots,
+				"DiffSavedSearchSnapshots", nil)
+		})
+	}
+}
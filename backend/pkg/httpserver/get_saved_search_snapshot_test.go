@@ -0,0 +1,203 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestGetSavedSearchSnapshot(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	latestRef := &backendtypes.SavedSearchSnapshotRef{
+		ID:           "latest",
+		SnapshotType: backend.SubscriptionFrequencyImmediate,
+		BlobPath:     "path/latest",
+	}
+	at := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	refCfg := func(expectedAt *time.Time, err error) *MockGetSavedSearchSnapshotRefConfig {
+		return &MockGetSavedSearchSnapshotRefConfig{
+			expectedUserID:        "test-user",
+			expectedSavedSearchID: "search-id",
+			expectedSnapshotType:  backend.SubscriptionFrequencyImmediate,
+			expectedAt:            expectedAt,
+			outputs:               map[string]*backendtypes.SavedSearchSnapshotRef{"latest": latestRef},
+			err:                   err,
+		}
+	}
+	readerCfg := func(output *backend.SavedSearchSnapshot, err error) *MockGetSavedSearchSnapshotConfig {
+		return &MockGetSavedSearchSnapshotConfig{
+			expectedRef: *latestRef,
+			output:      output,
+			err:         err,
+		}
+	}
+
+	latestURL := "/v1/saved-searches/search-id/snapshots/latest"
+
+	testCases := []struct {
+		name                    string
+		url                     string
+		refCfg                  *MockGetSavedSearchSnapshotRefConfig
+		readerCfg               *MockGetSavedSearchSnapshotConfig
+		expectedRefCallCount    int
+		expectedReaderCallCount int
+		expectedResponse        *http.Response
+	}{
+		{
+			name:   "success",
+			url:    latestURL,
+			refCfg: refCfg(nil, nil),
+			readerCfg: readerCfg(&backend.SavedSearchSnapshot{
+				Id:             "latest",
+				SnapshotType:   backend.SubscriptionFrequencyImmediate,
+				GeneratedAt:    time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+				Query:          "group:css",
+				HasQueryErrors: nil,
+				Features: []backend.SavedSearchSnapshotFeature{
+					{
+						FeatureId: "grid",
+						Name:      "Grid",
+						Baseline: &backend.BaselineInfo{
+							Status:   new(backend.Widely),
+							LowDate:  nil,
+							HighDate: nil,
+						},
+						BrowserImplementations: nil,
+					},
+				},
+			}, nil),
+			expectedRefCallCount:    1,
+			expectedReaderCallCount: 1,
+			expectedResponse: testJSONResponse(http.StatusOK, `{
+				"id":"latest",
+				"snapshot_type":"immediate",
+				"generated_at":"2026-01-01T00:00:00Z",
+				"query":"group:css",
+				"features":[{
+					"feature_id":"grid",
+					"name":"Grid",
+					"baseline":{"status":"widely"}
+				}]
+			}`),
+		},
+		{
+			name:   "success - at a time",
+			url:    latestURL + "?at=2026-01-01T00:00:00Z",
+			refCfg: refCfg(&at, nil),
+			readerCfg: readerCfg(&backend.SavedSearchSnapshot{
+				Id:             "latest",
+				SnapshotType:   backend.SubscriptionFrequencyImmediate,
+				GeneratedAt:    at,
+				Query:          "group:css",
+				HasQueryErrors: nil,
+				Features:       []backend.SavedSearchSnapshotFeature{},
+			}, nil),
+			expectedRefCallCount:    1,
+			expectedReaderCallCount: 1,
+			expectedResponse: testJSONResponse(http.StatusOK, `{
+				"id":"latest",
+				"snapshot_type":"immediate",
+				"generated_at":"2026-01-01T00:00:00Z",
+				"query":"group:css",
+				"features":[]
+			}`),
+		},
+		{
+			name:                    "bad request - at with a snapshot id",
+			url:                     "/v1/saved-searches/search-id/snapshots/event-1?at=2026-01-01T00:00:00Z",
+			refCfg:                  nil,
+			readerCfg:               nil,
+			expectedRefCallCount:    0,
+			expectedReaderCallCount: 0,
+			expectedResponse: testJSONResponse(http.StatusBadRequest,
+				`{"code":400,"message":"at is only allowed with the latest snapshot"}`),
+		},
+		{
+			name:                    "forbidden",
+			url:                     latestURL,
+			refCfg:                  refCfg(nil, backendtypes.ErrUserNotAuthorizedForAction),
+			readerCfg:               nil,
+			expectedRefCallCount:    1,
+			expectedReaderCallCount: 0,
+			expectedResponse:        testJSONResponse(http.StatusForbidden, `{"code":403,"message":"forbidden"}`),
+		},
+		{
+			name:                    "not found - unknown snapshot",
+			url:                     latestURL,
+			refCfg:                  refCfg(nil, backendtypes.ErrEntityDoesNotExist),
+			readerCfg:               nil,
+			expectedRefCallCount:    1,
+			expectedReaderCallCount: 0,
+			expectedResponse: testJSONResponse(http.StatusNotFound,
+				`{"code":404,"message":"snapshot not found"}`),
+		},
+		{
+			name:                    "not found - missing blob",
+			url:                     latestURL,
+			refCfg:                  refCfg(nil, nil),
+			readerCfg:               readerCfg(nil, backendtypes.ErrEntityDoesNotExist),
+			expectedRefCallCount:    1,
+			expectedReaderCallCount: 1,
+			expectedResponse: testJSONResponse(http.StatusNotFound,
+				`{"code":404,"message":"snapshot not found"}`),
+		},
+		{
+			name:                    "internal server error",
+			url:                     latestURL,
+			refCfg:                  refCfg(nil, nil),
+			readerCfg:               readerCfg(nil, errors.New("storage error")),
+			expectedRefCallCount:    1,
+			expectedReaderCallCount: 1,
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to get snapshot"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				getSavedSearchSnapshotRefCfg: tc.refCfg,
+				t:                            t,
+			}
+			//nolint:exhaustruct
+			mockReader := &MockSavedSearchSnapshotReader{
+				getSavedSearchSnapshotCfg: tc.readerCfg,
+				t:                         t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer), withCustomSnapshotReader(mockReader))
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+				tc.url, nil)
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, tc.expectedRefCallCount, mockStorer.callCountGetSavedSearchSnapshotRef,
+				"GetSavedSearchSnapshotRef", nil)
+			assertMocksExpectations(t, tc.expectedReaderCallCount, mockReader.callCountGetSavedSearchSnapshot,
+				"GetSavedSearchSnapshot", nil)
+		})
+	}
+}
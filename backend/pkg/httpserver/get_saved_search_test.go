@@ -48,6 +48,10 @@ func TestGetSavedSearch(t *testing.T) {
 					Description:    new("test description"),
 					BookmarkStatus: nil,
 					Permissions:    nil,
+					ForkedFrom:     nil,
+					Tags:           nil,
+					Listed:         nil,
+					Parameters:     nil,
 				},
 				err: nil,
 			},
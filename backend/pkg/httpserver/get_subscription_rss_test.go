@@ -75,6 +75,10 @@ func TestGetSubscriptionRSS(t *testing.T) {
 					Description:    nil,
 					Permissions:    nil,
 					UpdatedAt:      time.Time{},
+					ForkedFrom:     nil,
+					Tags:           nil,
+					Listed:         nil,
+					Parameters:     nil,
 				},
 				err: nil,
 			},
@@ -154,6 +158,10 @@ func TestGetSubscriptionRSS(t *testing.T) {
 					Description:    nil,
 					Permissions:    nil,
 					UpdatedAt:      time.Time{},
+					ForkedFrom:     nil,
+					Tags:           nil,
+					Listed:         nil,
+					Parameters:     nil,
 				},
 				err: nil,
 			},
@@ -229,6 +237,10 @@ func TestGetSubscriptionRSS(t *testing.T) {
 					Description:    nil,
 					Permissions:    nil,
 					UpdatedAt:      time.Time{},
+					ForkedFrom:     nil,
+					Tags:           nil,
+					Listed:         nil,
+					Parameters:     nil,
 				},
 				err: nil,
 			},
@@ -299,6 +311,10 @@ func TestGetSubscriptionRSS(t *testing.T) {
 					Description:    nil,
 					Permissions:    nil,
 					UpdatedAt:      time.Time{},
+					ForkedFrom:     nil,
+					Tags:           nil,
+					Listed:         nil,
+					Parameters:     nil,
 				},
 				err: nil,
 			},
@@ -369,6 +385,10 @@ func TestGetSubscriptionRSS(t *testing.T) {
 					Description:    nil,
 					Permissions:    nil,
 					UpdatedAt:      time.Time{},
+					ForkedFrom:     nil,
+					Tags:           nil,
+					Listed:         nil,
+					Parameters:     nil,
 				},
 				err: nil,
 			},
@@ -449,7 +469,7 @@ func TestGetSubscriptionRSS(t *testing.T) {
 			)
 
 			// Fix createOpenAPIServerServer call
-			srv := createOpenAPIServerServer("", myServer, nil, noopMiddleware)
+			srv := createOpenAPIServerServer("", myServer, nil, noopMiddleware, nil, nil)
 
 			w := httptest.NewRecorder()
 
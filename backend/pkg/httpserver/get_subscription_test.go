@@ -30,6 +30,7 @@ func TestGetSubscription(t *testing.T) {
 	testUser := &auth.User{
 		ID:           "test-user",
 		GitHubUserID: nil,
+		TokenScope:   nil,
 	}
 
 	testCases := []struct {
@@ -0,0 +1,58 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// hotlistMaxFeatures mirrors the limit enforced by the database layer.
+	hotlistMaxFeatures = 500
+)
+
+var (
+	errHotlistTooManyFeatures  = fmt.Errorf("a hotlist can contain at most %d features", hotlistMaxFeatures)
+	errHotlistDuplicateFeature = errors.New("feature_ids must not contain duplicates")
+	errHotlistEmptyFeatureID   = errors.New("feature_ids must not contain empty values")
+	errHotlistInvalidPosition  = errors.New("position must not be negative")
+	errHotlistUnknownFeatures  = errors.New("feature_ids must only contain existing features")
+	errHotlistNotPermutation   = errors.New("feature_ids must contain exactly the current features of the hotlist")
+	errHotlistFeatureRejected  = errors.New("the feature does not exist or the hotlist is full")
+)
+
+// validateHotlistFeatureIDs checks that the feature IDs can be stored in a hotlist.
+func validateHotlistFeatureIDs(featureIDs []string, fieldErrors *fieldValidationErrors) {
+	if len(featureIDs) > hotlistMaxFeatures {
+		fieldErrors.addFieldError("feature_ids", errHotlistTooManyFeatures)
+
+		return
+	}
+	seen := make(map[string]struct{}, len(featureIDs))
+	for _, id := range featureIDs {
+		if id == "" {
+			fieldErrors.addFieldError("feature_ids", errHotlistEmptyFeatureID)
+
+			return
+		}
+		if _, found := seen[id]; found {
+			fieldErrors.addFieldError("feature_ids", errHotlistDuplicateFeature)
+
+			return
+		}
+		seen[id] = struct{}{}
+	}
+}
@@ -0,0 +1,404 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+	"github.com/GoogleChrome/webstatus.dev/lib/httputils"
+)
+
+// userDataImportMaxItems bounds the number of items in an imported document.
+const userDataImportMaxItems = 1000
+
+var (
+	errUserDataUnsupportedVersion = fmt.Errorf("only version %d documents can be imported",
+		backendtypes.UserDataExportVersion)
+	errUserDataTooManyItems = fmt.Errorf("a document can contain at most %d items",
+		userDataImportMaxItems)
+	errUserDataDuplicateID           = errors.New("id must be unique")
+	errUserDataInvalidChannelType    = errors.New("type must be one of the following: email, rss, webhook")
+	errUserDataEmailAddressRequired  = errors.New("email_address is required for email channels")
+	errUserDataUnknownChannel        = errors.New("channel_id must be the id of a notification channel of the document")
+	errUserDataDuplicateSubscription = errors.New("the channel is subscribed to the saved search more than once")
+	errUserDataSavedSearchNotFound   = errors.New("the saved search does not exist")
+	errUserDataEmailChannelNotFound  = errors.New(
+		"no email channel with this address exists. Sign in with the email address first")
+	errUserDataSubscriptionConflict = errors.New(
+		"the channel is already subscribed to the saved search with a different configuration")
+	errUserDataWebhookURLNotExported = errors.New(
+		"webhook URLs are not exported. Add the channel again with its webhook URL")
+	errUserDataChannelSkipped = errors.New("the notification channel was skipped")
+)
+
+type userDataImportItemKey struct {
+	kind     backend.UserDataImportItemResultKind
+	sourceID string
+}
+
+// userDataImportFailures maps the items of a document that cannot be imported to the reason why.
+type userDataImportFailures map[userDataImportItemKey]string
+
+func (f userDataImportFailures) add(
+	kind backend.UserDataImportItemResultKind, sourceID string, fieldErrors *fieldValidationErrors) {
+	if !fieldErrors.hasErrors() {
+		return
+	}
+	fields := make([]string, 0, len(fieldErrors.fieldErrorMap))
+	for field := range fieldErrors.fieldErrorMap {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+	messages := make([]string, 0, len(fields))
+	for _, field := range fields {
+		messages = append(messages, field+": "+fieldErrors.fieldErrorMap[field])
+	}
+	f[userDataImportItemKey{kind: kind, sourceID: sourceID}] = strings.Join(messages, "; ")
+}
+
+// validateUserDataImport validates every item of the document, including the references of the saved search queries.
+func (s *Server) validateUserDataImport(
+	ctx context.Context, data *backend.UserDataExport) (userDataImportFailures, error) {
+	failures := userDataImportFailures{}
+
+	seen := map[string]bool{}
+	for _, search := range data.SavedSearches {
+		fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+		if seen[search.Id] {
+			fieldErrors.addFieldError("id", errUserDataDuplicateID)
+		}
+		seen[search.Id] = true
+		validateSavedSearchName(&search.Name, fieldErrors)
+		validateSavedSearchDescription(search.Description, fieldErrors)
+		validateSavedSearchTags(search.Tags, fieldErrors)
+		if search.HotlistFeatureIds != nil {
+			// The query of a hotlist is derived from its new ID.
+			validateHotlistFeatureIDs(*search.HotlistFeatureIds, fieldErrors)
+		} else {
+			validateSavedSearchQuery(&search.Query, search.Parameters, fieldErrors)
+			validateSavedSearchParameters(search.Parameters, fieldErrors)
+			if _, invalidQuery := fieldErrors.fieldErrorMap["query"]; !invalidQuery {
+				err := s.wptMetricsStorer.ValidateQueryReferences(ctx, search.Query, search.Parameters, nil)
+				if err != nil {
+					safeErr := sanitizeValidationError(err)
+					if safeErr == nil {
+						return nil, err
+					}
+					fieldErrors.addFieldError("query", safeErr)
+				}
+			}
+		}
+		failures.add(backend.UserDataImportItemKindSavedSearch, search.Id, fieldErrors)
+	}
+
+	seen = map[string]bool{}
+	for _, bookmark := range data.Bookmarks {
+		fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+		if seen[bookmark.SavedSearchId] {
+			fieldErrors.addFieldError("saved_search_id", errUserDataDuplicateID)
+		}
+		seen[bookmark.SavedSearchId] = true
+		validateSubscriptionSavedSearchID(bookmark.SavedSearchId, fieldErrors)
+		failures.add(backend.UserDataImportItemKindBookmark, bookmark.SavedSearchId, fieldErrors)
+	}
+
+	channelIDs := map[string]bool{}
+	for _, channel := range data.NotificationChannels {
+		fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+		if channelIDs[channel.Id] {
+			fieldErrors.addFieldError("id", errUserDataDuplicateID)
+		}
+		channelIDs[channel.Id] = true
+		if len(channel.Name) < notificationChannelNameMinLength || len(channel.Name) > notificationChannelNameMaxLength {
+			fieldErrors.addFieldError("name", errNotificationChannelInvalidNameLength)
+		}
+		switch channel.Type {
+		case backend.UserDataNotificationChannelTypeEmail:
+			if channel.EmailAddress == nil || *channel.EmailAddress == "" {
+				fieldErrors.addFieldError("email_address", errUserDataEmailAddressRequired)
+			}
+		case backend.UserDataNotificationChannelTypeWebhook:
+			// Webhook channels without a URL were skipped by skipUserDataWebhookChannelsWithoutURL.
+			if channel.WebhookUrl != nil {
+				if err := httputils.ValidateSlackWebhookURL(*channel.WebhookUrl); err != nil {
+					fieldErrors.addFieldError("webhook_url", err)
+				}
+			}
+		case backend.UserDataNotificationChannelTypeRSS:
+			break
+		default:
+			fieldErrors.addFieldError("type", errUserDataInvalidChannelType)
+		}
+		failures.add(backend.UserDataImportItemKindNotificationChannel, channel.Id, fieldErrors)
+	}
+
+	seen = map[string]bool{}
+	subscribed := map[[2]string]bool{}
+	for _, sub := range data.Subscriptions {
+		fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+		if seen[sub.Id] {
+			fieldErrors.addFieldError("id", errUserDataDuplicateID)
+		}
+		seen[sub.Id] = true
+		validateSubscriptionSavedSearchID(sub.SavedSearchId, fieldErrors)
+		if !channelIDs[sub.ChannelId] {
+			fieldErrors.addFieldError("channel_id", errUserDataUnknownChannel)
+		}
+		pair := [2]string{sub.ChannelId, sub.SavedSearchId}
+		if subscribed[pair] {
+			fieldErrors.addFieldError("saved_search_id", errUserDataDuplicateSubscription)
+		}
+		subscribed[pair] = true
+		validateSubscriptionTrigger(&sub.Triggers, true, fieldErrors)
+		validateSubscriptionFrequency(&sub.Frequency, true, fieldErrors)
+		failures.add(backend.UserDataImportItemKindSubscription, sub.Id, fieldErrors)
+	}
+
+	return failures, nil
+}
+
+// skipUserDataWebhookChannelsWithoutURL removes the webhook channels without a webhook URL, and their
+// subscriptions, from the document. Exports never contain webhook URLs, so every webhook channel of an
+// unmodified export is skipped instead of failing the whole import.
+// It returns the remaining document and the results of the skipped items.
+func skipUserDataWebhookChannelsWithoutURL(
+	data backend.UserDataExport) (backend.UserDataExport, []backend.UserDataImportItemResult) {
+	var skipped []backend.UserDataImportItemResult
+	skip := func(kind backend.UserDataImportItemResultKind, sourceID string, field string, reason error) {
+		skipped = append(skipped, backend.UserDataImportItemResult{
+			Kind:     kind,
+			SourceId: sourceID,
+			Status:   backend.UserDataImportItemStatusSkipped,
+			Id:       nil,
+			Message:  new(field + ": " + reason.Error()),
+		})
+	}
+
+	skippedChannels := map[string]bool{}
+	channels := make([]backend.UserDataNotificationChannel, 0, len(data.NotificationChannels))
+	for _, channel := range data.NotificationChannels {
+		if channel.Type == backend.UserDataNotificationChannelTypeWebhook && channel.WebhookUrl == nil {
+			skippedChannels[channel.Id] = true
+			skip(backend.UserDataImportItemKindNotificationChannel, channel.Id, "webhook_url",
+				errUserDataWebhookURLNotExported)
+
+			continue
+		}
+		channels = append(channels, channel)
+	}
+	if len(skippedChannels) == 0 {
+		return data, nil
+	}
+
+	subs := make([]backend.UserDataSubscription, 0, len(data.Subscriptions))
+	for _, sub := range data.Subscriptions {
+		if skippedChannels[sub.ChannelId] {
+			skip(backend.UserDataImportItemKindSubscription, sub.Id, "channel_id", errUserDataChannelSkipped)
+
+			continue
+		}
+		subs = append(subs, sub)
+	}
+	data.NotificationChannels = channels
+	data.Subscriptions = subs
+
+	return data, skipped
+}
+
+// newUncommittedUserDataImportResult reports every item of a document that was not imported.
+func newUncommittedUserDataImportResult(
+	data *backend.UserDataExport, failures userDataImportFailures) backend.UserDataImportResult {
+	results := make([]backend.UserDataImportItemResult, 0,
+		len(data.SavedSearches)+len(data.Bookmarks)+len(data.NotificationChannels)+len(data.Subscriptions))
+	addResult := func(kind backend.UserDataImportItemResultKind, sourceID string) {
+		result := backend.UserDataImportItemResult{
+			Kind:     kind,
+			SourceId: sourceID,
+			Status:   backend.UserDataImportItemStatusSkipped,
+			Id:       nil,
+			Message:  nil,
+		}
+		if message, found := failures[userDataImportItemKey{kind: kind, sourceID: sourceID}]; found {
+			result.Status = backend.UserDataImportItemStatusFailed
+			result.Message = &message
+		}
+		results = append(results, result)
+	}
+	for _, search := range data.SavedSearches {
+		addResult(backend.UserDataImportItemKindSavedSearch, search.Id)
+	}
+	for _, bookmark := range data.Bookmarks {
+		addResult(backend.UserDataImportItemKindBookmark, bookmark.SavedSearchId)
+	}
+	for _, channel := range data.NotificationChannels {
+		addResult(backend.UserDataImportItemKindNotificationChannel, channel.Id)
+	}
+	for _, sub := range data.Subscriptions {
+		addResult(backend.UserDataImportItemKindSubscription, sub.Id)
+	}
+
+	return backend.UserDataImportResult{
+		Committed: false,
+		Results:   results,
+	}
+}
+
+// userDataImportItemErrorMessage returns the message to report for an item that failed to import.
+// It returns false if the error is not caused by the item.
+func userDataImportItemErrorMessage(err error) (string, bool) {
+	switch {
+	case errors.Is(err, backendtypes.ErrEntityDoesNotExist):
+		return "saved_search_id: " + errUserDataSavedSearchNotFound.Error(), true
+	case errors.Is(err, backendtypes.ErrUserDataImportEmailChannelNotFound):
+		return "email_address: " + errUserDataEmailChannelNotFound.Error(), true
+	case errors.Is(err, backendtypes.ErrSubscriptionConflict):
+		return "saved_search_id: " + errUserDataSubscriptionConflict.Error(), true
+	case errors.Is(err, backendtypes.ErrInvalidHotlistFeatures):
+		return "hotlist_feature_ids: " + errHotlistUnknownFeatures.Error(), true
+	}
+
+	return "", false
+}
+
+// ImportUserData handles the POST request to /v1/users/me:import.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) ImportUserData(
+	ctx context.Context,
+	request backend.ImportUserDataRequestObject,
+) (backend.ImportUserDataResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "ImportUserData",
+		func(code int, message string) backend.ImportUserData500JSONResponse {
+			return backend.ImportUserData500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	data := request.Body
+	if data.Version != backendtypes.UserDataExportVersion {
+		return backend.ImportUserData400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: errUserDataUnsupportedVersion.Error(),
+		}, nil
+	}
+	itemCount := len(data.SavedSearches) + len(data.Bookmarks) + len(data.NotificationChannels) +
+		len(data.Subscriptions)
+	if itemCount > userDataImportMaxItems {
+		return backend.ImportUserData400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: errUserDataTooManyItems.Error(),
+		}, nil
+	}
+
+	imported, skipped := skipUserDataWebhookChannelsWithoutURL(*data)
+	failures, err := s.validateUserDataImport(ctx, &imported)
+	if err != nil {
+		slog.ErrorContext(ctx, "unexpected error during user data validation", "error", err)
+
+		return backend.ImportUserData500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to import user data",
+		}, nil
+	}
+	uncommitted := func() backend.ImportUserData200JSONResponse {
+		result := newUncommittedUserDataImportResult(&imported, failures)
+		result.Results = append(result.Results, skipped...)
+
+		return backend.ImportUserData200JSONResponse(result)
+	}
+	if len(failures) > 0 {
+		return uncommitted(), nil
+	}
+
+	result, err := s.wptMetricsStorer.ImportUserData(ctx, userCheckResult.User.ID, imported)
+	if err != nil {
+		var itemErr *backendtypes.UserDataImportItemError
+		if errors.As(err, &itemErr) {
+			if message, ok := userDataImportItemErrorMessage(itemErr.Err); ok {
+				failures[userDataImportItemKey{kind: itemErr.Kind, sourceID: itemErr.SourceID}] = message
+
+				return uncommitted(), nil
+			}
+		}
+		switch {
+		case errors.Is(err, backendtypes.ErrUserMaxSavedSearches):
+			return backend.ImportUserData403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "user would exceed the maximum number of allowed saved searches",
+			}, nil
+		case errors.Is(err, backendtypes.ErrUserMaxBookmarks):
+			return backend.ImportUserData403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "user would exceed the maximum number of allowed bookmarks",
+			}, nil
+		case errors.Is(err, backendtypes.ErrUserMaxNotificationChannels):
+			return backend.ImportUserData403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "user would exceed the maximum number of allowed notification channels",
+			}, nil
+		case errors.Is(err, backendtypes.ErrUserMaxSubscriptions):
+			return backend.ImportUserData403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "user would exceed the maximum number of allowed subscriptions",
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to import user data", "error", err)
+
+		return backend.ImportUserData500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to import user data",
+		}, nil
+	}
+
+	s.publishImportedSavedSearches(ctx, userCheckResult.User.ID, result.Results)
+	result.Results = append(result.Results, skipped...)
+
+	return backend.ImportUserData200JSONResponse(*result), nil
+}
+
+// publishImportedSavedSearches publishes the creation of every saved search created by an import.
+// The import is already committed, so failures are only logged.
+func (s *Server) publishImportedSavedSearches(
+	ctx context.Context, userID string, results []backend.UserDataImportItemResult) {
+	for _, result := range results {
+		if result.Kind != backend.UserDataImportItemKindSavedSearch ||
+			result.Status != backend.UserDataImportItemStatusCreated || result.Id == nil {
+			continue
+		}
+		savedSearch, err := s.wptMetricsStorer.GetSavedSearch(ctx, *result.Id, &userID)
+		if err != nil {
+			slog.WarnContext(ctx, "unable to get imported saved search", "error", err, "searchID", *result.Id)
+
+			continue
+		}
+		err = s.eventPublisher.PublishSearchConfigurationChanged(ctx, savedSearch, userID, true)
+		if err != nil {
+			// We should not mark this as a failure. Only log it.
+			slog.WarnContext(ctx, "unable to publish search configuration changed event during import", "error", err)
+		}
+	}
+}
@@ -0,0 +1,507 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+	"github.com/GoogleChrome/webstatus.dev/lib/httpmiddlewares"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func TestImportUserData(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	body := `{
+		"version":1,
+		"exported_at":"2026-01-01T00:00:00Z",
+		"saved_searches":[
+			{"id":"hotlist-id","name":"Hotlist","query":"hotlist:hotlist-id","listed":false,"hotlist_feature_ids":["grid"]}
+		],
+		"bookmarks":[{"saved_search_id":"other-id"}],
+		"notification_channels":[
+			{"id":"channel-id","name":"Slack","type":"webhook","webhook_url":"https://hooks.slack.com/services/T/B/X"}
+		],
+		"subscriptions":[
+			{
+				"id":"sub-id",
+				"saved_search_id":"hotlist-id",
+				"channel_id":"channel-id",
+				"triggers":["feature_baseline_to_widely"],
+				"frequency":"weekly"
+			}
+		]
+	}`
+	expectedData := backend.UserDataExport{
+		Version:    1,
+		ExportedAt: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+		SavedSearches: []backend.UserDataSavedSearch{
+			{
+				Id:                "hotlist-id",
+				Name:              "Hotlist",
+				Description:       nil,
+				Query:             "hotlist:hotlist-id",
+				Tags:              nil,
+				Listed:            false,
+				Parameters:        nil,
+				HotlistFeatureIds: &[]string{"grid"},
+			},
+		},
+		Bookmarks: []backend.UserDataBookmark{{SavedSearchId: "other-id"}},
+		NotificationChannels: []backend.UserDataNotificationChannel{
+			{
+				Id:           "channel-id",
+				Name:         "Slack",
+				Type:         backend.UserDataNotificationChannelTypeWebhook,
+				EmailAddress: nil,
+				WebhookUrl:   new("https://hooks.slack.com/services/T/B/X"),
+			},
+		},
+		Subscriptions: []backend.UserDataSubscription{
+			{
+				Id:            "sub-id",
+				SavedSearchId: "hotlist-id",
+				ChannelId:     "channel-id",
+				Triggers: []backend.SubscriptionTriggerWritable{
+					backend.SubscriptionTriggerFeatureBaselineToWidely,
+				},
+				Frequency: backend.SubscriptionFrequencyWeekly,
+			},
+		},
+	}
+	importedSearch := &backend.SavedSearchResponse{
+		Id:             "new-hotlist-id",
+		Name:           "Hotlist",
+		Query:          "hotlist:new-hotlist-id",
+		Description:    nil,
+		Permissions:    nil,
+		BookmarkStatus: nil,
+		CreatedAt:      time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC),
+		ForkedFrom:     nil,
+		Tags:           nil,
+		Listed:         new(false),
+		Parameters:     nil,
+	}
+	successCfg := &MockImportUserDataConfig{
+		expectedUserID: "test-user",
+		expectedData:   expectedData,
+		output: &backend.UserDataImportResult{
+			Committed: true,
+			Results: []backend.UserDataImportItemResult{
+				{
+					Kind:     backend.UserDataImportItemKindSavedSearch,
+					SourceId: "hotlist-id",
+					Status:   backend.UserDataImportItemStatusCreated,
+					Id:       new("new-hotlist-id"),
+					Message:  nil,
+				},
+			},
+		},
+		err: nil,
+	}
+	successResponse := `{
+		"committed":true,
+		"results":[
+			{"kind":"saved_search","source_id":"hotlist-id","status":"created","id":"new-hotlist-id"}
+		]
+	}`
+	newRequest := func(body string) *http.Request {
+		return httptest.NewRequestWithContext(t.Context(), http.MethodPost, "/v1/users/me:import",
+			strings.NewReader(body))
+	}
+
+	testCases := []struct {
+		name                 string
+		cfg                  *MockImportUserDataConfig
+		expectedCallCount    int
+		publishErr           error
+		expectedPublishCalls int
+		request              *http.Request
+		expectedResponse     *http.Response
+	}{
+		{
+			name:                 "success",
+			cfg:                  successCfg,
+			expectedCallCount:    1,
+			publishErr:           nil,
+			expectedPublishCalls: 1,
+			request:              newRequest(body),
+			expectedResponse:     testJSONResponse(http.StatusOK, successResponse),
+		},
+		{
+			name:                 "publish failure does not fail the import",
+			cfg:                  successCfg,
+			expectedCallCount:    1,
+			publishErr:           errTest,
+			expectedPublishCalls: 1,
+			request:              newRequest(body),
+			expectedResponse:     testJSONResponse(http.StatusOK, successResponse),
+		},
+		{
+			name:                 "invalid items are reported and nothing is imported",
+			cfg:                  nil,
+			expectedCallCount:    0,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			request: newRequest(`{
+				"version":1,
+				"exported_at":"2026-01-01T00:00:00Z",
+				"saved_searches":[{"id":"search-id","name":"","query":"","listed":false}],
+				"bookmarks":[{"saved_search_id":"other-id"}],
+				"notification_channels":[{"id":"channel-id","name":"Slack","type":"webhook"}],
+				"subscriptions":[
+					{
+						"id":"sub-id",
+						"saved_search_id":"search-id",
+						"channel_id":"unknown-channel",
+						"triggers":[],
+						"frequency":"weekly"
+					}
+				]
+			}`),
+			expectedResponse: testJSONResponse(http.StatusOK, `{
+				"committed":false,
+				"results":[
+					{
+						"kind":"saved_search",
+						"source_id":"search-id",
+						"status":"failed",
+						"message":"name: `+errSavedSearchInvalidNameLength.Error()+
+				`; query: `+errSavedSearchInvalidQueryLength.Error()+`"
+					},
+					{"kind":"bookmark","source_id":"other-id","status":"skipped"},
+					{
+						"kind":"subscription",
+						"source_id":"sub-id",
+						"status":"failed",
+						"message":"channel_id: `+errUserDataUnknownChannel.Error()+`"
+					},
+					{
+						"kind":"notification_channel",
+						"source_id":"channel-id",
+						"status":"skipped",
+						"message":"webhook_url: `+errUserDataWebhookURLNotExported.Error()+`"
+					}
+				]
+			}`),
+		},
+		{
+			name: "item rejected by the storer",
+			cfg: &MockImportUserDataConfig{
+				expectedUserID: "test-user",
+				expectedData:   expectedData,
+				output:         nil,
+				err: &backendtypes.UserDataImportItemError{
+					Kind:     backend.UserDataImportItemKindBookmark,
+					SourceID: "other-id",
+					Err:      backendtypes.ErrEntityDoesNotExist,
+				},
+			},
+			expectedCallCount:    1,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			request:              newRequest(body),
+			expectedResponse: testJSONResponse(http.StatusOK, `{
+				"committed":false,
+				"results":[
+					{"kind":"saved_search","source_id":"hotlist-id","status":"skipped"},
+					{
+						"kind":"bookmark",
+						"source_id":"other-id",
+						"status":"failed",
+						"message":"saved_search_id: `+errUserDataSavedSearchNotFound.Error()+`"
+					},
+					{"kind":"notification_channel","source_id":"channel-id","status":"skipped"},
+					{"kind":"subscription","source_id":"sub-id","status":"skipped"}
+				]
+			}`),
+		},
+		{
+			name:                 "unsupported version",
+			cfg:                  nil,
+			expectedCallCount:    0,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			request: newRequest(`{"version":2,"exported_at":"2026-01-01T00:00:00Z","saved_searches":[],
+				"bookmarks":[],"notification_channels":[],"subscriptions":[]}`),
+			expectedResponse: testJSONResponse(http.StatusBadRequest,
+				`{"code":400,"message":"`+errUserDataUnsupportedVersion.Error()+`"}`),
+		},
+		{
+			name: "forbidden - user max subscriptions",
+			cfg: &MockImportUserDataConfig{
+				expectedUserID: "test-user",
+				expectedData:   expectedData,
+				output:         nil,
+				err:            backendtypes.ErrUserMaxSubscriptions,
+			},
+			expectedCallCount:    1,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			request:              newRequest(body),
+			expectedResponse: testJSONResponse(http.StatusForbidden,
+				`{"code":403,"message":"user would exceed the maximum number of allowed subscriptions"}`),
+		},
+		{
+			name: "internal server error",
+			cfg: &MockImportUserDataConfig{
+				expectedUserID: "test-user",
+				expectedData:   expectedData,
+				output:         nil,
+				err:            errTest,
+			},
+			expectedCallCount:    1,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			request:              newRequest(body),
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to import user data"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				importUserDataCfg: tc.cfg,
+				getSavedSearchCfg: &MockGetSavedSearchConfig{
+					expectedSavedSearchID: "new-hotlist-id",
+					expectedUserID:        new("test-user"),
+					output:                importedSearch,
+					err:                   nil,
+				},
+				t: t,
+			}
+			mockPublisher := &MockEventPublisher{
+				t: t,
+				callCountPublishSearchConfigurationChanged: 0,
+				publishSearchConfigurationChangedCfg: &MockPublishSearchConfigurationChangedConfig{
+					expectedResp:       importedSearch,
+					expectedUserID:     "test-user",
+					expectedIsCreation: true,
+					err:                tc.publishErr,
+				},
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer), withCustomEventPublisher(mockPublisher))
+			assertTestServerRequest(t, myServer, tc.request, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountImportUserData,
+				"ImportUserData", nil)
+			assertMocksExpectations(t, tc.expectedPublishCalls, mockStorer.callCountGetSavedSearch,
+				"GetSavedSearch", nil)
+			assertMocksExpectations(t, tc.expectedPublishCalls,
+				mockPublisher.callCountPublishSearchConfigurationChanged, "PublishSearchConfigurationChanged", nil)
+		})
+	}
+}
+
+func TestExportImportUserData_RoundTrip(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	ctx := httpmiddlewares.AuthenticatedUserToContext(t.Context(), testUser)
+	search := backend.UserDataSavedSearch{
+		Id:                "search-id",
+		Name:              "Hotlist",
+		Description:       nil,
+		Query:             "hotlist:search-id",
+		Tags:              nil,
+		Listed:            false,
+		Parameters:        nil,
+		HotlistFeatureIds: &[]string{"grid"},
+	}
+	importedSearch := &backend.SavedSearchResponse{
+		Id:             "new-search-id",
+		Name:           "Hotlist",
+		Query:          "hotlist:new-search-id",
+		Description:    nil,
+		Permissions:    nil,
+		BookmarkStatus: nil,
+		CreatedAt:      time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC),
+		ForkedFrom:     nil,
+		Tags:           nil,
+		Listed:         new(false),
+		Parameters:     nil,
+	}
+	newSubscription := func(id, channelID string) backend.UserDataSubscription {
+		return backend.UserDataSubscription{
+			Id:            id,
+			SavedSearchId: "search-id",
+			ChannelId:     channelID,
+			Triggers: []backend.SubscriptionTriggerWritable{
+				backend.SubscriptionTriggerFeatureBaselineToWidely,
+			},
+			Frequency: backend.SubscriptionFrequencyWeekly,
+		}
+	}
+	emailChannel := backend.UserDataNotificationChannel{
+		Id:           "email-channel-id",
+		Name:         "Email",
+		Type:         backend.UserDataNotificationChannelTypeEmail,
+		EmailAddress: new(openapi_types.Email("user@example.com")),
+		WebhookUrl:   nil,
+	}
+	exportedAt := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	exported := &backend.UserDataExport{
+		Version:       1,
+		ExportedAt:    exportedAt,
+		SavedSearches: []backend.UserDataSavedSearch{search},
+		Bookmarks:     []backend.UserDataBookmark{},
+		NotificationChannels: []backend.UserDataNotificationChannel{
+			emailChannel,
+			// Exports never contain the webhook URL.
+			{
+				Id:           "webhook-channel-id",
+				Name:         "Slack",
+				Type:         backend.UserDataNotificationChannelTypeWebhook,
+				EmailAddress: nil,
+				WebhookUrl:   nil,
+			},
+		},
+		Subscriptions: []backend.UserDataSubscription{
+			newSubscription("email-sub-id", "email-channel-id"),
+			newSubscription("webhook-sub-id", "webhook-channel-id"),
+		},
+	}
+	//nolint:exhaustruct
+	mockStorer := &MockWPTMetricsStorer{
+		exportUserDataCfg: &MockExportUserDataConfig{
+			expectedUserID: "test-user",
+			output:         exported,
+			err:            nil,
+		},
+		importUserDataCfg: &MockImportUserDataConfig{
+			expectedUserID: "test-user",
+			expectedData: backend.UserDataExport{
+				Version:              1,
+				ExportedAt:           exportedAt,
+				SavedSearches:        []backend.UserDataSavedSearch{search},
+				Bookmarks:            []backend.UserDataBookmark{},
+				NotificationChannels: []backend.UserDataNotificationChannel{emailChannel},
+				Subscriptions: []backend.UserDataSubscription{
+					newSubscription("email-sub-id", "email-channel-id"),
+				},
+			},
+			output: &backend.UserDataImportResult{
+				Committed: true,
+				Results: []backend.UserDataImportItemResult{
+					{
+						Kind:     backend.UserDataImportItemKindSavedSearch,
+						SourceId: "search-id",
+						Status:   backend.UserDataImportItemStatusCreated,
+						Id:       new("new-search-id"),
+						Message:  nil,
+					},
+				},
+			},
+			err: nil,
+		},
+		getSavedSearchCfg: &MockGetSavedSearchConfig{
+			expectedSavedSearchID: "new-search-id",
+			expectedUserID:        new("test-user"),
+			output:                importedSearch,
+			err:                   nil,
+		},
+		t: t,
+	}
+	mockPublisher := &MockEventPublisher{
+		t: t,
+		callCountPublishSearchConfigurationChanged: 0,
+		publishSearchConfigurationChangedCfg: &MockPublishSearchConfigurationChangedConfig{
+			expectedResp:       importedSearch,
+			expectedUserID:     "test-user",
+			expectedIsCreation: true,
+			err:                nil,
+		},
+	}
+	myServer := setupTestServer(t, withCustomStorer(mockStorer), withCustomEventPublisher(mockPublisher))
+
+	exportResp, err := myServer.ExportUserData(ctx, backend.ExportUserDataRequestObject{})
+	if err != nil {
+		t.Fatalf("ExportUserData failed: %v", err)
+	}
+	exportOK, ok := exportResp.(backend.ExportUserData200JSONResponse)
+	if !ok {
+		t.Fatalf("unexpected export response %T", exportResp)
+	}
+	// The document goes through the file the user downloads.
+	document, err := json.Marshal(exportOK)
+	if err != nil {
+		t.Fatalf("failed to marshal export: %v", err)
+	}
+	var body backend.UserDataExport
+	if err := json.Unmarshal(document, &body); err != nil {
+		t.Fatalf("failed to unmarshal export: %v", err)
+	}
+
+	importResp, err := myServer.ImportUserData(ctx, backend.ImportUserDataRequestObject{Body: &body})
+	if err != nil {
+		t.Fatalf("ImportUserData failed: %v", err)
+	}
+	importOK, ok := importResp.(backend.ImportUserData200JSONResponse)
+	if !ok {
+		t.Fatalf("unexpected import response %+v", importResp)
+	}
+	// The webhook channel and its subscription are reported as skipped instead of failing the import.
+	expected := backend.ImportUserData200JSONResponse{
+		Committed: true,
+		Results: []backend.UserDataImportItemResult{
+			{
+				Kind:     backend.UserDataImportItemKindSavedSearch,
+				SourceId: "search-id",
+				Status:   backend.UserDataImportItemStatusCreated,
+				Id:       new("new-search-id"),
+				Message:  nil,
+			},
+			{
+				Kind:     backend.UserDataImportItemKindNotificationChannel,
+				SourceId: "webhook-channel-id",
+				Status:   backend.UserDataImportItemStatusSkipped,
+				Id:       nil,
+				Message:  new("webhook_url: " + errUserDataWebhookURLNotExported.Error()),
+			},
+			{
+				Kind:     backend.UserDataImportItemKindSubscription,
+				SourceId: "webhook-sub-id",
+				Status:   backend.UserDataImportItemStatusSkipped,
+				Id:       nil,
+				Message:  new("channel_id: " + errUserDataChannelSkipped.Error()),
+			},
+		},
+	}
+	if !reflect.DeepEqual(importOK, expected) {
+		t.Errorf("unexpected import result %+v", importOK)
+	}
+	assertMocksExpectations(t, 1, mockStorer.callCountExportUserData, "ExportUserData", nil)
+	assertMocksExpectations(t, 1, mockStorer.callCountImportUserData, "ImportUserData", nil)
+	assertMocksExpectations(t, 1, mockPublisher.callCountPublishSearchConfigurationChanged,
+		"PublishSearchConfigurationChanged", nil)
+}
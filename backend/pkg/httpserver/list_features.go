@@ -17,9 +17,12 @@ package httpserver
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"net/url"
+	"slices"
+	"strings"
 
 	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
 	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
@@ -38,29 +41,25 @@ func (s *Server) ListFeatures(
 		return cachedResponse, nil
 	}
 
-	var node *searchtypes.SearchNode
-	if req.Params.Q != nil {
-		// Try to decode the url.
-		decodedStr, err := url.QueryUnescape(*req.Params.Q)
-		if err != nil {
-			slog.WarnContext(ctx, "unable to decode string", "input string", *req.Params.Q, "error", err)
-
-			return backend.ListFeatures400JSONResponse{
-				Code:    http.StatusBadRequest,
-				Message: "query string cannot be decoded",
-			}, nil
-		}
-
-		parser := searchtypes.FeaturesSearchQueryParser{}
-		node, err = parser.Parse(decodedStr)
-		if err != nil {
-			slog.WarnContext(ctx, "unable to parse query string", "query", decodedStr, "error", err)
+	node, errModel := parseFeaturesSearchQuery(ctx, req.Params.Q)
+	if errModel != nil {
+		return backend.ListFeatures400JSONResponse(*errModel), nil
+	}
+	queryParams, err := parseQueryParams(req.Params.Params)
+	if err != nil {
+		slog.WarnContext(ctx, "unable to parse query params", "params", req.Params.Params, "error", err)
 
-			return backend.ListFeatures400JSONResponse{
-				Code:    http.StatusBadRequest,
-				Message: "query string does not match expected grammar",
-			}, nil
-		}
+		return backend.ListFeatures400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		}, nil
+	}
+	facets, err := parseFeatureSearchFacets(req.Params.Facets)
+	if err != nil {
+		return backend.ListFeatures400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: err.Error(),
+		}, nil
 	}
 	featurePage, err := s.wptMetricsStorer.FeaturesSearch(
 		ctx,
@@ -70,6 +69,7 @@ func (s *Server) ListFeatures(
 		req.Params.Sort,
 		getWPTMetricViewOrDefault(req.Params.WptMetricView),
 		backendtypes.DefaultBrowsers(),
+		queryParams,
 	)
 
 	if err != nil {
@@ -82,22 +82,12 @@ func (s *Server) ListFeatures(
 			}, nil
 		}
 
-		if errors.Is(err, backendtypes.ErrSavedSearchNotFound) ||
-			errors.Is(err, backendtypes.ErrHotlistNotFound) ||
-			errors.Is(err, backendtypes.ErrSavedSearchCycleDetected) ||
-			errors.Is(err, backendtypes.ErrSavedSearchMaxDepthExceeded) ||
-			errors.Is(err, backendtypes.ErrQueryConsistsEntirelyOfSavedSearch) {
+		if isInvalidSavedSearchQueryError(err) {
 			slog.WarnContext(ctx, "invalid saved search query", "error", err)
 
-			safeErr := sanitizeValidationError(err)
-			message := "invalid request"
-			if safeErr != nil {
-				message = safeErr.Error()
-			}
-
 			return backend.ListFeatures400JSONResponse{
 				Code:    400,
-				Message: message,
+				Message: invalidSavedSearchQueryMessage(err),
 			}, nil
 		}
 
@@ -109,15 +99,121 @@ func (s *Server) ListFeatures(
 		}, nil
 	}
 
+	var facetCounts *backend.FeatureSearchFacets
+	if len(facets) > 0 {
+		facetCounts, err = s.wptMetricsStorer.FeaturesSearchFacets(ctx, node, facets, queryParams)
+		if err != nil {
+			slog.ErrorContext(ctx, "unable to get feature search facets", "error", err)
+
+			return backend.ListFeatures500JSONResponse{
+				Code:    500,
+				Message: "unable to get list of features",
+			}, nil
+		}
+	}
+
 	resp := backend.ListFeatures200JSONResponse{
 		Metadata: featurePage.Metadata,
 		Data:     featurePage.Data,
+		Facets:   facetCounts,
 	}
 	s.operationResponseCaches.listFeaturesCache.AttemptCache(ctx, req, &resp)
 
 	return resp, nil
 }
 
+// parseFeatureSearchFacets validates the requested facets and removes duplicates.
+func parseFeatureSearchFacets(facets *[]backend.FeatureSearchFacet) ([]backend.FeatureSearchFacet, error) {
+	if facets == nil {
+		return nil, nil
+	}
+	ret := make([]backend.FeatureSearchFacet, 0, len(*facets))
+	for _, facet := range *facets {
+		if !facet.Valid() {
+			return nil, fmt.Errorf("invalid facet %q", facet)
+		}
+		if !slices.Contains(ret, facet) {
+			ret = append(ret, facet)
+		}
+	}
+
+	return ret, nil
+}
+
+// parseFeaturesSearchQuery decodes and parses the optional query of a features search.
+// It returns the error model to send back to the client if the query is invalid.
+func parseFeaturesSearchQuery(ctx context.Context, q *string) (*searchtypes.SearchNode, *backend.BasicErrorModel) {
+	if q == nil {
+		return nil, nil
+	}
+	// Try to decode the url.
+	decodedStr, err := url.QueryUnescape(*q)
+	if err != nil {
+		slog.WarnContext(ctx, "unable to decode string", "input string", *q, "error", err)
+
+		return nil, &backend.BasicErrorModel{
+			Code:    http.StatusBadRequest,
+			Message: "query string cannot be decoded",
+		}
+	}
+
+	parser := searchtypes.FeaturesSearchQueryParser{}
+	node, err := parser.Parse(decodedStr)
+	if err != nil {
+		slog.WarnContext(ctx, "unable to parse query string", "query", decodedStr, "error", err)
+
+		return nil, &backend.BasicErrorModel{
+			Code:    http.StatusBadRequest,
+			Message: "query string does not match expected grammar",
+		}
+	}
+
+	return node, nil
+}
+
+// isInvalidSavedSearchQueryError reports whether the error of a features search comes from
+// the saved searches referenced by the query, rather than from the storage layer.
+func isInvalidSavedSearchQueryError(err error) bool {
+	return errors.Is(err, backendtypes.ErrSavedSearchNotFound) ||
+		errors.Is(err, backendtypes.ErrHotlistNotFound) ||
+		errors.Is(err, backendtypes.ErrSavedSearchCycleDetected) ||
+		errors.Is(err, backendtypes.ErrSavedSearchMaxDepthExceeded) ||
+		errors.Is(err, backendtypes.ErrQueryConsistsEntirelyOfSavedSearch) ||
+		errors.Is(err, searchtypes.ErrUnboundQueryVariable) ||
+		errors.Is(err, searchtypes.ErrInvalidQueryParameter)
+}
+
+// invalidSavedSearchQueryMessage returns the message that is safe to show to the client
+// for an error matched by isInvalidSavedSearchQueryError.
+func invalidSavedSearchQueryMessage(err error) string {
+	if safeErr := sanitizeValidationError(err); safeErr != nil {
+		return safeErr.Error()
+	}
+
+	return "invalid request"
+}
+
+// parseQueryParams parses the values of the variables of the saved searches referenced by the query.
+// Each entry has the form name:value.
+func parseQueryParams(params *[]string) (map[string]string, error) {
+	ret := map[string]string{}
+	if params == nil {
+		return ret, nil
+	}
+	for _, param := range *params {
+		name, value, found := strings.Cut(param, ":")
+		if !found || !searchtypes.IsValidQueryParameterName(name) || !searchtypes.IsValidQueryParameterValue(value) {
+			return nil, fmt.Errorf("%w: params must have the form name:value", searchtypes.ErrInvalidQueryParameter)
+		}
+		if _, duplicate := ret[name]; duplicate {
+			return nil, fmt.Errorf("%w: duplicate value for %s", searchtypes.ErrInvalidQueryParameter, name)
+		}
+		ret[name] = value
+	}
+
+	return ret, nil
+}
+
 func getWPTMetricViewOrDefault(in *backend.WPTMetricView) backend.WPTMetricView {
 	if in != nil {
 		switch *in {
@@ -57,6 +57,9 @@ func TestListGlobalSavedSearches(t *testing.T) {
 							CreatedAt:    &testTime,
 							UpdatedAt:    &testTime,
 							DisplayOrder: new(int64(1)),
+							Tags:         nil,
+							Listed:       nil,
+							Parameters:   nil,
 						},
 					},
 				},
@@ -29,6 +29,7 @@ func TestListNotificationChannels(t *testing.T) {
 	testUser := &auth.User{
 		ID:           "listUserID1",
 		GitHubUserID: nil,
+		TokenScope:   nil,
 	}
 	testCases := []struct {
 		name                 string
@@ -0,0 +1,53 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// ListPersonalAccessTokens handles the GET request to /v1/users/me/tokens.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) ListPersonalAccessTokens(
+	ctx context.Context,
+	_ backend.ListPersonalAccessTokensRequestObject,
+) (backend.ListPersonalAccessTokensResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "ListPersonalAccessTokens",
+		func(code int, message string) backend.ListPersonalAccessTokens500JSONResponse {
+			return backend.ListPersonalAccessTokens500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	tokens, err := s.wptMetricsStorer.ListPersonalAccessTokens(ctx, userCheckResult.User.ID)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to list personal access tokens", "error", err)
+
+		return backend.ListPersonalAccessTokens500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "Could not list personal access tokens",
+		}, nil
+	}
+
+	return backend.ListPersonalAccessTokens200JSONResponse(*tokens), nil
+}
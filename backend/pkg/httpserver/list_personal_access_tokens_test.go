@@ -0,0 +1,102 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestListPersonalAccessTokens(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	lastUsedAt := time.Date(2000, time.January, 2, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name              string
+		cfg               *MockListPersonalAccessTokensConfig
+		expectedCallCount int
+		expectedResponse  *http.Response
+	}{
+		{
+			name: "success",
+			cfg: &MockListPersonalAccessTokensConfig{
+				expectedUserID: testUser.ID,
+				output: &backend.PersonalAccessTokenPage{
+					Data: []backend.PersonalAccessToken{
+						{
+							Id:         "token1",
+							Name:       "ci",
+							Scope:      backend.PersonalAccessTokenScopeManageSubscriptions,
+							CreatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+							LastUsedAt: &lastUsedAt,
+						},
+					},
+				},
+				err: nil,
+			},
+			expectedCallCount: 1,
+			expectedResponse: testJSONResponse(200, `
+{
+	"data": [
+		{
+			"id": "token1",
+			"name": "ci",
+			"scope": "manage_subscriptions",
+			"created_at": "2000-01-01T00:00:00Z",
+			"last_used_at": "2000-01-02T00:00:00Z"
+		}
+	]
+}`),
+		},
+		{
+			name: "500 error",
+			cfg: &MockListPersonalAccessTokensConfig{
+				expectedUserID: testUser.ID,
+				output:         nil,
+				err:            errTest,
+			},
+			expectedCallCount: 1,
+			expectedResponse: testJSONResponse(500, `
+{
+	"code": 500,
+	"message": "Could not list personal access tokens"
+}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				listPersonalAccessTokensCfg: tc.cfg,
+				t:                           t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/users/me/tokens", nil)
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountListPersonalAccessTokens,
+				"ListPersonalAccessTokens", nil)
+		})
+	}
+}
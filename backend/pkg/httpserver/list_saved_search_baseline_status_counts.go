@@ -0,0 +1,83 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// ListSavedSearchBaselineStatusCounts implements backend.StrictServerInterface.
+// nolint: ireturn // Name generated from openapi
+func (s *Server) ListSavedSearchBaselineStatusCounts(
+	ctx context.Context, request backend.ListSavedSearchBaselineStatusCountsRequestObject) (
+	backend.ListSavedSearchBaselineStatusCountsResponseObject, error) {
+	var cachedResponse backend.ListSavedSearchBaselineStatusCounts200JSONResponse
+	found := s.operationResponseCaches.listSavedSearchBaselineStatusCountsCache.Lookup(ctx, request, &cachedResponse)
+	if found {
+		return cachedResponse, nil
+	}
+	page, err := s.wptMetricsStorer.ListSavedSearchBaselineStatusCounts(
+		ctx,
+		request.SearchId,
+		request.Params.StartAt.Time,
+		request.Params.EndAt.Time,
+		getPageSizeOrDefault(request.Params.PageSize),
+		request.Params.PageToken,
+	)
+	if err != nil {
+		if errors.Is(err, backendtypes.ErrEntityDoesNotExist) {
+			return backend.ListSavedSearchBaselineStatusCounts404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: errMsgSavedSearchNotFound,
+			}, nil
+		}
+
+		if errors.Is(err, backendtypes.ErrInvalidPageToken) {
+			slog.WarnContext(ctx, errMsgInvalidPageToken, "token", request.Params.PageToken, "error", err)
+
+			return backend.ListSavedSearchBaselineStatusCounts400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: errMsgInvalidPageToken,
+			}, nil
+		}
+
+		if safeErr := sanitizeValidationError(err); safeErr != nil {
+			slog.WarnContext(ctx, "invalid saved search query", "error", err)
+
+			return backend.ListSavedSearchBaselineStatusCounts400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: safeErr.Error(),
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to get saved search baseline status counts", "error", err)
+
+		return backend.ListSavedSearchBaselineStatusCounts500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to get saved search baseline status metrics",
+		}, nil
+	}
+
+	resp := backend.ListSavedSearchBaselineStatusCounts200JSONResponse(*page)
+	s.operationResponseCaches.listSavedSearchBaselineStatusCountsCache.AttemptCache(ctx, request, &resp)
+
+	return resp, nil
+}
@@ -0,0 +1,193 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/cachetypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestListSavedSearchBaselineStatusCounts(t *testing.T) {
+	const cacheKey = `listSavedSearchBaselineStatusCounts-{"search_id":"search1",` +
+		`"Params":{"startAt":"2000-01-01","endAt":"2000-01-10"}}`
+	const cachedValue = `{"data":[{"count":10,"timestamp":"2000-01-10T00:00:00Z"}],"metadata":{}}`
+	testCases := []struct {
+		name               string
+		mockConfig         *MockListSavedSearchBaselineStatusCountsConfig
+		expectedCallCount  int
+		expectedCacheCalls []*ExpectedCacheCall
+		expectedGetCalls   []*ExpectedGetCall
+		request            *http.Request
+		expectedResponse   *http.Response
+	}{
+		{
+			name: "Success Case",
+			mockConfig: &MockListSavedSearchBaselineStatusCountsConfig{
+				expectedSavedSearchID: "search1",
+				expectedStartAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+				expectedEndAt:         time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+				expectedPageSize:      100,
+				expectedPageToken:     nil,
+				err:                   nil,
+				page: &backend.BaselineStatusMetricsPage{
+					Metadata: &backend.PageMetadata{
+						NextPageToken: nil,
+					},
+					Data: []backend.BaselineStatusMetric{
+						{
+							Count:     new(int64(10)),
+							Timestamp: time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+						},
+					},
+				},
+			},
+			expectedGetCalls: []*ExpectedGetCall{
+				{Key: cacheKey, Value: nil, Err: cachetypes.ErrCachedDataNotFound},
+			},
+			expectedCacheCalls: []*ExpectedCacheCall{
+				{Key: cacheKey, Value: []byte(cachedValue), CacheCfg: getDefaultCacheConfig()},
+			},
+			expectedCallCount: 1,
+			expectedResponse:  testJSONResponse(200, cachedValue),
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+				"/v1/saved-searches/search1/stats/baseline_counts?startAt=2000-01-01&endAt=2000-01-10", nil),
+		},
+		{
+			name:       "Success Case - cached",
+			mockConfig: nil,
+			expectedGetCalls: []*ExpectedGetCall{
+				{Key: cacheKey, Value: []byte(cachedValue), Err: nil},
+			},
+			expectedCacheCalls: nil,
+			expectedCallCount:  0,
+			expectedResponse:   testJSONResponse(200, cachedValue),
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+				"/v1/saved-searches/search1/stats/baseline_counts?startAt=2000-01-01&endAt=2000-01-10", nil),
+		},
+		{
+			name: "404 case",
+			mockConfig: &MockListSavedSearchBaselineStatusCountsConfig{
+				expectedSavedSearchID: "search1",
+				expectedStartAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+				expectedEndAt:         time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+				expectedPageSize:      100,
+				expectedPageToken:     nil,
+				page:                  nil,
+				err:                   backendtypes.ErrEntityDoesNotExist,
+			},
+			expectedGetCalls: []*ExpectedGetCall{
+				{Key: cacheKey, Value: nil, Err: cachetypes.ErrCachedDataNotFound},
+			},
+			expectedCacheCalls: nil,
+			expectedCallCount:  1,
+			expectedResponse:   testJSONResponse(404, `{"code":404,"message":"saved search not found"}`),
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+				"/v1/saved-searches/search1/stats/baseline_counts?startAt=2000-01-01&endAt=2000-01-10", nil),
+		},
+		{
+			name: "400 case - saved search query cannot be expanded",
+			mockConfig: &MockListSavedSearchBaselineStatusCountsConfig{
+				expectedSavedSearchID: "search1",
+				expectedStartAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+				expectedEndAt:         time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+				expectedPageSize:      100,
+				expectedPageToken:     nil,
+				page:                  nil,
+				err:                   errors.Join(errTest, backendtypes.ErrSavedSearchCycleDetected),
+			},
+			expectedGetCalls: []*ExpectedGetCall{
+				{Key: cacheKey, Value: nil, Err: cachetypes.ErrCachedDataNotFound},
+			},
+			expectedCacheCalls: nil,
+			expectedCallCount:  1,
+			expectedResponse: testJSONResponse(400,
+				`{"code":400,"message":"cycle detected in saved search expansion"}`),
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+				"/v1/saved-searches/search1/stats/baseline_counts?startAt=2000-01-01&endAt=2000-01-10", nil),
+		},
+		{
+			name: "400 case - invalid page token",
+			mockConfig: &MockListSavedSearchBaselineStatusCountsConfig{
+				expectedSavedSearchID: "search1",
+				expectedStartAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+				expectedEndAt:         time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+				expectedPageSize:      100,
+				expectedPageToken:     inputPageToken,
+				page:                  nil,
+				err:                   backendtypes.ErrInvalidPageToken,
+			},
+			expectedGetCalls: []*ExpectedGetCall{
+				{
+					Key: `listSavedSearchBaselineStatusCounts-{"search_id":"search1",` +
+						`"Params":{"startAt":"2000-01-01","endAt":"2000-01-10","page_token":"input-token"}}`,
+					Value: nil,
+					Err:   cachetypes.ErrCachedDataNotFound,
+				},
+			},
+			expectedCacheCalls: nil,
+			expectedCallCount:  1,
+			expectedResponse:   testJSONResponse(400, `{"code":400,"message":"invalid page token"}`),
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+				"/v1/saved-searches/search1/stats/baseline_counts?startAt=2000-01-01&endAt=2000-01-10"+
+					"&page_token="+*inputPageToken, nil),
+		},
+		{
+			name: "500 case",
+			mockConfig: &MockListSavedSearchBaselineStatusCountsConfig{
+				expectedSavedSearchID: "search1",
+				expectedStartAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+				expectedEndAt:         time.Date(2000, time.January, 10, 0, 0, 0, 0, time.UTC),
+				expectedPageSize:      100,
+				expectedPageToken:     nil,
+				page:                  nil,
+				err:                   errTest,
+			},
+			expectedGetCalls: []*ExpectedGetCall{
+				{Key: cacheKey, Value: nil, Err: cachetypes.ErrCachedDataNotFound},
+			},
+			expectedCacheCalls: nil,
+			expectedCallCount:  1,
+			expectedResponse: testJSONResponse(500,
+				`{"code":500,"message":"unable to get saved search baseline status metrics"}`),
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+				"/v1/saved-searches/search1/stats/baseline_counts?startAt=2000-01-01&endAt=2000-01-10", nil),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// nolint: exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				listSavedSearchBaselineStatusCountsCfg: tc.mockConfig,
+				t:                                      t,
+			}
+			mockCacher := NewMockRawBytesDataCacher(t, tc.expectedCacheCalls, tc.expectedGetCalls)
+			myServer := setupTestServer(t,
+				withCustomStorer(mockStorer),
+				withCustomCaches(initOperationResponseCaches(mockCacher, getTestRouteCacheOptions())),
+			)
+			assertTestServerRequest(t, myServer, tc.request, tc.expectedResponse)
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountListSavedSearchBaselineStatusCounts,
+				"ListSavedSearchBaselineStatusCounts", mockCacher)
+		})
+	}
+}
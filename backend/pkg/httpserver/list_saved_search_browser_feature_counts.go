@@ -0,0 +1,98 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// ListSavedSearchBrowserFeatureCounts implements backend.StrictServerInterface.
+// nolint: ireturn // Signature generated from openapi
+func (s *Server) ListSavedSearchBrowserFeatureCounts(
+	ctx context.Context, request backend.ListSavedSearchBrowserFeatureCountsRequestObject) (
+	backend.ListSavedSearchBrowserFeatureCountsResponseObject, error) {
+	var cachedResponse backend.ListSavedSearchBrowserFeatureCounts200JSONResponse
+	found := s.operationResponseCaches.listSavedSearchBrowserFeatureCountsCache.Lookup(ctx, request, &cachedResponse)
+	if found {
+		return cachedResponse, nil
+	}
+
+	var targetMobileBrowser *string
+	if request.Params.IncludeBaselineMobileBrowsers != nil {
+		matchingMobileBrowser, err := getDesktopsMobileProduct(request.Params.Browser)
+		if err != nil {
+			return backend.ListSavedSearchBrowserFeatureCounts400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: err.Error(),
+			}, nil
+		}
+		targetMobileBrowser = (*string)(&matchingMobileBrowser)
+	}
+
+	page, err := s.wptMetricsStorer.ListSavedSearchBrowserFeatureCountMetric(
+		ctx,
+		request.SearchId,
+		string(request.Params.Browser),
+		targetMobileBrowser,
+		request.Params.StartAt.Time,
+		request.Params.EndAt.Time,
+		getPageSizeOrDefault(request.Params.PageSize),
+		request.Params.PageToken,
+	)
+	if err != nil {
+		if errors.Is(err, backendtypes.ErrEntityDoesNotExist) {
+			return backend.ListSavedSearchBrowserFeatureCounts404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: errMsgSavedSearchNotFound,
+			}, nil
+		}
+
+		if errors.Is(err, backendtypes.ErrInvalidPageToken) {
+			slog.WarnContext(ctx, errMsgInvalidPageToken, "token", request.Params.PageToken, "error", err)
+
+			return backend.ListSavedSearchBrowserFeatureCounts400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: errMsgInvalidPageToken,
+			}, nil
+		}
+
+		if safeErr := sanitizeValidationError(err); safeErr != nil {
+			slog.WarnContext(ctx, "invalid saved search query", "error", err)
+
+			return backend.ListSavedSearchBrowserFeatureCounts400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: safeErr.Error(),
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to get saved search feature support counts", "error", err)
+
+		return backend.ListSavedSearchBrowserFeatureCounts500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to get saved search feature support metrics",
+		}, nil
+	}
+
+	resp := backend.ListSavedSearchBrowserFeatureCounts200JSONResponse(*page)
+	s.operationResponseCaches.listSavedSearchBrowserFeatureCountsCache.AttemptCache(ctx, request, &resp)
+
+	return resp, nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// ListSavedSearchCollaborators handles the GET request to /v1/saved-searches/{search_id}/collaborators.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) ListSavedSearchCollaborators(
+	ctx context.Context,
+	request backend.ListSavedSearchCollaboratorsRequestObject,
+) (backend.ListSavedSearchCollaboratorsResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "ListSavedSearchCollaborators",
+		func(code int, message string) backend.ListSavedSearchCollaborators500JSONResponse {
+			return backend.ListSavedSearchCollaborators500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	list, err := s.wptMetricsStorer.ListSavedSearchCollaborators(ctx, userCheckResult.User.ID, request.SearchId)
+	if err != nil {
+		if errors.Is(err, backendtypes.ErrEntityDoesNotExist) {
+			return backend.ListSavedSearchCollaborators404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: errMsgSavedSearchNotFound,
+			}, nil
+		} else if errors.Is(err, backendtypes.ErrUserNotAuthorizedForAction) {
+			return backend.ListSavedSearchCollaborators403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "forbidden",
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to list saved search collaborators", "error", err,
+			"searchID", request.SearchId)
+
+		return backend.ListSavedSearchCollaborators500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to list collaborators",
+		}, nil
+	}
+
+	return backend.ListSavedSearchCollaborators200JSONResponse(*list), nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestListSavedSearchCollaborators(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	errCfg := func(err error) *MockListSavedSearchCollaboratorsConfig {
+		return &MockListSavedSearchCollaboratorsConfig{
+			expectedUserID:        "test-user",
+			expectedSavedSearchID: "search-id",
+			output:                nil,
+			err:                   err,
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		cfg              *MockListSavedSearchCollaboratorsConfig
+		expectedResponse *http.Response
+	}{
+		{
+			name: "success",
+			cfg: &MockListSavedSearchCollaboratorsConfig{
+				expectedUserID:        "test-user",
+				expectedSavedSearchID: "search-id",
+				output: &backend.SavedSearchCollaboratorList{
+					Data: []backend.SavedSearchCollaborator{
+						{GithubUsername: new("owner"), Role: backend.SavedSearchOwner},
+						{GithubUsername: nil, Role: backend.SavedSearchEditor},
+					},
+				},
+				err: nil,
+			},
+			expectedResponse: testJSONResponse(http.StatusOK, `{"data":[
+				{"github_username":"owner","role":"saved_search_owner"},
+				{"role":"saved_search_editor"}
+			]}`),
+		},
+		{
+			name:             "forbidden",
+			cfg:              errCfg(backendtypes.ErrUserNotAuthorizedForAction),
+			expectedResponse: testJSONResponse(http.StatusForbidden, `{"code":403,"message":"forbidden"}`),
+		},
+		{
+			name: "not found",
+			cfg:  errCfg(backendtypes.ErrEntityDoesNotExist),
+			expectedResponse: testJSONResponse(http.StatusNotFound,
+				`{"code":404,"message":"saved search not found"}`),
+		},
+		{
+			name: "internal server error",
+			cfg:  errCfg(errors.New("database error")),
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to list collaborators"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				listSavedSearchCollaboratorsCfg: tc.cfg,
+				t:                               t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			req := httptest.NewRequestWithContext(t.Context(),
+				http.MethodGet, "/v1/saved-searches/search-id/collaborators", nil)
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, 1, mockStorer.callCountListSavedSearchCollaborators,
+				"ListSavedSearchCollaborators", nil)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+const savedSearchDirectoryQueryMaxLength = 64
+
+// ListSavedSearchDirectory handles the GET request to /v1/saved-searches.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) ListSavedSearchDirectory(
+	ctx context.Context,
+	request backend.ListSavedSearchDirectoryRequestObject,
+) (backend.ListSavedSearchDirectoryResponseObject, error) {
+	if request.Params.Q != nil &&
+		(len(*request.Params.Q) == 0 || len(*request.Params.Q) > savedSearchDirectoryQueryMaxLength) {
+		return backend.ListSavedSearchDirectory400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: "q must be between 1 and 64 characters long",
+		}, nil
+	}
+	if request.Params.Tag != nil &&
+		(len(*request.Params.Tag) > savedSearchTagMaxLength || !savedSearchTagRegex.MatchString(*request.Params.Tag)) {
+		return backend.ListSavedSearchDirectory400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: errSavedSearchInvalidTag.Error(),
+		}, nil
+	}
+	sort := backend.SavedSearchDirectorySortPopular
+	if request.Params.Sort != nil {
+		if !request.Params.Sort.Valid() {
+			return backend.ListSavedSearchDirectory400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: "invalid sort",
+			}, nil
+		}
+		sort = *request.Params.Sort
+	}
+
+	page, err := s.wptMetricsStorer.ListSavedSearchDirectory(
+		ctx,
+		request.Params.Q,
+		request.Params.Tag,
+		sort,
+		getPageSizeOrDefault(request.Params.PageSize),
+		request.Params.PageToken,
+	)
+	if err != nil {
+		if errors.Is(err, backendtypes.ErrInvalidPageToken) {
+			return backend.ListSavedSearchDirectory400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: errMsgInvalidPageToken,
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to list the saved search directory", "error", err)
+
+		return backend.ListSavedSearchDirectory500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to list the saved search directory",
+		}, nil
+	}
+
+	return backend.ListSavedSearchDirectory200JSONResponse(*page), nil
+}
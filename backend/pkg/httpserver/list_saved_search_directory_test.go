@@ -0,0 +1,173 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestListSavedSearchDirectory(t *testing.T) {
+	createdAt := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name              string
+		cfg               *MockListSavedSearchDirectoryConfig
+		expectedCallCount int
+		request           *http.Request
+		expectedResponse  *http.Response
+	}{
+		{
+			name: "success with defaults",
+			cfg: &MockListSavedSearchDirectoryConfig{
+				expectedQuery:     nil,
+				expectedTag:       nil,
+				expectedSort:      backend.SavedSearchDirectorySortPopular,
+				expectedPageSize:  100,
+				expectedPageToken: nil,
+				output: &backend.SavedSearchDirectoryPage{
+					Metadata: &backend.PageMetadata{
+						NextPageToken: new("next"),
+					},
+					Data: &[]backend.SavedSearchDirectoryEntry{
+						{
+							Id:              "search-id",
+							Name:            "css",
+							Description:     nil,
+							Query:           "group:css",
+							Tags:            &[]string{"interop"},
+							Listed:          new(true),
+							Parameters:      nil,
+							CreatedAt:       createdAt,
+							UpdatedAt:       createdAt,
+							BookmarkCount:   3,
+							SubscriberCount: 2,
+						},
+					},
+				},
+				err: nil,
+			},
+			expectedCallCount: 1,
+			request:           httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/saved-searches", nil),
+			expectedResponse: testJSONResponse(200, `
+{
+	"data":[
+		{
+			"bookmark_count":3,
+			"created_at":"2026-01-01T00:00:00Z",
+			"id":"search-id",
+			"listed":true,
+			"name":"css",
+			"query":"group:css",
+			"subscriber_count":2,
+			"tags":["interop"],
+			"updated_at":"2026-01-01T00:00:00Z"
+		}
+	],
+	"metadata":{
+		"next_page_token":"next"
+	}
+}`),
+		},
+		{
+			name: "success with filters",
+			cfg: &MockListSavedSearchDirectoryConfig{
+				expectedQuery:     new("css"),
+				expectedTag:       new("interop"),
+				expectedSort:      backend.SavedSearchDirectorySortRecent,
+				expectedPageSize:  10,
+				expectedPageToken: new("token"),
+				output: &backend.SavedSearchDirectoryPage{
+					Metadata: nil,
+					Data:     nil,
+				},
+				err: nil,
+			},
+			expectedCallCount: 1,
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+				"/v1/saved-searches?q=css&tag=interop&sort=recent&page_size=10&page_token=token", nil),
+			expectedResponse: testJSONResponse(200, `{}`),
+		},
+		{
+			name:              "invalid tag",
+			cfg:               nil,
+			expectedCallCount: 0,
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+				"/v1/saved-searches?tag=Not%20A%20Tag", nil),
+			expectedResponse: testJSONResponse(400, `{
+				"code":400,
+				"message":"tags must be unique, at most 32 characters long and only contain lowercase letters, digits and dashes"
+			}`),
+		},
+		{
+			name:              "invalid sort",
+			cfg:               nil,
+			expectedCallCount: 0,
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+				"/v1/saved-searches?sort=oldest", nil),
+			expectedResponse: testJSONResponse(400, `{"code":400,"message":"invalid sort"}`),
+		},
+		{
+			name: "invalid page token",
+			cfg: &MockListSavedSearchDirectoryConfig{
+				expectedQuery:     nil,
+				expectedTag:       nil,
+				expectedSort:      backend.SavedSearchDirectorySortPopular,
+				expectedPageSize:  100,
+				expectedPageToken: new("bad"),
+				output:            nil,
+				err:               backendtypes.ErrInvalidPageToken,
+			},
+			expectedCallCount: 1,
+			request: httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+				"/v1/saved-searches?page_token=bad", nil),
+			expectedResponse: testJSONResponse(400, `{"code":400,"message":"invalid page token"}`),
+		},
+		{
+			name: "500 case",
+			cfg: &MockListSavedSearchDirectoryConfig{
+				expectedQuery:     nil,
+				expectedTag:       nil,
+				expectedSort:      backend.SavedSearchDirectorySortPopular,
+				expectedPageSize:  100,
+				expectedPageToken: nil,
+				output:            nil,
+				err:               errTest,
+			},
+			expectedCallCount: 1,
+			request:           httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/v1/saved-searches", nil),
+			expectedResponse: testJSONResponse(500,
+				`{"code":500,"message":"unable to list the saved search directory"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				listSavedSearchDirectoryCfg: tc.cfg,
+				t:                           t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			assertTestServerRequest(t, myServer, tc.request, tc.expectedResponse)
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountListSavedSearchDirectory,
+				"ListSavedSearchDirectory", nil)
+		})
+	}
+}
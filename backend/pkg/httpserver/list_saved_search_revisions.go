@@ -0,0 +1,80 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// ListSavedSearchRevisions handles the GET request to /v1/saved-searches/{search_id}/revisions.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) ListSavedSearchRevisions(
+	ctx context.Context,
+	request backend.ListSavedSearchRevisionsRequestObject,
+) (backend.ListSavedSearchRevisionsResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "ListSavedSearchRevisions",
+		func(code int, message string) backend.ListSavedSearchRevisions500JSONResponse {
+			return backend.ListSavedSearchRevisions500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	page, err := s.wptMetricsStorer.ListSavedSearchRevisions(
+		ctx,
+		userCheckResult.User.ID,
+		request.SearchId,
+		getPageSizeOrDefault(request.Params.PageSize),
+		request.Params.PageToken,
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, backendtypes.ErrInvalidPageToken):
+			return backend.ListSavedSearchRevisions400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: errMsgInvalidPageToken,
+			}, nil
+		case errors.Is(err, backendtypes.ErrEntityDoesNotExist):
+			return backend.ListSavedSearchRevisions404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: errMsgSavedSearchNotFound,
+			}, nil
+		case errors.Is(err, backendtypes.ErrUserNotAuthorizedForAction):
+			return backend.ListSavedSearchRevisions403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "forbidden",
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to list saved search revisions", "error", err,
+			"searchID", request.SearchId)
+
+		return backend.ListSavedSearchRevisions500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to list revisions",
+		}, nil
+	}
+
+	return backend.ListSavedSearchRevisions200JSONResponse(*page), nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestListSavedSearchRevisions(t *testing.T) {
+	createdAt := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	errCfg := func(err error) *MockListSavedSearchRevisionsConfig {
+		return &MockListSavedSearchRevisionsConfig{
+			expectedUserID:        "test-user",
+			expectedSavedSearchID: "search-id",
+			expectedPageSize:      2,
+			expectedPageToken:     new("token"),
+			output:                nil,
+			err:                   err,
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		cfg              *MockListSavedSearchRevisionsConfig
+		expectedResponse *http.Response
+	}{
+		{
+			name: "success",
+			cfg: &MockListSavedSearchRevisionsConfig{
+				expectedUserID:        "test-user",
+				expectedSavedSearchID: "search-id",
+				expectedPageSize:      2,
+				expectedPageToken:     new("token"),
+				output: &backend.SavedSearchRevisionPage{
+					Metadata: &backend.PageMetadata{NextPageToken: new("next")},
+					Data: &[]backend.SavedSearchRevision{
+						{
+							RevisionNumber:       2,
+							Name:                 "name",
+							Description:          nil,
+							Query:                "group:css",
+							AuthorGithubUsername: new("octocat"),
+							RevertedFromRevision: new(int64(1)),
+							CreatedAt:            createdAt,
+						},
+					},
+				},
+				err: nil,
+			},
+			expectedResponse: testJSONResponse(http.StatusOK, `{
+				"metadata":{"next_page_token":"next"},
+				"data":[{
+					"revision_number":2,
+					"name":"name",
+					"query":"group:css",
+					"author_github_username":"octocat",
+					"reverted_from_revision":1,
+					"created_at":"2026-01-01T00:00:00Z"
+				}]
+			}`),
+		},
+		{
+			name: "bad request - invalid page token",
+			cfg:  errCfg(backendtypes.ErrInvalidPageToken),
+			expectedResponse: testJSONResponse(http.StatusBadRequest,
+				`{"code":400,"message":"invalid page token"}`),
+		},
+		{
+			name:             "forbidden",
+			cfg:              errCfg(backendtypes.ErrUserNotAuthorizedForAction),
+			expectedResponse: testJSONResponse(http.StatusForbidden, `{"code":403,"message":"forbidden"}`),
+		},
+		{
+			name: "not found",
+			cfg:  errCfg(backendtypes.ErrEntityDoesNotExist),
+			expectedResponse: testJSONResponse(http.StatusNotFound,
+				`{"code":404,"message":"saved search not found"}`),
+		},
+		{
+			name: "internal server error",
+			cfg:  errCfg(errors.New("database error")),
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to list revisions"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				listSavedSearchRevisionsCfg: tc.cfg,
+				t:                           t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet,
+				"/v1/saved-searches/search-id/revisions?page_size=2&page_token=token", nil)
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, 1, mockStorer.callCountListSavedSearchRevisions,
+				"ListSavedSearchRevisions", nil)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// ListSavedSearchSnapshots handles the GET request to /v1/saved-searches/{search_id}/snapshots.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) ListSavedSearchSnapshots(
+	ctx context.Context,
+	request backend.ListSavedSearchSnapshotsRequestObject,
+) (backend.ListSavedSearchSnapshotsResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "ListSavedSearchSnapshots",
+		func(code int, message string) backend.ListSavedSearchSnapshots500JSONResponse {
+			return backend.ListSavedSearchSnapshots500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	page, err := s.wptMetricsStorer.ListSavedSearchSnapshots(
+		ctx,
+		userCheckResult.User.ID,
+		request.SearchId,
+		getSnapshotTypeOrDefault(request.Params.SnapshotType),
+		request.Params.At,
+		getPageSizeOrDefault(request.Params.PageSize),
+		request.Params.PageToken,
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, backendtypes.ErrInvalidPageToken):
+			return backend.ListSavedSearchSnapshots400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: errMsgInvalidPageToken,
+			}, nil
+		case errors.Is(err, backendtypes.ErrEntityDoesNotExist):
+			return backend.ListSavedSearchSnapshots404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: errMsgSavedSearchNotFound,
+			}, nil
+		case errors.Is(err, backendtypes.ErrUserNotAuthorizedForAction):
+			return backend.ListSavedSearchSnapshots403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "forbidden",
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to list saved search snapshots", "error", err,
+			"searchID", request.SearchId)
+
+		return backend.ListSavedSearchSnapshots500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to list snapshots",
+		}, nil
+	}
+
+	return backend.ListSavedSearchSnapshots200JSONResponse(*page), nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestListSavedSearchSnapshots(t *testing.T) {
+	createdAt := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	errCfg := func(err error) *MockListSavedSearchSnapshotsConfig {
+		return &MockListSavedSearchSnapshotsConfig{
+			expectedUserID:        "test-user",
+			expectedSavedSearchID: "search-id",
+			expectedSnapshotType:  backend.SubscriptionFrequencyImmediate,
+			expectedAt:            nil,
+			expectedPageSize:      100,
+			expectedPageToken:     nil,
+			output:                nil,
+			err:                   err,
+		}
+	}
+	defaultURL := "/v1/saved-searches/search-id/snapshots"
+
+	testCases := []struct {
+		name             string
+		cfg              *MockListSavedSearchSnapshotsConfig
+		url              string
+		expectedResponse *http.Response
+	}{
+		{
+			name: "success",
+			cfg: &MockListSavedSearchSnapshotsConfig{
+				expectedUserID:        "test-user",
+				expectedSavedSearchID: "search-id",
+				expectedSnapshotType:  backend.SubscriptionFrequencyWeekly,
+				expectedAt:            &createdAt,
+				expectedPageSize:      2,
+				expectedPageToken:     new("token"),
+				output: &backend.SavedSearchSnapshotPage{
+					Metadata: &backend.PageMetadata{NextPageToken: new("next")},
+					Data: &[]backend.SavedSearchSnapshotMetadata{
+						{
+							Id:           "event-1",
+							SnapshotType: backend.SubscriptionFrequencyWeekly,
+							CreatedAt:    createdAt,
+							Reasons:      &[]backend.SavedSearchSnapshotReason{backend.SavedSearchSnapshotReasonDataUpdated},
+						},
+					},
+				},
+				err: nil,
+			},
+			url: "/v1/saved-searches/search-id/snapshots?snapshot_type=weekly&at=2026-01-01T00:00:00Z" +
+				"&page_size=2&page_token=token",
+			expectedResponse: testJSONResponse(http.StatusOK, `{
+				"metadata":{"next_page_token":"next"},
+				"data":[{
+					"id":"event-1",
+					"snapshot_type":"weekly",
+					"created_at":"2026-01-01T00:00:00Z",
+					"reasons":["data_updated"]
+				}]
+			}`),
+		},
+		{
+			name: "bad request - invalid page token",
+			cfg:  errCfg(backendtypes.ErrInvalidPageToken),
+			url:  defaultURL,
+			expectedResponse: testJSONResponse(http.StatusBadRequest,
+				`{"code":400,"message":"invalid page token"}`),
+		},
+		{
+			name:             "forbidden",
+			cfg:              errCfg(backendtypes.ErrUserNotAuthorizedForAction),
+			url:              defaultURL,
+			expectedResponse: testJSONResponse(http.StatusForbidden, `{"code":403,"message":"forbidden"}`),
+		},
+		{
+			name: "not found",
+			cfg:  errCfg(backendtypes.ErrEntityDoesNotExist),
+			url:  defaultURL,
+			expectedResponse: testJSONResponse(http.StatusNotFound,
+				`{"code":404,"message":"saved search not found"}`),
+		},
+		{
+			name: "internal server error",
+			cfg:  errCfg(errors.New("database error")),
+			url:  defaultURL,
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to list snapshots"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				listSavedSearchSnapshotsCfg: tc.cfg,
+				t:                           t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, tc.url, nil)
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, 1, mockStorer.callCountListSavedSearchSnapshots,
+				"ListSavedSearchSnapshots", nil)
+		})
+	}
+}
@@ -30,6 +30,7 @@ func TestListSubscriptions(t *testing.T) {
 	testUser := &auth.User{
 		ID:           "test-user",
 		GitHubUserID: nil,
+		TokenScope:   nil,
 	}
 	testCases := []struct {
 		name                 string
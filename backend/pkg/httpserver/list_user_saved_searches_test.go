@@ -29,6 +29,7 @@ func TestListUserSavedSearches(t *testing.T) {
 	testUser := &auth.User{
 		ID:           "listUserID1",
 		GitHubUserID: nil,
+		TokenScope:   nil,
 	}
 	testCases := []struct {
 		name                 string
@@ -58,6 +59,10 @@ func TestListUserSavedSearches(t *testing.T) {
 							BookmarkStatus: &backend.UserSavedSearchBookmark{
 								Status: backend.BookmarkActive,
 							},
+							ForkedFrom: nil,
+							Tags:       nil,
+							Listed:     nil,
+							Parameters: nil,
 						},
 					}),
 				},
@@ -106,6 +111,10 @@ func TestListUserSavedSearches(t *testing.T) {
 							BookmarkStatus: &backend.UserSavedSearchBookmark{
 								Status: backend.BookmarkActive,
 							},
+							ForkedFrom: nil,
+							Tags:       nil,
+							Listed:     nil,
+							Parameters: nil,
 						},
 					}),
 				},
@@ -0,0 +1,49 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) ListWatchedFeatures(
+	ctx context.Context,
+	request backend.ListWatchedFeaturesRequestObject,
+) (backend.ListWatchedFeaturesResponseObject, error) {
+	userCheck := CheckAuthenticatedUser[backend.ListWatchedFeaturesResponseObject](ctx, "ListWatchedFeatures",
+		func(code int, message string) backend.ListWatchedFeaturesResponseObject {
+			return backend.ListWatchedFeatures500JSONResponse(backend.BasicErrorModel{Code: code, Message: message})
+		})
+	if userCheck.User == nil {
+		return userCheck.Response, nil
+	}
+
+	resp, err := s.wptMetricsStorer.ListWatchedFeatures(
+		ctx, userCheck.User.ID, getPageSizeOrDefault(request.Params.PageSize), request.Params.PageToken)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to get page of watched features", "error", err)
+
+		return backend.ListWatchedFeatures500JSONResponse{
+			Code:    500,
+			Message: "could not list watched features",
+		}, nil
+	}
+
+	return backend.ListWatchedFeatures200JSONResponse(*resp), nil
+}
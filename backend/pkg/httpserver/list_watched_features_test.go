@@ -0,0 +1,147 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestListWatchedFeatures(t *testing.T) {
+	now := time.Now()
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	testCases := []struct {
+		name                 string
+		cfg                  *MockListWatchedFeaturesConfig
+		expectedCallCount    int
+		authMiddlewareOption testServerOption
+		request              *http.Request
+		expectedResponse     *http.Response
+	}{
+		{
+			name: "success",
+			cfg: &MockListWatchedFeaturesConfig{
+				expectedUserID:    "test-user",
+				expectedPageSize:  50,
+				expectedPageToken: new("page-token"),
+				output: &backend.WatchedFeaturePage{
+					Data: &[]backend.WatchedFeature{
+						{
+							FeatureId:   "grid",
+							FeatureName: "CSS Grid",
+							Subscription: backend.SubscriptionResponse{
+								Id:           "sub-id",
+								ChannelId:    "channel-id",
+								ChannelType:  backend.SubscriptionResponseChannelTypeRss,
+								Subscribable: backend.SavedSearchInfo{Id: "search-id", Name: "CSS Grid"},
+								Triggers: []backend.SubscriptionTriggerResponseItem{
+									{
+										Value:    backendtypes.AttemptToStoreSubscriptionTrigger("trigger"),
+										RawValue: nil,
+									},
+								},
+								Frequency: "weekly",
+								CreatedAt: now,
+								UpdatedAt: now,
+							},
+						},
+					},
+					Metadata: &backend.PageMetadata{
+						NextPageToken: new("next-page-token"),
+					},
+				},
+				err: nil,
+			},
+			expectedCallCount: 1,
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodGet,
+				"/v1/users/me/watched-features?page_size=50&page_token=page-token",
+				nil,
+			),
+			expectedResponse: testJSONResponse(http.StatusOK,
+				`{
+					"data":[
+						{
+							"feature_id":"grid",
+							"feature_name":"CSS Grid",
+							"subscription":{
+								"id":"sub-id",
+								"channel_id":"channel-id",
+								"channel_type":"rss",
+								"subscribable":{"id":"search-id","name":"CSS Grid"},
+								"triggers":[{"value":"trigger"}],
+								"frequency":"weekly",
+								"created_at":"`+now.Format(time.RFC3339Nano)+`",
+								"updated_at":"`+now.Format(time.RFC3339Nano)+`"
+							}
+						}
+					],
+					"metadata":{
+						"next_page_token":"next-page-token"
+					}
+				}`),
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+		},
+		{
+			name: "internal server error",
+			cfg: &MockListWatchedFeaturesConfig{
+				expectedUserID:    "test-user",
+				expectedPageSize:  100,
+				expectedPageToken: nil,
+				output:            nil,
+				err:               errTest,
+			},
+			expectedCallCount: 1,
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodGet,
+				"/v1/users/me/watched-features",
+				nil,
+			),
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{
+					"code":500,
+					"message":"could not list watched features"
+				}`),
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				listWatchedFeaturesCfg: tc.cfg,
+				t:                      t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			assertTestServerRequest(t, myServer, tc.request, tc.expectedResponse, tc.authMiddlewareOption)
+			assertMocksExpectations(t,
+				tc.expectedCallCount,
+				mockStorer.callCountListWatchedFeatures,
+				"ListWatchedFeatures",
+				nil)
+		})
+	}
+}
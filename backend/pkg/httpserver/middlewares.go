@@ -16,6 +16,7 @@ package httpserver
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 
@@ -62,7 +63,7 @@ func CheckAuthenticatedUser[T any](
 
 // applyPreRequestValidationMiddlewares applies a list of middleware functions to a given http.Handler.
 // The middlewares are applied in reverse order to ensure they execute in the order they are defined.
-func applyPreRequestValidationMiddlewares(mux *http.ServeMux,
+func applyPreRequestValidationMiddlewares(mux http.Handler,
 	middlewares []func(http.Handler) http.Handler) http.Handler {
 	var next http.Handler
 	next = mux
@@ -77,16 +78,29 @@ func applyPreRequestValidationMiddlewares(mux *http.ServeMux,
 // requires post-request validation. The wrapper function adapts the middleware to the signature expected by the
 // OpenAPI generator.
 func wrapPostRequestValidationMiddlewaresForOpenAPIHook(
-	authMiddleware func(http.Handler) http.Handler) []backend.StrictMiddlewareFunc {
-	openAPIMiddlewares := make([]backend.StrictMiddlewareFunc, 1)
+	authMiddleware func(http.Handler) http.Handler,
+	rateLimitMiddleware func(http.Handler) http.Handler) []backend.StrictMiddlewareFunc {
+	openAPIMiddlewares := make([]backend.StrictMiddlewareFunc, 0, 3)
 	// OpenAPI middlewares need to inserted in reverse order.
 	// This is an implementation detail for the current OpenAPI Generator.
-	openAPIMiddlewares[0] = wrapPostRequestValidationMiddlewareForOpenAPIHook(
-		authMiddleware, authMiddlewareOpenAPIHook)
+	// The token scope and rate limit middlewares need the authenticated user so they must run after the auth
+	// middleware.
+	openAPIMiddlewares = append(openAPIMiddlewares, tokenScopeMiddleware)
+	if rateLimitMiddleware != nil {
+		openAPIMiddlewares = append(openAPIMiddlewares, wrapPostRequestValidationMiddlewareForOpenAPIHook(
+			rateLimitMiddleware, passthroughOpenAPIHook))
+	}
+	openAPIMiddlewares = append(openAPIMiddlewares, wrapPostRequestValidationMiddlewareForOpenAPIHook(
+		authMiddleware, authMiddlewareOpenAPIHook))
 
 	return openAPIMiddlewares
 }
 
+// passthroughOpenAPIHook is used for the middlewares that do not pass anything to the handler.
+func passthroughOpenAPIHook(next backend.StrictHandlerFunc) backend.StrictHandlerFunc {
+	return next
+}
+
 // authMiddlewareOpenAPIHook is a wrapper function for the auth middleware that ensures the authenticated user is
 // passed to the handler.
 func authMiddlewareOpenAPIHook(next backend.StrictHandlerFunc) backend.StrictHandlerFunc {
@@ -103,6 +117,80 @@ func authMiddlewareOpenAPIHook(next backend.StrictHandlerFunc) backend.StrictHan
 	}
 }
 
+var (
+	errTokenScopeForbidsTokenManagement = errors.New(
+		"personal access tokens cannot be used to manage personal access tokens")
+	errTokenScopeReadOnly = errors.New("personal access token is read only")
+	errTokenScopeForbidsOperation = errors.New(
+		"personal access tokens can only change saved searches and subscriptions")
+)
+
+// isPersonalAccessTokenManagementOperation returns true for the operations that manage personal access tokens.
+func isPersonalAccessTokenManagementOperation(operationID string) bool {
+	switch operationID {
+	case "ListPersonalAccessTokens", "CreatePersonalAccessToken", "RevokePersonalAccessToken":
+		return true
+	}
+
+	return false
+}
+
+// isManageSubscriptionsOperation returns true for the operations that change data and are available to
+// personal access tokens with the manage subscriptions scope.
+func isManageSubscriptionsOperation(operationID string) bool {
+	switch operationID {
+	case "CreateSavedSearch", "UpdateSavedSearch", "RemoveSavedSearch",
+		"CreateSubscription", "UpdateSubscription", "DeleteSubscription":
+		return true
+	}
+
+	return false
+}
+
+// tokenScopeMiddleware restricts the operations available to users authenticated with a personal access token.
+// Personal access tokens can never manage personal access tokens. Every token can be used for safe methods.
+// Only tokens with the manage subscriptions scope can change data, and only with the operations of
+// isManageSubscriptionsOperation. Users authenticated with an interactive session are not restricted.
+func tokenScopeMiddleware(f backend.StrictHandlerFunc, operationID string) backend.StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, req any) (any, error) {
+		user, found := httpmiddlewares.AuthenticatedUserFromContext(ctx)
+		if !found || user.TokenScope == nil {
+			return f(ctx, w, r, req)
+		}
+
+		if err := checkTokenScope(*user.TokenScope, operationID, r.Method); err != nil {
+			GenericErrorFn(ctx, http.StatusForbidden, w, err)
+
+			// nolint:nilnil // WONTFIX - the error response was already written.
+			return nil, nil
+		}
+
+		return f(ctx, w, r, req)
+	}
+}
+
+// checkTokenScope returns an error if a personal access token with the scope cannot be used for the operation.
+func checkTokenScope(scope auth.TokenScope, operationID string, method string) error {
+	if isPersonalAccessTokenManagementOperation(operationID) {
+		return errTokenScopeForbidsTokenManagement
+	}
+
+	if method == http.MethodGet || method == http.MethodHead {
+		return nil
+	}
+
+	switch scope {
+	case auth.TokenScopeReadOnly:
+		return errTokenScopeReadOnly
+	case auth.TokenScopeManageSubscriptions:
+		if !isManageSubscriptionsOperation(operationID) {
+			return errTokenScopeForbidsOperation
+		}
+	}
+
+	return nil
+}
+
 // wrapPostRequestValidationMiddlewareForOpenAPIHook creates a wrapper function for a given middleware.
 // The wrapper function adapts the middleware to the signature expected by the OpenAPI generator.
 func wrapPostRequestValidationMiddlewareForOpenAPIHook(middleware func(http.Handler) http.Handler,
@@ -29,6 +29,7 @@ func createTestID1User() *auth.User {
 	return &auth.User{
 		ID:           "testID1",
 		GitHubUserID: new("123456"),
+		TokenScope:   nil,
 	}
 }
 
@@ -52,7 +53,8 @@ func TestMiddlewaresOrder(t *testing.T) {
 	count := 0
 	var preMiddleware1Hit,
 		preMiddleware2Hit,
-		authMiddlewareHit bool
+		authMiddlewareHit,
+		rateLimitMiddlewareHit bool
 
 	preRequestMiddlewares := []func(http.Handler) http.Handler{
 		recoveryMiddleware,
@@ -89,8 +91,20 @@ func TestMiddlewaresOrder(t *testing.T) {
 			})
 		}
 
+	rateLimitMiddleware :=
+		func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				rateLimitMiddlewareHit = true
+				if count != 3 {
+					t.Errorf("Rate Limit Middleware: Expected count to be 3, got %d", count)
+				}
+				count++
+				next.ServeHTTP(w, r)
+			})
+		}
+
 	mockServer := &mockServerInterface{t: t, expectedUserInCtx: nil, callCount: 0}
-	srv := createOpenAPIServerServer("", mockServer, preRequestMiddlewares, authMiddleware)
+	srv := createOpenAPIServerServer("", mockServer, preRequestMiddlewares, authMiddleware, rateLimitMiddleware, nil)
 	s := httptest.NewServer(srv.Handler)
 	defer s.Close()
 
@@ -98,7 +112,8 @@ func TestMiddlewaresOrder(t *testing.T) {
 
 	if !preMiddleware1Hit ||
 		!preMiddleware2Hit ||
-		!authMiddlewareHit {
+		!authMiddlewareHit ||
+		!rateLimitMiddlewareHit {
 		t.Errorf("expected all middlewares to be hit")
 	}
 
@@ -125,7 +140,7 @@ func testAuthScope(t *testing.T, path string, method string, shouldBePresent boo
 	}
 	mockServer := &mockServerInterface{t: t, expectedUserInCtx: expectedUserInCtx, callCount: 0}
 	srv := createOpenAPIServerServer("", mockServer, []func(http.Handler) http.Handler{
-		recoveryMiddleware}, authMiddleware)
+		recoveryMiddleware}, authMiddleware, nil, nil)
 	s := httptest.NewServer(srv.Handler)
 	defer s.Close()
 
@@ -137,7 +152,7 @@ func testAuthScope(t *testing.T, path string, method string, shouldBePresent boo
 // Bearer authentication scopes to the request context when the route has
 // security schemes configured.
 func TestAuthScopePresentWhenSecurityConfigured(t *testing.T) {
-	testUser := &auth.User{ID: "test", GitHubUserID: new("id2")}
+	testUser := &auth.User{ID: "test", GitHubUserID: new("id2"), TokenScope: nil}
 	testAuthScope(t, "/v1/users/me/saved-searches", http.MethodGet, true, testUser)
 }
 
@@ -158,3 +173,96 @@ func mockAuthMiddleware(u *auth.User) func(http.Handler) http.Handler {
 		})
 	}
 }
+
+func TestTokenScopeMiddleware(t *testing.T) {
+	readOnly := auth.TokenScopeReadOnly
+	manageSubscriptions := auth.TokenScopeManageSubscriptions
+	tests := []struct {
+		name              string
+		scope             *auth.TokenScope
+		path              string
+		method            string
+		expectedCallCount int
+	}{
+		{
+			name:              "interactive session can manage tokens",
+			scope:             nil,
+			path:              "/v1/users/me/tokens",
+			method:            http.MethodGet,
+			expectedCallCount: 1,
+		},
+		{
+			name:              "read only token can read",
+			scope:             &readOnly,
+			path:              "/v1/users/me/saved-searches",
+			method:            http.MethodGet,
+			expectedCallCount: 1,
+		},
+		{
+			name:              "read only token cannot write",
+			scope:             &readOnly,
+			path:              "/v1/users/me/subscriptions/sub1",
+			method:            http.MethodDelete,
+			expectedCallCount: 0,
+		},
+		{
+			name:              "manage subscriptions token can write",
+			scope:             &manageSubscriptions,
+			path:              "/v1/users/me/subscriptions/sub1",
+			method:            http.MethodDelete,
+			expectedCallCount: 1,
+		},
+		{
+			name:              "manage subscriptions token can delete saved searches",
+			scope:             &manageSubscriptions,
+			path:              "/v1/saved-searches/search1",
+			method:            http.MethodDelete,
+			expectedCallCount: 1,
+		},
+		{
+			name:              "manage subscriptions token cannot delete notification channels",
+			scope:             &manageSubscriptions,
+			path:              "/v1/users/me/notification-channels/channel1",
+			method:            http.MethodDelete,
+			expectedCallCount: 0,
+		},
+		{
+			name:              "manage subscriptions token cannot remove saved search collaborators",
+			scope:             &manageSubscriptions,
+			path:              "/v1/saved-searches/search1/collaborators/octocat",
+			method:            http.MethodDelete,
+			expectedCallCount: 0,
+		},
+		{
+			name:              "token cannot manage tokens",
+			scope:             &manageSubscriptions,
+			path:              "/v1/users/me/tokens",
+			method:            http.MethodGet,
+			expectedCallCount: 0,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testUser := &auth.User{ID: "test", GitHubUserID: nil, TokenScope: tc.scope}
+			mockServer := &mockServerInterface{t: t, expectedUserInCtx: testUser, callCount: 0}
+			srv := createOpenAPIServerServer("", mockServer, []func(http.Handler) http.Handler{
+				recoveryMiddleware}, mockAuthMiddleware(testUser), nil, nil)
+			s := httptest.NewServer(srv.Handler)
+			defer s.Close()
+
+			req, err := http.NewRequestWithContext(t.Context(), tc.method, s.URL+tc.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			if tc.expectedCallCount == 0 && resp.StatusCode != http.StatusForbidden {
+				t.Errorf("expected status %d, got %d", http.StatusForbidden, resp.StatusCode)
+			}
+			mockServer.assertCallCount(tc.expectedCallCount)
+		})
+	}
+}
@@ -95,9 +95,10 @@ func (s *Server) PingUser(
 	}
 
 	err = s.wptMetricsStorer.SyncUserProfileInfo(ctx, backendtypes.UserProfile{
-		UserID:       user.ID,
-		GitHubUserID: githubUser.ID,
-		Emails:       verifiedEmails,
+		UserID:         user.ID,
+		GitHubUserID:   githubUser.ID,
+		GitHubUsername: githubUser.Username,
+		Emails:         verifiedEmails,
 	})
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to sync user profile", "error", err, "user", user.ID)
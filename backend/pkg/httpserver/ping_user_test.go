@@ -66,6 +66,7 @@ func TestPingUser(t *testing.T) {
 			authMiddleware: mockAuthMiddleware(&auth.User{
 				ID:           "hi",
 				GitHubUserID: nil,
+				TokenScope:   nil,
 			}),
 			getCurrentUserCfg:      nil,
 			listEmailsCfg:          nil,
@@ -81,6 +82,7 @@ func TestPingUser(t *testing.T) {
 			authMiddleware: mockAuthMiddleware(&auth.User{
 				ID:           "hi",
 				GitHubUserID: new("123456"),
+				TokenScope:   nil,
 			}),
 			getCurrentUserCfg: &mockGetCurrentUserConfig{
 				err:  errTest,
@@ -99,6 +101,7 @@ func TestPingUser(t *testing.T) {
 			authMiddleware: mockAuthMiddleware(&auth.User{
 				ID:           "hi",
 				GitHubUserID: new("123456"),
+				TokenScope:   nil,
 			}),
 			getCurrentUserCfg: &mockGetCurrentUserConfig{
 				err: nil,
@@ -120,6 +123,7 @@ func TestPingUser(t *testing.T) {
 			authMiddleware: mockAuthMiddleware(&auth.User{
 				ID:           "hi",
 				GitHubUserID: new("123456"),
+				TokenScope:   nil,
 			}),
 			getCurrentUserCfg: &mockGetCurrentUserConfig{
 				err: nil,
@@ -141,6 +145,7 @@ func TestPingUser(t *testing.T) {
 			authMiddleware: mockAuthMiddleware(&auth.User{
 				ID:           "hi",
 				GitHubUserID: new("123456"),
+				TokenScope:   nil,
 			}),
 			getCurrentUserCfg: &mockGetCurrentUserConfig{
 				err: nil,
@@ -165,6 +170,7 @@ func TestPingUser(t *testing.T) {
 			authMiddleware: mockAuthMiddleware(&auth.User{
 				ID:           "hi",
 				GitHubUserID: new("123456"),
+				TokenScope:   nil,
 			}),
 			getCurrentUserCfg: &mockGetCurrentUserConfig{
 				err: nil,
@@ -192,8 +198,9 @@ func TestPingUser(t *testing.T) {
 			},
 			syncUserProfileInfoCfg: &MockSyncUserProfileInfoConfig{
 				expectedUserProfile: backendtypes.UserProfile{
-					UserID:       "hi",
-					GitHubUserID: 123456,
+					UserID:         "hi",
+					GitHubUserID:   123456,
+					GitHubUsername: "username",
 					Emails: []string{
 						"email1",
 						"email3",
@@ -212,6 +219,7 @@ func TestPingUser(t *testing.T) {
 			authMiddleware: mockAuthMiddleware(&auth.User{
 				ID:           "hi",
 				GitHubUserID: new("123456"),
+				TokenScope:   nil,
 			}),
 			getCurrentUserCfg: &mockGetCurrentUserConfig{
 				err: nil,
@@ -239,8 +247,9 @@ func TestPingUser(t *testing.T) {
 			},
 			syncUserProfileInfoCfg: &MockSyncUserProfileInfoConfig{
 				expectedUserProfile: backendtypes.UserProfile{
-					UserID:       "hi",
-					GitHubUserID: 123456,
+					UserID:         "hi",
+					GitHubUserID:   123456,
+					GitHubUsername: "username",
 					Emails: []string{
 						"email1",
 						"email3",
@@ -0,0 +1,113 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// PutHotlistFeature handles the PUT request to /v1/saved-searches/{search_id}/hotlist/features/{feature_id}.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) PutHotlistFeature(
+	ctx context.Context,
+	request backend.PutHotlistFeatureRequestObject,
+) (backend.PutHotlistFeatureResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "PutHotlistFeature",
+		func(code int, message string) backend.PutHotlistFeature500JSONResponse {
+			return backend.PutHotlistFeature500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	if request.Body.Position != nil && *request.Body.Position < 0 {
+		fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+		fieldErrors.addFieldError("position", errHotlistInvalidPosition)
+
+		return backend.PutHotlistFeature400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: errMsgInputValidationErrors,
+			Errors:  fieldErrors.fieldErrorMap,
+		}, nil
+	}
+
+	hotlist, err := s.wptMetricsStorer.PutHotlistFeature(ctx,
+		userCheckResult.User.ID, request.SearchId, request.FeatureId, *request.Body)
+	if err != nil {
+		switch {
+		case errors.Is(err, backendtypes.ErrInvalidHotlistFeatures):
+			fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+			fieldErrors.addFieldError("feature_id", errHotlistFeatureRejected)
+
+			return backend.PutHotlistFeature400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: errMsgInputValidationErrors,
+				Errors:  fieldErrors.fieldErrorMap,
+			}, nil
+		case errors.Is(err, backendtypes.ErrUserNotAuthorizedForAction):
+			return backend.PutHotlistFeature403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "forbidden",
+			}, nil
+		case errors.Is(err, backendtypes.ErrEntityDoesNotExist):
+			return backend.PutHotlistFeature404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: "hotlist not found",
+			}, nil
+		case errors.Is(err, backendtypes.ErrHotlistVersionConflict):
+			return backend.PutHotlistFeature409JSONResponse{
+				Code:    http.StatusConflict,
+				Message: "hotlist was changed since the given version",
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to put hotlist feature", "error", err, "searchID", request.SearchId)
+
+		return backend.PutHotlistFeature500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to update hotlist",
+		}, nil
+	}
+
+	s.publishHotlistChanged(ctx, userCheckResult.User.ID, hotlist.Id, "put hotlist feature")
+
+	return backend.PutHotlistFeature200JSONResponse(*hotlist), nil
+}
+
+// publishHotlistChanged publishes the change of the features of a hotlist, which changes the results of its
+// saved search. The change is already committed, so failures are only logged.
+func (s *Server) publishHotlistChanged(ctx context.Context, userID, savedSearchID, operation string) {
+	savedSearch, err := s.wptMetricsStorer.GetSavedSearch(ctx, savedSearchID, &userID)
+	if err != nil {
+		slog.WarnContext(ctx, "unable to get hotlist saved search during "+operation, "error", err,
+			"searchID", savedSearchID)
+
+		return
+	}
+	err = s.eventPublisher.PublishSearchConfigurationChanged(ctx, savedSearch, userID, false)
+	if err != nil {
+		// We should not mark this as a failure. Only log it.
+		slog.WarnContext(ctx, "unable to publish search configuration changed event during "+operation, "error", err)
+	}
+}
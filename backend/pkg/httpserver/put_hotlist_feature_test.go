@@ -0,0 +1,208 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestPutHotlistFeature(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	expectedRequest := backend.PutHotlistFeatureRequest{
+		Version:  2,
+		Position: new(0),
+	}
+	body := `{"version": 2, "position": 0}`
+	errCfg := func(err error) *MockPutHotlistFeatureConfig {
+		return &MockPutHotlistFeatureConfig{
+			expectedUserID:        "test-user",
+			expectedSavedSearchID: "hotlist-id",
+			expectedFeatureID:     "subgrid",
+			expectedRequest:       expectedRequest,
+			output:                nil,
+			err:                   err,
+		}
+	}
+
+	testCases := []struct {
+		name                 string
+		cfg                  *MockPutHotlistFeatureConfig
+		publishErr           error
+		expectedPublishCalls int
+		expectedCallCount    int
+		body                 string
+		expectedResponse     *http.Response
+	}{
+		{
+			name: "success",
+			cfg: &MockPutHotlistFeatureConfig{
+				expectedUserID:        "test-user",
+				expectedSavedSearchID: "hotlist-id",
+				expectedFeatureID:     "subgrid",
+				expectedRequest:       expectedRequest,
+				output: &backend.Hotlist{
+					Id:         "hotlist-id",
+					Version:    3,
+					FeatureIds: []string{"subgrid", "grid"},
+				},
+				err: nil,
+			},
+			expectedCallCount:    1,
+			body:                 body,
+			publishErr:           nil,
+			expectedPublishCalls: 1,
+			expectedResponse: testJSONResponse(http.StatusOK,
+				`{"id":"hotlist-id","version":3,"feature_ids":["subgrid","grid"]}`),
+		},
+		{
+			name: "publish failure does not fail the update",
+			cfg: &MockPutHotlistFeatureConfig{
+				expectedUserID:        "test-user",
+				expectedSavedSearchID: "hotlist-id",
+				expectedFeatureID:     "subgrid",
+				expectedRequest:       expectedRequest,
+				output: &backend.Hotlist{
+					Id:         "hotlist-id",
+					Version:    3,
+					FeatureIds: []string{"subgrid", "grid"},
+				},
+				err: nil,
+			},
+			expectedCallCount:    1,
+			body:                 body,
+			publishErr:           errTest,
+			expectedPublishCalls: 1,
+			expectedResponse: testJSONResponse(http.StatusOK,
+				`{"id":"hotlist-id","version":3,"feature_ids":["subgrid","grid"]}`),
+		},
+		{
+			name:                 "bad request - unknown feature",
+			cfg:                  errCfg(backendtypes.ErrInvalidHotlistFeatures),
+			expectedCallCount:    1,
+			body:                 body,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse: testJSONResponse(http.StatusBadRequest, `{
+				"code":400,
+				"message":"input validation errors",
+				"errors":{"feature_id":"`+errHotlistFeatureRejected.Error()+`"}
+			}`),
+		},
+		{
+			name:                 "forbidden",
+			cfg:                  errCfg(backendtypes.ErrUserNotAuthorizedForAction),
+			expectedCallCount:    1,
+			body:                 body,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse:     testJSONResponse(http.StatusForbidden, `{"code":403,"message":"forbidden"}`),
+		},
+		{
+			name:                 "not found",
+			cfg:                  errCfg(backendtypes.ErrEntityDoesNotExist),
+			expectedCallCount:    1,
+			body:                 body,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse:     testJSONResponse(http.StatusNotFound, `{"code":404,"message":"hotlist not found"}`),
+		},
+		{
+			name:                 "conflict - stale version",
+			cfg:                  errCfg(backendtypes.ErrHotlistVersionConflict),
+			expectedCallCount:    1,
+			body:                 body,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse: testJSONResponse(http.StatusConflict,
+				`{"code":409,"message":"hotlist was changed since the given version"}`),
+		},
+		{
+			name:                 "internal server error",
+			cfg:                  errCfg(errors.New("database error")),
+			expectedCallCount:    1,
+			body:                 body,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to update hotlist"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				putHotlistFeatureCfg: tc.cfg,
+				getSavedSearchCfg: &MockGetSavedSearchConfig{
+					expectedSavedSearchID: "hotlist-id",
+					expectedUserID:        new("test-user"),
+					output:                testHotlistSavedSearch(),
+					err:                   nil,
+				},
+				t: t,
+			}
+			mockPublisher := &MockEventPublisher{
+				t: t,
+				callCountPublishSearchConfigurationChanged: 0,
+				publishSearchConfigurationChangedCfg: &MockPublishSearchConfigurationChangedConfig{
+					expectedResp:       testHotlistSavedSearch(),
+					expectedUserID:     "test-user",
+					expectedIsCreation: false,
+					err:                tc.publishErr,
+				},
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer), withCustomEventPublisher(mockPublisher))
+			req := httptest.NewRequestWithContext(t.Context(),
+				http.MethodPut, "/v1/saved-searches/hotlist-id/hotlist/features/subgrid", strings.NewReader(tc.body))
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountPutHotlistFeature,
+				"PutHotlistFeature", nil)
+			assertMocksExpectations(t, tc.expectedPublishCalls,
+				mockPublisher.callCountPublishSearchConfigurationChanged, "PublishSearchConfigurationChanged", nil)
+		})
+	}
+}
+
+// testHotlistSavedSearch returns the saved search of the hotlist the hotlist tests change.
+func testHotlistSavedSearch() *backend.SavedSearchResponse {
+	return &backend.SavedSearchResponse{
+		Id:             "hotlist-id",
+		Name:           "Hotlist",
+		Query:          "hotlist:hotlist-id",
+		Description:    nil,
+		Permissions:    nil,
+		BookmarkStatus: nil,
+		CreatedAt:      time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+		ForkedFrom:     nil,
+		Tags:           nil,
+		Listed:         new(false),
+		Parameters:     nil,
+	}
+}
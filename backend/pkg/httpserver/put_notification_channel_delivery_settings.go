@@ -0,0 +1,112 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+	"github.com/GoogleChrome/webstatus.dev/lib/workertypes"
+)
+
+const (
+	maxDeliveriesPerHourMin = 1
+	maxDeliveriesPerHourMax = 1000
+)
+
+var errInvalidMaxDeliveriesPerHour = fmt.Errorf("max_deliveries_per_hour must be between %d and %d",
+	maxDeliveriesPerHourMin, maxDeliveriesPerHourMax)
+
+func validateNotificationChannelDeliverySettings(
+	settings *backend.NotificationChannelDeliverySettings) *fieldValidationErrors {
+	fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+
+	if settings.QuietHours != nil {
+		quietHours := workertypes.QuietHours{
+			Start:    settings.QuietHours.Start,
+			End:      settings.QuietHours.End,
+			TimeZone: settings.QuietHours.TimeZone,
+		}
+		if err := quietHours.Validate(); err != nil {
+			fieldErrors.addFieldError("quiet_hours", err)
+		}
+	}
+
+	if settings.MaxDeliveriesPerHour != nil && (*settings.MaxDeliveriesPerHour < maxDeliveriesPerHourMin ||
+		*settings.MaxDeliveriesPerHour > maxDeliveriesPerHourMax) {
+		fieldErrors.addFieldError("max_deliveries_per_hour", errInvalidMaxDeliveriesPerHour)
+	}
+
+	if fieldErrors.hasErrors() {
+		return fieldErrors
+	}
+
+	return nil
+}
+
+// PutNotificationChannelDeliverySettings handles the PUT request to
+// /v1/users/me/notification-channels/{channel_id}/delivery-settings.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) PutNotificationChannelDeliverySettings(
+	ctx context.Context,
+	req backend.PutNotificationChannelDeliverySettingsRequestObject,
+) (backend.PutNotificationChannelDeliverySettingsResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "PutNotificationChannelDeliverySettings",
+		func(code int, message string) backend.PutNotificationChannelDeliverySettings500JSONResponse {
+			return backend.PutNotificationChannelDeliverySettings500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	validationErr := validateNotificationChannelDeliverySettings(req.Body)
+	if validationErr != nil {
+		return backend.PutNotificationChannelDeliverySettings400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: errMsgInputValidationErrors,
+			Errors:  validationErr.fieldErrorMap,
+		}, nil
+	}
+
+	settings, err := s.wptMetricsStorer.PutNotificationChannelDeliverySettings(ctx,
+		userCheckResult.User.ID, req.ChannelId, *req.Body)
+	if err != nil {
+		if errors.Is(err, backendtypes.ErrEntityDoesNotExist) ||
+			errors.Is(err, backendtypes.ErrUserNotAuthorizedForAction) {
+			return backend.PutNotificationChannelDeliverySettings404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: "Notification channel not found or not owned by user",
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to update notification channel delivery settings",
+			"error", err, "channelID", req.ChannelId)
+
+		return backend.PutNotificationChannelDeliverySettings500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "Could not update notification channel delivery settings",
+		}, nil
+	}
+
+	return backend.PutNotificationChannelDeliverySettings200JSONResponse(*settings), nil
+}
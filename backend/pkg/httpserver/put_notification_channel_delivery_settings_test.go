@@ -0,0 +1,188 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestPutNotificationChannelDeliverySettings(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "listUserID1",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	settings := backend.NotificationChannelDeliverySettings{
+		QuietHours: &backend.QuietHours{
+			Start:    "22:00",
+			End:      "07:00",
+			TimeZone: "Europe/Berlin",
+		},
+		MaxDeliveriesPerHour: new(10),
+	}
+	validBody := `
+{
+	"quiet_hours": {
+		"start": "22:00",
+		"end": "07:00",
+		"time_zone": "Europe/Berlin"
+	},
+	"max_deliveries_per_hour": 10
+}`
+
+	testCases := []struct {
+		name              string
+		requestBody       string
+		cfg               *MockPutNotificationChannelDeliverySettingsConfig
+		expectedCallCount int
+		expectedResponse  *http.Response
+	}{
+		{
+			name:        "success",
+			requestBody: validBody,
+			cfg: &MockPutNotificationChannelDeliverySettingsConfig{
+				expectedUserID:    "listUserID1",
+				expectedChannelID: "channel1",
+				expectedSettings:  settings,
+				output:            &settings,
+				err:               nil,
+			},
+			expectedCallCount: 1,
+			expectedResponse:  testJSONResponse(200, validBody),
+		},
+		{
+			name:        "clear settings",
+			requestBody: `{}`,
+			cfg: &MockPutNotificationChannelDeliverySettingsConfig{
+				expectedUserID:    "listUserID1",
+				expectedChannelID: "channel1",
+				expectedSettings: backend.NotificationChannelDeliverySettings{
+					QuietHours:           nil,
+					MaxDeliveriesPerHour: nil,
+				},
+				output: &backend.NotificationChannelDeliverySettings{
+					QuietHours:           nil,
+					MaxDeliveriesPerHour: nil,
+				},
+				err: nil,
+			},
+			expectedCallCount: 1,
+			expectedResponse:  testJSONResponse(200, `{}`),
+		},
+		{
+			name: "invalid settings",
+			requestBody: `
+{
+	"quiet_hours": {
+		"start": "22:00",
+		"end": "22:00",
+		"time_zone": "Europe/Berlin"
+	},
+	"max_deliveries_per_hour": 0
+}`,
+			cfg:               nil,
+			expectedCallCount: 0,
+			expectedResponse: testJSONResponse(400, `
+{
+	"code": 400,
+	"message": "input validation errors",
+	"errors": {
+		"quiet_hours": "invalid quiet hours: start and end must differ",
+		"max_deliveries_per_hour": "max_deliveries_per_hour must be between 1 and 1000"
+	}
+}`),
+		},
+		{
+			name: "unknown time zone",
+			requestBody: `
+{
+	"quiet_hours": {
+		"start": "22:00",
+		"end": "07:00",
+		"time_zone": "Mars/Olympus_Mons"
+	}
+}`,
+			cfg:               nil,
+			expectedCallCount: 0,
+			expectedResponse: testJSONResponse(400, `
+{
+	"code": 400,
+	"message": "input validation errors",
+	"errors": {
+		"quiet_hours": "invalid quiet hours: unknown time zone \"Mars/Olympus_Mons\""
+	}
+}`),
+		},
+		{
+			name:        "not found",
+			requestBody: validBody,
+			cfg: &MockPutNotificationChannelDeliverySettingsConfig{
+				expectedUserID:    "listUserID1",
+				expectedChannelID: "channel1",
+				expectedSettings:  settings,
+				output:            nil,
+				err:               backendtypes.ErrEntityDoesNotExist,
+			},
+			expectedCallCount: 1,
+			expectedResponse: testJSONResponse(404, `
+			{
+				"code":404,
+				"message":"Notification channel not found or not owned by user"
+			}`),
+		},
+		{
+			name:        "500 error",
+			requestBody: validBody,
+			cfg: &MockPutNotificationChannelDeliverySettingsConfig{
+				expectedUserID:    "listUserID1",
+				expectedChannelID: "channel1",
+				expectedSettings:  settings,
+				output:            nil,
+				err:               errTest,
+			},
+			expectedCallCount: 1,
+			expectedResponse: testJSONResponse(500, `
+			{
+				"code":500,
+				"message":"Could not update notification channel delivery settings"
+			}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				putNotificationChannelDeliverySettingsCfg: tc.cfg,
+				t: t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPut,
+				"/v1/users/me/notification-channels/channel1/delivery-settings", strings.NewReader(tc.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse,
+				[]testServerOption{withAuthMiddleware(mockAuthMiddleware(testUser))}...)
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountPutNotificationChannelDeliverySettings,
+				"PutNotificationChannelDeliverySettings", nil)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+var errSavedSearchCollaboratorInvalidRole = errors.New(
+	"role must be saved_search_editor or saved_search_viewer; transfer the ownership instead")
+
+// PutSavedSearchCollaborator handles the PUT request to
+// /v1/saved-searches/{search_id}/collaborators/{github_username}.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) PutSavedSearchCollaborator(
+	ctx context.Context,
+	request backend.PutSavedSearchCollaboratorRequestObject,
+) (backend.PutSavedSearchCollaboratorResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "PutSavedSearchCollaborator",
+		func(code int, message string) backend.PutSavedSearchCollaborator500JSONResponse {
+			return backend.PutSavedSearchCollaborator500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	if request.Body.Role != backend.SavedSearchEditor && request.Body.Role != backend.SavedSearchViewer {
+		fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+		fieldErrors.addFieldError("role", errSavedSearchCollaboratorInvalidRole)
+
+		return backend.PutSavedSearchCollaborator400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: errMsgInputValidationErrors,
+			Errors:  fieldErrors.fieldErrorMap,
+		}, nil
+	}
+
+	collaborator, err := s.wptMetricsStorer.PutSavedSearchCollaborator(ctx,
+		userCheckResult.User.ID, request.SearchId, request.GithubUsername, *request.Body)
+	if err != nil {
+		switch {
+		case errors.Is(err, backendtypes.ErrInvalidCollaboratorChange):
+			fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+			fieldErrors.addFieldError("github_username", errSavedSearchCollaboratorIsOwner)
+
+			return backend.PutSavedSearchCollaborator400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: errMsgInputValidationErrors,
+				Errors:  fieldErrors.fieldErrorMap,
+			}, nil
+		case errors.Is(err, backendtypes.ErrUserNotAuthorizedForAction):
+			return backend.PutSavedSearchCollaborator403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "forbidden",
+			}, nil
+		case errors.Is(err, backendtypes.ErrSavedSearchMaxCollaborators):
+			return backend.PutSavedSearchCollaborator403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "saved search has reached the maximum number of collaborators",
+			}, nil
+		case errors.Is(err, backendtypes.ErrEntityDoesNotExist):
+			return backend.PutSavedSearchCollaborator404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: "no webstatus.dev user with this GitHub username",
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to put saved search collaborator", "error", err,
+			"searchID", request.SearchId)
+
+		return backend.PutSavedSearchCollaborator500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to update collaborator",
+		}, nil
+	}
+
+	return backend.PutSavedSearchCollaborator200JSONResponse(*collaborator), nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestPutSavedSearchCollaborator(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	body := `{"role": "saved_search_editor"}`
+	errCfg := func(err error) *MockPutSavedSearchCollaboratorConfig {
+		return &MockPutSavedSearchCollaboratorConfig{
+			expectedUserID:         "test-user",
+			expectedSavedSearchID:  "search-id",
+			expectedGitHubUsername: "octocat",
+			expectedRequest:        backend.PutSavedSearchCollaboratorRequest{Role: backend.SavedSearchEditor},
+			output:                 nil,
+			err:                    err,
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		cfg               *MockPutSavedSearchCollaboratorConfig
+		expectedCallCount int
+		body              string
+		expectedResponse  *http.Response
+	}{
+		{
+			name: "success",
+			cfg: &MockPutSavedSearchCollaboratorConfig{
+				expectedUserID:         "test-user",
+				expectedSavedSearchID:  "search-id",
+				expectedGitHubUsername: "octocat",
+				expectedRequest:        backend.PutSavedSearchCollaboratorRequest{Role: backend.SavedSearchEditor},
+				output: &backend.SavedSearchCollaborator{
+					GithubUsername: new("octocat"),
+					Role:           backend.SavedSearchEditor,
+				},
+				err: nil,
+			},
+			expectedCallCount: 1,
+			body:              body,
+			expectedResponse: testJSONResponse(http.StatusOK,
+				`{"github_username":"octocat","role":"saved_search_editor"}`),
+		},
+		{
+			name:              "bad request - owner role",
+			cfg:               nil,
+			expectedCallCount: 0,
+			body:              `{"role": "saved_search_owner"}`,
+			expectedResponse: testJSONResponse(http.StatusBadRequest, `{
+				"code":400,
+				"message":"input validation errors",
+				"errors":{"role":"`+errSavedSearchCollaboratorInvalidRole.Error()+`"}
+			}`),
+		},
+		{
+			name:              "bad request - target is the owner",
+			cfg:               errCfg(backendtypes.ErrInvalidCollaboratorChange),
+			expectedCallCount: 1,
+			body:              body,
+			expectedResponse: testJSONResponse(http.StatusBadRequest, `{
+				"code":400,
+				"message":"input validation errors",
+				"errors":{"github_username":"`+errSavedSearchCollaboratorIsOwner.Error()+`"}
+			}`),
+		},
+		{
+			name:              "forbidden - not the owner",
+			cfg:               errCfg(backendtypes.ErrUserNotAuthorizedForAction),
+			expectedCallCount: 1,
+			body:              body,
+			expectedResponse:  testJSONResponse(http.StatusForbidden, `{"code":403,"message":"forbidden"}`),
+		},
+		{
+			name:              "forbidden - collaborator limit",
+			cfg:               errCfg(backendtypes.ErrSavedSearchMaxCollaborators),
+			expectedCallCount: 1,
+			body:              body,
+			expectedResponse: testJSONResponse(http.StatusForbidden,
+				`{"code":403,"message":"saved search has reached the maximum number of collaborators"}`),
+		},
+		{
+			name:              "not found - unknown username",
+			cfg:               errCfg(backendtypes.ErrEntityDoesNotExist),
+			expectedCallCount: 1,
+			body:              body,
+			expectedResponse: testJSONResponse(http.StatusNotFound,
+				`{"code":404,"message":"no webstatus.dev user with this GitHub username"}`),
+		},
+		{
+			name:              "internal server error",
+			cfg:               errCfg(errors.New("database error")),
+			expectedCallCount: 1,
+			body:              body,
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to update collaborator"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				putSavedSearchCollaboratorCfg: tc.cfg,
+				t:                             t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			req := httptest.NewRequestWithContext(t.Context(),
+				http.MethodPut, "/v1/saved-searches/search-id/collaborators/octocat", strings.NewReader(tc.body))
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountPutSavedSearchCollaborator,
+				"PutSavedSearchCollaborator", nil)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func validateWatchFeatureRequest(input *backend.WatchFeatureRequest) *fieldValidationErrors {
+	fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+
+	// Enforce XOR constraint for channel_id and channel_type.
+	if (input.ChannelId == nil && input.ChannelType == nil) || (input.ChannelId != nil && input.ChannelType != nil) {
+		err := errors.New("must provide exactly one of 'channel_id' or 'channel_type'")
+		fieldErrors.addFieldError("channel_id", err)
+		fieldErrors.addFieldError("channel_type", err)
+	}
+
+	validateSubscriptionTrigger(&input.Triggers, true, fieldErrors)
+
+	validateSubscriptionFrequency(&input.Frequency, true, fieldErrors)
+
+	if input.ChannelId != nil {
+		validateSubscriptionChannelID(*input.ChannelId, fieldErrors)
+	}
+
+	if fieldErrors.hasErrors() {
+		return fieldErrors
+	}
+
+	return nil
+}
+
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) PutWatchedFeature(
+	ctx context.Context,
+	request backend.PutWatchedFeatureRequestObject,
+) (backend.PutWatchedFeatureResponseObject, error) {
+	userCheck := CheckAuthenticatedUser[backend.PutWatchedFeatureResponseObject](ctx, "PutWatchedFeature",
+		func(code int, message string) backend.PutWatchedFeatureResponseObject {
+			return backend.PutWatchedFeature500JSONResponse(backend.BasicErrorModel{Code: code, Message: message})
+		})
+	if userCheck.User == nil {
+		return userCheck.Response, nil
+	}
+	validationErr := validateWatchFeatureRequest(request.Body)
+	if validationErr != nil {
+		return backend.PutWatchedFeature400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: errMsgInputValidationErrors,
+			Errors:  validationErr.fieldErrorMap,
+		}, nil
+	}
+
+	resp, err := s.wptMetricsStorer.PutWatchedFeature(ctx, userCheck.User.ID, request.FeatureId, *request.Body)
+	if err != nil {
+		if errors.Is(err, backendtypes.ErrEntityDoesNotExist) {
+			return backend.PutWatchedFeature404JSONResponse(
+				backend.BasicErrorModel{
+					Code:    http.StatusNotFound,
+					Message: "feature not found",
+				},
+			), nil
+		} else if errors.Is(err, backendtypes.ErrUserNotAuthorizedForAction) {
+			return backend.PutWatchedFeature403JSONResponse(
+				backend.BasicErrorModel{
+					Code:    http.StatusForbidden,
+					Message: "user not authorized to watch this feature using the specified channel",
+				},
+			), nil
+		} else if errors.Is(err, backendtypes.ErrUserMaxSubscriptions) {
+			return backend.PutWatchedFeature403JSONResponse(
+				backend.BasicErrorModel{
+					Code:    http.StatusForbidden,
+					Message: "user has reached the maximum number of allowed subscriptions",
+				},
+			), nil
+		}
+		slog.ErrorContext(ctx, "failed to watch feature", "error", err, "feature_id", request.FeatureId)
+
+		return backend.PutWatchedFeature500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "could not watch feature",
+		}, nil
+	}
+
+	return backend.PutWatchedFeature200JSONResponse(*resp), nil
+}
@@ -0,0 +1,289 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestPutWatchedFeature(t *testing.T) {
+	now := time.Now()
+	channelIDStr := "channel-id"
+	rss := backend.WatchFeatureRequestChannelTypeRss
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	watched := &backend.WatchedFeature{
+		FeatureId:   "grid",
+		FeatureName: "CSS Grid",
+		Subscription: backend.SubscriptionResponse{
+			Id: "sub-id",
+			Subscribable: backend.SavedSearchInfo{
+				Id:   "search-id",
+				Name: "CSS Grid",
+			},
+			ChannelId: "channel-id",
+			Triggers: []backend.SubscriptionTriggerResponseItem{
+				{
+					Value: backendtypes.AttemptToStoreSubscriptionTrigger(
+						backend.SubscriptionTriggerFeatureBaselineToWidely),
+					RawValue: nil,
+				},
+			},
+			Frequency:   backend.SubscriptionFrequencyWeekly,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			ChannelType: backend.SubscriptionResponseChannelTypeEmail,
+		},
+	}
+	expectedRequest := backend.WatchFeatureRequest{
+		ChannelId:   &channelIDStr,
+		ChannelType: nil,
+		Triggers: []backend.SubscriptionTriggerWritable{
+			backend.SubscriptionTriggerFeatureBaselineToWidely},
+		Frequency: backend.SubscriptionFrequencyWeekly,
+	}
+	body := `{
+		"channel_id": "channel-id",
+		"triggers": ["feature_baseline_to_widely"],
+		"frequency": "weekly"
+	}`
+
+	testCases := []struct {
+		name                 string
+		cfg                  *MockPutWatchedFeatureConfig
+		expectedCallCount    int
+		authMiddlewareOption testServerOption
+		request              *http.Request
+		expectedResponse     *http.Response
+	}{
+		{
+			name: "success",
+			cfg: &MockPutWatchedFeatureConfig{
+				expectedUserID:    "test-user",
+				expectedFeatureID: "grid",
+				expectedRequest:   expectedRequest,
+				output:            watched,
+				err:               nil,
+			},
+			expectedCallCount:    1,
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodPut,
+				"/v1/users/me/watched-features/grid",
+				strings.NewReader(body),
+			),
+			expectedResponse: testJSONResponse(http.StatusOK, `{
+				"feature_id":"grid",
+				"feature_name":"CSS Grid",
+				"subscription":{
+					"id":"sub-id",
+					"subscribable": {"id":"search-id", "name":"CSS Grid"},
+					"channel_id":"channel-id",
+					"channel_type":"email",
+					"triggers": [{"value":"feature_baseline_to_widely"}],
+					"frequency":"weekly",
+					"created_at":"`+now.Format(time.RFC3339Nano)+`",
+					"updated_at":"`+now.Format(time.RFC3339Nano)+`"
+				}
+			}`),
+		},
+		{
+			name: "success - rss channel type",
+			cfg: &MockPutWatchedFeatureConfig{
+				expectedUserID:    "test-user",
+				expectedFeatureID: "grid",
+				expectedRequest: backend.WatchFeatureRequest{
+					ChannelId:   nil,
+					ChannelType: &rss,
+					Triggers:    []backend.SubscriptionTriggerWritable{},
+					Frequency:   backend.SubscriptionFrequencyImmediate,
+				},
+				output: watched,
+				err:    nil,
+			},
+			expectedCallCount:    1,
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodPut,
+				"/v1/users/me/watched-features/grid",
+				strings.NewReader(`{"channel_type": "rss", "triggers": [], "frequency": "immediate"}`),
+			),
+			expectedResponse: testJSONResponse(http.StatusOK, `{
+				"feature_id":"grid",
+				"feature_name":"CSS Grid",
+				"subscription":{
+					"id":"sub-id",
+					"subscribable": {"id":"search-id", "name":"CSS Grid"},
+					"channel_id":"channel-id",
+					"channel_type":"email",
+					"triggers": [{"value":"feature_baseline_to_widely"}],
+					"frequency":"weekly",
+					"created_at":"`+now.Format(time.RFC3339Nano)+`",
+					"updated_at":"`+now.Format(time.RFC3339Nano)+`"
+				}
+			}`),
+		},
+		{
+			name:                 "bad request - invalid trigger",
+			cfg:                  nil,
+			expectedCallCount:    0,
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodPut,
+				"/v1/users/me/watched-features/grid",
+				strings.NewReader(`{"channel_id": "channel-id", "triggers": ["bad"], "frequency": "weekly"}`),
+			),
+			expectedResponse: testJSONResponse(http.StatusBadRequest, `
+			{
+				"code":400,
+				"message":"input validation errors",
+				"errors":{
+					"triggers":"`+errSubscriptionInvalidTrigger.Error()+`"
+				}
+			}`),
+		},
+		{
+			name:                 "bad request - both channel_id and channel_type",
+			cfg:                  nil,
+			expectedCallCount:    0,
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodPut,
+				"/v1/users/me/watched-features/grid",
+				strings.NewReader(
+					`{"channel_id": "channel-id", "channel_type": "rss", "triggers": [], "frequency": "weekly"}`),
+			),
+			expectedResponse: testJSONResponse(http.StatusBadRequest, `
+			{
+				"code":400,
+				"message":"input validation errors",
+				"errors":{
+					"channel_id":"must provide exactly one of 'channel_id' or 'channel_type'",
+					"channel_type":"must provide exactly one of 'channel_id' or 'channel_type'"
+				}
+			}`),
+		},
+		{
+			name: "not found - unknown feature",
+			cfg: &MockPutWatchedFeatureConfig{
+				expectedUserID:    "test-user",
+				expectedFeatureID: "grid",
+				expectedRequest:   expectedRequest,
+				output:            nil,
+				err:               backendtypes.ErrEntityDoesNotExist,
+			},
+			expectedCallCount:    1,
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodPut,
+				"/v1/users/me/watched-features/grid",
+				strings.NewReader(body),
+			),
+			expectedResponse: testJSONResponse(http.StatusNotFound, `{"code":404,"message":"feature not found"}`),
+		},
+		{
+			name: "forbidden - user not authorized",
+			cfg: &MockPutWatchedFeatureConfig{
+				expectedUserID:    "test-user",
+				expectedFeatureID: "grid",
+				expectedRequest:   expectedRequest,
+				output:            nil,
+				err:               backendtypes.ErrUserNotAuthorizedForAction,
+			},
+			expectedCallCount:    1,
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodPut,
+				"/v1/users/me/watched-features/grid",
+				strings.NewReader(body),
+			),
+			expectedResponse: testJSONResponse(http.StatusForbidden, `{
+				"code":403,
+				"message":"user not authorized to watch this feature using the specified channel"
+			}`),
+		},
+		{
+			name: "forbidden - user max subscriptions reached",
+			cfg: &MockPutWatchedFeatureConfig{
+				expectedUserID:    "test-user",
+				expectedFeatureID: "grid",
+				expectedRequest:   expectedRequest,
+				output:            nil,
+				err:               backendtypes.ErrUserMaxSubscriptions,
+			},
+			expectedCallCount:    1,
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodPut,
+				"/v1/users/me/watched-features/grid",
+				strings.NewReader(body),
+			),
+			expectedResponse: testJSONResponse(http.StatusForbidden, `{
+				"code":403,
+				"message":"user has reached the maximum number of allowed subscriptions"
+			}`),
+		},
+		{
+			name: "internal server error",
+			cfg: &MockPutWatchedFeatureConfig{
+				expectedUserID:    "test-user",
+				expectedFeatureID: "grid",
+				expectedRequest:   expectedRequest,
+				output:            nil,
+				err:               errors.New("database error"),
+			},
+			expectedCallCount:    1,
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodPut,
+				"/v1/users/me/watched-features/grid",
+				strings.NewReader(body),
+			),
+			expectedResponse: testJSONResponse(http.StatusInternalServerError, `{
+				"code":500,
+				"message":"could not watch feature"
+			}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				putWatchedFeatureCfg: tc.cfg,
+				t:                    t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			assertTestServerRequest(t, myServer, tc.request, tc.expectedResponse, tc.authMiddlewareOption)
+			assertMocksExpectations(t,
+				tc.expectedCallCount,
+				mockStorer.callCountPutWatchedFeature,
+				"PutWatchedFeature",
+				nil)
+		})
+	}
+}
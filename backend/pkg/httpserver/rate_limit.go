@@ -0,0 +1,56 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"net/http"
+	"strings"
+)
+
+const (
+	// defaultRateLimitCost is the cost of most routes, which read a few rows.
+	defaultRateLimitCost = 1
+	// statsRateLimitCost is the cost of the routes that aggregate metrics over many features.
+	statsRateLimitCost = 5
+	// searchRateLimitCost is the cost of the routes that run a feature search query.
+	searchRateLimitCost = 5
+	// timelineRateLimitCost is the cost of the routes that read the history of a feature from several tables.
+	timelineRateLimitCost = 5
+	// exportRateLimitCost is the cost of the routes that read every page of a feature search.
+	exportRateLimitCost = 20
+)
+
+// RateLimitCost returns the number of tokens a request costs. It relies on the route pattern that matched the
+// request so it must only be called after routing.
+// Searches, aggregated statistics and feature timelines cost more because they drive most of the Spanner load.
+func RateLimitCost(r *http.Request) int64 {
+	// Patterns are in the form "METHOD /path".
+	_, path, _ := strings.Cut(r.Pattern, " ")
+	switch {
+	case path == "/v1/healthchecks/liveness":
+		// Health checks are never limited.
+		return 0
+	case path == "/v1/features:export", path == "/v1/compat:report":
+		return exportRateLimitCost
+	case path == "/v1/features", path == "/v1/features:batchGet":
+		return searchRateLimitCost
+	case strings.Contains(path, "/stats/"), path == "/v1/saved-searches/{search_id}/badge.svg":
+		return statsRateLimitCost
+	case path == "/v1/features/{feature_id}/timeline":
+		return timelineRateLimitCost
+	}
+
+	return defaultRateLimitCost
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRateLimitCost(t *testing.T) {
+	tests := []struct {
+		pattern      string
+		expectedCost int64
+	}{
+		{pattern: "GET /v1/healthchecks/liveness", expectedCost: 0},
+		{pattern: "GET /v1/features", expectedCost: searchRateLimitCost},
+		{pattern: "GET /v1/features:export", expectedCost: exportRateLimitCost},
+		{pattern: "POST /v1/features:batchGet", expectedCost: searchRateLimitCost},
+		{pattern: "POST /v1/compat:report", expectedCost: exportRateLimitCost},
+		{pattern: "GET /v1/stats/baseline_status/low_date_feature_counts", expectedCost: statsRateLimitCost},
+		{pattern: "GET /v1/saved-searches/{search_id}/stats/browser_counts", expectedCost: statsRateLimitCost},
+		{pattern: "GET /v1/saved-searches/{search_id}/badge.svg", expectedCost: statsRateLimitCost},
+		{pattern: "GET /v1/features/{feature_id}", expectedCost: defaultRateLimitCost},
+		{pattern: "GET /v1/features/{feature_id}/badge.svg", expectedCost: defaultRateLimitCost},
+		{pattern: "GET /v1/features/{feature_id}/timeline", expectedCost: timelineRateLimitCost},
+		{pattern: "POST /v1/users/me/subscriptions", expectedCost: defaultRateLimitCost},
+	}
+	for _, tc := range tests {
+		t.Run(tc.pattern, func(t *testing.T) {
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil)
+			req.Pattern = tc.pattern
+			if cost := RateLimitCost(req); cost != tc.expectedCost {
+				t.Errorf("expected cost %d, got %d", tc.expectedCost, cost)
+			}
+		})
+	}
+}
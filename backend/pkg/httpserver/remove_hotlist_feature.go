@@ -0,0 +1,76 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// RemoveHotlistFeature handles the DELETE request to /v1/saved-searches/{search_id}/hotlist/features/{feature_id}.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) RemoveHotlistFeature(
+	ctx context.Context,
+	request backend.RemoveHotlistFeatureRequestObject,
+) (backend.RemoveHotlistFeatureResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "RemoveHotlistFeature",
+		func(code int, message string) backend.RemoveHotlistFeature500JSONResponse {
+			return backend.RemoveHotlistFeature500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	hotlist, err := s.wptMetricsStorer.RemoveHotlistFeature(ctx,
+		userCheckResult.User.ID, request.SearchId, request.FeatureId, request.Params.Version)
+	if err != nil {
+		switch {
+		case errors.Is(err, backendtypes.ErrUserNotAuthorizedForAction):
+			return backend.RemoveHotlistFeature403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "forbidden",
+			}, nil
+		case errors.Is(err, backendtypes.ErrEntityDoesNotExist):
+			return backend.RemoveHotlistFeature404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: "hotlist or feature not found",
+			}, nil
+		case errors.Is(err, backendtypes.ErrHotlistVersionConflict):
+			return backend.RemoveHotlistFeature409JSONResponse{
+				Code:    http.StatusConflict,
+				Message: "hotlist was changed since the given version",
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to remove hotlist feature", "error", err, "searchID", request.SearchId)
+
+		return backend.RemoveHotlistFeature500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to update hotlist",
+		}, nil
+	}
+
+	s.publishHotlistChanged(ctx, userCheckResult.User.ID, hotlist.Id, "remove hotlist feature")
+
+	return backend.RemoveHotlistFeature200JSONResponse(*hotlist), nil
+}
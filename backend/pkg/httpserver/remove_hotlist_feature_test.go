@@ -0,0 +1,157 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestRemoveHotlistFeature(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	errCfg := func(err error) *MockRemoveHotlistFeatureConfig {
+		return &MockRemoveHotlistFeatureConfig{
+			expectedUserID:        "test-user",
+			expectedSavedSearchID: "hotlist-id",
+			expectedFeatureID:     "subgrid",
+			expectedVersion:       2,
+			output:                nil,
+			err:                   err,
+		}
+	}
+
+	testCases := []struct {
+		name                 string
+		cfg                  *MockRemoveHotlistFeatureConfig
+		publishErr           error
+		expectedPublishCalls int
+		expectedResponse     *http.Response
+	}{
+		{
+			name: "success",
+			cfg: &MockRemoveHotlistFeatureConfig{
+				expectedUserID:        "test-user",
+				expectedSavedSearchID: "hotlist-id",
+				expectedFeatureID:     "subgrid",
+				expectedVersion:       2,
+				output: &backend.Hotlist{
+					Id:         "hotlist-id",
+					Version:    3,
+					FeatureIds: []string{"grid"},
+				},
+				err: nil,
+			},
+			publishErr:           nil,
+			expectedPublishCalls: 1,
+			expectedResponse: testJSONResponse(http.StatusOK,
+				`{"id":"hotlist-id","version":3,"feature_ids":["grid"]}`),
+		},
+		{
+			name: "publish failure does not fail the update",
+			cfg: &MockRemoveHotlistFeatureConfig{
+				expectedUserID:        "test-user",
+				expectedSavedSearchID: "hotlist-id",
+				expectedFeatureID:     "subgrid",
+				expectedVersion:       2,
+				output: &backend.Hotlist{
+					Id:         "hotlist-id",
+					Version:    3,
+					FeatureIds: []string{"grid"},
+				},
+				err: nil,
+			},
+			publishErr:           errTest,
+			expectedPublishCalls: 1,
+			expectedResponse: testJSONResponse(http.StatusOK,
+				`{"id":"hotlist-id","version":3,"feature_ids":["grid"]}`),
+		},
+		{
+			name:                 "forbidden",
+			cfg:                  errCfg(backendtypes.ErrUserNotAuthorizedForAction),
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse:     testJSONResponse(http.StatusForbidden, `{"code":403,"message":"forbidden"}`),
+		},
+		{
+			name:                 "not found",
+			cfg:                  errCfg(backendtypes.ErrEntityDoesNotExist),
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse: testJSONResponse(http.StatusNotFound,
+				`{"code":404,"message":"hotlist or feature not found"}`),
+		},
+		{
+			name:                 "conflict - stale version",
+			cfg:                  errCfg(backendtypes.ErrHotlistVersionConflict),
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse: testJSONResponse(http.StatusConflict,
+				`{"code":409,"message":"hotlist was changed since the given version"}`),
+		},
+		{
+			name:                 "internal server error",
+			cfg:                  errCfg(errors.New("database error")),
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to update hotlist"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				removeHotlistFeatureCfg: tc.cfg,
+				getSavedSearchCfg: &MockGetSavedSearchConfig{
+					expectedSavedSearchID: "hotlist-id",
+					expectedUserID:        new("test-user"),
+					output:                testHotlistSavedSearch(),
+					err:                   nil,
+				},
+				t: t,
+			}
+			mockPublisher := &MockEventPublisher{
+				t: t,
+				callCountPublishSearchConfigurationChanged: 0,
+				publishSearchConfigurationChangedCfg: &MockPublishSearchConfigurationChangedConfig{
+					expectedResp:       testHotlistSavedSearch(),
+					expectedUserID:     "test-user",
+					expectedIsCreation: false,
+					err:                tc.publishErr,
+				},
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer), withCustomEventPublisher(mockPublisher))
+			req := httptest.NewRequestWithContext(t.Context(),
+				http.MethodDelete, "/v1/saved-searches/hotlist-id/hotlist/features/subgrid?version=2", nil)
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, 1, mockStorer.callCountRemoveHotlistFeature,
+				"RemoveHotlistFeature", nil)
+			assertMocksExpectations(t, tc.expectedPublishCalls,
+				mockPublisher.callCountPublishSearchConfigurationChanged, "PublishSearchConfigurationChanged", nil)
+		})
+	}
+}
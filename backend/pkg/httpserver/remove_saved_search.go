@@ -40,7 +40,8 @@ func (s *Server) RemoveSavedSearch(
 		return userCheckResult.Response, nil
 	}
 
-	err := s.wptMetricsStorer.DeleteUserSavedSearch(ctx, userCheckResult.User.ID, request.SearchId)
+	force := request.Params.Force != nil && *request.Params.Force
+	err := s.wptMetricsStorer.DeleteUserSavedSearch(ctx, userCheckResult.User.ID, request.SearchId, force)
 	if err != nil {
 		if errors.Is(err, backendtypes.ErrEntityDoesNotExist) {
 			return backend.RemoveSavedSearch404JSONResponse{
@@ -52,6 +53,11 @@ func (s *Server) RemoveSavedSearch(
 				Code:    http.StatusForbidden,
 				Message: "forbidden",
 			}, nil
+		} else if errors.Is(err, backendtypes.ErrSavedSearchReferenced) {
+			return backend.RemoveSavedSearch409JSONResponse{
+				Code:    http.StatusConflict,
+				Message: errMsgSavedSearchReferenced,
+			}, nil
 		}
 
 		slog.ErrorContext(ctx, "unknown error deleting saved search", "error", err)
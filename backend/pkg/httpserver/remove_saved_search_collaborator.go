@@ -0,0 +1,78 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+var errSavedSearchCollaboratorIsOwner = errors.New("the owner's role can only change by transferring the ownership")
+
+// RemoveSavedSearchCollaborator handles the DELETE request to
+// /v1/saved-searches/{search_id}/collaborators/{github_username}.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) RemoveSavedSearchCollaborator(
+	ctx context.Context,
+	request backend.RemoveSavedSearchCollaboratorRequestObject,
+) (backend.RemoveSavedSearchCollaboratorResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "RemoveSavedSearchCollaborator",
+		func(code int, message string) backend.RemoveSavedSearchCollaborator500JSONResponse {
+			return backend.RemoveSavedSearchCollaborator500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	err := s.wptMetricsStorer.RemoveSavedSearchCollaborator(ctx,
+		userCheckResult.User.ID, request.SearchId, request.GithubUsername)
+	if err != nil {
+		switch {
+		case errors.Is(err, backendtypes.ErrInvalidCollaboratorChange):
+			return backend.RemoveSavedSearchCollaborator400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: errSavedSearchCollaboratorIsOwner.Error(),
+			}, nil
+		case errors.Is(err, backendtypes.ErrUserNotAuthorizedForAction):
+			return backend.RemoveSavedSearchCollaborator403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "forbidden",
+			}, nil
+		case errors.Is(err, backendtypes.ErrEntityDoesNotExist):
+			return backend.RemoveSavedSearchCollaborator404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: "collaborator not found",
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to remove saved search collaborator", "error", err,
+			"searchID", request.SearchId)
+
+		return backend.RemoveSavedSearchCollaborator500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to remove collaborator",
+		}, nil
+	}
+
+	return backend.RemoveSavedSearchCollaborator204Response{}, nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+)
+
+func TestRemoveSavedSearchCollaborator(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+
+	testCases := []struct {
+		name             string
+		err              error
+		expectedResponse *http.Response
+	}{
+		{
+			name:             "success",
+			err:              nil,
+			expectedResponse: createEmptyBodyResponse(http.StatusNoContent),
+		},
+		{
+			name: "bad request - owner",
+			err:  backendtypes.ErrInvalidCollaboratorChange,
+			expectedResponse: testJSONResponse(http.StatusBadRequest,
+				`{"code":400,"message":"`+errSavedSearchCollaboratorIsOwner.Error()+`"}`),
+		},
+		{
+			name:             "forbidden",
+			err:              backendtypes.ErrUserNotAuthorizedForAction,
+			expectedResponse: testJSONResponse(http.StatusForbidden, `{"code":403,"message":"forbidden"}`),
+		},
+		{
+			name: "not found",
+			err:  backendtypes.ErrEntityDoesNotExist,
+			expectedResponse: testJSONResponse(http.StatusNotFound,
+				`{"code":404,"message":"collaborator not found"}`),
+		},
+		{
+			name: "internal server error",
+			err:  errors.New("database error"),
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to remove collaborator"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				removeSavedSearchCollaboratorCfg: &MockRemoveSavedSearchCollaboratorConfig{
+					expectedUserID:         "test-user",
+					expectedSavedSearchID:  "search-id",
+					expectedGitHubUsername: "octocat",
+					err:                    tc.err,
+				},
+				t: t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			req := httptest.NewRequestWithContext(t.Context(),
+				http.MethodDelete, "/v1/saved-searches/search-id/collaborators/octocat", nil)
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, 1, mockStorer.callCountRemoveSavedSearchCollaborator,
+				"RemoveSavedSearchCollaborator", nil)
+		})
+	}
+}
@@ -31,6 +31,7 @@ func TestRemoveSavedSearch(t *testing.T) {
 			cfg: &MockDeleteUserSavedSearchConfig{
 				expectedSavedSearchID: "saved-search-id",
 				expectedUserID:        "testID1",
+				expectedForce:         false,
 				err:                   nil,
 			},
 			request: httptest.NewRequestWithContext(t.Context(),
@@ -45,6 +46,7 @@ func TestRemoveSavedSearch(t *testing.T) {
 			cfg: &MockDeleteUserSavedSearchConfig{
 				expectedSavedSearchID: "saved-search-id",
 				expectedUserID:        "testID1",
+				expectedForce:         false,
 				err:                   backendtypes.ErrEntityDoesNotExist,
 			},
 			request: httptest.NewRequestWithContext(t.Context(),
@@ -64,6 +66,7 @@ func TestRemoveSavedSearch(t *testing.T) {
 			cfg: &MockDeleteUserSavedSearchConfig{
 				expectedSavedSearchID: "saved-search-id",
 				expectedUserID:        "testID1",
+				expectedForce:         false,
 				err:                   backendtypes.ErrUserNotAuthorizedForAction,
 			},
 			request: httptest.NewRequestWithContext(t.Context(),
@@ -78,11 +81,47 @@ func TestRemoveSavedSearch(t *testing.T) {
 				}`,
 			),
 		},
+		{
+			name: "referenced by other saved searches",
+			cfg: &MockDeleteUserSavedSearchConfig{
+				expectedSavedSearchID: "saved-search-id",
+				expectedUserID:        "testID1",
+				expectedForce:         false,
+				err:                   backendtypes.ErrSavedSearchReferenced,
+			},
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodDelete,
+				"/v1/saved-searches/saved-search-id",
+				nil,
+			),
+			expectedResponse: testJSONResponse(409,
+				`{
+					"code":409,
+					"message":"saved search is referenced by other saved searches, use force to remove it anyway"
+				}`,
+			),
+		},
+		{
+			name: "success with force",
+			cfg: &MockDeleteUserSavedSearchConfig{
+				expectedSavedSearchID: "saved-search-id",
+				expectedUserID:        "testID1",
+				expectedForce:         true,
+				err:                   nil,
+			},
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodDelete,
+				"/v1/saved-searches/saved-search-id?force=true",
+				nil,
+			),
+			expectedResponse: createEmptyBodyResponse(http.StatusNoContent),
+		},
 		{
 			name: "general error",
 			cfg: &MockDeleteUserSavedSearchConfig{
 				expectedSavedSearchID: "saved-search-id",
 				expectedUserID:        "testID1",
+				expectedForce:         false,
 				err:                   errTest,
 			},
 			request: httptest.NewRequestWithContext(t.Context(),
@@ -0,0 +1,94 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// ReorderHotlist handles the PUT request to /v1/saved-searches/{search_id}/hotlist/order.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) ReorderHotlist(
+	ctx context.Context,
+	request backend.ReorderHotlistRequestObject,
+) (backend.ReorderHotlistResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "ReorderHotlist",
+		func(code int, message string) backend.ReorderHotlist500JSONResponse {
+			return backend.ReorderHotlist500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+	validateHotlistFeatureIDs(request.Body.FeatureIds, fieldErrors)
+	if fieldErrors.hasErrors() {
+		return backend.ReorderHotlist400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: errMsgInputValidationErrors,
+			Errors:  fieldErrors.fieldErrorMap,
+		}, nil
+	}
+
+	hotlist, err := s.wptMetricsStorer.ReorderHotlist(ctx, userCheckResult.User.ID, request.SearchId, *request.Body)
+	if err != nil {
+		switch {
+		case errors.Is(err, backendtypes.ErrInvalidHotlistFeatures):
+			fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+			fieldErrors.addFieldError("feature_ids", errHotlistNotPermutation)
+
+			return backend.ReorderHotlist400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: errMsgInputValidationErrors,
+				Errors:  fieldErrors.fieldErrorMap,
+			}, nil
+		case errors.Is(err, backendtypes.ErrUserNotAuthorizedForAction):
+			return backend.ReorderHotlist403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "forbidden",
+			}, nil
+		case errors.Is(err, backendtypes.ErrEntityDoesNotExist):
+			return backend.ReorderHotlist404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: "hotlist not found",
+			}, nil
+		case errors.Is(err, backendtypes.ErrHotlistVersionConflict):
+			return backend.ReorderHotlist409JSONResponse{
+				Code:    http.StatusConflict,
+				Message: "hotlist was changed since the given version",
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to reorder hotlist", "error", err, "searchID", request.SearchId)
+
+		return backend.ReorderHotlist500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to reorder hotlist",
+		}, nil
+	}
+
+	s.publishHotlistChanged(ctx, userCheckResult.User.ID, hotlist.Id, "reorder hotlist")
+
+	return backend.ReorderHotlist200JSONResponse(*hotlist), nil
+}
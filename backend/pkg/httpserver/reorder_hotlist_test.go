@@ -0,0 +1,199 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestReorderHotlist(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	expectedRequest := backend.ReorderHotlistRequest{
+		Version:    2,
+		FeatureIds: []string{"subgrid", "grid"},
+	}
+	body := `{"version": 2, "feature_ids": ["subgrid", "grid"]}`
+	errCfg := func(err error) *MockReorderHotlistConfig {
+		return &MockReorderHotlistConfig{
+			expectedUserID:        "test-user",
+			expectedSavedSearchID: "hotlist-id",
+			expectedRequest:       expectedRequest,
+			output:                nil,
+			err:                   err,
+		}
+	}
+
+	testCases := []struct {
+		name                 string
+		cfg                  *MockReorderHotlistConfig
+		publishErr           error
+		expectedPublishCalls int
+		expectedCallCount    int
+		body                 string
+		expectedResponse     *http.Response
+	}{
+		{
+			name: "success",
+			cfg: &MockReorderHotlistConfig{
+				expectedUserID:        "test-user",
+				expectedSavedSearchID: "hotlist-id",
+				expectedRequest:       expectedRequest,
+				output: &backend.Hotlist{
+					Id:         "hotlist-id",
+					Version:    3,
+					FeatureIds: []string{"subgrid", "grid"},
+				},
+				err: nil,
+			},
+			expectedCallCount:    1,
+			body:                 body,
+			publishErr:           nil,
+			expectedPublishCalls: 1,
+			expectedResponse: testJSONResponse(http.StatusOK,
+				`{"id":"hotlist-id","version":3,"feature_ids":["subgrid","grid"]}`),
+		},
+		{
+			name: "publish failure does not fail the reorder",
+			cfg: &MockReorderHotlistConfig{
+				expectedUserID:        "test-user",
+				expectedSavedSearchID: "hotlist-id",
+				expectedRequest:       expectedRequest,
+				output: &backend.Hotlist{
+					Id:         "hotlist-id",
+					Version:    3,
+					FeatureIds: []string{"subgrid", "grid"},
+				},
+				err: nil,
+			},
+			expectedCallCount:    1,
+			body:                 body,
+			publishErr:           errTest,
+			expectedPublishCalls: 1,
+			expectedResponse: testJSONResponse(http.StatusOK,
+				`{"id":"hotlist-id","version":3,"feature_ids":["subgrid","grid"]}`),
+		},
+		{
+			name:                 "bad request - duplicate features",
+			cfg:                  nil,
+			expectedCallCount:    0,
+			body:                 `{"version": 2, "feature_ids": ["grid", "grid"]}`,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse: testJSONResponse(http.StatusBadRequest, `{
+				"code":400,
+				"message":"input validation errors",
+				"errors":{"feature_ids":"`+errHotlistDuplicateFeature.Error()+`"}
+			}`),
+		},
+		{
+			name:                 "bad request - not a permutation",
+			cfg:                  errCfg(backendtypes.ErrInvalidHotlistFeatures),
+			expectedCallCount:    1,
+			body:                 body,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse: testJSONResponse(http.StatusBadRequest, `{
+				"code":400,
+				"message":"input validation errors",
+				"errors":{"feature_ids":"`+errHotlistNotPermutation.Error()+`"}
+			}`),
+		},
+		{
+			name:                 "forbidden",
+			cfg:                  errCfg(backendtypes.ErrUserNotAuthorizedForAction),
+			expectedCallCount:    1,
+			body:                 body,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse:     testJSONResponse(http.StatusForbidden, `{"code":403,"message":"forbidden"}`),
+		},
+		{
+			name:                 "not found",
+			cfg:                  errCfg(backendtypes.ErrEntityDoesNotExist),
+			expectedCallCount:    1,
+			body:                 body,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse:     testJSONResponse(http.StatusNotFound, `{"code":404,"message":"hotlist not found"}`),
+		},
+		{
+			name:                 "conflict - stale version",
+			cfg:                  errCfg(backendtypes.ErrHotlistVersionConflict),
+			expectedCallCount:    1,
+			body:                 body,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse: testJSONResponse(http.StatusConflict,
+				`{"code":409,"message":"hotlist was changed since the given version"}`),
+		},
+		{
+			name:                 "internal server error",
+			cfg:                  errCfg(errors.New("database error")),
+			expectedCallCount:    1,
+			body:                 body,
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to reorder hotlist"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				reorderHotlistCfg: tc.cfg,
+				getSavedSearchCfg: &MockGetSavedSearchConfig{
+					expectedSavedSearchID: "hotlist-id",
+					expectedUserID:        new("test-user"),
+					output:                testHotlistSavedSearch(),
+					err:                   nil,
+				},
+				t: t,
+			}
+			mockPublisher := &MockEventPublisher{
+				t: t,
+				callCountPublishSearchConfigurationChanged: 0,
+				publishSearchConfigurationChangedCfg: &MockPublishSearchConfigurationChangedConfig{
+					expectedResp:       testHotlistSavedSearch(),
+					expectedUserID:     "test-user",
+					expectedIsCreation: false,
+					err:                tc.publishErr,
+				},
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer), withCustomEventPublisher(mockPublisher))
+			req := httptest.NewRequestWithContext(t.Context(),
+				http.MethodPut, "/v1/saved-searches/hotlist-id/hotlist/order", strings.NewReader(tc.body))
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountReorderHotlist,
+				"ReorderHotlist", nil)
+			assertMocksExpectations(t, tc.expectedPublishCalls,
+				mockPublisher.callCountPublishSearchConfigurationChanged, "PublishSearchConfigurationChanged", nil)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// RevertSavedSearch handles the POST request to /v1/saved-searches/{search_id}/revisions/{revision_number}/revert.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) RevertSavedSearch(
+	ctx context.Context,
+	request backend.RevertSavedSearchRequestObject,
+) (backend.RevertSavedSearchResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "RevertSavedSearch",
+		func(code int, message string) backend.RevertSavedSearch500JSONResponse {
+			return backend.RevertSavedSearch500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	savedSearch, err := s.wptMetricsStorer.RevertSavedSearch(ctx, userCheckResult.User.ID, request.SearchId,
+		request.RevisionNumber)
+	if err != nil {
+		switch {
+		case errors.Is(err, backendtypes.ErrEntityDoesNotExist):
+			return backend.RevertSavedSearch404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: "revision not found",
+			}, nil
+		case errors.Is(err, backendtypes.ErrUserNotAuthorizedForAction):
+			return backend.RevertSavedSearch403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "forbidden",
+			}, nil
+		}
+		if safeErr := sanitizeValidationError(err); safeErr != nil {
+			return backend.RevertSavedSearch400JSONResponse{
+				Code:    http.StatusBadRequest,
+				Message: safeErr.Error(),
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to revert saved search", "error", err,
+			"searchID", request.SearchId, "revision", request.RevisionNumber)
+
+		return backend.RevertSavedSearch500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to revert saved search",
+		}, nil
+	}
+
+	err = s.eventPublisher.PublishSearchConfigurationChanged(ctx, savedSearch, userCheckResult.User.ID, false)
+	if err != nil {
+		// We should not mark this as a failure. Only log it.
+		slog.WarnContext(ctx, "unable to publish search configuration changed event during revert", "error", err)
+	}
+
+	return backend.RevertSavedSearch200JSONResponse(*savedSearch), nil
+}
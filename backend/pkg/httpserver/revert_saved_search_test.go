@@ -0,0 +1,164 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestRevertSavedSearch(t *testing.T) {
+	now := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	cfg := func(output *backend.SavedSearchResponse, err error) *MockRevertSavedSearchConfig {
+		return &MockRevertSavedSearchConfig{
+			expectedUserID:         "test-user",
+			expectedSavedSearchID:  "search-id",
+			expectedRevisionNumber: 2,
+			output:                 output,
+			err:                    err,
+		}
+	}
+
+	//nolint:exhaustruct
+	reverted := &backend.SavedSearchResponse{
+		Id:         "search-id",
+		Name:       "name",
+		Query:      "group:css",
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		Tags:       nil,
+		Listed:     nil,
+		Parameters: nil,
+	}
+	revertedJSON := `{
+		"id":"search-id",
+		"name":"name",
+		"query":"group:css",
+		"created_at":"2026-01-01T00:00:00Z",
+		"updated_at":"2026-01-01T00:00:00Z"
+	}`
+
+	testCases := []struct {
+		name                 string
+		cfg                  *MockRevertSavedSearchConfig
+		publishErr           error
+		expectedPublishCalls int
+		expectedResponse     *http.Response
+	}{
+		{
+			name:                 "success",
+			cfg:                  cfg(reverted, nil),
+			publishErr:           nil,
+			expectedPublishCalls: 1,
+			expectedResponse:     testJSONResponse(http.StatusOK, revertedJSON),
+		},
+		{
+			name:                 "publish failure does not fail the revert",
+			cfg:                  cfg(reverted, nil),
+			publishErr:           errors.New("publish error"),
+			expectedPublishCalls: 1,
+			expectedResponse:     testJSONResponse(http.StatusOK, revertedJSON),
+		},
+		{
+			name:                 "forbidden",
+			cfg:                  cfg(nil, backendtypes.ErrUserNotAuthorizedForAction),
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse:     testJSONResponse(http.StatusForbidden, `{"code":403,"message":"forbidden"}`),
+		},
+		{
+			name:                 "not found",
+			cfg:                  cfg(nil, backendtypes.ErrEntityDoesNotExist),
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse: testJSONResponse(http.StatusNotFound,
+				`{"code":404,"message":"revision not found"}`),
+		},
+		{
+			name:                 "query does not match its parameters",
+			cfg:                  cfg(nil, fmt.Errorf("%w: $browser", searchtypes.ErrUnboundQueryVariable)),
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse: testJSONResponse(http.StatusBadRequest,
+				`{"code":400,"message":"query variable is not bound"}`),
+		},
+		{
+			name:                 "query references a deleted saved search",
+			cfg:                  cfg(nil, fmt.Errorf("wrapped: %w", backendtypes.ErrSavedSearchNotFound)),
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse: testJSONResponse(http.StatusBadRequest,
+				`{"code":400,"message":"saved search not found"}`),
+		},
+		{
+			name:                 "query creates a cycle",
+			cfg:                  cfg(nil, backendtypes.ErrSavedSearchCycleDetected),
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse: testJSONResponse(http.StatusBadRequest,
+				`{"code":400,"message":"cycle detected in saved search expansion"}`),
+		},
+		{
+			name:                 "internal server error",
+			cfg:                  cfg(nil, errors.New("database error")),
+			publishErr:           nil,
+			expectedPublishCalls: 0,
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to revert saved search"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				revertSavedSearchCfg: tc.cfg,
+				t:                    t,
+			}
+			mockPublisher := &MockEventPublisher{
+				t: t,
+				callCountPublishSearchConfigurationChanged: 0,
+				publishSearchConfigurationChangedCfg: &MockPublishSearchConfigurationChangedConfig{
+					expectedResp:       reverted,
+					expectedUserID:     "test-user",
+					expectedIsCreation: false,
+					err:                tc.publishErr,
+				},
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer), withCustomEventPublisher(mockPublisher))
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodPost,
+				"/v1/saved-searches/search-id/revisions/2/revert", nil)
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, 1, mockStorer.callCountRevertSavedSearch, "RevertSavedSearch", nil)
+			assertMocksExpectations(t, tc.expectedPublishCalls,
+				mockPublisher.callCountPublishSearchConfigurationChanged, "PublishSearchConfigurationChanged", nil)
+		})
+	}
+}
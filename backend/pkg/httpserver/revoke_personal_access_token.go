@@ -0,0 +1,62 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// RevokePersonalAccessToken handles the DELETE request to /v1/users/me/tokens/{token_id}.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) RevokePersonalAccessToken(
+	ctx context.Context,
+	req backend.RevokePersonalAccessTokenRequestObject,
+) (backend.RevokePersonalAccessTokenResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "RevokePersonalAccessToken",
+		func(code int, message string) backend.RevokePersonalAccessToken500JSONResponse {
+			return backend.RevokePersonalAccessToken500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	err := s.wptMetricsStorer.RevokePersonalAccessToken(ctx, userCheckResult.User.ID, req.TokenId)
+	if err != nil {
+		if errors.Is(err, backendtypes.ErrEntityDoesNotExist) {
+			return backend.RevokePersonalAccessToken404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: "Personal access token not found or not owned by user",
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to revoke personal access token", "error", err)
+
+		return backend.RevokePersonalAccessToken500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "Could not revoke personal access token",
+		}, nil
+	}
+
+	return backend.RevokePersonalAccessToken204Response{}, nil
+}
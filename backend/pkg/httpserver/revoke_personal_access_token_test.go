@@ -0,0 +1,93 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+)
+
+func TestRevokePersonalAccessToken(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	testCases := []struct {
+		name              string
+		cfg               *MockRevokePersonalAccessTokenConfig
+		expectedCallCount int
+		expectedResponse  *http.Response
+	}{
+		{
+			name: "success",
+			cfg: &MockRevokePersonalAccessTokenConfig{
+				expectedUserID:  testUser.ID,
+				expectedTokenID: "token1",
+				err:             nil,
+			},
+			expectedCallCount: 1,
+			expectedResponse:  createEmptyBodyResponse(http.StatusNoContent),
+		},
+		{
+			name: "not found",
+			cfg: &MockRevokePersonalAccessTokenConfig{
+				expectedUserID:  testUser.ID,
+				expectedTokenID: "token1",
+				err:             backendtypes.ErrEntityDoesNotExist,
+			},
+			expectedCallCount: 1,
+			expectedResponse: testJSONResponse(404, `
+			{
+				"code":404,
+				"message":"Personal access token not found or not owned by user"
+			}`),
+		},
+		{
+			name: "500 error",
+			cfg: &MockRevokePersonalAccessTokenConfig{
+				expectedUserID:  testUser.ID,
+				expectedTokenID: "token1",
+				err:             errTest,
+			},
+			expectedCallCount: 1,
+			expectedResponse: testJSONResponse(500, `
+			{
+				"code":500,
+				"message":"Could not revoke personal access token"
+			}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				revokePersonalAccessTokenCfg: tc.cfg,
+				t:                            t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodDelete, "/v1/users/me/tokens/token1", nil)
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountRevokePersonalAccessToken,
+				"RevokePersonalAccessToken", nil)
+		})
+	}
+}
@@ -74,13 +74,31 @@ type WPTMetricsStorer interface {
 		sortOrder *backend.ListFeaturesParamsSort,
 		wptMetricType backend.WPTMetricView,
 		browsers []backend.BrowserPathParam,
+		queryParams map[string]string,
 	) (*backend.FeaturePage, error)
+	FeaturesSearchFacets(
+		ctx context.Context,
+		searchNode *searchtypes.SearchNode,
+		facets []backend.FeatureSearchFacet,
+		queryParams map[string]string,
+	) (*backend.FeatureSearchFacets, error)
 	GetFeature(
 		ctx context.Context,
 		featureID string,
 		wptMetricType backend.WPTMetricView,
 		browsers []backend.BrowserPathParam,
 	) (*backendtypes.GetFeatureResult, error)
+	BatchGetFeatures(
+		ctx context.Context,
+		featureIDs []string,
+		wptMetricType backend.WPTMetricView,
+		browsers []backend.BrowserPathParam,
+	) (map[string]*backendtypes.GetFeatureResult, error)
+	GetFeatureTimeline(ctx context.Context, featureID string) (*backend.FeatureTimeline, error)
+	GetBrowserReleaseChannelVersion(
+		ctx context.Context, browser backend.SupportedBrowsers, channel string) (string, error)
+	CountSavedSearchFeaturesByBaselineStatus(ctx context.Context, savedSearchID string) (
+		[]backend.FeatureSearchFacetCount, error)
 	ListBrowserFeatureCountMetric(
 		ctx context.Context,
 		targetBrowser string,
@@ -119,9 +137,27 @@ type WPTMetricsStorer interface {
 		pageSize int,
 		pageToken *string,
 	) (*backend.BaselineStatusMetricsPage, error)
+	ListSavedSearchBaselineStatusCounts(
+		ctx context.Context,
+		savedSearchID string,
+		startAt time.Time,
+		endAt time.Time,
+		pageSize int,
+		pageToken *string,
+	) (*backend.BaselineStatusMetricsPage, error)
+	ListSavedSearchBrowserFeatureCountMetric(
+		ctx context.Context,
+		savedSearchID string,
+		targetBrowser string,
+		targetMobileBrowser *string,
+		startAt time.Time,
+		endAt time.Time,
+		pageSize int,
+		pageToken *string,
+	) (*backend.BrowserReleaseFeatureMetricsPage, error)
 	CreateUserSavedSearch(ctx context.Context, userID string,
 		savedSearch backend.SavedSearch) (*backend.SavedSearchResponse, error)
-	DeleteUserSavedSearch(ctx context.Context, userID, savedSearchID string) error
+	DeleteUserSavedSearch(ctx context.Context, userID, savedSearchID string, force bool) error
 	GetSavedSearch(ctx context.Context, savedSearchID string, userID *string) (*backend.SavedSearchResponse, error)
 	ListUserSavedSearches(
 		ctx context.Context,
@@ -139,6 +175,7 @@ type WPTMetricsStorer interface {
 	ValidateQueryReferences(
 		ctx context.Context,
 		query string,
+		params *backend.SavedSearchQueryParameters,
 		updateID *string,
 	) error
 	PutUserSavedSearchBookmark(
@@ -172,6 +209,14 @@ type WPTMetricsStorer interface {
 		userID string, req backend.CreateNotificationChannelRequest) (*backend.NotificationChannelResponse, error)
 	UpdateNotificationChannel(ctx context.Context,
 		userID, channelID string, req backend.UpdateNotificationChannelRequest) (*backend.NotificationChannelResponse, error)
+	GetNotificationChannelDeliverySettings(ctx context.Context,
+		userID, channelID string) (*backend.NotificationChannelDeliverySettings, error)
+	PutNotificationChannelDeliverySettings(ctx context.Context, userID, channelID string,
+		settings backend.NotificationChannelDeliverySettings) (*backend.NotificationChannelDeliverySettings, error)
+	CreatePersonalAccessToken(ctx context.Context,
+		userID string, req backend.CreatePersonalAccessTokenRequest) (*backend.CreatedPersonalAccessToken, error)
+	ListPersonalAccessTokens(ctx context.Context, userID string) (*backend.PersonalAccessTokenPage, error)
+	RevokePersonalAccessToken(ctx context.Context, userID, tokenID string) error
 	CreateSavedSearchSubscription(ctx context.Context, userID string,
 		subscription backend.Subscription) (*backend.SubscriptionResponse, error)
 	DeleteSavedSearchSubscription(ctx context.Context, userID, subscriptionID string) error
@@ -192,6 +237,51 @@ type WPTMetricsStorer interface {
 		userID, subscriptionID string,
 		req backend.UpdateSubscriptionRequest,
 	) (*backend.SubscriptionResponse, error)
+	PutWatchedFeature(ctx context.Context,
+		userID, featureID string, req backend.WatchFeatureRequest) (*backend.WatchedFeature, error)
+	DeleteWatchedFeature(ctx context.Context, userID, featureID string, channelID *string) error
+	ListWatchedFeatures(ctx context.Context,
+		userID string, pageSize int, pageToken *string) (*backend.WatchedFeaturePage, error)
+	CreateHotlist(ctx context.Context, userID string, req backend.CreateHotlistRequest) (*backend.Hotlist, error)
+	GetHotlist(ctx context.Context, savedSearchID string) (*backend.Hotlist, error)
+	ReorderHotlist(ctx context.Context,
+		userID, savedSearchID string, req backend.ReorderHotlistRequest) (*backend.Hotlist, error)
+	PutHotlistFeature(ctx context.Context,
+		userID, savedSearchID, featureID string, req backend.PutHotlistFeatureRequest) (*backend.Hotlist, error)
+	RemoveHotlistFeature(ctx context.Context,
+		userID, savedSearchID, featureID string, version int64) (*backend.Hotlist, error)
+	ListSavedSearchCollaborators(ctx context.Context,
+		userID, savedSearchID string) (*backend.SavedSearchCollaboratorList, error)
+	PutSavedSearchCollaborator(ctx context.Context, userID, savedSearchID, githubUsername string,
+		req backend.PutSavedSearchCollaboratorRequest) (*backend.SavedSearchCollaborator, error)
+	RemoveSavedSearchCollaborator(ctx context.Context, userID, savedSearchID, githubUsername string) error
+	TransferSavedSearchOwnership(ctx context.Context,
+		userID, savedSearchID string, req backend.TransferSavedSearchOwnershipRequest) error
+	ListSavedSearchRevisions(ctx context.Context,
+		userID, savedSearchID string, pageSize int, pageToken *string) (*backend.SavedSearchRevisionPage, error)
+	GetSavedSearchRevisionDiff(ctx context.Context, userID, savedSearchID string,
+		revisionNumber int64, baseRevisionNumber *int64) (*backend.SavedSearchRevisionDiff, error)
+	RevertSavedSearch(ctx context.Context,
+		userID, savedSearchID string, revisionNumber int64) (*backend.SavedSearchResponse, error)
+	ForkSavedSearch(ctx context.Context,
+		userID, savedSearchID string, req *backend.ForkSavedSearchRequest) (*backend.SavedSearchResponse, error)
+	ListSavedSearchDirectory(
+		ctx context.Context,
+		query, tag *string,
+		sort backend.ListSavedSearchDirectoryParamsSort,
+		pageSize int,
+		pageToken *string,
+	) (*backend.SavedSearchDirectoryPage, error)
+	ExportUserData(ctx context.Context, userID string) (*backend.UserDataExport, error)
+	ImportUserData(ctx context.Context, userID string, data backend.UserDataExport) (
+		*backend.UserDataImportResult, error)
+	ListSavedSearchSnapshots(ctx context.Context, userID, savedSearchID string,
+		snapshotType backend.SubscriptionFrequency, at *time.Time, pageSize int, pageToken *string) (
+		*backend.SavedSearchSnapshotPage, error)
+	GetSavedSearchSnapshotRef(ctx context.Context, userID, savedSearchID, snapshotID string,
+		snapshotType backend.SubscriptionFrequency, at *time.Time) (*backendtypes.SavedSearchSnapshotRef, error)
+	GetSavedSearchReferences(ctx context.Context, savedSearchID string, userID *string) (
+		*backend.SavedSearchReferences, error)
 }
 
 type Server struct {
@@ -201,6 +291,7 @@ type Server struct {
 	baseURL                 *url.URL
 	userGitHubClientFactory UserGitHubClientFactory
 	eventPublisher          EventPublisher
+	snapshotReader          SavedSearchSnapshotReader
 	rssRenderer             *RSSRenderer
 }
 
@@ -227,6 +318,15 @@ func getPageSizeOrDefault(pageSize *int) int {
 	return maxPageSize
 }
 
+// getSnapshotTypeOrDefault returns the requested snapshot type, defaulting to the immediate snapshots.
+func getSnapshotTypeOrDefault(snapshotType *backend.SubscriptionFrequency) backend.SubscriptionFrequency {
+	if snapshotType != nil {
+		return *snapshotType
+	}
+
+	return backend.SubscriptionFrequencyImmediate
+}
+
 func getFeatureIDsOrDefault(featureIDs *[]string) []string {
 	var defaultFeatureIDs []string
 
@@ -248,6 +348,9 @@ type RawBytesDataCacher interface {
 
 // RouteCacheOptions contains options for caching routes.
 type RouteCacheOptions struct {
+	// DefaultTTL is the TTL used by the RawBytesDataCacher when no options override it.
+	// It is also advertised to clients as the max-age of the cached routes.
+	DefaultTTL time.Duration
 	// AggregatedFeatureStatsOptions applies cache options for routes that only contain stats on features.
 	// Any routes with multiple data sources are not included (e.g. WPT Metrics)
 	AggregatedFeatureStatsOptions []cachetypes.CacheOption
@@ -258,45 +361,68 @@ type EventPublisher interface {
 		userID string, isCreation bool) error
 }
 
+// SavedSearchSnapshotReader reads the feature list snapshots stored for saved searches.
+type SavedSearchSnapshotReader interface {
+	GetSavedSearchSnapshot(ctx context.Context, ref backendtypes.SavedSearchSnapshotRef) (
+		*backend.SavedSearchSnapshot, error)
+	DiffSavedSearchSnapshots(ctx context.Context, base, target backendtypes.SavedSearchSnapshotRef) (
+		*backend.SavedSearchSnapshotDiff, error)
+}
+
 func NewHTTPServer(
 	port string,
 	baseURL *url.URL,
 	metadataStorer WebFeatureMetadataStorer,
 	wptMetricsStorer WPTMetricsStorer,
 	eventPublisher EventPublisher,
+	snapshotReader SavedSearchSnapshotReader,
 	rawBytesDataCacher RawBytesDataCacher,
 	routeCacheOptions RouteCacheOptions,
 	userGitHubClientFactory UserGitHubClientFactory,
 	preRequestValidationMiddlewares []func(http.Handler) http.Handler,
-	authMiddleware func(http.Handler) http.Handler) *http.Server {
+	authMiddleware func(http.Handler) http.Handler,
+	rateLimitMiddleware func(http.Handler) http.Handler) *http.Server {
 	// Create an instance of our handler which satisfies the generated interface
 	srv := &Server{
 		metadataStorer:          metadataStorer,
 		wptMetricsStorer:        wptMetricsStorer,
 		eventPublisher:          eventPublisher,
+		snapshotReader:          snapshotReader,
 		operationResponseCaches: initOperationResponseCaches(rawBytesDataCacher, routeCacheOptions),
 		baseURL:                 baseURL,
 		userGitHubClientFactory: userGitHubClientFactory,
 		rssRenderer:             NewRSSRenderer(),
 	}
 
-	return createOpenAPIServerServer(port, srv, preRequestValidationMiddlewares, authMiddleware)
+	routeMiddlewares := []backend.MiddlewareFunc{
+		newConditionalGetMiddleware(routeMaxAges(routeCacheOptions)),
+	}
+
+	return createOpenAPIServerServer(port, srv, preRequestValidationMiddlewares, authMiddleware, rateLimitMiddleware,
+		routeMiddlewares)
 }
 
 func createOpenAPIServerServer(
 	port string,
 	srv backend.StrictServerInterface,
 	preRequestValidationMiddlewares []func(http.Handler) http.Handler,
-	authMiddleware func(http.Handler) http.Handler) *http.Server {
+	authMiddleware func(http.Handler) http.Handler,
+	rateLimitMiddleware func(http.Handler) http.Handler,
+	routeMiddlewares []backend.MiddlewareFunc) *http.Server {
 
 	srvStrictHandler := backend.NewStrictHandler(srv,
-		wrapPostRequestValidationMiddlewaresForOpenAPIHook(authMiddleware))
+		wrapPostRequestValidationMiddlewaresForOpenAPIHook(authMiddleware, rateLimitMiddleware))
 
-	// Use standard library router
-	r := http.NewServeMux()
+	// Use standard library router, with support for custom methods on a resource.
+	r := newCustomMethodMux()
 
-	// We now register our web feature router above as the handler for the interface
-	backend.HandlerFromMux(srvStrictHandler, r)
+	// We now register our web feature router above as the handler for the interface.
+	// The route middlewares run after routing so they can rely on the matched route pattern.
+	// nolint:exhaustruct // WONTFIX - will rely on the default error handler.
+	backend.HandlerWithOptions(srvStrictHandler, backend.StdHTTPServerOptions{
+		BaseRouter:  r,
+		Middlewares: routeMiddlewares,
+	})
 
 	// Now wrap the middlewares
 	wrappedHandler := applyPreRequestValidationMiddlewares(r, preRequestValidationMiddlewares)
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"maps"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -105,10 +106,19 @@ type MockFeaturesSearchConfig struct {
 	expectedSortBy        *backend.ListFeaturesParamsSort
 	expectedWPTMetricView backend.WPTMetricView
 	expectedBrowsers      []backend.BrowserPathParam
+	expectedQueryParams   map[string]string
 	page                  *backend.FeaturePage
 	err                   error
 }
 
+type MockFeaturesSearchFacetsConfig struct {
+	expectedSearchNode  *searchtypes.SearchNode
+	expectedFacets      []backend.FeatureSearchFacet
+	expectedQueryParams map[string]string
+	facets              *backend.FeatureSearchFacets
+	err                 error
+}
+
 type MockGetFeatureByIDConfig struct {
 	expectedFeatureID     string
 	expectedWPTMetricView backend.WPTMetricView
@@ -117,6 +127,33 @@ type MockGetFeatureByIDConfig struct {
 	err                   error
 }
 
+type MockBatchGetFeaturesConfig struct {
+	expectedFeatureIDs    []string
+	expectedWPTMetricView backend.WPTMetricView
+	expectedBrowsers      []backend.BrowserPathParam
+	data                  map[string]*backendtypes.GetFeatureResult
+	err                   error
+}
+
+type MockGetFeatureTimelineConfig struct {
+	expectedFeatureID string
+	result            *backend.FeatureTimeline
+	err               error
+}
+
+type MockGetBrowserReleaseChannelVersionConfig struct {
+	expectedBrowser backend.SupportedBrowsers
+	expectedChannel string
+	result          string
+	err             error
+}
+
+type MockCountSavedSearchFeaturesByBaselineStatusConfig struct {
+	expectedSavedSearchID string
+	result                []backend.FeatureSearchFacetCount
+	err                   error
+}
+
 type MockGetIDFromFeatureKeyConfig struct {
 	expectedFeatureKey string
 	result             *string
@@ -180,6 +217,7 @@ type MockCreateUserSavedSearchConfig struct {
 type MockDeleteUserSavedSearchConfig struct {
 	expectedSavedSearchID string
 	expectedUserID        string
+	expectedForce         bool
 	err                   error
 }
 
@@ -264,6 +302,21 @@ type MockGetNotificationChannelConfig struct {
 	err               error
 }
 
+type MockGetNotificationChannelDeliverySettingsConfig struct {
+	expectedUserID    string
+	expectedChannelID string
+	output            *backend.NotificationChannelDeliverySettings
+	err               error
+}
+
+type MockPutNotificationChannelDeliverySettingsConfig struct {
+	expectedUserID    string
+	expectedChannelID string
+	expectedSettings  backend.NotificationChannelDeliverySettings
+	output            *backend.NotificationChannelDeliverySettings
+	err               error
+}
+
 type MockListNotificationChannelsConfig struct {
 	expectedUserID    string
 	expectedPageSize  int
@@ -285,6 +338,25 @@ type MockCreateNotificationChannelConfig struct {
 	err             error
 }
 
+type MockCreatePersonalAccessTokenConfig struct {
+	expectedUserID  string
+	expectedRequest backend.CreatePersonalAccessTokenRequest
+	output          *backend.CreatedPersonalAccessToken
+	err             error
+}
+
+type MockListPersonalAccessTokensConfig struct {
+	expectedUserID string
+	output         *backend.PersonalAccessTokenPage
+	err            error
+}
+
+type MockRevokePersonalAccessTokenConfig struct {
+	expectedUserID  string
+	expectedTokenID string
+	err             error
+}
+
 type MockUpdateNotificationChannelConfig struct {
 	expectedUserID    string
 	expectedChannelID string
@@ -329,6 +401,205 @@ type MockUpdateSavedSearchSubscriptionConfig struct {
 	err                    error
 }
 
+type MockPutWatchedFeatureConfig struct {
+	expectedUserID    string
+	expectedFeatureID string
+	expectedRequest   backend.WatchFeatureRequest
+	output            *backend.WatchedFeature
+	err               error
+}
+
+type MockDeleteWatchedFeatureConfig struct {
+	expectedUserID    string
+	expectedFeatureID string
+	expectedChannelID *string
+	err               error
+}
+
+type MockListWatchedFeaturesConfig struct {
+	expectedUserID    string
+	expectedPageSize  int
+	expectedPageToken *string
+	output            *backend.WatchedFeaturePage
+	err               error
+}
+
+type MockCreateHotlistConfig struct {
+	expectedUserID  string
+	expectedRequest backend.CreateHotlistRequest
+	output          *backend.Hotlist
+	err             error
+}
+
+type MockGetHotlistConfig struct {
+	expectedSavedSearchID string
+	output                *backend.Hotlist
+	err                   error
+}
+
+type MockReorderHotlistConfig struct {
+	expectedUserID        string
+	expectedSavedSearchID string
+	expectedRequest       backend.ReorderHotlistRequest
+	output                *backend.Hotlist
+	err                   error
+}
+
+type MockPutHotlistFeatureConfig struct {
+	expectedUserID        string
+	expectedSavedSearchID string
+	expectedFeatureID     string
+	expectedRequest       backend.PutHotlistFeatureRequest
+	output                *backend.Hotlist
+	err                   error
+}
+
+type MockRemoveHotlistFeatureConfig struct {
+	expectedUserID        string
+	expectedSavedSearchID string
+	expectedFeatureID     string
+	expectedVersion       int64
+	output                *backend.Hotlist
+	err                   error
+}
+
+type MockListSavedSearchCollaboratorsConfig struct {
+	expectedUserID        string
+	expectedSavedSearchID string
+	output                *backend.SavedSearchCollaboratorList
+	err                   error
+}
+
+type MockPutSavedSearchCollaboratorConfig struct {
+	expectedUserID         string
+	expectedSavedSearchID  string
+	expectedGitHubUsername string
+	expectedRequest        backend.PutSavedSearchCollaboratorRequest
+	output                 *backend.SavedSearchCollaborator
+	err                    error
+}
+
+type MockRemoveSavedSearchCollaboratorConfig struct {
+	expectedUserID         string
+	expectedSavedSearchID  string
+	expectedGitHubUsername string
+	err                    error
+}
+
+type MockTransferSavedSearchOwnershipConfig struct {
+	expectedUserID        string
+	expectedSavedSearchID string
+	expectedRequest       backend.TransferSavedSearchOwnershipRequest
+	err                   error
+}
+
+type MockListSavedSearchRevisionsConfig struct {
+	expectedUserID        string
+	expectedSavedSearchID string
+	expectedPageSize      int
+	expectedPageToken     *string
+	output                *backend.SavedSearchRevisionPage
+	err                   error
+}
+
+type MockGetSavedSearchRevisionDiffConfig struct {
+	expectedUserID             string
+	expectedSavedSearchID      string
+	expectedRevisionNumber     int64
+	expectedBaseRevisionNumber *int64
+	output                     *backend.SavedSearchRevisionDiff
+	err                        error
+}
+
+type MockRevertSavedSearchConfig struct {
+	expectedUserID         string
+	expectedSavedSearchID  string
+	expectedRevisionNumber int64
+	output                 *backend.SavedSearchResponse
+	err                    error
+}
+
+type MockForkSavedSearchConfig struct {
+	expectedUserID        string
+	expectedSavedSearchID string
+	expectedRequest       *backend.ForkSavedSearchRequest
+	output                *backend.SavedSearchResponse
+	err                   error
+}
+
+type MockListSavedSearchDirectoryConfig struct {
+	expectedQuery     *string
+	expectedTag       *string
+	expectedSort      backend.ListSavedSearchDirectoryParamsSort
+	expectedPageSize  int
+	expectedPageToken *string
+	output            *backend.SavedSearchDirectoryPage
+	err               error
+}
+
+type MockExportUserDataConfig struct {
+	expectedUserID string
+	output         *backend.UserDataExport
+	err            error
+}
+
+type MockImportUserDataConfig struct {
+	expectedUserID string
+	expectedData   backend.UserDataExport
+	output         *backend.UserDataImportResult
+	err            error
+}
+
+type MockListSavedSearchSnapshotsConfig struct {
+	expectedUserID        string
+	expectedSavedSearchID string
+	expectedSnapshotType  backend.SubscriptionFrequency
+	expectedAt            *time.Time
+	expectedPageSize      int
+	expectedPageToken     *string
+	output                *backend.SavedSearchSnapshotPage
+	err                   error
+}
+
+type MockGetSavedSearchSnapshotRefConfig struct {
+	expectedUserID        string
+	expectedSavedSearchID string
+	expectedSnapshotType  backend.SubscriptionFrequency
+	expectedAt            *time.Time
+	// outputs maps the snapshot IDs to their references. Unknown IDs return ErrEntityDoesNotExist.
+	outputs map[string]*backendtypes.SavedSearchSnapshotRef
+	err     error
+}
+
+type MockGetSavedSearchReferencesConfig struct {
+	expectedSavedSearchID string
+	expectedUserID        *string
+	output                *backend.SavedSearchReferences
+	err                   error
+}
+
+type MockListSavedSearchBaselineStatusCountsConfig struct {
+	expectedSavedSearchID string
+	expectedStartAt       time.Time
+	expectedEndAt         time.Time
+	expectedPageSize      int
+	expectedPageToken     *string
+	page                  *backend.BaselineStatusMetricsPage
+	err                   error
+}
+
+type MockListSavedSearchBrowserFeatureCountMetricConfig struct {
+	expectedSavedSearchID       string
+	expectedTargetBrowser       string
+	expectedTargetMobileBrowser *string
+	expectedStartAt             time.Time
+	expectedEndAt               time.Time
+	expectedPageSize            int
+	expectedPageToken           *string
+	page                        *backend.BrowserReleaseFeatureMetricsPage
+	err                         error
+}
+
 type basicHTTPTestCase[T any] struct {
 	name             string
 	cfg              *T
@@ -340,12 +611,20 @@ type MockWPTMetricsStorer struct {
 	featureCfg                                        *MockListMetricsForFeatureIDBrowserAndChannelConfig
 	aggregateCfg                                      *MockListMetricsOverTimeWithAggregatedTotalsConfig
 	featuresSearchCfg                                 *MockFeaturesSearchConfig
+	featuresSearchFacetsCfg                           *MockFeaturesSearchFacetsConfig
+	createPersonalAccessTokenCfg                      *MockCreatePersonalAccessTokenConfig
+	listPersonalAccessTokensCfg                       *MockListPersonalAccessTokensConfig
+	revokePersonalAccessTokenCfg                      *MockRevokePersonalAccessTokenConfig
 	listBrowserFeatureCountMetricCfg                  *MockListBrowserFeatureCountMetricConfig
 	listMissingOneImplCountCfg                        *MockListMissingOneImplCountsConfig
 	listMissingOneImplFeaturesCfg                     *MockListMissingOneImplFeaturesConfig
 	listBaselineStatusCountsCfg                       *MockListBaselineStatusCountsConfig
 	listChromeDailyUsageStatsCfg                      *MockListChromeDailyUsageStatsConfig
 	getFeatureByIDConfig                              *MockGetFeatureByIDConfig
+	batchGetFeaturesCfg                               *MockBatchGetFeaturesConfig
+	getFeatureTimelineCfg                             *MockGetFeatureTimelineConfig
+	getBrowserReleaseChannelVersionCfg                *MockGetBrowserReleaseChannelVersionConfig
+	countSavedSearchFeaturesByBaselineStatusCfg       *MockCountSavedSearchFeaturesByBaselineStatusConfig
 	getIDFromFeatureKeyConfig                         *MockGetIDFromFeatureKeyConfig
 	createUserSavedSearchCfg                          *MockCreateUserSavedSearchConfig
 	deleteUserSavedSearchCfg                          *MockDeleteUserSavedSearchConfig
@@ -359,6 +638,8 @@ type MockWPTMetricsStorer struct {
 	removeUserSavedSearchBookmarkCfg                  *MockRemoveUserSavedSearchBookmarkConfig
 	syncUserProfileInfoCfg                            *MockSyncUserProfileInfoConfig
 	getNotificationChannelCfg                         *MockGetNotificationChannelConfig
+	getNotificationChannelDeliverySettingsCfg         *MockGetNotificationChannelDeliverySettingsConfig
+	putNotificationChannelDeliverySettingsCfg         *MockPutNotificationChannelDeliverySettingsConfig
 	listNotificationChannelsCfg                       *MockListNotificationChannelsConfig
 	createNotificationChannelCfg                      *MockCreateNotificationChannelConfig
 	updateNotificationChannelCfg                      *MockUpdateNotificationChannelConfig
@@ -368,6 +649,30 @@ type MockWPTMetricsStorer struct {
 	getSavedSearchSubscriptionCfg                     *MockGetSavedSearchSubscriptionConfig
 	listSavedSearchSubscriptionsCfg                   *MockListSavedSearchSubscriptionsConfig
 	updateSavedSearchSubscriptionCfg                  *MockUpdateSavedSearchSubscriptionConfig
+	putWatchedFeatureCfg                              *MockPutWatchedFeatureConfig
+	deleteWatchedFeatureCfg                           *MockDeleteWatchedFeatureConfig
+	listWatchedFeaturesCfg                            *MockListWatchedFeaturesConfig
+	createHotlistCfg                                  *MockCreateHotlistConfig
+	getHotlistCfg                                     *MockGetHotlistConfig
+	reorderHotlistCfg                                 *MockReorderHotlistConfig
+	putHotlistFeatureCfg                              *MockPutHotlistFeatureConfig
+	removeHotlistFeatureCfg                           *MockRemoveHotlistFeatureConfig
+	listSavedSearchCollaboratorsCfg                   *MockListSavedSearchCollaboratorsConfig
+	putSavedSearchCollaboratorCfg                     *MockPutSavedSearchCollaboratorConfig
+	removeSavedSearchCollaboratorCfg                  *MockRemoveSavedSearchCollaboratorConfig
+	transferSavedSearchOwnershipCfg                   *MockTransferSavedSearchOwnershipConfig
+	listSavedSearchRevisionsCfg                       *MockListSavedSearchRevisionsConfig
+	getSavedSearchRevisionDiffCfg                     *MockGetSavedSearchRevisionDiffConfig
+	revertSavedSearchCfg                              *MockRevertSavedSearchConfig
+	forkSavedSearchCfg                                *MockForkSavedSearchConfig
+	listSavedSearchDirectoryCfg                       *MockListSavedSearchDirectoryConfig
+	exportUserDataCfg                                 *MockExportUserDataConfig
+	importUserDataCfg                                 *MockImportUserDataConfig
+	listSavedSearchSnapshotsCfg                       *MockListSavedSearchSnapshotsConfig
+	getSavedSearchSnapshotRefCfg                      *MockGetSavedSearchSnapshotRefConfig
+	getSavedSearchReferencesCfg                       *MockGetSavedSearchReferencesConfig
+	listSavedSearchBaselineStatusCountsCfg            *MockListSavedSearchBaselineStatusCountsConfig
+	listSavedSearchBrowserFeatureCountMetricCfg       *MockListSavedSearchBrowserFeatureCountMetricConfig
 	validateQueryReferencesCfg                        *MockValidateQueryReferencesConfig
 	listGlobalSavedSearchesCfg                        *MockListGlobalSavedSearchesConfig
 	t                                                 *testing.T
@@ -376,10 +681,18 @@ type MockWPTMetricsStorer struct {
 	callCountListBaselineStatusCounts                 int
 	callCountListBrowserFeatureCountMetric            int
 	callCountFeaturesSearch                           int
+	callCountFeaturesSearchFacets                     int
+	callCountCreatePersonalAccessToken                int
+	callCountListPersonalAccessTokens                 int
+	callCountRevokePersonalAccessToken                int
 	callCountListChromeDailyUsageStats                int
 	callCountListMetricsForFeatureIDBrowserAndChannel int
 	callCountListMetricsOverTimeWithAggregatedTotals  int
 	callCountGetFeature                               int
+	callCountBatchGetFeatures                         int
+	callCountGetFeatureTimeline                       int
+	callCountGetBrowserReleaseChannelVersion          int
+	callCountCountSavedSearchFeaturesByBaselineStatus int
 	callCountCreateUserSavedSearch                    int
 	callCountDeleteUserSavedSearch                    int
 	callCountGetSavedSearch                           int
@@ -389,6 +702,8 @@ type MockWPTMetricsStorer struct {
 	callCountRemoveUserSavedSearchBookmark            int
 	callCountSyncUserProfileInfo                      int
 	callCountGetNotificationChannel                   int
+	callCountGetNotificationChannelDeliverySettings   int
+	callCountPutNotificationChannelDeliverySettings   int
 	callCountListNotificationChannels                 int
 	callCountCreateNotificationChannel                int
 	callCountUpdateNotificationChannel                int
@@ -398,6 +713,30 @@ type MockWPTMetricsStorer struct {
 	callCountGetSavedSearchSubscription               int
 	callCountListSavedSearchSubscriptions             int
 	callCountUpdateSavedSearchSubscription            int
+	callCountPutWatchedFeature                        int
+	callCountDeleteWatchedFeature                     int
+	callCountListWatchedFeatures                      int
+	callCountCreateHotlist                            int
+	callCountGetHotlist                               int
+	callCountReorderHotlist                           int
+	callCountPutHotlistFeature                        int
+	callCountRemoveHotlistFeature                     int
+	callCountListSavedSearchCollaborators             int
+	callCountPutSavedSearchCollaborator               int
+	callCountRemoveSavedSearchCollaborator            int
+	callCountTransferSavedSearchOwnership             int
+	callCountListSavedSearchRevisions                 int
+	callCountGetSavedSearchRevisionDiff               int
+	callCountRevertSavedSearch                        int
+	callCountForkSavedSearch                          int
+	callCountListSavedSearchDirectory                 int
+	callCountExportUserData                           int
+	callCountImportUserData                           int
+	callCountListSavedSearchSnapshots                 int
+	callCountGetSavedSearchSnapshotRef                int
+	callCountGetSavedSearchReferences                 int
+	callCountListSavedSearchBaselineStatusCounts      int
+	callCountListSavedSearchBrowserFeatureCountMetric int
 	callCountGetSavedSearchPublic                     int
 	callCountGetSavedSearchSubscriptionPublic         int
 	callCountListSavedSearchNotificationEvents        int
@@ -471,6 +810,405 @@ func (m *MockWPTMetricsStorer) ListSavedSearchSubscriptions(_ context.Context,
 	return m.listSavedSearchSubscriptionsCfg.output, m.listSavedSearchSubscriptionsCfg.err
 }
 
+func (m *MockWPTMetricsStorer) PutWatchedFeature(_ context.Context,
+	userID, featureID string, req backend.WatchFeatureRequest) (*backend.WatchedFeature, error) {
+	m.callCountPutWatchedFeature++
+	if userID != m.putWatchedFeatureCfg.expectedUserID {
+		m.t.Errorf("unexpected user id %s", userID)
+	}
+	if featureID != m.putWatchedFeatureCfg.expectedFeatureID {
+		m.t.Errorf("unexpected feature id %s", featureID)
+	}
+	if !reflect.DeepEqual(req, m.putWatchedFeatureCfg.expectedRequest) {
+		m.t.Errorf("unexpected request %+v", req)
+	}
+
+	return m.putWatchedFeatureCfg.output, m.putWatchedFeatureCfg.err
+}
+
+func (m *MockWPTMetricsStorer) DeleteWatchedFeature(_ context.Context,
+	userID, featureID string, channelID *string) error {
+	m.callCountDeleteWatchedFeature++
+	if userID != m.deleteWatchedFeatureCfg.expectedUserID {
+		m.t.Errorf("unexpected user id %s", userID)
+	}
+	if featureID != m.deleteWatchedFeatureCfg.expectedFeatureID {
+		m.t.Errorf("unexpected feature id %s", featureID)
+	}
+	if !reflect.DeepEqual(channelID, m.deleteWatchedFeatureCfg.expectedChannelID) {
+		m.t.Errorf("unexpected channel id %+v", channelID)
+	}
+
+	return m.deleteWatchedFeatureCfg.err
+}
+
+func (m *MockWPTMetricsStorer) ListWatchedFeatures(_ context.Context,
+	userID string, pageSize int, pageToken *string) (*backend.WatchedFeaturePage, error) {
+	m.callCountListWatchedFeatures++
+	if userID != m.listWatchedFeaturesCfg.expectedUserID {
+		m.t.Errorf("unexpected user id %s", userID)
+	}
+	if pageSize != m.listWatchedFeaturesCfg.expectedPageSize {
+		m.t.Errorf("unexpected page size %d", pageSize)
+	}
+	if !reflect.DeepEqual(pageToken, m.listWatchedFeaturesCfg.expectedPageToken) {
+		m.t.Errorf("unexpected page token %+v", pageToken)
+	}
+
+	return m.listWatchedFeaturesCfg.output, m.listWatchedFeaturesCfg.err
+}
+
+func (m *MockWPTMetricsStorer) CreateHotlist(_ context.Context,
+	userID string, req backend.CreateHotlistRequest) (*backend.Hotlist, error) {
+	m.callCountCreateHotlist++
+	if userID != m.createHotlistCfg.expectedUserID {
+		m.t.Errorf("unexpected user id %s", userID)
+	}
+	if !reflect.DeepEqual(req, m.createHotlistCfg.expectedRequest) {
+		m.t.Errorf("unexpected request %+v", req)
+	}
+
+	return m.createHotlistCfg.output, m.createHotlistCfg.err
+}
+
+func (m *MockWPTMetricsStorer) GetHotlist(_ context.Context, savedSearchID string) (*backend.Hotlist, error) {
+	m.callCountGetHotlist++
+	if savedSearchID != m.getHotlistCfg.expectedSavedSearchID {
+		m.t.Errorf("unexpected saved search id %s", savedSearchID)
+	}
+
+	return m.getHotlistCfg.output, m.getHotlistCfg.err
+}
+
+func (m *MockWPTMetricsStorer) ReorderHotlist(_ context.Context,
+	userID, savedSearchID string, req backend.ReorderHotlistRequest) (*backend.Hotlist, error) {
+	m.callCountReorderHotlist++
+	if userID != m.reorderHotlistCfg.expectedUserID {
+		m.t.Errorf("unexpected user id %s", userID)
+	}
+	if savedSearchID != m.reorderHotlistCfg.expectedSavedSearchID {
+		m.t.Errorf("unexpected saved search id %s", savedSearchID)
+	}
+	if !reflect.DeepEqual(req, m.reorderHotlistCfg.expectedRequest) {
+		m.t.Errorf("unexpected request %+v", req)
+	}
+
+	return m.reorderHotlistCfg.output, m.reorderHotlistCfg.err
+}
+
+func (m *MockWPTMetricsStorer) PutHotlistFeature(_ context.Context,
+	userID, savedSearchID, featureID string, req backend.PutHotlistFeatureRequest) (*backend.Hotlist, error) {
+	m.callCountPutHotlistFeature++
+	if userID != m.putHotlistFeatureCfg.expectedUserID {
+		m.t.Errorf("unexpected user id %s", userID)
+	}
+	if savedSearchID != m.putHotlistFeatureCfg.expectedSavedSearchID {
+		m.t.Errorf("unexpected saved search id %s", savedSearchID)
+	}
+	if featureID != m.putHotlistFeatureCfg.expectedFeatureID {
+		m.t.Errorf("unexpected feature id %s", featureID)
+	}
+	if !reflect.DeepEqual(req, m.putHotlistFeatureCfg.expectedRequest) {
+		m.t.Errorf("unexpected request %+v", req)
+	}
+
+	return m.putHotlistFeatureCfg.output, m.putHotlistFeatureCfg.err
+}
+
+func (m *MockWPTMetricsStorer) RemoveHotlistFeature(_ context.Context,
+	userID, savedSearchID, featureID string, version int64) (*backend.Hotlist, error) {
+	m.callCountRemoveHotlistFeature++
+	if userID != m.removeHotlistFeatureCfg.expectedUserID {
+		m.t.Errorf("unexpected user id %s", userID)
+	}
+	if savedSearchID != m.removeHotlistFeatureCfg.expectedSavedSearchID {
+		m.t.Errorf("unexpected saved search id %s", savedSearchID)
+	}
+	if featureID != m.removeHotlistFeatureCfg.expectedFeatureID {
+		m.t.Errorf("unexpected feature id %s", featureID)
+	}
+	if version != m.removeHotlistFeatureCfg.expectedVersion {
+		m.t.Errorf("unexpected version %d", version)
+	}
+
+	return m.removeHotlistFeatureCfg.output, m.removeHotlistFeatureCfg.err
+}
+
+func (m *MockWPTMetricsStorer) ListSavedSearchCollaborators(_ context.Context,
+	userID, savedSearchID string) (*backend.SavedSearchCollaboratorList, error) {
+	m.callCountListSavedSearchCollaborators++
+	if userID != m.listSavedSearchCollaboratorsCfg.expectedUserID {
+		m.t.Errorf("unexpected user id %s", userID)
+	}
+	if savedSearchID != m.listSavedSearchCollaboratorsCfg.expectedSavedSearchID {
+		m.t.Errorf("unexpected saved search id %s", savedSearchID)
+	}
+
+	return m.listSavedSearchCollaboratorsCfg.output, m.listSavedSearchCollaboratorsCfg.err
+}
+
+func (m *MockWPTMetricsStorer) PutSavedSearchCollaborator(_ context.Context,
+	userID, savedSearchID, githubUsername string,
+	req backend.PutSavedSearchCollaboratorRequest) (*backend.SavedSearchCollaborator, error) {
+	m.callCountPutSavedSearchCollaborator++
+	if userID != m.putSavedSearchCollaboratorCfg.expectedUserID {
+		m.t.Errorf("unexpected user id %s", userID)
+	}
+	if savedSearchID != m.putSavedSearchCollaboratorCfg.expectedSavedSearchID {
+		m.t.Errorf("unexpected saved search id %s", savedSearchID)
+	}
+	if githubUsername != m.putSavedSearchCollaboratorCfg.expectedGitHubUsername {
+		m.t.Errorf("unexpected github username %s", githubUsername)
+	}
+	if !reflect.DeepEqual(req, m.putSavedSearchCollaboratorCfg.expectedRequest) {
+		m.t.Errorf("unexpected request %+v", req)
+	}
+
+	return m.putSavedSearchCollaboratorCfg.output, m.putSavedSearchCollaboratorCfg.err
+}
+
+func (m *MockWPTMetricsStorer) RemoveSavedSearchCollaborator(_ context.Context,
+	userID, savedSearchID, githubUsername string) error {
+	m.callCountRemoveSavedSearchCollaborator++
+	if userID != m.removeSavedSearchCollaboratorCfg.expectedUserID {
+		m.t.Errorf("unexpected user id %s", userID)
+	}
+	if savedSearchID != m.removeSavedSearchCollaboratorCfg.expectedSavedSearchID {
+		m.t.Errorf("unexpected saved search id %s", savedSearchID)
+	}
+	if githubUsername != m.removeSavedSearchCollaboratorCfg.expectedGitHubUsername {
+		m.t.Errorf("unexpected github username %s", githubUsername)
+	}
+
+	return m.removeSavedSearchCollaboratorCfg.err
+}
+
+func (m *MockWPTMetricsStorer) TransferSavedSearchOwnership(_ context.Context,
+	userID, savedSearchID string, req backend.TransferSavedSearchOwnershipRequest) error {
+	m.callCountTransferSavedSearchOwnership++
+	if userID != m.transferSavedSearchOwnershipCfg.expectedUserID {
+		m.t.Errorf("unexpected user id %s", userID)
+	}
+	if savedSearchID != m.transferSavedSearchOwnershipCfg.expectedSavedSearchID {
+		m.t.Errorf("unexpected saved search id %s", savedSearchID)
+	}
+	if !reflect.DeepEqual(req, m.transferSavedSearchOwnershipCfg.expectedRequest) {
+		m.t.Errorf("unexpected request %+v", req)
+	}
+
+	return m.transferSavedSearchOwnershipCfg.err
+}
+
+func (m *MockWPTMetricsStorer) ListSavedSearchRevisions(_ context.Context,
+	userID, savedSearchID string, pageSize int, pageToken *string) (*backend.SavedSearchRevisionPage, error) {
+	m.callCountListSavedSearchRevisions++
+	if userID != m.listSavedSearchRevisionsCfg.expectedUserID {
+		m.t.Errorf("unexpected user id %s", userID)
+	}
+	if savedSearchID != m.listSavedSearchRevisionsCfg.expectedSavedSearchID {
+		m.t.Errorf("unexpected saved search id %s", savedSearchID)
+	}
+	if pageSize != m.listSavedSearchRevisionsCfg.expectedPageSize ||
+		!reflect.DeepEqual(pageToken, m.listSavedSearchRevisionsCfg.expectedPageToken) {
+		m.t.Errorf("unexpected page size %d or token %v", pageSize, pageToken)
+	}
+
+	return m.listSavedSearchRevisionsCfg.output, m.listSavedSearchRevisionsCfg.err
+}
+
+func (m *MockWPTMetricsStorer) GetSavedSearchRevisionDiff(_ context.Context, userID, savedSearchID string,
+	revisionNumber int64, baseRevisionNumber *int64) (*backend.SavedSearchRevisionDiff, error) {
+	m.callCountGetSavedSearchRevisionDiff++
+	if userID != m.getSavedSearchRevisionDiffCfg.expectedUserID {
+		m.t.Errorf("unexpected user id %s", userID)
+	}
+	if savedSearchID != m.getSavedSearchRevisionDiffCfg.expectedSavedSearchID {
+		m.t.Errorf("unexpected saved search id %s", savedSearchID)
+	}
+	if revisionNumber != m.getSavedSearchRevisionDiffCfg.expectedRevisionNumber ||
+		!reflect.DeepEqual(baseRevisionNumber, m.getSavedSearchRevisionDiffCfg.expectedBaseRevisionNumber) {
+		m.t.Errorf("unexpected revisions %d and %v", revisionNumber, baseRevisionNumber)
+	}
+
+	return m.getSavedSearchRevisionDiffCfg.output, m.getSavedSearchRevisionDiffCfg.err
+}
+
+func (m *MockWPTMetricsStorer) RevertSavedSearch(_ context.Context,
+	userID, savedSearchID string, revisionNumber int64) (*backend.SavedSearchResponse, error) {
+	m.callCountRevertSavedSearch++
+	if userID != m.revertSavedSearchCfg.expectedUserID {
+		m.t.Errorf("unexpected user id %s", userID)
+	}
+	if savedSearchID != m.revertSavedSearchCfg.expectedSavedSearchID {
+		m.t.Errorf("unexpected saved search id %s", savedSearchID)
+	}
+	if revisionNumber != m.revertSavedSearchCfg.expectedRevisionNumber {
+		m.t.Errorf("unexpected revision number %d", revisionNumber)
+	}
+
+	return m.revertSavedSearchCfg.output, m.revertSavedSearchCfg.err
+}
+
+func (m *MockWPTMetricsStorer) ForkSavedSearch(_ context.Context,
+	userID, savedSearchID string, req *backend.ForkSavedSearchRequest) (*backend.SavedSearchResponse, error) {
+	m.callCountForkSavedSearch++
+	if userID != m.forkSavedSearchCfg.expectedUserID {
+		m.t.Errorf("unexpected user id %s", userID)
+	}
+	if savedSearchID != m.forkSavedSearchCfg.expectedSavedSearchID {
+		m.t.Errorf("unexpected saved search id %s", savedSearchID)
+	}
+	if !reflect.DeepEqual(req, m.forkSavedSearchCfg.expectedRequest) {
+		m.t.Errorf("unexpected request %+v", req)
+	}
+
+	return m.forkSavedSearchCfg.output, m.forkSavedSearchCfg.err
+}
+
+func (m *MockWPTMetricsStorer) ListSavedSearchDirectory(_ context.Context,
+	query, tag *string,
+	sort backend.ListSavedSearchDirectoryParamsSort,
+	pageSize int,
+	pageToken *string,
+) (*backend.SavedSearchDirectoryPage, error) {
+	m.callCountListSavedSearchDirectory++
+	cfg := m.listSavedSearchDirectoryCfg
+	if !reflect.DeepEqual(query, cfg.expectedQuery) || !reflect.DeepEqual(tag, cfg.expectedTag) ||
+		sort != cfg.expectedSort || pageSize != cfg.expectedPageSize ||
+		!reflect.DeepEqual(pageToken, cfg.expectedPageToken) {
+		m.t.Errorf("unexpected input %v %v %s %d %v", query, tag, sort, pageSize, pageToken)
+	}
+
+	return cfg.output, cfg.err
+}
+
+func (m *MockWPTMetricsStorer) ExportUserData(_ context.Context, userID string) (*backend.UserDataExport, error) {
+	m.callCountExportUserData++
+	if userID != m.exportUserDataCfg.expectedUserID {
+		m.t.Errorf("unexpected user id %s", userID)
+	}
+
+	return m.exportUserDataCfg.output, m.exportUserDataCfg.err
+}
+
+func (m *MockWPTMetricsStorer) ImportUserData(_ context.Context, userID string,
+	data backend.UserDataExport) (*backend.UserDataImportResult, error) {
+	m.callCountImportUserData++
+	if userID != m.importUserDataCfg.expectedUserID || !reflect.DeepEqual(data, m.importUserDataCfg.expectedData) {
+		m.t.Errorf("unexpected input %s %+v", userID, data)
+	}
+
+	return m.importUserDataCfg.output, m.importUserDataCfg.err
+}
+
+func (m *MockWPTMetricsStorer) ListSavedSearchSnapshots(_ context.Context, userID, savedSearchID string,
+	snapshotType backend.SubscriptionFrequency, at *time.Time, pageSize int, pageToken *string) (
+	*backend.SavedSearchSnapshotPage, error) {
+	m.callCountListSavedSearchSnapshots++
+	cfg := m.listSavedSearchSnapshotsCfg
+	if userID != cfg.expectedUserID || savedSearchID != cfg.expectedSavedSearchID {
+		m.t.Errorf("unexpected user id %s or saved search id %s", userID, savedSearchID)
+	}
+	if snapshotType != cfg.expectedSnapshotType {
+		m.t.Errorf("unexpected snapshot type %s", snapshotType)
+	}
+	if !reflect.DeepEqual(at, cfg.expectedAt) {
+		m.t.Errorf("unexpected at %v", at)
+	}
+	if pageSize != cfg.expectedPageSize || !reflect.DeepEqual(pageToken, cfg.expectedPageToken) {
+		m.t.Errorf("unexpected page size %d or token %v", pageSize, pageToken)
+	}
+
+	return cfg.output, cfg.err
+}
+
+func (m *MockWPTMetricsStorer) GetSavedSearchSnapshotRef(_ context.Context, userID, savedSearchID, snapshotID string,
+	snapshotType backend.SubscriptionFrequency, at *time.Time) (*backendtypes.SavedSearchSnapshotRef, error) {
+	m.callCountGetSavedSearchSnapshotRef++
+	cfg := m.getSavedSearchSnapshotRefCfg
+	if userID != cfg.expectedUserID || savedSearchID != cfg.expectedSavedSearchID {
+		m.t.Errorf("unexpected user id %s or saved search id %s", userID, savedSearchID)
+	}
+	if snapshotType != cfg.expectedSnapshotType {
+		m.t.Errorf("unexpected snapshot type %s", snapshotType)
+	}
+	if !reflect.DeepEqual(at, cfg.expectedAt) {
+		m.t.Errorf("unexpected at %v", at)
+	}
+	if cfg.err != nil {
+		return nil, cfg.err
+	}
+	ref, found := cfg.outputs[snapshotID]
+	if !found {
+		return nil, backendtypes.ErrEntityDoesNotExist
+	}
+
+	return ref, nil
+}
+
+func (m *MockWPTMetricsStorer) GetSavedSearchReferences(
+	_ context.Context, savedSearchID string, userID *string) (*backend.SavedSearchReferences, error) {
+	m.callCountGetSavedSearchReferences++
+	if savedSearchID != m.getSavedSearchReferencesCfg.expectedSavedSearchID {
+		m.t.Errorf("unexpected saved search id %s", savedSearchID)
+	}
+	if !reflect.DeepEqual(userID, m.getSavedSearchReferencesCfg.expectedUserID) {
+		m.t.Errorf("unexpected user id %v", userID)
+	}
+
+	return m.getSavedSearchReferencesCfg.output, m.getSavedSearchReferencesCfg.err
+}
+
+func (m *MockWPTMetricsStorer) ListSavedSearchBaselineStatusCounts(
+	_ context.Context,
+	savedSearchID string,
+	startAt time.Time,
+	endAt time.Time,
+	pageSize int,
+	pageToken *string,
+) (*backend.BaselineStatusMetricsPage, error) {
+	m.callCountListSavedSearchBaselineStatusCounts++
+	cfg := m.listSavedSearchBaselineStatusCountsCfg
+
+	if savedSearchID != cfg.expectedSavedSearchID ||
+		!startAt.Equal(cfg.expectedStartAt) ||
+		!endAt.Equal(cfg.expectedEndAt) ||
+		pageSize != cfg.expectedPageSize ||
+		!reflect.DeepEqual(pageToken, cfg.expectedPageToken) {
+		m.t.Errorf("Incorrect arguments. Expected: %v, Got: { %s, %s, %s, %d %v }",
+			cfg, savedSearchID, startAt, endAt, pageSize, pageToken)
+	}
+
+	return cfg.page, cfg.err
+}
+
+func (m *MockWPTMetricsStorer) ListSavedSearchBrowserFeatureCountMetric(
+	_ context.Context,
+	savedSearchID string,
+	targetBrowser string,
+	targetMobileBrowser *string,
+	startAt time.Time,
+	endAt time.Time,
+	pageSize int,
+	pageToken *string,
+) (*backend.BrowserReleaseFeatureMetricsPage, error) {
+	m.callCountListSavedSearchBrowserFeatureCountMetric++
+	cfg := m.listSavedSearchBrowserFeatureCountMetricCfg
+
+	if savedSearchID != cfg.expectedSavedSearchID ||
+		targetBrowser != cfg.expectedTargetBrowser ||
+		!reflect.DeepEqual(targetMobileBrowser, cfg.expectedTargetMobileBrowser) ||
+		!startAt.Equal(cfg.expectedStartAt) ||
+		!endAt.Equal(cfg.expectedEndAt) ||
+		pageSize != cfg.expectedPageSize ||
+		!reflect.DeepEqual(pageToken, cfg.expectedPageToken) {
+		m.t.Errorf("Incorrect arguments. Expected: %v, Got: { %s, %s, %v, %s, %s, %d %v }",
+			cfg, savedSearchID, targetBrowser, targetMobileBrowser, startAt, endAt, pageSize, pageToken)
+	}
+
+	return cfg.page, cfg.err
+}
+
 func (m *MockWPTMetricsStorer) UpdateSavedSearchSubscription(_ context.Context, userID, subscriptionID string,
 	req backend.UpdateSubscriptionRequest) (*backend.SubscriptionResponse, error) {
 	m.callCountUpdateSavedSearchSubscription++
@@ -571,6 +1309,7 @@ func (m *MockWPTMetricsStorer) FeaturesSearch(
 	sortBy *backend.ListFeaturesParamsSort,
 	view backend.WPTMetricView,
 	browsers []backend.BrowserPathParam,
+	queryParams map[string]string,
 ) (*backend.FeaturePage, error) {
 	m.callCountFeaturesSearch++
 
@@ -579,14 +1318,33 @@ func (m *MockWPTMetricsStorer) FeaturesSearch(
 		!reflect.DeepEqual(node, m.featuresSearchCfg.expectedSearchNode) ||
 		!reflect.DeepEqual(sortBy, m.featuresSearchCfg.expectedSortBy) ||
 		view != m.featuresSearchCfg.expectedWPTMetricView ||
-		!slices.Equal(browsers, m.featuresSearchCfg.expectedBrowsers) {
-		m.t.Errorf("Incorrect arguments. Expected: %v, Got: { %v %d %v %v %v %v }",
-			m.featuresSearchCfg, pageSize, pageToken, node, sortBy, view, browsers)
+		!slices.Equal(browsers, m.featuresSearchCfg.expectedBrowsers) ||
+		!maps.Equal(queryParams, m.featuresSearchCfg.expectedQueryParams) {
+		m.t.Errorf("Incorrect arguments. Expected: %v, Got: { %v %d %v %v %v %v %v }",
+			m.featuresSearchCfg, pageSize, pageToken, node, sortBy, view, browsers, queryParams)
 	}
 
 	return m.featuresSearchCfg.page, m.featuresSearchCfg.err
 }
 
+func (m *MockWPTMetricsStorer) FeaturesSearchFacets(
+	_ context.Context,
+	node *searchtypes.SearchNode,
+	facets []backend.FeatureSearchFacet,
+	queryParams map[string]string,
+) (*backend.FeatureSearchFacets, error) {
+	m.callCountFeaturesSearchFacets++
+
+	if !reflect.DeepEqual(node, m.featuresSearchFacetsCfg.expectedSearchNode) ||
+		!slices.Equal(facets, m.featuresSearchFacetsCfg.expectedFacets) ||
+		!maps.Equal(queryParams, m.featuresSearchFacetsCfg.expectedQueryParams) {
+		m.t.Errorf("Incorrect arguments. Expected: %v, Got: { %v %v %v }",
+			m.featuresSearchFacetsCfg, node, facets, queryParams)
+	}
+
+	return m.featuresSearchFacetsCfg.facets, m.featuresSearchFacetsCfg.err
+}
+
 func (m *MockWPTMetricsStorer) GetFeature(
 	_ context.Context,
 	featureID string,
@@ -605,6 +1363,67 @@ func (m *MockWPTMetricsStorer) GetFeature(
 	return m.getFeatureByIDConfig.data, m.getFeatureByIDConfig.err
 }
 
+func (m *MockWPTMetricsStorer) BatchGetFeatures(
+	_ context.Context,
+	featureIDs []string,
+	view backend.WPTMetricView,
+	browsers []backend.BrowserPathParam,
+) (map[string]*backendtypes.GetFeatureResult, error) {
+	m.callCountBatchGetFeatures++
+
+	if !slices.Equal(featureIDs, m.batchGetFeaturesCfg.expectedFeatureIDs) ||
+		view != m.batchGetFeaturesCfg.expectedWPTMetricView ||
+		!slices.Equal(browsers, m.batchGetFeaturesCfg.expectedBrowsers) {
+		m.t.Errorf("Incorrect arguments. Expected: %v, Got: { %v %v %v }",
+			m.batchGetFeaturesCfg, featureIDs, view, browsers)
+	}
+
+	return m.batchGetFeaturesCfg.data, m.batchGetFeaturesCfg.err
+}
+
+func (m *MockWPTMetricsStorer) GetFeatureTimeline(
+	_ context.Context,
+	featureID string,
+) (*backend.FeatureTimeline, error) {
+	m.callCountGetFeatureTimeline++
+
+	if featureID != m.getFeatureTimelineCfg.expectedFeatureID {
+		m.t.Errorf("Incorrect arguments. Expected: %v, Got: { %s }", m.getFeatureTimelineCfg, featureID)
+	}
+
+	return m.getFeatureTimelineCfg.result, m.getFeatureTimelineCfg.err
+}
+
+func (m *MockWPTMetricsStorer) GetBrowserReleaseChannelVersion(
+	_ context.Context,
+	browser backend.SupportedBrowsers,
+	channel string,
+) (string, error) {
+	m.callCountGetBrowserReleaseChannelVersion++
+
+	if browser != m.getBrowserReleaseChannelVersionCfg.expectedBrowser ||
+		channel != m.getBrowserReleaseChannelVersionCfg.expectedChannel {
+		m.t.Errorf("Incorrect arguments. Expected: %v, Got: { %s %s }",
+			m.getBrowserReleaseChannelVersionCfg, browser, channel)
+	}
+
+	return m.getBrowserReleaseChannelVersionCfg.result, m.getBrowserReleaseChannelVersionCfg.err
+}
+
+func (m *MockWPTMetricsStorer) CountSavedSearchFeaturesByBaselineStatus(
+	_ context.Context,
+	savedSearchID string,
+) ([]backend.FeatureSearchFacetCount, error) {
+	m.callCountCountSavedSearchFeaturesByBaselineStatus++
+
+	if savedSearchID != m.countSavedSearchFeaturesByBaselineStatusCfg.expectedSavedSearchID {
+		m.t.Errorf("Incorrect arguments. Expected: %v, Got: { %s }",
+			m.countSavedSearchFeaturesByBaselineStatusCfg, savedSearchID)
+	}
+
+	return m.countSavedSearchFeaturesByBaselineStatusCfg.result, m.countSavedSearchFeaturesByBaselineStatusCfg.err
+}
+
 func (m *MockWPTMetricsStorer) ListBrowserFeatureCountMetric(
 	_ context.Context,
 	targetBrowser string,
@@ -853,14 +1672,16 @@ func (m *MockWPTMetricsStorer) DeleteUserSavedSearch(
 	_ context.Context,
 	userID string,
 	savedSearchID string,
+	force bool,
 ) error {
 	m.callCountDeleteUserSavedSearch++
 
 	if userID != m.deleteUserSavedSearchCfg.expectedUserID ||
-		savedSearchID != m.deleteUserSavedSearchCfg.expectedSavedSearchID {
-		m.t.Errorf("Incorrect arguments. Expected: ( %s %s ), Got: { %s %s }",
+		savedSearchID != m.deleteUserSavedSearchCfg.expectedSavedSearchID ||
+		force != m.deleteUserSavedSearchCfg.expectedForce {
+		m.t.Errorf("Incorrect arguments. Expected: ( %s %s %t ), Got: { %s %s %t }",
 			m.deleteUserSavedSearchCfg.expectedUserID, m.deleteUserSavedSearchCfg.expectedSavedSearchID,
-			userID, savedSearchID)
+			m.deleteUserSavedSearchCfg.expectedForce, userID, savedSearchID, force)
 	}
 
 	return m.deleteUserSavedSearchCfg.err
@@ -950,6 +1771,56 @@ func (m *MockWPTMetricsStorer) RemoveUserSavedSearchBookmark(
 	return m.removeUserSavedSearchBookmarkCfg.err
 }
 
+func (m *MockWPTMetricsStorer) GetNotificationChannelDeliverySettings(
+	_ context.Context,
+	userID, channelID string,
+) (*backend.NotificationChannelDeliverySettings, error) {
+	m.callCountGetNotificationChannelDeliverySettings++
+
+	if m.getNotificationChannelDeliverySettingsCfg == nil {
+		return nil, errors.New("mock getNotificationChannelDeliverySettingsCfg not configured")
+	}
+
+	if userID != m.getNotificationChannelDeliverySettingsCfg.expectedUserID ||
+		channelID != m.getNotificationChannelDeliverySettingsCfg.expectedChannelID {
+		m.t.Errorf("Incorrect arguments - Expected: ( %s %s ), Got: ( %s %s )",
+			m.getNotificationChannelDeliverySettingsCfg.expectedUserID,
+			m.getNotificationChannelDeliverySettingsCfg.expectedChannelID,
+			userID,
+			channelID,
+		)
+	}
+
+	return m.getNotificationChannelDeliverySettingsCfg.output, m.getNotificationChannelDeliverySettingsCfg.err
+}
+
+func (m *MockWPTMetricsStorer) PutNotificationChannelDeliverySettings(
+	_ context.Context,
+	userID, channelID string,
+	settings backend.NotificationChannelDeliverySettings,
+) (*backend.NotificationChannelDeliverySettings, error) {
+	m.callCountPutNotificationChannelDeliverySettings++
+
+	if m.putNotificationChannelDeliverySettingsCfg == nil {
+		return nil, errors.New("mock putNotificationChannelDeliverySettingsCfg not configured")
+	}
+
+	if userID != m.putNotificationChannelDeliverySettingsCfg.expectedUserID ||
+		channelID != m.putNotificationChannelDeliverySettingsCfg.expectedChannelID ||
+		!reflect.DeepEqual(settings, m.putNotificationChannelDeliverySettingsCfg.expectedSettings) {
+		m.t.Errorf("Incorrect arguments - Expected: ( %s %s %+v ), Got: ( %s %s %+v )",
+			m.putNotificationChannelDeliverySettingsCfg.expectedUserID,
+			m.putNotificationChannelDeliverySettingsCfg.expectedChannelID,
+			m.putNotificationChannelDeliverySettingsCfg.expectedSettings,
+			userID,
+			channelID,
+			settings,
+		)
+	}
+
+	return m.putNotificationChannelDeliverySettingsCfg.output, m.putNotificationChannelDeliverySettingsCfg.err
+}
+
 func (m *MockWPTMetricsStorer) GetNotificationChannel(
 	_ context.Context,
 	userID, channelID string,
@@ -1025,12 +1896,61 @@ func (m *MockWPTMetricsStorer) CreateNotificationChannel(
 			m.createNotificationChannelCfg.expectedUserID, userID)
 	}
 
-	if diff := cmp.Diff(m.createNotificationChannelCfg.expectedRequest,
-		req, getCreateNotificationChannelRequestCmpOption()); diff != "" {
-		m.t.Errorf("Incorrect arguments - Request mismatch (-want +got):\n%s", diff)
+	if diff := cmp.Diff(m.createNotificationChannelCfg.expectedRequest,
+		req, getCreateNotificationChannelRequestCmpOption()); diff != "" {
+		m.t.Errorf("Incorrect arguments - Request mismatch (-want +got):\n%s", diff)
+	}
+
+	return m.createNotificationChannelCfg.output, m.createNotificationChannelCfg.err
+}
+
+func (m *MockWPTMetricsStorer) CreatePersonalAccessToken(
+	_ context.Context,
+	userID string,
+	req backend.CreatePersonalAccessTokenRequest,
+) (*backend.CreatedPersonalAccessToken, error) {
+	m.callCountCreatePersonalAccessToken++
+
+	if userID != m.createPersonalAccessTokenCfg.expectedUserID ||
+		req != m.createPersonalAccessTokenCfg.expectedRequest {
+		m.t.Errorf("Incorrect arguments - Expected: ( %s %+v ), Got: ( %s %+v )",
+			m.createPersonalAccessTokenCfg.expectedUserID, m.createPersonalAccessTokenCfg.expectedRequest,
+			userID, req)
+	}
+
+	return m.createPersonalAccessTokenCfg.output, m.createPersonalAccessTokenCfg.err
+}
+
+func (m *MockWPTMetricsStorer) ListPersonalAccessTokens(
+	_ context.Context,
+	userID string,
+) (*backend.PersonalAccessTokenPage, error) {
+	m.callCountListPersonalAccessTokens++
+
+	if userID != m.listPersonalAccessTokensCfg.expectedUserID {
+		m.t.Errorf("Incorrect arguments - Expected UserID: %s, Got: %s",
+			m.listPersonalAccessTokensCfg.expectedUserID, userID)
+	}
+
+	return m.listPersonalAccessTokensCfg.output, m.listPersonalAccessTokensCfg.err
+}
+
+func (m *MockWPTMetricsStorer) RevokePersonalAccessToken(
+	_ context.Context,
+	userID, tokenID string,
+) error {
+	m.callCountRevokePersonalAccessToken++
+
+	if userID != m.revokePersonalAccessTokenCfg.expectedUserID ||
+		tokenID != m.revokePersonalAccessTokenCfg.expectedTokenID {
+		m.t.Errorf("Incorrect arguments - Expected: ( %s %s ), Got: ( %s %s )",
+			m.revokePersonalAccessTokenCfg.expectedUserID,
+			m.revokePersonalAccessTokenCfg.expectedTokenID,
+			userID,
+			tokenID)
 	}
 
-	return m.createNotificationChannelCfg.output, m.createNotificationChannelCfg.err
+	return m.revokePersonalAccessTokenCfg.err
 }
 
 func (m *MockWPTMetricsStorer) UpdateNotificationChannel(
@@ -1066,6 +1986,7 @@ func (m *MockWPTMetricsStorer) UpdateNotificationChannel(
 func (m *MockWPTMetricsStorer) ValidateQueryReferences(
 	_ context.Context,
 	query string,
+	_ *backend.SavedSearchQueryParameters,
 	_ *string,
 ) error {
 	m.callCountValidateQueryReferences++
@@ -1143,6 +2064,50 @@ func (m *MockEventPublisher) PublishSearchConfigurationChanged(
 	return m.publishSearchConfigurationChangedCfg.err
 }
 
+type MockGetSavedSearchSnapshotConfig struct {
+	expectedRef backendtypes.SavedSearchSnapshotRef
+	output      *backend.SavedSearchSnapshot
+	err         error
+}
+
+type MockDiffSavedSearchSnapshotsConfig struct {
+	expectedBase   backendtypes.SavedSearchSnapshotRef
+	expectedTarget backendtypes.SavedSearchSnapshotRef
+	output         *backend.SavedSearchSnapshotDiff
+	err            error
+}
+
+type MockSavedSearchSnapshotReader struct {
+	t                                 *testing.T
+	callCountGetSavedSearchSnapshot   int
+	callCountDiffSavedSearchSnapshots int
+	getSavedSearchSnapshotCfg         *MockGetSavedSearchSnapshotConfig
+	diffSavedSearchSnapshotsCfg       *MockDiffSavedSearchSnapshotsConfig
+}
+
+func (m *MockSavedSearchSnapshotReader) GetSavedSearchSnapshot(
+	_ context.Context, ref backendtypes.SavedSearchSnapshotRef) (*backend.SavedSearchSnapshot, error) {
+	m.callCountGetSavedSearchSnapshot++
+	if !reflect.DeepEqual(ref, m.getSavedSearchSnapshotCfg.expectedRef) {
+		m.t.Errorf("unexpected ref %+v", ref)
+	}
+
+	return m.getSavedSearchSnapshotCfg.output, m.getSavedSearchSnapshotCfg.err
+}
+
+func (m *MockSavedSearchSnapshotReader) DiffSavedSearchSnapshots(
+	_ context.Context, base, target backendtypes.SavedSearchSnapshotRef) (*backend.SavedSearchSnapshotDiff, error) {
+	m.callCountDiffSavedSearchSnapshots++
+	if !reflect.DeepEqual(base, m.diffSavedSearchSnapshotsCfg.expectedBase) {
+		m.t.Errorf("unexpected base ref %+v", base)
+	}
+	if !reflect.DeepEqual(target, m.diffSavedSearchSnapshotsCfg.expectedTarget) {
+		m.t.Errorf("unexpected target ref %+v", target)
+	}
+
+	return m.diffSavedSearchSnapshotsCfg.output, m.diffSavedSearchSnapshotsCfg.err
+}
+
 func TestGetPageSizeOrDefault(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -1362,7 +2327,7 @@ func assertTestServerRequest(t *testing.T, testServer *Server, req *http.Request
 	}
 
 	srv := createOpenAPIServerServer("", testServer, []func(http.Handler) http.Handler{
-		recoveryMiddleware}, testServerConfig.authMiddleware)
+		recoveryMiddleware}, testServerConfig.authMiddleware, nil, nil)
 
 	w := httptest.NewRecorder()
 	srv.Handler.ServeHTTP(w, req)
@@ -1371,9 +2336,32 @@ func assertTestServerRequest(t *testing.T, testServer *Server, req *http.Request
 
 	assertStatusCode(t, resp.StatusCode, expectedResponse.StatusCode)
 	assertHeaders(t, resp.Header, expectedResponse.Header)
+	if contentType := expectedResponse.Header.Get("Content-Type"); contentType != "" &&
+		contentType != "application/json" {
+		// Bodies that are not JSON, such as images, are compared verbatim.
+		assertRawResponseBody(t, resp.Body, expectedResponse.Body)
+
+		return
+	}
 	assertResponseBody(t, resp.Body, expectedResponse.Body)
 }
 
+func assertRawResponseBody(t *testing.T, actual, expected io.Reader) {
+	actualBody, err := io.ReadAll(actual)
+	if err != nil {
+		t.Fatal("failed to read actual body")
+	}
+
+	expectedBody, err := io.ReadAll(expected)
+	if err != nil {
+		t.Fatal("failed to read expected body")
+	}
+
+	if string(actualBody) != string(expectedBody) {
+		t.Errorf("expected body %s. received %s", string(expectedBody), string(actualBody))
+	}
+}
+
 type mockServerInterface struct {
 	t                 *testing.T
 	expectedUserInCtx *auth.User
@@ -1408,6 +2396,51 @@ func (m *mockServerInterface) GetFeature(ctx context.Context, _ backend.GetFeatu
 	panic("unimplemented")
 }
 
+// BatchGetFeatures implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) BatchGetFeatures(ctx context.Context, _ backend.BatchGetFeaturesRequestObject) (
+	backend.BatchGetFeaturesResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// GetFeatureTimeline implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) GetFeatureTimeline(ctx context.Context, _ backend.GetFeatureTimelineRequestObject) (
+	backend.GetFeatureTimelineResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// GetFeatureBadge implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) GetFeatureBadge(ctx context.Context, _ backend.GetFeatureBadgeRequestObject) (
+	backend.GetFeatureBadgeResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// GetSavedSearchBadge implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) GetSavedSearchBadge(ctx context.Context, _ backend.GetSavedSearchBadgeRequestObject) (
+	backend.GetSavedSearchBadgeResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// GetCompatReport implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) GetCompatReport(ctx context.Context, _ backend.GetCompatReportRequestObject) (
+	backend.GetCompatReportResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
 // GetFeatureMetadata implements backend.StrictServerInterface.
 // nolint: ireturn // WONTFIX - generated method signature
 func (m *mockServerInterface) GetFeatureMetadata(ctx context.Context, _ backend.GetFeatureMetadataRequestObject) (
@@ -1562,6 +2595,28 @@ func (m *mockServerInterface) UpdateNotificationChannel(
 	panic("unimplemented")
 }
 
+// GetNotificationChannelDeliverySettings implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) GetNotificationChannelDeliverySettings(
+	ctx context.Context,
+	_ backend.GetNotificationChannelDeliverySettingsRequestObject,
+) (backend.GetNotificationChannelDeliverySettingsResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// PutNotificationChannelDeliverySettings implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) PutNotificationChannelDeliverySettings(
+	ctx context.Context,
+	_ backend.PutNotificationChannelDeliverySettingsRequestObject,
+) (backend.PutNotificationChannelDeliverySettingsResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
 // GetNotificationChannel implements backend.StrictServerInterface.
 // nolint: ireturn // WONTFIX - generated method signature
 func (m *mockServerInterface) GetNotificationChannel(
@@ -1676,6 +2731,295 @@ func (m *mockServerInterface) DeleteSubscription(ctx context.Context,
 	panic("unimplemented")
 }
 
+// PutWatchedFeature implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) PutWatchedFeature(ctx context.Context,
+	_ backend.PutWatchedFeatureRequestObject) (
+	backend.PutWatchedFeatureResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// DeleteWatchedFeature implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) DeleteWatchedFeature(ctx context.Context,
+	_ backend.DeleteWatchedFeatureRequestObject) (
+	backend.DeleteWatchedFeatureResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// ListWatchedFeatures implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) ListWatchedFeatures(ctx context.Context,
+	_ backend.ListWatchedFeaturesRequestObject) (
+	backend.ListWatchedFeaturesResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// CreateHotlist implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) CreateHotlist(ctx context.Context,
+	_ backend.CreateHotlistRequestObject) (
+	backend.CreateHotlistResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// GetHotlist implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) GetHotlist(ctx context.Context,
+	_ backend.GetHotlistRequestObject) (
+	backend.GetHotlistResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// ReorderHotlist implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) ReorderHotlist(ctx context.Context,
+	_ backend.ReorderHotlistRequestObject) (
+	backend.ReorderHotlistResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// PutHotlistFeature implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) PutHotlistFeature(ctx context.Context,
+	_ backend.PutHotlistFeatureRequestObject) (
+	backend.PutHotlistFeatureResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// RemoveHotlistFeature implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) RemoveHotlistFeature(ctx context.Context,
+	_ backend.RemoveHotlistFeatureRequestObject) (
+	backend.RemoveHotlistFeatureResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// ListSavedSearchCollaborators implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) ListSavedSearchCollaborators(ctx context.Context,
+	_ backend.ListSavedSearchCollaboratorsRequestObject) (
+	backend.ListSavedSearchCollaboratorsResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// PutSavedSearchCollaborator implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) PutSavedSearchCollaborator(ctx context.Context,
+	_ backend.PutSavedSearchCollaboratorRequestObject) (
+	backend.PutSavedSearchCollaboratorResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// RemoveSavedSearchCollaborator implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) RemoveSavedSearchCollaborator(ctx context.Context,
+	_ backend.RemoveSavedSearchCollaboratorRequestObject) (
+	backend.RemoveSavedSearchCollaboratorResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// TransferSavedSearchOwnership implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) TransferSavedSearchOwnership(ctx context.Context,
+	_ backend.TransferSavedSearchOwnershipRequestObject) (
+	backend.TransferSavedSearchOwnershipResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// ListSavedSearchRevisions implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) ListSavedSearchRevisions(ctx context.Context,
+	_ backend.ListSavedSearchRevisionsRequestObject) (
+	backend.ListSavedSearchRevisionsResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// GetSavedSearchRevisionDiff implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) GetSavedSearchRevisionDiff(ctx context.Context,
+	_ backend.GetSavedSearchRevisionDiffRequestObject) (
+	backend.GetSavedSearchRevisionDiffResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// RevertSavedSearch implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) RevertSavedSearch(ctx context.Context,
+	_ backend.RevertSavedSearchRequestObject) (
+	backend.RevertSavedSearchResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// ForkSavedSearch implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) ForkSavedSearch(ctx context.Context,
+	_ backend.ForkSavedSearchRequestObject) (
+	backend.ForkSavedSearchResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// ListSavedSearchDirectory implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) ListSavedSearchDirectory(
+	ctx context.Context,
+	_ backend.ListSavedSearchDirectoryRequestObject,
+) (backend.ListSavedSearchDirectoryResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+func (m *mockServerInterface) ExportUserData(
+	ctx context.Context,
+	_ backend.ExportUserDataRequestObject,
+) (backend.ExportUserDataResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+func (m *mockServerInterface) ImportUserData(
+	ctx context.Context,
+	_ backend.ImportUserDataRequestObject,
+) (backend.ImportUserDataResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// ListSavedSearchSnapshots implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) ListSavedSearchSnapshots(ctx context.Context,
+	_ backend.ListSavedSearchSnapshotsRequestObject) (
+	backend.ListSavedSearchSnapshotsResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// GetSavedSearchSnapshot implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) GetSavedSearchSnapshot(ctx context.Context,
+	_ backend.GetSavedSearchSnapshotRequestObject) (
+	backend.GetSavedSearchSnapshotResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// GetSavedSearchSnapshotDiff implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) GetSavedSearchSnapshotDiff(ctx context.Context,
+	_ backend.GetSavedSearchSnapshotDiffRequestObject) (
+	backend.GetSavedSearchSnapshotDiffResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// GetSavedSearchReferences implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) GetSavedSearchReferences(ctx context.Context,
+	_ backend.GetSavedSearchReferencesRequestObject) (
+	backend.GetSavedSearchReferencesResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// ListSavedSearchBaselineStatusCounts implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) ListSavedSearchBaselineStatusCounts(ctx context.Context,
+	_ backend.ListSavedSearchBaselineStatusCountsRequestObject) (
+	backend.ListSavedSearchBaselineStatusCountsResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// ListSavedSearchBrowserFeatureCounts implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) ListSavedSearchBrowserFeatureCounts(ctx context.Context,
+	_ backend.ListSavedSearchBrowserFeatureCountsRequestObject) (
+	backend.ListSavedSearchBrowserFeatureCountsResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// ExportFeatures implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) ExportFeatures(ctx context.Context,
+	_ backend.ExportFeaturesRequestObject) (
+	backend.ExportFeaturesResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// CreatePersonalAccessToken implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) CreatePersonalAccessToken(ctx context.Context,
+	_ backend.CreatePersonalAccessTokenRequestObject) (
+	backend.CreatePersonalAccessTokenResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// ListPersonalAccessTokens implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) ListPersonalAccessTokens(ctx context.Context,
+	_ backend.ListPersonalAccessTokensRequestObject) (
+	backend.ListPersonalAccessTokensResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
+// RevokePersonalAccessToken implements backend.StrictServerInterface.
+// nolint: ireturn // WONTFIX - generated method signature
+func (m *mockServerInterface) RevokePersonalAccessToken(ctx context.Context,
+	_ backend.RevokePersonalAccessTokenRequestObject) (
+	backend.RevokePersonalAccessTokenResponseObject, error) {
+	assertUserInCtx(ctx, m.t, m.expectedUserInCtx)
+	m.callCount++
+	panic("unimplemented")
+}
+
 // GetHealthcheckLiveness implements backend.StrictServerInterface.
 // nolint: ireturn // WONTFIX - generated method signature
 func (m *mockServerInterface) GetHealthcheckLiveness(ctx context.Context,
@@ -103,6 +103,7 @@ func setupTestServer(t *testing.T, options ...TestServerOption) *Server {
 		baseURL:                 getTestBaseURL(t),
 		userGitHubClientFactory: nil,
 		eventPublisher:          nil,
+		snapshotReader:          nil,
 		rssRenderer:             NewRSSRenderer(),
 	}
 
@@ -134,6 +135,12 @@ func withCustomEventPublisher(p EventPublisher) TestServerOption {
 	}
 }
 
+func withCustomSnapshotReader(r SavedSearchSnapshotReader) TestServerOption {
+	return func(srv *Server) {
+		srv.snapshotReader = r
+	}
+}
+
 func withCustomCaches(c *operationResponseCaches) TestServerOption {
 	return func(srv *Server) {
 		srv.operationResponseCaches = c
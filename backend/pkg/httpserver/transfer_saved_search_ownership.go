@@ -0,0 +1,88 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+var errSavedSearchNewOwnerRequired = errors.New("github_username must not be empty")
+
+// TransferSavedSearchOwnership handles the PUT request to /v1/saved-searches/{search_id}/owner.
+// nolint:ireturn, revive // Expected ireturn for openapi generation.
+func (s *Server) TransferSavedSearchOwnership(
+	ctx context.Context,
+	request backend.TransferSavedSearchOwnershipRequestObject,
+) (backend.TransferSavedSearchOwnershipResponseObject, error) {
+	userCheckResult := CheckAuthenticatedUser(ctx, "TransferSavedSearchOwnership",
+		func(code int, message string) backend.TransferSavedSearchOwnership500JSONResponse {
+			return backend.TransferSavedSearchOwnership500JSONResponse{
+				Code:    code,
+				Message: message,
+			}
+		})
+	if userCheckResult.User == nil {
+		return userCheckResult.Response, nil
+	}
+
+	if request.Body.GithubUsername == "" {
+		fieldErrors := &fieldValidationErrors{fieldErrorMap: nil}
+		fieldErrors.addFieldError("github_username", errSavedSearchNewOwnerRequired)
+
+		return backend.TransferSavedSearchOwnership400JSONResponse{
+			Code:    http.StatusBadRequest,
+			Message: errMsgInputValidationErrors,
+			Errors:  fieldErrors.fieldErrorMap,
+		}, nil
+	}
+
+	err := s.wptMetricsStorer.TransferSavedSearchOwnership(ctx,
+		userCheckResult.User.ID, request.SearchId, *request.Body)
+	if err != nil {
+		switch {
+		case errors.Is(err, backendtypes.ErrUserNotAuthorizedForAction):
+			return backend.TransferSavedSearchOwnership403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "forbidden",
+			}, nil
+		case errors.Is(err, backendtypes.ErrUserMaxSavedSearches):
+			return backend.TransferSavedSearchOwnership403JSONResponse{
+				Code:    http.StatusForbidden,
+				Message: "the new owner has reached the maximum number of allowed saved searches",
+			}, nil
+		case errors.Is(err, backendtypes.ErrEntityDoesNotExist):
+			return backend.TransferSavedSearchOwnership404JSONResponse{
+				Code:    http.StatusNotFound,
+				Message: "no webstatus.dev user with this GitHub username",
+			}, nil
+		}
+
+		slog.ErrorContext(ctx, "unable to transfer saved search ownership", "error", err,
+			"searchID", request.SearchId)
+
+		return backend.TransferSavedSearchOwnership500JSONResponse{
+			Code:    http.StatusInternalServerError,
+			Message: "unable to transfer ownership",
+		}, nil
+	}
+
+	return backend.TransferSavedSearchOwnership204Response{}, nil
+}
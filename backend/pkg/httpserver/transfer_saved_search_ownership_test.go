@@ -0,0 +1,119 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+func TestTransferSavedSearchOwnership(t *testing.T) {
+	testUser := &auth.User{
+		ID:           "test-user",
+		GitHubUserID: nil,
+		TokenScope:   nil,
+	}
+	body := `{"github_username": "octocat"}`
+	cfg := func(err error) *MockTransferSavedSearchOwnershipConfig {
+		return &MockTransferSavedSearchOwnershipConfig{
+			expectedUserID:        "test-user",
+			expectedSavedSearchID: "search-id",
+			expectedRequest:       backend.TransferSavedSearchOwnershipRequest{GithubUsername: "octocat"},
+			err:                   err,
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		cfg               *MockTransferSavedSearchOwnershipConfig
+		expectedCallCount int
+		body              string
+		expectedResponse  *http.Response
+	}{
+		{
+			name:              "success",
+			cfg:               cfg(nil),
+			expectedCallCount: 1,
+			body:              body,
+			expectedResponse:  createEmptyBodyResponse(http.StatusNoContent),
+		},
+		{
+			name:              "bad request - empty username",
+			cfg:               nil,
+			expectedCallCount: 0,
+			body:              `{"github_username": ""}`,
+			expectedResponse: testJSONResponse(http.StatusBadRequest, `{
+				"code":400,
+				"message":"input validation errors",
+				"errors":{"github_username":"`+errSavedSearchNewOwnerRequired.Error()+`"}
+			}`),
+		},
+		{
+			name:              "forbidden - not the owner",
+			cfg:               cfg(backendtypes.ErrUserNotAuthorizedForAction),
+			expectedCallCount: 1,
+			body:              body,
+			expectedResponse:  testJSONResponse(http.StatusForbidden, `{"code":403,"message":"forbidden"}`),
+		},
+		{
+			name:              "forbidden - new owner at limit",
+			cfg:               cfg(backendtypes.ErrUserMaxSavedSearches),
+			expectedCallCount: 1,
+			body:              body,
+			expectedResponse: testJSONResponse(http.StatusForbidden,
+				`{"code":403,"message":"the new owner has reached the maximum number of allowed saved searches"}`),
+		},
+		{
+			name:              "not found - unknown username",
+			cfg:               cfg(backendtypes.ErrEntityDoesNotExist),
+			expectedCallCount: 1,
+			body:              body,
+			expectedResponse: testJSONResponse(http.StatusNotFound,
+				`{"code":404,"message":"no webstatus.dev user with this GitHub username"}`),
+		},
+		{
+			name:              "internal server error",
+			cfg:               cfg(errors.New("database error")),
+			expectedCallCount: 1,
+			body:              body,
+			expectedResponse: testJSONResponse(http.StatusInternalServerError,
+				`{"code":500,"message":"unable to transfer ownership"}`),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint:exhaustruct
+			mockStorer := &MockWPTMetricsStorer{
+				transferSavedSearchOwnershipCfg: tc.cfg,
+				t:                               t,
+			}
+			myServer := setupTestServer(t, withCustomStorer(mockStorer))
+			req := httptest.NewRequestWithContext(t.Context(),
+				http.MethodPut, "/v1/saved-searches/search-id/owner", strings.NewReader(tc.body))
+			assertTestServerRequest(t, myServer, req, tc.expectedResponse,
+				withAuthMiddleware(mockAuthMiddleware(testUser)))
+			assertMocksExpectations(t, tc.expectedCallCount, mockStorer.callCountTransferSavedSearchOwnership,
+				"TransferSavedSearchOwnership", nil)
+		})
+	}
+}
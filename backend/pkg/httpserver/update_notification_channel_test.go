@@ -30,6 +30,7 @@ func TestUpdateNotificationChannel_Restrictions(t *testing.T) {
 	testUser := &auth.User{
 		ID:           "testUserID1",
 		GitHubUserID: nil,
+		TokenScope:   nil,
 	}
 
 	testCases := []struct {
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
@@ -44,7 +45,10 @@ func validateSavedSearchUpdate(input *backend.UpdateSavedSearchJSONRequestBody)
 		case
 			backend.SavedSearchUpdateRequestMaskName,
 			backend.SavedSearchUpdateRequestMaskQuery,
-			backend.SavedSearchUpdateRequestMaskDescription:
+			backend.SavedSearchUpdateRequestMaskDescription,
+			backend.SavedSearchUpdateRequestMaskTags,
+			backend.SavedSearchUpdateRequestMaskListed,
+			backend.SavedSearchUpdateRequestMaskParameters:
 			activeMasks[mask] = true
 		default:
 			invalidMasks = append(invalidMasks, string(mask))
@@ -64,10 +68,23 @@ func validateSavedSearchUpdate(input *backend.UpdateSavedSearchJSONRequestBody)
 		validateSavedSearchName(input.Name, fieldErrors)
 	}
 
+	// The parameters describe the variables of the query, so they can only change along with the query.
+	if activeMasks[backend.SavedSearchUpdateRequestMaskParameters] &&
+		!activeMasks[backend.SavedSearchUpdateRequestMaskQuery] {
+		fieldErrors.addFieldError("update_mask", errors.New("parameters can only be updated along with query"))
+
+		return fieldErrors
+	}
+
 	// Validate Query only if it's in the update mask
 	if activeMasks[backend.SavedSearchUpdateRequestMaskQuery] {
 		// Original logic also checked for nil before length/parsing.
-		validateSavedSearchQuery(input.Query, fieldErrors)
+		validateSavedSearchQuery(input.Query, updatedSavedSearchParameters(input), fieldErrors)
+	}
+
+	// Validate Parameters only if it's in the update mask
+	if activeMasks[backend.SavedSearchUpdateRequestMaskParameters] {
+		validateSavedSearchParameters(input.Parameters, fieldErrors)
 	}
 
 	// Validate Description only if it's in the update mask
@@ -75,6 +92,11 @@ func validateSavedSearchUpdate(input *backend.UpdateSavedSearchJSONRequestBody)
 		validateSavedSearchDescription(input.Description, fieldErrors)
 	}
 
+	// Validate Tags only if it's in the update mask
+	if activeMasks[backend.SavedSearchUpdateRequestMaskTags] {
+		validateSavedSearchTags(input.Tags, fieldErrors)
+	}
+
 	if fieldErrors.hasErrors() {
 		return fieldErrors
 	}
@@ -82,6 +104,16 @@ func validateSavedSearchUpdate(input *backend.UpdateSavedSearchJSONRequestBody)
 	return nil
 }
 
+// updatedSavedSearchParameters returns the parameters the updated query is checked with.
+// A query updated without its parameters cannot use variables.
+func updatedSavedSearchParameters(input *backend.UpdateSavedSearchJSONRequestBody) *backend.SavedSearchQueryParameters {
+	if !slices.Contains(input.UpdateMask, backend.SavedSearchUpdateRequestMaskParameters) {
+		return nil
+	}
+
+	return input.Parameters
+}
+
 // UpdateSavedSearch implements backend.StrictServerInterface.
 // nolint: ireturn // Name generated from openapi
 func (s *Server) UpdateSavedSearch(
@@ -109,7 +141,8 @@ func (s *Server) UpdateSavedSearch(
 	}
 
 	if request.Body.Query != nil {
-		err := s.wptMetricsStorer.ValidateQueryReferences(ctx, *request.Body.Query, &request.SearchId)
+		err := s.wptMetricsStorer.ValidateQueryReferences(
+			ctx, *request.Body.Query, updatedSavedSearchParameters(request.Body), &request.SearchId)
 		if err != nil {
 			if safeErr := sanitizeValidationError(err); safeErr != nil {
 				// nolint:nilerr // WONTFIX - false positive when returning structured 400 response instead of Go error.
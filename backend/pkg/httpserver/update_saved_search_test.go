@@ -31,6 +31,7 @@ func TestUpdateSavedSearch(t *testing.T) {
 	testUser := &auth.User{
 		ID:           "testID1",
 		GitHubUserID: nil,
+		TokenScope:   nil,
 	}
 	// Common Request Bodies and Mock Settings
 	updateAllFieldsRequestBody := `{
@@ -48,6 +49,9 @@ func TestUpdateSavedSearch(t *testing.T) {
 			backend.SavedSearchUpdateRequestMaskQuery,
 			backend.SavedSearchUpdateRequestMaskDescription,
 		},
+		Tags:       nil,
+		Listed:     nil,
+		Parameters: nil,
 	}
 	updateAllFieldsClearDescriptionExpectedRequest := &backend.SavedSearchUpdateRequest{
 		Name:        new("test name"),
@@ -58,6 +62,9 @@ func TestUpdateSavedSearch(t *testing.T) {
 			backend.SavedSearchUpdateRequestMaskQuery,
 			backend.SavedSearchUpdateRequestMaskDescription,
 		},
+		Tags:       nil,
+		Listed:     nil,
+		Parameters: nil,
 	}
 	testCases := []struct {
 		name                 string
@@ -154,6 +161,63 @@ func TestUpdateSavedSearch(t *testing.T) {
 					"message":"input validation errors"
 				}`),
 		},
+		{
+			name:                 "invalid tags",
+			cfg:                  nil,
+			publishCfg:           nil,
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodPatch,
+				"/v1/saved-searches/saved-search-id",
+				strings.NewReader(`{"tags": ["css", "CSS Grid"], "update_mask": ["tags", "listed"]}`),
+			),
+			expectedResponse: testJSONResponse(400,
+				`{
+					"code":400,
+					"errors":{
+						"tags":"tags must be unique, at most 32 characters long and only contain lowercase letters, digits and dashes"
+					},
+					"message":"input validation errors"
+				}`),
+		},
+		{
+			name:                 "parameters without query",
+			cfg:                  nil,
+			publishCfg:           nil,
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodPatch,
+				"/v1/saved-searches/saved-search-id",
+				strings.NewReader(`{"parameters": [], "update_mask": ["parameters"]}`),
+			),
+			expectedResponse: testJSONResponse(400,
+				`{
+					"code":400,
+					"errors":{
+						"update_mask":"parameters can only be updated along with query"
+					},
+					"message":"input validation errors"
+				}`),
+		},
+		{
+			name:                 "query variable not declared in parameters",
+			cfg:                  nil,
+			publishCfg:           nil,
+			authMiddlewareOption: withAuthMiddleware(mockAuthMiddleware(testUser)),
+			request: httptest.NewRequestWithContext(t.Context(),
+				http.MethodPatch,
+				"/v1/saved-searches/saved-search-id",
+				strings.NewReader(`{"query": "baseline_status:$status", "update_mask": ["query"]}`),
+			),
+			expectedResponse: testJSONResponse(400,
+				`{
+					"code":400,
+					"errors":{
+						"query":"query variable is not bound: $status"
+					},
+					"message":"input validation errors"
+				}`),
+		},
 		{
 			name:                 "query has bad syntax",
 			cfg:                  nil,
@@ -219,6 +283,9 @@ func TestUpdateSavedSearch(t *testing.T) {
 						UpdateMask: []backend.SavedSearchUpdateRequestUpdateMask{
 							backend.SavedSearchUpdateRequestMaskQuery,
 						},
+						Tags:       nil,
+						Listed:     nil,
+						Parameters: nil,
 					},
 					output: &backend.SavedSearchResponse{
 						Id:             "saved-search-id",
@@ -229,6 +296,10 @@ func TestUpdateSavedSearch(t *testing.T) {
 						BookmarkStatus: nil,
 						CreatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
 						UpdatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						ForkedFrom:     nil,
+						Tags:           nil,
+						Listed:         nil,
+						Parameters:     nil,
 					},
 					err: nil,
 				}
@@ -250,6 +321,10 @@ func TestUpdateSavedSearch(t *testing.T) {
 						BookmarkStatus: nil,
 						CreatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
 						UpdatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						ForkedFrom:     nil,
+						Tags:           nil,
+						Listed:         nil,
+						Parameters:     nil,
 					},
 					expectedUserID:     "testID1",
 					expectedIsCreation: false,
@@ -377,8 +452,12 @@ func TestUpdateSavedSearch(t *testing.T) {
 					BookmarkStatus: &backend.UserSavedSearchBookmark{
 						Status: backend.BookmarkActive,
 					},
-					CreatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					CreatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					ForkedFrom: nil,
+					Tags:       nil,
+					Listed:     nil,
+					Parameters: nil,
 				},
 				err: nil,
 			},
@@ -394,8 +473,12 @@ func TestUpdateSavedSearch(t *testing.T) {
 					BookmarkStatus: &backend.UserSavedSearchBookmark{
 						Status: backend.BookmarkActive,
 					},
-					CreatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					CreatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					ForkedFrom: nil,
+					Tags:       nil,
+					Listed:     nil,
+					Parameters: nil,
 				},
 				expectedIsCreation: false,
 				expectedUserID:     "testID1",
@@ -442,8 +525,12 @@ func TestUpdateSavedSearch(t *testing.T) {
 					BookmarkStatus: &backend.UserSavedSearchBookmark{
 						Status: backend.BookmarkActive,
 					},
-					CreatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					CreatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					ForkedFrom: nil,
+					Tags:       nil,
+					Listed:     nil,
+					Parameters: nil,
 				},
 				err: nil,
 			},
@@ -459,8 +546,12 @@ func TestUpdateSavedSearch(t *testing.T) {
 					BookmarkStatus: &backend.UserSavedSearchBookmark{
 						Status: backend.BookmarkActive,
 					},
-					CreatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					CreatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					ForkedFrom: nil,
+					Tags:       nil,
+					Listed:     nil,
+					Parameters: nil,
 				},
 				expectedIsCreation: false,
 				expectedUserID:     "testID1",
@@ -513,8 +604,12 @@ func TestUpdateSavedSearch(t *testing.T) {
 					BookmarkStatus: &backend.UserSavedSearchBookmark{
 						Status: backend.BookmarkActive,
 					},
-					CreatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					CreatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					ForkedFrom: nil,
+					Tags:       nil,
+					Listed:     nil,
+					Parameters: nil,
 				},
 				err: nil,
 			},
@@ -530,8 +625,12 @@ func TestUpdateSavedSearch(t *testing.T) {
 					BookmarkStatus: &backend.UserSavedSearchBookmark{
 						Status: backend.BookmarkActive,
 					},
-					CreatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-					UpdatedAt: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					CreatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					UpdatedAt:  time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+					ForkedFrom: nil,
+					Tags:       nil,
+					Listed:     nil,
+					Parameters: nil,
 				},
 				expectedUserID:     "testID1",
 				expectedIsCreation: false,
@@ -33,6 +33,7 @@ func TestUpdateSubscription(t *testing.T) {
 	testUser := &auth.User{
 		ID:           "test-user",
 		GitHubUserID: nil,
+		TokenScope:   nil,
 	}
 
 	testCases := []struct {
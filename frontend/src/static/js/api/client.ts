@@ -54,7 +54,10 @@ type PageablePath =
   | '/v1/stats/features/browsers/{browser}/feature_counts'
   | '/v1/users/me/saved-searches'
   | '/v1/global-saved-searches'
+  | '/v1/saved-searches'
   | '/v1/users/me/subscriptions'
+  | '/v1/users/me/watched-features'
+  | '/v1/saved-searches/{search_id}/revisions'
   | '/v1/stats/baseline_status/low_date_feature_counts'
   | '/v1/stats/features/browsers/{browser}/missing_one_implementation_counts'
   | '/v1/stats/features/browsers/{browser}/missing_one_implementation_counts/{date}/features';
@@ -654,6 +657,54 @@ export class APIClient {
     );
   }
 
+  public getNotificationChannelDeliverySettings(
+    token: string,
+    channelId: string,
+  ): Promise<components['schemas']['NotificationChannelDeliverySettings']> {
+    return this.handleResponse(
+      this.client.GET(
+        '/v1/users/me/notification-channels/{channel_id}/delivery-settings',
+        {
+          headers: {
+            Authorization: `Bearer ${token}`,
+          },
+          params: {
+            path: {
+              channel_id: channelId,
+            },
+          },
+        },
+      ),
+      '/v1/users/me/notification-channels/{channel_id}/delivery-settings',
+      'get',
+    );
+  }
+
+  public putNotificationChannelDeliverySettings(
+    token: string,
+    channelId: string,
+    settings: components['schemas']['NotificationChannelDeliverySettings'],
+  ): Promise<components['schemas']['NotificationChannelDeliverySettings']> {
+    return this.handleResponse(
+      this.client.PUT(
+        '/v1/users/me/notification-channels/{channel_id}/delivery-settings',
+        {
+          headers: {
+            Authorization: `Bearer ${token}`,
+          },
+          params: {
+            path: {
+              channel_id: channelId,
+            },
+          },
+          body: settings,
+        },
+      ),
+      '/v1/users/me/notification-channels/{channel_id}/delivery-settings',
+      'put',
+    );
+  }
+
   public async pingUser(
     token: string,
     pingOptions?: {githubToken?: string},
@@ -849,6 +900,51 @@ export class APIClient {
     );
   }
 
+  public exportUserData(
+    token: string,
+  ): Promise<components['schemas']['UserDataExport']> {
+    return this.handleResponse(
+      this.client.GET('/v1/users/me:export', {
+        headers: {
+          Authorization: `Bearer ${token}`,
+        },
+      }),
+      '/v1/users/me:export',
+      'get',
+    );
+  }
+
+  public importUserData(
+    token: string,
+    data: components['schemas']['UserDataExport'],
+  ): Promise<components['schemas']['UserDataImportResult']> {
+    return this.handleResponse(
+      this.client.POST('/v1/users/me:import', {
+        headers: {
+          Authorization: `Bearer ${token}`,
+        },
+        body: data,
+      }),
+      '/v1/users/me:import',
+      'post',
+    );
+  }
+
+  public async listSavedSearchDirectory(
+    query?: string,
+    tag?: string,
+    sort?: 'popular' | 'recent',
+    pageToken?: string,
+    pageSize?: number,
+  ): Promise<SuccessResponsePageableData<'/v1/saved-searches'>> {
+    return this.getPageOfData(
+      '/v1/saved-searches',
+      {params: {query: {q: query, tag, sort}}},
+      pageToken,
+      pageSize,
+    );
+  }
+
   public putUserSavedSearchBookmark(
     searchID: string,
     token: string,
@@ -1024,4 +1120,332 @@ export class APIClient {
       'patch',
     );
   }
+
+  public watchFeature(
+    featureId: string,
+    token: string,
+    req: components['schemas']['WatchFeatureRequest'],
+  ): Promise<components['schemas']['WatchedFeature']> {
+    return this.handleResponse(
+      this.client.PUT('/v1/users/me/watched-features/{feature_id}', {
+        headers: {
+          Authorization: `Bearer ${token}`,
+        },
+        params: {path: {feature_id: featureId}},
+        body: req,
+      }),
+      '/v1/users/me/watched-features/{feature_id}',
+      'put',
+    );
+  }
+
+  public unwatchFeature(
+    featureId: string,
+    token: string,
+    channelId?: string,
+  ): Promise<void> {
+    return this.handleResponse(
+      this.client.DELETE('/v1/users/me/watched-features/{feature_id}', {
+        params: {
+          path: {feature_id: featureId},
+          query: {channel_id: channelId},
+        },
+        headers: {Authorization: `Bearer ${token}`},
+      }),
+      '/v1/users/me/watched-features/{feature_id}',
+      'delete',
+    );
+  }
+
+  public async listWatchedFeatures(
+    token: string,
+  ): Promise<components['schemas']['WatchedFeature'][]> {
+    return this.getAllPagesOfData('/v1/users/me/watched-features', {
+      headers: {
+        Authorization: `Bearer ${token}`,
+      },
+    });
+  }
+
+  public createHotlist(
+    token: string,
+    req: components['schemas']['CreateHotlistRequest'],
+  ): Promise<components['schemas']['Hotlist']> {
+    return this.handleResponse(
+      this.client.POST('/v1/users/me/hotlists', {
+        headers: {Authorization: `Bearer ${token}`},
+        body: req,
+      }),
+      '/v1/users/me/hotlists',
+      'post',
+    );
+  }
+
+  public getHotlist(
+    searchID: string,
+    token?: string,
+  ): Promise<components['schemas']['Hotlist']> {
+    return this.handleResponse(
+      this.client.GET('/v1/saved-searches/{search_id}/hotlist', {
+        params: {path: {search_id: searchID}},
+        headers: token ? {Authorization: `Bearer ${token}`} : undefined,
+      }),
+      '/v1/saved-searches/{search_id}/hotlist',
+      'get',
+    );
+  }
+
+  public reorderHotlist(
+    searchID: string,
+    token: string,
+    req: components['schemas']['ReorderHotlistRequest'],
+  ): Promise<components['schemas']['Hotlist']> {
+    return this.handleResponse(
+      this.client.PUT('/v1/saved-searches/{search_id}/hotlist/order', {
+        params: {path: {search_id: searchID}},
+        headers: {Authorization: `Bearer ${token}`},
+        body: req,
+      }),
+      '/v1/saved-searches/{search_id}/hotlist/order',
+      'put',
+    );
+  }
+
+  public putHotlistFeature(
+    searchID: string,
+    featureId: string,
+    token: string,
+    req: components['schemas']['PutHotlistFeatureRequest'],
+  ): Promise<components['schemas']['Hotlist']> {
+    return this.handleResponse(
+      this.client.PUT(
+        '/v1/saved-searches/{search_id}/hotlist/features/{feature_id}',
+        {
+          params: {path: {search_id: searchID, feature_id: featureId}},
+          headers: {Authorization: `Bearer ${token}`},
+          body: req,
+        },
+      ),
+      '/v1/saved-searches/{search_id}/hotlist/features/{feature_id}',
+      'put',
+    );
+  }
+
+  public removeHotlistFeature(
+    searchID: string,
+    featureId: string,
+    token: string,
+    version: number,
+  ): Promise<components['schemas']['Hotlist']> {
+    return this.handleResponse(
+      this.client.DELETE(
+        '/v1/saved-searches/{search_id}/hotlist/features/{feature_id}',
+        {
+          params: {
+            path: {search_id: searchID, feature_id: featureId},
+            query: {version},
+          },
+          headers: {Authorization: `Bearer ${token}`},
+        },
+      ),
+      '/v1/saved-searches/{search_id}/hotlist/features/{feature_id}',
+      'delete',
+    );
+  }
+
+  public listSavedSearchCollaborators(
+    searchID: string,
+    token: string,
+  ): Promise<components['schemas']['SavedSearchCollaboratorList']> {
+    return this.handleResponse(
+      this.client.GET('/v1/saved-searches/{search_id}/collaborators', {
+        params: {path: {search_id: searchID}},
+        headers: {Authorization: `Bearer ${token}`},
+      }),
+      '/v1/saved-searches/{search_id}/collaborators',
+      'get',
+    );
+  }
+
+  public putSavedSearchCollaborator(
+    searchID: string,
+    githubUsername: string,
+    token: string,
+    req: components['schemas']['PutSavedSearchCollaboratorRequest'],
+  ): Promise<components['schemas']['SavedSearchCollaborator']> {
+    return this.handleResponse(
+      this.client.PUT(
+        '/v1/saved-searches/{search_id}/collaborators/{github_username}',
+        {
+          params: {
+            path: {search_id: searchID, github_username: githubUsername},
+          },
+          headers: {Authorization: `Bearer ${token}`},
+          body: req,
+        },
+      ),
+      '/v1/saved-searches/{search_id}/collaborators/{github_username}',
+      'put',
+    );
+  }
+
+  public removeSavedSearchCollaborator(
+    searchID: string,
+    githubUsername: string,
+    token: string,
+  ): Promise<void> {
+    return this.handleResponse(
+      this.client.DELETE(
+        '/v1/saved-searches/{search_id}/collaborators/{github_username}',
+        {
+          params: {
+            path: {search_id: searchID, github_username: githubUsername},
+          },
+          headers: {Authorization: `Bearer ${token}`},
+        },
+      ),
+      '/v1/saved-searches/{search_id}/collaborators/{github_username}',
+      'delete',
+    );
+  }
+
+  public transferSavedSearchOwnership(
+    searchID: string,
+    token: string,
+    req: components['schemas']['TransferSavedSearchOwnershipRequest'],
+  ): Promise<void> {
+    return this.handleResponse(
+      this.client.PUT('/v1/saved-searches/{search_id}/owner', {
+        params: {path: {search_id: searchID}},
+        headers: {Authorization: `Bearer ${token}`},
+        body: req,
+      }),
+      '/v1/saved-searches/{search_id}/owner',
+      'put',
+    );
+  }
+
+  public async listSavedSearchRevisions(
+    searchID: string,
+    token: string,
+  ): Promise<components['schemas']['SavedSearchRevision'][]> {
+    return this.getAllPagesOfData('/v1/saved-searches/{search_id}/revisions', {
+      params: {path: {search_id: searchID}},
+      headers: {Authorization: `Bearer ${token}`},
+    });
+  }
+
+  public getSavedSearchRevisionDiff(
+    searchID: string,
+    revisionNumber: number,
+    token: string,
+    baseRevision?: number,
+  ): Promise<components['schemas']['SavedSearchRevisionDiff']> {
+    return this.handleResponse(
+      this.client.GET(
+        '/v1/saved-searches/{search_id}/revisions/{revision_number}/diff',
+        {
+          params: {
+            path: {search_id: searchID, revision_number: revisionNumber},
+            query: {base_revision: baseRevision},
+          },
+          headers: {Authorization: `Bearer ${token}`},
+        },
+      ),
+      '/v1/saved-searches/{search_id}/revisions/{revision_number}/diff',
+      'get',
+    );
+  }
+
+  public revertSavedSearch(
+    searchID: string,
+    revisionNumber: number,
+    token: string,
+  ): Promise<components['schemas']['SavedSearchResponse']> {
+    return this.handleResponse(
+      this.client.POST(
+        '/v1/saved-searches/{search_id}/revisions/{revision_number}/revert',
+        {
+          params: {
+            path: {search_id: searchID, revision_number: revisionNumber},
+          },
+          headers: {Authorization: `Bearer ${token}`},
+        },
+      ),
+      '/v1/saved-searches/{search_id}/revisions/{revision_number}/revert',
+      'post',
+    );
+  }
+
+  public async listSavedSearchSnapshots(
+    searchID: string,
+    token: string,
+    snapshotType?: components['schemas']['SubscriptionFrequency'],
+  ): Promise<components['schemas']['SavedSearchSnapshotMetadata'][]> {
+    return this.getAllPagesOfData('/v1/saved-searches/{search_id}/snapshots', {
+      params: {
+        path: {search_id: searchID},
+        query: {snapshot_type: snapshotType},
+      },
+      headers: {Authorization: `Bearer ${token}`},
+    });
+  }
+
+  public getSavedSearchSnapshot(
+    searchID: string,
+    snapshotID: string,
+    token: string,
+    snapshotType?: components['schemas']['SubscriptionFrequency'],
+  ): Promise<components['schemas']['SavedSearchSnapshot']> {
+    return this.handleResponse(
+      this.client.GET('/v1/saved-searches/{search_id}/snapshots/{snapshot_id}', {
+        params: {
+          path: {search_id: searchID, snapshot_id: snapshotID},
+          query: {snapshot_type: snapshotType},
+        },
+        headers: {Authorization: `Bearer ${token}`},
+      }),
+      '/v1/saved-searches/{search_id}/snapshots/{snapshot_id}',
+      'get',
+    );
+  }
+
+  public getSavedSearchSnapshotDiff(
+    searchID: string,
+    snapshotID: string,
+    baseSnapshotID: string,
+    token: string,
+    snapshotType?: components['schemas']['SubscriptionFrequency'],
+  ): Promise<components['schemas']['SavedSearchSnapshotDiff']> {
+    return this.handleResponse(
+      this.client.GET(
+        '/v1/saved-searches/{search_id}/snapshots/{snapshot_id}/diff',
+        {
+          params: {
+            path: {search_id: searchID, snapshot_id: snapshotID},
+            query: {base_snapshot: baseSnapshotID, snapshot_type: snapshotType},
+          },
+          headers: {Authorization: `Bearer ${token}`},
+        },
+      ),
+      '/v1/saved-searches/{search_id}/snapshots/{snapshot_id}/diff',
+      'get',
+    );
+  }
+
+  public forkSavedSearch(
+    searchID: string,
+    token: string,
+    request?: components['schemas']['ForkSavedSearchRequest'],
+  ): Promise<components['schemas']['SavedSearchResponse']> {
+    return this.handleResponse(
+      this.client.POST('/v1/saved-searches/{search_id}:fork', {
+        params: {path: {search_id: searchID}},
+        headers: {Authorization: `Bearer ${token}`},
+        body: request ?? {},
+      }),
+      '/v1/saved-searches/{search_id}:fork',
+      'post',
+    );
+  }
 }
@@ -20,6 +20,7 @@ import {openShareDialog} from './webstatus-saved-search-share-dialog.js';
 import {APIClient} from '../contexts/api-client-context.js';
 import {UserContext} from '../contexts/firebase-user-context.js';
 import {
+  BookmarkEditorRole,
   BookmarkOwnerRole,
   BookmarkStatusActive,
   OpenSavedSearchEvent,
@@ -212,6 +213,8 @@ export class WebstatusSavedSearchControls extends LitElement {
     savedSearch: UserSavedSearch,
   ): TemplateResult {
     const isOwner = savedSearch.permissions?.role === BookmarkOwnerRole;
+    const canEdit =
+      isOwner || savedSearch.permissions?.role === BookmarkEditorRole;
     return html`
       <sl-icon-button
         name="share"
@@ -223,7 +226,7 @@ export class WebstatusSavedSearchControls extends LitElement {
       ></sl-icon-button>
       ${this.renderBookmarkControl(savedSearch, isOwner)}
       ${
-        isOwner
+        canEdit
           ? html`
               <sl-tooltip content="Edit current saved search">
                 <sl-icon-button
@@ -237,6 +240,12 @@ export class WebstatusSavedSearchControls extends LitElement {
                     )}
                 ></sl-icon-button>
               </sl-tooltip>
+            `
+          : nothing
+      }
+      ${
+        isOwner
+          ? html`
               <sl-tooltip content="Delete saved search">
                 <sl-icon-button
                   name="trash"
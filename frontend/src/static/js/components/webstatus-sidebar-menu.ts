@@ -35,6 +35,7 @@ import {
 import {
   GITHUB_REPO_ISSUE_LINK,
   ABOUT_PAGE_LINK,
+  BookmarkEditorRole,
   BookmarkOwnerRole,
   GlobalSavedSearch,
   UserSavedSearch,
@@ -297,7 +298,10 @@ export class WebstatusSidebarMenu extends LitElement {
       start: 0,
       q: `saved:${savedSearch.id}`,
     });
-    if (savedSearch.permissions?.role === BookmarkOwnerRole) {
+    if (
+      savedSearch.permissions?.role === BookmarkOwnerRole ||
+      savedSearch.permissions?.role === BookmarkEditorRole
+    ) {
       savedSearchEditUrl = formatOverviewPageUrl(currentURL, {
         start: 0,
         edit_saved_search: true,
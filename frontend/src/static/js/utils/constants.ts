@@ -26,6 +26,8 @@ export type BookmarkPermissions =
   components['schemas']['UserSavedSearchPermissions'];
 export const BookmarkOwnerRole: components['schemas']['UserSavedSearchPermissions']['role'] =
   'saved_search_owner';
+export const BookmarkEditorRole: components['schemas']['UserSavedSearchPermissions']['role'] =
+  'saved_search_editor';
 
 export type BookmarkStatus = components['schemas']['UserSavedSearchBookmark'];
 export const BookmarkStatusActive: components['schemas']['UserSavedSearchBookmark']['status'] =
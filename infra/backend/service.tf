@@ -62,7 +62,9 @@ resource "google_cloud_run_v2_service" "service" {
 
   template {
     scaling {
-      min_instance_count = var.min_instance_count
+      # The cache invalidation subscriber pulls messages in the background, so at least one
+      # instance has to stay up for the cached responses to be dropped when the data changes.
+      min_instance_count = max(1, var.min_instance_count)
       max_instance_count = var.max_instance_count
     }
     containers {
@@ -72,6 +74,11 @@ resource "google_cloud_run_v2_service" "service" {
         container_port = 8080
       }
       depends_on = ["otel"]
+      resources {
+        # Keep the CPU allocated outside of requests so the cache invalidation subscriber keeps
+        # receiving messages.
+        cpu_idle = false
+      }
       startup_probe {
         initial_delay_seconds = 0
         timeout_seconds       = 1
@@ -121,6 +128,11 @@ resource "google_cloud_run_v2_service" "service" {
         name  = "AGGREGATED_FEATURE_STATS_TTL"
         value = var.cache_settings.aggregated_feature_stats_duration
       }
+      env {
+        # The external load balancer appends "<client ip>, <load balancer ip>" to X-Forwarded-For.
+        name  = "RATE_LIMIT_TRUSTED_PROXIES"
+        value = "2"
+      }
       env {
         name  = "OTEL_EXPORTER_OTLP_ENDPOINT"
         value = var.otel_collector_endpoint
@@ -145,6 +157,14 @@ resource "google_cloud_run_v2_service" "service" {
         name  = "PUBSUB_PROJECT_ID"
         value = var.pubsub_project_id
       }
+      env {
+        name  = "CACHE_INVALIDATION_SUBSCRIPTION_ID"
+        value = google_pubsub_subscription.cache_invalidation[each.key].id
+      }
+      env {
+        name  = "STATE_BLOB_BUCKET"
+        value = var.state_bucket_name
+      }
     }
     containers {
       name  = "otel"
@@ -247,6 +267,31 @@ resource "google_pubsub_topic_iam_member" "pub" {
   provider = google.internal_project
 }
 
+# Every region has its own cache so each one needs its own subscription to the data updates.
+# Old events are not useful because the cached entries would have expired by then.
+resource "google_pubsub_subscription" "cache_invalidation" {
+  for_each                   = var.region_to_subnet_info_map
+  provider                   = google.internal_project
+  name                       = "${var.env_id}-${each.key}-backend-cache-invalidation-sub"
+  topic                      = var.data_updated_topic_id
+  message_retention_duration = "3600s"
+}
+
+resource "google_pubsub_subscription_iam_member" "cache_invalidation_sub" {
+  for_each     = google_pubsub_subscription.cache_invalidation
+  subscription = each.value.id
+  role         = "roles/pubsub.subscriber"
+  member       = "serviceAccount:${google_service_account.backend.email}"
+  provider     = google.internal_project
+}
+
+resource "google_storage_bucket_iam_member" "state_bucket_viewer" {
+  bucket   = var.state_bucket_name
+  role     = "roles/storage.objectViewer"
+  member   = "serviceAccount:${google_service_account.backend.email}"
+  provider = google.internal_project
+}
+
 resource "google_compute_region_network_endpoint_group" "neg" {
   provider = google.public_project
   for_each = google_cloud_run_v2_service.service
@@ -110,6 +110,9 @@ variable "backend_api_url" {
 
 variable "pubsub_project_id" { type = string }
 variable "ingestion_topic_id" { type = string }
+variable "data_updated_topic_id" { type = string }
+
+variable "state_bucket_name" { type = string }
 
 variable "otel_config_secret_id" {
   type        = string
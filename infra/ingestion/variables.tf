@@ -119,3 +119,8 @@ variable "otel_collector_endpoint" {
   type        = string
   description = "The endpoint for the application to export OTLP metrics/traces to the local collector"
 }
+
+variable "data_updated_topic_id" {
+  type        = string
+  description = "The Pub/Sub topic ID that workflows publish to after updating data"
+}
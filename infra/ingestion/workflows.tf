@@ -57,6 +57,14 @@ module "web_features_workflow" {
     {
       name  = "DATA_WINDOW_DURATION"
       value = "262980h" # 30 years in hours (365.25*30*24)
+    },
+    {
+      name  = "PUBSUB_PROJECT_ID"
+      value = var.projects.internal
+    },
+    {
+      name  = "DATA_UPDATED_TOPIC_ID"
+      value = var.data_updated_topic_id
     }
   ]
   otel_config_secret_id            = var.otel_config_secret_id
@@ -111,6 +119,14 @@ module "wpt_workflow" {
     {
       name  = "DATA_WINDOW_DURATION"
       value = "720h" # 30 days
+    },
+    {
+      name  = "PUBSUB_PROJECT_ID"
+      value = var.projects.internal
+    },
+    {
+      name  = "DATA_UPDATED_TOPIC_ID"
+      value = var.data_updated_topic_id
     }
   ]
   otel_config_secret_id            = var.otel_config_secret_id
@@ -324,3 +340,17 @@ module "web_features_mapping_workflow" {
   otel_collector_config_mount_path = var.otel_collector_config_mount_path
   otel_collector_endpoint          = var.otel_collector_endpoint
 }
+
+resource "google_pubsub_topic_iam_member" "web_features_data_updated_pub" {
+  topic    = var.data_updated_topic_id
+  role     = "roles/pubsub.publisher"
+  member   = module.web_features_workflow.job_service_account_member
+  provider = google.internal_project
+}
+
+resource "google_pubsub_topic_iam_member" "wpt_data_updated_pub" {
+  topic    = var.data_updated_topic_id
+  role     = "roles/pubsub.publisher"
+  member   = module.wpt_workflow.job_service_account_member
+  provider = google.internal_project
+}
@@ -97,6 +97,7 @@ module "ingestion" {
   developer_signals_region_schedules    = var.developer_signals_region_schedules
   web_features_mapping_region_schedules = var.web_features_mapping_region_schedules
   notification_channel_ids              = var.notification_channel_ids
+  data_updated_topic_id                 = module.pubsub.data_updated_topic_id
   otel_config_secret_id                 = google_secret_manager_secret.otel_config.id
   otel_project_id                       = var.projects.internal
   otel_collector_image                  = local.otel_collector_image
@@ -132,6 +133,8 @@ module "backend" {
   }
   pubsub_project_id                = var.projects.internal
   ingestion_topic_id               = module.pubsub.ingestion_topic_id
+  data_updated_topic_id            = module.pubsub.data_updated_topic_id
+  state_bucket_name                = module.storage.notification_state_bucket_name
   otel_config_secret_id            = google_secret_manager_secret.otel_config.id
   otel_collector_image             = local.otel_collector_image
   otel_collector_config_mount_path = local.otel_collector_config_mount_path
@@ -203,6 +206,9 @@ module "workers" {
     email_subscription_id        = module.pubsub.email_delivery_subscription_id
     webhook_topic_id             = module.pubsub.webhook_delivery_topic_id
     webhook_subscription_id      = module.pubsub.webhook_delivery_subscription_id
+    ingestion_dlq_topic_id       = module.pubsub.ingestion_dead_letter_topic_id
+    notification_dlq_topic_id    = module.pubsub.notification_dead_letter_topic_id
+    delivery_dlq_topic_id        = module.pubsub.delivery_dead_letter_topic_id
   }
 
   worker_instance_count = {
@@ -144,3 +144,14 @@ resource "google_pubsub_subscription" "webhook_delivery_sub" {
     max_delivery_attempts = 5
   }
 }
+
+# ==========================================
+# 4. Data Updates (Cache Invalidation)
+# ==========================================
+
+# Main Topic: Data Updated (Published by ingestion workflows)
+# Each backend region subscribes to it to clear its own cache.
+resource "google_pubsub_topic" "data_updated" {
+  name    = "data-updated-${var.env_id}"
+  project = var.project_id
+}
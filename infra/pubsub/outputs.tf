@@ -51,3 +51,19 @@ output "webhook_delivery_topic_id" {
 output "webhook_delivery_subscription_id" {
   value = google_pubsub_subscription.webhook_delivery_sub.id
 }
+
+output "ingestion_dead_letter_topic_id" {
+  value = google_pubsub_topic.ingestion_dlq.id
+}
+
+output "notification_dead_letter_topic_id" {
+  value = google_pubsub_topic.notification_dlq.id
+}
+
+output "delivery_dead_letter_topic_id" {
+  value = google_pubsub_topic.delivery_dlq.id
+}
+
+output "data_updated_topic_id" {
+  value = google_pubsub_topic.data_updated.id
+}
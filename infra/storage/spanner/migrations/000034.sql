@@ -0,0 +1,33 @@
+-- Copyright 2026 Google LLC
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- NotificationDeliveryKeys records the idempotency key of each delivery job.
+-- The key is derived from (EventID, SubscriptionID, ChannelID) so that Pub/Sub
+-- redeliveries and dispatcher retries of the same job resolve to the same row.
+-- Delivery workers claim the key before sending and mark it completed after a
+-- successful send. Jobs whose key is already completed are skipped.
+CREATE TABLE IF NOT EXISTS NotificationDeliveryKeys (
+    ChannelID STRING(36) NOT NULL,
+    -- Hex encoded SHA-256 of the (EventID, SubscriptionID, ChannelID) tuple.
+    DeliveryKey STRING(64) NOT NULL,
+    -- Valid values are 'PENDING' and 'COMPLETED'. See lib/gcpspanner/notification_delivery_keys.go for constants.
+    Status STRING(32) NOT NULL,
+    AttemptCount INT64 NOT NULL,
+    CreatedAt TIMESTAMP NOT NULL OPTIONS(allow_commit_timestamp = true),
+    UpdatedAt TIMESTAMP NOT NULL OPTIONS(allow_commit_timestamp = true),
+    CompletedAt TIMESTAMP,
+    CONSTRAINT FK_NotificationDeliveryKeys_NotificationChannel FOREIGN KEY (ChannelID) REFERENCES NotificationChannels (ID) ON DELETE CASCADE
+) PRIMARY KEY (ChannelID, DeliveryKey),
+-- Pub/Sub retains messages for at most 7 days, so older keys can never be redelivered.
+ROW DELETION POLICY (OLDER_THAN(CreatedAt, INTERVAL 30 DAY));
@@ -0,0 +1,57 @@
+-- Copyright 2026 Google LLC
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- NotificationChannelDeliverySettings stores the user configured delivery limits of a channel.
+-- Deliveries that fall within the quiet hours or exceed the hourly limit are held in
+-- NotificationChannelHeldDeliveries and coalesced into a single delivery when the window opens.
+CREATE TABLE IF NOT EXISTS NotificationChannelDeliverySettings (
+    ChannelID STRING(36) NOT NULL,
+    -- Local "HH:MM" times. If the end is before the start, the quiet hours span midnight.
+    QuietHoursStart STRING(5),
+    QuietHoursEnd STRING(5),
+    -- IANA time zone name used to evaluate the quiet hours.
+    QuietHoursTimeZone STRING(64),
+    MaxDeliveriesPerHour INT64,
+    CreatedAt TIMESTAMP NOT NULL OPTIONS(allow_commit_timestamp = true),
+    UpdatedAt TIMESTAMP NOT NULL OPTIONS(allow_commit_timestamp = true),
+    CONSTRAINT FK_NotificationChannelDeliverySettings_NotificationChannel FOREIGN KEY (ChannelID) REFERENCES NotificationChannels (ID) ON DELETE CASCADE
+) PRIMARY KEY (ChannelID);
+
+-- NotificationChannelDeliveryWindows counts the deliveries of a channel per clock hour.
+CREATE TABLE IF NOT EXISTS NotificationChannelDeliveryWindows (
+    ChannelID STRING(36) NOT NULL,
+    WindowStart TIMESTAMP NOT NULL,
+    DeliveryCount INT64 NOT NULL,
+    CONSTRAINT FK_NotificationChannelDeliveryWindows_NotificationChannel FOREIGN KEY (ChannelID) REFERENCES NotificationChannels (ID) ON DELETE CASCADE
+) PRIMARY KEY (ChannelID, WindowStart),
+-- Only the current window is ever read.
+ROW DELETION POLICY (OLDER_THAN(WindowStart, INTERVAL 1 DAY));
+
+-- NotificationChannelHeldDeliveries stores the delivery jobs that were held back by the delivery
+-- settings of their channel. There is at most one held job per subscription; newer jobs are
+-- coalesced into it.
+CREATE TABLE IF NOT EXISTS NotificationChannelHeldDeliveries (
+    ChannelID STRING(36) NOT NULL,
+    SubscriptionID STRING(36) NOT NULL,
+    Job JSON NOT NULL,
+    -- Number of delivery jobs coalesced into this one.
+    HeldCount INT64 NOT NULL,
+    ReleaseAt TIMESTAMP NOT NULL,
+    CreatedAt TIMESTAMP NOT NULL OPTIONS(allow_commit_timestamp = true),
+    UpdatedAt TIMESTAMP NOT NULL OPTIONS(allow_commit_timestamp = true),
+    CONSTRAINT FK_NotificationChannelHeldDeliveries_NotificationChannel FOREIGN KEY (ChannelID) REFERENCES NotificationChannels (ID) ON DELETE CASCADE,
+    CONSTRAINT FK_NotificationChannelHeldDeliveries_SavedSearchSubscription FOREIGN KEY (SubscriptionID) REFERENCES SavedSearchSubscriptions (ID) ON DELETE CASCADE
+) PRIMARY KEY (ChannelID, SubscriptionID);
+
+CREATE INDEX IF NOT EXISTS NotificationChannelHeldDeliveries_ReleaseAt ON NotificationChannelHeldDeliveries(ReleaseAt);
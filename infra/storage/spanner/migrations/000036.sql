@@ -0,0 +1,27 @@
+-- Copyright 2026 Google LLC
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- UserHotlists marks a user owned saved search as a hotlist.
+-- A hotlist has no filter query of its own. Its features and their order are curated by hand
+-- and stored in SavedSearchFeatureSortOrder, which previously only held the order of
+-- system global saved searches.
+CREATE TABLE IF NOT EXISTS UserHotlists (
+    SavedSearchID STRING(36) NOT NULL,
+    -- OrderVersion is incremented on every change to the features of the hotlist.
+    -- Clients send the version they last read to detect concurrent edits.
+    OrderVersion INT64 NOT NULL,
+    CreatedAt TIMESTAMP NOT NULL OPTIONS(allow_commit_timestamp = true),
+    UpdatedAt TIMESTAMP NOT NULL OPTIONS(allow_commit_timestamp = true),
+    CONSTRAINT FK_UserHotlists_SavedSearch FOREIGN KEY (SavedSearchID) REFERENCES SavedSearches (ID) ON DELETE CASCADE
+) PRIMARY KEY (SavedSearchID);
@@ -0,0 +1,28 @@
+-- Copyright 2026 Google LLC
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- UserGitHubProfiles keeps the GitHub identity of a user, as last synced by the ping endpoint.
+-- It is used to resolve GitHub usernames when sharing saved searches with other users.
+CREATE TABLE IF NOT EXISTS UserGitHubProfiles (
+    UserID STRING(MAX) NOT NULL,
+    GitHubUserID INT64 NOT NULL,
+    GitHubUsername STRING(MAX) NOT NULL,
+    -- GitHub usernames are case insensitive.
+    GitHubUsername_Lowercase STRING(MAX) AS (LOWER(GitHubUsername)) STORED,
+    UpdatedAt TIMESTAMP NOT NULL OPTIONS(allow_commit_timestamp = true)
+) PRIMARY KEY (UserID);
+
+-- A username may be recycled by GitHub after a rename, so the index is not unique.
+-- Lookups pick the most recently synced profile.
+CREATE INDEX IF NOT EXISTS UserGitHubProfiles_ByUsername ON UserGitHubProfiles(GitHubUsername_Lowercase);
@@ -0,0 +1,31 @@
+-- Copyright 2026 Google LLC
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- SavedSearchRevisions contains the historical revisions of user saved searches.
+-- A revision is written every time the name, query or description of a saved search changes.
+-- SavedSearches keeps containing the latest revision.
+CREATE TABLE IF NOT EXISTS SavedSearchRevisions (
+    SavedSearchID STRING(36) NOT NULL,
+    -- RevisionNumber starts at 1 and increments by one for every change.
+    RevisionNumber INT64 NOT NULL,
+    Name STRING(MAX) NOT NULL,
+    Description STRING(MAX),
+    Query STRING(MAX) NOT NULL,
+    -- AuthorID is the user who made the change.
+    AuthorID STRING(MAX) NOT NULL,
+    -- RevertedFromRevision is set when the revision was created by reverting to an earlier revision.
+    RevertedFromRevision INT64,
+    CreatedAt TIMESTAMP NOT NULL OPTIONS(allow_commit_timestamp = true),
+    CONSTRAINT FK_SavedSearchRevisions_SavedSearch FOREIGN KEY (SavedSearchID) REFERENCES SavedSearches (ID) ON DELETE CASCADE
+) PRIMARY KEY (SavedSearchID, RevisionNumber DESC);
@@ -0,0 +1,27 @@
+-- Copyright 2026 Google LLC
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- SavedSearchForks records where a forked saved search was copied from.
+-- The source is not a foreign key so that the provenance outlives the deletion of the source.
+CREATE TABLE IF NOT EXISTS SavedSearchForks (
+    SavedSearchID STRING(36) NOT NULL,
+    ForkedFromSavedSearchID STRING(36) NOT NULL,
+    -- ForkedFromRevisionNumber is the latest revision of the source at the time of the fork.
+    -- It is NULL if the source has no revisions, such as system saved searches.
+    ForkedFromRevisionNumber INT64,
+    CreatedAt TIMESTAMP NOT NULL OPTIONS(allow_commit_timestamp = true),
+    CONSTRAINT FK_SavedSearchForks_SavedSearch FOREIGN KEY (SavedSearchID) REFERENCES SavedSearches (ID) ON DELETE CASCADE
+) PRIMARY KEY (SavedSearchID);
+
+CREATE INDEX IF NOT EXISTS SavedSearchForks_ByForkedFrom ON SavedSearchForks(ForkedFromSavedSearchID);
@@ -0,0 +1,24 @@
+-- Copyright 2026 Google LLC
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+
+-- Add tags and the directory visibility flag to saved searches.
+-- Listed saved searches appear in the public saved search directory.
+ALTER TABLE SavedSearches ADD COLUMN Tags ARRAY<STRING(MAX)>;
+ALTER TABLE SavedSearches ADD COLUMN Listed BOOL NOT NULL DEFAULT (FALSE);
+-- Lowercase the name and description for basic searching of the directory.
+ALTER TABLE SavedSearches ADD COLUMN Name_Lowercase STRING(MAX) AS (LOWER(Name)) STORED;
+ALTER TABLE SavedSearches ADD COLUMN Description_Lowercase STRING(MAX) AS (LOWER(Description)) STORED;
+
+CREATE INDEX IF NOT EXISTS SavedSearches_ByListed ON SavedSearches(Listed, Scope);
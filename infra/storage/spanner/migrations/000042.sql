@@ -0,0 +1,31 @@
+-- Copyright 2026 Google LLC
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- PersonalAccessTokens stores the tokens that users create for programmatic access to the API.
+-- Only the SHA-256 hash of a token is stored. The token itself is shown once, when it is created.
+CREATE TABLE IF NOT EXISTS PersonalAccessTokens (
+    ID STRING(36) NOT NULL,
+    UserID STRING(MAX) NOT NULL,
+    Name STRING(MAX) NOT NULL,
+    -- Hex encoded SHA-256 hash of the token.
+    TokenHash STRING(64) NOT NULL,
+    -- read_only or manage_subscriptions.
+    Scope STRING(64) NOT NULL,
+    CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+    LastUsedAt TIMESTAMP OPTIONS (allow_commit_timestamp = true)
+) PRIMARY KEY (ID);
+
+CREATE UNIQUE INDEX PersonalAccessTokensByTokenHash ON PersonalAccessTokens(TokenHash);
+
+CREATE INDEX PersonalAccessTokensByUserID ON PersonalAccessTokens(UserID);
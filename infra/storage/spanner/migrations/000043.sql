@@ -0,0 +1,29 @@
+-- Copyright 2026 Google LLC
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- Held deliveries are leased while they are published and only removed once the publish succeeded.
+-- A delivery whose lease expired, e.g. because the worker crashed, is claimed again.
+ALTER TABLE NotificationChannelHeldDeliveries ADD COLUMN LeaseID STRING(36);
+ALTER TABLE NotificationChannelHeldDeliveries ADD COLUMN LeaseExpiresAt TIMESTAMP;
+
+-- NotificationChannelDeliveryReservations records the deliveries counted in
+-- NotificationChannelDeliveryWindows so that a redelivered event is not counted twice.
+CREATE TABLE IF NOT EXISTS NotificationChannelDeliveryReservations (
+    ChannelID STRING(36) NOT NULL,
+    WindowStart TIMESTAMP NOT NULL,
+    -- Idempotency key of the delivery job. See workertypes.NewDeliveryKey.
+    DeliveryKey STRING(64) NOT NULL,
+    CONSTRAINT FK_NotificationChannelDeliveryReservations_NotificationChannel FOREIGN KEY (ChannelID) REFERENCES NotificationChannels (ID) ON DELETE CASCADE
+) PRIMARY KEY (ChannelID, WindowStart, DeliveryKey),
+ROW DELETION POLICY (OLDER_THAN(WindowStart, INTERVAL 1 DAY));
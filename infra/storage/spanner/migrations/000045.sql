@@ -0,0 +1,25 @@
+-- Copyright 2026 Google LLC
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- FeatureTimelineChanges
+-- The renames and moves of features reported by the saved search notification events.
+-- The same change is reported by many events. Each change is stored once, with the earliest event timestamp.
+-- RENAME rows go from the old name to the new name. MOVE rows go from the old feature key to FeatureKey.
+CREATE TABLE IF NOT EXISTS FeatureTimelineChanges (
+    FeatureKey STRING(64) NOT NULL,
+    ChangeType STRING(16) NOT NULL,
+    FromValue STRING(MAX) NOT NULL,
+    ToValue STRING(MAX) NOT NULL,
+    Timestamp TIMESTAMP NOT NULL,
+) PRIMARY KEY (FeatureKey, ChangeType, FromValue, ToValue);
@@ -0,0 +1,39 @@
+-- Copyright 2026 Google LLC
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- Backfill FeatureTimelineChanges from the summaries of the existing notification events.
+-- The summaries are stored under the summary field of the event.
+INSERT INTO FeatureTimelineChanges (FeatureKey, ChangeType, FromValue, ToValue, Timestamp)
+SELECT FeatureKey, ChangeType, FromValue, ToValue, MIN(Timestamp)
+FROM (
+    SELECT
+        JSON_VALUE(h, '$.feature_id') AS FeatureKey,
+        'RENAME' AS ChangeType,
+        COALESCE(JSON_VALUE(h, '$.name_change.from'), '') AS FromValue,
+        JSON_VALUE(h, '$.name_change.to') AS ToValue,
+        e.Timestamp
+    FROM SavedSearchNotificationEvents e, UNNEST(JSON_QUERY_ARRAY(e.Summary, '$.summary.highlights')) AS h
+    WHERE JSON_VALUE(h, '$.name_change.to') IS NOT NULL AND JSON_VALUE(h, '$.moved.from.id') IS NULL
+    UNION ALL
+    SELECT
+        JSON_VALUE(h, '$.feature_id') AS FeatureKey,
+        'MOVE' AS ChangeType,
+        JSON_VALUE(h, '$.moved.from.id') AS FromValue,
+        JSON_VALUE(h, '$.feature_id') AS ToValue,
+        e.Timestamp
+    FROM SavedSearchNotificationEvents e, UNNEST(JSON_QUERY_ARRAY(e.Summary, '$.summary.highlights')) AS h
+    WHERE JSON_VALUE(h, '$.moved.from.id') IS NOT NULL
+)
+WHERE FeatureKey IS NOT NULL
+GROUP BY FeatureKey, ChangeType, FromValue, ToValue;
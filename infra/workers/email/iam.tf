@@ -34,6 +34,13 @@ resource "google_pubsub_subscription_iam_member" "email_sub" {
   provider     = google.internal_project
 }
 
+resource "google_pubsub_topic_iam_member" "dead_letter_pub" {
+  topic    = var.dead_letter_topic_id
+  role     = "roles/pubsub.publisher"
+  member   = "serviceAccount:${data.google_service_account.worker_sa.email}"
+  provider = google.internal_project
+}
+
 resource "google_project_iam_member" "gcp_metric_permission" {
   role     = "roles/monitoring.metricWriter"
   provider = google.internal_project
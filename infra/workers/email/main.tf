@@ -47,6 +47,10 @@ resource "google_cloud_run_v2_worker_pool" "worker" {
         name  = "EMAIL_SUBSCRIPTION_ID"
         value = var.email_subscription_id
       }
+      env {
+        name  = "DEAD_LETTER_TOPIC_ID"
+        value = var.dead_letter_topic_id
+      }
       env {
         name  = "FRONTEND_BASE_URL"
         value = var.frontend_base_url
@@ -18,6 +18,7 @@ variable "image_url" { type = string }
 variable "spanner_instance_id" { type = string }
 variable "spanner_database_id" { type = string }
 variable "email_subscription_id" { type = string }
+variable "dead_letter_topic_id" { type = string }
 variable "manual_instance_count" { type = number }
 variable "service_account_email" { type = string }
 variable "regions" { type = set(string) }
@@ -66,6 +66,10 @@ resource "google_cloud_run_v2_worker_pool" "worker" {
         name  = "BATCH_UPDATE_SUBSCRIPTION_ID"
         value = var.batch_subscription_id
       }
+      env {
+        name  = "DEAD_LETTER_TOPIC_ID"
+        value = var.dead_letter_topic_id
+      }
       env {
         name  = "NOTIFICATION_TOPIC_ID"
         value = var.notification_topic_id
@@ -21,6 +21,7 @@ variable "state_bucket_name" { type = string }
 variable "ingestion_subscription_id" { type = string }
 variable "ingestion_topic_id" { type = string }
 variable "batch_subscription_id" { type = string }
+variable "dead_letter_topic_id" { type = string }
 variable "notification_topic_id" { type = string }
 variable "manual_instance_count" { type = number }
 variable "regions" { type = set(string) }
@@ -41,6 +41,7 @@ module "event_producer" {
   ingestion_subscription_id = var.pubsub_details.ingestion_subscription_id
   ingestion_topic_id        = var.pubsub_details.ingestion_topic_id
   batch_subscription_id     = var.pubsub_details.batch_subscription_id
+  dead_letter_topic_id      = var.pubsub_details.ingestion_dlq_topic_id
   notification_topic_id     = var.pubsub_details.notification_topic_id
 
   manual_instance_count = var.worker_instance_count.event_producer_count
@@ -80,6 +81,7 @@ module "push_delivery" {
   spanner_database_id = var.spanner_details.database
 
   notification_subscription_id = var.pubsub_details.notification_subscription_id
+  dead_letter_topic_id         = var.pubsub_details.notification_dlq_topic_id
   email_topic_id               = var.pubsub_details.email_topic_id
   webhook_topic_id             = var.pubsub_details.webhook_topic_id
 
@@ -120,6 +122,7 @@ module "email" {
   spanner_database_id = var.spanner_details.database
 
   email_subscription_id = var.pubsub_details.email_subscription_id
+  dead_letter_topic_id  = var.pubsub_details.delivery_dlq_topic_id
 
   manual_instance_count = var.worker_instance_count.email_count
 
@@ -165,6 +168,7 @@ module "webhook" {
   spanner_database_id = var.spanner_details.database
 
   webhook_subscription_id = var.pubsub_details.webhook_subscription_id
+  dead_letter_topic_id    = var.pubsub_details.delivery_dlq_topic_id
 
   manual_instance_count = var.worker_instance_count.webhook_count
   regions               = var.regions
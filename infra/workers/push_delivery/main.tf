@@ -53,6 +53,10 @@ resource "google_cloud_run_v2_worker_pool" "worker" {
         name  = "NOTIFICATION_SUBSCRIPTION_ID"
         value = var.notification_subscription_id
       }
+      env {
+        name  = "DEAD_LETTER_TOPIC_ID"
+        value = var.dead_letter_topic_id
+      }
       env {
         name  = "EMAIL_TOPIC_ID"
         value = var.email_topic_id
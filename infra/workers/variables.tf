@@ -58,6 +58,9 @@ variable "pubsub_details" {
     email_subscription_id        = string
     webhook_topic_id             = string
     webhook_subscription_id      = string
+    ingestion_dlq_topic_id       = string
+    notification_dlq_topic_id    = string
+    delivery_dlq_topic_id        = string
   })
 }
 
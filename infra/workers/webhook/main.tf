@@ -53,6 +53,10 @@ resource "google_cloud_run_v2_worker_pool" "worker" {
         name  = "WEBHOOK_SUBSCRIPTION_ID"
         value = var.webhook_subscription_id
       }
+      env {
+        name  = "DEAD_LETTER_TOPIC_ID"
+        value = var.dead_letter_topic_id
+      }
       env {
         name  = "FRONTEND_BASE_URL"
         value = var.frontend_base_url
@@ -112,6 +116,13 @@ resource "google_pubsub_subscription_iam_member" "webhook_sub" {
   provider     = google.internal_project
 }
 
+resource "google_pubsub_topic_iam_member" "dead_letter_pub" {
+  topic    = var.dead_letter_topic_id
+  role     = "roles/pubsub.publisher"
+  member   = "serviceAccount:${google_service_account.worker_sa.email}"
+  provider = google.internal_project
+}
+
 resource "google_project_iam_member" "gcp_metric_permission" {
   role     = "roles/monitoring.metricWriter"
   provider = google.internal_project
@@ -82,5 +82,6 @@ func (a GCIPAuthenticator) Authenticate(ctx context.Context, idToken string) (*U
 	return &User{
 		ID:           token.UID,
 		GitHubUserID: gitHubUserID,
+		TokenScope:   nil,
 	}, nil
 }
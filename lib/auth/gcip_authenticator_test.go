@@ -52,7 +52,7 @@ func TestGCIPAuthenticator(t *testing.T) {
 			mockVerifyFn: func(_ context.Context, _ string) (*firebaseauth.Token, error) {
 				return createTestFirebaseToken("123", new("id2")), nil
 			},
-			expectedUser:  &User{ID: "123", GitHubUserID: new("id2")},
+			expectedUser:  &User{ID: "123", GitHubUserID: new("id2"), TokenScope: nil},
 			expectedError: false,
 		},
 		{
@@ -70,7 +70,7 @@ func TestGCIPAuthenticator(t *testing.T) {
 			mockVerifyFn: func(_ context.Context, _ string) (*firebaseauth.Token, error) {
 				return createTestFirebaseToken("123", nil), nil
 			},
-			expectedUser:  &User{ID: "123", GitHubUserID: nil},
+			expectedUser:  &User{ID: "123", GitHubUserID: nil, TokenScope: nil},
 			expectedError: false,
 		},
 	}
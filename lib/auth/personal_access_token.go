@@ -0,0 +1,91 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// PersonalAccessTokenPrefix is the prefix of every personal access token.
+// It allows bearer tokens to be routed to the right authenticator without trying each one.
+const PersonalAccessTokenPrefix = "wsdpat_"
+
+// personalAccessTokenBytes is the number of random bytes in a personal access token.
+const personalAccessTokenBytes = 32
+
+// ErrInvalidPersonalAccessToken indicates the token is malformed, unknown or revoked.
+var ErrInvalidPersonalAccessToken = errors.New("invalid personal access token")
+
+// GeneratePersonalAccessToken returns a new random personal access token and its hash.
+// Only the hash should be stored. The token is shown to the user once.
+func GeneratePersonalAccessToken() (token string, tokenHash string, err error) {
+	b := make([]byte, personalAccessTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = PersonalAccessTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+
+	return token, HashPersonalAccessToken(token), nil
+}
+
+// HashPersonalAccessToken returns the hex encoded SHA-256 hash of a personal access token.
+func HashPersonalAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// PersonalAccessTokenStore looks up the user that owns a personal access token.
+type PersonalAccessTokenStore interface {
+	// LookupPersonalAccessToken returns the owner of the token with the given hash, with the scope of the token.
+	// It returns ErrInvalidPersonalAccessToken if no token has the hash.
+	LookupPersonalAccessToken(ctx context.Context, tokenHash string) (*User, error)
+}
+
+// PersonalAccessTokenAuthenticator authenticates users using personal access tokens.
+type PersonalAccessTokenAuthenticator struct {
+	store PersonalAccessTokenStore
+}
+
+// NewPersonalAccessTokenAuthenticator creates a new PersonalAccessTokenAuthenticator instance.
+func NewPersonalAccessTokenAuthenticator(store PersonalAccessTokenStore) *PersonalAccessTokenAuthenticator {
+	return &PersonalAccessTokenAuthenticator{
+		store: store,
+	}
+}
+
+// Authenticate authenticates a user using the provided personal access token.
+func (a PersonalAccessTokenAuthenticator) Authenticate(ctx context.Context, token string) (*User, error) {
+	if !strings.HasPrefix(token, PersonalAccessTokenPrefix) {
+		return nil, ErrInvalidPersonalAccessToken
+	}
+
+	u, err := a.store.LookupPersonalAccessToken(ctx, HashPersonalAccessToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if u.TokenScope == nil {
+		// A personal access token always has a scope. Never treat it as an interactive session.
+		return nil, ErrInvalidPersonalAccessToken
+	}
+
+	return u, nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type mockPersonalAccessTokenStore struct {
+	lookupFn func(context.Context, string) (*User, error)
+}
+
+func (m *mockPersonalAccessTokenStore) LookupPersonalAccessToken(ctx context.Context, tokenHash string) (*User, error) {
+	return m.lookupFn(ctx, tokenHash)
+}
+
+func TestGeneratePersonalAccessToken(t *testing.T) {
+	token, tokenHash, err := GeneratePersonalAccessToken()
+	if err != nil {
+		t.Fatalf("GeneratePersonalAccessToken failed: %v", err)
+	}
+	if !strings.HasPrefix(token, PersonalAccessTokenPrefix) {
+		t.Errorf("expected token %q to have prefix %q", token, PersonalAccessTokenPrefix)
+	}
+	if tokenHash != HashPersonalAccessToken(token) {
+		t.Errorf("unexpected token hash %q", tokenHash)
+	}
+	other, _, err := GeneratePersonalAccessToken()
+	if err != nil {
+		t.Fatalf("GeneratePersonalAccessToken failed: %v", err)
+	}
+	if other == token {
+		t.Error("expected generated tokens to be different")
+	}
+}
+
+func TestPersonalAccessTokenAuthenticator(t *testing.T) {
+	token, tokenHash, err := GeneratePersonalAccessToken()
+	if err != nil {
+		t.Fatalf("GeneratePersonalAccessToken failed: %v", err)
+	}
+	scope := TokenScopeReadOnly
+	errTest := errors.New("test error")
+	tests := []struct {
+		name          string
+		token         string
+		lookupFn      func(context.Context, string) (*User, error)
+		expectedUser  *User
+		expectedError error
+	}{
+		{
+			name:  "Successful authentication",
+			token: token,
+			lookupFn: func(_ context.Context, h string) (*User, error) {
+				if h != tokenHash {
+					t.Errorf("unexpected hash %q", h)
+				}
+
+				return &User{ID: "user", GitHubUserID: nil, TokenScope: &scope}, nil
+			},
+			expectedUser:  &User{ID: "user", GitHubUserID: nil, TokenScope: &scope},
+			expectedError: nil,
+		},
+		{
+			name:  "Missing prefix",
+			token: "not-a-pat",
+			lookupFn: func(_ context.Context, _ string) (*User, error) {
+				t.Fatal("lookup should not have been called")
+
+				return nil, errTest
+			},
+			expectedUser:  nil,
+			expectedError: ErrInvalidPersonalAccessToken,
+		},
+		{
+			name:  "Lookup failure",
+			token: token,
+			lookupFn: func(_ context.Context, _ string) (*User, error) {
+				return nil, errTest
+			},
+			expectedUser:  nil,
+			expectedError: errTest,
+		},
+		{
+			name:  "Missing scope",
+			token: token,
+			lookupFn: func(_ context.Context, _ string) (*User, error) {
+				return &User{ID: "user", GitHubUserID: nil, TokenScope: nil}, nil
+			},
+			expectedUser:  nil,
+			expectedError: ErrInvalidPersonalAccessToken,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			authenticator := NewPersonalAccessTokenAuthenticator(&mockPersonalAccessTokenStore{lookupFn: tc.lookupFn})
+			user, err := authenticator.Authenticate(context.Background(), tc.token)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("expected error %v, got %v", tc.expectedError, err)
+			}
+			if !reflect.DeepEqual(tc.expectedUser, user) {
+				t.Errorf("expected user %+v, got %+v", tc.expectedUser, user)
+			}
+		})
+	}
+}
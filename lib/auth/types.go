@@ -26,8 +26,22 @@ type User struct {
 	// linked to GitHub, or if the ID hasn't been verified against the GitHub API yet.
 	// Verification is deferred until needed to keep most authenticated calls fast.
 	GitHubUserID *string
+	// TokenScope is the scope of the personal access token used to authenticate the user.
+	// It is nil for users authenticated with an interactive session, which have no restrictions.
+	TokenScope *TokenScope
 }
 
+// TokenScope limits the operations available to a user authenticated with a personal access token.
+type TokenScope string
+
+const (
+	// TokenScopeReadOnly only allows reading data.
+	TokenScopeReadOnly TokenScope = "read_only"
+	// TokenScopeManageSubscriptions allows reading data and creating, updating and deleting saved searches and
+	// subscriptions.
+	TokenScopeManageSubscriptions TokenScope = "manage_subscriptions"
+)
+
 // HasGitHubID checks if the authenticated user matches a specific GitHub integer ID.
 // It handles the necessary string-to-int64 conversion safely.
 func (u User) HasGitHubUserID(in int64) bool {
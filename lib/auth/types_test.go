@@ -28,6 +28,7 @@ func TestUser_HasGitHubUserID(t *testing.T) {
 			user: User{
 				ID:           "user1",
 				GitHubUserID: new("12345"),
+				TokenScope:   nil,
 			},
 			inputID:        12345,
 			expectedResult: true,
@@ -37,6 +38,7 @@ func TestUser_HasGitHubUserID(t *testing.T) {
 			user: User{
 				ID:           "user1",
 				GitHubUserID: new("12345"),
+				TokenScope:   nil,
 			},
 			inputID:        54321,
 			expectedResult: false,
@@ -46,6 +48,7 @@ func TestUser_HasGitHubUserID(t *testing.T) {
 			user: User{
 				ID:           "user1",
 				GitHubUserID: nil,
+				TokenScope:   nil,
 			},
 			inputID:        12345,
 			expectedResult: false,
@@ -55,6 +58,7 @@ func TestUser_HasGitHubUserID(t *testing.T) {
 			user: User{
 				ID:           "user1",
 				GitHubUserID: new("0"),
+				TokenScope:   nil,
 			},
 			inputID:        0,
 			expectedResult: true,
@@ -0,0 +1,40 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backendtypes
+
+import (
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+)
+
+// MaxSavedSearchQueryParameters is the maximum number of parameters a saved search can declare.
+const MaxSavedSearchQueryParameters = 10
+
+// SearchQueryParameters converts the declared parameters of a saved search into the parameters
+// used to bind the variables of its query.
+func SearchQueryParameters(params *backend.SavedSearchQueryParameters) []searchtypes.QueryParameter {
+	if params == nil {
+		return nil
+	}
+	ret := make([]searchtypes.QueryParameter, 0, len(*params))
+	for _, param := range *params {
+		ret = append(ret, searchtypes.QueryParameter{
+			Name:         param.Name,
+			DefaultValue: param.DefaultValue,
+		})
+	}
+
+	return ret
+}
@@ -0,0 +1,24 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backendtypes
+
+import "github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+
+// SavedSearchSnapshotRef points to a stored feature list snapshot of a saved search.
+type SavedSearchSnapshotRef struct {
+	ID           string
+	SnapshotType backend.SubscriptionFrequency
+	BlobPath     string
+}
@@ -44,6 +44,10 @@ var (
 	// number of allowed notification channels.
 	ErrUserMaxNotificationChannels = errors.New("user has reached the maximum number of allowed notification channels")
 
+	// ErrUserMaxPersonalAccessTokens indicates the user has reached the maximum
+	// number of allowed personal access tokens.
+	ErrUserMaxPersonalAccessTokens = errors.New("user has reached the maximum number of allowed personal access tokens")
+
 	// ErrUserNotAuthorizedForAction indicates the user is not authorized to execute the requested action.
 	ErrUserNotAuthorizedForAction = errors.New("user not authorized to execute action")
 
@@ -75,6 +79,25 @@ var (
 	// ErrHotlistNotFound indicates the hotlist was not found.
 	ErrHotlistNotFound = errors.New("hotlist not found")
 
+	// ErrHotlistVersionConflict indicates the hotlist was changed since the version the request is based on.
+	ErrHotlistVersionConflict = errors.New("hotlist was changed since the given version")
+
+	// ErrInvalidHotlistFeatures indicates the requested features of a hotlist are unknown, duplicated,
+	// too many or do not match the current features of the hotlist.
+	ErrInvalidHotlistFeatures = errors.New("invalid hotlist features")
+
+	// ErrInvalidCollaboratorChange indicates the request would grant, change or remove the owner role
+	// of a saved search. Ownership can only be transferred.
+	ErrInvalidCollaboratorChange = errors.New("invalid collaborator change")
+
+	// ErrSavedSearchMaxCollaborators indicates the saved search has reached the maximum
+	// number of allowed collaborators.
+	ErrSavedSearchMaxCollaborators = errors.New("saved search has reached the maximum number of collaborators")
+
+	// ErrSavedSearchReferenced indicates the saved search cannot be removed because
+	// the queries of other saved searches reference it.
+	ErrSavedSearchReferenced = errors.New("saved search is referenced by other saved searches")
+
 	// ErrQueryConsistsEntirelyOfSavedSearch indicates the query consists entirely of a saved search.
 	ErrQueryConsistsEntirelyOfSavedSearch = errors.New(
 		"query cannot consist entirely of a single saved search or hotlist",
@@ -82,11 +105,37 @@ var (
 
 	// ErrQueryComplexityExceeded indicates that the search query AST complexity exceeds the maximum allowed limit.
 	ErrQueryComplexityExceeded = errors.New("search query complexity limit exceeded")
+
+	// ErrSubscriptionConflict indicates the channel is already subscribed to the saved search
+	// with a different configuration.
+	ErrSubscriptionConflict = errors.New("subscription already exists with a different configuration")
+
+	// ErrUserDataImportEmailChannelNotFound indicates the user has no email channel with the
+	// address of an imported email channel.
+	ErrUserDataImportEmailChannelNotFound = errors.New("no email channel with this address")
 )
 
 // MaxASTNodes specifies the maximum allowed AST node complexity after deduplication.
 const MaxASTNodes = 50
 
+// UserDataExportVersion is the version of the user data export document format.
+const UserDataExportVersion = 1
+
+// UserDataImportItemError indicates a single item of a user data import could not be imported.
+type UserDataImportItemError struct {
+	Kind     backend.UserDataImportItemResultKind
+	SourceID string
+	Err      error
+}
+
+func (e *UserDataImportItemError) Error() string {
+	return fmt.Sprintf("unable to import %s %s: %v", e.Kind, e.SourceID, e.Err)
+}
+
+func (e *UserDataImportItemError) Unwrap() error {
+	return e.Err
+}
+
 type QueryParseError struct {
 	BadQuery string
 	Err      error
@@ -97,9 +146,10 @@ func (e *QueryParseError) Error() string {
 }
 
 type UserProfile struct {
-	UserID       string
-	GitHubUserID int64
-	Emails       []string
+	UserID         string
+	GitHubUserID   int64
+	GitHubUsername string
+	Emails         []string
 }
 
 // AttemptToStoreSubscriptionTrigger attempts to convert the given subscription trigger
@@ -0,0 +1,132 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+	"github.com/GoogleChrome/webstatus.dev/lib/generic"
+	"github.com/GoogleChrome/webstatus.dev/lib/workertypes/comparables"
+)
+
+// ToComparable maps a V1 feature struct to the canonical comparables.Feature.
+func (f Feature) ToComparable() comparables.Feature {
+	// 1. Convert name and ID
+	name := generic.OptionallySet[string]{Value: f.Name.Value, IsSet: true}
+	id := f.ID
+
+	// 2. Convert BaselineStatus
+	baselineStatus := generic.UnsetOpt[comparables.BaselineState]()
+	if f.BaselineStatus.IsSet {
+		baselineStatus.IsSet = true
+		baselineInfoStatus := generic.UnsetOpt[backend.BaselineInfoStatus]()
+		if f.BaselineStatus.Value.Status.IsSet {
+			baselineInfoStatus.IsSet = true
+			var status backend.BaselineInfoStatus
+			switch f.BaselineStatus.Value.Status.Value {
+			case Limited:
+				status = backend.Limited
+			case Newly:
+				status = backend.Newly
+			case Widely:
+				status = backend.Widely
+			}
+			baselineInfoStatus.Value = status
+			baselineStatus.Value.Status = baselineInfoStatus
+		}
+		baselineStatus.Value.LowDate = f.BaselineStatus.Value.LowDate
+		baselineStatus.Value.HighDate = f.BaselineStatus.Value.HighDate
+
+	}
+
+	// 3. Convert BrowserImplementations
+	browserImpls := generic.UnsetOpt[comparables.BrowserImplementations]()
+	if f.BrowserImpls.IsSet {
+		browserImpls.IsSet = true
+		browserImpls.Value = comparables.BrowserImplementations{
+			Chrome:         browserStateToComparable(f.BrowserImpls.Value.Chrome),
+			ChromeAndroid:  browserStateToComparable(f.BrowserImpls.Value.ChromeAndroid),
+			Edge:           browserStateToComparable(f.BrowserImpls.Value.Edge),
+			Firefox:        browserStateToComparable(f.BrowserImpls.Value.Firefox),
+			FirefoxAndroid: browserStateToComparable(f.BrowserImpls.Value.FirefoxAndroid),
+			Safari:         browserStateToComparable(f.BrowserImpls.Value.Safari),
+			SafariIos:      browserStateToComparable(f.BrowserImpls.Value.SafariIos),
+		}
+	}
+
+	// 4. Convert Docs
+	docs := generic.UnsetOpt[comparables.Docs]()
+	if f.Docs.IsSet {
+		docs.IsSet = true
+		mdnDocs := generic.UnsetOpt[[]comparables.MdnDoc]()
+		if f.Docs.Value.MdnDocs.IsSet {
+			mdnDocs.IsSet = true
+			for _, v1Doc := range f.Docs.Value.MdnDocs.Value {
+				mdnDocs.Value = append(mdnDocs.Value, comparables.MdnDoc{
+					URL:   v1Doc.URL,
+					Title: v1Doc.Title,
+					Slug:  v1Doc.Slug,
+				})
+			}
+		}
+		docs.Value.MdnDocs = mdnDocs
+	}
+
+	return comparables.Feature{
+		ID:             id,
+		Name:           name,
+		BaselineStatus: baselineStatus,
+		BrowserImpls:   browserImpls,
+		Docs:           docs,
+	}
+}
+
+// ComparableFeatures returns the features of the snapshot as canonical comparables.Feature values,
+// keyed by feature ID.
+func (s FeatureListSnapshot) ComparableFeatures() map[string]comparables.Feature {
+	comparableMap := make(map[string]comparables.Feature, len(s.Data.Features))
+	for id, v1Feature := range s.Data.Features {
+		comparableMap[id] = v1Feature.ToComparable()
+	}
+
+	return comparableMap
+}
+
+// browserStateToComparable converts a simple string status from V1
+// into the more detailed comparables.BrowserState.
+func browserStateToComparable(
+	state generic.OptionallySet[BrowserState]) generic.OptionallySet[comparables.BrowserState] {
+	browserState := generic.UnsetOpt[comparables.BrowserState]()
+	if !state.IsSet {
+		return browserState
+	}
+	browserState.IsSet = true
+	browserImplStatus := generic.UnsetOpt[backend.BrowserImplementationStatus]()
+	var status backend.BrowserImplementationStatus
+	if state.Value.Status.IsSet {
+		switch state.Value.Status.Value {
+		case Available:
+			status = backend.Available
+		case Unavailable:
+			status = backend.Unavailable
+		}
+		browserImplStatus.IsSet = true
+		browserImplStatus.Value = status
+		browserState.Value.Status = browserImplStatus
+	}
+	browserState.Value.Version = state.Value.Version
+	browserState.Value.Date = state.Value.Date
+
+	return browserState
+}
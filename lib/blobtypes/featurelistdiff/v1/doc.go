@@ -50,8 +50,9 @@ you don't trigger 10,000 false-positive alerts on deployment.
  2. Update V1 Types (`lib/blobtypes/featurelist/v1`):
     Add the field to the V1 `Feature` struct so it can be persisted.
 
- 3. Update Adapters (`workers/event_producer/pkg/producer/diff.go`):
-    Update `convertV1FeatureToComparable` and `convertComparableToV1Feature` to map
+ 3. Update Adapters (`lib/blobtypes/featurelist/v1/comparables.go` and
+    `workers/event_producer/pkg/producer/diff.go`):
+    Update `Feature.ToComparable` and `convertComparableToV1Feature` to map
     the data between storage and memory.
 
  4. Update Fetcher (`workers/event_producer/pkg/differ/differ.go`):
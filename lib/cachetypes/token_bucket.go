@@ -0,0 +1,31 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cachetypes
+
+import "time"
+
+// TokenBucketResult is the outcome of taking tokens from a token bucket.
+type TokenBucketResult struct {
+	// Allowed is true if the bucket had enough tokens and they were taken.
+	Allowed bool
+	// Capacity is the maximum number of tokens in the bucket.
+	Capacity int64
+	// Remaining is the number of whole tokens left in the bucket.
+	Remaining int64
+	// RetryAfter is how long to wait until enough tokens are available. It is zero if Allowed is true.
+	RetryAfter time.Duration
+	// ResetAfter is how long until the bucket is full again.
+	ResetAfter time.Duration
+}
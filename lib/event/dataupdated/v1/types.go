@@ -0,0 +1,68 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/cachetypes"
+)
+
+// DataSource identifies the dataset that was updated.
+type DataSource string
+
+const (
+	DataSourceUnknown     DataSource = "UNKNOWN"
+	DataSourceWebFeatures DataSource = "WEB_FEATURES"
+	DataSourceWPT         DataSource = "WPT"
+)
+
+func ToDataSource(source cachetypes.DataSource) DataSource {
+	switch source {
+	case cachetypes.DataSourceWebFeatures:
+		return DataSourceWebFeatures
+	case cachetypes.DataSourceWPT:
+		return DataSourceWPT
+	}
+
+	return DataSourceUnknown
+}
+
+// ToCacheTypesDataSource returns the data source and false if it is unknown.
+func (s DataSource) ToCacheTypesDataSource() (cachetypes.DataSource, bool) {
+	switch s {
+	case DataSourceWebFeatures:
+		return cachetypes.DataSourceWebFeatures, true
+	case DataSourceWPT:
+		return cachetypes.DataSourceWPT, true
+	case DataSourceUnknown:
+		// Not a dataset that consumers know about.
+	}
+
+	return "", false
+}
+
+// DataUpdatedEvent is the signal sent by an ingestion workflow after it successfully stored new data.
+// Consumers use it to drop anything derived from the previous version of the data, like cached responses.
+type DataUpdatedEvent struct {
+	// Source is the dataset that was updated.
+	Source DataSource `json:"source"`
+
+	// UpdatedAt is when the ingestion workflow finished.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+func (DataUpdatedEvent) Kind() string       { return "DataUpdatedEvent" }
+func (DataUpdatedEvent) APIVersion() string { return "v1" }
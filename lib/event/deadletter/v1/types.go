@@ -0,0 +1,59 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import "time"
+
+// ErrorClass categorizes why a worker gave up on a message.
+type ErrorClass string
+
+const (
+	ErrorClassInvalidEnvelope            ErrorClass = "INVALID_ENVELOPE"
+	ErrorClassNoHandler                  ErrorClass = "NO_HANDLER"
+	ErrorClassSchemaValidation           ErrorClass = "SCHEMA_VALIDATION"
+	ErrorClassUnprocessableEntity        ErrorClass = "UNPROCESSABLE_ENTITY"
+	ErrorClassUnrecoverableUserFailure   ErrorClass = "UNRECOVERABLE_USER_FAILURE"
+	ErrorClassUnrecoverableSystemFailure ErrorClass = "UNRECOVERABLE_SYSTEM_FAILURE"
+	ErrorClassPermanentWebhook           ErrorClass = "PERMANENT_WEBHOOK"
+	// ErrorClassMaxDeliveryAttempts is used for messages that Pub/Sub moved to the dead-letter topic
+	// itself after the subscription's maximum delivery attempts were exhausted.
+	ErrorClassMaxDeliveryAttempts ErrorClass = "MAX_DELIVERY_ATTEMPTS"
+	// ErrorClassPermanent is used for any other non-transient failure.
+	ErrorClassPermanent ErrorClass = "PERMANENT"
+)
+
+// DeadLetterEvent wraps a message that a worker could not process.
+// It is published to the dead-letter topic of the worker's subscription.
+type DeadLetterEvent struct {
+	// MessageID is the Pub/Sub ID of the failed message.
+	MessageID string `json:"message_id"`
+	// SubscriptionID is the subscription the failed message was received from.
+	SubscriptionID string `json:"subscription_id"`
+	// WorkerID identifies the worker that gave up on the message.
+	WorkerID string `json:"worker_id"`
+	// ErrorClass categorizes the failure.
+	ErrorClass ErrorClass `json:"error_class"`
+	// Error is the error message returned by the worker.
+	Error string `json:"error"`
+	// AttemptCount is the number of times the message was delivered.
+	AttemptCount int `json:"attempt_count"`
+	// FailedAt is when the worker gave up on the message.
+	FailedAt time.Time `json:"failed_at"`
+	// Data is the original message, usually an event envelope.
+	Data []byte `json:"data"`
+}
+
+func (DeadLetterEvent) Kind() string       { return "DeadLetterEvent" }
+func (DeadLetterEvent) APIVersion() string { return "v1" }
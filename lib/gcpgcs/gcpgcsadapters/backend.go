@@ -0,0 +1,284 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpgcsadapters
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/blobtypes"
+	featurelistv1 "github.com/GoogleChrome/webstatus.dev/lib/blobtypes/featurelist/v1"
+	featurelistdiffv1 "github.com/GoogleChrome/webstatus.dev/lib/blobtypes/featurelistdiff/v1"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+	"github.com/GoogleChrome/webstatus.dev/lib/generic"
+	"github.com/GoogleChrome/webstatus.dev/lib/workertypes/comparables"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+type BackendBlobStorageClient interface {
+	ReadBlob(ctx context.Context, path string, opts ...blobtypes.ReadOption) (*blobtypes.Blob, error)
+}
+
+// Backend reads the saved search snapshots stored by the event producer for the backend service.
+type Backend struct {
+	client   BackendBlobStorageClient
+	migrator *blobtypes.Migrator
+}
+
+// NewBackend constructs an adapter for the backend service.
+func NewBackend(client BackendBlobStorageClient) *Backend {
+	// In the future, do the registration for migration here
+	return &Backend{client: client, migrator: blobtypes.NewMigrator()}
+}
+
+// GetSavedSearchSnapshot returns the features of a stored snapshot, sorted by name.
+func (b *Backend) GetSavedSearchSnapshot(
+	ctx context.Context, ref backendtypes.SavedSearchSnapshotRef) (*backend.SavedSearchSnapshot, error) {
+	snapshot, err := b.readFeatureListSnapshot(ctx, ref.BlobPath)
+	if err != nil {
+		return nil, err
+	}
+
+	features := make([]backend.SavedSearchSnapshotFeature, 0, len(snapshot.Data.Features))
+	for _, feature := range snapshot.ComparableFeatures() {
+		features = append(features, backend.SavedSearchSnapshotFeature{
+			FeatureId:              feature.ID,
+			Name:                   feature.Name.Value,
+			Baseline:               toBackendBaselineInfo(feature.BaselineStatus),
+			BrowserImplementations: toBackendBrowserImplementations(feature.BrowserImpls),
+		})
+	}
+	slices.SortFunc(features, func(a, b backend.SavedSearchSnapshotFeature) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.FeatureId, b.FeatureId))
+	})
+
+	var hasQueryErrors *bool
+	if len(snapshot.Metadata.QueryErrors) > 0 {
+		hasQueryErrors = new(true)
+	}
+
+	return &backend.SavedSearchSnapshot{
+		Id:             ref.ID,
+		SnapshotType:   ref.SnapshotType,
+		GeneratedAt:    snapshot.Metadata.GeneratedAt,
+		Query:          snapshot.Metadata.QuerySignature,
+		HasQueryErrors: hasQueryErrors,
+		Features:       features,
+	}, nil
+}
+
+// DiffSavedSearchSnapshots compares the features of two stored snapshots.
+// Unlike the diffs produced for notifications, features that moved or split are not reconciled.
+func (b *Backend) DiffSavedSearchSnapshots(
+	ctx context.Context, base, target backendtypes.SavedSearchSnapshotRef) (*backend.SavedSearchSnapshotDiff, error) {
+	baseSnapshot, err := b.readFeatureListSnapshot(ctx, base.BlobPath)
+	if err != nil {
+		return nil, err
+	}
+	targetSnapshot, err := b.readFeatureListSnapshot(ctx, target.BlobPath)
+	if err != nil {
+		return nil, err
+	}
+
+	workflow := featurelistdiffv1.NewFeatureDiffWorkflow(nil, nil)
+	workflow.CalculateDiff(baseSnapshot.ComparableFeatures(), targetSnapshot.ComparableFeatures(), nil,
+		comparables.OriginUnknown)
+	diff := workflow.GetDiff()
+	diff.Sort()
+
+	ret := &backend.SavedSearchSnapshotDiff{
+		BaseSnapshotId: base.ID,
+		SnapshotId:     target.ID,
+		SnapshotType:   target.SnapshotType,
+		Added:          make([]backend.SavedSearchSnapshotFeatureReference, 0, len(diff.Added)),
+		Removed:        make([]backend.SavedSearchSnapshotFeatureReference, 0, len(diff.Removed)),
+		Modified:       make([]backend.SavedSearchSnapshotFeatureChange, 0, len(diff.Modified)),
+	}
+	for _, added := range diff.Added {
+		ret.Added = append(ret.Added, backend.SavedSearchSnapshotFeatureReference{
+			FeatureId: added.ID,
+			Name:      added.Name,
+		})
+	}
+	for _, removed := range diff.Removed {
+		ret.Removed = append(ret.Removed, backend.SavedSearchSnapshotFeatureReference{
+			FeatureId: removed.ID,
+			Name:      removed.Name,
+		})
+	}
+	for _, modified := range diff.Modified {
+		ret.Modified = append(ret.Modified, toBackendSnapshotFeatureChange(modified))
+	}
+
+	return ret, nil
+}
+
+func (b *Backend) readFeatureListSnapshot(
+	ctx context.Context, blobPath string) (*featurelistv1.FeatureListSnapshot, error) {
+	blob, err := b.client.ReadBlob(ctx, blobPath)
+	if err != nil {
+		if errors.Is(err, blobtypes.ErrBlobNotFound) {
+			return nil, errors.Join(err, backendtypes.ErrEntityDoesNotExist)
+		}
+
+		return nil, err
+	}
+
+	migrated, err := blobtypes.Apply[featurelistv1.FeatureListSnapshot](b.migrator, blob.Data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to migrate snapshot %s: %w", blobPath, err)
+	}
+	var snapshot featurelistv1.FeatureListSnapshot
+	if err := json.Unmarshal(migrated, &snapshot); err != nil {
+		return nil, fmt.Errorf("unable to parse snapshot %s: %w", blobPath, err)
+	}
+
+	return &snapshot, nil
+}
+
+func toBackendSnapshotFeatureChange(
+	modified featurelistdiffv1.FeatureModified) backend.SavedSearchSnapshotFeatureChange {
+	change := backend.SavedSearchSnapshotFeatureChange{
+		FeatureId:              modified.ID,
+		Name:                   modified.Name,
+		PreviousName:           nil,
+		Baseline:               nil,
+		BrowserImplementations: nil,
+		DocsChanged:            nil,
+	}
+	if modified.NameChange != nil {
+		change.PreviousName = &modified.NameChange.From
+	}
+	if modified.BaselineChange != nil {
+		change.Baseline = &backend.SavedSearchSnapshotBaselineChange{
+			OldValue: toBackendDiffBaselineInfo(modified.BaselineChange.From),
+			NewValue: toBackendDiffBaselineInfo(modified.BaselineChange.To),
+		}
+	}
+	if len(modified.BrowserChanges) > 0 {
+		browserChanges := make(map[string]backend.SavedSearchSnapshotBrowserChange, len(modified.BrowserChanges))
+		for browser, browserChange := range modified.BrowserChanges {
+			if browserChange == nil {
+				continue
+			}
+			browserChanges[string(browser)] = backend.SavedSearchSnapshotBrowserChange{
+				OldValue: toBackendDiffBrowserImplementation(browserChange.From),
+				NewValue: toBackendDiffBrowserImplementation(browserChange.To),
+			}
+		}
+		change.BrowserImplementations = &browserChanges
+	}
+	if modified.DocsChange != nil {
+		change.DocsChanged = new(true)
+	}
+
+	return change
+}
+
+func toBackendDate(date generic.OptionallySet[*time.Time]) *openapi_types.Date {
+	if !date.IsSet || date.Value == nil {
+		return nil
+	}
+
+	return &openapi_types.Date{Time: *date.Value}
+}
+
+func toBackendBaselineInfo(state generic.OptionallySet[comparables.BaselineState]) *backend.BaselineInfo {
+	if !state.IsSet {
+		return nil
+	}
+	var status *backend.BaselineInfoStatus
+	if state.Value.Status.IsSet {
+		status = new(state.Value.Status.Value)
+	}
+
+	return &backend.BaselineInfo{
+		Status:   status,
+		LowDate:  toBackendDate(state.Value.LowDate),
+		HighDate: toBackendDate(state.Value.HighDate),
+	}
+}
+
+func toBackendBrowserImplementations(
+	impls generic.OptionallySet[comparables.BrowserImplementations]) *map[string]backend.BrowserImplementation {
+	if !impls.IsSet {
+		return nil
+	}
+	ret := make(map[string]backend.BrowserImplementation)
+	for browser, state := range map[featurelistdiffv1.SupportedBrowsers]generic.OptionallySet[comparables.BrowserState]{
+		featurelistdiffv1.Chrome:         impls.Value.Chrome,
+		featurelistdiffv1.ChromeAndroid:  impls.Value.ChromeAndroid,
+		featurelistdiffv1.Edge:           impls.Value.Edge,
+		featurelistdiffv1.Firefox:        impls.Value.Firefox,
+		featurelistdiffv1.FirefoxAndroid: impls.Value.FirefoxAndroid,
+		featurelistdiffv1.Safari:         impls.Value.Safari,
+		featurelistdiffv1.SafariIos:      impls.Value.SafariIos,
+	} {
+		if !state.IsSet {
+			continue
+		}
+		var status *backend.BrowserImplementationStatus
+		if state.Value.Status.IsSet {
+			status = new(state.Value.Status.Value)
+		}
+		var version *string
+		if state.Value.Version.IsSet {
+			version = state.Value.Version.Value
+		}
+		ret[string(browser)] = backend.BrowserImplementation{
+			Status:  status,
+			Date:    toBackendDate(state.Value.Date),
+			Version: version,
+		}
+	}
+
+	return &ret
+}
+
+func toBackendDiffBaselineInfo(state featurelistdiffv1.BaselineState) *backend.BaselineInfo {
+	var status *backend.BaselineInfoStatus
+	if state.Status.IsSet {
+		status = new(backend.BaselineInfoStatus(state.Status.Value))
+	}
+
+	return &backend.BaselineInfo{
+		Status:   status,
+		LowDate:  toBackendDate(state.LowDate),
+		HighDate: toBackendDate(state.HighDate),
+	}
+}
+
+func toBackendDiffBrowserImplementation(state featurelistdiffv1.BrowserState) *backend.BrowserImplementation {
+	var status *backend.BrowserImplementationStatus
+	if state.Status.IsSet {
+		status = new(backend.BrowserImplementationStatus(state.Status.Value))
+	}
+	var version *string
+	if state.Version.IsSet {
+		version = state.Version.Value
+	}
+
+	return &backend.BrowserImplementation{
+		Status:  status,
+		Date:    toBackendDate(state.Date),
+		Version: version,
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpgcsadapters
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/blobtypes"
+	featurelistv1 "github.com/GoogleChrome/webstatus.dev/lib/blobtypes/featurelist/v1"
+	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+	"github.com/GoogleChrome/webstatus.dev/lib/generic"
+	"github.com/google/go-cmp/cmp"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// mockSnapshotBlobStorageClient serves feature list snapshots keyed by path.
+type mockSnapshotBlobStorageClient struct {
+	t     *testing.T
+	blobs map[string][]byte
+}
+
+func (m *mockSnapshotBlobStorageClient) ReadBlob(_ context.Context, path string,
+	_ ...blobtypes.ReadOption) (*blobtypes.Blob, error) {
+	data, found := m.blobs[path]
+	if !found {
+		return nil, blobtypes.ErrBlobNotFound
+	}
+
+	return &blobtypes.Blob{
+		Data:        data,
+		Generation:  1,
+		ContentType: "application/json",
+		Metadata:    nil,
+	}, nil
+}
+
+func (m *mockSnapshotBlobStorageClient) addSnapshot(path string, queryErrors []featurelistv1.QueryError,
+	features ...featurelistv1.Feature) {
+	m.t.Helper()
+	featureMap := make(map[string]featurelistv1.Feature, len(features))
+	for _, feature := range features {
+		featureMap[feature.ID] = feature
+	}
+	data, err := blobtypes.NewBlob(featurelistv1.FeatureListSnapshot{
+		Metadata: featurelistv1.StateMetadata{
+			ID:             path,
+			GeneratedAt:    time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+			SearchID:       "search-id",
+			QuerySignature: "group:css",
+			QueryErrors:    queryErrors,
+			EventID:        "",
+		},
+		Data: featurelistv1.FeatureListData{Features: featureMap},
+	})
+	if err != nil {
+		m.t.Fatalf("unable to create blob: %v", err)
+	}
+	m.blobs[path] = data
+}
+
+func testSnapshotFeature(id, name string, baseline featurelistv1.BaselineInfoStatus,
+	chrome featurelistv1.BrowserImplementationStatus) featurelistv1.Feature {
+	return featurelistv1.Feature{
+		ID:   id,
+		Name: generic.OptionallySet[string]{Value: name, IsSet: true},
+		BaselineStatus: generic.OptionallySet[featurelistv1.BaselineState]{
+			Value: featurelistv1.BaselineState{
+				Status:   generic.OptionallySet[featurelistv1.BaselineInfoStatus]{Value: baseline, IsSet: true},
+				LowDate:  generic.UnsetOpt[*time.Time](),
+				HighDate: generic.UnsetOpt[*time.Time](),
+			},
+			IsSet: true,
+		},
+		BrowserImpls: generic.OptionallySet[featurelistv1.BrowserImplementations]{
+			//nolint:exhaustruct // Only chrome is tracked in these tests.
+			Value: featurelistv1.BrowserImplementations{
+				Chrome: generic.OptionallySet[featurelistv1.BrowserState]{
+					Value: featurelistv1.BrowserState{
+						Status: generic.OptionallySet[featurelistv1.BrowserImplementationStatus]{
+							Value: chrome, IsSet: true},
+						Date:    generic.UnsetOpt[*time.Time](),
+						Version: generic.UnsetOpt[*string](),
+					},
+					IsSet: true,
+				},
+			},
+			IsSet: true,
+		},
+		Docs: generic.UnsetOpt[featurelistv1.Docs](),
+	}
+}
+
+func newTestSnapshotBackend(t *testing.T) *Backend {
+	t.Helper()
+	client := &mockSnapshotBlobStorageClient{t: t, blobs: map[string][]byte{}}
+	client.addSnapshot("path/old", nil,
+		testSnapshotFeature("grid", "Grid", featurelistv1.Newly, featurelistv1.Available),
+		testSnapshotFeature("subgrid", "Subgrid", featurelistv1.Limited, featurelistv1.Unavailable),
+		testSnapshotFeature("marquee", "Marquee", featurelistv1.Limited, featurelistv1.Available),
+	)
+	client.addSnapshot("path/new", []featurelistv1.QueryError{{Code: featurelistv1.ErrorCodeFeatureNotFound}},
+		testSnapshotFeature("grid", "CSS Grid", featurelistv1.Widely, featurelistv1.Available),
+		testSnapshotFeature("subgrid", "Subgrid", featurelistv1.Newly, featurelistv1.Available),
+		testSnapshotFeature("anchor", "Anchor positioning", featurelistv1.Limited, featurelistv1.Available),
+	)
+
+	return NewBackend(client)
+}
+
+func TestGetSavedSearchSnapshot(t *testing.T) {
+	b := newTestSnapshotBackend(t)
+	snapshot, err := b.GetSavedSearchSnapshot(context.Background(), backendtypes.SavedSearchSnapshotRef{
+		ID:           "event-1",
+		SnapshotType: backend.SubscriptionFrequencyWeekly,
+		BlobPath:     "path/new",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	feature := func(id, name string, baseline backend.BaselineInfoStatus) backend.SavedSearchSnapshotFeature {
+		return backend.SavedSearchSnapshotFeature{
+			FeatureId: id,
+			Name:      name,
+			Baseline: &backend.BaselineInfo{
+				Status:   new(baseline),
+				LowDate:  nil,
+				HighDate: nil,
+			},
+			BrowserImplementations: &map[string]backend.BrowserImplementation{
+				"chrome": {Status: new(backend.Available), Date: nil, Version: nil},
+			},
+		}
+	}
+	expected := &backend.SavedSearchSnapshot{
+		Id:             "event-1",
+		SnapshotType:   backend.SubscriptionFrequencyWeekly,
+		GeneratedAt:    time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Query:          "group:css",
+		HasQueryErrors: new(true),
+		Features: []backend.SavedSearchSnapshotFeature{
+			feature("anchor", "Anchor positioning", backend.Limited),
+			feature("grid", "CSS Grid", backend.Widely),
+			feature("subgrid", "Subgrid", backend.Newly),
+		},
+	}
+	if diff := cmp.Diff(expected, snapshot); diff != "" {
+		t.Errorf("unexpected snapshot (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetSavedSearchSnapshot_NotFound(t *testing.T) {
+	b := newTestSnapshotBackend(t)
+	_, err := b.GetSavedSearchSnapshot(context.Background(), backendtypes.SavedSearchSnapshotRef{
+		ID:           "event-1",
+		SnapshotType: backend.SubscriptionFrequencyImmediate,
+		BlobPath:     "path/missing",
+	})
+	if !errors.Is(err, backendtypes.ErrEntityDoesNotExist) {
+		t.Errorf("unexpected error. got %v, want %v", err, backendtypes.ErrEntityDoesNotExist)
+	}
+}
+
+func TestDiffSavedSearchSnapshots(t *testing.T) {
+	b := newTestSnapshotBackend(t)
+	diff, err := b.DiffSavedSearchSnapshots(context.Background(),
+		backendtypes.SavedSearchSnapshotRef{
+			ID:           "event-1",
+			SnapshotType: backend.SubscriptionFrequencyImmediate,
+			BlobPath:     "path/old",
+		},
+		backendtypes.SavedSearchSnapshotRef{
+			ID:           "latest",
+			SnapshotType: backend.SubscriptionFrequencyImmediate,
+			BlobPath:     "path/new",
+		})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	baseline := func(status backend.BaselineInfoStatus) *backend.BaselineInfo {
+		return &backend.BaselineInfo{Status: new(status), LowDate: nil, HighDate: nil}
+	}
+	expected := &backend.SavedSearchSnapshotDiff{
+		BaseSnapshotId: "event-1",
+		SnapshotId:     "latest",
+		SnapshotType:   backend.SubscriptionFrequencyImmediate,
+		Added: []backend.SavedSearchSnapshotFeatureReference{
+			{FeatureId: "anchor", Name: "Anchor positioning"},
+		},
+		Removed: []backend.SavedSearchSnapshotFeatureReference{
+			{FeatureId: "marquee", Name: "Marquee"},
+		},
+		Modified: []backend.SavedSearchSnapshotFeatureChange{
+			{
+				FeatureId:    "grid",
+				Name:         "CSS Grid",
+				PreviousName: new("Grid"),
+				Baseline: &backend.SavedSearchSnapshotBaselineChange{
+					OldValue: baseline(backend.Newly),
+					NewValue: baseline(backend.Widely),
+				},
+				BrowserImplementations: nil,
+				DocsChanged:            nil,
+			},
+			{
+				FeatureId:    "subgrid",
+				Name:         "Subgrid",
+				PreviousName: nil,
+				Baseline: &backend.SavedSearchSnapshotBaselineChange{
+					OldValue: baseline(backend.Limited),
+					NewValue: baseline(backend.Newly),
+				},
+				BrowserImplementations: &map[string]backend.SavedSearchSnapshotBrowserChange{
+					"chrome": {
+						OldValue: &backend.BrowserImplementation{
+							Status: new(backend.Unavailable), Date: nil, Version: nil},
+						NewValue: &backend.BrowserImplementation{
+							Status: new(backend.Available), Date: nil, Version: nil},
+					},
+				},
+				DocsChanged: nil,
+			},
+		},
+	}
+	if d := cmp.Diff(expected, diff, cmp.AllowUnexported(openapi_types.Date{})); d != "" {
+		t.Errorf("unexpected diff (-want +got):\n%s", d)
+	}
+}
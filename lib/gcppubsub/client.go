@@ -25,6 +25,10 @@ import (
 
 type Client struct {
 	client *pubsub.Client
+	// deadLetterTopicID is where permanently failed messages are stored. Empty disables dead-lettering.
+	deadLetterTopicID string
+	// workerID identifies this worker in dead-lettered messages.
+	workerID string
 }
 
 // NewClient creates a new Pub/Sub client.
@@ -35,7 +39,15 @@ func NewClient(ctx context.Context, projectID string) (*Client, error) {
 		return nil, errors.Join(ErrFailedToEstablishClient, err)
 	}
 
-	return &Client{client: c}, nil
+	return &Client{client: c, deadLetterTopicID: "", workerID: ""}, nil
+}
+
+// SetDeadLetterTopic enables the dead-letter path for permanent failures.
+// Messages that fail permanently are published to topicID, along with the error and workerID,
+// instead of being dropped.
+func (c *Client) SetDeadLetterTopic(topicID, workerID string) {
+	c.deadLetterTopicID = topicID
+	c.workerID = workerID
 }
 
 func (c *Client) Close() error {
@@ -78,6 +90,14 @@ func (c *Client) Subscribe(ctx context.Context, subID string,
 		} else {
 			// ACK: Permanent failure or unknown error, do not retry
 			slog.ErrorContext(ctx, "permanent failure", "error", workErr)
+			if err := c.deadLetter(ctx, subID, msg, workErr); err != nil {
+				// NACK: Keep the message around rather than losing it. The subscription's
+				// dead-letter policy takes over if this keeps failing.
+				slog.ErrorContext(ctx, "unable to dead-letter message, will retry", "error", err)
+				msg.Nack()
+
+				return
+			}
 			msg.Ack()
 		}
 	})
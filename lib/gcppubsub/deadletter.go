@@ -0,0 +1,196 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcppubsub
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/pubsub/v2"
+	"github.com/GoogleChrome/webstatus.dev/lib/event"
+	deadletterv1 "github.com/GoogleChrome/webstatus.dev/lib/event/deadletter/v1"
+	"github.com/GoogleChrome/webstatus.dev/lib/workertypes"
+)
+
+// Attributes that Pub/Sub sets on messages it forwards to a dead-letter topic after the
+// subscription's maximum delivery attempts are exhausted.
+const (
+	sourceSubscriptionAttribute  = "CloudPubSubDeadLetterSourceSubscription"
+	sourceDeliveryCountAttribute = "CloudPubSubDeadLetterSourceDeliveryCount"
+)
+
+// WorkerID builds the identifier recorded in dead-lettered messages.
+// It combines the worker name with the host name of the running instance, when available.
+func WorkerID(name string) string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		return name
+	}
+
+	return name + "/" + hostname
+}
+
+// ClassifyError maps a permanent worker error to its dead-letter error class.
+func ClassifyError(err error) deadletterv1.ErrorClass {
+	switch {
+	case errors.Is(err, event.ErrInvalidEnvelope):
+		return deadletterv1.ErrorClassInvalidEnvelope
+	case errors.Is(err, event.ErrNoHandler):
+		return deadletterv1.ErrorClassNoHandler
+	case errors.Is(err, event.ErrSchemaValidation):
+		return deadletterv1.ErrorClassSchemaValidation
+	case errors.Is(err, event.ErrUnprocessableEntity):
+		return deadletterv1.ErrorClassUnprocessableEntity
+	case errors.Is(err, workertypes.ErrUnrecoverableUserFailureEmailSending):
+		return deadletterv1.ErrorClassUnrecoverableUserFailure
+	case errors.Is(err, workertypes.ErrUnrecoverableSystemFailureEmailSending):
+		return deadletterv1.ErrorClassUnrecoverableSystemFailure
+	case errors.Is(err, workertypes.ErrPermanentWebhook):
+		return deadletterv1.ErrorClassPermanentWebhook
+	}
+
+	return deadletterv1.ErrorClassPermanent
+}
+
+// deadLetter publishes the failed message to the configured dead-letter topic.
+// It is a no-op if no dead-letter topic is configured.
+func (c *Client) deadLetter(ctx context.Context, subID string, msg *pubsub.Message, workErr error) error {
+	if c.deadLetterTopicID == "" {
+		return nil
+	}
+
+	attempts := 1
+	if msg.DeliveryAttempt != nil {
+		attempts = *msg.DeliveryAttempt
+	}
+
+	b, err := event.New(deadletterv1.DeadLetterEvent{
+		MessageID:      msg.ID,
+		SubscriptionID: subID,
+		WorkerID:       c.workerID,
+		ErrorClass:     ClassifyError(workErr),
+		Error:          workErr.Error(),
+		AttemptCount:   attempts,
+		FailedAt:       time.Now().UTC(),
+		Data:           msg.Data,
+	})
+	if err != nil {
+		return errors.Join(ErrFailedToDeadLetterMessage, err)
+	}
+
+	if _, err := c.Publish(ctx, c.deadLetterTopicID, b); err != nil {
+		return errors.Join(ErrFailedToDeadLetterMessage, err)
+	}
+
+	return nil
+}
+
+// DeadLetterMessage is a message read from a dead-letter subscription.
+type DeadLetterMessage struct {
+	// ID is the ID of the message in the dead-letter subscription.
+	ID string
+	// PublishTime is when the message was published to the dead-letter topic.
+	PublishTime time.Time
+	// Event describes the original failed message.
+	Event deadletterv1.DeadLetterEvent
+}
+
+// ReceiveDeadLetters reads up to maxMessages messages from a dead-letter subscription and calls
+// handler for each of them. Messages for which handler returns true are acknowledged and removed
+// from the subscription. All other messages are nacked so they remain available.
+// It returns once maxMessages distinct messages were handled, a message is seen for the second
+// time, or ctx is done.
+func (c *Client) ReceiveDeadLetters(ctx context.Context, subID string, maxMessages int,
+	handler func(ctx context.Context, msg DeadLetterMessage) bool) error {
+	sub := c.client.Subscriber(subID)
+	// Handle one message at a time so that the handler does not need to be concurrency safe.
+	sub.ReceiveSettings.NumGoroutines = 1
+	sub.ReceiveSettings.MaxOutstandingMessages = 1
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var mu sync.Mutex
+	seen := make(map[string]struct{})
+	err := sub.Receive(ctx, func(ctx context.Context, msg *pubsub.Message) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if _, found := seen[msg.ID]; found || len(seen) >= maxMessages {
+			// A nacked message came back around or we already have enough. Stop reading.
+			msg.Nack()
+			cancel()
+
+			return
+		}
+		seen[msg.ID] = struct{}{}
+
+		if handler(ctx, parseDeadLetterMessage(ctx, subID, msg)) {
+			msg.Ack()
+		} else {
+			msg.Nack()
+		}
+
+		if len(seen) >= maxMessages {
+			cancel()
+		}
+	})
+	if err != nil {
+		return errors.Join(ErrFailedToReceiveMessage, err)
+	}
+
+	return nil
+}
+
+// parseDeadLetterMessage converts a raw dead-letter message into a DeadLetterMessage.
+// Messages published by a worker's dead-letter path carry a DeadLetterEvent. Messages that Pub/Sub
+// forwarded itself carry the original payload, so the details come from the message attributes.
+func parseDeadLetterMessage(ctx context.Context, subID string, msg *pubsub.Message) DeadLetterMessage {
+	ret := DeadLetterMessage{
+		ID:          msg.ID,
+		PublishTime: msg.PublishTime,
+		Event: deadletterv1.DeadLetterEvent{
+			MessageID:      "",
+			SubscriptionID: msg.Attributes[sourceSubscriptionAttribute],
+			WorkerID:       "",
+			ErrorClass:     deadletterv1.ErrorClassMaxDeliveryAttempts,
+			Error:          "",
+			AttemptCount:   0,
+			FailedAt:       msg.PublishTime,
+			Data:           msg.Data,
+		},
+	}
+	if count, err := strconv.Atoi(msg.Attributes[sourceDeliveryCountAttribute]); err == nil {
+		ret.Event.AttemptCount = count
+	}
+
+	router := event.NewRouter()
+	event.Register(router, func(_ context.Context, _ string, evt deadletterv1.DeadLetterEvent) error {
+		ret.Event = evt
+
+		return nil
+	})
+	if err := router.HandleMessage(ctx, msg.ID, msg.Data); err != nil {
+		slog.DebugContext(ctx, "dead-letter message was forwarded by pubsub", "subscription", subID,
+			"id", msg.ID, "reason", err)
+	}
+
+	return ret
+}
@@ -0,0 +1,182 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcppubsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/event"
+	deadletterv1 "github.com/GoogleChrome/webstatus.dev/lib/event/deadletter/v1"
+	"github.com/GoogleChrome/webstatus.dev/lib/workertypes"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want deadletterv1.ErrorClass
+	}{
+		{
+			name: "invalid envelope",
+			err:  fmt.Errorf("%w: %w", event.ErrUnprocessableEntity, event.ErrInvalidEnvelope),
+			want: deadletterv1.ErrorClassInvalidEnvelope,
+		},
+		{
+			name: "no handler",
+			err:  fmt.Errorf("%w: %w", event.ErrUnprocessableEntity, event.ErrNoHandler),
+			want: deadletterv1.ErrorClassNoHandler,
+		},
+		{
+			name: "schema validation",
+			err:  fmt.Errorf("%w: %w", event.ErrUnprocessableEntity, event.ErrSchemaValidation),
+			want: deadletterv1.ErrorClassSchemaValidation,
+		},
+		{
+			name: "unprocessable entity",
+			err:  event.ErrUnprocessableEntity,
+			want: deadletterv1.ErrorClassUnprocessableEntity,
+		},
+		{
+			name: "unrecoverable user failure",
+			err:  errors.Join(workertypes.ErrUnrecoverableUserFailureEmailSending, errors.New("bad address")),
+			want: deadletterv1.ErrorClassUnrecoverableUserFailure,
+		},
+		{
+			name: "unrecoverable system failure",
+			err:  workertypes.ErrUnrecoverableSystemFailureEmailSending,
+			want: deadletterv1.ErrorClassUnrecoverableSystemFailure,
+		},
+		{
+			name: "permanent webhook failure",
+			err: fmt.Errorf("failed to send webhook: %w",
+				errors.Join(workertypes.ErrPermanentWebhook, errors.New("unexpected status 404"))),
+			want: deadletterv1.ErrorClassPermanentWebhook,
+		},
+		{
+			name: "other error",
+			err:  errors.New("unexpected failure"),
+			want: deadletterv1.ErrorClassPermanent,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ClassifyError(tc.err); got != tc.want {
+				t.Errorf("ClassifyError() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSubscribe_DeadLetter(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	topicID := "dead-letter-source-topic"
+	subID := "dead-letter-source-sub"
+	dlqTopicID := "dead-letter-topic"
+	dlqSubID := "dead-letter-sub"
+	createTestTopic(t, topicID)
+	createTestSubscription(t, topicID, subID, 10)
+	createTestTopic(t, dlqTopicID)
+	createTestSubscription(t, dlqTopicID, dlqSubID, 10)
+
+	client, err := NewClient(ctx, testProjectID)
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	defer client.Close()
+	client.SetDeadLetterTopic(dlqTopicID, "test-worker")
+
+	msgData := []byte(`{"apiVersion":"v1","kind":"Unknown","data":{}}`)
+	msgID, err := client.Publish(ctx, topicID, msgData)
+	if err != nil {
+		t.Fatalf("Publish failed: %v", err)
+	}
+
+	// Fail the message permanently.
+	subCtx, subCancel := context.WithCancel(ctx)
+	defer subCancel()
+	handled := make(chan struct{})
+	go func() {
+		err := client.Subscribe(subCtx, subID, func(ctx context.Context, id string, data []byte) error {
+			defer close(handled)
+
+			return event.NewRouter().HandleMessage(ctx, id, data)
+		})
+		if err != nil {
+			t.Errorf("Subscribe failed: %v", err)
+		}
+	}()
+	select {
+	case <-handled:
+		subCancel()
+	case <-ctx.Done():
+		t.Fatal("Timeout waiting for message")
+	}
+
+	// Inspect without acknowledging. The message should stay in the dead-letter subscription.
+	var inspected []DeadLetterMessage
+	err = client.ReceiveDeadLetters(ctx, dlqSubID, 1, func(_ context.Context, msg DeadLetterMessage) bool {
+		inspected = append(inspected, msg)
+
+		return false
+	})
+	if err != nil {
+		t.Fatalf("ReceiveDeadLetters failed: %v", err)
+	}
+	if len(inspected) != 1 {
+		t.Fatalf("expected 1 dead-lettered message, got %d", len(inspected))
+	}
+	got := inspected[0].Event
+	if got.MessageID != msgID {
+		t.Errorf("MessageID = %q, want %q", got.MessageID, msgID)
+	}
+	if got.SubscriptionID != subID {
+		t.Errorf("SubscriptionID = %q, want %q", got.SubscriptionID, subID)
+	}
+	if got.WorkerID != "test-worker" {
+		t.Errorf("WorkerID = %q, want %q", got.WorkerID, "test-worker")
+	}
+	if got.ErrorClass != deadletterv1.ErrorClassNoHandler {
+		t.Errorf("ErrorClass = %q, want %q", got.ErrorClass, deadletterv1.ErrorClassNoHandler)
+	}
+	if got.AttemptCount != 1 {
+		t.Errorf("AttemptCount = %d, want 1", got.AttemptCount)
+	}
+	if string(got.Data) != string(msgData) {
+		t.Errorf("Data = %q, want %q", got.Data, msgData)
+	}
+
+	// Acknowledge it this time.
+	var acked int
+	err = client.ReceiveDeadLetters(ctx, dlqSubID, 1, func(_ context.Context, msg DeadLetterMessage) bool {
+		if msg.ID == inspected[0].ID {
+			acked++
+		}
+
+		return true
+	})
+	if err != nil {
+		t.Fatalf("ReceiveDeadLetters failed: %v", err)
+	}
+	if acked != 1 {
+		t.Errorf("expected the inspected message to be acknowledged, got %d", acked)
+	}
+}
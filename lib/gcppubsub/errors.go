@@ -24,3 +24,6 @@ var ErrFailedToPublishMessage = errors.New("failed to publish message")
 
 // ErrFailedToReceiveMessage indicates failure to receive messages.
 var ErrFailedToReceiveMessage = errors.New("failed to receive message")
+
+// ErrFailedToDeadLetterMessage indicates failure to publish a message to the dead-letter topic.
+var ErrFailedToDeadLetterMessage = errors.New("failed to dead-letter message")
@@ -19,8 +19,10 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
 	"github.com/GoogleChrome/webstatus.dev/lib/event"
 	searchconfigv1 "github.com/GoogleChrome/webstatus.dev/lib/event/searchconfigurationchanged/v1"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
 	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
 )
 
@@ -42,7 +44,7 @@ func (p *BackendAdapter) PublishSearchConfigurationChanged(
 	evt := searchconfigv1.SearchConfigurationChangedEvent{
 		SearchID:   resp.Id,
 		SearchName: resp.Name,
-		Query:      resp.Query,
+		Query:      bindSavedSearchQueryDefaults(ctx, resp),
 		UserID:     userID,
 		Timestamp:  resp.UpdatedAt,
 		IsCreation: isCreation,
@@ -66,3 +68,20 @@ func (p *BackendAdapter) PublishSearchConfigurationChanged(
 
 	return nil
 }
+
+// bindSavedSearchQueryDefaults returns the query of the saved search with its variables bound to
+// the defaults of its parameters. Notifications for a parameterized search are always computed with the defaults.
+func bindSavedSearchQueryDefaults(ctx context.Context, resp *backend.SavedSearchResponse) string {
+	if resp.Parameters == nil {
+		return resp.Query
+	}
+	query, err := searchtypes.BindQueryVariables(resp.Query, backendtypes.SearchQueryParameters(resp.Parameters), nil)
+	if err != nil {
+		// The query was validated when it was saved. Fall back to the raw query and let the consumer report it.
+		slog.WarnContext(ctx, "unable to bind saved search query", "searchID", resp.Id, "error", err)
+
+		return resp.Query
+	}
+
+	return query
+}
@@ -87,6 +87,32 @@ func TestSearchConfigurationPublisherAdapter_Publish(t *testing.T) {
 				}
 			}`,
 		},
+		{
+			name: "success with query parameters",
+			resp: func() *backend.SavedSearchResponse {
+				resp := testSavedSearchResponse("search-789", "baseline_status:$status", fixedTime)
+				resp.Parameters = &backend.SavedSearchQueryParameters{{Name: "status", DefaultValue: "newly"}}
+
+				return resp
+			}(),
+			userID:     "user-1",
+			isCreation: true,
+			publishErr: nil,
+			wantErr:    false,
+			expectedJSON: `{
+				"apiVersion": "v1",
+				"kind": "SearchConfigurationChangedEvent",
+				"data": {
+					"search_id": "search-789",
+					"search_name": "",
+					"query": "baseline_status:newly",
+					"user_id": "user-1",
+					"timestamp": "2025-01-01T00:00:00Z",
+					"is_creation": true,
+					"frequency": "IMMEDIATE"
+				}
+			}`,
+		},
 		{
 			name:         "publish error",
 			resp:         testSavedSearchResponse("search-err", "group:html", fixedTime),
@@ -0,0 +1,111 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcppubsubadapters
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/cachetypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/event"
+	dataupdatedv1 "github.com/GoogleChrome/webstatus.dev/lib/event/dataupdated/v1"
+)
+
+// DataUpdatedPublisherAdapter lets ingestion workflows announce that they stored new data.
+type DataUpdatedPublisherAdapter struct {
+	client  EventPublisher
+	topicID string
+}
+
+func NewDataUpdatedPublisherAdapter(client EventPublisher, topicID string) *DataUpdatedPublisherAdapter {
+	return &DataUpdatedPublisherAdapter{client: client, topicID: topicID}
+}
+
+func (a *DataUpdatedPublisherAdapter) PublishDataUpdated(ctx context.Context,
+	source cachetypes.DataSource, updatedAt time.Time) error {
+	msg, err := event.New(dataupdatedv1.DataUpdatedEvent{
+		Source:    dataupdatedv1.ToDataSource(source),
+		UpdatedAt: updatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create event: %w", err)
+	}
+
+	id, err := a.client.Publish(ctx, a.topicID, msg)
+	if err != nil {
+		return fmt.Errorf("failed to publish message: %w", err)
+	}
+	slog.InfoContext(ctx, "published data updated event", "msgID", id, "source", source)
+
+	return nil
+}
+
+// CacheInvalidationHandler defines the interface for the logic that drops the cached data derived from a
+// data source.
+type CacheInvalidationHandler interface {
+	InvalidateDataSource(ctx context.Context, source cachetypes.DataSource) error
+}
+
+type CacheInvalidationSubscriberAdapter struct {
+	invalidator     CacheInvalidationHandler
+	eventSubscriber EventSubscriber
+	subscriptionID  string
+	router          *event.Router
+}
+
+func NewCacheInvalidationSubscriberAdapter(
+	invalidator CacheInvalidationHandler,
+	eventSubscriber EventSubscriber,
+	subscriptionID string,
+) *CacheInvalidationSubscriberAdapter {
+	router := event.NewRouter()
+
+	ret := &CacheInvalidationSubscriberAdapter{
+		invalidator:     invalidator,
+		eventSubscriber: eventSubscriber,
+		subscriptionID:  subscriptionID,
+		router:          router,
+	}
+
+	event.Register(router, ret.processDataUpdatedEvent)
+
+	return ret
+}
+
+func (a *CacheInvalidationSubscriberAdapter) Subscribe(ctx context.Context) error {
+	return a.eventSubscriber.Subscribe(ctx, a.subscriptionID, func(ctx context.Context,
+		msgID string, data []byte) error {
+		return a.router.HandleMessage(ctx, msgID, data)
+	})
+}
+
+func (a *CacheInvalidationSubscriberAdapter) processDataUpdatedEvent(ctx context.Context,
+	eventID string, evt dataupdatedv1.DataUpdatedEvent) error {
+	slog.InfoContext(ctx, "received data updated event", "eventID", eventID, "source", evt.Source,
+		"updatedAt", evt.UpdatedAt)
+
+	source, ok := evt.Source.ToCacheTypesDataSource()
+	if !ok {
+		// Newer publishers may know about more data sources. There is nothing to invalidate for them here.
+		slog.WarnContext(ctx, "ignoring data updated event for unknown source", "eventID", eventID,
+			"source", evt.Source)
+
+		return nil
+	}
+
+	return a.invalidator.InvalidateDataSource(ctx, source)
+}
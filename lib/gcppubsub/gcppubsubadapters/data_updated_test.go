@@ -0,0 +1,180 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcppubsubadapters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/cachetypes"
+	dataupdatedv1 "github.com/GoogleChrome/webstatus.dev/lib/event/dataupdated/v1"
+)
+
+func TestDataUpdatedPublisherAdapter_PublishDataUpdated(t *testing.T) {
+	tests := []struct {
+		name         string
+		source       cachetypes.DataSource
+		publishErr   error
+		wantErr      bool
+		expectedJSON string
+	}{
+		{
+			name:       "success",
+			source:     cachetypes.DataSourceWPT,
+			publishErr: nil,
+			wantErr:    false,
+			expectedJSON: `{
+				"apiVersion": "v1",
+				"kind": "DataUpdatedEvent",
+				"data": {
+					"source": "WPT",
+					"updated_at": "2025-01-01T00:00:00Z"
+				}
+			}`,
+		},
+		{
+			name:         "publish error",
+			source:       cachetypes.DataSourceWebFeatures,
+			publishErr:   errors.New("pubsub error"),
+			wantErr:      true,
+			expectedJSON: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			publisher := new(mockPublisher)
+			publisher.err = tc.publishErr
+			adapter := NewDataUpdatedPublisherAdapter(publisher, "data-updated-topic")
+
+			err := adapter.PublishDataUpdated(context.Background(), tc.source,
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+			if (err != nil) != tc.wantErr {
+				t.Errorf("PublishDataUpdated() error = %v, wantErr %v", err, tc.wantErr)
+			}
+
+			if publisher.publishedTopic != "data-updated-topic" {
+				t.Errorf("unexpected topic %q", publisher.publishedTopic)
+			}
+			if !tc.wantErr {
+				verifyJSONPayload(t, publisher.publishedData, tc.expectedJSON)
+			}
+		})
+	}
+}
+
+type mockCacheInvalidationHandler struct {
+	calls []cachetypes.DataSource
+	mu    sync.Mutex
+	err   error
+}
+
+func (m *mockCacheInvalidationHandler) InvalidateDataSource(_ context.Context, source cachetypes.DataSource) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls = append(m.calls, source)
+
+	return m.err
+}
+
+func TestCacheInvalidationSubscriberAdapter(t *testing.T) {
+	tests := []struct {
+		name          string
+		source        dataupdatedv1.DataSource
+		handlerErr    error
+		expectedCalls []cachetypes.DataSource
+		wantErr       bool
+	}{
+		{
+			name:          "web features",
+			source:        dataupdatedv1.DataSourceWebFeatures,
+			handlerErr:    nil,
+			expectedCalls: []cachetypes.DataSource{cachetypes.DataSourceWebFeatures},
+			wantErr:       false,
+		},
+		{
+			name:          "handler error",
+			source:        dataupdatedv1.DataSourceWPT,
+			handlerErr:    errors.New("valkey error"),
+			expectedCalls: []cachetypes.DataSource{cachetypes.DataSourceWPT},
+			wantErr:       true,
+		},
+		{
+			name:          "unknown source is ignored",
+			source:        "BCD",
+			handlerErr:    nil,
+			expectedCalls: nil,
+			wantErr:       false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := &mockCacheInvalidationHandler{calls: nil, mu: sync.Mutex{}, err: tc.handlerErr}
+			subscriber := &mockSubscriber{block: make(chan struct{}), mu: sync.Mutex{}, handlers: nil}
+			adapter := NewCacheInvalidationSubscriberAdapter(handler, subscriber, "data-updated-sub")
+
+			ctx, cancel := context.WithCancel(context.Background())
+			errChan := make(chan error)
+			go func() {
+				errChan <- adapter.Subscribe(ctx)
+			}()
+			defer func() {
+				close(subscriber.block)
+				cancel()
+				<-errChan
+			}()
+
+			// Wait briefly for Subscribe to start and register the handler
+			time.Sleep(50 * time.Millisecond)
+			subscriber.mu.Lock()
+			handleFn := subscriber.handlers["data-updated-sub"]
+			subscriber.mu.Unlock()
+			if handleFn == nil {
+				t.Fatal("Subscribe did not register a handler")
+			}
+
+			data, err := json.Marshal(map[string]any{
+				"apiVersion": "v1",
+				"kind":       "DataUpdatedEvent",
+				"data": dataupdatedv1.DataUpdatedEvent{
+					Source:    tc.source,
+					UpdatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				},
+			})
+			if err != nil {
+				t.Fatalf("Failed to marshal event: %v", err)
+			}
+
+			err = handleFn(context.Background(), "msg-1", data)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("handleFn() error = %v, wantErr %v", err, tc.wantErr)
+			}
+
+			if len(handler.calls) != len(tc.expectedCalls) {
+				t.Fatalf("expected calls %v, got %v", tc.expectedCalls, handler.calls)
+			}
+			for i := range tc.expectedCalls {
+				if handler.calls[i] != tc.expectedCalls[i] {
+					t.Errorf("expected calls %v, got %v", tc.expectedCalls, handler.calls)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcppubsubadapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/event"
+	batchrefreshv1 "github.com/GoogleChrome/webstatus.dev/lib/event/batchrefreshtrigger/v1"
+	deadletterv1 "github.com/GoogleChrome/webstatus.dev/lib/event/deadletter/v1"
+	emailjobv1 "github.com/GoogleChrome/webstatus.dev/lib/event/emailjob/v1"
+	featurediffv1 "github.com/GoogleChrome/webstatus.dev/lib/event/featurediff/v1"
+	refreshv1 "github.com/GoogleChrome/webstatus.dev/lib/event/refreshsearchcommand/v1"
+	searchconfigv1 "github.com/GoogleChrome/webstatus.dev/lib/event/searchconfigurationchanged/v1"
+	webhookjobv1 "github.com/GoogleChrome/webstatus.dev/lib/event/webhookjob/v1"
+)
+
+// ErrNoRedriveTopic indicates that no topic was configured for the kind of the dead-lettered event.
+var ErrNoRedriveTopic = errors.New("no redrive topic configured for event")
+
+// DeadLetterRedriveTopics holds the topics that the workers consume each event kind from.
+// An empty topic disables redrive for the corresponding kinds.
+type DeadLetterRedriveTopics struct {
+	// IngestionTopicID receives RefreshSearchCommand and SearchConfigurationChangedEvent.
+	IngestionTopicID string
+	// BatchTopicID receives BatchRefreshTrigger.
+	BatchTopicID string
+	// NotificationTopicID receives FeatureDiffEvent.
+	NotificationTopicID string
+	// EmailTopicID receives EmailJobEvent.
+	EmailTopicID string
+	// WebhookTopicID receives WebhookJobEvent.
+	WebhookTopicID string
+}
+
+// DeadLetterRedriveAdapter sends dead-lettered events back to the topic they originally came from.
+// The original message goes through an event.Router so that only envelopes that still match a
+// known kind and schema are republished.
+type DeadLetterRedriveAdapter struct {
+	client EventPublisher
+	router *event.Router
+}
+
+func NewDeadLetterRedriveAdapter(client EventPublisher, topics DeadLetterRedriveTopics) *DeadLetterRedriveAdapter {
+	ret := &DeadLetterRedriveAdapter{
+		client: client,
+		router: event.NewRouter(),
+	}
+
+	registerRedrive[refreshv1.RefreshSearchCommand](ret, topics.IngestionTopicID)
+	registerRedrive[searchconfigv1.SearchConfigurationChangedEvent](ret, topics.IngestionTopicID)
+	registerRedrive[batchrefreshv1.BatchRefreshTrigger](ret, topics.BatchTopicID)
+	registerRedrive[featurediffv1.FeatureDiffEvent](ret, topics.NotificationTopicID)
+	registerRedrive[emailjobv1.EmailJobEvent](ret, topics.EmailTopicID)
+	registerRedrive[webhookjobv1.WebhookJobEvent](ret, topics.WebhookTopicID)
+
+	return ret
+}
+
+// Redrive republishes the original message of a dead-lettered event.
+func (a *DeadLetterRedriveAdapter) Redrive(ctx context.Context, evt deadletterv1.DeadLetterEvent) error {
+	return a.router.HandleMessage(ctx, evt.MessageID, evt.Data)
+}
+
+func registerRedrive[T event.Event](a *DeadLetterRedriveAdapter, topicID string) {
+	event.Register(a.router, func(ctx context.Context, eventID string, evt T) error {
+		if topicID == "" {
+			return fmt.Errorf("%w: kind=%q", ErrNoRedriveTopic, evt.Kind())
+		}
+
+		b, err := event.New(evt)
+		if err != nil {
+			return fmt.Errorf("failed to create event: %w", err)
+		}
+
+		id, err := a.client.Publish(ctx, topicID, b)
+		if err != nil {
+			return fmt.Errorf("failed to publish message: %w", err)
+		}
+
+		slog.InfoContext(ctx, "redrove dead-lettered event",
+			"originalMsgID", eventID,
+			"msgID", id,
+			"kind", evt.Kind(),
+			"topicID", topicID)
+
+		return nil
+	})
+}
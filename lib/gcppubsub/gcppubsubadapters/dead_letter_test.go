@@ -0,0 +1,142 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcppubsubadapters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/event"
+	deadletterv1 "github.com/GoogleChrome/webstatus.dev/lib/event/deadletter/v1"
+	"github.com/google/go-cmp/cmp"
+)
+
+var errPublish = errors.New("pubsub error")
+
+func testRedriveTopics() DeadLetterRedriveTopics {
+	return DeadLetterRedriveTopics{
+		IngestionTopicID:    "ingestion-topic",
+		BatchTopicID:        "batch-topic",
+		NotificationTopicID: "notification-topic",
+		EmailTopicID:        "email-topic",
+		WebhookTopicID:      "",
+	}
+}
+
+func TestDeadLetterRedriveAdapter_Redrive(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          string
+		publishErr    error
+		expectedErr   error
+		expectedTopic string
+		expectedJSON  string
+	}{
+		{
+			name:          "batch refresh trigger",
+			data:          `{"apiVersion":"v1","kind":"BatchRefreshTrigger","data":{"frequency":"WEEKLY"}}`,
+			publishErr:    nil,
+			expectedErr:   nil,
+			expectedTopic: "batch-topic",
+			expectedJSON:  `{"apiVersion":"v1","kind":"BatchRefreshTrigger","data":{"frequency":"WEEKLY"}}`,
+		},
+		{
+			name: "search configuration changed",
+			data: `{"apiVersion":"v1","kind":"SearchConfigurationChangedEvent","data":{
+				"search_id":"s1","search_name":"","query":"q","user_id":"u1",
+				"timestamp":"2025-01-01T00:00:00Z","is_creation":false,"frequency":"IMMEDIATE"}}`,
+			publishErr:    nil,
+			expectedErr:   nil,
+			expectedTopic: "ingestion-topic",
+			expectedJSON: `{"apiVersion":"v1","kind":"SearchConfigurationChangedEvent","data":{
+				"search_id":"s1","search_name":"","query":"q","user_id":"u1",
+				"timestamp":"2025-01-01T00:00:00Z","is_creation":false,"frequency":"IMMEDIATE"}}`,
+		},
+		{
+			name:          "unknown kind",
+			data:          `{"apiVersion":"v1","kind":"SomethingElse","data":{}}`,
+			publishErr:    nil,
+			expectedErr:   event.ErrNoHandler,
+			expectedTopic: "",
+			expectedJSON:  "",
+		},
+		{
+			name:          "invalid envelope",
+			data:          `not-json`,
+			publishErr:    nil,
+			expectedErr:   event.ErrInvalidEnvelope,
+			expectedTopic: "",
+			expectedJSON:  "",
+		},
+		{
+			name:          "no topic configured",
+			data:          `{"apiVersion":"v1","kind":"WebhookJobEvent","data":{}}`,
+			publishErr:    nil,
+			expectedErr:   ErrNoRedriveTopic,
+			expectedTopic: "",
+			expectedJSON:  "",
+		},
+		{
+			name:          "publish error",
+			data:          `{"apiVersion":"v1","kind":"BatchRefreshTrigger","data":{"frequency":"WEEKLY"}}`,
+			publishErr:    errPublish,
+			expectedErr:   errPublish,
+			expectedTopic: "batch-topic",
+			expectedJSON:  "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			publisher := new(mockPublisher)
+			publisher.err = tc.publishErr
+			adapter := NewDeadLetterRedriveAdapter(publisher, testRedriveTopics())
+
+			err := adapter.Redrive(context.Background(), deadletterv1.DeadLetterEvent{
+				MessageID:      "msg-1",
+				SubscriptionID: "sub",
+				WorkerID:       "worker",
+				ErrorClass:     deadletterv1.ErrorClassPermanent,
+				Error:          "boom",
+				AttemptCount:   1,
+				FailedAt:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+				Data:           []byte(tc.data),
+			})
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("Redrive() error = %v, want %v", err, tc.expectedErr)
+			}
+			if publisher.publishedTopic != tc.expectedTopic {
+				t.Errorf("Topic mismatch: got %q, want %q", publisher.publishedTopic, tc.expectedTopic)
+			}
+			if tc.expectedJSON == "" {
+				return
+			}
+
+			var actual, expected any
+			if err := json.Unmarshal(publisher.publishedData, &actual); err != nil {
+				t.Fatalf("failed to unmarshal published data: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tc.expectedJSON), &expected); err != nil {
+				t.Fatalf("failed to unmarshal expected data: %v", err)
+			}
+			if diff := cmp.Diff(expected, actual); diff != "" {
+				t.Errorf("Payload mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"cloud.google.com/go/spanner"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
 	"google.golang.org/api/iterator"
 )
 
@@ -75,6 +76,32 @@ func (c *Client) ListBaselineStatusCounts(
 	endAt time.Time,
 	pageSize int,
 	pageToken *string,
+) (*BaselineStatusCountResultPage, error) {
+	return c.listBaselineStatusCounts(ctx, nil, dateType, startAt, endAt, pageSize, pageToken)
+}
+
+// ListBaselineStatusCountsForSearch retrieves a cumulative count over time of the baseline features
+// that match the search node. A nil search node matches all features.
+func (c *Client) ListBaselineStatusCountsForSearch(
+	ctx context.Context,
+	searchNode *searchtypes.SearchNode,
+	dateType BaselineDateType,
+	startAt time.Time,
+	endAt time.Time,
+	pageSize int,
+	pageToken *string,
+) (*BaselineStatusCountResultPage, error) {
+	return c.listBaselineStatusCounts(ctx, searchNode, dateType, startAt, endAt, pageSize, pageToken)
+}
+
+func (c *Client) listBaselineStatusCounts(
+	ctx context.Context,
+	searchNode *searchtypes.SearchNode,
+	dateType BaselineDateType,
+	startAt time.Time,
+	endAt time.Time,
+	pageSize int,
+	pageToken *string,
 ) (*BaselineStatusCountResultPage, error) {
 	var parsedToken *baselineStatusCountCursor
 	var err error
@@ -102,15 +129,22 @@ func (c *Client) ListBaselineStatusCounts(
 		return nil, err
 	}
 
-	// 3. Calculate initial cumulative count
+	// 3. Get the features of the search, if any
+	includedFeatureIDs, err := c.getFeatureIDsForStatsSearch(ctx, txn, searchNode)
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Calculate initial cumulative count
 	cumulativeCount, err := c.getInitialBaselineStatusCount(
-		ctx, txn, parsedToken, startAt, ignoredFeatureIDs, dateType)
+		ctx, txn, parsedToken, startAt, ignoredFeatureIDs, includedFeatureIDs, dateType)
 	if err != nil {
 		return nil, errors.Join(ErrInternalQueryFailure, err)
 	}
 
-	// 4. Process results and update cumulative count
-	stmt := createListBaselineStatusCountsStatement(dateType, startAt, endAt, pageSize, parsedToken, ignoredFeatureIDs)
+	// 5. Process results and update cumulative count
+	stmt := createListBaselineStatusCountsStatement(
+		dateType, startAt, endAt, pageSize, parsedToken, ignoredFeatureIDs, includedFeatureIDs)
 
 	iter := txn.Query(ctx, stmt)
 	defer iter.Stop()
@@ -155,6 +189,7 @@ func (c *Client) getInitialBaselineStatusCount(
 	parsedToken *baselineStatusCountCursor,
 	startAt time.Time,
 	excludedFeatureIDs []string,
+	includedFeatureIDs []string,
 	dateType BaselineDateType,
 ) (int64, error) {
 	if parsedToken != nil {
@@ -171,6 +206,11 @@ func (c *Client) getInitialBaselineStatusCount(
 				AND fbs.WebFeatureID NOT IN UNNEST(@excludedFeatureIDs)`
 		params["excludedFeatureIDs"] = excludedFeatureIDs
 	}
+	if includedFeatureIDs != nil {
+		excludedFeatureFilter += `
+				AND fbs.WebFeatureID IN UNNEST(@includedFeatureIDs)`
+		params["includedFeatureIDs"] = includedFeatureIDs
+	}
 
 	// Construct the query based on dateType
 	var dateField string
@@ -207,6 +247,7 @@ func createListBaselineStatusCountsStatement(
 	pageSize int,
 	pageToken *baselineStatusCountCursor,
 	excludedFeatureIDs []string,
+	includedFeatureIDs []string,
 ) spanner.Statement {
 	params := map[string]any{
 		"startAt":  startAt,
@@ -230,6 +271,10 @@ func createListBaselineStatusCountsStatement(
 		excludedFeatureFilter = `AND fbs.WebFeatureID NOT IN UNNEST(@excludedFeatureIDs)`
 		params["excludedFeatureIDs"] = excludedFeatureIDs
 	}
+	if includedFeatureIDs != nil {
+		excludedFeatureFilter += ` AND fbs.WebFeatureID IN UNNEST(@includedFeatureIDs)`
+		params["includedFeatureIDs"] = includedFeatureIDs
+	}
 
 	// Construct the query based on dateType
 	var dateField string
@@ -19,6 +19,8 @@ import (
 	"reflect"
 	"testing"
 	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
 )
 
 func TestListBaselineStatusCounts_LowDate(t *testing.T) {
@@ -140,6 +142,98 @@ func TestListBaselineStatusCounts_ExcludedFeatures(t *testing.T) {
 	}
 }
 
+func TestListBaselineStatusCountsForSearch(t *testing.T) {
+	restartDatabaseContainer(t)
+	ctx := context.Background()
+	loadDataForListBaselineStatusCounts(ctx, t)
+
+	startAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	pageSize := 10
+
+	testCases := []struct {
+		name       string
+		searchNode *searchtypes.SearchNode
+		expected   *BaselineStatusCountResultPage
+	}{
+		{
+			name:       "only the matching features are counted",
+			searchNode: featureKeysSearchNode("FeatureA", "FeatureC", "FeatureD"),
+			expected: &BaselineStatusCountResultPage{
+				Metrics: []BaselineStatusCountMetric{
+					{Date: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC), StatusCount: 1},
+					{Date: time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC), StatusCount: 2},
+					{Date: time.Date(2024, 4, 25, 0, 0, 0, 0, time.UTC), StatusCount: 3},
+				},
+				NextPageToken: nil,
+			},
+		},
+		{
+			name:       "no matching features",
+			searchNode: featureKeysSearchNode("FeatureMissing"),
+			expected: &BaselineStatusCountResultPage{
+				Metrics:       nil,
+				NextPageToken: nil,
+			},
+		},
+		{
+			name:       "nil search node counts all features",
+			searchNode: nil,
+			expected: &BaselineStatusCountResultPage{
+				Metrics: []BaselineStatusCountMetric{
+					{Date: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC), StatusCount: 1},
+					{Date: time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC), StatusCount: 2},
+					{Date: time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC), StatusCount: 3},
+					{Date: time.Date(2024, 4, 25, 0, 0, 0, 0, time.UTC), StatusCount: 5},
+				},
+				NextPageToken: nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := spannerClient.ListBaselineStatusCountsForSearch(
+				ctx, tc.searchNode, BaselineDateTypeLow, startAt, endAt, pageSize, nil)
+			if err != nil {
+				t.Fatalf("ListBaselineStatusCountsForSearch failed: %v", err)
+			}
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Unexpected result. Got: %+v, Want: %+v", result, tc.expected)
+			}
+		})
+	}
+}
+
+// featureKeysSearchNode returns a search node that matches the features with the given keys.
+func featureKeysSearchNode(featureKeys ...string) *searchtypes.SearchNode {
+	children := make([]*searchtypes.SearchNode, 0, len(featureKeys))
+	for _, featureKey := range featureKeys {
+		children = append(children, &searchtypes.SearchNode{
+			Keyword: searchtypes.KeywordNone,
+			Term: &searchtypes.SearchTerm{
+				Identifier: searchtypes.IdentifierID,
+				Value:      featureKey,
+				Operator:   searchtypes.OperatorEq,
+			},
+			Children: nil,
+		})
+	}
+
+	return &searchtypes.SearchNode{
+		Keyword: searchtypes.KeywordRoot,
+		Term:    nil,
+		Children: []*searchtypes.SearchNode{
+			{
+				Keyword:  searchtypes.KeywordOR,
+				Term:     nil,
+				Children: children,
+			},
+		},
+	}
+}
+
 func loadDataForListBaselineStatusCounts(ctx context.Context, t *testing.T) {
 	// Insert web features
 	webFeatures := []WebFeature{
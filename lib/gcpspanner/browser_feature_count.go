@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"cloud.google.com/go/spanner"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
 	"google.golang.org/api/iterator"
 )
 
@@ -48,6 +49,36 @@ func (c *Client) ListBrowserFeatureCountMetric(
 	endAt time.Time,
 	pageSize int,
 	pageToken *string,
+) (*BrowserFeatureCountResultPage, error) {
+	return c.listBrowserFeatureCountMetric(
+		ctx, nil, targetBrowser, targetMobileBrowser, startAt, endAt, pageSize, pageToken)
+}
+
+// ListBrowserFeatureCountMetricForSearch retrieves a cumulative count over the releases of the browser
+// of the supported features that match the search node. A nil search node matches all features.
+func (c *Client) ListBrowserFeatureCountMetricForSearch(
+	ctx context.Context,
+	searchNode *searchtypes.SearchNode,
+	targetBrowser string,
+	targetMobileBrowser *string,
+	startAt time.Time,
+	endAt time.Time,
+	pageSize int,
+	pageToken *string,
+) (*BrowserFeatureCountResultPage, error) {
+	return c.listBrowserFeatureCountMetric(
+		ctx, searchNode, targetBrowser, targetMobileBrowser, startAt, endAt, pageSize, pageToken)
+}
+
+func (c *Client) listBrowserFeatureCountMetric(
+	ctx context.Context,
+	searchNode *searchtypes.SearchNode,
+	targetBrowser string,
+	targetMobileBrowser *string,
+	startAt time.Time,
+	endAt time.Time,
+	pageSize int,
+	pageToken *string,
 ) (*BrowserFeatureCountResultPage, error) {
 	var parsedToken *BrowserFeatureCountCursor
 	var err error
@@ -65,14 +96,19 @@ func (c *Client) ListBrowserFeatureCountMetric(
 	if err != nil {
 		return nil, err
 	}
-	// 2. Calculate initial cumulative count
+	// 2. Get the features of the search, if any
+	includedFeatureIDs, err := c.getFeatureIDsForStatsSearch(ctx, txn, searchNode)
+	if err != nil {
+		return nil, err
+	}
+	// 3. Calculate initial cumulative count
 	cumulativeCount, err := c.getInitialBrowserFeatureCount(
-		ctx, txn, parsedToken, targetBrowser, targetMobileBrowser, startAt, ignoredFeatureIDs)
+		ctx, txn, parsedToken, targetBrowser, targetMobileBrowser, startAt, ignoredFeatureIDs, includedFeatureIDs)
 	if err != nil {
 		return nil, errors.Join(ErrInternalQueryFailure, err)
 	}
 
-	// 3. Process results and update cumulative count
+	// 4. Process results and update cumulative count
 	stmt := createListBrowserFeatureCountMetricStatement(
 		targetBrowser,
 		targetMobileBrowser,
@@ -81,6 +117,7 @@ func (c *Client) ListBrowserFeatureCountMetric(
 		pageSize,
 		parsedToken,
 		ignoredFeatureIDs,
+		includedFeatureIDs,
 	)
 	it := txn.Query(ctx, stmt)
 	defer it.Stop()
@@ -125,7 +162,8 @@ func (c *Client) getInitialBrowserFeatureCount(
 	targetBrowser string,
 	targetMobileBrowser *string,
 	startAt time.Time,
-	excludedFeatureIDs []string) (int64, error) {
+	excludedFeatureIDs []string,
+	includedFeatureIDs []string) (int64, error) {
 	// For pagination, we have the existing count. Return early.
 	if parsedToken != nil {
 		return parsedToken.LastCumulativeCount, nil
@@ -142,6 +180,11 @@ func (c *Client) getInitialBrowserFeatureCount(
             AND bfa1.WebFeatureID NOT IN UNNEST(@excludedFeatureIDs)`
 		params["excludedFeatureIDs"] = excludedFeatureIDs
 	}
+	if includedFeatureIDs != nil {
+		excludedFeatureFilter += `
+            AND bfa1.WebFeatureID IN UNNEST(@includedFeatureIDs)`
+		params["includedFeatureIDs"] = includedFeatureIDs
+	}
 
 	var targetMobileBrowserFilter string
 	if targetMobileBrowser != nil {
@@ -189,6 +232,7 @@ func createListBrowserFeatureCountMetricStatement(
 	pageSize int,
 	pageToken *BrowserFeatureCountCursor,
 	excludedFeatureIDs []string,
+	includedFeatureIDs []string,
 ) spanner.Statement {
 
 	params := map[string]any{
@@ -210,6 +254,10 @@ func createListBrowserFeatureCountMetricStatement(
 		params["excludedFeatureIDs"] = excludedFeatureIDs
 		excludedFeatureFilter = "AND cf.WebFeatureID NOT IN UNNEST(@excludedFeatureIDs)"
 	}
+	if includedFeatureIDs != nil {
+		params["includedFeatureIDs"] = includedFeatureIDs
+		excludedFeatureFilter += " AND cf.WebFeatureID IN UNNEST(@includedFeatureIDs)"
+	}
 
 	var targetMobileBrowserFilter string
 	if targetMobileBrowser != nil {
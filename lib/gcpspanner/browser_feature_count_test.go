@@ -19,6 +19,8 @@ import (
 	"reflect"
 	"testing"
 	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
 )
 
 func loadDataForListBrowserFeatureCountMetric(ctx context.Context, t *testing.T, client *Client) {
@@ -36,6 +38,7 @@ func loadDataForListBrowserFeatureCountMetric(ctx context.Context, t *testing.T,
 		}
 	}
 
+	// nolint: exhaustruct // The releases do not need a status.
 	browserReleases := []BrowserRelease{
 		// Chrome Releases
 		{BrowserName: "chrome", BrowserVersion: "99", ReleaseDate: time.Date(2023, 12, 5, 0, 0, 0, 0, time.UTC)},
@@ -285,3 +288,74 @@ func TestListBrowserFeatureCountMetric(t *testing.T) {
 	}
 
 }
+
+func TestListBrowserFeatureCountMetricForSearch(t *testing.T) {
+	restartDatabaseContainer(t)
+	ctx := context.Background()
+
+	loadDataForListBrowserFeatureCountMetric(ctx, t, spannerClient)
+
+	startAt := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	endAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		testName            string
+		searchNode          *searchtypes.SearchNode
+		targetMobileBrowser *string
+		expectedResult      *BrowserFeatureCountResultPage
+	}{
+		{
+			testName:            "only the matching features are counted",
+			searchNode:          featureKeysSearchNode("FeatureX", "FeatureZ"),
+			targetMobileBrowser: nil,
+			expectedResult: &BrowserFeatureCountResultPage{
+				NextPageToken: nil,
+				Metrics: []BrowserFeatureCountMetric{
+					{ReleaseDate: time.Date(2023, 12, 5, 0, 0, 0, 0, time.UTC), FeatureCount: 0},
+					{ReleaseDate: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC), FeatureCount: 1},
+					{ReleaseDate: time.Date(2024, 4, 5, 0, 0, 0, 0, time.UTC), FeatureCount: 2},
+				},
+			},
+		},
+		{
+			testName:            "only the matching features are counted with the mobile browser",
+			searchNode:          featureKeysSearchNode("FeatureX", "FeatureZ"),
+			targetMobileBrowser: new("chrome_android"),
+			expectedResult: &BrowserFeatureCountResultPage{
+				NextPageToken: nil,
+				Metrics: []BrowserFeatureCountMetric{
+					{ReleaseDate: time.Date(2023, 12, 5, 0, 0, 0, 0, time.UTC), FeatureCount: 0},
+					{ReleaseDate: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC), FeatureCount: 1},
+					{ReleaseDate: time.Date(2024, 4, 5, 0, 0, 0, 0, time.UTC), FeatureCount: 1},
+				},
+			},
+		},
+		{
+			testName:            "no matching features",
+			searchNode:          featureKeysSearchNode("FeatureMissing"),
+			targetMobileBrowser: nil,
+			expectedResult: &BrowserFeatureCountResultPage{
+				NextPageToken: nil,
+				Metrics: []BrowserFeatureCountMetric{
+					{ReleaseDate: time.Date(2023, 12, 5, 0, 0, 0, 0, time.UTC), FeatureCount: 0},
+					{ReleaseDate: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC), FeatureCount: 0},
+					{ReleaseDate: time.Date(2024, 4, 5, 0, 0, 0, 0, time.UTC), FeatureCount: 0},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.testName, func(t *testing.T) {
+			result, err := spannerClient.ListBrowserFeatureCountMetricForSearch(
+				ctx, tc.searchNode, "chrome", tc.targetMobileBrowser, startAt, endAt, 100, nil)
+			if err != nil {
+				t.Fatalf("ListBrowserFeatureCountMetricForSearch failed: %v", err)
+			}
+
+			if !reflect.DeepEqual(result, tc.expectedResult) {
+				t.Errorf("unexpected result. got %+v, want %+v", result, tc.expectedResult)
+			}
+		})
+	}
+}
@@ -45,6 +45,7 @@ func setupTablesForPrecalculateBrowserFeatureSupportEvents(
 	}
 
 	// 2. Insert sample data into BrowserReleases
+	// nolint: exhaustruct // The releases do not need a status.
 	releases := []BrowserRelease{
 		{BrowserName: "Chrome", BrowserVersion: "110", ReleaseDate: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)},
 		{BrowserName: "Chrome", BrowserVersion: "111", ReleaseDate: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
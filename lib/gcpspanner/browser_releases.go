@@ -16,10 +16,13 @@ package gcpspanner
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
 	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
 )
 
 const browserReleasesTable = "BrowserReleases"
@@ -36,6 +39,8 @@ type BrowserRelease struct {
 	BrowserName    string    `spanner:"BrowserName"`
 	BrowserVersion string    `spanner:"BrowserVersion"`
 	ReleaseDate    time.Time `spanner:"ReleaseDate"`
+	// ReleaseStatus is the BCD status of the release, such as current or esr.
+	ReleaseStatus *string `spanner:"ReleaseStatus"`
 }
 
 // Equal returns true if all fields of two BrowserRelease structs match, using
@@ -43,7 +48,8 @@ type BrowserRelease struct {
 func (r BrowserRelease) Equal(other BrowserRelease) bool {
 	return r.BrowserName == other.BrowserName &&
 		r.BrowserVersion == other.BrowserVersion &&
-		r.ReleaseDate.Equal(other.ReleaseDate)
+		r.ReleaseDate.Equal(other.ReleaseDate) &&
+		reflect.DeepEqual(r.ReleaseStatus, other.ReleaseStatus)
 }
 
 type browserReleaseKey struct {
@@ -57,7 +63,7 @@ type browserReleaseSpannerMapper struct{}
 func (m browserReleaseSpannerMapper) SelectOne(key browserReleaseKey) spanner.Statement {
 	stmt := spanner.NewStatement(fmt.Sprintf(`
 	SELECT
-		BrowserName, BrowserVersion, ReleaseDate
+		BrowserName, BrowserVersion, ReleaseDate, ReleaseStatus
 	FROM %s
 	WHERE BrowserName = @browserName AND BrowserVersion = @browserVersion
 	LIMIT 1`, m.Table()))
@@ -72,6 +78,7 @@ func (m browserReleaseSpannerMapper) SelectOne(key browserReleaseKey) spanner.St
 
 func (m browserReleaseSpannerMapper) Merge(input BrowserRelease, existing spannerBrowserRelease) spannerBrowserRelease {
 	existing.ReleaseDate = input.ReleaseDate
+	existing.ReleaseStatus = input.ReleaseStatus
 
 	return existing
 }
@@ -99,6 +106,7 @@ func (c *Client) fetchAllBrowserReleasesWithTransaction(
 		"BrowserName",
 		"BrowserVersion",
 		"ReleaseDate",
+		"ReleaseStatus",
 	})
 	defer iter.Stop()
 	err := iter.Do(func(row *spanner.Row) error {
@@ -116,3 +124,38 @@ func (c *Client) fetchAllBrowserReleasesWithTransaction(
 
 	return releases, nil
 }
+
+// GetOldestBrowserReleaseVersionWithStatus returns the version of the oldest release of the browser that has the
+// given status. Several releases can share a status, like the Firefox ESR releases while their support overlaps.
+// Returns ErrQueryReturnedNoResults if no release of the browser has the status.
+func (c *Client) GetOldestBrowserReleaseVersionWithStatus(
+	ctx context.Context, browserName string, status string) (string, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`
+	SELECT BrowserVersion
+	FROM %s
+	WHERE BrowserName = @browserName AND ReleaseStatus = @status
+	ORDER BY ReleaseDate ASC
+	LIMIT 1`, browserReleasesTable),
+		Params: map[string]any{
+			"browserName": browserName,
+			"status":      status,
+		},
+	}
+	it := c.Single().Query(ctx, stmt)
+	defer it.Stop()
+	row, err := it.Next()
+	if err != nil {
+		if errors.Is(err, iterator.Done) {
+			return "", errors.Join(ErrQueryReturnedNoResults, err)
+		}
+
+		return "", errors.Join(ErrInternalQueryFailure, err)
+	}
+	var version string
+	if err := row.Column(0, &version); err != nil {
+		return "", errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return version, nil
+}
@@ -32,31 +32,37 @@ func getSampleBrowserReleases() []BrowserRelease {
 			BrowserName:    "fooBrowser",
 			BrowserVersion: "0.0.0",
 			ReleaseDate:    time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			ReleaseStatus:  new("retired"),
 		},
 		{
 			BrowserName:    "barBrowser",
 			BrowserVersion: "0.0.0",
 			ReleaseDate:    time.Date(2000, time.January, 2, 0, 0, 0, 0, time.UTC),
+			ReleaseStatus:  nil,
 		},
 		{
 			BrowserName:    "fooBrowser",
 			BrowserVersion: "1.0.0",
 			ReleaseDate:    time.Date(2000, time.February, 1, 0, 0, 0, 0, time.UTC),
+			ReleaseStatus:  new("retired"),
 		},
 		{
 			BrowserName:    "barBrowser",
 			BrowserVersion: "1.0.0",
 			ReleaseDate:    time.Date(2000, time.February, 2, 0, 0, 0, 0, time.UTC),
+			ReleaseStatus:  new("esr"),
 		},
 		{
 			BrowserName:    "fooBrowser",
 			BrowserVersion: "2.0.0",
 			ReleaseDate:    time.Date(2000, time.March, 1, 0, 0, 0, 0, time.UTC),
+			ReleaseStatus:  new("current"),
 		},
 		{
 			BrowserName:    "barBrowser",
 			BrowserVersion: "2.0.0",
 			ReleaseDate:    time.Date(2000, time.March, 2, 0, 0, 0, 0, time.UTC),
+			ReleaseStatus:  new("esr"),
 		},
 	}
 }
@@ -65,7 +71,7 @@ func getSampleBrowserReleases() []BrowserRelease {
 // nolint: lll
 func (c *Client) ReadAllBrowserReleases(ctx context.Context, _ *testing.T) ([]BrowserRelease, error) {
 	stmt := spanner.NewStatement(
-		"SELECT BrowserName, BrowserVersion, ReleaseDate FROM BrowserReleases ORDER BY ReleaseDate ASC",
+		"SELECT BrowserName, BrowserVersion, ReleaseDate, ReleaseStatus FROM BrowserReleases ORDER BY ReleaseDate ASC",
 	)
 	iter := c.Single().Query(ctx, stmt)
 	defer iter.Stop()
@@ -108,11 +114,12 @@ func TestInsertBrowserRelease(t *testing.T) {
 		t.Errorf("unequal releases. expected %+v actual %+v", sampleBrowserReleases, releases)
 	}
 
-	// Verify that updating a release date for an existing browser release updates ReleaseDate in Spanner
+	// Verify that updating an existing browser release updates ReleaseDate and ReleaseStatus in Spanner
 	updatedRelease := BrowserRelease{
 		BrowserName:    "fooBrowser",
 		BrowserVersion: "0.0.0",
 		ReleaseDate:    time.Date(2000, time.January, 15, 0, 0, 0, 0, time.UTC),
+		ReleaseStatus:  new("esr"),
 	}
 	err = spannerClient.InsertBrowserRelease(ctx, updatedRelease)
 	if err != nil {
@@ -128,3 +135,55 @@ func TestInsertBrowserRelease(t *testing.T) {
 		t.Errorf("expected to find updated release %+v in Spanner: got %+v", updatedRelease, releases)
 	}
 }
+
+func TestGetOldestBrowserReleaseVersionWithStatus(t *testing.T) {
+	restartDatabaseContainer(t)
+	ctx := context.Background()
+	for _, release := range getSampleBrowserReleases() {
+		err := spannerClient.InsertBrowserRelease(ctx, release)
+		if err != nil {
+			t.Fatalf("unexpected error during insert. %s", err.Error())
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		browserName     string
+		status          string
+		expectedVersion string
+		expectedError   error
+	}{
+		{
+			name:            "single release with the status",
+			browserName:     "fooBrowser",
+			status:          "current",
+			expectedVersion: "2.0.0",
+			expectedError:   nil,
+		},
+		{
+			name:            "oldest of several releases with the status",
+			browserName:     "barBrowser",
+			status:          "esr",
+			expectedVersion: "1.0.0",
+			expectedError:   nil,
+		},
+		{
+			name:            "no release with the status",
+			browserName:     "fooBrowser",
+			status:          "esr",
+			expectedVersion: "",
+			expectedError:   ErrQueryReturnedNoResults,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			version, err := spannerClient.GetOldestBrowserReleaseVersionWithStatus(ctx, tc.browserName, tc.status)
+			if !errors.Is(err, tc.expectedError) {
+				t.Fatalf("unexpected error. expected %v actual %v", tc.expectedError, err)
+			}
+			if version != tc.expectedVersion {
+				t.Errorf("unexpected version. expected %s actual %s", tc.expectedVersion, version)
+			}
+		})
+	}
+}
@@ -28,18 +28,25 @@ type CreateUserSavedSearchRequest struct {
 	Query       string
 	OwnerUserID string
 	Description *string
+	Tags        []string
+	Listed      bool
+	// QueryParameters declares the variables used in Query.
+	QueryParameters []SavedSearchQueryParameter
 }
 
 func (m savedSearchMapper) NewEntity(id string, req CreateUserSavedSearchRequest) (SavedSearch, error) {
 	return SavedSearch{
-		ID:          id,
-		Name:        req.Name,
-		Query:       req.Query,
-		Description: req.Description,
-		Scope:       UserPublicScope,
-		AuthorID:    req.OwnerUserID,
-		CreatedAt:   spanner.CommitTimestamp,
-		UpdatedAt:   spanner.CommitTimestamp,
+		ID:              id,
+		Name:            req.Name,
+		Query:           req.Query,
+		Description:     req.Description,
+		Scope:           UserPublicScope,
+		AuthorID:        req.OwnerUserID,
+		CreatedAt:       spanner.CommitTimestamp,
+		UpdatedAt:       spanner.CommitTimestamp,
+		Tags:            req.Tags,
+		Listed:          req.Listed,
+		QueryParameters: savedSearchQueryParametersJSON(req.QueryParameters),
 	}, nil
 }
 
@@ -70,70 +77,87 @@ func (c *Client) createNewUserSavedSearch(
 	opts ...CreateOption) (*string, error) {
 	var newID *string
 	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
-		// 1. Read the current count of owned searches
-		var count int64
-		stmt := spanner.Statement{
-			SQL: fmt.Sprintf(`SELECT COUNT(*)
+		var err error
+		newID, err = c.createNewUserSavedSearchWithTransaction(ctx, txn, newSearch, opts...)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newID, nil
+}
+
+func (c *Client) createNewUserSavedSearchWithTransaction(
+	ctx context.Context,
+	txn *spanner.ReadWriteTransaction,
+	newSearch CreateUserSavedSearchRequest,
+	opts ...CreateOption) (*string, error) {
+	// 1. Read the current count of owned searches
+	var count int64
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT COUNT(*)
                   FROM %s
                   WHERE UserID = @OwnerID AND UserRole = @Role`, savedSearchUserRolesTable),
-			Params: map[string]any{
-				"OwnerID": newSearch.OwnerUserID,
-				"Role":    SavedSearchOwner,
-			},
-		}
-		row, err := txn.Query(ctx, stmt).Next()
-		if err != nil {
-			return err
-		}
-		if err := row.Columns(&count); err != nil {
-			return err
-		}
-
-		// 2. Check against the limit
-		if count >= int64(c.searchCfg.maxOwnedSearchesPerUser) {
-			return ErrOwnerSavedSearchLimitExceeded
-		}
-
-		// 3. Create the SavedSearch entity using the generic creator
-		createdID, err := newEntityCreator[savedSearchMapper, CreateUserSavedSearchRequest, SavedSearch](c).
-			createWithTransaction(ctx, txn, newSearch, opts...)
-		if err != nil {
-			return err
-		}
-		newID = createdID
-
-		var mutations []*spanner.Mutation
-		// 4. Create the associated UserRole and Bookmark
-		m2, err := spanner.InsertStruct(savedSearchUserRolesTable, SavedSearchUserRole{
-			SavedSearchID: *newID,
-			UserID:        newSearch.OwnerUserID,
-			UserRole:      SavedSearchOwner,
-		})
-		if err != nil {
-			return errors.Join(ErrInternalQueryFailure, err)
-		}
-		mutations = append(mutations, m2)
-
-		m3, err := spanner.InsertStruct(userSavedSearchBookmarksTable, UserSavedSearchBookmark{
-			SavedSearchID: *newID,
-			UserID:        newSearch.OwnerUserID,
-		})
-		if err != nil {
-			return errors.Join(ErrInternalQueryFailure, err)
-		}
-		mutations = append(mutations, m3)
-
-		err = txn.BufferWrite(mutations)
-		if err != nil {
-			return errors.Join(ErrInternalQueryFailure, err)
-		}
-
-		return nil
+		Params: map[string]any{
+			"OwnerID": newSearch.OwnerUserID,
+			"Role":    SavedSearchOwner,
+		},
+	}
+	row, err := txn.Query(ctx, stmt).Next()
+	if err != nil {
+		return nil, err
+	}
+	if err := row.Columns(&count); err != nil {
+		return nil, err
+	}
+
+	// 2. Check against the limit
+	if count >= int64(c.searchCfg.maxOwnedSearchesPerUser) {
+		return nil, ErrOwnerSavedSearchLimitExceeded
+	}
+
+	// 3. Create the SavedSearch entity using the generic creator
+	newID, err := newEntityCreator[savedSearchMapper, CreateUserSavedSearchRequest, SavedSearch](c).
+		createWithTransaction(ctx, txn, newSearch, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var mutations []*spanner.Mutation
+	// 4. Create the associated UserRole and Bookmark
+	m2, err := spanner.InsertStruct(savedSearchUserRolesTable, SavedSearchUserRole{
+		SavedSearchID: *newID,
+		UserID:        newSearch.OwnerUserID,
+		UserRole:      SavedSearchOwner,
 	})
+	if err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+	mutations = append(mutations, m2)
+
+	m3, err := spanner.InsertStruct(userSavedSearchBookmarksTable, UserSavedSearchBookmark{
+		SavedSearchID: *newID,
+		UserID:        newSearch.OwnerUserID,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+	mutations = append(mutations, m3)
 
+	// 5. Record the first revision
+	m4, err := newSavedSearchRevisionMutation(*newID, newSearch)
 	if err != nil {
 		return nil, err
 	}
+	mutations = append(mutations, m4)
+
+	err = txn.BufferWrite(mutations)
+	if err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
 
 	return newID, nil
 }
@@ -28,10 +28,13 @@ func TestCreateNewUserSavedSearch(t *testing.T) {
 
 	t.Run("create fails after reaching limit", func(t *testing.T) {
 		savedSearchID1, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-			Name:        "my little search",
-			Query:       "group:css",
-			OwnerUserID: "userID1",
-			Description: new("description1"),
+			Name:            "my little search",
+			Query:           "group:css",
+			OwnerUserID:     "userID1",
+			Description:     new("description1"),
+			Tags:            nil,
+			Listed:          false,
+			QueryParameters: nil,
 		})
 		if err != nil {
 			t.Errorf("expected nil error. received %s", err)
@@ -41,10 +44,13 @@ func TestCreateNewUserSavedSearch(t *testing.T) {
 		}
 
 		savedSearchID2, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-			Name:        "my little search part 2",
-			Query:       "group:avif",
-			OwnerUserID: "userID1",
-			Description: new("description2"),
+			Name:            "my little search part 2",
+			Query:           "group:avif",
+			OwnerUserID:     "userID1",
+			Description:     new("description2"),
+			Tags:            nil,
+			Listed:          false,
+			QueryParameters: nil,
 		})
 		if err != nil {
 			t.Errorf("expected nil error. received %s", err)
@@ -54,10 +60,13 @@ func TestCreateNewUserSavedSearch(t *testing.T) {
 		}
 
 		savedSearchID3, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-			Name:        "my little search part 3",
-			Query:       "name:subgrid",
-			OwnerUserID: "userID1",
-			Description: new("description3"),
+			Name:            "my little search part 3",
+			Query:           "name:subgrid",
+			OwnerUserID:     "userID1",
+			Description:     new("description3"),
+			Tags:            nil,
+			Listed:          false,
+			QueryParameters: nil,
 		})
 		if !errors.Is(err, ErrOwnerSavedSearchLimitExceeded) {
 			t.Errorf("unexpected error. received %v", err)
@@ -16,11 +16,16 @@ package gcpspanner
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 
 	"cloud.google.com/go/spanner"
 )
 
+// ErrSavedSearchReferenced indicates the saved search is referenced by the query of other saved searches.
+var ErrSavedSearchReferenced = errors.New("saved search is referenced by other saved searches")
+
 // removeUserSavedSearchMapper implements removableEntityMapper.
 type removeUserSavedSearchMapper struct{}
 
@@ -52,9 +57,13 @@ func (m removeUserSavedSearchMapper) DeleteKey(key removeUserSavedSearchMapperKe
 type DeleteUserSavedSearchRequest struct {
 	RequestingUserID string
 	SavedSearchID    string
+	// Force deletes the saved search even if other saved searches reference it.
+	// Those saved searches will fail to expand until their queries are fixed.
+	Force bool
 }
 
 // DeleteUserSavedSearch deletes a user's saved search.
+// It returns ErrSavedSearchReferenced if other saved searches reference it, unless Force is set.
 func (c *Client) DeleteUserSavedSearch(ctx context.Context, req DeleteUserSavedSearchRequest) error {
 	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
 		// 1. Check if the saved search exists
@@ -74,7 +83,18 @@ func (c *Client) DeleteUserSavedSearch(ctx context.Context, req DeleteUserSavedS
 			return err
 		}
 
-		// 2. Read and update the existing saved search
+		// 3. Check that no other saved search would break
+		if !req.Force {
+			referencingIDs, err := c.getReferencingSavedSearchIDsWithTransaction(ctx, txn, req.SavedSearchID)
+			if err != nil {
+				return err
+			}
+			if len(referencingIDs) > 0 {
+				return fmt.Errorf("%w: %d saved searches", ErrSavedSearchReferenced, len(referencingIDs))
+			}
+		}
+
+		// 4. Remove the existing saved search
 		err = newEntityRemover[removeUserSavedSearchMapper, UserSavedSearch](c).removeWithTransaction(ctx, txn, req)
 		if err != nil {
 			slog.ErrorContext(ctx, "failed to update the saved search", "error", err)
@@ -27,10 +27,13 @@ func TestDeleteUserSavedSearch(t *testing.T) {
 	ctx := context.Background()
 
 	savedSearchID, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-		Name:        "my little search",
-		Query:       "group:css",
-		OwnerUserID: "userID1",
-		Description: new("desc"),
+		Name:            "my little search",
+		Query:           "group:css",
+		OwnerUserID:     "userID1",
+		Description:     new("desc"),
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
 	})
 	if err != nil {
 		t.Errorf("expected nil error. received %s", err)
@@ -43,6 +46,7 @@ func TestDeleteUserSavedSearch(t *testing.T) {
 		err := spannerClient.DeleteUserSavedSearch(ctx, DeleteUserSavedSearchRequest{
 			SavedSearchID:    *savedSearchID,
 			RequestingUserID: "userID2",
+			Force:            false,
 		})
 		if !errors.Is(err, ErrMissingRequiredRole) {
 			t.Errorf("expected ErrMissingRequiredRole. received %s", err)
@@ -59,8 +63,11 @@ func TestDeleteUserSavedSearch(t *testing.T) {
 				AuthorID:    "userID1",
 				Description: new("desc"),
 				// Don't actually compare the last two values.
-				CreatedAt: spanner.CommitTimestamp,
-				UpdatedAt: spanner.CommitTimestamp,
+				CreatedAt:       spanner.CommitTimestamp,
+				UpdatedAt:       spanner.CommitTimestamp,
+				Tags:            nil,
+				Listed:          false,
+				QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 			},
 		}
 		actual, err := spannerClient.GetUserSavedSearch(ctx, *savedSearchID, nil)
@@ -76,6 +83,7 @@ func TestDeleteUserSavedSearch(t *testing.T) {
 		err := spannerClient.DeleteUserSavedSearch(ctx, DeleteUserSavedSearchRequest{
 			SavedSearchID:    *savedSearchID,
 			RequestingUserID: "userID1",
+			Force:            false,
 		})
 		if !errors.Is(err, nil) {
 			t.Errorf("expected nil error. received %s", err)
@@ -90,12 +98,79 @@ func TestDeleteUserSavedSearch(t *testing.T) {
 		err := spannerClient.DeleteUserSavedSearch(ctx, DeleteUserSavedSearchRequest{
 			RequestingUserID: "userID1",
 			SavedSearchID:    "bad-id",
+			Force:            false,
 		})
 		if !errors.Is(err, ErrQueryReturnedNoResults) {
 			t.Errorf("expected ErrQueryReturnedNoResults. received %s", err)
 		}
 	})
 
+	t.Run("referenced search requires force", func(t *testing.T) {
+		referencedID, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
+			Name:            "referenced search",
+			Query:           "group:css",
+			OwnerUserID:     "userID1",
+			Description:     nil,
+			Tags:            nil,
+			Listed:          false,
+			QueryParameters: nil,
+		})
+		if err != nil {
+			t.Fatalf("expected nil error. received %s", err)
+		}
+		_, err = spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
+			Name:            "referencing search",
+			Query:           "saved:" + *referencedID + " baseline_status:widely",
+			OwnerUserID:     "userID2",
+			Description:     nil,
+			Tags:            nil,
+			Listed:          false,
+			QueryParameters: nil,
+		})
+		if err != nil {
+			t.Fatalf("expected nil error. received %s", err)
+		}
+
+		err = spannerClient.DeleteUserSavedSearch(ctx, DeleteUserSavedSearchRequest{
+			SavedSearchID:    *referencedID,
+			RequestingUserID: "userID1",
+			Force:            false,
+		})
+		if !errors.Is(err, ErrSavedSearchReferenced) {
+			t.Errorf("expected ErrSavedSearchReferenced. received %v", err)
+		}
+
+		err = spannerClient.DeleteUserSavedSearch(ctx, DeleteUserSavedSearchRequest{
+			SavedSearchID:    *referencedID,
+			RequestingUserID: "userID1",
+			Force:            true,
+		})
+		if err != nil {
+			t.Errorf("expected nil error. received %s", err)
+		}
+	})
+
+	t.Run("hotlist referencing itself can be deleted", func(t *testing.T) {
+		hotlistID, err := spannerClient.CreateUserHotlist(ctx, CreateUserHotlistRequest{
+			Name:        "my hotlist",
+			Description: nil,
+			OwnerUserID: "userID1",
+			FeatureKeys: nil,
+		})
+		if err != nil {
+			t.Fatalf("expected nil error. received %s", err)
+		}
+
+		err = spannerClient.DeleteUserSavedSearch(ctx, DeleteUserSavedSearchRequest{
+			SavedSearchID:    *hotlistID,
+			RequestingUserID: "userID1",
+			Force:            false,
+		})
+		if err != nil {
+			t.Errorf("expected nil error. received %s", err)
+		}
+	})
+
 	t.Run("SYSTEM_MANAGED search cannot be deleted", func(t *testing.T) {
 		// 1. Create a system managed search directly in the DB.
 		systemSearchID := "system-search"
@@ -114,6 +189,7 @@ func TestDeleteUserSavedSearch(t *testing.T) {
 		err = spannerClient.DeleteUserSavedSearch(ctx, DeleteUserSavedSearchRequest{
 			RequestingUserID: "userID1",
 			SavedSearchID:    systemSearchID,
+			Force:            false,
 		})
 
 		// 3. Verify it fails with 'not found' because the mapper filters it out.
@@ -21,6 +21,7 @@ import (
 	"log/slog"
 	"math/big"
 	"slices"
+	"strings"
 	"time"
 
 	"cloud.google.com/go/spanner"
@@ -204,6 +205,43 @@ func (c *Client) getTotalFeatureCount(
 	return count, nil
 }
 
+// getFeatureIDsForStatsSearch returns the IDs of the features that match the search node so that stats
+// can be restricted to them. It returns nil, meaning no restriction, if there is no search node.
+func (c *Client) getFeatureIDsForStatsSearch(
+	ctx context.Context,
+	txn *spanner.ReadOnlyTransaction,
+	searchNode *searchtypes.SearchNode) ([]string, error) {
+	if searchNode == nil {
+		return nil, nil
+	}
+	filter, err := NewFeatureSearchFilterBuilder().Build(searchNode)
+	if err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+	filters := append(defaultFeatureSearchFilters(), filter.Filters()...)
+
+	stmt := spanner.NewStatement(
+		"SELECT wf.ID" + commonFSCountBaseQueryTemplate + "WHERE " + strings.Join(filters, " AND "))
+	stmt.Params = filter.Params()
+
+	// Never nil, so that a search without matches restricts the stats to no features.
+	featureIDs := []string{}
+	err = txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		var featureID string
+		if err := row.Columns(&featureID); err != nil {
+			return err
+		}
+		featureIDs = append(featureIDs, featureID)
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return featureIDs, nil
+}
+
 func (c *Client) getFeatureResult(
 	ctx context.Context,
 	queryBuilder FeatureSearchQueryBuilder,
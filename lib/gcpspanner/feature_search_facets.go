@@ -0,0 +1,154 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/spanner"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
+)
+
+// FeatureSearchFacet is a dimension over which the results of a features search can be counted.
+type FeatureSearchFacet string
+
+const (
+	// FeatureSearchFacetBaselineStatus counts the features by their BaselineStatus.
+	FeatureSearchFacetBaselineStatus FeatureSearchFacet = "baseline_status"
+	// FeatureSearchFacetAvailableOn counts the features available on each browser.
+	FeatureSearchFacetAvailableOn FeatureSearchFacet = "available_on"
+	// FeatureSearchFacetGroup counts the features in each group, including the features of descendant groups.
+	FeatureSearchFacetGroup FeatureSearchFacet = "group"
+)
+
+// ErrUnknownFeatureSearchFacet indicates a facet that cannot be counted.
+var ErrUnknownFeatureSearchFacet = errors.New("unknown feature search facet")
+
+// FeatureSearchFacetCount is the number of features of a search with the given value of a facet.
+type FeatureSearchFacetCount struct {
+	Facet FeatureSearchFacet `spanner:"Facet"`
+	Value string             `spanner:"Value"`
+	Count int64              `spanner:"Count"`
+}
+
+// featureSearchFacetSubquery returns the subquery that counts the matched features for a facet.
+// Each subquery reads from the MatchedFeatures common table expression.
+func featureSearchFacetSubquery(facet FeatureSearchFacet) (string, error) {
+	switch facet {
+	case FeatureSearchFacetBaselineStatus:
+		return `
+	SELECT 'baseline_status' AS Facet, mf.Status AS Value, COUNT(*) AS Count
+	FROM MatchedFeatures mf
+	WHERE mf.Status IS NOT NULL
+	GROUP BY mf.Status`, nil
+	case FeatureSearchFacetAvailableOn:
+		return `
+	SELECT 'available_on' AS Facet, bfa.BrowserName AS Value, COUNT(*) AS Count
+	FROM MatchedFeatures mf
+	JOIN BrowserFeatureAvailabilities bfa ON mf.ID = bfa.WebFeatureID
+	GROUP BY bfa.BrowserName`, nil
+	case FeatureSearchFacetGroup:
+		// FeatureGroupKeysLookup links a feature to its group and every ancestor of that group,
+		// matching the behavior of the group search term.
+		return `
+	SELECT 'group' AS Facet, fgkl.GroupKey_Lowercase AS Value, COUNT(*) AS Count
+	FROM MatchedFeatures mf
+	JOIN FeatureGroupKeysLookup fgkl ON mf.ID = fgkl.WebFeatureID
+	GROUP BY fgkl.GroupKey_Lowercase`, nil
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrUnknownFeatureSearchFacet, facet)
+}
+
+// createFeatureSearchFacetCountsStatement creates a single statement that counts the features matched by the
+// filter for every requested facet.
+func createFeatureSearchFacetCountsStatement(
+	filter *FeatureSearchCompiledFilter, facets []FeatureSearchFacet) (spanner.Statement, error) {
+	subqueries := make([]string, 0, len(facets))
+	for _, facet := range facets {
+		subquery, err := featureSearchFacetSubquery(facet)
+		if err != nil {
+			return spanner.Statement{}, err
+		}
+		subqueries = append(subqueries, "("+subquery+"\n)")
+	}
+
+	filters := defaultFeatureSearchFilters()
+	params := map[string]any{}
+	if filter != nil {
+		filters = append(filters, filter.Filters()...)
+		params = filter.Params()
+	}
+
+	query := `
+WITH MatchedFeatures AS (
+	SELECT wf.ID, fbs.Status` + commonFSCountBaseQueryTemplate + `
+	WHERE ` + strings.Join(filters, " AND ") + `
+)
+SELECT Facet, Value, Count
+FROM (` + strings.Join(subqueries, "\nUNION ALL\n") + `)
+ORDER BY Facet, Count DESC, Value`
+
+	stmt := spanner.NewStatement(query)
+	stmt.Params = params
+
+	return stmt, nil
+}
+
+// FeaturesSearchFacetCounts counts the features that match the search node for each requested facet,
+// in a single query. The counts are ordered by facet, then by descending count.
+func (c *Client) FeaturesSearchFacetCounts(
+	ctx context.Context,
+	searchNode *searchtypes.SearchNode,
+	facets []FeatureSearchFacet,
+) ([]FeatureSearchFacetCount, error) {
+	if len(facets) == 0 {
+		return []FeatureSearchFacetCount{}, nil
+	}
+
+	filter, err := NewFeatureSearchFilterBuilder().Build(searchNode)
+	if errors.Is(err, ErrNilSearchNode) {
+		filter = nil
+	} else if err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	stmt, err := createFeatureSearchFacetCountsStatement(filter, facets)
+	if err != nil {
+		return nil, err
+	}
+
+	txn := c.Single()
+	defer txn.Close()
+
+	counts := []FeatureSearchFacetCount{}
+	err = txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		var count FeatureSearchFacetCount
+		if err := row.ToStruct(&count); err != nil {
+			return err
+		}
+		counts = append(counts, count)
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return counts, nil
+}
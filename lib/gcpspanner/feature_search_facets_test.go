@@ -0,0 +1,123 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
+)
+
+func TestFeaturesSearchFacetCounts(t *testing.T) {
+	restartDatabaseContainer(t)
+	ctx := context.Background()
+	setupRequiredTablesForFeaturesSearch(ctx, spannerClient, t)
+
+	allFacets := []FeatureSearchFacet{
+		FeatureSearchFacetBaselineStatus,
+		FeatureSearchFacetAvailableOn,
+		FeatureSearchFacetGroup,
+	}
+
+	testCases := []struct {
+		name     string
+		node     *searchtypes.SearchNode
+		facets   []FeatureSearchFacet
+		expected []FeatureSearchFacetCount
+	}{
+		{
+			name:   "all features",
+			node:   nil,
+			facets: allFacets,
+			// feature5 is excluded.
+			expected: []FeatureSearchFacetCount{
+				{Facet: FeatureSearchFacetAvailableOn, Value: "barBrowser", Count: 2},
+				{Facet: FeatureSearchFacetAvailableOn, Value: "fooBrowser", Count: 2},
+				{Facet: FeatureSearchFacetBaselineStatus, Value: string(BaselineStatusHigh), Count: 1},
+				{Facet: FeatureSearchFacetBaselineStatus, Value: string(BaselineStatusLow), Count: 1},
+				{Facet: FeatureSearchFacetBaselineStatus, Value: string(BaselineStatusNone), Count: 1},
+				// feature3 belongs to child3, whose parent is parent1.
+				{Facet: FeatureSearchFacetGroup, Value: "parent1", Count: 2},
+				{Facet: FeatureSearchFacetGroup, Value: "child3", Count: 1},
+				{Facet: FeatureSearchFacetGroup, Value: "parent2", Count: 1},
+			},
+		},
+		{
+			name: "restricted to the features of the search",
+			node: &searchtypes.SearchNode{
+				Keyword: searchtypes.KeywordRoot,
+				Term:    nil,
+				Children: []*searchtypes.SearchNode{
+					{
+						Keyword: searchtypes.KeywordNone,
+						Term: &searchtypes.SearchTerm{
+							Identifier: searchtypes.IdentifierAvailableOn,
+							Value:      "fooBrowser",
+							Operator:   searchtypes.OperatorEq,
+						},
+						Children: nil,
+					},
+				},
+			},
+			facets: allFacets,
+			expected: []FeatureSearchFacetCount{
+				{Facet: FeatureSearchFacetAvailableOn, Value: "fooBrowser", Count: 2},
+				{Facet: FeatureSearchFacetAvailableOn, Value: "barBrowser", Count: 1},
+				{Facet: FeatureSearchFacetBaselineStatus, Value: string(BaselineStatusLow), Count: 1},
+				{Facet: FeatureSearchFacetBaselineStatus, Value: string(BaselineStatusNone), Count: 1},
+				{Facet: FeatureSearchFacetGroup, Value: "parent1", Count: 2},
+				{Facet: FeatureSearchFacetGroup, Value: "child3", Count: 1},
+			},
+		},
+		{
+			name:   "single facet",
+			node:   nil,
+			facets: []FeatureSearchFacet{FeatureSearchFacetBaselineStatus},
+			expected: []FeatureSearchFacetCount{
+				{Facet: FeatureSearchFacetBaselineStatus, Value: string(BaselineStatusHigh), Count: 1},
+				{Facet: FeatureSearchFacetBaselineStatus, Value: string(BaselineStatusLow), Count: 1},
+				{Facet: FeatureSearchFacetBaselineStatus, Value: string(BaselineStatusNone), Count: 1},
+			},
+		},
+		{
+			name:     "no facets",
+			node:     nil,
+			facets:   nil,
+			expected: []FeatureSearchFacetCount{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			counts, err := spannerClient.FeaturesSearchFacetCounts(ctx, tc.node, tc.facets)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(counts, tc.expected) {
+				t.Errorf("unexpected counts\nexpected: %+v\nreceived: %+v", tc.expected, counts)
+			}
+		})
+	}
+
+	t.Run("unknown facet", func(t *testing.T) {
+		_, err := spannerClient.FeaturesSearchFacetCounts(ctx, nil, []FeatureSearchFacet{"unknown"})
+		if !errors.Is(err, ErrUnknownFeatureSearchFacet) {
+			t.Errorf("expected ErrUnknownFeatureSearchFacet, received %v", err)
+		}
+	})
+}
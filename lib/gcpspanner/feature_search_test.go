@@ -133,31 +133,37 @@ func setupRequiredTablesForFeaturesSearch(ctx context.Context,
 			BrowserName:    "fooBrowser",
 			BrowserVersion: "0.0.0",
 			ReleaseDate:    time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			ReleaseStatus:  nil,
 		},
 		{
 			BrowserName:    "barBrowser",
 			BrowserVersion: "0.0.0",
 			ReleaseDate:    time.Date(2000, time.January, 2, 0, 0, 0, 0, time.UTC),
+			ReleaseStatus:  nil,
 		},
 		{
 			BrowserName:    "fooBrowser",
 			BrowserVersion: "1.0.0",
 			ReleaseDate:    time.Date(2000, time.February, 1, 0, 0, 0, 0, time.UTC),
+			ReleaseStatus:  nil,
 		},
 		{
 			BrowserName:    "barBrowser",
 			BrowserVersion: "1.0.0",
 			ReleaseDate:    time.Date(2000, time.February, 2, 0, 0, 0, 0, time.UTC),
+			ReleaseStatus:  nil,
 		},
 		{
 			BrowserName:    "fooBrowser",
 			BrowserVersion: "2.0.0",
 			ReleaseDate:    time.Date(2000, time.March, 1, 0, 0, 0, 0, time.UTC),
+			ReleaseStatus:  nil,
 		},
 		{
 			BrowserName:    "barBrowser",
 			BrowserVersion: "2.0.0",
 			ReleaseDate:    time.Date(2000, time.March, 2, 0, 0, 0, 0, time.UTC),
+			ReleaseStatus:  nil,
 		},
 	}
 	for _, release := range sampleReleases {
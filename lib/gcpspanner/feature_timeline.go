@@ -0,0 +1,352 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+const featureTimelineChangesTable = "FeatureTimelineChanges"
+
+// featureTimelineChangeType is the kind of a row in the FeatureTimelineChanges table.
+type featureTimelineChangeType string
+
+const (
+	// featureTimelineChangeTypeRename rows go from the old name to the new name of the feature.
+	featureTimelineChangeTypeRename featureTimelineChangeType = "RENAME"
+	// featureTimelineChangeTypeMove rows go from the old feature key to the feature key.
+	featureTimelineChangeTypeMove featureTimelineChangeType = "MOVE"
+)
+
+// FeatureTimelineBrowserRelease is the browser release in which a feature became available.
+type FeatureTimelineBrowserRelease struct {
+	BrowserName    string    `spanner:"BrowserName"`
+	BrowserVersion string    `spanner:"BrowserVersion"`
+	ReleaseDate    time.Time `spanner:"ReleaseDate"`
+}
+
+// FeatureTimelineChange is a rename or a move of a feature recorded by the notification events.
+// The same change is usually recorded by many saved searches. Only the first time it was recorded is kept.
+type FeatureTimelineChange struct {
+	Timestamp time.Time `spanner:"Timestamp"`
+	// OldName and NewName are set for renames.
+	OldName *string `spanner:"OldName"`
+	NewName *string `spanner:"NewName"`
+	// OldFeatureKey is set for moves.
+	OldFeatureKey *string `spanner:"OldFeatureKey"`
+}
+
+// FeatureTimelineChangeRecord is a rename or a move of a feature reported by a notification event.
+type FeatureTimelineChangeRecord struct {
+	FeatureKey string
+	// OldName and NewName are set for renames.
+	OldName *string
+	NewName *string
+	// OldFeatureKey is set for moves.
+	OldFeatureKey *string
+}
+
+// spannerFeatureTimelineChange is a row in the FeatureTimelineChanges table.
+type spannerFeatureTimelineChange struct {
+	FeatureKey string                    `spanner:"FeatureKey"`
+	ChangeType featureTimelineChangeType `spanner:"ChangeType"`
+	FromValue  string                    `spanner:"FromValue"`
+	ToValue    string                    `spanner:"ToValue"`
+	Timestamp  time.Time                 `spanner:"Timestamp"`
+}
+
+type featureTimelineChangeKey struct {
+	FeatureKey string
+	ChangeType featureTimelineChangeType
+	FromValue  string
+	ToValue    string
+}
+
+// featureTimelineChangeMapper implements the necessary interfaces for the generic helpers.
+type featureTimelineChangeMapper struct{}
+
+func (m featureTimelineChangeMapper) Table() string {
+	return featureTimelineChangesTable
+}
+
+func (m featureTimelineChangeMapper) SelectOne(key featureTimelineChangeKey) spanner.Statement {
+	return spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT FeatureKey, ChangeType, FromValue, ToValue, Timestamp
+			FROM %s
+			WHERE FeatureKey = @featureKey AND ChangeType = @changeType
+				AND FromValue = @fromValue AND ToValue = @toValue`, featureTimelineChangesTable),
+		Params: map[string]any{
+			"featureKey": key.FeatureKey,
+			"changeType": key.ChangeType,
+			"fromValue":  key.FromValue,
+			"toValue":    key.ToValue,
+		},
+	}
+}
+
+// featureTimelineChangeKeyFromRecord returns the row key of the change. It returns false if the record is
+// neither a rename nor a move.
+func featureTimelineChangeKeyFromRecord(record FeatureTimelineChangeRecord) (featureTimelineChangeKey, bool) {
+	switch {
+	case record.OldFeatureKey != nil:
+		return featureTimelineChangeKey{
+			FeatureKey: record.FeatureKey,
+			ChangeType: featureTimelineChangeTypeMove,
+			FromValue:  *record.OldFeatureKey,
+			ToValue:    record.FeatureKey,
+		}, true
+	case record.NewName != nil:
+		fromValue := ""
+		if record.OldName != nil {
+			fromValue = *record.OldName
+		}
+
+		return featureTimelineChangeKey{
+			FeatureKey: record.FeatureKey,
+			ChangeType: featureTimelineChangeTypeRename,
+			FromValue:  fromValue,
+			ToValue:    *record.NewName,
+		}, true
+	}
+
+	return featureTimelineChangeKey{
+		FeatureKey: "",
+		ChangeType: "",
+		FromValue:  "",
+		ToValue:    "",
+	}, false
+}
+
+// recordFeatureTimelineChangesWithTransaction stores the changes reported by a notification event at the
+// timestamp. A change that is already stored keeps the earliest timestamp.
+func (c *Client) recordFeatureTimelineChangesWithTransaction(
+	ctx context.Context,
+	txn *spanner.ReadWriteTransaction,
+	timestamp time.Time,
+	records []FeatureTimelineChangeRecord) error {
+	// Buffered mutations are not visible to the reads of the transaction so each change is only written once.
+	seen := make(map[featureTimelineChangeKey]struct{}, len(records))
+	for _, record := range records {
+		key, ok := featureTimelineChangeKeyFromRecord(record)
+		if !ok {
+			continue
+		}
+		if _, found := seen[key]; found {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		err := newEntityMutator[featureTimelineChangeMapper, spannerFeatureTimelineChange](c).
+			readInspectMutateWithTransaction(ctx, key,
+				func(_ context.Context, existing *spannerFeatureTimelineChange) (*spanner.Mutation, error) {
+					if existing != nil && !existing.Timestamp.After(timestamp) {
+						return nil, nil
+					}
+
+					return spanner.InsertOrUpdateStruct(featureTimelineChangesTable, spannerFeatureTimelineChange{
+						FeatureKey: key.FeatureKey,
+						ChangeType: key.ChangeType,
+						FromValue:  key.FromValue,
+						ToValue:    key.ToValue,
+						Timestamp:  timestamp,
+					})
+				}, txn)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FeatureTimeline contains the stored facts about the history of a feature.
+type FeatureTimeline struct {
+	BrowserReleases  []FeatureTimelineBrowserRelease
+	BaselineLowDate  *time.Time
+	BaselineHighDate *time.Time
+	// MovedFromFeatureKeys are the keys under which the feature was previously known.
+	MovedFromFeatureKeys []string
+	// SplitFromFeatureKeys are the keys of the features that were split into this feature, among others.
+	SplitFromFeatureKeys []string
+	Changes              []FeatureTimelineChange
+}
+
+// GetFeatureTimeline returns the history of a feature: the browser releases that shipped it, its baseline dates,
+// the features it replaced and the renames and moves recorded by the notification events.
+// The renames and moves are read from the FeatureTimelineChanges table, which is written with the events.
+// If the feature key does not exist, it returns ErrQueryReturnedNoResults.
+func (c *Client) GetFeatureTimeline(ctx context.Context, featureKey string) (*FeatureTimeline, error) {
+	txn := c.ReadOnlyTransaction()
+	defer txn.Close()
+
+	featureID, err := c.getFeatureTimelineWebFeatureID(ctx, txn, featureKey)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := new(FeatureTimeline)
+
+	releasesStmt := spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT bfa.BrowserName, bfa.BrowserVersion, br.ReleaseDate
+			FROM %s bfa
+			JOIN %s br ON bfa.BrowserName = br.BrowserName AND bfa.BrowserVersion = br.BrowserVersion
+			WHERE bfa.WebFeatureID = @featureID
+			ORDER BY br.ReleaseDate, bfa.BrowserName`,
+			browserFeatureAvailabilitiesTable, browserReleasesTable),
+		Params: map[string]any{"featureID": featureID},
+	}
+	err = queryRowsWithTransaction(ctx, txn, releasesStmt, func(row *spanner.Row) error {
+		var release FeatureTimelineBrowserRelease
+		if err := row.ToStruct(&release); err != nil {
+			return err
+		}
+		timeline.BrowserReleases = append(timeline.BrowserReleases, release)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	baselineStmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT LowDate, HighDate FROM %s WHERE WebFeatureID = @featureID`,
+			featureBaselineStatusTable),
+		Params: map[string]any{"featureID": featureID},
+	}
+	err = queryRowsWithTransaction(ctx, txn, baselineStmt, func(row *spanner.Row) error {
+		return row.Columns(&timeline.BaselineLowDate, &timeline.BaselineHighDate)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	timeline.MovedFromFeatureKeys, err = getFeatureTimelineOriginalFeatureKeys(ctx, txn, movedFeaturesTable, featureID)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline.SplitFromFeatureKeys, err = getFeatureTimelineOriginalFeatureKeys(
+		ctx, txn, splitWebFeaturesTable, featureID)
+	if err != nil {
+		return nil, err
+	}
+
+	changesStmt := spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT
+				Timestamp,
+				IF(ChangeType = @renameType, FromValue, NULL) AS OldName,
+				IF(ChangeType = @renameType, ToValue, NULL) AS NewName,
+				IF(ChangeType = @moveType, FromValue, NULL) AS OldFeatureKey
+			FROM %s
+			WHERE FeatureKey = @featureKey
+			ORDER BY Timestamp, ChangeType, FromValue`,
+			featureTimelineChangesTable),
+		Params: map[string]any{
+			"featureKey": featureKey,
+			"renameType": featureTimelineChangeTypeRename,
+			"moveType":   featureTimelineChangeTypeMove,
+		},
+	}
+	err = queryRowsWithTransaction(ctx, txn, changesStmt, func(row *spanner.Row) error {
+		var change FeatureTimelineChange
+		if err := row.ToStruct(&change); err != nil {
+			return err
+		}
+		timeline.Changes = append(timeline.Changes, change)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return timeline, nil
+}
+
+func (c *Client) getFeatureTimelineWebFeatureID(
+	ctx context.Context, txn transaction, featureKey string) (string, error) {
+	stmt := spanner.Statement{
+		SQL:    fmt.Sprintf(`SELECT ID FROM %s WHERE FeatureKey = @featureKey`, webFeaturesTable),
+		Params: map[string]any{"featureKey": featureKey},
+	}
+	it := txn.Query(ctx, stmt)
+	defer it.Stop()
+
+	row, err := it.Next()
+	if err != nil {
+		if errors.Is(err, iterator.Done) {
+			return "", errors.Join(ErrQueryReturnedNoResults, err)
+		}
+
+		return "", errors.Join(ErrInternalQueryFailure, err)
+	}
+	var id string
+	if err := row.Columns(&id); err != nil {
+		return "", errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return id, nil
+}
+
+// getFeatureTimelineOriginalFeatureKeys returns the original feature keys of the moved or split features
+// that target the feature.
+func getFeatureTimelineOriginalFeatureKeys(
+	ctx context.Context, txn transaction, table string, featureID string) ([]string, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT OriginalFeatureKey FROM %s
+			WHERE TargetWebFeatureID = @featureID
+			ORDER BY OriginalFeatureKey`, table),
+		Params: map[string]any{"featureID": featureID},
+	}
+	var keys []string
+	err := queryRowsWithTransaction(ctx, txn, stmt, func(row *spanner.Row) error {
+		var key string
+		if err := row.Columns(&key); err != nil {
+			return err
+		}
+		keys = append(keys, key)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// queryRowsWithTransaction calls fn for every row returned by the statement.
+func queryRowsWithTransaction(
+	ctx context.Context, txn transaction, stmt spanner.Statement, fn func(row *spanner.Row) error) error {
+	it := txn.Query(ctx, stmt)
+	defer it.Stop()
+
+	err := it.Do(fn)
+	if err != nil {
+		return errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return nil
+}
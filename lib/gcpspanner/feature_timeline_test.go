@@ -0,0 +1,171 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/go-cmp/cmp"
+)
+
+func setupFeatureTimelineTables(ctx context.Context, t *testing.T) {
+	t.Helper()
+	err := spannerClient.SyncWebFeatures(ctx, []WebFeature{
+		{FeatureKey: "grid", Name: "Grid", Description: "", DescriptionHTML: ""},
+		{FeatureKey: "subgrid", Name: "Subgrid", Description: "", DescriptionHTML: ""},
+	})
+	if err != nil {
+		t.Fatalf("failed to sync web features: %v", err)
+	}
+	// nolint: exhaustruct // The releases do not need a status.
+	for _, release := range []BrowserRelease{
+		{BrowserName: "chrome", BrowserVersion: "57", ReleaseDate: time.Date(2017, 3, 9, 0, 0, 0, 0, time.UTC)},
+		{BrowserName: "firefox", BrowserVersion: "52", ReleaseDate: time.Date(2017, 3, 7, 0, 0, 0, 0, time.UTC)},
+	} {
+		if err := spannerClient.InsertBrowserRelease(ctx, release); err != nil {
+			t.Fatalf("failed to insert browser release: %v", err)
+		}
+	}
+	err = spannerClient.SyncBrowserFeatureAvailabilities(ctx, map[string][]BrowserFeatureAvailability{
+		"grid": {
+			{BrowserName: "chrome", BrowserVersion: "57"},
+			{BrowserName: "firefox", BrowserVersion: "52"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to sync browser feature availabilities: %v", err)
+	}
+	err = spannerClient.UpsertFeatureBaselineStatus(ctx, "grid", FeatureBaselineStatus{
+		Status:   new(BaselineStatusHigh),
+		LowDate:  new(time.Date(2017, 10, 17, 0, 0, 0, 0, time.UTC)),
+		HighDate: new(time.Date(2020, 4, 17, 0, 0, 0, 0, time.UTC)),
+	})
+	if err != nil {
+		t.Fatalf("failed to upsert baseline status: %v", err)
+	}
+	err = spannerClient.SyncMovedWebFeatures(ctx, []MovedWebFeature{
+		{OriginalFeatureKey: "css-grid", NewFeatureKey: "grid"},
+	})
+	if err != nil {
+		t.Fatalf("failed to sync moved web features: %v", err)
+	}
+	err = spannerClient.SyncSplitWebFeatures(ctx, []SplitWebFeature{
+		{OriginalFeatureKey: "layout", TargetFeatureKeys: []string{"grid", "subgrid"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to sync split web features: %v", err)
+	}
+}
+
+func TestGetFeatureTimeline(t *testing.T) {
+	ctx := context.Background()
+	restartDatabaseContainer(t)
+	setupFeatureTimelineTables(ctx, t)
+
+	savedSearchID := createSavedSearchForNotificationTests(ctx, t)
+	workerID := "worker-1"
+	setupLockAndInitialState(ctx, t, savedSearchID, string(SavedSearchSnapshotTypeImmediate), workerID,
+		"path/initial", 10*time.Minute, time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	renamed := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	moved := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)
+	rename := func(featureKey, oldName, newName string) FeatureTimelineChangeRecord {
+		return FeatureTimelineChangeRecord{
+			FeatureKey: featureKey, OldName: &oldName, NewName: &newName, OldFeatureKey: nil}
+	}
+	// The events are published in random order. The earliest timestamp of a change is kept either way.
+	for id, event := range map[string]struct {
+		timestamp time.Time
+		changes   []FeatureTimelineChangeRecord
+	}{
+		"event-1": {timestamp: renamed, changes: []FeatureTimelineChangeRecord{
+			rename("grid", "CSS Grid", "Grid"),
+			rename("subgrid", "Sub", "Subgrid"),
+			// Records that are neither renames nor moves are ignored.
+			{FeatureKey: "grid", OldName: nil, NewName: nil, OldFeatureKey: nil},
+		}},
+		"event-2": {timestamp: moved, changes: []FeatureTimelineChangeRecord{
+			{FeatureKey: "grid", OldName: nil, NewName: nil, OldFeatureKey: new("css-grid")},
+			// The same rename recorded again later only counts once.
+			rename("grid", "CSS Grid", "Grid"),
+			rename("grid", "CSS Grid", "Grid"),
+		}},
+	} {
+		_, err := spannerClient.PublishSavedSearchNotificationEvent(ctx, SavedSearchNotificationCreateRequest{
+			SavedSearchID:  savedSearchID,
+			SnapshotType:   SavedSearchSnapshotTypeImmediate,
+			Timestamp:      event.timestamp,
+			EventType:      "IMMEDIATE_DIFF",
+			Reasons:        []string{"DATA_UPDATED"},
+			BlobPath:       "path/" + id,
+			DiffBlobPath:   "path/diff",
+			Summary:        spanner.NullJSON{Value: map[string]any{"summary": nil}, Valid: true},
+			FeatureChanges: event.changes,
+		}, "path/"+id, workerID, WithID(id))
+		if err != nil {
+			t.Fatalf("PublishSavedSearchNotificationEvent() unexpected error: %v", err)
+		}
+	}
+
+	timeline, err := spannerClient.GetFeatureTimeline(ctx, "grid")
+	if err != nil {
+		t.Fatalf("GetFeatureTimeline() unexpected error: %v", err)
+	}
+	expected := &FeatureTimeline{
+		BrowserReleases: []FeatureTimelineBrowserRelease{
+			{BrowserName: "firefox", BrowserVersion: "52", ReleaseDate: time.Date(2017, 3, 7, 0, 0, 0, 0, time.UTC)},
+			{BrowserName: "chrome", BrowserVersion: "57", ReleaseDate: time.Date(2017, 3, 9, 0, 0, 0, 0, time.UTC)},
+		},
+		BaselineLowDate:      new(time.Date(2017, 10, 17, 0, 0, 0, 0, time.UTC)),
+		BaselineHighDate:     new(time.Date(2020, 4, 17, 0, 0, 0, 0, time.UTC)),
+		MovedFromFeatureKeys: []string{"css-grid"},
+		SplitFromFeatureKeys: []string{"layout"},
+		Changes: []FeatureTimelineChange{
+			{Timestamp: renamed, OldName: new("CSS Grid"), NewName: new("Grid"), OldFeatureKey: nil},
+			{Timestamp: moved, OldName: nil, NewName: nil, OldFeatureKey: new("css-grid")},
+		},
+	}
+	if diff := cmp.Diff(expected, timeline); diff != "" {
+		t.Errorf("unexpected timeline (-want +got):\n%s", diff)
+	}
+
+	// A feature without history only has the split.
+	timeline, err = spannerClient.GetFeatureTimeline(ctx, "subgrid")
+	if err != nil {
+		t.Fatalf("GetFeatureTimeline() unexpected error: %v", err)
+	}
+	expected = &FeatureTimeline{
+		BrowserReleases:      nil,
+		BaselineLowDate:      nil,
+		BaselineHighDate:     nil,
+		MovedFromFeatureKeys: nil,
+		SplitFromFeatureKeys: []string{"layout"},
+		Changes: []FeatureTimelineChange{
+			{Timestamp: renamed, OldName: new("Sub"), NewName: new("Subgrid"), OldFeatureKey: nil},
+		},
+	}
+	if diff := cmp.Diff(expected, timeline); diff != "" {
+		t.Errorf("unexpected timeline (-want +got):\n%s", diff)
+	}
+
+	_, err = spannerClient.GetFeatureTimeline(ctx, "unknown")
+	if !errors.Is(err, ErrQueryReturnedNoResults) {
+		t.Errorf("expected ErrQueryReturnedNoResults, got %v", err)
+	}
+}
@@ -45,7 +45,10 @@ func (m unauthenticatedUserSavedSearchMapper) SelectOne(
 		Scope,
 		AuthorID,
 		CreatedAt,
-		UpdatedAt
+		UpdatedAt,
+		Tags,
+		Listed,
+		QueryParameters
 	FROM %s
 	WHERE
 		ID = @id
@@ -80,6 +83,9 @@ func (m authenticatedUserSavedSearchMapper) SelectOne(
 		AuthorID,
 		CreatedAt,
 		UpdatedAt,
+		Tags,
+		Listed,
+		QueryParameters,
 		r.UserRole AS Role,
 		CASE
 			WHEN b.UserID IS NOT NULL THEN TRUE
@@ -110,7 +116,7 @@ type readSavedSearchMapper struct{}
 func (m readSavedSearchMapper) SelectOne(id string) spanner.Statement {
 	stmt := spanner.NewStatement(fmt.Sprintf(`
 	SELECT
-		ID, Name, Description, Query, Scope, AuthorID, CreatedAt, UpdatedAt
+		ID, Name, Description, Query, Scope, AuthorID, CreatedAt, UpdatedAt, Tags, Listed, QueryParameters
 	FROM %s
 	WHERE ID = @id
 	LIMIT 1`,
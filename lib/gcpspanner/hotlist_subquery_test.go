@@ -41,14 +41,17 @@ func TestFeaturesSearch_HotlistSubquery_AllBuilders(t *testing.T) {
 	hotlistID := "hotlist-subquery-test"
 	_, err := spannerClient.ReadWriteTransaction(ctx, func(_ context.Context, txn *spanner.ReadWriteTransaction) error {
 		m, err := spanner.InsertStruct(savedSearchesTable, &SavedSearch{
-			ID:          hotlistID,
-			Name:        "Hotlist Subquery Test",
-			Description: nil,
-			Query:       "", // Empty query triggers subquery path
-			Scope:       SystemGlobalScope,
-			AuthorID:    "system",
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
+			ID:              hotlistID,
+			Name:            "Hotlist Subquery Test",
+			Description:     nil,
+			Query:           "", // Empty query triggers subquery path
+			Scope:           SystemGlobalScope,
+			AuthorID:        "system",
+			CreatedAt:       time.Now(),
+			UpdatedAt:       time.Now(),
+			Tags:            nil,
+			Listed:          false,
+			QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 		})
 		if err != nil {
 			return err
@@ -80,6 +80,9 @@ SELECT
 	AuthorID,
 	CreatedAt,
 	UpdatedAt,
+	Tags,
+	Listed,
+	QueryParameters,
 	r.UserRole AS Role,
 	CASE
 		WHEN b.UserID IS NOT NULL THEN TRUE
@@ -169,14 +172,15 @@ func (c *Client) ListUserSavedSearches(
 }
 
 type SavedSearchBriefDetails struct {
-	ID    string `spanner:"ID"`
-	Name  string `spanner:"Name"`
-	Query string `spanner:"Query"`
+	ID              string           `spanner:"ID"`
+	Name            string           `spanner:"Name"`
+	Query           string           `spanner:"Query"`
+	QueryParameters spanner.NullJSON `spanner:"QueryParameters"`
 }
 
 func (m userSavedSearchListerMapper) SelectAll() spanner.Statement {
 	return spanner.Statement{
-		SQL:    "SELECT ID, Name, Query FROM SavedSearches",
+		SQL:    "SELECT ID, Name, Query, QueryParameters FROM SavedSearches",
 		Params: nil,
 	}
 }
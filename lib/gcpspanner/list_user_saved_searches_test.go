@@ -21,6 +21,8 @@ import (
 	"slices"
 	"testing"
 	"time"
+
+	"cloud.google.com/go/spanner"
 )
 
 func loadFakeSavedSearches(t *testing.T) []SavedSearch {
@@ -64,8 +66,11 @@ func loadFakeSavedSearches(t *testing.T) []SavedSearch {
 			Description: request.Description,
 			Scope:       UserPublicScope,
 			// Timestamps don't matter for testing
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
+			CreatedAt:       time.Now(),
+			UpdatedAt:       time.Now(),
+			Tags:            nil,
+			Listed:          false,
+			QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 		}
 	}
 
@@ -309,9 +314,10 @@ func TestListAllSavedSearches(t *testing.T) {
 		expectedDetails := make([]SavedSearchBriefDetails, 0, len(searches)+len(initialDetails))
 		for _, search := range searches {
 			expectedDetails = append(expectedDetails, SavedSearchBriefDetails{
-				ID:    search.ID,
-				Name:  search.Name,
-				Query: search.Query,
+				ID:              search.ID,
+				Name:            search.Name,
+				Query:           search.Query,
+				QueryParameters: search.QueryParameters,
 			})
 		}
 		expectedDetails = append(expectedDetails, initialDetails...)
@@ -39,6 +39,7 @@ func loadDataForListMissingOneImplFeatureList(ctx context.Context, t *testing.T,
 		}
 	}
 
+	// nolint: exhaustruct // The releases do not need a status.
 	browserReleases := []BrowserRelease{
 		// fooBrowser Releases
 		{BrowserName: "fooBrowser", BrowserVersion: "110", ReleaseDate: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)},
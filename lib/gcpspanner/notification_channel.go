@@ -327,21 +327,7 @@ func (c *Client) CreateNotificationChannel(
 			return ErrOwnerNotificationChannelLimitExceeded
 		}
 
-		newID, err := newEntityCreator[notificationChannelMapper](c).createWithTransaction(ctx, txn, req)
-		if err != nil {
-			return err
-		}
-		id = newID
-
-		// Also create the initial state for the channel.
-		_, err = newEntityCreator[notificationChannelStateMapper](c).createWithTransaction(ctx, txn,
-			NotificationChannelState{
-				ChannelID:           *id,
-				IsDisabledBySystem:  false,
-				ConsecutiveFailures: 0,
-				CreatedAt:           spanner.CommitTimestamp,
-				UpdatedAt:           spanner.CommitTimestamp,
-			}, WithID(*id))
+		id, err = c.createNotificationChannelWithTransaction(ctx, txn, req)
 
 		return err
 	})
@@ -353,6 +339,34 @@ func (c *Client) CreateNotificationChannel(
 	return id, nil
 }
 
+// createNotificationChannelWithTransaction creates the channel and its initial state.
+// It does not check the channel limit of the user.
+func (c *Client) createNotificationChannelWithTransaction(
+	ctx context.Context,
+	txn *spanner.ReadWriteTransaction,
+	req CreateNotificationChannelRequest,
+) (*string, error) {
+	id, err := newEntityCreator[notificationChannelMapper](c).createWithTransaction(ctx, txn, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Also create the initial state for the channel.
+	_, err = newEntityCreator[notificationChannelStateMapper](c).createWithTransaction(ctx, txn,
+		NotificationChannelState{
+			ChannelID:           *id,
+			IsDisabledBySystem:  false,
+			ConsecutiveFailures: 0,
+			CreatedAt:           spanner.CommitTimestamp,
+			UpdatedAt:           spanner.CommitTimestamp,
+		}, WithID(*id))
+	if err != nil {
+		return nil, err
+	}
+
+	return id, nil
+}
+
 func (c *Client) countNotificationChannels(
 	ctx context.Context, userID string, txn *spanner.ReadWriteTransaction) (int64, error) {
 	stmt := spanner.Statement{
@@ -373,12 +387,12 @@ func (c *Client) countNotificationChannels(
 	return count, err
 }
 
-// findOrCreateRSSChannel ensures a user has exactly one RSS channel.
-func (c *Client) findOrCreateRSSChannel(
+// findRSSChannel returns the ID of the RSS channel of the user, or nil if the user has none.
+func (c *Client) findRSSChannel(
 	ctx context.Context,
 	userID string,
 	txn *spanner.ReadWriteTransaction,
-) (string, error) {
+) (*string, error) {
 	stmt := spanner.Statement{
 		SQL: fmt.Sprintf(`SELECT ID FROM %s
               WHERE UserID = @userID AND Type = 'rss' LIMIT 1`, notificationChannelTable),
@@ -388,17 +402,32 @@ func (c *Client) findOrCreateRSSChannel(
 	defer iter.Stop()
 
 	row, err := iter.Next()
-	if err == nil {
-		var id string
-		if err := row.Column(0, &id); err != nil {
-			return "", err
-		}
-
-		return id, nil
+	if errors.Is(err, iterator.Done) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
 	}
-	if !errors.Is(err, iterator.Done) {
+	var id string
+	if err := row.Column(0, &id); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// findOrCreateRSSChannel ensures a user has exactly one RSS channel.
+func (c *Client) findOrCreateRSSChannel(
+	ctx context.Context,
+	userID string,
+	txn *spanner.ReadWriteTransaction,
+) (string, error) {
+	existingID, err := c.findRSSChannel(ctx, userID, txn)
+	if err != nil {
 		return "", err
 	}
+	if existingID != nil {
+		return *existingID, nil
+	}
 
 	// Not found; create it.
 	req := CreateNotificationChannelRequest{
@@ -409,20 +438,7 @@ func (c *Client) findOrCreateRSSChannel(
 		WebhookConfig: nil,
 	}
 
-	newID, err := newEntityCreator[notificationChannelMapper](c).createWithTransaction(ctx, txn, req)
-	if err != nil {
-		return "", err
-	}
-
-	// Also create the initial state for the channel.
-	_, err = newEntityCreator[notificationChannelStateMapper](c).createWithTransaction(ctx, txn,
-		NotificationChannelState{
-			ChannelID:           *newID,
-			IsDisabledBySystem:  false,
-			ConsecutiveFailures: 0,
-			CreatedAt:           spanner.CommitTimestamp,
-			UpdatedAt:           spanner.CommitTimestamp,
-		}, WithID(*newID))
+	newID, err := c.createNotificationChannelWithTransaction(ctx, txn, req)
 	if err != nil {
 		return "", err
 	}
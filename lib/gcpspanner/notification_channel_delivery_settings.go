@@ -0,0 +1,105 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+const notificationChannelDeliverySettingsTable = "NotificationChannelDeliverySettings"
+
+// NotificationChannelDeliverySettings represents a row in the NotificationChannelDeliverySettings table.
+// Nil fields do not limit the deliveries of the channel.
+type NotificationChannelDeliverySettings struct {
+	ChannelID string `spanner:"ChannelID"`
+	// QuietHoursStart and QuietHoursEnd are local "HH:MM" times in QuietHoursTimeZone.
+	QuietHoursStart      *string   `spanner:"QuietHoursStart"`
+	QuietHoursEnd        *string   `spanner:"QuietHoursEnd"`
+	QuietHoursTimeZone   *string   `spanner:"QuietHoursTimeZone"`
+	MaxDeliveriesPerHour *int64    `spanner:"MaxDeliveriesPerHour"`
+	CreatedAt            time.Time `spanner:"CreatedAt"`
+	UpdatedAt            time.Time `spanner:"UpdatedAt"`
+}
+
+type notificationChannelDeliverySettingsMapper struct{}
+
+func (m notificationChannelDeliverySettingsMapper) SelectOne(channelID string) spanner.Statement {
+	return spanner.Statement{
+		SQL: `SELECT ChannelID, QuietHoursStart, QuietHoursEnd, QuietHoursTimeZone, MaxDeliveriesPerHour,
+			CreatedAt, UpdatedAt
+		FROM NotificationChannelDeliverySettings
+		WHERE ChannelID = @channelID`,
+		Params: map[string]any{
+			"channelID": channelID,
+		},
+	}
+}
+
+// UpsertNotificationChannelDeliverySettings replaces the delivery settings of a channel owned by the user.
+// It returns ErrNotificationChannelNotFound if the user does not own a push channel with the ID.
+func (c *Client) UpsertNotificationChannelDeliverySettings(
+	ctx context.Context, userID string, settings NotificationChannelDeliverySettings) error {
+	mutator := newEntityMutator[notificationChannelDeliverySettingsMapper, NotificationChannelDeliverySettings](c)
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		err := c.checkNotificationChannelOwnership(ctx, settings.ChannelID, userID, txn, false)
+		if err != nil {
+			return err
+		}
+
+		return mutator.readInspectMutateWithTransaction(ctx, settings.ChannelID,
+			func(_ context.Context, existing *NotificationChannelDeliverySettings) (*spanner.Mutation, error) {
+				settings.CreatedAt = spanner.CommitTimestamp
+				if existing != nil {
+					settings.CreatedAt = existing.CreatedAt
+				}
+				settings.UpdatedAt = spanner.CommitTimestamp
+
+				return spanner.InsertOrUpdateStruct(notificationChannelDeliverySettingsTable, settings)
+			}, txn)
+	})
+
+	return err
+}
+
+// GetNotificationChannelDeliverySettings retrieves the delivery settings of a channel owned by the user.
+// It returns ErrQueryReturnedNoResults if the channel has no settings.
+func (c *Client) GetNotificationChannelDeliverySettings(
+	ctx context.Context, channelID string, userID string) (*NotificationChannelDeliverySettings, error) {
+	var ret *NotificationChannelDeliverySettings
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		err := c.checkNotificationChannelOwnership(ctx, channelID, userID, txn, false)
+		if err != nil {
+			return err
+		}
+		ret, err = newEntityReader[notificationChannelDeliverySettingsMapper,
+			NotificationChannelDeliverySettings, string](c).readRowByKeyWithTransaction(ctx, channelID, txn)
+
+		return err
+	})
+
+	return ret, err
+}
+
+// GetNotificationChannelDeliverySettingsByChannelID retrieves the delivery settings of a channel without checking
+// its owner. It is meant for the delivery workers.
+// It returns ErrQueryReturnedNoResults if the channel has no settings.
+func (c *Client) GetNotificationChannelDeliverySettingsByChannelID(
+	ctx context.Context, channelID string) (*NotificationChannelDeliverySettings, error) {
+	return newEntityReader[notificationChannelDeliverySettingsMapper,
+		NotificationChannelDeliverySettings, string](c).readRowByKey(ctx, channelID)
+}
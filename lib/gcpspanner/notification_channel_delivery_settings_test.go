@@ -0,0 +1,173 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+)
+
+func createDeliverySettingsTestChannel(ctx context.Context, t *testing.T, userID string) string {
+	t.Helper()
+	channelIDPtr, err := spannerClient.CreateNotificationChannel(ctx, CreateNotificationChannelRequest{
+		UserID:        userID,
+		Name:          "Test",
+		Type:          NotificationChannelTypeWebhook,
+		EmailConfig:   nil,
+		WebhookConfig: &WebhookConfig{URL: "https://hooks.slack.com/services/123"},
+	})
+	if err != nil {
+		t.Fatalf("failed to create notification channel: %v", err)
+	}
+
+	return *channelIDPtr
+}
+
+func TestNotificationChannelDeliverySettings(t *testing.T) {
+	ctx := context.Background()
+	restartDatabaseContainer(t)
+
+	userID := uuid.NewString()
+	channelID := createDeliverySettingsTestChannel(ctx, t, userID)
+
+	_, err := spannerClient.GetNotificationChannelDeliverySettings(ctx, channelID, userID)
+	if !errors.Is(err, ErrQueryReturnedNoResults) {
+		t.Fatalf("expected ErrQueryReturnedNoResults before settings exist, got %v", err)
+	}
+
+	settings := NotificationChannelDeliverySettings{
+		ChannelID:            channelID,
+		QuietHoursStart:      new("22:00"),
+		QuietHoursEnd:        new("07:00"),
+		QuietHoursTimeZone:   new("Europe/Berlin"),
+		MaxDeliveriesPerHour: new(int64(10)),
+		CreatedAt:            time.Time{},
+		UpdatedAt:            time.Time{},
+	}
+	if err := spannerClient.UpsertNotificationChannelDeliverySettings(ctx, userID, settings); err != nil {
+		t.Fatalf("UpsertNotificationChannelDeliverySettings failed: %v", err)
+	}
+	got, err := spannerClient.GetNotificationChannelDeliverySettings(ctx, channelID, userID)
+	if err != nil {
+		t.Fatalf("GetNotificationChannelDeliverySettings failed: %v", err)
+	}
+	ignoreTimestamps := cmpopts.IgnoreFields(NotificationChannelDeliverySettings{
+		ChannelID:            "",
+		QuietHoursStart:      nil,
+		QuietHoursEnd:        nil,
+		QuietHoursTimeZone:   nil,
+		MaxDeliveriesPerHour: nil,
+		CreatedAt:            time.Time{},
+		UpdatedAt:            time.Time{},
+	}, "CreatedAt", "UpdatedAt")
+	if diff := cmp.Diff(&settings, got, ignoreTimestamps); diff != "" {
+		t.Errorf("settings mismatch (-want +got):\n%s", diff)
+	}
+
+	// Replacing the settings clears the fields that are not set.
+	settings.QuietHoursStart = nil
+	settings.QuietHoursEnd = nil
+	settings.QuietHoursTimeZone = nil
+	if err := spannerClient.UpsertNotificationChannelDeliverySettings(ctx, userID, settings); err != nil {
+		t.Fatalf("UpsertNotificationChannelDeliverySettings (replace) failed: %v", err)
+	}
+	updated, err := spannerClient.GetNotificationChannelDeliverySettings(ctx, channelID, userID)
+	if err != nil {
+		t.Fatalf("GetNotificationChannelDeliverySettings failed: %v", err)
+	}
+	if diff := cmp.Diff(&settings, updated, ignoreTimestamps); diff != "" {
+		t.Errorf("settings mismatch (-want +got):\n%s", diff)
+	}
+	if !updated.CreatedAt.Equal(got.CreatedAt) {
+		t.Errorf("CreatedAt changed from %v to %v", got.CreatedAt, updated.CreatedAt)
+	}
+
+	// Other users can neither read nor write the settings.
+	otherUserID := uuid.NewString()
+	if _, err := spannerClient.GetNotificationChannelDeliverySettings(ctx, channelID, otherUserID); !errors.Is(err,
+		ErrNotificationChannelNotFound) {
+		t.Errorf("expected ErrNotificationChannelNotFound, got %v", err)
+	}
+	if err := spannerClient.UpsertNotificationChannelDeliverySettings(ctx, otherUserID, settings); !errors.Is(err,
+		ErrNotificationChannelNotFound) {
+		t.Errorf("expected ErrNotificationChannelNotFound, got %v", err)
+	}
+}
+
+func TestFindAllActivePushSubscriptions_DeliverySettings(t *testing.T) {
+	ctx := context.Background()
+	restartDatabaseContainer(t)
+
+	userID := uuid.NewString()
+	channelID := createDeliverySettingsTestChannel(ctx, t, userID)
+	savedSearchID, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
+		Name:            "Test Search",
+		Query:           "is:widely",
+		OwnerUserID:     userID,
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
+	})
+	if err != nil {
+		t.Fatalf("failed to create saved search: %v", err)
+	}
+	_, err = spannerClient.CreateSavedSearchSubscription(ctx, CreateSavedSearchSubscriptionRequest{
+		UserID:        userID,
+		ChannelID:     channelID,
+		ChannelType:   nil,
+		SavedSearchID: *savedSearchID,
+		Triggers:      nil,
+		Frequency:     SavedSearchSnapshotTypeImmediate,
+	})
+	if err != nil {
+		t.Fatalf("failed to create subscription: %v", err)
+	}
+
+	dests, err := spannerClient.FindAllActivePushSubscriptions(ctx, *savedSearchID, SavedSearchSnapshotTypeImmediate)
+	if err != nil {
+		t.Fatalf("FindAllActivePushSubscriptions failed: %v", err)
+	}
+	if len(dests) != 1 || dests[0].DeliverySettings != nil {
+		t.Fatalf("expected one destination without delivery settings, got %+v", dests)
+	}
+
+	err = spannerClient.UpsertNotificationChannelDeliverySettings(ctx, userID, NotificationChannelDeliverySettings{
+		ChannelID:            channelID,
+		QuietHoursStart:      nil,
+		QuietHoursEnd:        nil,
+		QuietHoursTimeZone:   nil,
+		MaxDeliveriesPerHour: new(int64(3)),
+		CreatedAt:            time.Time{},
+		UpdatedAt:            time.Time{},
+	})
+	if err != nil {
+		t.Fatalf("UpsertNotificationChannelDeliverySettings failed: %v", err)
+	}
+	dests, err = spannerClient.FindAllActivePushSubscriptions(ctx, *savedSearchID, SavedSearchSnapshotTypeImmediate)
+	if err != nil {
+		t.Fatalf("FindAllActivePushSubscriptions failed: %v", err)
+	}
+	if len(dests) != 1 || dests[0].DeliverySettings == nil ||
+		dests[0].DeliverySettings.MaxDeliveriesPerHour == nil || *dests[0].DeliverySettings.MaxDeliveriesPerHour != 3 {
+		t.Fatalf("expected the delivery settings to be joined, got %+v", dests)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+const (
+	notificationChannelDeliveryWindowsTable      = "NotificationChannelDeliveryWindows"
+	notificationChannelDeliveryReservationsTable = "NotificationChannelDeliveryReservations"
+)
+
+// NotificationChannelDeliveryWindow represents a row in the NotificationChannelDeliveryWindows table.
+type NotificationChannelDeliveryWindow struct {
+	ChannelID     string    `spanner:"ChannelID"`
+	WindowStart   time.Time `spanner:"WindowStart"`
+	DeliveryCount int64     `spanner:"DeliveryCount"`
+}
+
+type notificationChannelDeliveryWindowMapper struct{}
+
+type notificationChannelDeliveryWindowKey struct {
+	ChannelID   string
+	WindowStart time.Time
+}
+
+func (m notificationChannelDeliveryWindowMapper) SelectOne(key notificationChannelDeliveryWindowKey) spanner.Statement {
+	return spanner.Statement{
+		SQL: `SELECT ChannelID, WindowStart, DeliveryCount
+		FROM NotificationChannelDeliveryWindows
+		WHERE ChannelID = @channelID AND WindowStart = @windowStart`,
+		Params: map[string]any{
+			"channelID":   key.ChannelID,
+			"windowStart": key.WindowStart,
+		},
+	}
+}
+
+// notificationChannelDeliveryReservation represents a row in the NotificationChannelDeliveryReservations table.
+type notificationChannelDeliveryReservation struct {
+	ChannelID   string    `spanner:"ChannelID"`
+	WindowStart time.Time `spanner:"WindowStart"`
+	DeliveryKey string    `spanner:"DeliveryKey"`
+}
+
+type notificationChannelDeliveryReservationMapper struct{}
+
+type notificationChannelDeliveryReservationKey struct {
+	ChannelID   string
+	WindowStart time.Time
+	DeliveryKey string
+}
+
+func (m notificationChannelDeliveryReservationMapper) SelectOne(
+	key notificationChannelDeliveryReservationKey) spanner.Statement {
+	return spanner.Statement{
+		SQL: `SELECT ChannelID, WindowStart, DeliveryKey
+		FROM NotificationChannelDeliveryReservations
+		WHERE ChannelID = @channelID AND WindowStart = @windowStart AND DeliveryKey = @deliveryKey`,
+		Params: map[string]any{
+			"channelID":   key.ChannelID,
+			"windowStart": key.WindowStart,
+			"deliveryKey": key.DeliveryKey,
+		},
+	}
+}
+
+// ReserveNotificationChannelDelivery counts a delivery against the hourly limit of a channel.
+// It returns false, without counting the delivery, if the channel already reached the limit in the
+// window that starts at windowStart.
+// Reservations are idempotent per deliveryKey: a delivery that was already counted in the window is
+// admitted again without being counted twice. An empty deliveryKey is always counted.
+func (c *Client) ReserveNotificationChannelDelivery(
+	ctx context.Context, channelID string, deliveryKey string, windowStart time.Time, limit int64) (bool, error) {
+	var reserved bool
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		// Reset in case the transaction is retried.
+		reserved = false
+		reservationKey := notificationChannelDeliveryReservationKey{
+			ChannelID:   channelID,
+			WindowStart: windowStart,
+			DeliveryKey: deliveryKey,
+		}
+		if deliveryKey != "" {
+			_, err := newEntityReader[notificationChannelDeliveryReservationMapper,
+				notificationChannelDeliveryReservation, notificationChannelDeliveryReservationKey](c).
+				readRowByKeyWithTransaction(ctx, reservationKey, txn)
+			if err == nil {
+				reserved = true
+
+				return nil
+			} else if !errors.Is(err, ErrQueryReturnedNoResults) {
+				return err
+			}
+		}
+
+		window, err := newEntityReader[notificationChannelDeliveryWindowMapper, NotificationChannelDeliveryWindow,
+			notificationChannelDeliveryWindowKey](c).readRowByKeyWithTransaction(ctx,
+			notificationChannelDeliveryWindowKey{ChannelID: channelID, WindowStart: windowStart}, txn)
+		if errors.Is(err, ErrQueryReturnedNoResults) {
+			window = &NotificationChannelDeliveryWindow{
+				ChannelID:     channelID,
+				WindowStart:   windowStart,
+				DeliveryCount: 0,
+			}
+		} else if err != nil {
+			return err
+		}
+		if window.DeliveryCount >= limit {
+			return nil
+		}
+		window.DeliveryCount++
+
+		windowMutation, err := spanner.InsertOrUpdateStruct(notificationChannelDeliveryWindowsTable, *window)
+		if err != nil {
+			return errors.Join(ErrInternalQueryFailure, err)
+		}
+		mutations := []*spanner.Mutation{windowMutation}
+		if deliveryKey != "" {
+			reservationMutation, err := spanner.InsertStruct(notificationChannelDeliveryReservationsTable,
+				notificationChannelDeliveryReservation(reservationKey))
+			if err != nil {
+				return errors.Join(ErrInternalQueryFailure, err)
+			}
+			mutations = append(mutations, reservationMutation)
+		}
+		reserved = true
+
+		return txn.BufferWrite(mutations)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return reserved, nil
+}
+
+// GetNotificationChannelDeliveryWindow retrieves the delivery count of a channel for a window.
+// If no delivery was counted in the window, ErrQueryReturnedNoResults is returned.
+func (c *Client) GetNotificationChannelDeliveryWindow(
+	ctx context.Context, channelID string, windowStart time.Time) (*NotificationChannelDeliveryWindow, error) {
+	r := newEntityReader[notificationChannelDeliveryWindowMapper, NotificationChannelDeliveryWindow,
+		notificationChannelDeliveryWindowKey](c)
+
+	return r.readRowByKey(ctx, notificationChannelDeliveryWindowKey{ChannelID: channelID, WindowStart: windowStart})
+}
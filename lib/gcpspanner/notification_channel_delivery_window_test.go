@@ -0,0 +1,83 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestReserveNotificationChannelDelivery(t *testing.T) {
+	ctx := context.Background()
+	restartDatabaseContainer(t)
+
+	channelID := createDeliverySettingsTestChannel(ctx, t, uuid.NewString())
+	window := time.Date(2026, 3, 11, 7, 0, 0, 0, time.UTC)
+
+	for i := range 2 {
+		reserved, err := spannerClient.ReserveNotificationChannelDelivery(ctx, channelID,
+			fmt.Sprintf("delivery-%d", i+1), window, 2)
+		if err != nil {
+			t.Fatalf("ReserveNotificationChannelDelivery failed: %v", err)
+		}
+		if !reserved {
+			t.Errorf("expected delivery %d to be reserved", i+1)
+		}
+	}
+	reserved, err := spannerClient.ReserveNotificationChannelDelivery(ctx, channelID, "delivery-3", window, 2)
+	if err != nil {
+		t.Fatalf("ReserveNotificationChannelDelivery failed: %v", err)
+	}
+	if reserved {
+		t.Error("expected the third delivery to exceed the limit")
+	}
+
+	// A delivery that was already counted is admitted again without being counted twice.
+	reserved, err = spannerClient.ReserveNotificationChannelDelivery(ctx, channelID, "delivery-1", window, 2)
+	if err != nil {
+		t.Fatalf("ReserveNotificationChannelDelivery failed: %v", err)
+	}
+	if !reserved {
+		t.Error("expected the repeated delivery to be reserved")
+	}
+
+	got, err := spannerClient.GetNotificationChannelDeliveryWindow(ctx, channelID, window)
+	if err != nil {
+		t.Fatalf("GetNotificationChannelDeliveryWindow failed: %v", err)
+	}
+	if got.DeliveryCount != 2 {
+		t.Errorf("DeliveryCount = %d, want 2", got.DeliveryCount)
+	}
+
+	// The next window starts from zero.
+	next := window.Add(time.Hour)
+	reserved, err = spannerClient.ReserveNotificationChannelDelivery(ctx, channelID, "delivery-3", next, 2)
+	if err != nil {
+		t.Fatalf("ReserveNotificationChannelDelivery failed: %v", err)
+	}
+	if !reserved {
+		t.Error("expected the delivery in the next window to be reserved")
+	}
+
+	_, err = spannerClient.GetNotificationChannelDeliveryWindow(ctx, channelID, next.Add(time.Hour))
+	if !errors.Is(err, ErrQueryReturnedNoResults) {
+		t.Errorf("expected ErrQueryReturnedNoResults, got %v", err)
+	}
+}
@@ -0,0 +1,329 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+)
+
+const notificationChannelHeldDeliveriesTable = "NotificationChannelHeldDeliveries"
+
+// NotificationChannelHeldDelivery is a delivery job that was held back by the delivery settings of its channel.
+type NotificationChannelHeldDelivery struct {
+	ChannelID      string
+	SubscriptionID string
+	// Job is the serialized delivery job. The format is owned by the caller.
+	Job []byte
+	// HeldCount is the number of delivery jobs coalesced into Job.
+	HeldCount int64
+	ReleaseAt time.Time
+	// LeaseID identifies the claim that currently owns the job, if any.
+	LeaseID *string
+	// LeaseExpiresAt is the time after which the job can be claimed again.
+	LeaseExpiresAt *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// spannerNotificationChannelHeldDelivery is the internal struct for Spanner mapping.
+type spannerNotificationChannelHeldDelivery struct {
+	ChannelID      string             `spanner:"ChannelID"`
+	SubscriptionID string             `spanner:"SubscriptionID"`
+	Job            spanner.NullJSON   `spanner:"Job"`
+	HeldCount      int64              `spanner:"HeldCount"`
+	ReleaseAt      time.Time          `spanner:"ReleaseAt"`
+	LeaseID        spanner.NullString `spanner:"LeaseID"`
+	LeaseExpiresAt spanner.NullTime   `spanner:"LeaseExpiresAt"`
+	CreatedAt      time.Time          `spanner:"CreatedAt"`
+	UpdatedAt      time.Time          `spanner:"UpdatedAt"`
+}
+
+func (s spannerNotificationChannelHeldDelivery) toExternal() (*NotificationChannelHeldDelivery, error) {
+	job, err := json.Marshal(s.Job.Value)
+	if err != nil {
+		return nil, err
+	}
+	ret := &NotificationChannelHeldDelivery{
+		ChannelID:      s.ChannelID,
+		SubscriptionID: s.SubscriptionID,
+		Job:            job,
+		HeldCount:      s.HeldCount,
+		ReleaseAt:      s.ReleaseAt,
+		LeaseID:        nil,
+		LeaseExpiresAt: nil,
+		CreatedAt:      s.CreatedAt,
+		UpdatedAt:      s.UpdatedAt,
+	}
+	if s.LeaseID.Valid {
+		ret.LeaseID = &s.LeaseID.StringVal
+	}
+	if s.LeaseExpiresAt.Valid {
+		ret.LeaseExpiresAt = &s.LeaseExpiresAt.Time
+	}
+
+	return ret, nil
+}
+
+// isLeased returns true if the job is claimed by leaseID.
+func (s spannerNotificationChannelHeldDelivery) isLeased(leaseID string) bool {
+	return s.LeaseID.Valid && s.LeaseID.StringVal == leaseID
+}
+
+type notificationChannelHeldDeliveryMapper struct{}
+
+type notificationChannelHeldDeliveryKey struct {
+	ChannelID      string
+	SubscriptionID string
+}
+
+func (m notificationChannelHeldDeliveryMapper) SelectOne(key notificationChannelHeldDeliveryKey) spanner.Statement {
+	return spanner.Statement{
+		SQL: `SELECT ChannelID, SubscriptionID, Job, HeldCount, ReleaseAt, LeaseID, LeaseExpiresAt,
+			CreatedAt, UpdatedAt
+		FROM NotificationChannelHeldDeliveries
+		WHERE ChannelID = @channelID AND SubscriptionID = @subscriptionID`,
+		Params: map[string]any{
+			"channelID":      key.ChannelID,
+			"subscriptionID": key.SubscriptionID,
+		},
+	}
+}
+
+// ErrInvalidHeldDeliveryJob is returned when a held delivery job is not valid JSON.
+var ErrInvalidHeldDeliveryJob = errors.New("held delivery job is not valid json")
+
+// HoldNotificationChannelDelivery stores a delivery job until releaseAt.
+// There is at most one held job per subscription. If a job is already held, coalesce merges the held job
+// with the new one and the later of the two release times is kept. coalesce returns false if the new job
+// was already part of the held job, in which case nothing is written.
+// A job can be coalesced into a held job while it is leased. The lease is kept.
+func (c *Client) HoldNotificationChannelDelivery(
+	ctx context.Context,
+	channelID string,
+	subscriptionID string,
+	job []byte,
+	releaseAt time.Time,
+	coalesce func(held, incoming []byte) ([]byte, bool, error),
+) error {
+	mutator := newEntityMutator[notificationChannelHeldDeliveryMapper, spannerNotificationChannelHeldDelivery](c)
+	key := notificationChannelHeldDeliveryKey{ChannelID: channelID, SubscriptionID: subscriptionID}
+
+	return mutator.readInspectMutate(ctx, key,
+		func(_ context.Context, existing *spannerNotificationChannelHeldDelivery) (*spanner.Mutation, error) {
+			row := spannerNotificationChannelHeldDelivery{
+				ChannelID:      channelID,
+				SubscriptionID: subscriptionID,
+				Job:            spanner.NullJSON{Value: nil, Valid: false},
+				HeldCount:      1,
+				ReleaseAt:      releaseAt,
+				LeaseID:        spanner.NullString{StringVal: "", Valid: false},
+				LeaseExpiresAt: spanner.NullTime{Time: time.Time{}, Valid: false},
+				CreatedAt:      spanner.CommitTimestamp,
+				UpdatedAt:      spanner.CommitTimestamp,
+			}
+			merged := job
+			if existing != nil {
+				held, err := existing.toExternal()
+				if err != nil {
+					return nil, errors.Join(ErrInvalidHeldDeliveryJob, err)
+				}
+				var added bool
+				merged, added, err = coalesce(held.Job, job)
+				if err != nil {
+					return nil, err
+				}
+				if !added {
+					return nil, nil
+				}
+				row.HeldCount = existing.HeldCount + 1
+				row.LeaseID = existing.LeaseID
+				row.LeaseExpiresAt = existing.LeaseExpiresAt
+				row.CreatedAt = existing.CreatedAt
+				if existing.ReleaseAt.After(releaseAt) {
+					row.ReleaseAt = existing.ReleaseAt
+				}
+			}
+			if !json.Valid(merged) {
+				return nil, ErrInvalidHeldDeliveryJob
+			}
+			row.Job = spanner.NullJSON{Value: json.RawMessage(merged), Valid: true}
+
+			return spanner.InsertOrUpdateStruct(notificationChannelHeldDeliveriesTable, row)
+		})
+}
+
+// ClaimDueNotificationChannelHeldDeliveries leases and returns up to limit held delivery jobs whose release time
+// is at or before now, oldest release time first. Jobs that are leased by another claim are skipped until
+// their lease expires.
+// The claimed jobs stay stored until they are completed with CompleteNotificationChannelHeldDelivery or
+// handed back with RescheduleNotificationChannelHeldDelivery. If neither happens, e.g. because the worker
+// crashed, the job is claimed again once the lease expires.
+func (c *Client) ClaimDueNotificationChannelHeldDeliveries(
+	ctx context.Context, now time.Time, limit int, leaseDuration time.Duration,
+) ([]NotificationChannelHeldDelivery, error) {
+	leaseID := uuid.NewString()
+	leaseExpiresAt := now.Add(leaseDuration)
+	var ret []NotificationChannelHeldDelivery
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		// Reset in case the transaction is retried.
+		ret = nil
+		stmt := spanner.Statement{
+			SQL: `SELECT ChannelID, SubscriptionID, Job, HeldCount, ReleaseAt, LeaseID, LeaseExpiresAt,
+				CreatedAt, UpdatedAt
+			FROM NotificationChannelHeldDeliveries
+			WHERE ReleaseAt <= @now AND (LeaseExpiresAt IS NULL OR LeaseExpiresAt <= @now)
+			ORDER BY ReleaseAt
+			LIMIT @limit`,
+			Params: map[string]any{
+				"now":   now,
+				"limit": limit,
+			},
+		}
+
+		var mutations []*spanner.Mutation
+		iter := txn.Query(ctx, stmt)
+		defer iter.Stop()
+		err := iter.Do(func(row *spanner.Row) error {
+			var held spannerNotificationChannelHeldDelivery
+			if err := row.ToStruct(&held); err != nil {
+				return err
+			}
+			held.LeaseID = spanner.NullString{StringVal: leaseID, Valid: true}
+			held.LeaseExpiresAt = spanner.NullTime{Time: leaseExpiresAt, Valid: true}
+			external, err := held.toExternal()
+			if err != nil {
+				return errors.Join(ErrInvalidHeldDeliveryJob, err)
+			}
+			ret = append(ret, *external)
+			mutations = append(mutations, spanner.Update(notificationChannelHeldDeliveriesTable,
+				[]string{"ChannelID", "SubscriptionID", "LeaseID", "LeaseExpiresAt"},
+				[]any{held.ChannelID, held.SubscriptionID, held.LeaseID, held.LeaseExpiresAt}))
+
+			return nil
+		})
+		if err != nil {
+			return errors.Join(ErrInternalQueryFailure, err)
+		}
+		if len(mutations) == 0 {
+			return nil
+		}
+
+		return txn.BufferWrite(mutations)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// CompleteNotificationChannelHeldDelivery removes the parts of a claimed job that were delivered.
+// prune receives the stored job and returns what is left of it once the delivered parts are removed,
+// together with the number of jobs that remain coalesced in it. If nothing remains, the held job is deleted.
+// Otherwise, the remainder (jobs coalesced while the job was leased) is kept and the lease is released
+// so that it is claimed again.
+// It is a no-op if the held job no longer exists.
+func (c *Client) CompleteNotificationChannelHeldDelivery(
+	ctx context.Context,
+	channelID string,
+	subscriptionID string,
+	leaseID string,
+	prune func(held []byte) ([]byte, int64, error),
+) error {
+	mutator := newEntityMutator[notificationChannelHeldDeliveryMapper, spannerNotificationChannelHeldDelivery](c)
+	key := notificationChannelHeldDeliveryKey{ChannelID: channelID, SubscriptionID: subscriptionID}
+
+	return mutator.readInspectMutate(ctx, key,
+		func(_ context.Context, existing *spannerNotificationChannelHeldDelivery) (*spanner.Mutation, error) {
+			if existing == nil {
+				return nil, nil
+			}
+			held, err := existing.toExternal()
+			if err != nil {
+				return nil, errors.Join(ErrInvalidHeldDeliveryJob, err)
+			}
+			remaining, count, err := prune(held.Job)
+			if err != nil {
+				return nil, err
+			}
+			if count == 0 {
+				return spanner.Delete(notificationChannelHeldDeliveriesTable,
+					spanner.Key{channelID, subscriptionID}), nil
+			}
+			if !json.Valid(remaining) {
+				return nil, ErrInvalidHeldDeliveryJob
+			}
+			columns := []string{"ChannelID", "SubscriptionID", "Job", "HeldCount", "UpdatedAt"}
+			values := []any{channelID, subscriptionID, spanner.NullJSON{Value: json.RawMessage(remaining), Valid: true},
+				count, spanner.CommitTimestamp}
+			// Another claim may have taken over after the lease expired. Leave its lease alone.
+			if existing.isLeased(leaseID) {
+				columns = append(columns, "LeaseID", "LeaseExpiresAt")
+				values = append(values, nil, nil)
+			}
+
+			return spanner.Update(notificationChannelHeldDeliveriesTable, columns, values), nil
+		})
+}
+
+// RescheduleNotificationChannelHeldDelivery releases the lease of a claimed job that was not delivered and
+// keeps it held until releaseAt, or until its current release time if that is later.
+// It is a no-op if the held job no longer exists or is no longer leased by leaseID.
+func (c *Client) RescheduleNotificationChannelHeldDelivery(
+	ctx context.Context,
+	channelID string,
+	subscriptionID string,
+	leaseID string,
+	releaseAt time.Time,
+) error {
+	mutator := newEntityMutator[notificationChannelHeldDeliveryMapper, spannerNotificationChannelHeldDelivery](c)
+	key := notificationChannelHeldDeliveryKey{ChannelID: channelID, SubscriptionID: subscriptionID}
+
+	return mutator.readInspectMutate(ctx, key,
+		func(_ context.Context, existing *spannerNotificationChannelHeldDelivery) (*spanner.Mutation, error) {
+			if existing == nil || !existing.isLeased(leaseID) {
+				return nil, nil
+			}
+			heldUntil := releaseAt
+			if existing.ReleaseAt.After(heldUntil) {
+				heldUntil = existing.ReleaseAt
+			}
+
+			return spanner.Update(notificationChannelHeldDeliveriesTable,
+				[]string{"ChannelID", "SubscriptionID", "ReleaseAt", "LeaseID", "LeaseExpiresAt", "UpdatedAt"},
+				[]any{channelID, subscriptionID, heldUntil, nil, nil, spanner.CommitTimestamp}), nil
+		})
+}
+
+// GetNotificationChannelHeldDelivery retrieves the held delivery job of a subscription.
+// If no job is held, ErrQueryReturnedNoResults is returned.
+func (c *Client) GetNotificationChannelHeldDelivery(
+	ctx context.Context, channelID string, subscriptionID string) (*NotificationChannelHeldDelivery, error) {
+	r := newEntityReader[notificationChannelHeldDeliveryMapper, spannerNotificationChannelHeldDelivery,
+		notificationChannelHeldDeliveryKey](c)
+	row, err := r.readRowByKey(ctx,
+		notificationChannelHeldDeliveryKey{ChannelID: channelID, SubscriptionID: subscriptionID})
+	if err != nil {
+		return nil, err
+	}
+
+	return row.toExternal()
+}
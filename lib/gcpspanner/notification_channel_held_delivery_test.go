@@ -0,0 +1,205 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestNotificationChannelHeldDeliveries(t *testing.T) {
+	ctx := context.Background()
+	restartDatabaseContainer(t)
+
+	userID := uuid.NewString()
+	channelID := createDeliverySettingsTestChannel(ctx, t, userID)
+	savedSearchID, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
+		Name:            "Test Search",
+		Query:           "is:widely",
+		OwnerUserID:     userID,
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
+	})
+	if err != nil {
+		t.Fatalf("failed to create saved search: %v", err)
+	}
+	subscriptionID, err := spannerClient.CreateSavedSearchSubscription(ctx, CreateSavedSearchSubscriptionRequest{
+		UserID:        userID,
+		ChannelID:     channelID,
+		ChannelType:   nil,
+		SavedSearchID: *savedSearchID,
+		Triggers:      nil,
+		Frequency:     SavedSearchSnapshotTypeImmediate,
+	})
+	if err != nil {
+		t.Fatalf("failed to create subscription: %v", err)
+	}
+
+	// The coalesce function appends the events of the incoming job that are not held yet.
+	type job struct {
+		Events []string `json:"events"`
+	}
+	coalesce := func(held, incoming []byte) ([]byte, bool, error) {
+		var a, b job
+		if err := json.Unmarshal(held, &a); err != nil {
+			return nil, false, err
+		}
+		if err := json.Unmarshal(incoming, &b); err != nil {
+			return nil, false, err
+		}
+		added := false
+		for _, event := range b.Events {
+			if !slices.Contains(a.Events, event) {
+				a.Events = append(a.Events, event)
+				added = true
+			}
+		}
+		ret, err := json.Marshal(a)
+
+		return ret, added, err
+	}
+	// The prune function removes the given events.
+	prune := func(delivered ...string) func([]byte) ([]byte, int64, error) {
+		return func(held []byte) ([]byte, int64, error) {
+			var j job
+			if err := json.Unmarshal(held, &j); err != nil {
+				return nil, 0, err
+			}
+			j.Events = slices.DeleteFunc(j.Events, func(e string) bool { return slices.Contains(delivered, e) })
+			ret, err := json.Marshal(j)
+
+			return ret, int64(len(j.Events)), err
+		}
+	}
+	hold := func(event string, releaseAt time.Time) {
+		t.Helper()
+		payload, _ := json.Marshal(job{Events: []string{event}})
+		err := spannerClient.HoldNotificationChannelDelivery(ctx, channelID, *subscriptionID, payload, releaseAt,
+			coalesce)
+		if err != nil {
+			t.Fatalf("HoldNotificationChannelDelivery failed: %v", err)
+		}
+	}
+	claim := func(now time.Time) []NotificationChannelHeldDelivery {
+		t.Helper()
+		due, err := spannerClient.ClaimDueNotificationChannelHeldDeliveries(ctx, now, 10, time.Minute)
+		if err != nil {
+			t.Fatalf("ClaimDueNotificationChannelHeldDeliveries failed: %v", err)
+		}
+
+		return due
+	}
+	events := func(d NotificationChannelHeldDelivery) []string {
+		t.Helper()
+		var got job
+		if err := json.Unmarshal(d.Job, &got); err != nil {
+			t.Fatalf("failed to unmarshal job: %v", err)
+		}
+
+		return got.Events
+	}
+
+	releaseAt := time.Date(2026, 3, 11, 7, 0, 0, 0, time.UTC)
+	// The later release time wins.
+	hold("evt-1", releaseAt)
+	hold("evt-2", releaseAt.Add(-time.Hour))
+	// A job that is already held is not coalesced again.
+	hold("evt-1", releaseAt)
+
+	held, err := spannerClient.GetNotificationChannelHeldDelivery(ctx, channelID, *subscriptionID)
+	if err != nil {
+		t.Fatalf("GetNotificationChannelHeldDelivery failed: %v", err)
+	}
+	if held.HeldCount != 2 || !held.ReleaseAt.Equal(releaseAt) || held.LeaseID != nil {
+		t.Errorf("unexpected held delivery %+v", held)
+	}
+
+	// Nothing is due yet.
+	if due := claim(releaseAt.Add(-time.Minute)); len(due) != 0 {
+		t.Fatalf("expected no due deliveries, got %d", len(due))
+	}
+
+	due := claim(releaseAt)
+	if len(due) != 1 || due[0].LeaseID == nil {
+		t.Fatalf("expected 1 leased delivery, got %+v", due)
+	}
+	if got := events(due[0]); !slices.Equal(got, []string{"evt-1", "evt-2"}) {
+		t.Errorf("expected coalesced events, got %v", got)
+	}
+	lease := *due[0].LeaseID
+
+	// Leased deliveries are not claimed again while the lease is active.
+	if again := claim(releaseAt.Add(30 * time.Second)); len(again) != 0 {
+		t.Fatalf("expected leased delivery to be skipped, got %d", len(again))
+	}
+
+	// A job held while the delivery is leased survives the completion of the lease.
+	hold("evt-3", releaseAt)
+	err = spannerClient.CompleteNotificationChannelHeldDelivery(ctx, channelID, *subscriptionID, lease,
+		prune("evt-1", "evt-2"))
+	if err != nil {
+		t.Fatalf("CompleteNotificationChannelHeldDelivery failed: %v", err)
+	}
+	held, err = spannerClient.GetNotificationChannelHeldDelivery(ctx, channelID, *subscriptionID)
+	if err != nil {
+		t.Fatalf("GetNotificationChannelHeldDelivery failed: %v", err)
+	}
+	if held.HeldCount != 1 || held.LeaseID != nil || !slices.Equal(events(*held), []string{"evt-3"}) {
+		t.Errorf("unexpected remaining delivery %+v", held)
+	}
+
+	// A rescheduled delivery is released at the new time.
+	due = claim(releaseAt)
+	if len(due) != 1 {
+		t.Fatalf("expected 1 due delivery, got %d", len(due))
+	}
+	later := releaseAt.Add(time.Hour)
+	err = spannerClient.RescheduleNotificationChannelHeldDelivery(ctx, channelID, *subscriptionID, *due[0].LeaseID,
+		later)
+	if err != nil {
+		t.Fatalf("RescheduleNotificationChannelHeldDelivery failed: %v", err)
+	}
+	if again := claim(releaseAt.Add(2 * time.Minute)); len(again) != 0 {
+		t.Fatalf("expected rescheduled delivery to be held, got %d", len(again))
+	}
+
+	// A delivery whose lease expired, e.g. because the worker crashed, is claimed again.
+	if due = claim(later); len(due) != 1 {
+		t.Fatalf("expected 1 due delivery, got %d", len(due))
+	}
+	due = claim(later.Add(2 * time.Minute))
+	if len(due) != 1 {
+		t.Fatalf("expected expired lease to be claimed again, got %d", len(due))
+	}
+
+	err = spannerClient.CompleteNotificationChannelHeldDelivery(ctx, channelID, *subscriptionID, *due[0].LeaseID,
+		prune("evt-3"))
+	if err != nil {
+		t.Fatalf("CompleteNotificationChannelHeldDelivery failed: %v", err)
+	}
+	// Completed deliveries are removed.
+	_, err = spannerClient.GetNotificationChannelHeldDelivery(ctx, channelID, *subscriptionID)
+	if !errors.Is(err, ErrQueryReturnedNoResults) {
+		t.Errorf("expected ErrQueryReturnedNoResults, got %v", err)
+	}
+}
@@ -110,7 +110,8 @@ func (c *Client) RecordNotificationChannelSuccess(
 				Status:           DeliveryAttemptStatusSuccess,
 				Details: spanner.NullJSON{Value: AttemptDetails{
 					EventID: eventID,
-					Message: "delivered"}, Valid: true},
+					Message: "delivered",
+					Attempt: 0}, Valid: true},
 			})
 
 		return err
@@ -172,7 +173,8 @@ func (c *Client) RecordNotificationChannelFailure(
 				Status:           DeliveryAttemptStatusFailure,
 				Details: spanner.NullJSON{Value: AttemptDetails{
 					EventID: eventID,
-					Message: errorMsg}, Valid: true},
+					Message: errorMsg,
+					Attempt: 0}, Valid: true},
 			})
 
 		return err
@@ -182,7 +184,29 @@ func (c *Client) RecordNotificationChannelFailure(
 	return err
 }
 
+// RecordNotificationChannelRetriedFailure logs a failed delivery attempt that the worker is going to retry
+// in the NotificationChannelDeliveryAttempts table.
+// It does not touch the NotificationChannelStates table since only the final outcome of a delivery counts
+// toward the consecutive failures count.
+func (c *Client) RecordNotificationChannelRetriedFailure(
+	ctx context.Context, channelID string, errorMsg string, timestamp time.Time,
+	attempt int, eventID string) error {
+	_, err := c.CreateNotificationChannelDeliveryAttempt(ctx, CreateNotificationChannelDeliveryAttemptRequest{
+		ChannelID:        channelID,
+		AttemptTimestamp: timestamp,
+		Status:           DeliveryAttemptStatusFailure,
+		Details: spanner.NullJSON{Value: AttemptDetails{
+			EventID: eventID,
+			Message: errorMsg,
+			Attempt: attempt}, Valid: true},
+	})
+
+	return err
+}
+
 type AttemptDetails struct {
 	Message string `json:"message"`
 	EventID string `json:"event_id"`
+	// Attempt is the number of the attempt within a single delivery. It is only set for attempts that were retried.
+	Attempt int `json:"attempt,omitempty"`
 }
@@ -223,6 +223,31 @@ func testRecordNotificationChannelFailure(t *testing.T, channelID string) {
 
 		verifyFailureAttemptAndState(t, channelID, 0, false, errorMsg, eventID)
 	})
+
+	t.Run("Retried Failure", func(t *testing.T) {
+		retriedState := *initialState
+		retriedState.ConsecutiveFailures = 2
+		_ = spannerClient.UpsertNotificationChannelState(ctx, retriedState)
+		testTime := time.Now()
+		errorMsg := "rate limited"
+		eventID := "evt-126"
+		err = spannerClient.RecordNotificationChannelRetriedFailure(ctx, channelID, errorMsg, testTime, 2, eventID)
+		if err != nil {
+			t.Fatalf("RecordNotificationChannelRetriedFailure failed: %v", err)
+		}
+
+		// The consecutive failures count is left untouched.
+		verifyFailureAttemptAndState(t, channelID, 2, false, errorMsg, eventID)
+
+		attempts, _, err := spannerClient.ListNotificationChannelDeliveryAttempts(ctx,
+			ListNotificationChannelDeliveryAttemptsRequest{ChannelID: channelID, PageSize: 1, PageToken: nil})
+		if err != nil {
+			t.Fatalf("ListNotificationChannelDeliveryAttempts failed: %v", err)
+		}
+		if attempts[0].AttemptDetails.Attempt != 2 {
+			t.Errorf("expected attempt 2, got %d", attempts[0].AttemptDetails.Attempt)
+		}
+	})
 }
 
 // verifyFailureAttemptAndState is a helper function to verify the state and delivery attempt after a failure.
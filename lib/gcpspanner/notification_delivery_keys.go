@@ -0,0 +1,141 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+const notificationDeliveryKeysTable = "NotificationDeliveryKeys"
+
+// NotificationDeliveryKeyStatus is the status of a delivery key.
+type NotificationDeliveryKeyStatus string
+
+const (
+	// NotificationDeliveryKeyStatusPending indicates that a worker claimed the key but has not
+	// successfully delivered the notification yet.
+	NotificationDeliveryKeyStatusPending NotificationDeliveryKeyStatus = "PENDING"
+	// NotificationDeliveryKeyStatusCompleted indicates that the notification was delivered.
+	NotificationDeliveryKeyStatusCompleted NotificationDeliveryKeyStatus = "COMPLETED"
+)
+
+// NotificationDeliveryKey represents a row in the NotificationDeliveryKeys table.
+type NotificationDeliveryKey struct {
+	ChannelID    string                        `spanner:"ChannelID"`
+	DeliveryKey  string                        `spanner:"DeliveryKey"`
+	Status       NotificationDeliveryKeyStatus `spanner:"Status"`
+	AttemptCount int64                         `spanner:"AttemptCount"`
+	CreatedAt    time.Time                     `spanner:"CreatedAt"`
+	UpdatedAt    time.Time                     `spanner:"UpdatedAt"`
+	CompletedAt  *time.Time                    `spanner:"CompletedAt"`
+}
+
+type notificationDeliveryKeyMapper struct{}
+
+type notificationDeliveryKeyKey struct {
+	ChannelID   string
+	DeliveryKey string
+}
+
+func (m notificationDeliveryKeyMapper) SelectOne(key notificationDeliveryKeyKey) spanner.Statement {
+	return spanner.Statement{
+		SQL: `SELECT ChannelID, DeliveryKey, Status, AttemptCount, CreatedAt, UpdatedAt, CompletedAt
+		FROM NotificationDeliveryKeys
+		WHERE ChannelID = @channelID AND DeliveryKey = @deliveryKey`,
+		Params: map[string]any{
+			"channelID":   key.ChannelID,
+			"deliveryKey": key.DeliveryKey,
+		},
+	}
+}
+
+// ErrDeliveryKeyAlreadyCompleted is returned when a delivery key has already been completed.
+var ErrDeliveryKeyAlreadyCompleted = errors.New("delivery key already completed")
+
+// ClaimNotificationDeliveryKey records the delivery key before a worker sends a notification.
+// The read and write happen in a single transaction so that concurrent redeliveries observe
+// the same state. If the key was already completed, ErrDeliveryKeyAlreadyCompleted is returned
+// and the caller must skip the send. Otherwise, the key is marked as pending and its attempt
+// count is incremented.
+func (c *Client) ClaimNotificationDeliveryKey(
+	ctx context.Context, channelID string, deliveryKey string, timestamp time.Time) error {
+	mutator := newEntityMutator[notificationDeliveryKeyMapper, NotificationDeliveryKey](c)
+	key := notificationDeliveryKeyKey{ChannelID: channelID, DeliveryKey: deliveryKey}
+
+	return mutator.readInspectMutate(ctx, key,
+		func(_ context.Context, existing *NotificationDeliveryKey) (*spanner.Mutation, error) {
+			if existing == nil {
+				return spanner.InsertStruct(notificationDeliveryKeysTable, NotificationDeliveryKey{
+					ChannelID:    channelID,
+					DeliveryKey:  deliveryKey,
+					Status:       NotificationDeliveryKeyStatusPending,
+					AttemptCount: 1,
+					CreatedAt:    timestamp,
+					UpdatedAt:    timestamp,
+					CompletedAt:  nil,
+				})
+			}
+
+			if existing.Status == NotificationDeliveryKeyStatusCompleted {
+				return nil, ErrDeliveryKeyAlreadyCompleted
+			}
+
+			existing.AttemptCount++
+			existing.UpdatedAt = timestamp
+
+			return spanner.UpdateStruct(notificationDeliveryKeysTable, *existing)
+		})
+}
+
+// CompleteNotificationDeliveryKey marks the delivery key as completed after a successful send.
+// Completing a key that was never claimed creates it directly in the completed state.
+func (c *Client) CompleteNotificationDeliveryKey(
+	ctx context.Context, channelID string, deliveryKey string, timestamp time.Time) error {
+	mutator := newEntityMutator[notificationDeliveryKeyMapper, NotificationDeliveryKey](c)
+	key := notificationDeliveryKeyKey{ChannelID: channelID, DeliveryKey: deliveryKey}
+
+	return mutator.readInspectMutate(ctx, key,
+		func(_ context.Context, existing *NotificationDeliveryKey) (*spanner.Mutation, error) {
+			if existing == nil {
+				existing = &NotificationDeliveryKey{
+					ChannelID:    channelID,
+					DeliveryKey:  deliveryKey,
+					Status:       NotificationDeliveryKeyStatusPending,
+					AttemptCount: 1,
+					CreatedAt:    timestamp,
+					UpdatedAt:    timestamp,
+					CompletedAt:  nil,
+				}
+			}
+			existing.Status = NotificationDeliveryKeyStatusCompleted
+			existing.UpdatedAt = timestamp
+			existing.CompletedAt = &timestamp
+
+			return spanner.InsertOrUpdateStruct(notificationDeliveryKeysTable, *existing)
+		})
+}
+
+// GetNotificationDeliveryKey retrieves a delivery key.
+// If no such row exists, ErrQueryReturnedNoResults is returned.
+func (c *Client) GetNotificationDeliveryKey(
+	ctx context.Context, channelID string, deliveryKey string) (*NotificationDeliveryKey, error) {
+	r := newEntityReader[notificationDeliveryKeyMapper, NotificationDeliveryKey, notificationDeliveryKeyKey](c)
+
+	return r.readRowByKey(ctx, notificationDeliveryKeyKey{ChannelID: channelID, DeliveryKey: deliveryKey})
+}
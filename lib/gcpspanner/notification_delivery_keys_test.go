@@ -0,0 +1,166 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestNotificationDeliveryKeyOperations(t *testing.T) {
+	ctx := context.Background()
+	restartDatabaseContainer(t)
+
+	userID := uuid.NewString()
+	createReq := CreateNotificationChannelRequest{
+		UserID:        userID,
+		Name:          "Test Channel",
+		Type:          NotificationChannelTypeEmail,
+		EmailConfig:   &EmailConfig{Address: "test@example.com", IsVerified: true, VerificationToken: nil},
+		WebhookConfig: nil,
+	}
+	channelIDPtr, err := spannerClient.CreateNotificationChannel(ctx, createReq)
+	if err != nil {
+		t.Fatalf("failed to create notification channel: %v", err)
+	}
+	channelID := *channelIDPtr
+	deliveryKey := "delivery-key-1"
+
+	firstClaim := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	secondClaim := firstClaim.Add(time.Minute)
+	completedAt := secondClaim.Add(time.Minute)
+
+	t.Run("Claim new key", func(t *testing.T) {
+		err := spannerClient.ClaimNotificationDeliveryKey(ctx, channelID, deliveryKey, firstClaim)
+		if err != nil {
+			t.Fatalf("ClaimNotificationDeliveryKey failed: %v", err)
+		}
+
+		assertNotificationDeliveryKey(ctx, t, channelID, deliveryKey, &NotificationDeliveryKey{
+			ChannelID:    channelID,
+			DeliveryKey:  deliveryKey,
+			Status:       NotificationDeliveryKeyStatusPending,
+			AttemptCount: 1,
+			CreatedAt:    firstClaim,
+			UpdatedAt:    firstClaim,
+			CompletedAt:  nil,
+		})
+	})
+
+	t.Run("Claim pending key again", func(t *testing.T) {
+		err := spannerClient.ClaimNotificationDeliveryKey(ctx, channelID, deliveryKey, secondClaim)
+		if err != nil {
+			t.Fatalf("ClaimNotificationDeliveryKey failed: %v", err)
+		}
+
+		assertNotificationDeliveryKey(ctx, t, channelID, deliveryKey, &NotificationDeliveryKey{
+			ChannelID:    channelID,
+			DeliveryKey:  deliveryKey,
+			Status:       NotificationDeliveryKeyStatusPending,
+			AttemptCount: 2,
+			CreatedAt:    firstClaim,
+			UpdatedAt:    secondClaim,
+			CompletedAt:  nil,
+		})
+	})
+
+	t.Run("Complete key", func(t *testing.T) {
+		err := spannerClient.CompleteNotificationDeliveryKey(ctx, channelID, deliveryKey, completedAt)
+		if err != nil {
+			t.Fatalf("CompleteNotificationDeliveryKey failed: %v", err)
+		}
+
+		assertNotificationDeliveryKey(ctx, t, channelID, deliveryKey, &NotificationDeliveryKey{
+			ChannelID:    channelID,
+			DeliveryKey:  deliveryKey,
+			Status:       NotificationDeliveryKeyStatusCompleted,
+			AttemptCount: 2,
+			CreatedAt:    firstClaim,
+			UpdatedAt:    completedAt,
+			CompletedAt:  &completedAt,
+		})
+	})
+
+	t.Run("Claim completed key", func(t *testing.T) {
+		err := spannerClient.ClaimNotificationDeliveryKey(ctx, channelID, deliveryKey, completedAt.Add(time.Minute))
+		if !errors.Is(err, ErrDeliveryKeyAlreadyCompleted) {
+			t.Fatalf("expected ErrDeliveryKeyAlreadyCompleted, got %v", err)
+		}
+
+		// The row should be unchanged.
+		assertNotificationDeliveryKey(ctx, t, channelID, deliveryKey, &NotificationDeliveryKey{
+			ChannelID:    channelID,
+			DeliveryKey:  deliveryKey,
+			Status:       NotificationDeliveryKeyStatusCompleted,
+			AttemptCount: 2,
+			CreatedAt:    firstClaim,
+			UpdatedAt:    completedAt,
+			CompletedAt:  &completedAt,
+		})
+	})
+
+	t.Run("Complete unclaimed key", func(t *testing.T) {
+		otherKey := "delivery-key-2"
+		err := spannerClient.CompleteNotificationDeliveryKey(ctx, channelID, otherKey, completedAt)
+		if err != nil {
+			t.Fatalf("CompleteNotificationDeliveryKey failed: %v", err)
+		}
+
+		assertNotificationDeliveryKey(ctx, t, channelID, otherKey, &NotificationDeliveryKey{
+			ChannelID:    channelID,
+			DeliveryKey:  otherKey,
+			Status:       NotificationDeliveryKeyStatusCompleted,
+			AttemptCount: 1,
+			CreatedAt:    completedAt,
+			UpdatedAt:    completedAt,
+			CompletedAt:  &completedAt,
+		})
+	})
+
+	t.Run("Keys are scoped to a channel", func(t *testing.T) {
+		otherChannelIDPtr, err := spannerClient.CreateNotificationChannel(ctx, createReq)
+		if err != nil {
+			t.Fatalf("failed to create notification channel: %v", err)
+		}
+		err = spannerClient.ClaimNotificationDeliveryKey(ctx, *otherChannelIDPtr, deliveryKey, firstClaim)
+		if err != nil {
+			t.Fatalf("expected claim on a different channel to succeed, got %v", err)
+		}
+	})
+
+	t.Run("Get missing key", func(t *testing.T) {
+		_, err := spannerClient.GetNotificationDeliveryKey(ctx, channelID, "missing")
+		if !errors.Is(err, ErrQueryReturnedNoResults) {
+			t.Errorf("expected ErrQueryReturnedNoResults, got %v", err)
+		}
+	})
+}
+
+func assertNotificationDeliveryKey(ctx context.Context, t *testing.T, channelID, deliveryKey string,
+	expected *NotificationDeliveryKey) {
+	t.Helper()
+	retrieved, err := spannerClient.GetNotificationDeliveryKey(ctx, channelID, deliveryKey)
+	if err != nil {
+		t.Fatalf("GetNotificationDeliveryKey failed: %v", err)
+	}
+	if diff := cmp.Diff(expected, retrieved); diff != "" {
+		t.Errorf("GetNotificationDeliveryKey mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
+)
+
+const personalAccessTokensTable = "PersonalAccessTokens"
+
+// maxPersonalAccessTokensPerUser is the maximum number of personal access tokens a user can have.
+const maxPersonalAccessTokensPerUser = 20
+
+// personalAccessTokenLastUsedResolution is how old LastUsedAt can be before it is updated again.
+// It avoids a write on every request authenticated with the same token.
+const personalAccessTokenLastUsedResolution = 5 * time.Minute
+
+var (
+	// ErrPersonalAccessTokenNotFound indicates the token does not exist or belongs to another user.
+	ErrPersonalAccessTokenNotFound = errors.New("personal access token not found")
+	// ErrPersonalAccessTokenLimitExceeded indicates the user already has the maximum number of tokens.
+	ErrPersonalAccessTokenLimitExceeded = errors.New("personal access token limit reached")
+)
+
+// PersonalAccessTokenScope limits what a personal access token can do.
+type PersonalAccessTokenScope string
+
+const (
+	PersonalAccessTokenScopeReadOnly            PersonalAccessTokenScope = "read_only"
+	PersonalAccessTokenScopeManageSubscriptions PersonalAccessTokenScope = "manage_subscriptions"
+)
+
+// PersonalAccessToken represents a row in the PersonalAccessTokens table, without the hash of the token.
+type PersonalAccessToken struct {
+	ID         string                   `spanner:"ID"`
+	UserID     string                   `spanner:"UserID"`
+	Name       string                   `spanner:"Name"`
+	Scope      PersonalAccessTokenScope `spanner:"Scope"`
+	CreatedAt  time.Time                `spanner:"CreatedAt"`
+	LastUsedAt *time.Time               `spanner:"LastUsedAt"`
+}
+
+// spannerPersonalAccessToken is the internal struct for Spanner mapping.
+type spannerPersonalAccessToken struct {
+	PersonalAccessToken
+	TokenHash string `spanner:"TokenHash"`
+}
+
+// CreatePersonalAccessTokenRequest is the request to store a new personal access token.
+type CreatePersonalAccessTokenRequest struct {
+	UserID string
+	Name   string
+	Scope  PersonalAccessTokenScope
+	// TokenHash is the hex encoded SHA-256 hash of the token.
+	TokenHash string
+}
+
+// CreatePersonalAccessToken stores a new personal access token for the user.
+// It returns ErrPersonalAccessTokenLimitExceeded if the user already has too many tokens.
+func (c *Client) CreatePersonalAccessToken(
+	ctx context.Context, req CreatePersonalAccessTokenRequest) (*PersonalAccessToken, error) {
+	token := spannerPersonalAccessToken{
+		PersonalAccessToken: PersonalAccessToken{
+			ID:         uuid.NewString(),
+			UserID:     req.UserID,
+			Name:       req.Name,
+			Scope:      req.Scope,
+			CreatedAt:  spanner.CommitTimestamp,
+			LastUsedAt: nil,
+		},
+		TokenHash: req.TokenHash,
+	}
+	commitTimestamp, err := c.ReadWriteTransaction(ctx, func(
+		ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		stmt := spanner.Statement{
+			SQL:    `SELECT COUNT(*) FROM PersonalAccessTokens WHERE UserID = @userID`,
+			Params: map[string]any{"userID": req.UserID},
+		}
+		var count int64
+		err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+			return row.Columns(&count)
+		})
+		if err != nil {
+			return errors.Join(ErrInternalQueryFailure, err)
+		}
+		if count >= maxPersonalAccessTokensPerUser {
+			return ErrPersonalAccessTokenLimitExceeded
+		}
+
+		m, err := spanner.InsertStruct(personalAccessTokensTable, token)
+		if err != nil {
+			return errors.Join(ErrInternalMutationFailure, err)
+		}
+
+		return txn.BufferWrite([]*spanner.Mutation{m})
+	})
+	if err != nil {
+		return nil, err
+	}
+	token.CreatedAt = commitTimestamp
+
+	return &token.PersonalAccessToken, nil
+}
+
+// ListPersonalAccessTokens returns the personal access tokens of the user, newest first.
+func (c *Client) ListPersonalAccessTokens(ctx context.Context, userID string) ([]PersonalAccessToken, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT ID, UserID, Name, Scope, CreatedAt, LastUsedAt
+			FROM PersonalAccessTokens
+			WHERE UserID = @userID
+			ORDER BY CreatedAt DESC, ID`,
+		Params: map[string]any{"userID": userID},
+	}
+	txn := c.Single()
+	defer txn.Close()
+
+	tokens := []PersonalAccessToken{}
+	err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		var token PersonalAccessToken
+		if err := row.ToStruct(&token); err != nil {
+			return err
+		}
+		tokens = append(tokens, token)
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return tokens, nil
+}
+
+// DeletePersonalAccessToken revokes a personal access token of the user.
+// It returns ErrPersonalAccessTokenNotFound if the user does not own the token.
+func (c *Client) DeletePersonalAccessToken(ctx context.Context, userID string, tokenID string) error {
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		stmt := spanner.Statement{
+			SQL:    `SELECT ID FROM PersonalAccessTokens WHERE ID = @id AND UserID = @userID`,
+			Params: map[string]any{"id": tokenID, "userID": userID},
+		}
+		it := txn.Query(ctx, stmt)
+		defer it.Stop()
+		_, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return ErrPersonalAccessTokenNotFound
+		} else if err != nil {
+			return errors.Join(ErrInternalQueryFailure, err)
+		}
+
+		return txn.BufferWrite([]*spanner.Mutation{
+			spanner.Delete(personalAccessTokensTable, spanner.Key{tokenID}),
+		})
+	})
+
+	return err
+}
+
+// GetPersonalAccessTokenByHash returns the personal access token with the given hash and records that it was used.
+// It returns ErrPersonalAccessTokenNotFound if no token has the hash.
+func (c *Client) GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*PersonalAccessToken, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT ID, UserID, Name, Scope, CreatedAt, LastUsedAt
+			FROM PersonalAccessTokens
+			WHERE TokenHash = @tokenHash`,
+		Params: map[string]any{"tokenHash": tokenHash},
+	}
+	txn := c.Single()
+	defer txn.Close()
+
+	it := txn.Query(ctx, stmt)
+	defer it.Stop()
+	row, err := it.Next()
+	if errors.Is(err, iterator.Done) {
+		return nil, ErrPersonalAccessTokenNotFound
+	} else if err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+	var token PersonalAccessToken
+	if err := row.ToStruct(&token); err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	if token.LastUsedAt == nil || c.timeNow().Sub(*token.LastUsedAt) > personalAccessTokenLastUsedResolution {
+		_, err := c.Apply(ctx, []*spanner.Mutation{
+			spanner.Update(personalAccessTokensTable,
+				[]string{"ID", "LastUsedAt"}, []any{token.ID, spanner.CommitTimestamp}),
+		})
+		// Failing to track the usage must not prevent the token from being used.
+		if err != nil {
+			slog.WarnContext(ctx, "unable to update the last use of a personal access token", "id", token.ID,
+				"error", err)
+		}
+	}
+
+	return &token, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestPersonalAccessTokens(t *testing.T) {
+	restartDatabaseContainer(t)
+	ctx := context.Background()
+	userID := uuid.NewString()
+	otherUserID := uuid.NewString()
+
+	first, err := spannerClient.CreatePersonalAccessToken(ctx, CreatePersonalAccessTokenRequest{
+		UserID:    userID,
+		Name:      "ci",
+		Scope:     PersonalAccessTokenScopeReadOnly,
+		TokenHash: "hash-1",
+	})
+	if err != nil {
+		t.Fatalf("CreatePersonalAccessToken failed: %v", err)
+	}
+	if first.Name != "ci" || first.Scope != PersonalAccessTokenScopeReadOnly || first.LastUsedAt != nil {
+		t.Errorf("unexpected token %+v", first)
+	}
+	second, err := spannerClient.CreatePersonalAccessToken(ctx, CreatePersonalAccessTokenRequest{
+		UserID:    userID,
+		Name:      "subscriptions script",
+		Scope:     PersonalAccessTokenScopeManageSubscriptions,
+		TokenHash: "hash-2",
+	})
+	if err != nil {
+		t.Fatalf("CreatePersonalAccessToken failed: %v", err)
+	}
+
+	tokens, err := spannerClient.ListPersonalAccessTokens(ctx, userID)
+	if err != nil {
+		t.Fatalf("ListPersonalAccessTokens failed: %v", err)
+	}
+	if len(tokens) != 2 || tokens[0].ID != second.ID || tokens[1].ID != first.ID {
+		t.Errorf("unexpected tokens %+v", tokens)
+	}
+	tokens, err = spannerClient.ListPersonalAccessTokens(ctx, otherUserID)
+	if err != nil {
+		t.Fatalf("ListPersonalAccessTokens failed: %v", err)
+	}
+	if len(tokens) != 0 {
+		t.Errorf("expected no tokens for the other user, got %+v", tokens)
+	}
+
+	// Lookup by hash records the use of the token.
+	token, err := spannerClient.GetPersonalAccessTokenByHash(ctx, "hash-1")
+	if err != nil {
+		t.Fatalf("GetPersonalAccessTokenByHash failed: %v", err)
+	}
+	if token.ID != first.ID || token.UserID != userID {
+		t.Errorf("unexpected token %+v", token)
+	}
+	token, err = spannerClient.GetPersonalAccessTokenByHash(ctx, "hash-1")
+	if err != nil {
+		t.Fatalf("GetPersonalAccessTokenByHash failed: %v", err)
+	}
+	if token.LastUsedAt == nil {
+		t.Error("expected LastUsedAt to be set")
+	}
+	_, err = spannerClient.GetPersonalAccessTokenByHash(ctx, "unknown")
+	if !errors.Is(err, ErrPersonalAccessTokenNotFound) {
+		t.Errorf("expected ErrPersonalAccessTokenNotFound, got %v", err)
+	}
+
+	// Only the owner can revoke a token.
+	err = spannerClient.DeletePersonalAccessToken(ctx, otherUserID, first.ID)
+	if !errors.Is(err, ErrPersonalAccessTokenNotFound) {
+		t.Errorf("expected ErrPersonalAccessTokenNotFound, got %v", err)
+	}
+	err = spannerClient.DeletePersonalAccessToken(ctx, userID, first.ID)
+	if err != nil {
+		t.Fatalf("DeletePersonalAccessToken failed: %v", err)
+	}
+	_, err = spannerClient.GetPersonalAccessTokenByHash(ctx, "hash-1")
+	if !errors.Is(err, ErrPersonalAccessTokenNotFound) {
+		t.Errorf("expected revoked token to be not found, got %v", err)
+	}
+}
+
+func TestCreatePersonalAccessTokenLimit(t *testing.T) {
+	restartDatabaseContainer(t)
+	ctx := context.Background()
+	userID := uuid.NewString()
+
+	for i := range maxPersonalAccessTokensPerUser {
+		_, err := spannerClient.CreatePersonalAccessToken(ctx, CreatePersonalAccessTokenRequest{
+			UserID:    userID,
+			Name:      fmt.Sprintf("token %d", i),
+			Scope:     PersonalAccessTokenScopeReadOnly,
+			TokenHash: fmt.Sprintf("hash-%d", i),
+		})
+		if err != nil {
+			t.Fatalf("CreatePersonalAccessToken failed: %v", err)
+		}
+	}
+	_, err := spannerClient.CreatePersonalAccessToken(ctx, CreatePersonalAccessTokenRequest{
+		UserID:    userID,
+		Name:      "one too many",
+		Scope:     PersonalAccessTokenScopeReadOnly,
+		TokenHash: "hash-extra",
+	})
+	if !errors.Is(err, ErrPersonalAccessTokenLimitExceeded) {
+		t.Errorf("expected ErrPersonalAccessTokenLimitExceeded, got %v", err)
+	}
+}
@@ -0,0 +1,322 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+// maxCollaboratorsPerSavedSearch caps the number of users with a role on a single saved search,
+// including the owner.
+const maxCollaboratorsPerSavedSearch = 50
+
+var (
+	// ErrCannotChangeOwnerRole indicates that the request would add, change or remove the role of the
+	// owner. Ownership can only move through TransferSavedSearchOwnership.
+	ErrCannotChangeOwnerRole = errors.New("the owner role can only be changed by transferring ownership")
+	// ErrInvalidCollaboratorRole indicates that the role can not be granted to a collaborator.
+	ErrInvalidCollaboratorRole = errors.New("invalid collaborator role")
+	// ErrSavedSearchCollaboratorLimitExceeded indicates that the saved search already has
+	// the maximum number of collaborators.
+	ErrSavedSearchCollaboratorLimitExceeded = errors.New("saved search collaborator limit reached")
+)
+
+// SavedSearchCollaborator is a user with a role on a saved search.
+type SavedSearchCollaborator struct {
+	UserID string          `spanner:"UserID"`
+	Role   SavedSearchRole `spanner:"UserRole"`
+	// GitHubUsername is nil if the user has not synced their GitHub profile.
+	GitHubUsername *string `spanner:"GitHubUsername"`
+}
+
+// PutSavedSearchCollaboratorRequest grants a role on a saved search to the user with the GitHub username.
+type PutSavedSearchCollaboratorRequest struct {
+	SavedSearchID    string
+	RequestingUserID string
+	GitHubUsername   string
+	Role             SavedSearchRole
+}
+
+// RemoveSavedSearchCollaboratorRequest removes the role of the user with the GitHub username.
+type RemoveSavedSearchCollaboratorRequest struct {
+	SavedSearchID    string
+	RequestingUserID string
+	GitHubUsername   string
+}
+
+// TransferSavedSearchOwnershipRequest makes the user with the GitHub username the owner of the saved search.
+type TransferSavedSearchOwnershipRequest struct {
+	SavedSearchID          string
+	RequestingUserID       string
+	NewOwnerGitHubUsername string
+}
+
+// ListSavedSearchCollaborators returns the users with a role on the saved search, owner first.
+// The requesting user must have a role on the saved search.
+func (c *Client) ListSavedSearchCollaborators(
+	ctx context.Context, savedSearchID string, requestingUserID string) ([]SavedSearchCollaborator, error) {
+	var ret []SavedSearchCollaborator
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		err := c.checkForSavedSearchRole(ctx, txn, SavedSearchViewer, requestingUserID, savedSearchID)
+		if err != nil {
+			return err
+		}
+		ret, err = listSavedSearchCollaborators(ctx, txn, savedSearchID)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func listSavedSearchCollaborators(
+	ctx context.Context, txn transaction, savedSearchID string) ([]SavedSearchCollaborator, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT r.UserID, r.UserRole, p.GitHubUsername
+			FROM %s r
+			LEFT JOIN %s p ON r.UserID = p.UserID
+			WHERE r.SavedSearchID = @savedSearchID
+			ORDER BY
+				CASE r.UserRole WHEN @owner THEN 0 WHEN @editor THEN 1 ELSE 2 END,
+				LOWER(p.GitHubUsername), r.UserID`, savedSearchUserRolesTable, userGitHubProfilesTable),
+		Params: map[string]any{
+			"savedSearchID": savedSearchID,
+			"owner":         string(SavedSearchOwner),
+			"editor":        string(SavedSearchEditor),
+		},
+	}
+	var collaborators []SavedSearchCollaborator
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+	err := iter.Do(func(row *spanner.Row) error {
+		var collaborator SavedSearchCollaborator
+		if err := row.ToStruct(&collaborator); err != nil {
+			return err
+		}
+		collaborators = append(collaborators, collaborator)
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return collaborators, nil
+}
+
+// getSavedSearchRole returns the role of the user on the saved search, or nil if the user has none.
+func getSavedSearchRole(
+	ctx context.Context, txn transaction, savedSearchID, userID string) (*SavedSearchRole, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT UserRole FROM %s WHERE SavedSearchID = @savedSearchID AND UserID = @userID`,
+			savedSearchUserRolesTable),
+		Params: map[string]any{
+			"savedSearchID": savedSearchID,
+			"userID":        userID,
+		},
+	}
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+	row, err := iter.Next()
+	if err != nil {
+		if errors.Is(err, iterator.Done) {
+			return nil, nil
+		}
+
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+	var role string
+	if err := row.Columns(&role); err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return new(SavedSearchRole(role)), nil
+}
+
+// PutSavedSearchCollaborator grants the EDITOR or VIEWER role to the user with the GitHub username,
+// replacing any non-owner role they already have. Only the owner can manage collaborators.
+// The saved search is bookmarked for the collaborator so that it shows up in their list.
+func (c *Client) PutSavedSearchCollaborator(
+	ctx context.Context, req PutSavedSearchCollaboratorRequest) (*SavedSearchCollaborator, error) {
+	if req.Role != SavedSearchEditor && req.Role != SavedSearchViewer {
+		return nil, ErrInvalidCollaboratorRole
+	}
+	var ret *SavedSearchCollaborator
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		err := c.checkForSavedSearchRole(ctx, txn, SavedSearchOwner, req.RequestingUserID, req.SavedSearchID)
+		if err != nil {
+			return err
+		}
+		userID, err := getUserIDByGitHubUsername(ctx, txn, req.GitHubUsername)
+		if err != nil {
+			return err
+		}
+		existing, err := getSavedSearchRole(ctx, txn, req.SavedSearchID, userID)
+		if err != nil {
+			return err
+		}
+		if existing != nil && *existing == SavedSearchOwner {
+			return ErrCannotChangeOwnerRole
+		}
+		if existing == nil {
+			collaborators, err := listSavedSearchCollaborators(ctx, txn, req.SavedSearchID)
+			if err != nil {
+				return err
+			}
+			if len(collaborators) >= maxCollaboratorsPerSavedSearch {
+				return ErrSavedSearchCollaboratorLimitExceeded
+			}
+		}
+
+		roleMutation, err := spanner.InsertOrUpdateStruct(savedSearchUserRolesTable, SavedSearchUserRole{
+			SavedSearchID: req.SavedSearchID,
+			UserID:        userID,
+			UserRole:      req.Role,
+		})
+		if err != nil {
+			return errors.Join(ErrInternalQueryFailure, err)
+		}
+		bookmarkMutation, err := spanner.InsertOrUpdateStruct(userSavedSearchBookmarksTable, UserSavedSearchBookmark{
+			SavedSearchID: req.SavedSearchID,
+			UserID:        userID,
+		})
+		if err != nil {
+			return errors.Join(ErrInternalQueryFailure, err)
+		}
+		ret = &SavedSearchCollaborator{
+			UserID:         userID,
+			Role:           req.Role,
+			GitHubUsername: &req.GitHubUsername,
+		}
+
+		return txn.BufferWrite([]*spanner.Mutation{roleMutation, bookmarkMutation})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+// RemoveSavedSearchCollaborator removes the role of the user with the GitHub username.
+// The owner can remove any collaborator and collaborators can remove themselves.
+// It returns ErrQueryReturnedNoResults if the user is not a collaborator.
+func (c *Client) RemoveSavedSearchCollaborator(ctx context.Context, req RemoveSavedSearchCollaboratorRequest) error {
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		requesterRole, err := getSavedSearchRole(ctx, txn, req.SavedSearchID, req.RequestingUserID)
+		if err != nil {
+			return err
+		}
+		if requesterRole == nil {
+			return ErrMissingRequiredRole
+		}
+		userID, err := getUserIDByGitHubUsername(ctx, txn, req.GitHubUsername)
+		if err != nil {
+			return err
+		}
+		if userID != req.RequestingUserID && *requesterRole != SavedSearchOwner {
+			return ErrMissingRequiredRole
+		}
+		existing, err := getSavedSearchRole(ctx, txn, req.SavedSearchID, userID)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			return ErrQueryReturnedNoResults
+		}
+		if *existing == SavedSearchOwner {
+			return ErrCannotChangeOwnerRole
+		}
+
+		// The bookmark is kept. The user can remove it like any other bookmark.
+		return txn.BufferWrite([]*spanner.Mutation{
+			spanner.Delete(savedSearchUserRolesTable, spanner.Key{userID, req.SavedSearchID}),
+		})
+	})
+
+	return err
+}
+
+// TransferSavedSearchOwnership makes the user with the GitHub username the owner of the saved search.
+// The previous owner becomes an editor. The new owner must be below their owned saved search limit.
+func (c *Client) TransferSavedSearchOwnership(ctx context.Context, req TransferSavedSearchOwnershipRequest) error {
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		err := c.checkForSavedSearchRole(ctx, txn, SavedSearchOwner, req.RequestingUserID, req.SavedSearchID)
+		if err != nil {
+			return err
+		}
+		newOwnerID, err := getUserIDByGitHubUsername(ctx, txn, req.NewOwnerGitHubUsername)
+		if err != nil {
+			return err
+		}
+		if newOwnerID == req.RequestingUserID {
+			// Already the owner.
+			return nil
+		}
+
+		var count int64
+		stmt := spanner.Statement{
+			SQL: fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE UserID = @userID AND UserRole = @role`,
+				savedSearchUserRolesTable),
+			Params: map[string]any{
+				"userID": newOwnerID,
+				"role":   SavedSearchOwner,
+			},
+		}
+		row, err := txn.Query(ctx, stmt).Next()
+		if err != nil {
+			return errors.Join(ErrInternalQueryFailure, err)
+		}
+		if err := row.Columns(&count); err != nil {
+			return errors.Join(ErrInternalQueryFailure, err)
+		}
+		if count >= int64(c.searchCfg.maxOwnedSearchesPerUser) {
+			return ErrOwnerSavedSearchLimitExceeded
+		}
+
+		var mutations []*spanner.Mutation
+		for _, role := range []SavedSearchUserRole{
+			{SavedSearchID: req.SavedSearchID, UserID: newOwnerID, UserRole: SavedSearchOwner},
+			{SavedSearchID: req.SavedSearchID, UserID: req.RequestingUserID, UserRole: SavedSearchEditor},
+		} {
+			m, err := spanner.InsertOrUpdateStruct(savedSearchUserRolesTable, role)
+			if err != nil {
+				return errors.Join(ErrInternalQueryFailure, err)
+			}
+			mutations = append(mutations, m)
+		}
+		m, err := spanner.InsertOrUpdateStruct(userSavedSearchBookmarksTable, UserSavedSearchBookmark{
+			SavedSearchID: req.SavedSearchID,
+			UserID:        newOwnerID,
+		})
+		if err != nil {
+			return errors.Join(ErrInternalQueryFailure, err)
+		}
+		mutations = append(mutations, m)
+
+		return txn.BufferWrite(mutations)
+	})
+
+	return err
+}
@@ -0,0 +1,207 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func syncTestGitHubProfile(ctx context.Context, t *testing.T, userID string, githubID int64, username string) {
+	t.Helper()
+	err := spannerClient.SyncUserProfileInfo(ctx, UserProfile{
+		UserID:         userID,
+		GitHubUserID:   githubID,
+		GitHubUsername: username,
+		Emails:         nil,
+	})
+	if err != nil {
+		t.Fatalf("SyncUserProfileInfo failed: %v", err)
+	}
+}
+
+func TestSavedSearchCollaborators(t *testing.T) {
+	restartDatabaseContainer(t)
+	ctx := context.Background()
+
+	syncTestGitHubProfile(ctx, t, "owner", 1, "Owner")
+	syncTestGitHubProfile(ctx, t, "editor", 2, "Editor")
+	syncTestGitHubProfile(ctx, t, "viewer", 3, "viewer")
+	savedSearchID, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
+		Name:            "interop",
+		Query:           "group:css",
+		OwnerUserID:     "owner",
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
+	})
+	if err != nil {
+		t.Fatalf("CreateNewUserSavedSearch failed: %v", err)
+	}
+	id := *savedSearchID
+
+	// Usernames are resolved case insensitively.
+	for username, role := range map[string]SavedSearchRole{"EDITOR": SavedSearchEditor, "Viewer": SavedSearchViewer} {
+		_, err := spannerClient.PutSavedSearchCollaborator(ctx, PutSavedSearchCollaboratorRequest{
+			SavedSearchID:    id,
+			RequestingUserID: "owner",
+			GitHubUsername:   username,
+			Role:             role,
+		})
+		if err != nil {
+			t.Fatalf("PutSavedSearchCollaborator(%s) failed: %v", username, err)
+		}
+	}
+
+	collaborators, err := spannerClient.ListSavedSearchCollaborators(ctx, id, "viewer")
+	if err != nil {
+		t.Fatalf("ListSavedSearchCollaborators failed: %v", err)
+	}
+	expected := []SavedSearchCollaborator{
+		{UserID: "owner", Role: SavedSearchOwner, GitHubUsername: new("Owner")},
+		{UserID: "editor", Role: SavedSearchEditor, GitHubUsername: new("Editor")},
+		{UserID: "viewer", Role: SavedSearchViewer, GitHubUsername: new("viewer")},
+	}
+	if diff := cmp.Diff(expected, collaborators); diff != "" {
+		t.Errorf("collaborators mismatch (-want +got):\n%s", diff)
+	}
+
+	// The collaborator sees the saved search in their list with their role.
+	page, err := spannerClient.ListUserSavedSearches(ctx, "editor", 10, nil)
+	if err != nil {
+		t.Fatalf("ListUserSavedSearches failed: %v", err)
+	}
+	if len(page.Searches) != 1 || page.Searches[0].Role == nil || *page.Searches[0].Role != string(SavedSearchEditor) {
+		t.Errorf("expected the shared search in the editor's list, got %+v", page.Searches)
+	}
+
+	update := func(userID string) error {
+		return spannerClient.UpdateUserSavedSearch(ctx, UpdateSavedSearchRequest{
+			ID:              id,
+			AuthorID:        userID,
+			Query:           OptionallySet[string]{IsSet: true, Value: "group:html"},
+			Name:            OptionallySet[string]{IsSet: false, Value: ""},
+			Description:     OptionallySet[*string]{IsSet: false, Value: nil},
+			Tags:            OptionallySet[[]string]{Value: nil, IsSet: false},
+			Listed:          OptionallySet[bool]{Value: false, IsSet: false},
+			QueryParameters: OptionallySet[[]SavedSearchQueryParameter]{Value: nil, IsSet: false},
+		})
+	}
+	if err := update("editor"); err != nil {
+		t.Errorf("expected editor to update the saved search, got %v", err)
+	}
+	if err := update("viewer"); !errors.Is(err, ErrMissingRequiredRole) {
+		t.Errorf("expected ErrMissingRequiredRole for viewer update, got %v", err)
+	}
+	err = spannerClient.DeleteUserSavedSearch(ctx, DeleteUserSavedSearchRequest{
+		SavedSearchID:    id,
+		RequestingUserID: "editor",
+		Force:            false,
+	})
+	if !errors.Is(err, ErrMissingRequiredRole) {
+		t.Errorf("expected ErrMissingRequiredRole for editor delete, got %v", err)
+	}
+
+	errorCases := []struct {
+		name string
+		req  PutSavedSearchCollaboratorRequest
+		want error
+	}{
+		{
+			name: "editor can not manage collaborators",
+			req: PutSavedSearchCollaboratorRequest{
+				SavedSearchID: id, RequestingUserID: "editor", GitHubUsername: "viewer", Role: SavedSearchEditor},
+			want: ErrMissingRequiredRole,
+		},
+		{
+			name: "unknown username",
+			req: PutSavedSearchCollaboratorRequest{
+				SavedSearchID: id, RequestingUserID: "owner", GitHubUsername: "nobody", Role: SavedSearchEditor},
+			want: ErrUnknownGitHubUsername,
+		},
+		{
+			name: "owner role can not be changed",
+			req: PutSavedSearchCollaboratorRequest{
+				SavedSearchID: id, RequestingUserID: "owner", GitHubUsername: "owner", Role: SavedSearchViewer},
+			want: ErrCannotChangeOwnerRole,
+		},
+		{
+			name: "owner role can not be granted",
+			req: PutSavedSearchCollaboratorRequest{
+				SavedSearchID: id, RequestingUserID: "owner", GitHubUsername: "viewer", Role: SavedSearchOwner},
+			want: ErrInvalidCollaboratorRole,
+		},
+	}
+	for _, tc := range errorCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := spannerClient.PutSavedSearchCollaborator(ctx, tc.req)
+			if !errors.Is(err, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, err)
+			}
+		})
+	}
+
+	// A collaborator can leave, but can not remove others.
+	err = spannerClient.RemoveSavedSearchCollaborator(ctx, RemoveSavedSearchCollaboratorRequest{
+		SavedSearchID: id, RequestingUserID: "viewer", GitHubUsername: "editor"})
+	if !errors.Is(err, ErrMissingRequiredRole) {
+		t.Errorf("expected ErrMissingRequiredRole, got %v", err)
+	}
+	err = spannerClient.RemoveSavedSearchCollaborator(ctx, RemoveSavedSearchCollaboratorRequest{
+		SavedSearchID: id, RequestingUserID: "viewer", GitHubUsername: "viewer"})
+	if err != nil {
+		t.Errorf("expected viewer to leave, got %v", err)
+	}
+	err = spannerClient.RemoveSavedSearchCollaborator(ctx, RemoveSavedSearchCollaboratorRequest{
+		SavedSearchID: id, RequestingUserID: "owner", GitHubUsername: "owner"})
+	if !errors.Is(err, ErrCannotChangeOwnerRole) {
+		t.Errorf("expected ErrCannotChangeOwnerRole, got %v", err)
+	}
+
+	// Transfer the ownership to the editor.
+	err = spannerClient.TransferSavedSearchOwnership(ctx, TransferSavedSearchOwnershipRequest{
+		SavedSearchID: id, RequestingUserID: "editor", NewOwnerGitHubUsername: "editor"})
+	if !errors.Is(err, ErrMissingRequiredRole) {
+		t.Errorf("expected ErrMissingRequiredRole for editor transfer, got %v", err)
+	}
+	err = spannerClient.TransferSavedSearchOwnership(ctx, TransferSavedSearchOwnershipRequest{
+		SavedSearchID: id, RequestingUserID: "owner", NewOwnerGitHubUsername: "editor"})
+	if err != nil {
+		t.Fatalf("TransferSavedSearchOwnership failed: %v", err)
+	}
+	collaborators, err = spannerClient.ListSavedSearchCollaborators(ctx, id, "owner")
+	if err != nil {
+		t.Fatalf("ListSavedSearchCollaborators failed: %v", err)
+	}
+	expected = []SavedSearchCollaborator{
+		{UserID: "editor", Role: SavedSearchOwner, GitHubUsername: new("Editor")},
+		{UserID: "owner", Role: SavedSearchEditor, GitHubUsername: new("Owner")},
+	}
+	if diff := cmp.Diff(expected, collaborators); diff != "" {
+		t.Errorf("collaborators after transfer mismatch (-want +got):\n%s", diff)
+	}
+	err = spannerClient.DeleteUserSavedSearch(ctx, DeleteUserSavedSearchRequest{
+		SavedSearchID:    id,
+		RequestingUserID: "editor",
+		Force:            false,
+	})
+	if err != nil {
+		t.Errorf("expected the new owner to delete the saved search, got %v", err)
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+)
+
+// SavedSearchDirectorySort is the order of the saved search directory.
+type SavedSearchDirectorySort string
+
+const (
+	// SavedSearchDirectorySortPopular orders by the number of bookmarks and subscribers, most popular first.
+	SavedSearchDirectorySortPopular SavedSearchDirectorySort = "popular"
+	// SavedSearchDirectorySortRecent orders by the last update, most recent first.
+	SavedSearchDirectorySortRecent SavedSearchDirectorySort = "recent"
+)
+
+// ListSavedSearchDirectoryRequest is a request to list the listed saved searches.
+type ListSavedSearchDirectoryRequest struct {
+	// Query optionally filters to saved searches whose name or description contain the text.
+	Query *string
+	// Tag optionally filters to saved searches with the tag.
+	Tag       *string
+	Sort      SavedSearchDirectorySort
+	PageSize  int
+	PageToken *string
+}
+
+// SavedSearchDirectoryEntry is a listed saved search with its popularity.
+type SavedSearchDirectoryEntry struct {
+	SavedSearch
+	// BookmarkCount is the number of users who bookmarked the saved search.
+	BookmarkCount int64 `spanner:"BookmarkCount"`
+	// SubscriberCount is the number of users with at least one subscription to the saved search.
+	SubscriberCount int64 `spanner:"SubscriberCount"`
+}
+
+// SavedSearchDirectoryPage contains the details for a page of the saved search directory.
+type SavedSearchDirectoryPage struct {
+	NextPageToken *string
+	Entries       []SavedSearchDirectoryEntry
+}
+
+// SavedSearchDirectoryCursor represents a point for resuming the saved search directory.
+// Only the field of the requested sort is set.
+type SavedSearchDirectoryCursor struct {
+	LastID         string     `json:"last_id"`
+	LastPopularity *int64     `json:"last_popularity,omitempty"`
+	LastUpdatedAt  *time.Time `json:"last_updated_at,omitempty"`
+}
+
+const savedSearchDirectoryRawQuery = `
+SELECT * FROM (
+	SELECT
+		s.ID, s.Name, s.Description, s.Query, s.Scope, s.AuthorID, s.CreatedAt, s.UpdatedAt, s.Tags, s.Listed,
+		s.QueryParameters,
+		(SELECT COUNT(*) FROM UserSavedSearchBookmarks b WHERE b.SavedSearchID = s.ID) AS BookmarkCount,
+		(SELECT COUNT(DISTINCT nc.UserID)
+			FROM SavedSearchSubscriptions sub
+			JOIN NotificationChannels nc ON sub.ChannelID = nc.ID
+			WHERE sub.SavedSearchID = s.ID) AS SubscriberCount
+	FROM SavedSearches s
+	WHERE s.Listed = TRUE AND s.Scope = 'USER_PUBLIC' %s
+) d
+%s
+ORDER BY %s
+LIMIT @pageSize`
+
+// escapeLikePattern escapes the characters with a special meaning in a LIKE pattern, so that the text is
+// matched literally.
+func escapeLikePattern(text string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(text)
+}
+
+// ListSavedSearchDirectory returns a page of the saved searches that their owners listed in the directory.
+func (c *Client) ListSavedSearchDirectory(
+	ctx context.Context, req ListSavedSearchDirectoryRequest) (*SavedSearchDirectoryPage, error) {
+	params := map[string]any{
+		"pageSize": req.PageSize,
+	}
+	var filters []string
+	if req.Query != nil {
+		params["query"] = "%" + escapeLikePattern(strings.ToLower(*req.Query)) + "%"
+		filters = append(filters, "(s.Name_Lowercase LIKE @query OR s.Description_Lowercase LIKE @query)")
+	}
+	if req.Tag != nil {
+		params["tag"] = *req.Tag
+		filters = append(filters, "@tag IN UNNEST(s.Tags)")
+	}
+	filter := ""
+	if len(filters) > 0 {
+		filter = "AND " + strings.Join(filters, " AND ")
+	}
+
+	var cursor *SavedSearchDirectoryCursor
+	if req.PageToken != nil {
+		var err error
+		cursor, err = decodeCursor[SavedSearchDirectoryCursor](*req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		params["lastID"] = cursor.LastID
+	}
+
+	var orderBy, pageFilter string
+	switch req.Sort {
+	case SavedSearchDirectorySortRecent:
+		orderBy = "d.UpdatedAt DESC, d.ID ASC"
+		if cursor != nil {
+			if cursor.LastUpdatedAt == nil {
+				return nil, ErrInvalidCursorFormat
+			}
+			params["lastUpdatedAt"] = *cursor.LastUpdatedAt
+			pageFilter = "WHERE (d.UpdatedAt < @lastUpdatedAt OR (d.UpdatedAt = @lastUpdatedAt AND d.ID > @lastID))"
+		}
+	case SavedSearchDirectorySortPopular:
+		fallthrough
+	default:
+		orderBy = "(d.BookmarkCount + d.SubscriberCount) DESC, d.ID ASC"
+		if cursor != nil {
+			if cursor.LastPopularity == nil {
+				return nil, ErrInvalidCursorFormat
+			}
+			params["lastPopularity"] = *cursor.LastPopularity
+			pageFilter = "WHERE ((d.BookmarkCount + d.SubscriberCount) < @lastPopularity OR " +
+				"((d.BookmarkCount + d.SubscriberCount) = @lastPopularity AND d.ID > @lastID))"
+		}
+	}
+
+	stmt := spanner.Statement{
+		SQL:    fmt.Sprintf(savedSearchDirectoryRawQuery, filter, pageFilter, orderBy),
+		Params: params,
+	}
+	txn := c.Single()
+	defer txn.Close()
+	var entries []SavedSearchDirectoryEntry
+	err := txn.Query(ctx, stmt).Do(func(row *spanner.Row) error {
+		var entry SavedSearchDirectoryEntry
+		if err := row.ToStruct(&entry); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken *string
+	if len(entries) == req.PageSize {
+		last := entries[len(entries)-1]
+		next := SavedSearchDirectoryCursor{
+			LastID:         last.ID,
+			LastPopularity: nil,
+			LastUpdatedAt:  nil,
+		}
+		if req.Sort == SavedSearchDirectorySortRecent {
+			next.LastUpdatedAt = new(last.UpdatedAt)
+		} else {
+			next.LastPopularity = new(last.BookmarkCount + last.SubscriberCount)
+		}
+		nextPageToken = new(encodeCursor(next))
+	}
+
+	return &SavedSearchDirectoryPage{
+		NextPageToken: nextPageToken,
+		Entries:       entries,
+	}, nil
+}
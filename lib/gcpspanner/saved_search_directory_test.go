@@ -0,0 +1,141 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func directoryEntryIDs(page *SavedSearchDirectoryPage) []string {
+	ids := make([]string, 0, len(page.Entries))
+	for _, entry := range page.Entries {
+		ids = append(ids, entry.ID)
+	}
+
+	return ids
+}
+
+func TestListSavedSearchDirectory(t *testing.T) {
+	restartDatabaseContainer(t)
+	ctx := context.Background()
+
+	createSearch := func(name string, tags []string, listed bool) (string, string) {
+		ownerID := uuid.NewString()
+		id, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
+			Name:            name,
+			Query:           "group:css",
+			OwnerUserID:     ownerID,
+			Description:     nil,
+			Tags:            tags,
+			Listed:          listed,
+			QueryParameters: nil,
+		})
+		if err != nil {
+			t.Fatalf("CreateNewUserSavedSearch failed: %v", err)
+		}
+
+		return *id, ownerID
+	}
+	cssID, _ := createSearch("CSS tracking", []string{"css", "interop"}, true)
+	jsID, _ := createSearch("JavaScript", []string{"js"}, true)
+	unlistedID, unlistedOwnerID := createSearch("CSS private", []string{"css"}, false)
+
+	// The JavaScript search is bookmarked by another user, so it is more popular.
+	err := spannerClient.AddUserSearchBookmark(ctx, UserSavedSearchBookmark{
+		UserID:        uuid.NewString(),
+		SavedSearchID: jsID,
+	})
+	if err != nil {
+		t.Fatalf("AddUserSearchBookmark failed: %v", err)
+	}
+
+	assertIDs := func(expected []string, page *SavedSearchDirectoryPage) {
+		t.Helper()
+		if diff := cmp.Diff(expected, directoryEntryIDs(page)); diff != "" {
+			t.Errorf("unexpected entries (-want +got):\n%s", diff)
+		}
+	}
+	list := func(req ListSavedSearchDirectoryRequest) *SavedSearchDirectoryPage {
+		page, err := spannerClient.ListSavedSearchDirectory(ctx, req)
+		if err != nil {
+			t.Fatalf("ListSavedSearchDirectory failed: %v", err)
+		}
+
+		return page
+	}
+
+	page := list(ListSavedSearchDirectoryRequest{
+		Query: nil, Tag: nil, Sort: SavedSearchDirectorySortPopular, PageSize: 10, PageToken: nil})
+	assertIDs([]string{jsID, cssID}, page)
+	if page.Entries[0].BookmarkCount != 2 || page.Entries[0].SubscriberCount != 0 {
+		t.Errorf("unexpected counts %+v", page.Entries[0])
+	}
+	if page.NextPageToken != nil {
+		t.Error("expected no next page token")
+	}
+
+	// Paginate one entry at a time.
+	page = list(ListSavedSearchDirectoryRequest{
+		Query: nil, Tag: nil, Sort: SavedSearchDirectorySortPopular, PageSize: 1, PageToken: nil})
+	assertIDs([]string{jsID}, page)
+	if page.NextPageToken == nil {
+		t.Fatal("expected next page token")
+	}
+	page = list(ListSavedSearchDirectoryRequest{
+		Query: nil, Tag: nil, Sort: SavedSearchDirectorySortPopular, PageSize: 1, PageToken: page.NextPageToken})
+	assertIDs([]string{cssID}, page)
+
+	// Filter by tag and text.
+	page = list(ListSavedSearchDirectoryRequest{
+		Query: nil, Tag: new("css"), Sort: SavedSearchDirectorySortPopular, PageSize: 10, PageToken: nil})
+	assertIDs([]string{cssID}, page)
+	page = list(ListSavedSearchDirectoryRequest{
+		Query: new("javascript"), Tag: nil, Sort: SavedSearchDirectorySortPopular, PageSize: 10, PageToken: nil})
+	assertIDs([]string{jsID}, page)
+	// The LIKE wildcards in the text are matched literally.
+	for _, query := range []string{"%", "_", `\`, "css%tracking", "css_tracking"} {
+		page = list(ListSavedSearchDirectoryRequest{
+			Query: new(query), Tag: nil, Sort: SavedSearchDirectorySortPopular, PageSize: 10, PageToken: nil})
+		assertIDs([]string{}, page)
+	}
+
+	// Listing the private search makes it the most recent entry.
+	err = spannerClient.UpdateUserSavedSearch(ctx, UpdateSavedSearchRequest{
+		ID:              unlistedID,
+		AuthorID:        unlistedOwnerID,
+		Query:           OptionallySet[string]{Value: "", IsSet: false},
+		Name:            OptionallySet[string]{Value: "", IsSet: false},
+		Description:     OptionallySet[*string]{Value: nil, IsSet: false},
+		Tags:            OptionallySet[[]string]{Value: nil, IsSet: false},
+		Listed:          OptionallySet[bool]{Value: true, IsSet: true},
+		QueryParameters: OptionallySet[[]SavedSearchQueryParameter]{Value: nil, IsSet: false},
+	})
+	if err != nil {
+		t.Fatalf("UpdateUserSavedSearch failed: %v", err)
+	}
+	page = list(ListSavedSearchDirectoryRequest{
+		Query: nil, Tag: new("css"), Sort: SavedSearchDirectorySortRecent, PageSize: 10, PageToken: nil})
+	assertIDs([]string{unlistedID, cssID}, page)
+
+	_, err = spannerClient.ListSavedSearchDirectory(ctx, ListSavedSearchDirectoryRequest{
+		Query: nil, Tag: nil, Sort: SavedSearchDirectorySortPopular, PageSize: 10, PageToken: new("bad")})
+	if err == nil {
+		t.Error("expected an error for an invalid page token")
+	}
+}
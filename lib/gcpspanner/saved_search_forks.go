@@ -0,0 +1,223 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
+)
+
+const savedSearchForksTable = "SavedSearchForks"
+
+// spannerSavedSearchFork represents a row in the SavedSearchForks table.
+type spannerSavedSearchFork struct {
+	SavedSearchID            string    `spanner:"SavedSearchID"`
+	ForkedFromSavedSearchID  string    `spanner:"ForkedFromSavedSearchID"`
+	ForkedFromRevisionNumber *int64    `spanner:"ForkedFromRevisionNumber"`
+	CreatedAt                time.Time `spanner:"CreatedAt"`
+}
+
+// SavedSearchForkSource describes the saved search that a saved search was forked from.
+type SavedSearchForkSource struct {
+	SavedSearchID string `spanner:"ForkedFromSavedSearchID"`
+	// RevisionNumber is nil if the source had no revisions when it was forked.
+	RevisionNumber *int64 `spanner:"ForkedFromRevisionNumber"`
+	// Name is the current name of the source. It is nil if the source was deleted.
+	Name *string `spanner:"Name"`
+}
+
+// ForkSavedSearchRequest is a request to copy a saved search into a new saved search owned by the user.
+type ForkSavedSearchRequest struct {
+	SourceSavedSearchID string
+	OwnerUserID         string
+	// Name defaults to the name of the source.
+	Name *string
+	// Description defaults to the description of the source.
+	Description *string
+}
+
+// ForkSavedSearch creates a new saved search owned by the user with the query and description of the source.
+// Any saved search can be forked, including system global saved searches.
+// The custom feature order of the source in SavedSearchFeatureSortOrder is copied. When the source is a user
+// hotlist, the fork is a new hotlist with the same features.
+// It returns the ID of the new saved search.
+func (c *Client) ForkSavedSearch(ctx context.Context, req ForkSavedSearchRequest) (*string, error) {
+	id := uuid.NewString()
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		source, err := newEntityReader[readSavedSearchMapper, SavedSearch, string](c).
+			readRowByKeyWithTransaction(ctx, req.SourceSavedSearchID, txn)
+		if err != nil {
+			return err
+		}
+
+		isHotlist := true
+		_, err = newEntityReader[userHotlistMapper, spannerUserHotlist, string](c).
+			readRowByKeyWithTransaction(ctx, source.ID, txn)
+		if errors.Is(err, ErrQueryReturnedNoResults) {
+			isHotlist = false
+		} else if err != nil {
+			return err
+		}
+
+		queryParameters, err := DecodeSavedSearchQueryParameters(source.QueryParameters)
+		if err != nil {
+			return err
+		}
+
+		newSearch := CreateUserSavedSearchRequest{
+			Name:        source.Name,
+			Query:       source.Query,
+			OwnerUserID: req.OwnerUserID,
+			Description: source.Description,
+			Tags:        source.Tags,
+			// Forks start out unlisted so that the directory does not fill up with copies.
+			Listed:          false,
+			QueryParameters: queryParameters,
+		}
+		if req.Name != nil {
+			newSearch.Name = *req.Name
+		}
+		if req.Description != nil {
+			newSearch.Description = req.Description
+		}
+		if isHotlist {
+			newSearch.Query = UserHotlistQuery(id)
+		}
+		_, err = c.createNewUserSavedSearchWithTransaction(ctx, txn, newSearch, WithID(id))
+		if err != nil {
+			return err
+		}
+
+		mutations, err := copySavedSearchFeatureSortOrderMutations(ctx, txn, source.ID, id)
+		if err != nil {
+			return err
+		}
+		if isHotlist {
+			m, err := spanner.InsertStruct(userHotlistsTable, spannerUserHotlist{
+				SavedSearchID: id,
+				OrderVersion:  1,
+				CreatedAt:     spanner.CommitTimestamp,
+				UpdatedAt:     spanner.CommitTimestamp,
+			})
+			if err != nil {
+				return errors.Join(ErrInternalMutationFailure, err)
+			}
+			mutations = append(mutations, m)
+		}
+
+		latestRevision, err := getLatestSavedSearchRevisionNumber(ctx, txn, source.ID)
+		if err != nil {
+			return err
+		}
+		var forkedFromRevision *int64
+		if latestRevision > 0 {
+			forkedFromRevision = &latestRevision
+		}
+		m, err := spanner.InsertStruct(savedSearchForksTable, spannerSavedSearchFork{
+			SavedSearchID:            id,
+			ForkedFromSavedSearchID:  source.ID,
+			ForkedFromRevisionNumber: forkedFromRevision,
+			CreatedAt:                spanner.CommitTimestamp,
+		})
+		if err != nil {
+			return errors.Join(ErrInternalMutationFailure, err)
+		}
+		mutations = append(mutations, m)
+
+		if err := txn.BufferWrite(mutations); err != nil {
+			return errors.Join(ErrInternalMutationFailure, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// copySavedSearchFeatureSortOrderMutations builds the mutations that copy the custom feature order
+// of one saved search to another.
+func copySavedSearchFeatureSortOrderMutations(
+	ctx context.Context, txn transaction, fromSavedSearchID, toSavedSearchID string) ([]*spanner.Mutation, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT SavedSearchID, FeatureKey, PositionIndex FROM %s WHERE SavedSearchID = @savedSearchID`,
+			savedSearchFeatureSortOrderTable),
+		Params: map[string]any{
+			"savedSearchID": fromSavedSearchID,
+		},
+	}
+	var mapper savedSearchFeatureSortOrderMapper
+	var mutations []*spanner.Mutation
+	err := txn.Query(ctx, stmt).Do(func(r *spanner.Row) error {
+		var row SpannerSavedSearchFeatureSortOrder
+		if err := r.ToStruct(&row); err != nil {
+			return err
+		}
+		row.SavedSearchID = toSavedSearchID
+		m, err := mapper.InsertOrUpdateMutation(row)
+		if err != nil {
+			return err
+		}
+		mutations = append(mutations, m)
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return mutations, nil
+}
+
+// GetSavedSearchForkSource returns where the saved search was forked from, or nil if it is not a fork.
+func (c *Client) GetSavedSearchForkSource(ctx context.Context, savedSearchID string) (*SavedSearchForkSource, error) {
+	txn := c.Single()
+	defer txn.Close()
+
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT f.ForkedFromSavedSearchID, f.ForkedFromRevisionNumber, s.Name
+			FROM %s f
+			LEFT JOIN %s s ON f.ForkedFromSavedSearchID = s.ID
+			WHERE f.SavedSearchID = @savedSearchID`, savedSearchForksTable, savedSearchesTable),
+		Params: map[string]any{
+			"savedSearchID": savedSearchID,
+		},
+	}
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+	row, err := iter.Next()
+	if err != nil {
+		if errors.Is(err, iterator.Done) {
+			return nil, nil
+		}
+
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+	var source SavedSearchForkSource
+	if err := row.ToStruct(&source); err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return &source, nil
+}
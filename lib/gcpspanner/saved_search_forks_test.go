@@ -0,0 +1,135 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+)
+
+func TestForkSavedSearch(t *testing.T) {
+	restartDatabaseContainer(t)
+	ctx := context.Background()
+	insertMockFeatures(ctx, t, map[string]bool{
+		"hot1": true,
+		"hot2": true,
+	})
+	authorID := uuid.NewString()
+	forkerID := uuid.NewString()
+
+	sourceID, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
+		Name:            "interop",
+		Query:           "group:css",
+		OwnerUserID:     authorID,
+		Description:     new("description"),
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
+	})
+	if err != nil {
+		t.Fatalf("CreateNewUserSavedSearch failed: %v", err)
+	}
+
+	forkID, err := spannerClient.ForkSavedSearch(ctx, ForkSavedSearchRequest{
+		SourceSavedSearchID: *sourceID,
+		OwnerUserID:         forkerID,
+		Name:                new("my interop"),
+		Description:         nil,
+	})
+	if err != nil {
+		t.Fatalf("ForkSavedSearch failed: %v", err)
+	}
+	fork, err := spannerClient.GetUserSavedSearch(ctx, *forkID, &forkerID)
+	if err != nil {
+		t.Fatalf("GetUserSavedSearch failed: %v", err)
+	}
+	if fork.Name != "my interop" || fork.Query != "group:css" || fork.Description == nil ||
+		*fork.Description != "description" || fork.Role == nil || *fork.Role != string(SavedSearchOwner) {
+		t.Errorf("unexpected fork %+v", fork)
+	}
+	source, err := spannerClient.GetSavedSearchForkSource(ctx, *forkID)
+	if err != nil {
+		t.Fatalf("GetSavedSearchForkSource failed: %v", err)
+	}
+	expected := &SavedSearchForkSource{
+		SavedSearchID:  *sourceID,
+		RevisionNumber: new(int64(1)),
+		Name:           new("interop"),
+	}
+	if diff := cmp.Diff(expected, source); diff != "" {
+		t.Errorf("fork source mismatch (-want +got):\n%s", diff)
+	}
+
+	// The provenance outlives the source.
+	err = spannerClient.DeleteUserSavedSearch(ctx, DeleteUserSavedSearchRequest{
+		SavedSearchID:    *sourceID,
+		RequestingUserID: authorID,
+		Force:            false,
+	})
+	if err != nil {
+		t.Fatalf("DeleteUserSavedSearch failed: %v", err)
+	}
+	source, err = spannerClient.GetSavedSearchForkSource(ctx, *forkID)
+	if err != nil {
+		t.Fatalf("GetSavedSearchForkSource failed: %v", err)
+	}
+	if source == nil || source.SavedSearchID != *sourceID || source.Name != nil {
+		t.Errorf("unexpected fork source after deleting the source %+v", source)
+	}
+
+	// A saved search that is not a fork has no source.
+	source, err = spannerClient.GetSavedSearchForkSource(ctx, *sourceID)
+	if err != nil || source != nil {
+		t.Errorf("expected no fork source, got %+v, %v", source, err)
+	}
+
+	// Forking a hotlist creates a new hotlist with the same features.
+	hotlistID, err := spannerClient.CreateUserHotlist(ctx, CreateUserHotlistRequest{
+		Name:        "priorities",
+		Description: nil,
+		OwnerUserID: authorID,
+		FeatureKeys: []string{"hot2", "hot1"},
+	})
+	if err != nil {
+		t.Fatalf("CreateUserHotlist failed: %v", err)
+	}
+	hotlistForkID, err := spannerClient.ForkSavedSearch(ctx, ForkSavedSearchRequest{
+		SourceSavedSearchID: *hotlistID,
+		OwnerUserID:         forkerID,
+		Name:                nil,
+		Description:         nil,
+	})
+	if err != nil {
+		t.Fatalf("ForkSavedSearch failed: %v", err)
+	}
+	hotlistFork, err := spannerClient.GetSavedSearch(ctx, *hotlistForkID)
+	if err != nil {
+		t.Fatalf("GetSavedSearch failed: %v", err)
+	}
+	if hotlistFork.Name != "priorities" || hotlistFork.Query != UserHotlistQuery(*hotlistForkID) {
+		t.Errorf("unexpected hotlist fork %+v", hotlistFork)
+	}
+	hotlist, err := spannerClient.GetUserHotlist(ctx, *hotlistForkID)
+	if err != nil {
+		t.Fatalf("GetUserHotlist failed: %v", err)
+	}
+	if hotlist.OrderVersion != 1 || !slices.Equal(hotlist.FeatureKeys, []string{"hot2", "hot1"}) {
+		t.Errorf("unexpected hotlist %+v", hotlist)
+	}
+}
@@ -45,6 +45,9 @@ type SavedSearchNotificationCreateRequest struct {
 	BlobPath      string                  `spanner:"BlobPath"`
 	DiffBlobPath  string                  `spanner:"DiffBlobPath"`
 	Summary       spanner.NullJSON        `spanner:"Summary"`
+	// FeatureChanges are the renames and moves of features reported by the summary.
+	// They are stored in the FeatureTimelineChanges table.
+	FeatureChanges []FeatureTimelineChangeRecord `spanner:"-"`
 }
 
 func (c *Client) GetSavedSearchNotificationEvent(
@@ -83,7 +86,8 @@ func (m savedSearchNotificationEventMapper) NewEntity(id string, req SavedSearch
 	}, nil
 }
 
-// PublishSavedSearchNotificationEvent records a new saved search notification event.
+// PublishSavedSearchNotificationEvent records a new saved search notification event and the feature
+// changes it reports.
 // This saves the event and updates the state pointer, but explicitly KEEPS the lock.
 // The worker is expected to call ReleaseLock via defer.
 func (c *Client) PublishSavedSearchNotificationEvent(ctx context.Context,
@@ -129,7 +133,7 @@ func (c *Client) PublishSavedSearchNotificationEvent(ctx context.Context,
 		}
 		id = newID
 
-		return nil
+		return c.recordFeatureTimelineChangesWithTransaction(ctx, txn, event.Timestamp, event.FeatureChanges)
 	})
 
 	return id, err
@@ -30,10 +30,13 @@ import (
 func createSavedSearchForNotificationTests(ctx context.Context, t *testing.T) string {
 	t.Helper()
 	id, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-		Name:        "test search for notifications",
-		Query:       "group:notification-test",
-		OwnerUserID: "owner-notification-1",
-		Description: nil,
+		Name:            "test search for notifications",
+		Query:           "group:notification-test",
+		OwnerUserID:     "owner-notification-1",
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
 	})
 	if err != nil {
 		t.Fatalf("CreateNewUserSavedSearch() returned unexpected error: %v", err)
@@ -184,6 +187,7 @@ func TestPublishSavedSearchNotificationEvent(t *testing.T) {
 						},
 						Valid: true,
 					},
+					FeatureChanges: nil,
 				}
 			},
 			newStatePath: newStatePath,
@@ -228,6 +232,7 @@ func TestPublishSavedSearchNotificationEvent(t *testing.T) {
 						Value: nil,
 						Valid: false,
 					},
+					FeatureChanges: nil,
 				}
 			},
 			newStatePath: newStatePath,
@@ -272,6 +277,7 @@ func TestPublishSavedSearchNotificationEvent(t *testing.T) {
 						Value: nil,
 						Valid: false,
 					},
+					FeatureChanges: nil,
 				}
 			},
 			newStatePath: newStatePath,
@@ -303,6 +309,7 @@ func TestPublishSavedSearchNotificationEvent(t *testing.T) {
 						Value: nil,
 						Valid: false,
 					},
+					FeatureChanges: nil,
 				}
 			},
 			createOptions: nil,
@@ -382,6 +389,7 @@ func TestGetLatestSavedSearchNotificationEvent(t *testing.T) {
 				Value: nil,
 				Valid: false,
 			},
+			FeatureChanges: nil,
 		}, "path/"+eventID, "worker-1", WithID(eventID))
 		if err != nil {
 			t.Fatalf("PublishSavedSearchNotificationEvent() failed: %v", err)
@@ -433,6 +441,7 @@ func TestListSavedSearchNotificationEvents(t *testing.T) {
 				Value: nil,
 				Valid: false,
 			},
+			FeatureChanges: nil,
 		}, "path/"+eventID, "worker-1", WithID(eventID))
 		if err != nil {
 			t.Fatalf("PublishSavedSearchNotificationEvent() failed: %v", err)
@@ -0,0 +1,424 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+const savedSearchRevisionsTable = "SavedSearchRevisions"
+
+// spannerSavedSearchRevision is a row in the SavedSearchRevisions table.
+type spannerSavedSearchRevision struct {
+	SavedSearchID        string    `spanner:"SavedSearchID"`
+	RevisionNumber       int64     `spanner:"RevisionNumber"`
+	Name                 string    `spanner:"Name"`
+	Description          *string   `spanner:"Description"`
+	Query                string    `spanner:"Query"`
+	AuthorID             string    `spanner:"AuthorID"`
+	RevertedFromRevision *int64    `spanner:"RevertedFromRevision"`
+	CreatedAt            time.Time `spanner:"CreatedAt"`
+	// QueryParameters holds the declared parameters of Query. See SavedSearch.QueryParameters.
+	QueryParameters spanner.NullJSON `spanner:"QueryParameters"`
+}
+
+// SavedSearchRevision is a historical revision of a saved search.
+type SavedSearchRevision struct {
+	SavedSearchID        string    `spanner:"SavedSearchID"`
+	RevisionNumber       int64     `spanner:"RevisionNumber"`
+	Name                 string    `spanner:"Name"`
+	Description          *string   `spanner:"Description"`
+	Query                string    `spanner:"Query"`
+	AuthorID             string    `spanner:"AuthorID"`
+	RevertedFromRevision *int64    `spanner:"RevertedFromRevision"`
+	CreatedAt            time.Time `spanner:"CreatedAt"`
+	// QueryParameters holds the declared parameters of Query. See SavedSearch.QueryParameters.
+	QueryParameters spanner.NullJSON `spanner:"QueryParameters"`
+	// AuthorGitHubUsername is nil if the author has not synced their GitHub profile.
+	AuthorGitHubUsername *string `spanner:"AuthorGitHubUsername"`
+}
+
+// ListSavedSearchRevisionsRequest is a request to list the revisions of a saved search, newest first.
+type ListSavedSearchRevisionsRequest struct {
+	SavedSearchID    string
+	RequestingUserID string
+	PageSize         int
+	PageToken        *string
+}
+
+// SavedSearchRevisionsPage contains the details for a page of SavedSearchRevisions.
+type SavedSearchRevisionsPage struct {
+	NextPageToken *string
+	Revisions     []SavedSearchRevision
+}
+
+// SavedSearchRevisionsCursor represents a point for resuming the list of revisions.
+type SavedSearchRevisionsCursor struct {
+	LastRevisionNumber int64 `json:"last_revision_number"`
+}
+
+// GetSavedSearchRevisionRequest is a request to read one revision of a saved search.
+type GetSavedSearchRevisionRequest struct {
+	SavedSearchID    string
+	RequestingUserID string
+	RevisionNumber   int64
+}
+
+// GetSavedSearchRevisionPairRequest is a request to read two revisions of a saved search so that
+// they can be compared.
+type GetSavedSearchRevisionPairRequest struct {
+	SavedSearchID    string
+	RequestingUserID string
+	RevisionNumber   int64
+	// BaseRevisionNumber defaults to the revision right before RevisionNumber.
+	BaseRevisionNumber *int64
+}
+
+// RevertSavedSearchRequest is a request to restore the name, query, query parameters and description
+// of a saved search from one of its revisions.
+type RevertSavedSearchRequest struct {
+	SavedSearchID    string
+	RequestingUserID string
+	RevisionNumber   int64
+}
+
+const savedSearchRevisionSelectColumns = `
+	r.SavedSearchID, r.RevisionNumber, r.Name, r.Description, r.Query, r.AuthorID,
+	r.RevertedFromRevision, r.CreatedAt, r.QueryParameters, p.GitHubUsername AS AuthorGitHubUsername`
+
+// ListSavedSearchRevisions returns a page of revisions of the saved search, newest first.
+// The requesting user must have a role on the saved search.
+func (c *Client) ListSavedSearchRevisions(
+	ctx context.Context, req ListSavedSearchRevisionsRequest) (*SavedSearchRevisionsPage, error) {
+	params := map[string]any{
+		"savedSearchID": req.SavedSearchID,
+		"pageSize":      req.PageSize,
+	}
+	pageFilter := ""
+	if req.PageToken != nil {
+		cursor, err := decodeCursor[SavedSearchRevisionsCursor](*req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		params["lastRevisionNumber"] = cursor.LastRevisionNumber
+		pageFilter = "AND r.RevisionNumber < @lastRevisionNumber"
+	}
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT %s
+			FROM %s r
+			LEFT JOIN %s p ON r.AuthorID = p.UserID
+			WHERE r.SavedSearchID = @savedSearchID %s
+			ORDER BY r.RevisionNumber DESC
+			LIMIT @pageSize`,
+			savedSearchRevisionSelectColumns, savedSearchRevisionsTable, userGitHubProfilesTable, pageFilter),
+		Params: params,
+	}
+
+	var revisions []SavedSearchRevision
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		err := c.checkForSavedSearchRole(ctx, txn, SavedSearchViewer, req.RequestingUserID, req.SavedSearchID)
+		if err != nil {
+			return err
+		}
+		revisions = nil
+		iter := txn.Query(ctx, stmt)
+		defer iter.Stop()
+
+		return iter.Do(func(row *spanner.Row) error {
+			var revision SavedSearchRevision
+			if err := row.ToStruct(&revision); err != nil {
+				return err
+			}
+			revisions = append(revisions, revision)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken *string
+	if len(revisions) == req.PageSize {
+		nextPageToken = new(encodeCursor(SavedSearchRevisionsCursor{
+			LastRevisionNumber: revisions[len(revisions)-1].RevisionNumber,
+		}))
+	}
+
+	return &SavedSearchRevisionsPage{
+		NextPageToken: nextPageToken,
+		Revisions:     revisions,
+	}, nil
+}
+
+// GetSavedSearchRevision returns the revision of the request.
+// The requesting user must have a role on the saved search.
+func (c *Client) GetSavedSearchRevision(
+	ctx context.Context, req GetSavedSearchRevisionRequest) (*SavedSearchRevision, error) {
+	var revision *SavedSearchRevision
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		err := c.checkForSavedSearchRole(ctx, txn, SavedSearchViewer, req.RequestingUserID, req.SavedSearchID)
+		if err != nil {
+			return err
+		}
+		revision, err = getSavedSearchRevision(ctx, txn, req.SavedSearchID, req.RevisionNumber)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return revision, nil
+}
+
+// GetSavedSearchRevisionPair returns the base revision and the revision of the request, in that order.
+// The requesting user must have a role on the saved search.
+// If the request has no base revision and the revision is the first one, the base revision is nil.
+func (c *Client) GetSavedSearchRevisionPair(
+	ctx context.Context, req GetSavedSearchRevisionPairRequest) (*SavedSearchRevision, *SavedSearchRevision, error) {
+	var base, revision *SavedSearchRevision
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		err := c.checkForSavedSearchRole(ctx, txn, SavedSearchViewer, req.RequestingUserID, req.SavedSearchID)
+		if err != nil {
+			return err
+		}
+		revision, err = getSavedSearchRevision(ctx, txn, req.SavedSearchID, req.RevisionNumber)
+		if err != nil {
+			return err
+		}
+		baseRevisionNumber := req.RevisionNumber - 1
+		if req.BaseRevisionNumber != nil {
+			baseRevisionNumber = *req.BaseRevisionNumber
+		} else if baseRevisionNumber < 1 {
+			base = nil
+
+			return nil
+		}
+		base, err = getSavedSearchRevision(ctx, txn, req.SavedSearchID, baseRevisionNumber)
+
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return base, revision, nil
+}
+
+// RevertSavedSearch restores the name, query, query parameters and description of the saved search
+// from the revision. The revert is recorded as a new revision, unless the saved search already matches
+// the revision. The requesting user must be an owner or editor.
+// It returns the error of BindSavedSearchQuery if the query of the revision cannot be bound to its
+// parameters.
+func (c *Client) RevertSavedSearch(ctx context.Context, req RevertSavedSearchRequest) error {
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		err := c.checkForSavedSearchRole(ctx, txn, SavedSearchEditor, req.RequestingUserID, req.SavedSearchID)
+		if err != nil {
+			return err
+		}
+		revision, err := getSavedSearchRevision(ctx, txn, req.SavedSearchID, req.RevisionNumber)
+		if err != nil {
+			return err
+		}
+		queryParameters, err := DecodeSavedSearchQueryParameters(revision.QueryParameters)
+		if err != nil {
+			return errors.Join(ErrInternalQueryFailure, err)
+		}
+		_, err = BindSavedSearchQuery(revision.Query, revision.QueryParameters, nil)
+		if err != nil {
+			return err
+		}
+
+		return c.updateUserSavedSearchWithTransaction(ctx, txn, UpdateSavedSearchRequest{
+			ID:          req.SavedSearchID,
+			AuthorID:    req.RequestingUserID,
+			Query:       OptionallySet[string]{Value: revision.Query, IsSet: true},
+			Name:        OptionallySet[string]{Value: revision.Name, IsSet: true},
+			Description: OptionallySet[*string]{Value: revision.Description, IsSet: true},
+			Tags:        OptionallySet[[]string]{Value: nil, IsSet: false},
+			Listed:      OptionallySet[bool]{Value: false, IsSet: false},
+			QueryParameters: OptionallySet[[]SavedSearchQueryParameter]{
+				Value: queryParameters,
+				IsSet: true,
+			},
+		}, &revision.RevisionNumber)
+	})
+
+	return err
+}
+
+func getSavedSearchRevision(
+	ctx context.Context, txn transaction, savedSearchID string, revisionNumber int64) (*SavedSearchRevision, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT %s
+			FROM %s r
+			LEFT JOIN %s p ON r.AuthorID = p.UserID
+			WHERE r.SavedSearchID = @savedSearchID AND r.RevisionNumber = @revisionNumber`,
+			savedSearchRevisionSelectColumns, savedSearchRevisionsTable, userGitHubProfilesTable),
+		Params: map[string]any{
+			"savedSearchID":  savedSearchID,
+			"revisionNumber": revisionNumber,
+		},
+	}
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+	row, err := iter.Next()
+	if err != nil {
+		if errors.Is(err, iterator.Done) {
+			return nil, errors.Join(ErrQueryReturnedNoResults, err)
+		}
+
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+	var revision SavedSearchRevision
+	if err := row.ToStruct(&revision); err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return &revision, nil
+}
+
+func getLatestSavedSearchRevisionNumber(
+	ctx context.Context, txn transaction, savedSearchID string) (int64, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT COALESCE(MAX(RevisionNumber), 0) FROM %s WHERE SavedSearchID = @savedSearchID`,
+			savedSearchRevisionsTable),
+		Params: map[string]any{
+			"savedSearchID": savedSearchID,
+		},
+	}
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+	row, err := iter.Next()
+	if err != nil {
+		return 0, errors.Join(ErrInternalQueryFailure, err)
+	}
+	var latest int64
+	if err := row.Columns(&latest); err != nil {
+		return 0, errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return latest, nil
+}
+
+// newSavedSearchRevisionMutation builds the mutation for the first revision of a new saved search.
+func newSavedSearchRevisionMutation(savedSearchID string, req CreateUserSavedSearchRequest) (*spanner.Mutation, error) {
+	m, err := spanner.InsertStruct(savedSearchRevisionsTable, spannerSavedSearchRevision{
+		SavedSearchID:        savedSearchID,
+		RevisionNumber:       1,
+		Name:                 req.Name,
+		Description:          req.Description,
+		Query:                req.Query,
+		AuthorID:             req.OwnerUserID,
+		RevertedFromRevision: nil,
+		CreatedAt:            spanner.CommitTimestamp,
+		QueryParameters:      savedSearchQueryParametersJSON(req.QueryParameters),
+	})
+	if err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return m, nil
+}
+
+// savedSearchRevisionMutations builds the mutations that record the change from existing to updated.
+// Saved searches created before revisions were tracked have no revisions yet. For those, the
+// existing state is recorded first so that it can be restored.
+func savedSearchRevisionMutations(
+	ctx context.Context,
+	txn transaction,
+	existing SavedSearch,
+	updated SavedSearch,
+	revertedFromRevision *int64,
+) ([]*spanner.Mutation, error) {
+	latest, err := getLatestSavedSearchRevisionNumber(ctx, txn, existing.ID)
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]spannerSavedSearchRevision, 0, 2)
+	if latest == 0 {
+		latest++
+		revisions = append(revisions, spannerSavedSearchRevision{
+			SavedSearchID:        existing.ID,
+			RevisionNumber:       latest,
+			Name:                 existing.Name,
+			Description:          existing.Description,
+			Query:                existing.Query,
+			AuthorID:             existing.AuthorID,
+			RevertedFromRevision: nil,
+			CreatedAt:            existing.UpdatedAt,
+			QueryParameters:      existing.QueryParameters,
+		})
+	}
+	revisions = append(revisions, spannerSavedSearchRevision{
+		SavedSearchID:        updated.ID,
+		RevisionNumber:       latest + 1,
+		Name:                 updated.Name,
+		Description:          updated.Description,
+		Query:                updated.Query,
+		AuthorID:             updated.AuthorID,
+		RevertedFromRevision: revertedFromRevision,
+		CreatedAt:            spanner.CommitTimestamp,
+		QueryParameters:      updated.QueryParameters,
+	})
+
+	mutations := make([]*spanner.Mutation, 0, len(revisions))
+	for _, revision := range revisions {
+		m, err := spanner.InsertStruct(savedSearchRevisionsTable, revision)
+		if err != nil {
+			return nil, errors.Join(ErrInternalQueryFailure, err)
+		}
+		mutations = append(mutations, m)
+	}
+
+	return mutations, nil
+}
+
+// savedSearchContentEqual reports whether the revisioned fields of both saved searches are the same.
+func savedSearchContentEqual(a, b SavedSearch) bool {
+	if a.Name != b.Name || a.Query != b.Query {
+		return false
+	}
+	if !savedSearchQueryParametersEqual(a.QueryParameters, b.QueryParameters) {
+		return false
+	}
+	if a.Description == nil || b.Description == nil {
+		return a.Description == b.Description
+	}
+
+	return *a.Description == *b.Description
+}
+
+// savedSearchQueryParametersEqual reports whether both values of the QueryParameters column declare the
+// same parameters.
+func savedSearchQueryParametersEqual(a, b spanner.NullJSON) bool {
+	aParams, aErr := DecodeSavedSearchQueryParameters(a)
+	bParams, bErr := DecodeSavedSearchQueryParameters(b)
+	if aErr != nil || bErr != nil {
+		return false
+	}
+
+	return slices.Equal(aParams, bParams)
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
+)
+
+func TestSavedSearchRevisions(t *testing.T) {
+	restartDatabaseContainer(t)
+	ctx := context.Background()
+
+	syncTestGitHubProfile(ctx, t, "owner", 1, "owner")
+	savedSearchID, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
+		Name:            "interop",
+		Query:           "group:css",
+		OwnerUserID:     "owner",
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
+	})
+	if err != nil {
+		t.Fatalf("CreateNewUserSavedSearch failed: %v", err)
+	}
+	id := *savedSearchID
+
+	update := func(name OptionallySet[string], query OptionallySet[string]) {
+		t.Helper()
+		err := spannerClient.UpdateUserSavedSearch(ctx, UpdateSavedSearchRequest{
+			ID:              id,
+			AuthorID:        "owner",
+			Query:           query,
+			Name:            name,
+			Description:     OptionallySet[*string]{IsSet: false, Value: nil},
+			Tags:            OptionallySet[[]string]{Value: nil, IsSet: false},
+			Listed:          OptionallySet[bool]{Value: false, IsSet: false},
+			QueryParameters: OptionallySet[[]SavedSearchQueryParameter]{Value: nil, IsSet: false},
+		})
+		if err != nil {
+			t.Fatalf("UpdateUserSavedSearch failed: %v", err)
+		}
+	}
+	update(OptionallySet[string]{IsSet: false, Value: ""}, OptionallySet[string]{IsSet: true, Value: "group:html"})
+	// An update that does not change the content does not add a revision.
+	update(OptionallySet[string]{IsSet: true, Value: "interop"}, OptionallySet[string]{IsSet: false, Value: ""})
+	update(OptionallySet[string]{IsSet: true, Value: "interop 2026"}, OptionallySet[string]{IsSet: false, Value: ""})
+
+	listQueries := func(pageSize int, pageToken *string) ([]string, *string) {
+		t.Helper()
+		page, err := spannerClient.ListSavedSearchRevisions(ctx, ListSavedSearchRevisionsRequest{
+			SavedSearchID:    id,
+			RequestingUserID: "owner",
+			PageSize:         pageSize,
+			PageToken:        pageToken,
+		})
+		if err != nil {
+			t.Fatalf("ListSavedSearchRevisions failed: %v", err)
+		}
+		queries := make([]string, 0, len(page.Revisions))
+		for _, revision := range page.Revisions {
+			if revision.AuthorGitHubUsername == nil || *revision.AuthorGitHubUsername != "owner" {
+				t.Errorf("unexpected author username %v", revision.AuthorGitHubUsername)
+			}
+			queries = append(queries, revision.Name+"|"+revision.Query)
+		}
+
+		return queries, page.NextPageToken
+	}
+	first, token := listQueries(2, nil)
+	if token == nil {
+		t.Fatal("expected a next page token")
+	}
+	second, _ := listQueries(2, token)
+	got := slices.Concat(first, second)
+	want := []string{"interop 2026|group:html", "interop|group:html", "interop|group:css"}
+	if len(got) != len(want) {
+		t.Fatalf("revisions mismatch: want %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("revision %d: want %s, got %s", i, want[i], got[i])
+		}
+	}
+
+	base, revision, err := spannerClient.GetSavedSearchRevisionPair(ctx, GetSavedSearchRevisionPairRequest{
+		SavedSearchID:      id,
+		RequestingUserID:   "owner",
+		RevisionNumber:     3,
+		BaseRevisionNumber: nil,
+	})
+	if err != nil {
+		t.Fatalf("GetSavedSearchRevisionPair failed: %v", err)
+	}
+	if base.RevisionNumber != 2 || revision.RevisionNumber != 3 {
+		t.Errorf("unexpected pair %d..%d", base.RevisionNumber, revision.RevisionNumber)
+	}
+	base, _, err = spannerClient.GetSavedSearchRevisionPair(ctx, GetSavedSearchRevisionPairRequest{
+		SavedSearchID:      id,
+		RequestingUserID:   "owner",
+		RevisionNumber:     1,
+		BaseRevisionNumber: nil,
+	})
+	if err != nil || base != nil {
+		t.Errorf("expected no base for the first revision, got %v, %v", base, err)
+	}
+
+	err = spannerClient.RevertSavedSearch(ctx, RevertSavedSearchRequest{
+		SavedSearchID:    id,
+		RequestingUserID: "owner",
+		RevisionNumber:   1,
+	})
+	if err != nil {
+		t.Fatalf("RevertSavedSearch failed: %v", err)
+	}
+	search, err := spannerClient.GetSavedSearch(ctx, id)
+	if err != nil {
+		t.Fatalf("GetSavedSearch failed: %v", err)
+	}
+	if search.Name != "interop" || search.Query != "group:css" {
+		t.Errorf("unexpected saved search after revert %+v", search)
+	}
+	latest, _ := listQueries(1, nil)
+	if len(latest) != 1 || latest[0] != "interop|group:css" {
+		t.Errorf("expected the revert as the latest revision, got %v", latest)
+	}
+
+	err = spannerClient.RevertSavedSearch(ctx, RevertSavedSearchRequest{
+		SavedSearchID:    id,
+		RequestingUserID: "someone-else",
+		RevisionNumber:   1,
+	})
+	if !errors.Is(err, ErrMissingRequiredRole) {
+		t.Errorf("expected ErrMissingRequiredRole, got %v", err)
+	}
+	err = spannerClient.RevertSavedSearch(ctx, RevertSavedSearchRequest{
+		SavedSearchID:    id,
+		RequestingUserID: "owner",
+		RevisionNumber:   42,
+	})
+	if !errors.Is(err, ErrQueryReturnedNoResults) {
+		t.Errorf("expected ErrQueryReturnedNoResults, got %v", err)
+	}
+}
+
+func TestRevertSavedSearch_QueryParameters(t *testing.T) {
+	restartDatabaseContainer(t)
+	ctx := context.Background()
+
+	params := []SavedSearchQueryParameter{{Name: "browser", DefaultValue: "chrome"}}
+	savedSearchID, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
+		Name:            "missing",
+		Query:           "missing_in_one_of:($browser)",
+		OwnerUserID:     "owner",
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: params,
+	})
+	if err != nil {
+		t.Fatalf("CreateNewUserSavedSearch failed: %v", err)
+	}
+	id := *savedSearchID
+
+	update := func(query string, queryParameters []SavedSearchQueryParameter) {
+		t.Helper()
+		err := spannerClient.UpdateUserSavedSearch(ctx, UpdateSavedSearchRequest{
+			ID:              id,
+			AuthorID:        "owner",
+			Query:           OptionallySet[string]{IsSet: true, Value: query},
+			Name:            OptionallySet[string]{IsSet: false, Value: ""},
+			Description:     OptionallySet[*string]{IsSet: false, Value: nil},
+			Tags:            OptionallySet[[]string]{Value: nil, IsSet: false},
+			Listed:          OptionallySet[bool]{Value: false, IsSet: false},
+			QueryParameters: OptionallySet[[]SavedSearchQueryParameter]{Value: queryParameters, IsSet: true},
+		})
+		if err != nil {
+			t.Fatalf("UpdateUserSavedSearch failed: %v", err)
+		}
+	}
+	update("group:css", nil)
+	// Revision 3 uses a variable without declaring it.
+	update("missing_in_one_of:($engine)", nil)
+
+	revert := func(revisionNumber int64) error {
+		return spannerClient.RevertSavedSearch(ctx, RevertSavedSearchRequest{
+			SavedSearchID:    id,
+			RequestingUserID: "owner",
+			RevisionNumber:   revisionNumber,
+		})
+	}
+	if err := revert(1); err != nil {
+		t.Fatalf("RevertSavedSearch failed: %v", err)
+	}
+	search, err := spannerClient.GetSavedSearch(ctx, id)
+	if err != nil {
+		t.Fatalf("GetSavedSearch failed: %v", err)
+	}
+	restored, err := DecodeSavedSearchQueryParameters(search.QueryParameters)
+	if err != nil {
+		t.Fatalf("DecodeSavedSearchQueryParameters failed: %v", err)
+	}
+	if search.Query != "missing_in_one_of:($browser)" || !slices.Equal(restored, params) {
+		t.Errorf("unexpected saved search after revert %+v", search)
+	}
+
+	err = revert(3)
+	if !errors.Is(err, searchtypes.ErrUnboundQueryVariable) {
+		t.Errorf("expected ErrUnboundQueryVariable, got %v", err)
+	}
+	search, err = spannerClient.GetSavedSearch(ctx, id)
+	if err != nil {
+		t.Fatalf("GetSavedSearch failed: %v", err)
+	}
+	if search.Query != "missing_in_one_of:($browser)" {
+		t.Errorf("expected the failed revert to leave the saved search unchanged, got %+v", search)
+	}
+}
@@ -0,0 +1,309 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+// LatestSavedSearchSnapshotID refers to the last known state of a saved search, as recorded
+// in SavedSearchState, instead of the state stored with a notification event.
+const LatestSavedSearchSnapshotID = "latest"
+
+// SavedSearchSnapshot is a stored feature list snapshot of a saved search.
+// Every notification event stores the state of the saved search that produced it.
+type SavedSearchSnapshot struct {
+	// ID is the ID of the notification event that stored the snapshot, or LatestSavedSearchSnapshotID.
+	ID            string
+	SavedSearchID string
+	SnapshotType  SavedSearchSnapshotType
+	// Timestamp is nil for the latest snapshot.
+	Timestamp *time.Time
+	Reasons   []string
+	BlobPath  string
+}
+
+// ListSavedSearchSnapshotsRequest is a request to list the snapshots of a saved search, newest first.
+type ListSavedSearchSnapshotsRequest struct {
+	SavedSearchID    string
+	RequestingUserID string
+	SnapshotType     SavedSearchSnapshotType
+	// At limits the list to the snapshots stored at or before this time, if set.
+	At        *time.Time
+	PageSize  int
+	PageToken *string
+}
+
+// SavedSearchSnapshotsPage contains the details for a page of SavedSearchSnapshots.
+type SavedSearchSnapshotsPage struct {
+	NextPageToken *string
+	Snapshots     []SavedSearchSnapshot
+}
+
+// GetSavedSearchSnapshotRequest is a request to read a snapshot of a saved search.
+type GetSavedSearchSnapshotRequest struct {
+	SavedSearchID    string
+	RequestingUserID string
+	SnapshotType     SavedSearchSnapshotType
+	// SnapshotID is the ID of a notification event or LatestSavedSearchSnapshotID.
+	SnapshotID string
+	// At turns LatestSavedSearchSnapshotID into the newest snapshot stored at or before this time, if set.
+	At *time.Time
+}
+
+// ListSavedSearchSnapshots returns a page of the snapshots stored with the notification events
+// of the saved search, newest first.
+// The requesting user must be allowed to read the snapshots, see checkCanReadSavedSearchSnapshots.
+func (c *Client) ListSavedSearchSnapshots(
+	ctx context.Context, req ListSavedSearchSnapshotsRequest) (*SavedSearchSnapshotsPage, error) {
+	err := c.checkCanReadSavedSearchSnapshots(ctx, req.RequestingUserID, req.SavedSearchID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := map[string]any{
+		"savedSearchID": req.SavedSearchID,
+		"snapshotType":  req.SnapshotType,
+		"pageSize":      req.PageSize,
+	}
+	atFilter := ""
+	if req.At != nil {
+		params["at"] = *req.At
+		atFilter = "AND Timestamp <= @at"
+	}
+	pageFilter := ""
+	if req.PageToken != nil {
+		cursor, err := decodeSavedSearchNotificationEventCursor(*req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		params["lastTimestamp"] = cursor.LastTimestamp
+		params["lastID"] = cursor.LastID
+		pageFilter = "AND (Timestamp < @lastTimestamp OR (Timestamp = @lastTimestamp AND EventId > @lastID))"
+	}
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT EventId, Timestamp, Reasons, BlobPath
+			FROM %s
+			WHERE SavedSearchId = @savedSearchID AND SnapshotType = @snapshotType %s %s
+			ORDER BY Timestamp DESC, EventId ASC
+			LIMIT @pageSize`,
+			savedSearchNotificationEventsTable, atFilter, pageFilter),
+		Params: params,
+	}
+
+	txn := c.Single()
+	defer txn.Close()
+	snapshots, err := c.querySavedSearchSnapshots(ctx, txn, stmt, req.SavedSearchID, req.SnapshotType)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken *string
+	if len(snapshots) == req.PageSize {
+		last := snapshots[len(snapshots)-1]
+		nextPageToken = new(encodeSavedSearchNotificationEventCursor(*last.Timestamp, last.ID))
+	}
+
+	return &SavedSearchSnapshotsPage{
+		NextPageToken: nextPageToken,
+		Snapshots:     snapshots,
+	}, nil
+}
+
+// GetSavedSearchSnapshot returns a snapshot of the saved search.
+// The requesting user must be allowed to read the snapshots, see checkCanReadSavedSearchSnapshots.
+// It returns ErrQueryReturnedNoResults if the snapshot does not belong to the saved search and snapshot type.
+func (c *Client) GetSavedSearchSnapshot(
+	ctx context.Context, req GetSavedSearchSnapshotRequest) (*SavedSearchSnapshot, error) {
+	err := c.checkCanReadSavedSearchSnapshots(ctx, req.RequestingUserID, req.SavedSearchID)
+	if err != nil {
+		return nil, err
+	}
+
+	txn := c.Single()
+	defer txn.Close()
+	if req.SnapshotID == LatestSavedSearchSnapshotID {
+		if req.At != nil {
+			return c.getSavedSearchSnapshotAt(ctx, txn, req.SavedSearchID, req.SnapshotType, *req.At)
+		}
+
+		return c.getLatestSavedSearchSnapshot(ctx, txn, req.SavedSearchID, req.SnapshotType)
+	}
+
+	event, err := newEntityReader[savedSearchNotificationEventMapper, SavedSearchNotificationEvent, string](c).
+		readRowByKeyWithTransaction(ctx, req.SnapshotID, txn)
+	if err != nil {
+		return nil, err
+	}
+	if event.SavedSearchID != req.SavedSearchID || event.SnapshotType != req.SnapshotType {
+		return nil, ErrQueryReturnedNoResults
+	}
+
+	return &SavedSearchSnapshot{
+		ID:            event.ID,
+		SavedSearchID: event.SavedSearchID,
+		SnapshotType:  event.SnapshotType,
+		Timestamp:     &event.Timestamp,
+		Reasons:       event.Reasons,
+		BlobPath:      event.BlobPath,
+	}, nil
+}
+
+// checkCanReadSavedSearchSnapshots returns ErrMissingRequiredRole unless the user may read the snapshots of the
+// saved search. Users with a role on the saved search may read them, as may everyone for the listed user saved
+// searches and the subscribers of the system saved searches.
+// It returns ErrQueryReturnedNoResults if the saved search does not exist.
+func (c *Client) checkCanReadSavedSearchSnapshots(ctx context.Context, userID, savedSearchID string) error {
+	stmt := spanner.Statement{
+		SQL: `
+			SELECT
+				s.Scope,
+				s.Listed,
+				EXISTS(
+					SELECT 1 FROM SavedSearchUserRoles r
+					WHERE r.SavedSearchID = s.ID AND r.UserID = @userID) AS HasRole,
+				EXISTS(
+					SELECT 1 FROM SavedSearchSubscriptions sub
+					JOIN NotificationChannels nc ON nc.ID = sub.ChannelID
+					WHERE sub.SavedSearchID = s.ID AND nc.UserID = @userID) AS IsSubscriber
+			FROM SavedSearches s
+			WHERE s.ID = @savedSearchID`,
+		Params: map[string]any{
+			"savedSearchID": savedSearchID,
+			"userID":        userID,
+		},
+	}
+	it := c.Single().Query(ctx, stmt)
+	defer it.Stop()
+	row, err := it.Next()
+	if err != nil {
+		if errors.Is(err, iterator.Done) {
+			return errors.Join(ErrQueryReturnedNoResults, err)
+		}
+
+		return errors.Join(ErrInternalQueryFailure, err)
+	}
+	var (
+		scope                         SavedSearchScope
+		listed, hasRole, isSubscriber bool
+	)
+	if err := row.Columns(&scope, &listed, &hasRole, &isSubscriber); err != nil {
+		return errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	switch {
+	case hasRole:
+		return nil
+	case scope == UserPublicScope && listed:
+		return nil
+	case (scope == SystemManagedScope || scope == SystemGlobalScope) && isSubscriber:
+		return nil
+	}
+
+	return ErrMissingRequiredRole
+}
+
+// querySavedSearchSnapshots runs a query on the notification events that selects the
+// EventId, Timestamp, Reasons and BlobPath columns.
+func (c *Client) querySavedSearchSnapshots(ctx context.Context, txn transaction, stmt spanner.Statement,
+	savedSearchID string, snapshotType SavedSearchSnapshotType) ([]SavedSearchSnapshot, error) {
+	var snapshots []SavedSearchSnapshot
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+	err := iter.Do(func(row *spanner.Row) error {
+		var (
+			id, blobPath string
+			timestamp    time.Time
+			reasons      []string
+		)
+		if err := row.Columns(&id, &timestamp, &reasons, &blobPath); err != nil {
+			return errors.Join(ErrInternalQueryFailure, err)
+		}
+		snapshots = append(snapshots, SavedSearchSnapshot{
+			ID:            id,
+			SavedSearchID: savedSearchID,
+			SnapshotType:  snapshotType,
+			Timestamp:     &timestamp,
+			Reasons:       reasons,
+			BlobPath:      blobPath,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// getSavedSearchSnapshotAt returns the newest snapshot stored at or before the given time.
+func (c *Client) getSavedSearchSnapshotAt(ctx context.Context, txn transaction,
+	savedSearchID string, snapshotType SavedSearchSnapshotType, at time.Time) (*SavedSearchSnapshot, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`
+			SELECT EventId, Timestamp, Reasons, BlobPath
+			FROM %s
+			WHERE SavedSearchId = @savedSearchID AND SnapshotType = @snapshotType AND Timestamp <= @at
+			ORDER BY Timestamp DESC, EventId ASC
+			LIMIT 1`,
+			savedSearchNotificationEventsTable),
+		Params: map[string]any{
+			"savedSearchID": savedSearchID,
+			"snapshotType":  snapshotType,
+			"at":            at,
+		},
+	}
+	snapshots, err := c.querySavedSearchSnapshots(ctx, txn, stmt, savedSearchID, snapshotType)
+	if err != nil {
+		return nil, err
+	}
+	if len(snapshots) == 0 {
+		return nil, ErrQueryReturnedNoResults
+	}
+
+	return &snapshots[0], nil
+}
+
+func (c *Client) getLatestSavedSearchSnapshot(ctx context.Context, txn transaction,
+	savedSearchID string, snapshotType SavedSearchSnapshotType) (*SavedSearchSnapshot, error) {
+	key := savedSearchStateKey{SavedSearchID: savedSearchID, SnapshotType: snapshotType}
+	state, err := newEntityReader[savedSearchStateMapper, SavedSearchState, savedSearchStateKey](c).
+		readRowByKeyWithTransaction(ctx, key, txn)
+	if err != nil {
+		return nil, err
+	}
+	// The state row exists as soon as a worker picks up the saved search, before the first
+	// snapshot is stored.
+	if state.LastKnownStateBlobPath == nil {
+		return nil, ErrQueryReturnedNoResults
+	}
+
+	return &SavedSearchSnapshot{
+		ID:            LatestSavedSearchSnapshotID,
+		SavedSearchID: savedSearchID,
+		SnapshotType:  snapshotType,
+		Timestamp:     nil,
+		Reasons:       nil,
+		BlobPath:      *state.LastKnownStateBlobPath,
+	}, nil
+}
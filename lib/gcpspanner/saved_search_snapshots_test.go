@@ -0,0 +1,380 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSavedSearchSnapshots(t *testing.T) {
+	ctx := context.Background()
+	restartDatabaseContainer(t)
+
+	savedSearchID := createSavedSearchForNotificationTests(ctx, t)
+	workerID := "worker-1"
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	setupLockAndInitialState(ctx, t, savedSearchID, string(SavedSearchSnapshotTypeImmediate), workerID,
+		"path/initial", 10*time.Minute, fixedTime)
+
+	older := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)
+	for id, event := range map[string]struct {
+		timestamp time.Time
+		blobPath  string
+	}{
+		"event-1": {timestamp: older, blobPath: "path/state-1"},
+		"event-2": {timestamp: newer, blobPath: "path/state-2"},
+	} {
+		_, err := spannerClient.PublishSavedSearchNotificationEvent(ctx, SavedSearchNotificationCreateRequest{
+			SavedSearchID:  savedSearchID,
+			SnapshotType:   SavedSearchSnapshotTypeImmediate,
+			Timestamp:      event.timestamp,
+			EventType:      "IMMEDIATE_DIFF",
+			Reasons:        []string{"DATA_UPDATED"},
+			BlobPath:       event.blobPath,
+			DiffBlobPath:   "path/diff",
+			Summary:        spanner.NullJSON{Value: nil, Valid: false},
+			FeatureChanges: nil,
+		}, event.blobPath, workerID, WithID(id))
+		if err != nil {
+			t.Fatalf("PublishSavedSearchNotificationEvent() unexpected error: %v", err)
+		}
+	}
+
+	// The state points to the blob of the last published event.
+	latestBlobPath := "path/state-2"
+	err := spannerClient.UpdateSavedSearchStateLastKnownStateBlobPath(ctx, savedSearchID,
+		SavedSearchSnapshotTypeImmediate, latestBlobPath)
+	if err != nil {
+		t.Fatalf("UpdateSavedSearchStateLastKnownStateBlobPath() unexpected error: %v", err)
+	}
+
+	snapshot1 := SavedSearchSnapshot{
+		ID:            "event-1",
+		SavedSearchID: savedSearchID,
+		SnapshotType:  SavedSearchSnapshotTypeImmediate,
+		Timestamp:     &older,
+		Reasons:       []string{"DATA_UPDATED"},
+		BlobPath:      "path/state-1",
+	}
+	snapshot2 := SavedSearchSnapshot{
+		ID:            "event-2",
+		SavedSearchID: savedSearchID,
+		SnapshotType:  SavedSearchSnapshotTypeImmediate,
+		Timestamp:     &newer,
+		Reasons:       []string{"DATA_UPDATED"},
+		BlobPath:      "path/state-2",
+	}
+
+	// List the snapshots one page at a time, newest first.
+	page, err := spannerClient.ListSavedSearchSnapshots(ctx, ListSavedSearchSnapshotsRequest{
+		SavedSearchID:    savedSearchID,
+		RequestingUserID: "owner-notification-1",
+		SnapshotType:     SavedSearchSnapshotTypeImmediate,
+		At:               nil,
+		PageSize:         1,
+		PageToken:        nil,
+	})
+	if err != nil {
+		t.Fatalf("ListSavedSearchSnapshots() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]SavedSearchSnapshot{snapshot2}, page.Snapshots); diff != "" {
+		t.Errorf("first page mismatch (-want +got):\n%s", diff)
+	}
+	if page.NextPageToken == nil {
+		t.Fatal("expected a next page token")
+	}
+	page, err = spannerClient.ListSavedSearchSnapshots(ctx, ListSavedSearchSnapshotsRequest{
+		SavedSearchID:    savedSearchID,
+		RequestingUserID: "owner-notification-1",
+		SnapshotType:     SavedSearchSnapshotTypeImmediate,
+		At:               nil,
+		PageSize:         1,
+		PageToken:        page.NextPageToken,
+	})
+	if err != nil {
+		t.Fatalf("ListSavedSearchSnapshots() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]SavedSearchSnapshot{snapshot1}, page.Snapshots); diff != "" {
+		t.Errorf("second page mismatch (-want +got):\n%s", diff)
+	}
+
+	// Only the snapshots stored at or before the given time are listed.
+	page, err = spannerClient.ListSavedSearchSnapshots(ctx, ListSavedSearchSnapshotsRequest{
+		SavedSearchID:    savedSearchID,
+		RequestingUserID: "owner-notification-1",
+		SnapshotType:     SavedSearchSnapshotTypeImmediate,
+		At:               new(older.Add(time.Hour)),
+		PageSize:         10,
+		PageToken:        nil,
+	})
+	if err != nil {
+		t.Fatalf("ListSavedSearchSnapshots() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]SavedSearchSnapshot{snapshot1}, page.Snapshots); diff != "" {
+		t.Errorf("snapshots at a time mismatch (-want +got):\n%s", diff)
+	}
+
+	// Other snapshot types have no snapshots.
+	page, err = spannerClient.ListSavedSearchSnapshots(ctx, ListSavedSearchSnapshotsRequest{
+		SavedSearchID:    savedSearchID,
+		RequestingUserID: "owner-notification-1",
+		SnapshotType:     SavedSearchSnapshotTypeWeekly,
+		At:               nil,
+		PageSize:         10,
+		PageToken:        nil,
+	})
+	if err != nil {
+		t.Fatalf("ListSavedSearchSnapshots() unexpected error: %v", err)
+	}
+	if len(page.Snapshots) != 0 {
+		t.Errorf("expected no weekly snapshots, got %d", len(page.Snapshots))
+	}
+
+	testCases := []struct {
+		name        string
+		req         GetSavedSearchSnapshotRequest
+		expected    *SavedSearchSnapshot
+		expectedErr error
+	}{
+		{
+			name: "event snapshot",
+			req: GetSavedSearchSnapshotRequest{
+				SavedSearchID:    savedSearchID,
+				RequestingUserID: "owner-notification-1",
+				SnapshotType:     SavedSearchSnapshotTypeImmediate,
+				SnapshotID:       "event-1",
+				At:               nil,
+			},
+			expected:    &snapshot1,
+			expectedErr: nil,
+		},
+		{
+			name: "latest snapshot",
+			req: GetSavedSearchSnapshotRequest{
+				SavedSearchID:    savedSearchID,
+				RequestingUserID: "owner-notification-1",
+				SnapshotType:     SavedSearchSnapshotTypeImmediate,
+				SnapshotID:       LatestSavedSearchSnapshotID,
+				At:               nil,
+			},
+			expected: &SavedSearchSnapshot{
+				ID:            LatestSavedSearchSnapshotID,
+				SavedSearchID: savedSearchID,
+				SnapshotType:  SavedSearchSnapshotTypeImmediate,
+				Timestamp:     nil,
+				Reasons:       nil,
+				BlobPath:      latestBlobPath,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "latest snapshot at a time",
+			req: GetSavedSearchSnapshotRequest{
+				SavedSearchID:    savedSearchID,
+				RequestingUserID: "owner-notification-1",
+				SnapshotType:     SavedSearchSnapshotTypeImmediate,
+				SnapshotID:       LatestSavedSearchSnapshotID,
+				At:               new(newer.Add(-time.Hour)),
+			},
+			expected:    &snapshot1,
+			expectedErr: nil,
+		},
+		{
+			name: "latest snapshot at a time before the first snapshot",
+			req: GetSavedSearchSnapshotRequest{
+				SavedSearchID:    savedSearchID,
+				RequestingUserID: "owner-notification-1",
+				SnapshotType:     SavedSearchSnapshotTypeImmediate,
+				SnapshotID:       LatestSavedSearchSnapshotID,
+				At:               new(older.Add(-time.Hour)),
+			},
+			expected:    nil,
+			expectedErr: ErrQueryReturnedNoResults,
+		},
+		{
+			name: "latest snapshot of a snapshot type without state",
+			req: GetSavedSearchSnapshotRequest{
+				SavedSearchID:    savedSearchID,
+				RequestingUserID: "owner-notification-1",
+				SnapshotType:     SavedSearchSnapshotTypeMonthly,
+				SnapshotID:       LatestSavedSearchSnapshotID,
+				At:               nil,
+			},
+			expected:    nil,
+			expectedErr: ErrQueryReturnedNoResults,
+		},
+		{
+			name: "snapshot of another snapshot type",
+			req: GetSavedSearchSnapshotRequest{
+				SavedSearchID:    savedSearchID,
+				RequestingUserID: "owner-notification-1",
+				SnapshotType:     SavedSearchSnapshotTypeWeekly,
+				SnapshotID:       "event-1",
+				At:               nil,
+			},
+			expected:    nil,
+			expectedErr: ErrQueryReturnedNoResults,
+		},
+		{
+			name: "unknown snapshot",
+			req: GetSavedSearchSnapshotRequest{
+				SavedSearchID:    savedSearchID,
+				RequestingUserID: "owner-notification-1",
+				SnapshotType:     SavedSearchSnapshotTypeImmediate,
+				SnapshotID:       "missing",
+				At:               nil,
+			},
+			expected:    nil,
+			expectedErr: ErrQueryReturnedNoResults,
+		},
+		{
+			name: "user without a role",
+			req: GetSavedSearchSnapshotRequest{
+				SavedSearchID:    savedSearchID,
+				RequestingUserID: "stranger",
+				SnapshotType:     SavedSearchSnapshotTypeImmediate,
+				SnapshotID:       "event-1",
+				At:               nil,
+			},
+			expected:    nil,
+			expectedErr: ErrMissingRequiredRole,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			snapshot, err := spannerClient.GetSavedSearchSnapshot(ctx, tc.req)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Fatalf("GetSavedSearchSnapshot() error = %v, want %v", err, tc.expectedErr)
+			}
+			if diff := cmp.Diff(tc.expected, snapshot); diff != "" {
+				t.Errorf("snapshot mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	_, err = spannerClient.ListSavedSearchSnapshots(ctx, ListSavedSearchSnapshotsRequest{
+		SavedSearchID:    savedSearchID,
+		RequestingUserID: "stranger",
+		SnapshotType:     SavedSearchSnapshotTypeImmediate,
+		At:               nil,
+		PageSize:         10,
+		PageToken:        nil,
+	})
+	if !errors.Is(err, ErrMissingRequiredRole) {
+		t.Errorf("ListSavedSearchSnapshots() error = %v, want %v", err, ErrMissingRequiredRole)
+	}
+}
+
+func TestCheckCanReadSavedSearchSnapshots(t *testing.T) {
+	ctx := context.Background()
+	restartDatabaseContainer(t)
+
+	createUserSavedSearch := func(listed bool) string {
+		id, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
+			Name:            "user search",
+			Query:           "group:css",
+			OwnerUserID:     "owner",
+			Description:     nil,
+			Tags:            nil,
+			Listed:          listed,
+			QueryParameters: nil,
+		})
+		if err != nil {
+			t.Fatalf("CreateNewUserSavedSearch() unexpected error: %v", err)
+		}
+
+		return *id
+	}
+	unlistedID := createUserSavedSearch(false)
+	listedID := createUserSavedSearch(true)
+
+	globalID := "global-search"
+	_, err := spannerClient.ReadWriteTransaction(ctx, func(_ context.Context, txn *spanner.ReadWriteTransaction) error {
+		m, err := spanner.InsertStruct(savedSearchesTable, &SavedSearch{
+			ID:              globalID,
+			Name:            "global search",
+			Description:     nil,
+			Query:           "baseline_status:newly",
+			Scope:           SystemGlobalScope,
+			AuthorID:        "system",
+			CreatedAt:       time.Now(),
+			UpdatedAt:       time.Now(),
+			Tags:            nil,
+			Listed:          false,
+			QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
+		})
+		if err != nil {
+			return err
+		}
+
+		return txn.BufferWrite([]*spanner.Mutation{m})
+	})
+	if err != nil {
+		t.Fatalf("failed to insert global saved search: %v", err)
+	}
+
+	channelID, err := spannerClient.CreateNotificationChannel(ctx, CreateNotificationChannelRequest{
+		UserID:        "subscriber",
+		Name:          "Test",
+		Type:          NotificationChannelTypeEmail,
+		EmailConfig:   &EmailConfig{Address: "test@example.com", IsVerified: true, VerificationToken: nil},
+		WebhookConfig: nil,
+	})
+	if err != nil {
+		t.Fatalf("CreateNotificationChannel() unexpected error: %v", err)
+	}
+	_, err = spannerClient.CreateSavedSearchSubscription(ctx, CreateSavedSearchSubscriptionRequest{
+		UserID:        "subscriber",
+		ChannelID:     *channelID,
+		SavedSearchID: globalID,
+		Triggers:      []SubscriptionTrigger{SubscriptionTriggerFeatureBaselineRegressionToLimited},
+		Frequency:     SavedSearchSnapshotTypeImmediate,
+		ChannelType:   nil,
+	})
+	if err != nil {
+		t.Fatalf("CreateSavedSearchSubscription() unexpected error: %v", err)
+	}
+
+	testCases := []struct {
+		name          string
+		userID        string
+		savedSearchID string
+		expectedErr   error
+	}{
+		{name: "owner of an unlisted search", userID: "owner", savedSearchID: unlistedID, expectedErr: nil},
+		{name: "stranger on an unlisted search", userID: "stranger", savedSearchID: unlistedID,
+			expectedErr: ErrMissingRequiredRole},
+		{name: "stranger on a listed search", userID: "stranger", savedSearchID: listedID, expectedErr: nil},
+		{name: "subscriber of a global search", userID: "subscriber", savedSearchID: globalID, expectedErr: nil},
+		{name: "stranger on a global search", userID: "stranger", savedSearchID: globalID,
+			expectedErr: ErrMissingRequiredRole},
+		{name: "unknown search", userID: "owner", savedSearchID: "missing", expectedErr: ErrQueryReturnedNoResults},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := spannerClient.checkCanReadSavedSearchSnapshots(ctx, tc.userID, tc.savedSearchID)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("checkCanReadSavedSearchSnapshots() error = %v, want %v", err, tc.expectedErr)
+			}
+		})
+	}
+}
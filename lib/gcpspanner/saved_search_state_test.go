@@ -34,10 +34,13 @@ func noopSavedSearchStateHelper(t *testing.T, _ string) {
 func createSavedSearchForSavedSearchStateTests(ctx context.Context, t *testing.T) string {
 	t.Helper()
 	id, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-		Name:        "test search",
-		Query:       "group:test",
-		OwnerUserID: "owner-1",
-		Description: nil,
+		Name:            "test search",
+		Query:           "group:test",
+		OwnerUserID:     "owner-1",
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
 	})
 	if err != nil {
 		t.Fatalf("CreateNewUserSavedSearch() returned unexpected error: %v", err)
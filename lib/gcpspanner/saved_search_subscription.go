@@ -317,97 +317,135 @@ func (c *Client) createSavedSearchSubscription(
 ) (*string, error) {
 	var id *string
 	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
-		// 0. Resolve Channel ID if needed (Implicit Mode).
-		var skipOwnershipCheck bool
-		if req.ChannelID == "" && req.ChannelType != nil && *req.ChannelType == NotificationChannelTypeRSS {
-			resolvedChannelID, err := c.findOrCreateRSSChannel(ctx, req.UserID, txn)
-			if err != nil {
-				return err
-			}
-			req.ChannelID = resolvedChannelID
-			skipOwnershipCheck = true
-		}
+		var err error
+		id, err = c.createSavedSearchSubscriptionWithTransaction(ctx, txn, req, opts...)
 
-		// Check existing subscription for idempotency.
-		var existingSub SavedSearchSubscription
-		stmt := spanner.Statement{
-			SQL: `SELECT ID, Triggers, Frequency FROM SavedSearchSubscriptions 
-                  WHERE ChannelID = @channelID AND SavedSearchID = @savedSearchID LIMIT 1`,
-			Params: map[string]any{
-				"channelID":     req.ChannelID,
-				"savedSearchID": req.SavedSearchID,
-			},
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return id, nil
+}
+
+// resolveSubscriptionChannel returns the channel that a subscription request targets.
+// In implicit mode (RSS), the channel is found or created for the user and does not need an ownership check.
+func (c *Client) resolveSubscriptionChannel(
+	ctx context.Context,
+	txn *spanner.ReadWriteTransaction,
+	req CreateSavedSearchSubscriptionRequest,
+) (channelID string, skipOwnershipCheck bool, err error) {
+	if req.ChannelID == "" && req.ChannelType != nil && *req.ChannelType == NotificationChannelTypeRSS {
+		resolvedChannelID, err := c.findOrCreateRSSChannel(ctx, req.UserID, txn)
+		if err != nil {
+			return "", false, err
 		}
-		iter := txn.Query(ctx, stmt)
-		defer iter.Stop()
-		row, err := iter.Next()
-		if err == nil {
-			if err := row.ToStruct(&existingSub); err != nil {
-				return err
-			}
 
-			// Compare configuration (order-insensitive for triggers).
-			reqTriggers := make([]SubscriptionTrigger, len(req.Triggers))
-			copy(reqTriggers, req.Triggers)
-			slices.Sort(reqTriggers)
+		return resolvedChannelID, true, nil
+	}
 
-			existingTriggers := make([]SubscriptionTrigger, len(existingSub.Triggers))
-			copy(existingTriggers, existingSub.Triggers)
-			slices.Sort(existingTriggers)
+	return req.ChannelID, false, nil
+}
 
-			if existingSub.Frequency == req.Frequency && slices.Equal(existingTriggers, reqTriggers) {
-				id = &existingSub.ID
+// findSavedSearchSubscriptionByChannel returns the subscription of a channel to a saved search.
+// It returns nil if the channel is not subscribed to the saved search.
+func (c *Client) findSavedSearchSubscriptionByChannel(
+	ctx context.Context,
+	txn *spanner.ReadWriteTransaction,
+	channelID, savedSearchID string,
+) (*SavedSearchSubscription, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT ID, Triggers, Frequency FROM SavedSearchSubscriptions 
+                  WHERE ChannelID = @channelID AND SavedSearchID = @savedSearchID LIMIT 1`,
+		Params: map[string]any{
+			"channelID":     channelID,
+			"savedSearchID": savedSearchID,
+		},
+	}
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+	row, err := iter.Next()
+	if errors.Is(err, iterator.Done) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
 
-				return nil // Idempotent success.
-			}
+	var existingSub SavedSearchSubscription
+	if err := row.ToStruct(&existingSub); err != nil {
+		return nil, err
+	}
 
-			return ErrSubscriptionConflict
-		} else if !errors.Is(err, iterator.Done) {
-			return err
+	return &existingSub, nil
+}
+
+func (c *Client) createSavedSearchSubscriptionWithTransaction(
+	ctx context.Context,
+	txn *spanner.ReadWriteTransaction,
+	req CreateSavedSearchSubscriptionRequest,
+	opts ...CreateOption,
+) (*string, error) {
+	// 0. Resolve Channel ID if needed (Implicit Mode).
+	channelID, skipOwnershipCheck, err := c.resolveSubscriptionChannel(ctx, txn, req)
+	if err != nil {
+		return nil, err
+	}
+	req.ChannelID = channelID
+
+	// Check existing subscription for idempotency.
+	existingSub, err := c.findSavedSearchSubscriptionByChannel(ctx, txn, req.ChannelID, req.SavedSearchID)
+	if err != nil {
+		return nil, err
+	}
+	if existingSub != nil {
+		// Compare configuration (order-insensitive for triggers).
+		reqTriggers := make([]SubscriptionTrigger, len(req.Triggers))
+		copy(reqTriggers, req.Triggers)
+		slices.Sort(reqTriggers)
+
+		existingTriggers := make([]SubscriptionTrigger, len(existingSub.Triggers))
+		copy(existingTriggers, existingSub.Triggers)
+		slices.Sort(existingTriggers)
+
+		if existingSub.Frequency == req.Frequency && slices.Equal(existingTriggers, reqTriggers) {
+			return &existingSub.ID, nil // Idempotent success.
 		}
 
-		// 1. Check limit.
-		var count int64
-		stmt = spanner.Statement{
-			SQL: `SELECT COUNT(*)
+		return nil, ErrSubscriptionConflict
+	}
+
+	// 1. Check limit.
+	var count int64
+	stmt := spanner.Statement{
+		SQL: `SELECT COUNT(*)
               FROM SavedSearchSubscriptions sc
               JOIN NotificationChannels nc ON sc.ChannelID = nc.ID
               WHERE nc.UserID = @userID`,
-			Params: map[string]any{
-				"userID": req.UserID,
-			},
-		}
-		row, err = txn.Query(ctx, stmt).Next()
-		if err != nil {
-			return err
-		}
-		if err := row.Columns(&count); err != nil {
-			return err
-		}
+		Params: map[string]any{
+			"userID": req.UserID,
+		},
+	}
+	row, err := txn.Query(ctx, stmt).Next()
+	if err != nil {
+		return nil, err
+	}
+	if err := row.Columns(&count); err != nil {
+		return nil, err
+	}
 
-		if count >= int64(c.searchCfg.maxSubscriptionsPerUser) {
-			return ErrSubscriptionLimitExceeded
-		}
+	if count >= int64(c.searchCfg.maxSubscriptionsPerUser) {
+		return nil, ErrSubscriptionLimitExceeded
+	}
 
-		if !skipOwnershipCheck {
-			err = c.checkNotificationChannelOwnership(ctx, req.ChannelID, req.UserID, txn, true)
-			if err != nil {
-				return err
-			}
-		}
-		newID, err := newEntityCreator[savedSearchSubscriptionMapper](c).createWithTransaction(ctx, txn, req, opts...)
+	if !skipOwnershipCheck {
+		err = c.checkNotificationChannelOwnership(ctx, req.ChannelID, req.UserID, txn, true)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		id = newID
-
-		return nil
-	})
-	if err != nil {
-		return nil, err
 	}
 
-	return id, nil
+	return newEntityCreator[savedSearchSubscriptionMapper](c).createWithTransaction(ctx, txn, req, opts...)
 }
 
 // GetSavedSearchSubscription retrieves a subscription if it belongs to the specified user.
@@ -530,6 +568,11 @@ type spannerSubscriberDestination struct {
 	Type           NotificationChannelType `spanner:"Type"`
 	Triggers       []SubscriptionTrigger   `spanner:"Triggers"`
 	Config         spanner.NullJSON        `spanner:"Config"`
+	// Delivery settings of the channel. All of them are NULL if the channel has no settings.
+	QuietHoursStart      *string `spanner:"QuietHoursStart"`
+	QuietHoursEnd        *string `spanner:"QuietHoursEnd"`
+	QuietHoursTimeZone   *string `spanner:"QuietHoursTimeZone"`
+	MaxDeliveriesPerHour *int64  `spanner:"MaxDeliveriesPerHour"`
 }
 
 type SubscriberDestination struct {
@@ -542,6 +585,8 @@ type SubscriberDestination struct {
 	EmailConfig *EmailConfig
 	// If type is WEBHOOK, WebhookConfig is set.
 	WebhookConfig *WebhookConfig
+	// DeliverySettings is set if the channel has quiet hours or a rate limit.
+	DeliverySettings *NotificationChannelDeliverySettings
 }
 
 type readAllActivePushSubscriptionsMapper struct {
@@ -564,10 +609,15 @@ func (m readAllActivePushSubscriptionsMapper) SelectAllByKeys(key activePushSubs
 			sc.ChannelID,
 			sc.Triggers,
 			nc.Type,
-			nc.Config
+			nc.Config,
+			ds.QuietHoursStart,
+			ds.QuietHoursEnd,
+			ds.QuietHoursTimeZone,
+			ds.MaxDeliveriesPerHour
 		FROM SavedSearchSubscriptions sc
 		JOIN NotificationChannels nc ON sc.ChannelID = nc.ID
 		LEFT JOIN NotificationChannelStates AS cs ON nc.ID = cs.ChannelID
+		LEFT JOIN NotificationChannelDeliverySettings AS ds ON nc.ID = ds.ChannelID
 		WHERE
 			sc.SavedSearchID = @savedSearchID
 			AND sc.Frequency = @frequency
@@ -606,13 +656,25 @@ func (c *Client) FindAllActivePushSubscriptions(
 	results := make([]SubscriberDestination, 0, len(values))
 	for _, v := range values {
 		dest := SubscriberDestination{
-			SubscriptionID: v.SubscriptionID,
-			UserID:         v.UserID,
-			ChannelID:      v.ChannelID,
-			Type:           v.Type,
-			Triggers:       v.Triggers,
-			EmailConfig:    nil,
-			WebhookConfig:  nil,
+			SubscriptionID:   v.SubscriptionID,
+			UserID:           v.UserID,
+			ChannelID:        v.ChannelID,
+			Type:             v.Type,
+			Triggers:         v.Triggers,
+			EmailConfig:      nil,
+			WebhookConfig:    nil,
+			DeliverySettings: nil,
+		}
+		if v.QuietHoursStart != nil || v.MaxDeliveriesPerHour != nil {
+			dest.DeliverySettings = &NotificationChannelDeliverySettings{
+				ChannelID:            v.ChannelID,
+				QuietHoursStart:      v.QuietHoursStart,
+				QuietHoursEnd:        v.QuietHoursEnd,
+				QuietHoursTimeZone:   v.QuietHoursTimeZone,
+				MaxDeliveriesPerHour: v.MaxDeliveriesPerHour,
+				CreatedAt:            time.Time{},
+				UpdatedAt:            time.Time{},
+			}
 		}
 		subscriptionConfigs, err := loadSubscriptionConfigs(v.Type, v.Config)
 		if err != nil {
@@ -49,10 +49,13 @@ func TestCreateAndGetSavedSearchSubscription(t *testing.T) {
 	channelID := *channelIDPtr
 
 	savedSearchIDPtr, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-		Name:        "Test Search",
-		Query:       "is:widely",
-		OwnerUserID: userID,
-		Description: nil,
+		Name:            "Test Search",
+		Query:           "is:widely",
+		OwnerUserID:     userID,
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
 	})
 	if err != nil {
 		t.Fatalf("failed to create saved search: %v", err)
@@ -130,10 +133,13 @@ func TestGetSavedSearchSubscriptionFailsForWrongUser(t *testing.T) {
 	channelID := *channelIDPtr
 
 	savedSearchIDPtr, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-		Name:        "Test Search",
-		Query:       "is:widely",
-		OwnerUserID: userID,
-		Description: nil,
+		Name:            "Test Search",
+		Query:           "is:widely",
+		OwnerUserID:     userID,
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
 	})
 	if err != nil {
 		t.Fatalf("failed to create saved search: %v", err)
@@ -182,10 +188,13 @@ func TestUpdateSavedSearchSubscription(t *testing.T) {
 	channelID := *channelIDPtr
 
 	savedSearchIDPtr, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-		Name:        "Test Search",
-		Query:       "is:widely",
-		OwnerUserID: userID,
-		Description: nil,
+		Name:            "Test Search",
+		Query:           "is:widely",
+		OwnerUserID:     userID,
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
 	})
 	if err != nil {
 		t.Fatalf("failed to create saved search: %v", err)
@@ -256,10 +265,13 @@ func TestDeleteSavedSearchSubscription(t *testing.T) {
 	channelID := *channelIDPtr
 
 	savedSearchIDPtr, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-		Name:        "Test Search",
-		Query:       "is:widely",
-		OwnerUserID: userID,
-		Description: nil,
+		Name:            "Test Search",
+		Query:           "is:widely",
+		OwnerUserID:     userID,
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
 	})
 	if err != nil {
 		t.Fatalf("failed to create saved search: %v", err)
@@ -313,10 +325,13 @@ func TestListSavedSearchSubscriptions(t *testing.T) {
 	channelID := *channelIDPtr
 
 	savedSearchIDPtr, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-		Name:        "Test Search",
-		Query:       "is:widely",
-		OwnerUserID: userID,
-		Description: nil,
+		Name:            "Test Search",
+		Query:           "is:widely",
+		OwnerUserID:     userID,
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
 	})
 	if err != nil {
 		t.Fatalf("failed to create saved search: %v", err)
@@ -335,10 +350,13 @@ func TestListSavedSearchSubscriptions(t *testing.T) {
 	// Create a few subscriptions to list
 	for i := range 3 {
 		savedSearchIDPtr, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-			Name:        "Test Search",
-			Query:       fmt.Sprintf("is:widely_%d", i),
-			OwnerUserID: userID,
-			Description: nil,
+			Name:            "Test Search",
+			Query:           fmt.Sprintf("is:widely_%d", i),
+			OwnerUserID:     userID,
+			Description:     nil,
+			Tags:            nil,
+			Listed:          false,
+			QueryParameters: nil,
 		})
 		if err != nil {
 			t.Fatalf("failed to create saved search %d: %v", i, err)
@@ -615,10 +633,13 @@ func TestCreateSavedSearchSubscriptionLimitExceeded(t *testing.T) {
 	savedSearchIDs := make([]string, 0, 5)
 	for i := range 5 {
 		savedSearchIDPtr, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-			Name:        fmt.Sprintf("Search %d", i),
-			Query:       fmt.Sprintf("is:widely_%d", i),
-			OwnerUserID: userID,
-			Description: nil,
+			Name:            fmt.Sprintf("Search %d", i),
+			Query:           fmt.Sprintf("is:widely_%d", i),
+			OwnerUserID:     userID,
+			Description:     nil,
+			Tags:            nil,
+			Listed:          false,
+			QueryParameters: nil,
 		})
 		if err != nil {
 			t.Fatalf("failed to create saved search %d: %v", i, err)
@@ -651,10 +672,13 @@ func TestCreateSavedSearchSubscriptionLimitExceeded(t *testing.T) {
 	// Try to create one more.
 	// Create a 6th saved search to make it unique.
 	savedSearchIDPtr, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-		Name:        "Search Limit",
-		Query:       "is:widely_limit",
-		OwnerUserID: userID,
-		Description: nil,
+		Name:            "Search Limit",
+		Query:           "is:widely_limit",
+		OwnerUserID:     userID,
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
 	})
 	if err != nil {
 		t.Fatalf("failed to create saved search limit: %v", err)
@@ -679,10 +703,13 @@ func TestCreateSavedSearchSubscriptionRSSResolution(t *testing.T) {
 	userID := uuid.NewString()
 
 	savedSearchIDPtr, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-		Name:        "Test Search 1",
-		Query:       "is:widely",
-		OwnerUserID: userID,
-		Description: nil,
+		Name:            "Test Search 1",
+		Query:           "is:widely",
+		OwnerUserID:     userID,
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
 	})
 	if err != nil {
 		t.Fatalf("failed to create saved search 1: %v", err)
@@ -690,10 +717,13 @@ func TestCreateSavedSearchSubscriptionRSSResolution(t *testing.T) {
 	savedSearchID1 := *savedSearchIDPtr
 
 	savedSearchID2Ptr, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-		Name:        "Test Search 2",
-		Query:       "is:widely2",
-		OwnerUserID: userID,
-		Description: nil,
+		Name:            "Test Search 2",
+		Query:           "is:widely2",
+		OwnerUserID:     userID,
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
 	})
 	if err != nil {
 		t.Fatalf("failed to create saved search 2: %v", err)
@@ -784,10 +814,13 @@ func TestCreateSavedSearchSubscriptionIdempotency(t *testing.T) {
 	channelID := *channelIDPtr
 
 	savedSearchIDPtr, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-		Name:        "Test Search",
-		Query:       "is:widely",
-		OwnerUserID: userID,
-		Description: nil,
+		Name:            "Test Search",
+		Query:           "is:widely",
+		OwnerUserID:     userID,
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
 	})
 	if err != nil {
 		t.Fatalf("failed to create saved search: %v", err)
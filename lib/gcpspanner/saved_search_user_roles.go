@@ -29,8 +29,32 @@ type SavedSearchRole string
 const (
 	// SavedSearchOwner indicates the user owns the saved search query.
 	SavedSearchOwner SavedSearchRole = "OWNER"
+	// SavedSearchEditor indicates the user can edit the saved search query but can not delete it
+	// or manage its collaborators.
+	SavedSearchEditor SavedSearchRole = "EDITOR"
+	// SavedSearchViewer indicates the user is a named collaborator of the saved search without edit rights.
+	SavedSearchViewer SavedSearchRole = "VIEWER"
 )
 
+// rank orders the roles by the permissions they grant. Unknown roles grant nothing.
+func (r SavedSearchRole) rank() int {
+	switch r {
+	case SavedSearchOwner:
+		return 3
+	case SavedSearchEditor:
+		return 2
+	case SavedSearchViewer:
+		return 1
+	}
+
+	return 0
+}
+
+// Includes returns true if the role grants at least the permissions of the other role.
+func (r SavedSearchRole) Includes(other SavedSearchRole) bool {
+	return r.rank() > 0 && r.rank() >= other.rank()
+}
+
 const savedSearchUserRolesTable = "SavedSearchUserRoles"
 
 // SavedSearchUserRole represents a user's role in relation to a saved search.
@@ -40,6 +64,8 @@ type SavedSearchUserRole struct {
 	UserRole      SavedSearchRole `spanner:"UserRole"`
 }
 
+// checkForSavedSearchRole returns ErrMissingRequiredRole unless the user has roleToCheck,
+// or a role that includes it, on the saved search.
 func (c *Client) checkForSavedSearchRole(
 	ctx context.Context, txn *spanner.ReadWriteTransaction, roleToCheck SavedSearchRole,
 	userID string, savedSearchID string) error {
@@ -69,7 +95,7 @@ func (c *Client) checkForSavedSearchRole(
 		return errors.Join(ErrInternalQueryFailure, err)
 	}
 
-	if role != string(roleToCheck) {
+	if !SavedSearchRole(role).Includes(roleToCheck) {
 		return ErrMissingRequiredRole
 	}
 
@@ -16,9 +16,12 @@ package gcpspanner
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"time"
 
 	"cloud.google.com/go/spanner"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
 )
 
 // SavedSearchScope represents the scope of a saved search.
@@ -45,6 +48,64 @@ type SavedSearch struct {
 	AuthorID    string           `spanner:"AuthorID"`
 	CreatedAt   time.Time        `spanner:"CreatedAt"`
 	UpdatedAt   time.Time        `spanner:"UpdatedAt"`
+	// Tags are free form labels used to find the saved search in the directory.
+	Tags []string `spanner:"Tags"`
+	// Listed indicates that the saved search appears in the public saved search directory.
+	Listed bool `spanner:"Listed"`
+	// QueryParameters holds the declared parameters of the query as a JSON list of
+	// SavedSearchQueryParameter. It is null when the query has no parameters.
+	QueryParameters spanner.NullJSON `spanner:"QueryParameters"`
+}
+
+// SavedSearchQueryParameter declares a variable of a saved search query and its default value.
+// A query uses the parameter by referring to $Name.
+type SavedSearchQueryParameter struct {
+	Name         string `json:"name"`
+	DefaultValue string `json:"default_value"`
+}
+
+// savedSearchQueryParametersJSON converts the query parameters to a value of the QueryParameters column.
+func savedSearchQueryParametersJSON(params []SavedSearchQueryParameter) spanner.NullJSON {
+	if len(params) == 0 {
+		return spanner.NullJSON{Value: nil, Valid: false}
+	}
+
+	return spanner.NullJSON{Value: params, Valid: true}
+}
+
+// DecodeSavedSearchQueryParameters converts a value of the QueryParameters column to the query parameters.
+func DecodeSavedSearchQueryParameters(value spanner.NullJSON) ([]SavedSearchQueryParameter, error) {
+	if !value.Valid || value.Value == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(value.Value)
+	if err != nil {
+		return nil, err
+	}
+	var params []SavedSearchQueryParameter
+	if err := json.Unmarshal(data, &params); err != nil {
+		return nil, err
+	}
+
+	return params, nil
+}
+
+// BindSavedSearchQuery substitutes the variables of a saved search query. Each variable takes its value from
+// values, or from the default of its parameter declared in queryParameters.
+func BindSavedSearchQuery(query string, queryParameters spanner.NullJSON, values map[string]string) (string, error) {
+	params, err := DecodeSavedSearchQueryParameters(queryParameters)
+	if err != nil {
+		return "", err
+	}
+	searchParams := make([]searchtypes.QueryParameter, 0, len(params))
+	for _, param := range params {
+		searchParams = append(searchParams, searchtypes.QueryParameter{
+			Name:         param.Name,
+			DefaultValue: param.DefaultValue,
+		})
+	}
+
+	return searchtypes.BindQueryVariables(query, searchParams, values)
 }
 
 // savedSearchMapper implements the necessary interfaces for the generic helpers.
@@ -93,7 +154,7 @@ func (r referencingSavedSearchRequest) GetPageSize() int {
 // SelectOne returns a statement to select a single saved search.
 func (m savedSearchMapper) SelectOne(id string) spanner.Statement {
 	stmt := spanner.NewStatement(
-		`SELECT ID, Name, Description, Query, Scope, AuthorID, CreatedAt, UpdatedAt
+		`SELECT ID, Name, Description, Query, Scope, AuthorID, CreatedAt, UpdatedAt, Tags, Listed, QueryParameters
 		 FROM SavedSearches WHERE ID = @id`,
 	)
 	stmt.Params["id"] = id
@@ -135,3 +196,28 @@ func (c *Client) GetReferencingSavedSearchIDs(ctx context.Context, id string) ([
 
 	return ids, nil
 }
+
+// getReferencingSavedSearchIDsWithTransaction finds the other saved searches that reference the given ID
+// in their query. Unlike GetReferencingSavedSearchIDs, it excludes the saved search itself, as the query of
+// a user hotlist refers to its own ID.
+func (c *Client) getReferencingSavedSearchIDsWithTransaction(
+	ctx context.Context, txn *spanner.ReadWriteTransaction, id string) ([]string, error) {
+	stmt := referencingSavedSearchMapper{}.SelectList(referencingSavedSearchRequest{ID: id})
+	var ids []string
+	err := txn.Query(ctx, stmt).Do(func(r *spanner.Row) error {
+		var result SavedSearchIDOnly
+		if err := r.ToStruct(&result); err != nil {
+			return err
+		}
+		if result.ID != id {
+			ids = append(ids, result.ID)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return ids, nil
+}
@@ -40,14 +40,17 @@ func TestGetSavedSearch(t *testing.T) {
 	id := uuid.NewString()
 	desc := systemSearchDesc
 	expectedSavedSearch := &SavedSearch{
-		ID:          id,
-		Name:        "my system search",
-		Query:       "feature:is(\"foo\")",
-		Scope:       SystemManagedScope,
-		AuthorID:    "system",
-		Description: &desc,
-		CreatedAt:   spanner.CommitTimestamp,
-		UpdatedAt:   spanner.CommitTimestamp,
+		ID:              id,
+		Name:            "my system search",
+		Query:           "feature:is(\"foo\")",
+		Scope:           SystemManagedScope,
+		AuthorID:        "system",
+		Description:     &desc,
+		CreatedAt:       spanner.CommitTimestamp,
+		UpdatedAt:       spanner.CommitTimestamp,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 	}
 
 	_, err := spannerClient.ReadWriteTransaction(ctx, func(_ context.Context, txn *spanner.ReadWriteTransaction) error {
@@ -77,10 +80,13 @@ func TestGetUserSavedSearch(t *testing.T) {
 	ctx := context.Background()
 
 	savedSearchID, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-		Name:        "my little search",
-		Query:       "group:css",
-		OwnerUserID: "userID1",
-		Description: new("desc"),
+		Name:            "my little search",
+		Query:           "group:css",
+		OwnerUserID:     "userID1",
+		Description:     new("desc"),
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
 	})
 	if err != nil {
 		t.Errorf("expected nil error. received %s", err)
@@ -101,8 +107,11 @@ func TestGetUserSavedSearch(t *testing.T) {
 				AuthorID:    "userID1",
 				Description: new("desc"),
 				// Don't actually compare the last two values.
-				CreatedAt: spanner.CommitTimestamp,
-				UpdatedAt: spanner.CommitTimestamp,
+				CreatedAt:       spanner.CommitTimestamp,
+				UpdatedAt:       spanner.CommitTimestamp,
+				Tags:            nil,
+				Listed:          false,
+				QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 			},
 		}
 		actual, err := spannerClient.GetUserSavedSearch(ctx, *savedSearchID, nil)
@@ -125,8 +134,11 @@ func TestGetUserSavedSearch(t *testing.T) {
 				AuthorID:    "userID1",
 				Description: new("desc"),
 				// Don't actually compare the last two values.
-				CreatedAt: spanner.CommitTimestamp,
-				UpdatedAt: spanner.CommitTimestamp,
+				CreatedAt:       spanner.CommitTimestamp,
+				UpdatedAt:       spanner.CommitTimestamp,
+				Tags:            nil,
+				Listed:          false,
+				QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 			},
 		}
 		actual, err := spannerClient.GetUserSavedSearch(ctx, *savedSearchID, new("userID1"))
@@ -150,8 +162,11 @@ func TestGetUserSavedSearch(t *testing.T) {
 				AuthorID:    "userID1",
 				Description: new("desc"),
 				// Don't actually compare the last two values.
-				CreatedAt: spanner.CommitTimestamp,
-				UpdatedAt: spanner.CommitTimestamp,
+				CreatedAt:       spanner.CommitTimestamp,
+				UpdatedAt:       spanner.CommitTimestamp,
+				Tags:            nil,
+				Listed:          false,
+				QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 			},
 		}
 		actual, err := spannerClient.GetUserSavedSearch(ctx, *savedSearchID, new("otherUser"))
@@ -198,35 +213,44 @@ func TestGetReferencingSavedSearchIDs(t *testing.T) {
 
 	// Insert searches that reference the targetID
 	ref1 := &SavedSearch{
-		ID:          uuid.NewString(),
-		Name:        "ref 1",
-		Query:       "saved:" + targetID,
-		Scope:       UserPublicScope,
-		AuthorID:    "user1",
-		Description: nil,
-		CreatedAt:   spanner.CommitTimestamp,
-		UpdatedAt:   spanner.CommitTimestamp,
+		ID:              uuid.NewString(),
+		Name:            "ref 1",
+		Query:           "saved:" + targetID,
+		Scope:           UserPublicScope,
+		AuthorID:        "user1",
+		Description:     nil,
+		CreatedAt:       spanner.CommitTimestamp,
+		UpdatedAt:       spanner.CommitTimestamp,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 	}
 	ref2 := &SavedSearch{
-		ID:          uuid.NewString(),
-		Name:        "ref 2",
-		Query:       "hotlist:" + targetID,
-		Scope:       UserPublicScope,
-		AuthorID:    "user2",
-		Description: nil,
-		CreatedAt:   spanner.CommitTimestamp,
-		UpdatedAt:   spanner.CommitTimestamp,
+		ID:              uuid.NewString(),
+		Name:            "ref 2",
+		Query:           "hotlist:" + targetID,
+		Scope:           UserPublicScope,
+		AuthorID:        "user2",
+		Description:     nil,
+		CreatedAt:       spanner.CommitTimestamp,
+		UpdatedAt:       spanner.CommitTimestamp,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 	}
 	// Insert a search that does NOT reference the targetID
 	noref := &SavedSearch{
-		ID:          uuid.NewString(),
-		Name:        "no ref",
-		Query:       "saved:other-id",
-		Scope:       UserPublicScope,
-		AuthorID:    "user3",
-		Description: nil,
-		CreatedAt:   spanner.CommitTimestamp,
-		UpdatedAt:   spanner.CommitTimestamp,
+		ID:              uuid.NewString(),
+		Name:            "no ref",
+		Query:           "saved:other-id",
+		Scope:           UserPublicScope,
+		AuthorID:        "user3",
+		Description:     nil,
+		CreatedAt:       spanner.CommitTimestamp,
+		UpdatedAt:       spanner.CommitTimestamp,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 	}
 
 	_, err := spannerClient.ReadWriteTransaction(ctx, func(_ context.Context, txn *spanner.ReadWriteTransaction) error {
@@ -258,3 +282,90 @@ func TestGetReferencingSavedSearchIDs(t *testing.T) {
 		}
 	}
 }
+
+func TestSavedSearchQueryParameters(t *testing.T) {
+	restartDatabaseContainer(t)
+	ctx := context.Background()
+
+	params := []SavedSearchQueryParameter{
+		{Name: "browser", DefaultValue: "safari"},
+		{Name: "status", DefaultValue: "limited"},
+	}
+	id, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
+		Name:            "missing on a browser",
+		Query:           "-available_on:$browser baseline_status:$status",
+		OwnerUserID:     "userID1",
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: params,
+	})
+	if err != nil {
+		t.Fatalf("unable to create saved search: %s", err)
+	}
+	assertQueryParameters := func(id string, expected []SavedSearchQueryParameter) {
+		t.Helper()
+		savedSearch, err := spannerClient.GetSavedSearch(ctx, id)
+		if err != nil {
+			t.Fatalf("unable to get saved search: %s", err)
+		}
+		got, err := DecodeSavedSearchQueryParameters(savedSearch.QueryParameters)
+		if err != nil {
+			t.Fatalf("unable to decode query parameters: %s", err)
+		}
+		if !reflect.DeepEqual(got, expected) {
+			t.Errorf("unexpected query parameters. got %+v, want %+v", got, expected)
+		}
+	}
+	assertQueryParameters(*id, params)
+
+	// Forks declare the same parameters as their source.
+	forkID, err := spannerClient.ForkSavedSearch(ctx, ForkSavedSearchRequest{
+		SourceSavedSearchID: *id,
+		OwnerUserID:         "userID2",
+		Name:                nil,
+		Description:         nil,
+	})
+	if err != nil {
+		t.Fatalf("unable to fork saved search: %s", err)
+	}
+	assertQueryParameters(*forkID, params)
+
+	updatedParams := []SavedSearchQueryParameter{{Name: "browser", DefaultValue: "firefox"}}
+	err = spannerClient.UpdateUserSavedSearch(ctx, UpdateSavedSearchRequest{
+		ID:          *id,
+		AuthorID:    "userID1",
+		Query:       OptionallySet[string]{Value: "-available_on:$browser", IsSet: true},
+		Name:        OptionallySet[string]{Value: "", IsSet: false},
+		Description: OptionallySet[*string]{Value: nil, IsSet: false},
+		Tags:        OptionallySet[[]string]{Value: nil, IsSet: false},
+		Listed:      OptionallySet[bool]{Value: false, IsSet: false},
+		QueryParameters: OptionallySet[[]SavedSearchQueryParameter]{
+			Value: updatedParams,
+			IsSet: true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to update saved search: %s", err)
+	}
+	assertQueryParameters(*id, updatedParams)
+
+	// Clearing the parameters stores null.
+	err = spannerClient.UpdateUserSavedSearch(ctx, UpdateSavedSearchRequest{
+		ID:          *id,
+		AuthorID:    "userID1",
+		Query:       OptionallySet[string]{Value: "group:css", IsSet: true},
+		Name:        OptionallySet[string]{Value: "", IsSet: false},
+		Description: OptionallySet[*string]{Value: nil, IsSet: false},
+		Tags:        OptionallySet[[]string]{Value: nil, IsSet: false},
+		Listed:      OptionallySet[bool]{Value: false, IsSet: false},
+		QueryParameters: OptionallySet[[]SavedSearchQueryParameter]{
+			Value: nil,
+			IsSet: true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to update saved search: %s", err)
+	}
+	assertQueryParameters(*id, nil)
+}
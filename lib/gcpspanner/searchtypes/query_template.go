@@ -0,0 +1,120 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package searchtypes
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// ErrUnboundQueryVariable indicates a query variable has neither a declared parameter nor a value.
+	ErrUnboundQueryVariable = errors.New("query variable is not bound")
+	// ErrInvalidQueryParameter indicates a query parameter has an invalid name or value.
+	ErrInvalidQueryParameter = errors.New("invalid query parameter")
+)
+
+var (
+	// queryVariableRegex matches the variables of a query template, e.g. $browser.
+	// Only the parts of the query outside of quoted terms are matched. See unquotedQueryParts.
+	queryVariableRegex = regexp.MustCompile(`\$([A-Za-z_][A-Za-z0-9_]*)`)
+	// queryParameterNameRegex matches a valid parameter name, without the leading $.
+	queryParameterNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	// queryParameterValueRegex matches the values that can be substituted for a variable.
+	// Values are restricted to a single word so that a value cannot change the structure of the query.
+	queryParameterValueRegex = regexp.MustCompile(`^[A-Za-z0-9_.@-]+$`)
+)
+
+// QueryParameter declares a variable of a query template and the value used when no value is given.
+type QueryParameter struct {
+	Name         string
+	DefaultValue string
+}
+
+// IsValidQueryParameterName reports whether the name can be used for a query parameter.
+func IsValidQueryParameterName(name string) bool {
+	return queryParameterNameRegex.MatchString(name)
+}
+
+// IsValidQueryParameterValue reports whether the value can be substituted for a query variable.
+func IsValidQueryParameterValue(value string) bool {
+	return queryParameterValueRegex.MatchString(value)
+}
+
+// queryQuote delimits the quoted terms of a query, e.g. name:"CSS Grid".
+const queryQuote = `"`
+
+// unquotedQueryParts splits the query at its quotes. The parts at even indexes are outside of quoted terms.
+// The text after an unterminated quote is treated as quoted.
+func unquotedQueryParts(query string) []string {
+	return strings.Split(query, queryQuote)
+}
+
+// QueryVariables returns the distinct variable names used in the query, in order of appearance.
+// A $ inside a quoted term is part of the term and not a variable.
+func QueryVariables(query string) []string {
+	var names []string
+	seen := map[string]struct{}{}
+	parts := unquotedQueryParts(query)
+	for i := 0; i < len(parts); i += 2 {
+		for _, match := range queryVariableRegex.FindAllStringSubmatch(parts[i], -1) {
+			if _, found := seen[match[1]]; found {
+				continue
+			}
+			seen[match[1]] = struct{}{}
+			names = append(names, match[1])
+		}
+	}
+
+	return names
+}
+
+// BindQueryVariables substitutes the variables of the query template with their values.
+// A variable takes its value from values if present, and otherwise from the default of its declared parameter.
+// Values for variables that the query does not use are ignored. Quoted terms are left as they are.
+// It returns ErrUnboundQueryVariable if a variable has no value and ErrInvalidQueryParameter
+// if a value is not a single word.
+func BindQueryVariables(query string, params []QueryParameter, values map[string]string) (string, error) {
+	bound := make(map[string]string, len(params))
+	for _, param := range params {
+		bound[param.Name] = param.DefaultValue
+	}
+	for name, value := range values {
+		if _, declared := bound[name]; declared {
+			bound[name] = value
+		}
+	}
+
+	for _, name := range QueryVariables(query) {
+		value, found := bound[name]
+		if !found {
+			return "", fmt.Errorf("%w: $%s", ErrUnboundQueryVariable, name)
+		}
+		if !IsValidQueryParameterValue(value) {
+			return "", fmt.Errorf("%w: value of $%s", ErrInvalidQueryParameter, name)
+		}
+	}
+
+	parts := unquotedQueryParts(query)
+	for i := 0; i < len(parts); i += 2 {
+		parts[i] = queryVariableRegex.ReplaceAllStringFunc(parts[i], func(variable string) string {
+			return bound[variable[1:]]
+		})
+	}
+
+	return strings.Join(parts, queryQuote), nil
+}
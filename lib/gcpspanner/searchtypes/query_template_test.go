@@ -0,0 +1,110 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package searchtypes
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestQueryVariables(t *testing.T) {
+	got := QueryVariables("-available_on:$browser baseline_status:$status OR available_on:$browser group:css")
+	if want := []string{"browser", "status"}; !slices.Equal(got, want) {
+		t.Errorf("unexpected variables. got %v, want %v", got, want)
+	}
+	if got := QueryVariables("group:css"); got != nil {
+		t.Errorf("expected no variables, got %v", got)
+	}
+	got = QueryVariables(`name:"$foo" available_on:$browser name:"$bar`)
+	if want := []string{"browser"}; !slices.Equal(got, want) {
+		t.Errorf("unexpected variables with quoted terms. got %v, want %v", got, want)
+	}
+}
+
+func TestBindQueryVariables(t *testing.T) {
+	params := []QueryParameter{
+		{Name: "browser", DefaultValue: "safari"},
+		{Name: "status", DefaultValue: "limited"},
+	}
+	testCases := []struct {
+		name          string
+		query         string
+		params        []QueryParameter
+		values        map[string]string
+		expectedQuery string
+		expectedError error
+	}{
+		{
+			name:          "defaults",
+			query:         "-available_on:$browser baseline_status:$status",
+			params:        params,
+			values:        nil,
+			expectedQuery: "-available_on:safari baseline_status:limited",
+			expectedError: nil,
+		},
+		{
+			name:          "values override defaults",
+			query:         "-available_on:$browser baseline_status:$status OR available_on:$browser",
+			params:        params,
+			values:        map[string]string{"browser": "firefox", "unused": "x"},
+			expectedQuery: "-available_on:firefox baseline_status:limited OR available_on:firefox",
+			expectedError: nil,
+		},
+		{
+			name:          "values for undeclared variables are ignored",
+			query:         "-available_on:$browser",
+			params:        nil,
+			values:        map[string]string{"browser": "firefox"},
+			expectedQuery: "",
+			expectedError: ErrUnboundQueryVariable,
+		},
+		{
+			name:          "no variables",
+			query:         "group:css",
+			params:        nil,
+			values:        nil,
+			expectedQuery: "group:css",
+			expectedError: nil,
+		},
+		{
+			name:          "quoted terms are not substituted",
+			query:         `name:"$browser" available_on:$browser name:"a $status`,
+			params:        params,
+			values:        nil,
+			expectedQuery: `name:"$browser" available_on:safari name:"a $status`,
+			expectedError: nil,
+		},
+		{
+			name:          "value changing the query structure",
+			query:         "-available_on:$browser",
+			params:        params,
+			values:        map[string]string{"browser": "safari OR id:grid"},
+			expectedQuery: "",
+			expectedError: ErrInvalidQueryParameter,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			query, err := BindQueryVariables(tc.query, tc.params, tc.values)
+			if !errors.Is(err, tc.expectedError) {
+				t.Fatalf("unexpected error. got %v, want %v", err, tc.expectedError)
+			}
+			if query != tc.expectedQuery {
+				t.Errorf("unexpected query. got %q, want %q", query, tc.expectedQuery)
+			}
+		})
+	}
+}
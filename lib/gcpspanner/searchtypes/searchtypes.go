@@ -109,6 +109,23 @@ func CountNodes(node *SearchNode) int {
 	return count
 }
 
+// ReferenceTerms returns the saved search and hotlist terms of the SearchNode AST tree, in query order.
+func ReferenceTerms(node *SearchNode) []*SearchTerm {
+	if node == nil {
+		return nil
+	}
+	var terms []*SearchTerm
+	if node.Term != nil &&
+		(node.Term.Identifier == IdentifierSavedSearch || node.Term.Identifier == IdentifierHotlist) {
+		terms = append(terms, node.Term)
+	}
+	for _, child := range node.Children {
+		terms = append(terms, ReferenceTerms(child)...)
+	}
+
+	return terms
+}
+
 // EqualSearchNode checks if two SearchNode trees are structurally identical.
 func EqualSearchNode(a, b *SearchNode) bool {
 	if a == nil && b == nil {
@@ -28,10 +28,12 @@ import (
 	"time"
 
 	"cloud.google.com/go/spanner"
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
 	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
 	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner"
 	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
 	"github.com/GoogleChrome/webstatus.dev/lib/gen/openapi/backend"
+	"github.com/GoogleChrome/webstatus.dev/lib/workertypes"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
@@ -73,6 +75,11 @@ type BackendSpannerClient interface {
 		wptMetricView gcpspanner.WPTMetricView,
 		browsers []string,
 	) (*gcpspanner.FeatureResultPage, error)
+	FeaturesSearchFacetCounts(
+		ctx context.Context,
+		searchNode *searchtypes.SearchNode,
+		facets []gcpspanner.FeatureSearchFacet,
+	) ([]gcpspanner.FeatureSearchFacetCount, error)
 	GetFeature(
 		ctx context.Context,
 		filter gcpspanner.Filterable,
@@ -87,6 +94,8 @@ type BackendSpannerClient interface {
 		ctx context.Context,
 		featureKey string,
 	) (*gcpspanner.SplitWebFeature, error)
+	GetFeatureTimeline(ctx context.Context, featureKey string) (*gcpspanner.FeatureTimeline, error)
+	GetOldestBrowserReleaseVersionWithStatus(ctx context.Context, browserName string, status string) (string, error)
 	GetIDFromFeatureKey(
 		ctx context.Context,
 		filter *gcpspanner.FeatureIDFilter,
@@ -100,6 +109,16 @@ type BackendSpannerClient interface {
 		pageSize int,
 		pageToken *string,
 	) (*gcpspanner.BrowserFeatureCountResultPage, error)
+	ListBrowserFeatureCountMetricForSearch(
+		ctx context.Context,
+		searchNode *searchtypes.SearchNode,
+		targetBrowser string,
+		targetMobileBrowser *string,
+		startAt time.Time,
+		endAt time.Time,
+		pageSize int,
+		pageToken *string,
+	) (*gcpspanner.BrowserFeatureCountResultPage, error)
 	ListMissingOneImplCounts(
 		ctx context.Context,
 		targetBrowser string,
@@ -127,6 +146,15 @@ type BackendSpannerClient interface {
 		pageSize int,
 		pageToken *string,
 	) (*gcpspanner.BaselineStatusCountResultPage, error)
+	ListBaselineStatusCountsForSearch(
+		ctx context.Context,
+		searchNode *searchtypes.SearchNode,
+		dateType gcpspanner.BaselineDateType,
+		startAt time.Time,
+		endAt time.Time,
+		pageSize int,
+		pageToken *string,
+	) (*gcpspanner.BaselineStatusCountResultPage, error)
 	CreateNewUserSavedSearch(
 		ctx context.Context,
 		newSearch gcpspanner.CreateUserSavedSearchRequest) (*string, error)
@@ -145,6 +173,32 @@ type BackendSpannerClient interface {
 		ctx context.Context,
 		id string,
 	) (*gcpspanner.SystemGlobalSavedSearchWithSortOption, error)
+	CreateUserHotlist(ctx context.Context, req gcpspanner.CreateUserHotlistRequest) (*string, error)
+	GetUserHotlist(ctx context.Context, savedSearchID string) (*gcpspanner.UserHotlist, error)
+	ReplaceUserHotlistFeatures(ctx context.Context, req gcpspanner.ReplaceUserHotlistFeaturesRequest) (int64, error)
+	ListSavedSearchCollaborators(
+		ctx context.Context, savedSearchID string, requestingUserID string) ([]gcpspanner.SavedSearchCollaborator, error)
+	PutSavedSearchCollaborator(
+		ctx context.Context, req gcpspanner.PutSavedSearchCollaboratorRequest) (*gcpspanner.SavedSearchCollaborator, error)
+	RemoveSavedSearchCollaborator(ctx context.Context, req gcpspanner.RemoveSavedSearchCollaboratorRequest) error
+	TransferSavedSearchOwnership(ctx context.Context, req gcpspanner.TransferSavedSearchOwnershipRequest) error
+	ListSavedSearchRevisions(
+		ctx context.Context, req gcpspanner.ListSavedSearchRevisionsRequest) (*gcpspanner.SavedSearchRevisionsPage, error)
+	GetSavedSearchRevision(ctx context.Context, req gcpspanner.GetSavedSearchRevisionRequest) (
+		*gcpspanner.SavedSearchRevision, error)
+	GetSavedSearchRevisionPair(ctx context.Context, req gcpspanner.GetSavedSearchRevisionPairRequest) (
+		*gcpspanner.SavedSearchRevision, *gcpspanner.SavedSearchRevision, error)
+	RevertSavedSearch(ctx context.Context, req gcpspanner.RevertSavedSearchRequest) error
+	ForkSavedSearch(ctx context.Context, req gcpspanner.ForkSavedSearchRequest) (*string, error)
+	GetSavedSearchForkSource(ctx context.Context, savedSearchID string) (*gcpspanner.SavedSearchForkSource, error)
+	ListSavedSearchDirectory(
+		ctx context.Context, req gcpspanner.ListSavedSearchDirectoryRequest) (*gcpspanner.SavedSearchDirectoryPage, error)
+	ImportUserData(
+		ctx context.Context, req gcpspanner.ImportUserDataRequest) ([]gcpspanner.ImportUserDataItemResult, error)
+	ListSavedSearchSnapshots(
+		ctx context.Context, req gcpspanner.ListSavedSearchSnapshotsRequest) (*gcpspanner.SavedSearchSnapshotsPage, error)
+	GetSavedSearchSnapshot(
+		ctx context.Context, req gcpspanner.GetSavedSearchSnapshotRequest) (*gcpspanner.SavedSearchSnapshot, error)
 	GetReferencingSavedSearchIDs(
 		ctx context.Context,
 		id string,
@@ -168,6 +222,11 @@ type BackendSpannerClient interface {
 	DeleteSavedSearchSubscription(ctx context.Context, subscriptionID string, userID string) error
 	ListSavedSearchSubscriptions(ctx context.Context, req gcpspanner.ListSavedSearchSubscriptionsRequest) (
 		[]gcpspanner.SavedSearchSubscriptionView, *string, error)
+	WatchFeature(ctx context.Context, req gcpspanner.WatchFeatureRequest) (*string, error)
+	UnwatchFeature(ctx context.Context, req gcpspanner.UnwatchFeatureRequest) error
+	GetWatchedFeature(ctx context.Context, subscriptionID string, userID string) (*gcpspanner.WatchedFeature, error)
+	ListWatchedFeatures(ctx context.Context, req gcpspanner.ListWatchedFeaturesRequest) (
+		[]gcpspanner.WatchedFeature, *string, error)
 	GetNotificationChannel(
 		ctx context.Context, channelID string, userID string) (*gcpspanner.NotificationChannel, error)
 	ListNotificationChannels(ctx context.Context, req gcpspanner.ListNotificationChannelsRequest) (
@@ -178,6 +237,14 @@ type BackendSpannerClient interface {
 	) (*string, error)
 	UpdateNotificationChannel(ctx context.Context, req gcpspanner.UpdateNotificationChannelRequest) error
 	DeleteNotificationChannel(ctx context.Context, channelID string, userID string) error
+	GetNotificationChannelDeliverySettings(ctx context.Context, channelID string, userID string) (
+		*gcpspanner.NotificationChannelDeliverySettings, error)
+	UpsertNotificationChannelDeliverySettings(
+		ctx context.Context, userID string, settings gcpspanner.NotificationChannelDeliverySettings) error
+	CreatePersonalAccessToken(ctx context.Context, req gcpspanner.CreatePersonalAccessTokenRequest) (
+		*gcpspanner.PersonalAccessToken, error)
+	ListPersonalAccessTokens(ctx context.Context, userID string) ([]gcpspanner.PersonalAccessToken, error)
+	DeletePersonalAccessToken(ctx context.Context, userID string, tokenID string) error
 	ListSavedSearchNotificationEvents(
 		ctx context.Context,
 		savedSearchID string,
@@ -271,9 +338,10 @@ func (s *Backend) SyncUserProfileInfo(ctx context.Context, userProfile backendty
 	// In the future, we can add more complex adapter logic here.
 	// For now, we just translate between the two types.
 	return s.client.SyncUserProfileInfo(ctx, gcpspanner.UserProfile{
-		UserID:       userProfile.UserID,
-		GitHubUserID: userProfile.GitHubUserID,
-		Emails:       userProfile.Emails,
+		UserID:         userProfile.UserID,
+		GitHubUserID:   userProfile.GitHubUserID,
+		GitHubUsername: userProfile.GitHubUsername,
+		Emails:         userProfile.Emails,
 	})
 }
 
@@ -303,6 +371,77 @@ func (s *Backend) ListBrowserFeatureCountMetric(
 		return nil, err
 	}
 
+	return toBackendBrowserReleaseFeatureMetricsPage(page), nil
+}
+
+// ListSavedSearchBrowserFeatureCountMetric is ListBrowserFeatureCountMetric restricted to the features
+// that match the query of the saved search.
+func (s *Backend) ListSavedSearchBrowserFeatureCountMetric(
+	ctx context.Context,
+	savedSearchID string,
+	targetBrowser string,
+	targetMobileBrowser *string,
+	startAt time.Time,
+	endAt time.Time,
+	pageSize int,
+	pageToken *string,
+) (*backend.BrowserReleaseFeatureMetricsPage, error) {
+	searchNode, err := s.savedSearchStatsNode(ctx, savedSearchID)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.client.ListBrowserFeatureCountMetricForSearch(
+		ctx,
+		searchNode,
+		targetBrowser,
+		targetMobileBrowser,
+		startAt,
+		endAt,
+		pageSize,
+		pageToken,
+	)
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrInvalidCursorFormat) {
+			return nil, errors.Join(err, backendtypes.ErrInvalidPageToken)
+		}
+
+		return nil, err
+	}
+
+	return toBackendBrowserReleaseFeatureMetricsPage(page), nil
+}
+
+// CountSavedSearchFeaturesByBaselineStatus returns the number of features that currently match the query of the
+// saved search, per baseline status. Features without a baseline status are not counted.
+func (s *Backend) CountSavedSearchFeaturesByBaselineStatus(
+	ctx context.Context,
+	savedSearchID string,
+) ([]backend.FeatureSearchFacetCount, error) {
+	searchNode, err := s.savedSearchStatsNode(ctx, savedSearchID)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.client.FeaturesSearchFacetCounts(ctx, searchNode,
+		[]gcpspanner.FeatureSearchFacet{gcpspanner.FeatureSearchFacetBaselineStatus})
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]backend.FeatureSearchFacetCount, 0, len(counts))
+	for _, count := range counts {
+		ret = append(ret, backend.FeatureSearchFacetCount{
+			Value: convertSpannerBaselineStatusToFacetValue(count.Value),
+			Count: count.Count,
+		})
+	}
+
+	return ret, nil
+}
+
+func toBackendBrowserReleaseFeatureMetricsPage(
+	page *gcpspanner.BrowserFeatureCountResultPage) *backend.BrowserReleaseFeatureMetricsPage {
 	results := make([]backend.BrowserReleaseFeatureMetric, 0, len(page.Metrics))
 	for idx := range page.Metrics {
 		results = append(results, backend.BrowserReleaseFeatureMetric{
@@ -316,7 +455,7 @@ func (s *Backend) ListBrowserFeatureCountMetric(
 			NextPageToken: page.NextPageToken,
 		},
 		Data: results,
-	}, nil
+	}
 }
 
 func (s *Backend) ListMetricsOverTimeWithAggregatedTotals(
@@ -553,6 +692,47 @@ func (s *Backend) ListBaselineStatusCounts(
 		return nil, err
 	}
 
+	return toBackendBaselineStatusMetricsPage(spannerPage), nil
+}
+
+// ListSavedSearchBaselineStatusCounts is ListBaselineStatusCounts restricted to the features
+// that match the query of the saved search.
+func (s *Backend) ListSavedSearchBaselineStatusCounts(
+	ctx context.Context,
+	savedSearchID string,
+	startAt time.Time,
+	endAt time.Time,
+	pageSize int,
+	pageToken *string,
+) (*backend.BaselineStatusMetricsPage, error) {
+	searchNode, err := s.savedSearchStatsNode(ctx, savedSearchID)
+	if err != nil {
+		return nil, err
+	}
+
+	spannerPage, err := s.client.ListBaselineStatusCountsForSearch(
+		ctx,
+		searchNode,
+		// For now, base it on the low date
+		gcpspanner.BaselineDateTypeLow,
+		startAt,
+		endAt,
+		pageSize,
+		pageToken,
+	)
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrInvalidCursorFormat) {
+			return nil, errors.Join(err, backendtypes.ErrInvalidPageToken)
+		}
+
+		return nil, err
+	}
+
+	return toBackendBaselineStatusMetricsPage(spannerPage), nil
+}
+
+func toBackendBaselineStatusMetricsPage(
+	spannerPage *gcpspanner.BaselineStatusCountResultPage) *backend.BaselineStatusMetricsPage {
 	// Convert the metric type to backend metrics
 	backendData := make([]backend.BaselineStatusMetric, 0, len(spannerPage.Metrics))
 	for _, metric := range spannerPage.Metrics {
@@ -567,7 +747,7 @@ func (s *Backend) ListBaselineStatusCounts(
 			NextPageToken: spannerPage.NextPageToken,
 		},
 		Data: backendData,
-	}, nil
+	}
 }
 
 func (s *Backend) GetNotificationChannel(ctx context.Context,
@@ -605,6 +785,85 @@ func (s *Backend) DeleteNotificationChannel(ctx context.Context, userID, channel
 	return nil
 }
 
+// GetNotificationChannelDeliverySettings returns the delivery settings of a channel owned by the user.
+// A channel without settings returns empty settings.
+func (s *Backend) GetNotificationChannelDeliverySettings(ctx context.Context,
+	userID, channelID string) (*backend.NotificationChannelDeliverySettings, error) {
+	settings, err := s.client.GetNotificationChannelDeliverySettings(ctx, channelID, userID)
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrQueryReturnedNoResults) {
+			return &backend.NotificationChannelDeliverySettings{
+				QuietHours:           nil,
+				MaxDeliveriesPerHour: nil,
+			}, nil
+		} else if errors.Is(err, gcpspanner.ErrMissingRequiredRole) {
+			return nil, errors.Join(err, backendtypes.ErrUserNotAuthorizedForAction)
+		} else if errors.Is(err, gcpspanner.ErrNotificationChannelNotFound) {
+			return nil, errors.Join(err, backendtypes.ErrEntityDoesNotExist)
+		}
+
+		return nil, err
+	}
+
+	return toBackendNotificationChannelDeliverySettings(settings), nil
+}
+
+// PutNotificationChannelDeliverySettings replaces the delivery settings of a channel owned by the user.
+func (s *Backend) PutNotificationChannelDeliverySettings(ctx context.Context,
+	userID, channelID string, settings backend.NotificationChannelDeliverySettings,
+) (*backend.NotificationChannelDeliverySettings, error) {
+	dbSettings := gcpspanner.NotificationChannelDeliverySettings{
+		ChannelID:            channelID,
+		QuietHoursStart:      nil,
+		QuietHoursEnd:        nil,
+		QuietHoursTimeZone:   nil,
+		MaxDeliveriesPerHour: nil,
+		CreatedAt:            time.Time{},
+		UpdatedAt:            time.Time{},
+	}
+	if settings.QuietHours != nil {
+		dbSettings.QuietHoursStart = &settings.QuietHours.Start
+		dbSettings.QuietHoursEnd = &settings.QuietHours.End
+		dbSettings.QuietHoursTimeZone = &settings.QuietHours.TimeZone
+	}
+	if settings.MaxDeliveriesPerHour != nil {
+		dbSettings.MaxDeliveriesPerHour = new(int64(*settings.MaxDeliveriesPerHour))
+	}
+
+	err := s.client.UpsertNotificationChannelDeliverySettings(ctx, userID, dbSettings)
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrMissingRequiredRole) {
+			return nil, errors.Join(err, backendtypes.ErrUserNotAuthorizedForAction)
+		} else if errors.Is(err, gcpspanner.ErrNotificationChannelNotFound) {
+			return nil, errors.Join(err, backendtypes.ErrEntityDoesNotExist)
+		}
+
+		return nil, err
+	}
+
+	return toBackendNotificationChannelDeliverySettings(&dbSettings), nil
+}
+
+func toBackendNotificationChannelDeliverySettings(
+	settings *gcpspanner.NotificationChannelDeliverySettings) *backend.NotificationChannelDeliverySettings {
+	ret := &backend.NotificationChannelDeliverySettings{
+		QuietHours:           nil,
+		MaxDeliveriesPerHour: nil,
+	}
+	if settings.QuietHoursStart != nil && settings.QuietHoursEnd != nil && settings.QuietHoursTimeZone != nil {
+		ret.QuietHours = &backend.QuietHours{
+			Start:    *settings.QuietHoursStart,
+			End:      *settings.QuietHoursEnd,
+			TimeZone: *settings.QuietHoursTimeZone,
+		}
+	}
+	if settings.MaxDeliveriesPerHour != nil {
+		ret.MaxDeliveriesPerHour = new(int(*settings.MaxDeliveriesPerHour))
+	}
+
+	return ret
+}
+
 func (s *Backend) ListNotificationChannels(ctx context.Context,
 	userID string, pageSize int, pageToken *string) (*backend.NotificationChannelPage, error) {
 	listReq := gcpspanner.ListNotificationChannelsRequest{
@@ -836,10 +1095,13 @@ func toBackendNotificationChannel(channel *gcpspanner.NotificationChannel) *back
 func (s *Backend) CreateUserSavedSearch(ctx context.Context, userID string,
 	savedSearch backend.SavedSearch) (*backend.SavedSearchResponse, error) {
 	output, err := s.client.CreateNewUserSavedSearch(ctx, gcpspanner.CreateUserSavedSearchRequest{
-		OwnerUserID: userID,
-		Query:       savedSearch.Query,
-		Name:        savedSearch.Name,
-		Description: savedSearch.Description,
+		OwnerUserID:     userID,
+		Query:           savedSearch.Query,
+		Name:            savedSearch.Name,
+		Description:     savedSearch.Description,
+		Tags:            convertSavedSearchTagsToGCP(savedSearch.Tags),
+		Listed:          savedSearch.Listed != nil && *savedSearch.Listed,
+		QueryParameters: convertSavedSearchQueryParametersToGCP(savedSearch.Parameters),
 	})
 	if err != nil {
 		if errors.Is(err, gcpspanner.ErrOwnerSavedSearchLimitExceeded) {
@@ -914,26 +1176,55 @@ func convertSavedSearchRoleFromGCP(role *string) *backend.UserSavedSearchPermiss
 		return nil
 	}
 
-	switch gcpspanner.SavedSearchRole(*role) {
+	backendRole := convertSavedSearchRoleToBackend(gcpspanner.SavedSearchRole(*role))
+	if backendRole == nil {
+		return nil
+	}
+
+	return &backend.UserSavedSearchPermissions{
+		Role: backendRole,
+	}
+}
+
+func convertSavedSearchRoleToBackend(role gcpspanner.SavedSearchRole) *backend.UserSavedSearchRole {
+	switch role {
 	case gcpspanner.SavedSearchOwner:
-		return &backend.UserSavedSearchPermissions{
-			Role: new(backend.SavedSearchOwner),
-		}
+		return new(backend.SavedSearchOwner)
+	case gcpspanner.SavedSearchEditor:
+		return new(backend.SavedSearchEditor)
+	case gcpspanner.SavedSearchViewer:
+		return new(backend.SavedSearchViewer)
 	}
 
 	return nil
 }
 
-func (s *Backend) DeleteUserSavedSearch(ctx context.Context, userID, savedSearchID string) error {
+func convertSavedSearchRoleToGCP(role backend.UserSavedSearchRole) gcpspanner.SavedSearchRole {
+	switch role {
+	case backend.SavedSearchOwner:
+		return gcpspanner.SavedSearchOwner
+	case backend.SavedSearchEditor:
+		return gcpspanner.SavedSearchEditor
+	case backend.SavedSearchViewer:
+		return gcpspanner.SavedSearchViewer
+	}
+
+	return ""
+}
+
+func (s *Backend) DeleteUserSavedSearch(ctx context.Context, userID, savedSearchID string, force bool) error {
 	err := s.client.DeleteUserSavedSearch(ctx, gcpspanner.DeleteUserSavedSearchRequest{
 		SavedSearchID:    savedSearchID,
 		RequestingUserID: userID,
+		Force:            force,
 	})
 	if err != nil {
 		if errors.Is(err, gcpspanner.ErrMissingRequiredRole) {
 			return errors.Join(err, backendtypes.ErrUserNotAuthorizedForAction)
 		} else if errors.Is(err, gcpspanner.ErrQueryReturnedNoResults) {
 			return errors.Join(err, backendtypes.ErrEntityDoesNotExist)
+		} else if errors.Is(err, gcpspanner.ErrSavedSearchReferenced) {
+			return errors.Join(err, backendtypes.ErrSavedSearchReferenced)
 		}
 
 		return err
@@ -953,7 +1244,13 @@ func (s *Backend) GetSavedSearch(ctx context.Context, savedSearchID string, user
 		return nil, err
 	}
 
-	return convertUserSavedSearchToSavedSearchResponse(savedSearch), nil
+	resp := convertUserSavedSearchToSavedSearchResponse(savedSearch)
+	resp.ForkedFrom, err = s.getSavedSearchForkSource(ctx, savedSearchID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
 }
 
 func (s *Backend) GetSavedSearchPublic(ctx context.Context, savedSearchID string) (
@@ -967,11 +1264,34 @@ func (s *Backend) GetSavedSearchPublic(ctx context.Context, savedSearchID string
 		return nil, err
 	}
 
-	return convertUserSavedSearchToSavedSearchResponse(&gcpspanner.UserSavedSearch{
+	resp := convertUserSavedSearchToSavedSearchResponse(&gcpspanner.UserSavedSearch{
 		SavedSearch:  *savedSearch,
 		Role:         nil,
 		IsBookmarked: nil,
-	}), nil
+	})
+	resp.ForkedFrom, err = s.getSavedSearchForkSource(ctx, savedSearchID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *Backend) getSavedSearchForkSource(
+	ctx context.Context, savedSearchID string) (*backend.SavedSearchForkSource, error) {
+	source, err := s.client.GetSavedSearchForkSource(ctx, savedSearchID)
+	if err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, nil
+	}
+
+	return &backend.SavedSearchForkSource{
+		Id:             source.SavedSearchID,
+		Name:           source.Name,
+		RevisionNumber: source.RevisionNumber,
+	}, nil
 }
 
 func buildUpdateSavedSearchRequestForGCP(savedSearchID string,
@@ -992,6 +1312,18 @@ func buildUpdateSavedSearchRequestForGCP(savedSearchID string,
 			IsSet: false,
 			Value: nil,
 		},
+		Tags: gcpspanner.OptionallySet[[]string]{
+			IsSet: false,
+			Value: nil,
+		},
+		Listed: gcpspanner.OptionallySet[bool]{
+			IsSet: false,
+			Value: false,
+		},
+		QueryParameters: gcpspanner.OptionallySet[[]gcpspanner.SavedSearchQueryParameter]{
+			IsSet: false,
+			Value: nil,
+		},
 	}
 	if slices.Contains(updateRequest.UpdateMask, backend.SavedSearchUpdateRequestMaskName) {
 		req.Name.IsSet = true
@@ -1007,6 +1339,21 @@ func buildUpdateSavedSearchRequestForGCP(savedSearchID string,
 		req.Description.Value = updateRequest.Description
 	}
 
+	if slices.Contains(updateRequest.UpdateMask, backend.SavedSearchUpdateRequestMaskTags) {
+		req.Tags.IsSet = true
+		req.Tags.Value = convertSavedSearchTagsToGCP(updateRequest.Tags)
+	}
+
+	if slices.Contains(updateRequest.UpdateMask, backend.SavedSearchUpdateRequestMaskListed) {
+		req.Listed.IsSet = true
+		req.Listed.Value = updateRequest.Listed != nil && *updateRequest.Listed
+	}
+
+	if slices.Contains(updateRequest.UpdateMask, backend.SavedSearchUpdateRequestMaskParameters) {
+		req.QueryParameters.IsSet = true
+		req.QueryParameters.Value = convertSavedSearchQueryParametersToGCP(updateRequest.Parameters)
+	}
+
 	return req
 
 }
@@ -1097,7 +1444,69 @@ func convertUserSavedSearchToSavedSearchResponse(savedSearch *gcpspanner.UserSav
 		Description:    savedSearch.Description,
 		BookmarkStatus: convertSavedSearchIsBookmarkedFromGCP(savedSearch.IsBookmarked),
 		Permissions:    convertSavedSearchRoleFromGCP(savedSearch.Role),
+		ForkedFrom:     nil,
+		Tags:           convertSavedSearchTagsFromGCP(savedSearch.Tags),
+		Listed:         new(savedSearch.Listed),
+		Parameters:     convertSavedSearchQueryParametersFromGCP(savedSearch.QueryParameters),
+	}
+}
+
+// convertSavedSearchTagsToGCP stores an empty list of tags as NULL.
+func convertSavedSearchTagsToGCP(tags *backend.SavedSearchTags) []string {
+	if tags == nil || len(*tags) == 0 {
+		return nil
+	}
+
+	return *tags
+}
+
+// convertSavedSearchTagsFromGCP omits the tags from the response when there are none.
+func convertSavedSearchTagsFromGCP(tags []string) *backend.SavedSearchTags {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return &tags
+}
+
+// convertSavedSearchQueryParametersToGCP stores an empty list of query parameters as NULL.
+func convertSavedSearchQueryParametersToGCP(
+	params *backend.SavedSearchQueryParameters) []gcpspanner.SavedSearchQueryParameter {
+	if params == nil || len(*params) == 0 {
+		return nil
+	}
+	ret := make([]gcpspanner.SavedSearchQueryParameter, 0, len(*params))
+	for _, param := range *params {
+		ret = append(ret, gcpspanner.SavedSearchQueryParameter{
+			Name:         param.Name,
+			DefaultValue: param.DefaultValue,
+		})
+	}
+
+	return ret
+}
+
+// convertSavedSearchQueryParametersFromGCP omits the query parameters from the response when there are none.
+func convertSavedSearchQueryParametersFromGCP(value spanner.NullJSON) *backend.SavedSearchQueryParameters {
+	params, err := gcpspanner.DecodeSavedSearchQueryParameters(value)
+	if err != nil {
+		// Log the error but don't fail the whole request.
+		slog.ErrorContext(context.Background(), "unable to convert saved search query parameters", "error", err)
+
+		return nil
+	}
+	if len(params) == 0 {
+		return nil
+	}
+	ret := make(backend.SavedSearchQueryParameters, 0, len(params))
+	for _, param := range params {
+		ret = append(ret, backend.SavedSearchQueryParameter{
+			Name:         param.Name,
+			DefaultValue: param.DefaultValue,
+		})
 	}
+
+	return &ret
 }
 
 func convertBaselineStatusBackendToSpanner(status backend.BaselineInfoStatus) gcpspanner.BaselineStatus {
@@ -1404,11 +1813,14 @@ func (b BrowserList) ToStringList() []string {
 // expandSavedSearches recursively expands IdentifierSavedSearch nodes by looking up the actual
 // saved search from the database and parsing its query. It limits recursion depth to prevent
 // abuse/DoS and checks seenIDs to prevent infinite cycles.
+// bindings are the values of the query variables of the saved searches referenced by node. Saved searches
+// referenced further down are expanded with the defaults of their parameters.
 func (s *Backend) expandSavedSearches(
 	ctx context.Context,
 	node *searchtypes.SearchNode,
 	depth int,
 	seenIDs map[string]struct{},
+	bindings map[string]string,
 ) (*searchtypes.SearchNode, *string, error) {
 	if node == nil {
 		return nil, nil, nil
@@ -1424,21 +1836,28 @@ func (s *Backend) expandSavedSearches(
 	if node.Term == nil ||
 		(node.Term.Identifier != searchtypes.IdentifierSavedSearch &&
 			node.Term.Identifier != searchtypes.IdentifierHotlist) {
-		return s.expandNonSavedSearchChildren(ctx, node, depth, seenIDs)
+		return s.expandNonSavedSearchChildren(ctx, node, depth, seenIDs, bindings)
 	}
 
 	savedSearchID := node.Term.Value
 	isHotlist := node.Term.Identifier == searchtypes.IdentifierHotlist
-	// 1. Cycle detection
-	if _, exists := seenIDs[savedSearchID]; exists {
+	// 1. Cycle detection. Hotlists are checked once it is known that they expand to a query.
+	_, seen := seenIDs[savedSearchID]
+	if seen && !isHotlist {
 		return nil, nil, backendtypes.ErrSavedSearchCycleDetected
 	}
 
-	// Fetch the query for the saved search. Hotlists map to system global searches.
-	query, sortTgt, err := s.fetchQueryForSavedSearch(ctx, savedSearchID, isHotlist)
+	// Fetch the query for the saved search. Hotlists map to system global searches or user hotlists.
+	query, sortTgt, err := s.fetchQueryForSavedSearch(ctx, savedSearchID, isHotlist, bindings)
+	if isHotlist && errors.Is(err, backendtypes.ErrHotlistNotFound) {
+		return s.resolveUserHotlist(ctx, node, savedSearchID)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
+	if seen {
+		return nil, nil, backendtypes.ErrSavedSearchCycleDetected
+	}
 	if sortTgt != nil {
 		injectedSortTarget = sortTgt
 	}
@@ -1460,7 +1879,7 @@ func (s *Backend) expandSavedSearches(
 	maps.Copy(newSeen, seenIDs)
 	newSeen[savedSearchID] = struct{}{}
 
-	expandedChild, sortTgt, err := s.expandSavedSearches(ctx, childAST, depth+1, newSeen)
+	expandedChild, sortTgt, err := s.expandSavedSearches(ctx, childAST, depth+1, newSeen, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1487,13 +1906,14 @@ func (s *Backend) expandNonSavedSearchChildren(
 	node *searchtypes.SearchNode,
 	depth int,
 	seenIDs map[string]struct{},
+	bindings map[string]string,
 ) (*searchtypes.SearchNode, *string, error) {
 	var injectedSortTarget *string
 	var expandedChildren []*searchtypes.SearchNode
 	if node.Children != nil {
 		expandedChildren = make([]*searchtypes.SearchNode, 0, len(node.Children))
 		for _, child := range node.Children {
-			expandedChild, sortTgt, err := s.expandSavedSearches(ctx, child, depth, seenIDs)
+			expandedChild, sortTgt, err := s.expandSavedSearches(ctx, child, depth, seenIDs, bindings)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -1535,9 +1955,35 @@ func (s *Backend) fetchSystemHotlistQuery(
 	return systemSearch.Query, injectedSortTarget, nil
 }
 
+// resolveUserHotlist keeps the hotlist term of a user hotlist as is. A user hotlist has no query to expand:
+// both its features and their order come from SavedSearchFeatureSortOrder.
+func (s *Backend) resolveUserHotlist(
+	ctx context.Context,
+	node *searchtypes.SearchNode,
+	savedSearchID string,
+) (*searchtypes.SearchNode, *string, error) {
+	_, err := s.client.GetUserHotlist(ctx, savedSearchID)
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrQueryReturnedNoResults) {
+			return nil, nil, backendtypes.ErrHotlistNotFound
+		}
+
+		return nil, nil, err
+	}
+
+	return &searchtypes.SearchNode{
+		Keyword:  node.Keyword,
+		Term:     node.Term,
+		Children: nil,
+	}, &savedSearchID, nil
+}
+
+// fetchUserSearchQuery returns the query of a user saved search with its variables bound to bindings
+// or to the defaults of its parameters.
 func (s *Backend) fetchUserSearchQuery(
 	ctx context.Context,
 	savedSearchID string,
+	bindings map[string]string,
 ) (string, error) {
 	userSearch, err := s.client.GetSavedSearch(ctx, savedSearchID)
 	if err != nil {
@@ -1548,23 +1994,31 @@ func (s *Backend) fetchUserSearchQuery(
 		return "", err
 	}
 
-	return userSearch.Query, nil
+	return gcpspanner.BindSavedSearchQuery(userSearch.Query, userSearch.QueryParameters, bindings)
 }
 
 func (s *Backend) fetchQueryForSavedSearch(
 	ctx context.Context,
 	savedSearchID string,
 	isHotlist bool,
+	bindings map[string]string,
 ) (string, *string, error) {
 	if isHotlist {
 		return s.fetchSystemHotlistQuery(ctx, savedSearchID)
 	}
-	query, err := s.fetchUserSearchQuery(ctx, savedSearchID)
+	query, err := s.fetchUserSearchQuery(ctx, savedSearchID, bindings)
 
 	return query, nil, err
 }
 
-func (s *Backend) ValidateQueryReferences(ctx context.Context, query string, updateID *string) error {
+// ValidateQueryReferences checks the saved searches referenced by the query of a saved search.
+// The variables of the query must all be declared in params. The query is checked with their default values.
+func (s *Backend) ValidateQueryReferences(
+	ctx context.Context, query string, params *backend.SavedSearchQueryParameters, updateID *string) error {
+	query, err := searchtypes.BindQueryVariables(query, backendtypes.SearchQueryParameters(params), nil)
+	if err != nil {
+		return err
+	}
 	parser := searchtypes.FeaturesSearchQueryParser{}
 	node, err := parser.Parse(query)
 	if err != nil {
@@ -1598,7 +2052,7 @@ func (s *Backend) ValidateQueryReferences(ctx context.Context, query string, upd
 	// Run expansion as a dry-run check mechanism.
 	// Starting with depth = maxAncestorDist ensures that any transitive ancestors
 	// won't exceed the global depth limit of 2.
-	expandedNode, _, err := s.expandSavedSearches(ctx, node, maxAncestorDist, map[string]struct{}{})
+	expandedNode, _, err := s.expandSavedSearches(ctx, node, maxAncestorDist, map[string]struct{}{}, nil)
 	if err != nil {
 		return err
 	}
@@ -1634,32 +2088,286 @@ func (s *Backend) getMaxAncestorDistance(ctx context.Context, id string, current
 	return maxDist, nil
 }
 
-func (s *Backend) FeaturesSearch(
-	ctx context.Context,
-	pageToken *string,
-	pageSize int,
-	searchNode *searchtypes.SearchNode,
-	sortOrder *backend.ListFeaturesParamsSort,
-	wptMetricView backend.WPTMetricView,
-	browsers []backend.BrowserPathParam,
-) (*backend.FeaturePage, error) {
+// maxSavedSearchReferenceDepth bounds the traversal of the saved search references.
+// It is one more than the maximum depth of the saved search expansion, so that a reference
+// that breaks the expansion limit is still reported.
+const maxSavedSearchReferenceDepth = 3
 
-	expandedAST, injectedSortTarget, err := s.expandSavedSearches(ctx, searchNode, 0, map[string]struct{}{})
+// savedSearchStatsNode returns the search node of the query of the saved search, read with the default
+// values of its parameters and with the saved searches that it references expanded.
+// It returns an empty search node, matching all features, if the query is empty.
+func (s *Backend) savedSearchStatsNode(ctx context.Context, savedSearchID string) (*searchtypes.SearchNode, error) {
+	query, err := s.fetchUserSearchQuery(ctx, savedSearchID, nil)
 	if err != nil {
+		if errors.Is(err, backendtypes.ErrSavedSearchNotFound) {
+			return nil, errors.Join(err, backendtypes.ErrEntityDoesNotExist)
+		}
+
 		return nil, err
 	}
+	if strings.TrimSpace(query) == "" {
+		return searchtypes.EmptySearchNode(), nil
+	}
 
-	spannerSortOrder := getFeatureSearchSortOrder(sortOrder)
-	if sortOrder == nil && injectedSortTarget != nil {
-		spannerSortOrder = gcpspanner.NewSearchIDOrderSort(true, *injectedSortTarget)
+	parser := searchtypes.FeaturesSearchQueryParser{}
+	ast, err := parser.Parse(query)
+	if err != nil {
+		return nil, &backendtypes.QueryParseError{BadQuery: query, Err: err}
 	}
 
-	page, err := s.client.FeaturesSearch(ctx, pageToken, pageSize, expandedAST,
-		spannerSortOrder, getSpannerWPTMetricView(wptMetricView),
-		BrowserList(browsers).ToStringList())
+	// The query is expanded as if it was referenced by a search, like in FeaturesSearch.
+	expandedAST, _, err := s.expandSavedSearches(ctx, ast, 1, map[string]struct{}{savedSearchID: {}}, nil)
 	if err != nil {
-		if errors.Is(err, gcpspanner.ErrInvalidCursorFormat) {
-			return nil, errors.Join(err, backendtypes.ErrInvalidPageToken)
+		return nil, err
+	}
+
+	return expandedAST, nil
+}
+
+// savedSearchReferenceNode is a saved search whose outbound references are yet to be listed.
+type savedSearchReferenceNode struct {
+	query           string
+	queryParameters spanner.NullJSON
+	depth           int
+}
+
+// GetSavedSearchReferences lists the saved searches that reference the saved search and the saved searches
+// and hotlists that its query references, transitively.
+// Referencing saved searches that are unlisted are omitted unless the user has a role on them.
+func (s *Backend) GetSavedSearchReferences(
+	ctx context.Context, savedSearchID string, userID *string) (*backend.SavedSearchReferences, error) {
+	savedSearch, err := s.client.GetSavedSearch(ctx, savedSearchID)
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrQueryReturnedNoResults) {
+			return nil, errors.Join(err, backendtypes.ErrEntityDoesNotExist)
+		}
+
+		return nil, err
+	}
+
+	inbound, err := s.listInboundSavedSearchReferences(ctx, savedSearchID, userID)
+	if err != nil {
+		return nil, err
+	}
+	outbound, err := s.listOutboundSavedSearchReferences(ctx, savedSearch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &backend.SavedSearchReferences{
+		Inbound:  inbound,
+		Outbound: outbound,
+	}, nil
+}
+
+// listInboundSavedSearchReferences walks the saved searches that reference the saved search, level by level.
+// The saved searches the user cannot see are not walked either.
+func (s *Backend) listInboundSavedSearchReferences(
+	ctx context.Context, savedSearchID string, userID *string) ([]backend.SavedSearchReference, error) {
+	references := []backend.SavedSearchReference{}
+	visited := map[string]bool{savedSearchID: true}
+	level := []string{savedSearchID}
+	for depth := 1; depth <= maxSavedSearchReferenceDepth && len(level) > 0; depth++ {
+		var next []string
+		for _, id := range level {
+			referencingIDs, err := s.client.GetReferencingSavedSearchIDs(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			slices.Sort(referencingIDs)
+			for _, referencingID := range referencingIDs {
+				if visited[referencingID] {
+					continue
+				}
+				visited[referencingID] = true
+				referencing, err := s.client.GetSavedSearch(ctx, referencingID)
+				if errors.Is(err, gcpspanner.ErrQueryReturnedNoResults) {
+					// Removed since it was listed.
+					continue
+				} else if err != nil {
+					return nil, err
+				}
+				visible, err := s.canSeeReferencingSavedSearch(ctx, referencing, userID)
+				if err != nil {
+					return nil, err
+				}
+				if !visible {
+					continue
+				}
+				references = append(references, backend.SavedSearchReference{
+					Id:     referencingID,
+					Name:   &referencing.Name,
+					Kind:   backend.SavedSearchReferenceKindSavedSearch,
+					Depth:  depth,
+					Exists: true,
+				})
+				next = append(next, referencingID)
+			}
+		}
+		level = next
+	}
+
+	return references, nil
+}
+
+// canSeeReferencingSavedSearch reports whether the user can see a saved search that references another one.
+// Unlisted user saved searches are only shared by their link, so they are only visible to the users with a role.
+func (s *Backend) canSeeReferencingSavedSearch(
+	ctx context.Context, savedSearch *gcpspanner.SavedSearch, userID *string) (bool, error) {
+	if savedSearch.Scope != gcpspanner.UserPublicScope || savedSearch.Listed {
+		return true, nil
+	}
+	if userID == nil {
+		return false, nil
+	}
+	userSavedSearch, err := s.client.GetUserSavedSearch(ctx, savedSearch.ID, userID)
+	if errors.Is(err, gcpspanner.ErrQueryReturnedNoResults) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return userSavedSearch.Role != nil, nil
+}
+
+// listOutboundSavedSearchReferences walks the saved searches and hotlists referenced by the query of
+// the saved search, level by level. Queries are read with the default values of their parameters.
+func (s *Backend) listOutboundSavedSearchReferences(
+	ctx context.Context, savedSearch *gcpspanner.SavedSearch) ([]backend.SavedSearchReference, error) {
+	references := []backend.SavedSearchReference{}
+	visited := map[string]bool{savedSearch.ID: true}
+	level := []savedSearchReferenceNode{
+		{query: savedSearch.Query, queryParameters: savedSearch.QueryParameters, depth: 1},
+	}
+	for len(level) > 0 {
+		var next []savedSearchReferenceNode
+		for _, node := range level {
+			for _, term := range s.savedSearchReferenceTerms(ctx, node) {
+				if visited[term.Value] {
+					continue
+				}
+				visited[term.Value] = true
+				reference, child, err := s.resolveSavedSearchReference(ctx, term, node.depth)
+				if err != nil {
+					return nil, err
+				}
+				references = append(references, *reference)
+				if child != nil && child.depth <= maxSavedSearchReferenceDepth {
+					next = append(next, *child)
+				}
+			}
+		}
+		level = next
+	}
+
+	return references, nil
+}
+
+// savedSearchReferenceTerms returns the saved search and hotlist terms of the query.
+// A query that cannot be read has no references. Its saved search already fails to load.
+func (s *Backend) savedSearchReferenceTerms(
+	ctx context.Context, node savedSearchReferenceNode) []*searchtypes.SearchTerm {
+	if strings.TrimSpace(node.query) == "" {
+		return nil
+	}
+	query, err := gcpspanner.BindSavedSearchQuery(node.query, node.queryParameters, nil)
+	if err != nil {
+		slog.WarnContext(ctx, "unable to bind saved search query", "query", node.query, "error", err)
+
+		return nil
+	}
+	parser := searchtypes.FeaturesSearchQueryParser{}
+	ast, err := parser.Parse(query)
+	if err != nil {
+		slog.WarnContext(ctx, "unable to parse saved search query", "query", query, "error", err)
+
+		return nil
+	}
+
+	return searchtypes.ReferenceTerms(ast)
+}
+
+// resolveSavedSearchReference looks up the target of a saved search or hotlist term.
+// It also returns the target as the next node to walk if its query can reference other saved searches.
+func (s *Backend) resolveSavedSearchReference(
+	ctx context.Context,
+	term *searchtypes.SearchTerm,
+	depth int,
+) (*backend.SavedSearchReference, *savedSearchReferenceNode, error) {
+	reference := &backend.SavedSearchReference{
+		Id:     term.Value,
+		Name:   nil,
+		Kind:   backend.SavedSearchReferenceKindSavedSearch,
+		Depth:  depth,
+		Exists: false,
+	}
+	if term.Identifier == searchtypes.IdentifierHotlist {
+		reference.Kind = backend.SavedSearchReferenceKindHotlist
+		systemSearch, err := s.client.GetSystemGlobalSavedSearch(ctx, term.Value)
+		if err == nil {
+			reference.Name = &systemSearch.Name
+			reference.Exists = true
+
+			return reference, &savedSearchReferenceNode{
+				query:           systemSearch.Query,
+				queryParameters: spanner.NullJSON{Value: nil, Valid: false},
+				depth:           depth + 1,
+			}, nil
+		} else if !errors.Is(err, gcpspanner.ErrQueryReturnedNoResults) {
+			return nil, nil, err
+		}
+	}
+
+	target, err := s.client.GetSavedSearch(ctx, term.Value)
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrQueryReturnedNoResults) {
+			return reference, nil, nil
+		}
+
+		return nil, nil, err
+	}
+	reference.Name = &target.Name
+	reference.Exists = true
+	if term.Identifier == searchtypes.IdentifierHotlist {
+		// The query of a user hotlist only refers to the hotlist itself.
+		return reference, nil, nil
+	}
+
+	return reference, &savedSearchReferenceNode{
+		query:           target.Query,
+		queryParameters: target.QueryParameters,
+		depth:           depth + 1,
+	}, nil
+}
+
+func (s *Backend) FeaturesSearch(
+	ctx context.Context,
+	pageToken *string,
+	pageSize int,
+	searchNode *searchtypes.SearchNode,
+	sortOrder *backend.ListFeaturesParamsSort,
+	wptMetricView backend.WPTMetricView,
+	browsers []backend.BrowserPathParam,
+	queryParams map[string]string,
+) (*backend.FeaturePage, error) {
+
+	expandedAST, injectedSortTarget, err := s.expandSavedSearches(
+		ctx, searchNode, 0, map[string]struct{}{}, queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	spannerSortOrder := getFeatureSearchSortOrder(sortOrder)
+	if sortOrder == nil && injectedSortTarget != nil {
+		spannerSortOrder = gcpspanner.NewSearchIDOrderSort(true, *injectedSortTarget)
+	}
+
+	page, err := s.client.FeaturesSearch(ctx, pageToken, pageSize, expandedAST,
+		spannerSortOrder, getSpannerWPTMetricView(wptMetricView),
+		BrowserList(browsers).ToStringList())
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrInvalidCursorFormat) {
+			return nil, errors.Join(err, backendtypes.ErrInvalidPageToken)
 		}
 
 		return nil, err
@@ -1672,6 +2380,7 @@ func (s *Backend) FeaturesSearch(
 	}
 
 	ret := &backend.FeaturePage{
+		Facets: nil,
 		Metadata: backend.PageMetadataWithTotal{
 			NextPageToken: page.NextPageToken,
 			Total:         page.Total,
@@ -1682,6 +2391,83 @@ func (s *Backend) FeaturesSearch(
 	return ret, nil
 }
 
+// FeaturesSearchFacets counts the features that match the search node for each requested facet.
+// The search node is expanded the same way as in FeaturesSearch.
+func (s *Backend) FeaturesSearchFacets(
+	ctx context.Context,
+	searchNode *searchtypes.SearchNode,
+	facets []backend.FeatureSearchFacet,
+	queryParams map[string]string,
+) (*backend.FeatureSearchFacets, error) {
+	expandedAST, _, err := s.expandSavedSearches(ctx, searchNode, 0, map[string]struct{}{}, queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every requested facet is present in the response, even without any counts.
+	ret := &backend.FeatureSearchFacets{
+		AvailableOn:    nil,
+		BaselineStatus: nil,
+		Group:          nil,
+	}
+	spannerFacets := make([]gcpspanner.FeatureSearchFacet, 0, len(facets))
+	for _, facet := range facets {
+		switch facet {
+		case backend.FacetBaselineStatus:
+			ret.BaselineStatus = &[]backend.FeatureSearchFacetCount{}
+			spannerFacets = append(spannerFacets, gcpspanner.FeatureSearchFacetBaselineStatus)
+		case backend.FacetAvailableOn:
+			ret.AvailableOn = &[]backend.FeatureSearchFacetCount{}
+			spannerFacets = append(spannerFacets, gcpspanner.FeatureSearchFacetAvailableOn)
+		case backend.FacetGroup:
+			ret.Group = &[]backend.FeatureSearchFacetCount{}
+			spannerFacets = append(spannerFacets, gcpspanner.FeatureSearchFacetGroup)
+		}
+	}
+
+	counts, err := s.client.FeaturesSearchFacetCounts(ctx, expandedAST, spannerFacets)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, count := range counts {
+		value := count.Value
+		var target *[]backend.FeatureSearchFacetCount
+		switch count.Facet {
+		case gcpspanner.FeatureSearchFacetBaselineStatus:
+			target = ret.BaselineStatus
+			value = convertSpannerBaselineStatusToFacetValue(count.Value)
+		case gcpspanner.FeatureSearchFacetAvailableOn:
+			target = ret.AvailableOn
+		case gcpspanner.FeatureSearchFacetGroup:
+			target = ret.Group
+		}
+		if target == nil {
+			continue
+		}
+		*target = append(*target, backend.FeatureSearchFacetCount{
+			Value: value,
+			Count: count.Count,
+		})
+	}
+
+	return ret, nil
+}
+
+// convertSpannerBaselineStatusToFacetValue returns the baseline status as it is written in search queries.
+func convertSpannerBaselineStatusToFacetValue(status string) string {
+	switch gcpspanner.BaselineStatus(status) {
+	case gcpspanner.BaselineStatusHigh:
+		return string(backend.Widely)
+	case gcpspanner.BaselineStatusLow:
+		return string(backend.Newly)
+	case gcpspanner.BaselineStatusNone:
+		return string(backend.Limited)
+	}
+
+	return status
+}
+
 // TODO: Pass in context to be used by slog.ErrorContext.
 // nolint: gocyclo // WONTFIX. Keep all the cases here so that the exhaustive
 // linter can catch a missing case.
@@ -1822,6 +2608,83 @@ func (s *Backend) GetFeature(
 		return nil, err
 	}
 
+	return s.getEvolvedFeature(ctx, featureID)
+}
+
+// BatchGetFeatures looks up several features with a single search query on their IDs.
+// Only the IDs that are not found by the search are checked for moves and splits, like GetFeature does.
+// The returned map is keyed by the requested IDs. IDs that do not exist in the database are absent from it.
+func (s *Backend) BatchGetFeatures(
+	ctx context.Context,
+	featureIDs []string,
+	wptMetricView backend.WPTMetricView,
+	browsers []backend.BrowserPathParam,
+) (map[string]*backendtypes.GetFeatureResult, error) {
+	results := make(map[string]*backendtypes.GetFeatureResult, len(featureIDs))
+	if len(featureIDs) == 0 {
+		return results, nil
+	}
+
+	idNodes := make([]*searchtypes.SearchNode, 0, len(featureIDs))
+	for _, featureID := range featureIDs {
+		idNodes = append(idNodes, &searchtypes.SearchNode{
+			Keyword: searchtypes.KeywordNone,
+			Term: &searchtypes.SearchTerm{
+				Identifier: searchtypes.IdentifierID,
+				Operator:   searchtypes.OperatorEq,
+				Value:      featureID,
+			},
+			Children: nil,
+		})
+	}
+	searchNode := &searchtypes.SearchNode{
+		Keyword: searchtypes.KeywordRoot,
+		Term:    nil,
+		Children: []*searchtypes.SearchNode{
+			{
+				Keyword:  searchtypes.KeywordOR,
+				Term:     nil,
+				Children: idNodes,
+			},
+		},
+	}
+
+	page, err := s.client.FeaturesSearch(ctx, nil, len(featureIDs), searchNode,
+		getFeatureSearchSortOrder(nil), getSpannerWPTMetricView(wptMetricView),
+		BrowserList(browsers).ToStringList())
+	if err != nil {
+		return nil, err
+	}
+
+	// The id filter is case insensitive.
+	found := make(map[string]*backend.Feature, len(page.Features))
+	for idx := range page.Features {
+		found[strings.ToLower(page.Features[idx].FeatureKey)] = s.convertFeatureResult(&page.Features[idx])
+	}
+
+	for _, featureID := range featureIDs {
+		if feature, ok := found[strings.ToLower(featureID)]; ok {
+			results[featureID] = backendtypes.NewGetFeatureResult(backendtypes.NewRegularFeatureResult(feature))
+
+			continue
+		}
+
+		result, err := s.getEvolvedFeature(ctx, featureID)
+		if err != nil {
+			if errors.Is(err, backendtypes.ErrEntityDoesNotExist) {
+				continue
+			}
+
+			return nil, err
+		}
+		results[featureID] = result
+	}
+
+	return results, nil
+}
+
+// getEvolvedFeature returns the result for a feature that has been moved or split.
+func (s *Backend) getEvolvedFeature(ctx context.Context, featureID string) (*backendtypes.GetFeatureResult, error) {
 	// If the feature is not found, check if it has been moved.
 	movedFeatureResult, err := s.client.GetMovedWebFeatureDetailsByOriginalFeatureKey(ctx, featureID)
 	if err == nil {
@@ -1856,6 +2719,116 @@ func (s *Backend) GetFeature(
 	return nil, errors.Join(err, backendtypes.ErrEntityDoesNotExist)
 }
 
+// GetBrowserReleaseChannelVersion returns the version of the oldest release of the browser that is in the given
+// release channel, such as esr.
+func (s *Backend) GetBrowserReleaseChannelVersion(
+	ctx context.Context, browser backend.SupportedBrowsers, channel string) (string, error) {
+	version, err := s.client.GetOldestBrowserReleaseVersionWithStatus(ctx, string(browser), channel)
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrQueryReturnedNoResults) {
+			return "", errors.Join(err, backendtypes.ErrEntityDoesNotExist)
+		}
+
+		return "", err
+	}
+
+	return version, nil
+}
+
+// GetFeatureTimeline returns the history of a feature, with the dated events sorted oldest first.
+// Moves and splits that were not recorded by a notification event have no date and come last.
+func (s *Backend) GetFeatureTimeline(ctx context.Context, featureID string) (*backend.FeatureTimeline, error) {
+	timeline, err := s.client.GetFeatureTimeline(ctx, featureID)
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrQueryReturnedNoResults) {
+			return nil, errors.Join(err, backendtypes.ErrEntityDoesNotExist)
+		}
+
+		return nil, err
+	}
+
+	var events []backend.FeatureTimelineEvent
+	for _, release := range timeline.BrowserReleases {
+		events = append(events, backend.FeatureTimelineEvent{
+			Type:              backend.TimelineBrowserImplementation,
+			Date:              &release.ReleaseDate,
+			Browser:           &release.BrowserName,
+			BrowserVersion:    &release.BrowserVersion,
+			OldName:           nil,
+			NewName:           nil,
+			PreviousFeatureId: nil,
+		})
+	}
+	if timeline.BaselineLowDate != nil {
+		events = append(events, newDatedTimelineEvent(backend.TimelineBaselineLow, *timeline.BaselineLowDate))
+	}
+	if timeline.BaselineHighDate != nil {
+		events = append(events, newDatedTimelineEvent(backend.TimelineBaselineHigh, *timeline.BaselineHighDate))
+	}
+	datedMoves := make(map[string]struct{}, len(timeline.Changes))
+	for _, change := range timeline.Changes {
+		switch {
+		case change.OldFeatureKey != nil:
+			event := newDatedTimelineEvent(backend.TimelineMovedFrom, change.Timestamp)
+			event.PreviousFeatureId = change.OldFeatureKey
+			events = append(events, event)
+			datedMoves[*change.OldFeatureKey] = struct{}{}
+		case change.NewName != nil:
+			event := newDatedTimelineEvent(backend.TimelineRenamed, change.Timestamp)
+			event.OldName = change.OldName
+			event.NewName = change.NewName
+			events = append(events, event)
+		}
+	}
+	slices.SortStableFunc(events, func(a, b backend.FeatureTimelineEvent) int {
+		return a.Date.Compare(*b.Date)
+	})
+
+	for _, key := range timeline.MovedFromFeatureKeys {
+		if _, found := datedMoves[key]; found {
+			continue
+		}
+		events = append(events, newUndatedReplacementTimelineEvent(backend.TimelineMovedFrom, key))
+	}
+	for _, key := range timeline.SplitFromFeatureKeys {
+		events = append(events, newUndatedReplacementTimelineEvent(backend.TimelineSplitFrom, key))
+	}
+
+	if events == nil {
+		events = []backend.FeatureTimelineEvent{}
+	}
+
+	return &backend.FeatureTimeline{
+		FeatureId: featureID,
+		Events:    events,
+	}, nil
+}
+
+func newDatedTimelineEvent(eventType backend.FeatureTimelineEventType, date time.Time) backend.FeatureTimelineEvent {
+	return backend.FeatureTimelineEvent{
+		Type:              eventType,
+		Date:              &date,
+		Browser:           nil,
+		BrowserVersion:    nil,
+		OldName:           nil,
+		NewName:           nil,
+		PreviousFeatureId: nil,
+	}
+}
+
+func newUndatedReplacementTimelineEvent(
+	eventType backend.FeatureTimelineEventType, previousFeatureID string) backend.FeatureTimelineEvent {
+	return backend.FeatureTimelineEvent{
+		Type:              eventType,
+		Date:              nil,
+		Browser:           nil,
+		BrowserVersion:    nil,
+		OldName:           nil,
+		NewName:           nil,
+		PreviousFeatureId: &previousFeatureID,
+	}
+}
+
 func (s *Backend) GetIDFromFeatureKey(
 	ctx context.Context,
 	featureID string,
@@ -2148,44 +3121,139 @@ func toBackendSubscription(sub *gcpspanner.SavedSearchSubscriptionView) *backend
 	}
 }
 
-func (s *Backend) ListGlobalSavedSearches(
-	ctx context.Context,
-	pageSize int,
-	pageToken *string,
-) (*backend.GlobalSavedSearchPage, error) {
-	page, nextPageToken, err := s.client.ListSystemGlobalSavedSearches(ctx, pageSize, pageToken)
+func (s *Backend) PutWatchedFeature(ctx context.Context,
+	userID, featureID string, req backend.WatchFeatureRequest) (*backend.WatchedFeature, error) {
+	var channelID string
+	if req.ChannelId != nil {
+		channelID = *req.ChannelId
+	}
+	watchReq := gcpspanner.WatchFeatureRequest{
+		UserID:      userID,
+		FeatureKey:  featureID,
+		ChannelID:   channelID,
+		ChannelType: nil,
+		Triggers:    backendTriggersToSpannerTriggers(req.Triggers),
+		Frequency:   toSpannerSubscriptionFrequency(req.Frequency),
+	}
+	if req.ChannelType != nil {
+		t := gcpspanner.NotificationChannelType(*req.ChannelType)
+		watchReq.ChannelType = &t
+	}
+
+	id, err := s.client.WatchFeature(ctx, watchReq)
 	if err != nil {
-		if errors.Is(err, gcpspanner.ErrInvalidCursorFormat) {
-			return nil, errors.Join(err, backendtypes.ErrInvalidPageToken)
+		if errors.Is(err, gcpspanner.ErrMissingRequiredRole) {
+			return nil, errors.Join(err, backendtypes.ErrUserNotAuthorizedForAction)
+		} else if errors.Is(err, gcpspanner.ErrSubscriptionLimitExceeded) {
+			return nil, errors.Join(err, backendtypes.ErrUserMaxSubscriptions)
+		} else if errors.Is(err, gcpspanner.ErrQueryReturnedNoResults) {
+			return nil, errors.Join(err, backendtypes.ErrEntityDoesNotExist)
 		}
 
 		return nil, err
 	}
-	var metadata *backend.PageMetadata
-	if nextPageToken != nil {
-		metadata = &backend.PageMetadata{
-			NextPageToken: nextPageToken,
-		}
-	}
-	var results []backend.GlobalSavedSearch
-	if len(page) > 0 {
-		for _, savedSearch := range page {
-			results = append(results, backend.GlobalSavedSearch{
-				Id:           &savedSearch.ID,
-				DisplayOrder: &savedSearch.DisplayOrder,
-				Name:         savedSearch.Name,
-				Description:  savedSearch.Description,
-				Query:        savedSearch.Query,
-				CreatedAt:    &savedSearch.CreatedAt,
-				UpdatedAt:    &savedSearch.UpdatedAt,
-			})
-		}
+
+	watched, err := s.client.GetWatchedFeature(ctx, *id, userID)
+	if err != nil {
+		return nil, err
 	}
 
-	return &backend.GlobalSavedSearchPage{
-		Metadata: metadata,
-		Data:     &results,
-	}, nil
+	return toBackendWatchedFeature(watched), nil
+}
+
+func (s *Backend) DeleteWatchedFeature(ctx context.Context, userID, featureID string, channelID *string) error {
+	err := s.client.UnwatchFeature(ctx, gcpspanner.UnwatchFeatureRequest{
+		UserID:     userID,
+		FeatureKey: featureID,
+		ChannelID:  channelID,
+	})
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrQueryReturnedNoResults) {
+			return errors.Join(err, backendtypes.ErrEntityDoesNotExist)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *Backend) ListWatchedFeatures(ctx context.Context,
+	userID string, pageSize int, pageToken *string) (*backend.WatchedFeaturePage, error) {
+	watched, token, err := s.client.ListWatchedFeatures(ctx, gcpspanner.ListWatchedFeaturesRequest{
+		UserID:    userID,
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	backendWatched := make([]backend.WatchedFeature, 0, len(watched))
+	for i := range watched {
+		backendWatched = append(backendWatched, *toBackendWatchedFeature(&watched[i]))
+	}
+
+	return &backend.WatchedFeaturePage{
+		Data: &backendWatched,
+		Metadata: &backend.PageMetadata{
+			NextPageToken: token,
+		},
+	}, nil
+}
+
+func toBackendWatchedFeature(watched *gcpspanner.WatchedFeature) *backend.WatchedFeature {
+	if watched == nil {
+		return nil
+	}
+
+	return &backend.WatchedFeature{
+		FeatureId:    watched.FeatureKey,
+		FeatureName:  watched.FeatureName,
+		Subscription: *toBackendSubscription(&watched.SavedSearchSubscriptionView),
+	}
+}
+
+func (s *Backend) ListGlobalSavedSearches(
+	ctx context.Context,
+	pageSize int,
+	pageToken *string,
+) (*backend.GlobalSavedSearchPage, error) {
+	page, nextPageToken, err := s.client.ListSystemGlobalSavedSearches(ctx, pageSize, pageToken)
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrInvalidCursorFormat) {
+			return nil, errors.Join(err, backendtypes.ErrInvalidPageToken)
+		}
+
+		return nil, err
+	}
+	var metadata *backend.PageMetadata
+	if nextPageToken != nil {
+		metadata = &backend.PageMetadata{
+			NextPageToken: nextPageToken,
+		}
+	}
+	var results []backend.GlobalSavedSearch
+	if len(page) > 0 {
+		for _, savedSearch := range page {
+			results = append(results, backend.GlobalSavedSearch{
+				Id:           &savedSearch.ID,
+				DisplayOrder: &savedSearch.DisplayOrder,
+				Name:         savedSearch.Name,
+				Description:  savedSearch.Description,
+				Query:        savedSearch.Query,
+				CreatedAt:    &savedSearch.CreatedAt,
+				UpdatedAt:    &savedSearch.UpdatedAt,
+				Tags:         nil,
+				Listed:       nil,
+				Parameters:   nil,
+			})
+		}
+	}
+
+	return &backend.GlobalSavedSearchPage{
+		Metadata: metadata,
+		Data:     &results,
+	}, nil
 }
 
 func (s *Backend) GetGlobalSavedSearch(
@@ -2209,5 +3277,952 @@ func (s *Backend) GetGlobalSavedSearch(
 		Query:        savedSearch.Query,
 		CreatedAt:    &savedSearch.CreatedAt,
 		UpdatedAt:    &savedSearch.UpdatedAt,
+		Tags:         nil,
+		Listed:       nil,
+		Parameters:   nil,
+	}, nil
+}
+
+// CreateHotlist creates a hotlist backed saved search owned by the user.
+func (s *Backend) CreateHotlist(ctx context.Context,
+	userID string, req backend.CreateHotlistRequest) (*backend.Hotlist, error) {
+	var featureKeys []string
+	if req.FeatureIds != nil {
+		featureKeys = *req.FeatureIds
+	}
+	id, err := s.client.CreateUserHotlist(ctx, gcpspanner.CreateUserHotlistRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		OwnerUserID: userID,
+		FeatureKeys: featureKeys,
+	})
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrOwnerSavedSearchLimitExceeded) {
+			return nil, errors.Join(err, backendtypes.ErrUserMaxSavedSearches)
+		}
+
+		return nil, convertUserHotlistError(err)
+	}
+
+	return s.GetHotlist(ctx, *id)
+}
+
+// GetHotlist returns the features of a hotlist backed saved search.
+func (s *Backend) GetHotlist(ctx context.Context, savedSearchID string) (*backend.Hotlist, error) {
+	hotlist, err := s.client.GetUserHotlist(ctx, savedSearchID)
+	if err != nil {
+		return nil, convertUserHotlistError(err)
+	}
+
+	return &backend.Hotlist{
+		Id:         hotlist.SavedSearchID,
+		Version:    hotlist.OrderVersion,
+		FeatureIds: hotlist.FeatureKeys,
 	}, nil
 }
+
+// ReorderHotlist replaces the order of the features of a hotlist.
+// The requested features must be exactly the current features of the hotlist.
+func (s *Backend) ReorderHotlist(ctx context.Context,
+	userID, savedSearchID string, req backend.ReorderHotlistRequest) (*backend.Hotlist, error) {
+	return s.updateHotlistFeatures(ctx, userID, savedSearchID, req.Version,
+		func(current []string) ([]string, error) {
+			if len(current) != len(req.FeatureIds) {
+				return nil, backendtypes.ErrInvalidHotlistFeatures
+			}
+			remaining := make(map[string]struct{}, len(current))
+			for _, key := range current {
+				remaining[key] = struct{}{}
+			}
+			for _, key := range req.FeatureIds {
+				if _, found := remaining[key]; !found {
+					return nil, backendtypes.ErrInvalidHotlistFeatures
+				}
+				delete(remaining, key)
+			}
+
+			return req.FeatureIds, nil
+		})
+}
+
+// PutHotlistFeature adds a feature to a hotlist or moves it within the hotlist.
+func (s *Backend) PutHotlistFeature(ctx context.Context,
+	userID, savedSearchID, featureID string, req backend.PutHotlistFeatureRequest) (*backend.Hotlist, error) {
+	return s.updateHotlistFeatures(ctx, userID, savedSearchID, req.Version,
+		func(current []string) ([]string, error) {
+			idx := slices.Index(current, featureID)
+			if req.Position == nil {
+				if idx >= 0 {
+					return current, nil
+				}
+
+				return append(current, featureID), nil
+			}
+			if idx >= 0 {
+				current = slices.Delete(current, idx, idx+1)
+			}
+			position := min(*req.Position, len(current))
+
+			return slices.Insert(current, position, featureID), nil
+		})
+}
+
+// RemoveHotlistFeature removes a feature from a hotlist.
+func (s *Backend) RemoveHotlistFeature(ctx context.Context,
+	userID, savedSearchID, featureID string, version int64) (*backend.Hotlist, error) {
+	return s.updateHotlistFeatures(ctx, userID, savedSearchID, version,
+		func(current []string) ([]string, error) {
+			idx := slices.Index(current, featureID)
+			if idx < 0 {
+				return nil, backendtypes.ErrEntityDoesNotExist
+			}
+
+			return slices.Delete(current, idx, idx+1), nil
+		})
+}
+
+// updateHotlistFeatures computes the new features of a hotlist from its current features and
+// writes them if the hotlist is still at the given version.
+func (s *Backend) updateHotlistFeatures(ctx context.Context,
+	userID, savedSearchID string, version int64,
+	update func(current []string) ([]string, error)) (*backend.Hotlist, error) {
+	hotlist, err := s.client.GetUserHotlist(ctx, savedSearchID)
+	if err != nil {
+		return nil, convertUserHotlistError(err)
+	}
+	if hotlist.OrderVersion != version {
+		return nil, backendtypes.ErrHotlistVersionConflict
+	}
+
+	featureKeys, err := update(slices.Clone(hotlist.FeatureKeys))
+	if err != nil {
+		return nil, err
+	}
+
+	newVersion, err := s.client.ReplaceUserHotlistFeatures(ctx, gcpspanner.ReplaceUserHotlistFeaturesRequest{
+		SavedSearchID:        savedSearchID,
+		UserID:               userID,
+		ExpectedOrderVersion: version,
+		FeatureKeys:          featureKeys,
+	})
+	if err != nil {
+		return nil, convertUserHotlistError(err)
+	}
+
+	return &backend.Hotlist{
+		Id:         savedSearchID,
+		Version:    newVersion,
+		FeatureIds: featureKeys,
+	}, nil
+}
+
+func convertUserHotlistError(err error) error {
+	switch {
+	case errors.Is(err, gcpspanner.ErrQueryReturnedNoResults):
+		return errors.Join(err, backendtypes.ErrEntityDoesNotExist)
+	case errors.Is(err, gcpspanner.ErrMissingRequiredRole):
+		return errors.Join(err, backendtypes.ErrUserNotAuthorizedForAction)
+	case errors.Is(err, gcpspanner.ErrHotlistVersionMismatch):
+		return errors.Join(err, backendtypes.ErrHotlistVersionConflict)
+	case errors.Is(err, gcpspanner.ErrUnknownHotlistFeature),
+		errors.Is(err, gcpspanner.ErrDuplicateHotlistFeature),
+		errors.Is(err, gcpspanner.ErrUserHotlistFeatureLimitExceeded):
+		return errors.Join(err, backendtypes.ErrInvalidHotlistFeatures)
+	}
+
+	return err
+}
+
+func (s *Backend) ListSavedSearchCollaborators(
+	ctx context.Context, userID, savedSearchID string) (*backend.SavedSearchCollaboratorList, error) {
+	collaborators, err := s.client.ListSavedSearchCollaborators(ctx, savedSearchID, userID)
+	if err != nil {
+		return nil, convertSavedSearchCollaboratorError(err)
+	}
+
+	data := make([]backend.SavedSearchCollaborator, 0, len(collaborators))
+	for _, collaborator := range collaborators {
+		role := convertSavedSearchRoleToBackend(collaborator.Role)
+		if role == nil {
+			slog.WarnContext(ctx, "skipping collaborator with unknown role",
+				"role", collaborator.Role, "savedSearchID", savedSearchID)
+
+			continue
+		}
+		data = append(data, backend.SavedSearchCollaborator{
+			GithubUsername: collaborator.GitHubUsername,
+			Role:           *role,
+		})
+	}
+
+	return &backend.SavedSearchCollaboratorList{Data: data}, nil
+}
+
+func (s *Backend) PutSavedSearchCollaborator(
+	ctx context.Context,
+	userID, savedSearchID, githubUsername string,
+	req backend.PutSavedSearchCollaboratorRequest,
+) (*backend.SavedSearchCollaborator, error) {
+	collaborator, err := s.client.PutSavedSearchCollaborator(ctx, gcpspanner.PutSavedSearchCollaboratorRequest{
+		SavedSearchID:    savedSearchID,
+		RequestingUserID: userID,
+		GitHubUsername:   githubUsername,
+		Role:             convertSavedSearchRoleToGCP(req.Role),
+	})
+	if err != nil {
+		return nil, convertSavedSearchCollaboratorError(err)
+	}
+
+	return &backend.SavedSearchCollaborator{
+		GithubUsername: collaborator.GitHubUsername,
+		Role:           req.Role,
+	}, nil
+}
+
+func (s *Backend) RemoveSavedSearchCollaborator(
+	ctx context.Context, userID, savedSearchID, githubUsername string) error {
+	err := s.client.RemoveSavedSearchCollaborator(ctx, gcpspanner.RemoveSavedSearchCollaboratorRequest{
+		SavedSearchID:    savedSearchID,
+		RequestingUserID: userID,
+		GitHubUsername:   githubUsername,
+	})
+	if err != nil {
+		return convertSavedSearchCollaboratorError(err)
+	}
+
+	return nil
+}
+
+func (s *Backend) TransferSavedSearchOwnership(
+	ctx context.Context, userID, savedSearchID string, req backend.TransferSavedSearchOwnershipRequest) error {
+	err := s.client.TransferSavedSearchOwnership(ctx, gcpspanner.TransferSavedSearchOwnershipRequest{
+		SavedSearchID:          savedSearchID,
+		RequestingUserID:       userID,
+		NewOwnerGitHubUsername: req.GithubUsername,
+	})
+	if err != nil {
+		return convertSavedSearchCollaboratorError(err)
+	}
+
+	return nil
+}
+
+func convertSavedSearchCollaboratorError(err error) error {
+	switch {
+	case errors.Is(err, gcpspanner.ErrQueryReturnedNoResults),
+		errors.Is(err, gcpspanner.ErrUnknownGitHubUsername):
+		return errors.Join(err, backendtypes.ErrEntityDoesNotExist)
+	case errors.Is(err, gcpspanner.ErrMissingRequiredRole):
+		return errors.Join(err, backendtypes.ErrUserNotAuthorizedForAction)
+	case errors.Is(err, gcpspanner.ErrCannotChangeOwnerRole),
+		errors.Is(err, gcpspanner.ErrInvalidCollaboratorRole):
+		return errors.Join(err, backendtypes.ErrInvalidCollaboratorChange)
+	case errors.Is(err, gcpspanner.ErrSavedSearchCollaboratorLimitExceeded):
+		return errors.Join(err, backendtypes.ErrSavedSearchMaxCollaborators)
+	case errors.Is(err, gcpspanner.ErrOwnerSavedSearchLimitExceeded):
+		return errors.Join(err, backendtypes.ErrUserMaxSavedSearches)
+	}
+
+	return err
+}
+
+func (s *Backend) ListSavedSearchRevisions(
+	ctx context.Context,
+	userID, savedSearchID string,
+	pageSize int,
+	pageToken *string,
+) (*backend.SavedSearchRevisionPage, error) {
+	page, err := s.client.ListSavedSearchRevisions(ctx, gcpspanner.ListSavedSearchRevisionsRequest{
+		SavedSearchID:    savedSearchID,
+		RequestingUserID: userID,
+		PageSize:         pageSize,
+		PageToken:        pageToken,
+	})
+	if err != nil {
+		return nil, convertSavedSearchRevisionError(err)
+	}
+
+	var metadata *backend.PageMetadata
+	if page.NextPageToken != nil {
+		metadata = &backend.PageMetadata{
+			NextPageToken: page.NextPageToken,
+		}
+	}
+	var results *[]backend.SavedSearchRevision
+	if len(page.Revisions) > 0 {
+		data := make([]backend.SavedSearchRevision, 0, len(page.Revisions))
+		for _, revision := range page.Revisions {
+			data = append(data, backend.SavedSearchRevision{
+				RevisionNumber:       revision.RevisionNumber,
+				Name:                 revision.Name,
+				Description:          revision.Description,
+				Query:                revision.Query,
+				AuthorGithubUsername: revision.AuthorGitHubUsername,
+				RevertedFromRevision: revision.RevertedFromRevision,
+				CreatedAt:            revision.CreatedAt,
+			})
+		}
+		results = &data
+	}
+
+	return &backend.SavedSearchRevisionPage{
+		Metadata: metadata,
+		Data:     results,
+	}, nil
+}
+
+func (s *Backend) GetSavedSearchRevisionDiff(
+	ctx context.Context,
+	userID, savedSearchID string,
+	revisionNumber int64,
+	baseRevisionNumber *int64,
+) (*backend.SavedSearchRevisionDiff, error) {
+	base, revision, err := s.client.GetSavedSearchRevisionPair(ctx, gcpspanner.GetSavedSearchRevisionPairRequest{
+		SavedSearchID:      savedSearchID,
+		RequestingUserID:   userID,
+		RevisionNumber:     revisionNumber,
+		BaseRevisionNumber: baseRevisionNumber,
+	})
+	if err != nil {
+		return nil, convertSavedSearchRevisionError(err)
+	}
+
+	diff := &backend.SavedSearchRevisionDiff{
+		BaseRevision: nil,
+		Revision:     revision.RevisionNumber,
+		Name:         nil,
+		Query:        nil,
+		Description:  nil,
+	}
+	// Comparing the first revision with nothing shows every field that is set as new.
+	var oldName, oldQuery, oldDescription *string
+	if base != nil {
+		diff.BaseRevision = &base.RevisionNumber
+		oldName, oldQuery, oldDescription = &base.Name, &base.Query, base.Description
+	}
+	diff.Name = diffSavedSearchRevisionField(oldName, &revision.Name)
+	diff.Query = diffSavedSearchRevisionField(oldQuery, &revision.Query)
+	diff.Description = diffSavedSearchRevisionField(oldDescription, revision.Description)
+
+	return diff, nil
+}
+
+// diffSavedSearchRevisionField returns nil if both values are the same.
+func diffSavedSearchRevisionField(oldValue, newValue *string) *backend.SavedSearchRevisionFieldChange {
+	if oldValue == nil && newValue == nil {
+		return nil
+	}
+	if oldValue != nil && newValue != nil && *oldValue == *newValue {
+		return nil
+	}
+
+	return &backend.SavedSearchRevisionFieldChange{
+		OldValue: oldValue,
+		NewValue: newValue,
+	}
+}
+
+// RevertSavedSearch restores the saved search from the revision.
+// The references of the query of the revision are checked the same way as for an update, so the revert
+// returns the errors of ValidateQueryReferences.
+func (s *Backend) RevertSavedSearch(
+	ctx context.Context, userID, savedSearchID string, revisionNumber int64) (*backend.SavedSearchResponse, error) {
+	revision, err := s.client.GetSavedSearchRevision(ctx, gcpspanner.GetSavedSearchRevisionRequest{
+		SavedSearchID:    savedSearchID,
+		RequestingUserID: userID,
+		RevisionNumber:   revisionNumber,
+	})
+	if err != nil {
+		return nil, convertSavedSearchRevisionError(err)
+	}
+	err = s.ValidateQueryReferences(ctx, revision.Query,
+		convertSavedSearchQueryParametersFromGCP(revision.QueryParameters), &savedSearchID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.client.RevertSavedSearch(ctx, gcpspanner.RevertSavedSearchRequest{
+		SavedSearchID:    savedSearchID,
+		RequestingUserID: userID,
+		RevisionNumber:   revisionNumber,
+	})
+	if err != nil {
+		return nil, convertSavedSearchRevisionError(err)
+	}
+
+	savedSearch, err := s.client.GetUserSavedSearch(ctx, savedSearchID, &userID)
+	if err != nil {
+		return nil, convertSavedSearchRevisionError(err)
+	}
+
+	return convertUserSavedSearchToSavedSearchResponse(savedSearch), nil
+}
+
+func convertSavedSearchRevisionError(err error) error {
+	switch {
+	case errors.Is(err, gcpspanner.ErrQueryReturnedNoResults):
+		return errors.Join(err, backendtypes.ErrEntityDoesNotExist)
+	case errors.Is(err, gcpspanner.ErrMissingRequiredRole):
+		return errors.Join(err, backendtypes.ErrUserNotAuthorizedForAction)
+	case errors.Is(err, gcpspanner.ErrInvalidCursorFormat):
+		return errors.Join(err, backendtypes.ErrInvalidPageToken)
+	}
+
+	return err
+}
+
+func (s *Backend) ListSavedSearchSnapshots(
+	ctx context.Context,
+	userID, savedSearchID string,
+	snapshotType backend.SubscriptionFrequency,
+	at *time.Time,
+	pageSize int,
+	pageToken *string,
+) (*backend.SavedSearchSnapshotPage, error) {
+	page, err := s.client.ListSavedSearchSnapshots(ctx, gcpspanner.ListSavedSearchSnapshotsRequest{
+		SavedSearchID:    savedSearchID,
+		RequestingUserID: userID,
+		SnapshotType:     toSpannerSubscriptionFrequency(snapshotType),
+		At:               at,
+		PageSize:         pageSize,
+		PageToken:        pageToken,
+	})
+	if err != nil {
+		return nil, convertSavedSearchRevisionError(err)
+	}
+
+	var metadata *backend.PageMetadata
+	if page.NextPageToken != nil {
+		metadata = &backend.PageMetadata{
+			NextPageToken: page.NextPageToken,
+		}
+	}
+	var results *[]backend.SavedSearchSnapshotMetadata
+	if len(page.Snapshots) > 0 {
+		data := make([]backend.SavedSearchSnapshotMetadata, 0, len(page.Snapshots))
+		for _, snapshot := range page.Snapshots {
+			var createdAt time.Time
+			if snapshot.Timestamp != nil {
+				createdAt = *snapshot.Timestamp
+			}
+			data = append(data, backend.SavedSearchSnapshotMetadata{
+				Id:           snapshot.ID,
+				SnapshotType: toBackendSubscriptionFrequency(snapshot.SnapshotType),
+				CreatedAt:    createdAt,
+				Reasons:      toBackendSavedSearchSnapshotReasons(snapshot.Reasons),
+			})
+		}
+		results = &data
+	}
+
+	return &backend.SavedSearchSnapshotPage{
+		Metadata: metadata,
+		Data:     results,
+	}, nil
+}
+
+// toBackendSavedSearchSnapshotReasons drops reasons the API does not know about.
+func toBackendSavedSearchSnapshotReasons(reasons []string) *[]backend.SavedSearchSnapshotReason {
+	if len(reasons) == 0 {
+		return nil
+	}
+	ret := make([]backend.SavedSearchSnapshotReason, 0, len(reasons))
+	for _, reason := range reasons {
+		switch reason {
+		case workertypes.ReasonQueryChanged:
+			ret = append(ret, backend.SavedSearchSnapshotReasonQueryChanged)
+		case workertypes.ReasonDataUpdated:
+			ret = append(ret, backend.SavedSearchSnapshotReasonDataUpdated)
+		default:
+			slog.Warn("unknown saved search snapshot reason. skipping", "value", reason)
+		}
+	}
+
+	return &ret
+}
+
+// GetSavedSearchSnapshotRef returns where a snapshot of the saved search is stored.
+// The snapshot ID is either the ID of a notification event or gcpspanner.LatestSavedSearchSnapshotID.
+// When at is set, the latest snapshot stored at or before that time is returned.
+func (s *Backend) GetSavedSearchSnapshotRef(
+	ctx context.Context,
+	userID, savedSearchID, snapshotID string,
+	snapshotType backend.SubscriptionFrequency,
+	at *time.Time,
+) (*backendtypes.SavedSearchSnapshotRef, error) {
+	snapshot, err := s.client.GetSavedSearchSnapshot(ctx, gcpspanner.GetSavedSearchSnapshotRequest{
+		SavedSearchID:    savedSearchID,
+		RequestingUserID: userID,
+		SnapshotType:     toSpannerSubscriptionFrequency(snapshotType),
+		SnapshotID:       snapshotID,
+		At:               at,
+	})
+	if err != nil {
+		return nil, convertSavedSearchRevisionError(err)
+	}
+
+	return &backendtypes.SavedSearchSnapshotRef{
+		ID:           snapshot.ID,
+		SnapshotType: toBackendSubscriptionFrequency(snapshot.SnapshotType),
+		BlobPath:     snapshot.BlobPath,
+	}, nil
+}
+
+func (s *Backend) ForkSavedSearch(
+	ctx context.Context,
+	userID, savedSearchID string,
+	req *backend.ForkSavedSearchRequest,
+) (*backend.SavedSearchResponse, error) {
+	forkReq := gcpspanner.ForkSavedSearchRequest{
+		SourceSavedSearchID: savedSearchID,
+		OwnerUserID:         userID,
+		Name:                nil,
+		Description:         nil,
+	}
+	if req != nil {
+		forkReq.Name = req.Name
+		forkReq.Description = req.Description
+	}
+	newID, err := s.client.ForkSavedSearch(ctx, forkReq)
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrOwnerSavedSearchLimitExceeded) {
+			return nil, errors.Join(err, backendtypes.ErrUserMaxSavedSearches)
+		} else if errors.Is(err, gcpspanner.ErrQueryReturnedNoResults) {
+			return nil, errors.Join(err, backendtypes.ErrEntityDoesNotExist)
+		}
+
+		return nil, err
+	}
+
+	return s.GetSavedSearch(ctx, *newID, &userID)
+}
+
+func (s *Backend) ListSavedSearchDirectory(
+	ctx context.Context,
+	query, tag *string,
+	sort backend.ListSavedSearchDirectoryParamsSort,
+	pageSize int,
+	pageToken *string,
+) (*backend.SavedSearchDirectoryPage, error) {
+	page, err := s.client.ListSavedSearchDirectory(ctx, gcpspanner.ListSavedSearchDirectoryRequest{
+		Query:     query,
+		Tag:       tag,
+		Sort:      convertSavedSearchDirectorySortToGCP(sort),
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrInvalidCursorFormat) {
+			return nil, errors.Join(err, backendtypes.ErrInvalidPageToken)
+		}
+
+		return nil, err
+	}
+
+	var metadata *backend.PageMetadata
+	if page.NextPageToken != nil {
+		metadata = &backend.PageMetadata{
+			NextPageToken: page.NextPageToken,
+		}
+	}
+	var results *[]backend.SavedSearchDirectoryEntry
+	if len(page.Entries) > 0 {
+		data := make([]backend.SavedSearchDirectoryEntry, 0, len(page.Entries))
+		for _, entry := range page.Entries {
+			data = append(data, backend.SavedSearchDirectoryEntry{
+				Id:              entry.ID,
+				Name:            entry.Name,
+				Description:     entry.Description,
+				Query:           entry.Query,
+				Tags:            convertSavedSearchTagsFromGCP(entry.Tags),
+				Listed:          new(entry.Listed),
+				Parameters:      convertSavedSearchQueryParametersFromGCP(entry.QueryParameters),
+				CreatedAt:       entry.CreatedAt,
+				UpdatedAt:       entry.UpdatedAt,
+				BookmarkCount:   entry.BookmarkCount,
+				SubscriberCount: entry.SubscriberCount,
+			})
+		}
+		results = &data
+	}
+
+	return &backend.SavedSearchDirectoryPage{
+		Metadata: metadata,
+		Data:     results,
+	}, nil
+}
+
+func convertSavedSearchDirectorySortToGCP(
+	sort backend.ListSavedSearchDirectoryParamsSort) gcpspanner.SavedSearchDirectorySort {
+	switch sort {
+	case backend.SavedSearchDirectorySortRecent:
+		return gcpspanner.SavedSearchDirectorySortRecent
+	case backend.SavedSearchDirectorySortPopular:
+		return gcpspanner.SavedSearchDirectorySortPopular
+	}
+
+	return gcpspanner.SavedSearchDirectorySortPopular
+}
+
+// userDataExportPageSize is the page size used to read every resource of the user during an export.
+const userDataExportPageSize = 100
+
+func (s *Backend) ExportUserData(ctx context.Context, userID string) (*backend.UserDataExport, error) {
+	ret := &backend.UserDataExport{
+		Version:              backendtypes.UserDataExportVersion,
+		ExportedAt:           time.Now().UTC(),
+		SavedSearches:        []backend.UserDataSavedSearch{},
+		Bookmarks:            []backend.UserDataBookmark{},
+		NotificationChannels: []backend.UserDataNotificationChannel{},
+		Subscriptions:        []backend.UserDataSubscription{},
+	}
+	if err := s.exportUserSavedSearches(ctx, userID, ret); err != nil {
+		return nil, err
+	}
+	if err := s.exportUserNotificationChannels(ctx, userID, ret); err != nil {
+		return nil, err
+	}
+	if err := s.exportUserSubscriptions(ctx, userID, ret); err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (s *Backend) exportUserSavedSearches(ctx context.Context, userID string, ret *backend.UserDataExport) error {
+	var pageToken *string
+	for {
+		page, err := s.client.ListUserSavedSearches(ctx, userID, userDataExportPageSize, pageToken)
+		if err != nil {
+			return err
+		}
+		for _, search := range page.Searches {
+			if search.Role == nil || *search.Role != string(gcpspanner.SavedSearchOwner) {
+				ret.Bookmarks = append(ret.Bookmarks, backend.UserDataBookmark{SavedSearchId: search.ID})
+
+				continue
+			}
+			exported := backend.UserDataSavedSearch{
+				Id:                search.ID,
+				Name:              search.Name,
+				Description:       search.Description,
+				Query:             search.Query,
+				Tags:              convertSavedSearchTagsFromGCP(search.Tags),
+				Listed:            search.Listed,
+				Parameters:        convertSavedSearchQueryParametersFromGCP(search.QueryParameters),
+				HotlistFeatureIds: nil,
+			}
+			if search.Query == gcpspanner.UserHotlistQuery(search.ID) {
+				hotlist, err := s.client.GetUserHotlist(ctx, search.ID)
+				if err != nil {
+					return err
+				}
+				exported.HotlistFeatureIds = &hotlist.FeatureKeys
+			}
+			ret.SavedSearches = append(ret.SavedSearches, exported)
+		}
+		if page.NextPageToken == nil {
+			return nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+func (s *Backend) exportUserNotificationChannels(
+	ctx context.Context, userID string, ret *backend.UserDataExport) error {
+	var pageToken *string
+	for {
+		channels, nextPageToken, err := s.client.ListNotificationChannels(ctx, gcpspanner.ListNotificationChannelsRequest{
+			UserID:    userID,
+			PageSize:  userDataExportPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		for _, channel := range channels {
+			exported := backend.UserDataNotificationChannel{
+				Id:           channel.ID,
+				Name:         channel.Name,
+				Type:         "",
+				EmailAddress: nil,
+				// The webhook URL is a secret and is never exported.
+				WebhookUrl: nil,
+			}
+			switch channel.Type {
+			case gcpspanner.NotificationChannelTypeEmail:
+				exported.Type = backend.UserDataNotificationChannelTypeEmail
+				if channel.EmailConfig != nil {
+					exported.EmailAddress = new(openapi_types.Email(channel.EmailConfig.Address))
+				}
+			case gcpspanner.NotificationChannelTypeWebhook:
+				exported.Type = backend.UserDataNotificationChannelTypeWebhook
+			case gcpspanner.NotificationChannelTypeRSS:
+				exported.Type = backend.UserDataNotificationChannelTypeRSS
+			}
+			ret.NotificationChannels = append(ret.NotificationChannels, exported)
+		}
+		if nextPageToken == nil {
+			return nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+func (s *Backend) exportUserSubscriptions(ctx context.Context, userID string, ret *backend.UserDataExport) error {
+	// RSS channels are not listed with the other channels. Export the ones that have subscriptions.
+	exportedChannels := make(map[string]bool, len(ret.NotificationChannels))
+	for _, channel := range ret.NotificationChannels {
+		exportedChannels[channel.Id] = true
+	}
+	var pageToken *string
+	for {
+		subs, nextPageToken, err := s.client.ListSavedSearchSubscriptions(ctx,
+			gcpspanner.ListSavedSearchSubscriptionsRequest{
+				UserID:    userID,
+				PageSize:  userDataExportPageSize,
+				PageToken: pageToken,
+			})
+		if err != nil {
+			return err
+		}
+		for _, sub := range subs {
+			if !exportedChannels[sub.ChannelID] &&
+				gcpspanner.NotificationChannelType(sub.ChannelType) == gcpspanner.NotificationChannelTypeRSS {
+				ret.NotificationChannels = append(ret.NotificationChannels, backend.UserDataNotificationChannel{
+					Id:           sub.ChannelID,
+					Name:         "RSS",
+					Type:         backend.UserDataNotificationChannelTypeRSS,
+					EmailAddress: nil,
+					WebhookUrl:   nil,
+				})
+				exportedChannels[sub.ChannelID] = true
+			}
+			triggers := make([]backend.SubscriptionTriggerWritable, 0, len(sub.Triggers))
+			for _, trigger := range sub.Triggers {
+				// Deprecated triggers cannot be imported.
+				if t, ok := toBackendSubscriptionTriggerWritable(trigger); ok {
+					triggers = append(triggers, t)
+				}
+			}
+			ret.Subscriptions = append(ret.Subscriptions, backend.UserDataSubscription{
+				Id:            sub.ID,
+				SavedSearchId: sub.SavedSearchID,
+				ChannelId:     sub.ChannelID,
+				Triggers:      triggers,
+				Frequency:     toBackendSubscriptionFrequency(sub.Frequency),
+			})
+		}
+		if nextPageToken == nil {
+			return nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
+func toBackendSubscriptionTriggerWritable(
+	trigger gcpspanner.SubscriptionTrigger) (backend.SubscriptionTriggerWritable, bool) {
+	switch trigger {
+	case gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete:
+		return backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete, true
+	case gcpspanner.SubscriptionTriggerFeatureBaselinePromoteToNewly:
+		return backend.SubscriptionTriggerFeatureBaselineToNewly, true
+	case gcpspanner.SubscriptionTriggerFeatureBaselinePromoteToWidely:
+		return backend.SubscriptionTriggerFeatureBaselineToWidely, true
+	case gcpspanner.SubscriptionTriggerFeatureBaselineRegressionToLimited:
+		return backend.SubscriptionTriggerFeatureBaselineRegressionToLimited, true
+	case gcpspanner.SubscriptionTriggerUnknown:
+		break
+	}
+
+	return "", false
+}
+
+func (s *Backend) ImportUserData(
+	ctx context.Context, userID string, data backend.UserDataExport) (*backend.UserDataImportResult, error) {
+	req := gcpspanner.ImportUserDataRequest{
+		UserID:                   userID,
+		SavedSearches:            make([]gcpspanner.ImportSavedSearch, 0, len(data.SavedSearches)),
+		BookmarkedSavedSearchIDs: make([]string, 0, len(data.Bookmarks)),
+		NotificationChannels:     make([]gcpspanner.ImportNotificationChannel, 0, len(data.NotificationChannels)),
+		Subscriptions:            make([]gcpspanner.ImportSavedSearchSubscription, 0, len(data.Subscriptions)),
+	}
+	for _, search := range data.SavedSearches {
+		imported := gcpspanner.ImportSavedSearch{
+			SourceID:           search.Id,
+			Name:               search.Name,
+			Description:        search.Description,
+			Query:              search.Query,
+			Tags:               convertSavedSearchTagsToGCP(search.Tags),
+			Listed:             search.Listed,
+			QueryParameters:    convertSavedSearchQueryParametersToGCP(search.Parameters),
+			IsHotlist:          search.HotlistFeatureIds != nil,
+			HotlistFeatureKeys: nil,
+		}
+		if search.HotlistFeatureIds != nil {
+			imported.HotlistFeatureKeys = *search.HotlistFeatureIds
+		}
+		req.SavedSearches = append(req.SavedSearches, imported)
+	}
+	for _, bookmark := range data.Bookmarks {
+		req.BookmarkedSavedSearchIDs = append(req.BookmarkedSavedSearchIDs, bookmark.SavedSearchId)
+	}
+	for _, channel := range data.NotificationChannels {
+		imported := gcpspanner.ImportNotificationChannel{
+			SourceID:     channel.Id,
+			Name:         channel.Name,
+			Type:         gcpspanner.NotificationChannelType(channel.Type),
+			EmailAddress: "",
+			WebhookURL:   "",
+		}
+		if channel.EmailAddress != nil {
+			imported.EmailAddress = string(*channel.EmailAddress)
+		}
+		if channel.WebhookUrl != nil {
+			imported.WebhookURL = *channel.WebhookUrl
+		}
+		req.NotificationChannels = append(req.NotificationChannels, imported)
+	}
+	for _, sub := range data.Subscriptions {
+		req.Subscriptions = append(req.Subscriptions, gcpspanner.ImportSavedSearchSubscription{
+			SourceID:      sub.Id,
+			SavedSearchID: sub.SavedSearchId,
+			ChannelID:     sub.ChannelId,
+			Triggers:      backendTriggersToSpannerTriggers(sub.Triggers),
+			Frequency:     toSpannerSubscriptionFrequency(sub.Frequency),
+		})
+	}
+
+	results, err := s.client.ImportUserData(ctx, req)
+	if err != nil {
+		return nil, convertImportUserDataError(err)
+	}
+
+	ret := &backend.UserDataImportResult{
+		Committed: true,
+		Results:   make([]backend.UserDataImportItemResult, 0, len(results)),
+	}
+	for _, result := range results {
+		status := backend.UserDataImportItemStatusCreated
+		if result.Status == gcpspanner.ImportUserDataItemExisting {
+			status = backend.UserDataImportItemStatusExisting
+		}
+		ret.Results = append(ret.Results, backend.UserDataImportItemResult{
+			Kind:     backend.UserDataImportItemResultKind(result.Kind),
+			SourceId: result.SourceID,
+			Status:   status,
+			Id:       &result.ID,
+			Message:  nil,
+		})
+	}
+
+	return ret, nil
+}
+
+func convertImportUserDataError(err error) error {
+	var itemErr *gcpspanner.ImportUserDataItemError
+	if errors.As(err, &itemErr) {
+		cause := itemErr.Err
+		switch {
+		case errors.Is(cause, gcpspanner.ErrImportReferenceNotFound):
+			cause = errors.Join(cause, backendtypes.ErrEntityDoesNotExist)
+		case errors.Is(cause, gcpspanner.ErrImportEmailChannelNotFound):
+			cause = errors.Join(cause, backendtypes.ErrUserDataImportEmailChannelNotFound)
+		case errors.Is(cause, gcpspanner.ErrSubscriptionConflict):
+			cause = errors.Join(cause, backendtypes.ErrSubscriptionConflict)
+		case errors.Is(cause, gcpspanner.ErrUnknownHotlistFeature),
+			errors.Is(cause, gcpspanner.ErrDuplicateHotlistFeature),
+			errors.Is(cause, gcpspanner.ErrUserHotlistFeatureLimitExceeded):
+			cause = errors.Join(cause, backendtypes.ErrInvalidHotlistFeatures)
+		}
+
+		return &backendtypes.UserDataImportItemError{
+			Kind:     backend.UserDataImportItemResultKind(itemErr.Kind),
+			SourceID: itemErr.SourceID,
+			Err:      cause,
+		}
+	}
+
+	switch {
+	case errors.Is(err, gcpspanner.ErrOwnerSavedSearchLimitExceeded):
+		return errors.Join(err, backendtypes.ErrUserMaxSavedSearches)
+	case errors.Is(err, gcpspanner.ErrUserSearchBookmarkLimitExceeded):
+		return errors.Join(err, backendtypes.ErrUserMaxBookmarks)
+	case errors.Is(err, gcpspanner.ErrOwnerNotificationChannelLimitExceeded):
+		return errors.Join(err, backendtypes.ErrUserMaxNotificationChannels)
+	case errors.Is(err, gcpspanner.ErrSubscriptionLimitExceeded):
+		return errors.Join(err, backendtypes.ErrUserMaxSubscriptions)
+	}
+
+	return err
+}
+
+// CreatePersonalAccessToken creates a new personal access token for the user.
+// The returned token is the only copy of the token. Only its hash is stored.
+func (s *Backend) CreatePersonalAccessToken(ctx context.Context,
+	userID string, req backend.CreatePersonalAccessTokenRequest) (*backend.CreatedPersonalAccessToken, error) {
+	token, tokenHash, err := auth.GeneratePersonalAccessToken()
+	if err != nil {
+		return nil, err
+	}
+	created, err := s.client.CreatePersonalAccessToken(ctx, gcpspanner.CreatePersonalAccessTokenRequest{
+		UserID:    userID,
+		Name:      req.Name,
+		Scope:     gcpspanner.PersonalAccessTokenScope(req.Scope),
+		TokenHash: tokenHash,
+	})
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrPersonalAccessTokenLimitExceeded) {
+			return nil, errors.Join(err, backendtypes.ErrUserMaxPersonalAccessTokens)
+		}
+
+		return nil, err
+	}
+
+	return &backend.CreatedPersonalAccessToken{
+		Id:         created.ID,
+		Name:       created.Name,
+		Scope:      backend.PersonalAccessTokenScope(created.Scope),
+		CreatedAt:  created.CreatedAt,
+		LastUsedAt: created.LastUsedAt,
+		Token:      token,
+	}, nil
+}
+
+// ListPersonalAccessTokens returns the personal access tokens of the user, newest first.
+func (s *Backend) ListPersonalAccessTokens(ctx context.Context,
+	userID string) (*backend.PersonalAccessTokenPage, error) {
+	tokens, err := s.client.ListPersonalAccessTokens(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make([]backend.PersonalAccessToken, 0, len(tokens))
+	for _, token := range tokens {
+		data = append(data, backend.PersonalAccessToken{
+			Id:         token.ID,
+			Name:       token.Name,
+			Scope:      backend.PersonalAccessTokenScope(token.Scope),
+			CreatedAt:  token.CreatedAt,
+			LastUsedAt: token.LastUsedAt,
+		})
+	}
+
+	return &backend.PersonalAccessTokenPage{
+		Data: data,
+	}, nil
+}
+
+// RevokePersonalAccessToken revokes a personal access token of the user.
+func (s *Backend) RevokePersonalAccessToken(ctx context.Context, userID, tokenID string) error {
+	err := s.client.DeletePersonalAccessToken(ctx, userID, tokenID)
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrPersonalAccessTokenNotFound) {
+			return errors.Join(err, backendtypes.ErrEntityDoesNotExist)
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"cloud.google.com/go/spanner"
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
 	"github.com/GoogleChrome/webstatus.dev/lib/backendtypes"
 	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner"
 	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
@@ -83,6 +84,19 @@ type mockGetSplitWebFeatureByOriginalFeatureKeyConfig struct {
 	returnedError      error
 }
 
+type mockGetFeatureTimelineConfig struct {
+	expectedFeatureKey string
+	result             *gcpspanner.FeatureTimeline
+	returnedError      error
+}
+
+type mockGetOldestBrowserReleaseVersionWithStatusConfig struct {
+	expectedBrowserName string
+	expectedStatus      string
+	result              string
+	returnedError       error
+}
+
 type mockGetNotificationChannelConfig struct {
 	expectedChannelID string
 	expectedUserID    string
@@ -90,6 +104,19 @@ type mockGetNotificationChannelConfig struct {
 	returnedError     error
 }
 
+type mockGetNotificationChannelDeliverySettingsConfig struct {
+	expectedChannelID string
+	expectedUserID    string
+	result            *gcpspanner.NotificationChannelDeliverySettings
+	returnedError     error
+}
+
+type mockUpsertNotificationChannelDeliverySettingsConfig struct {
+	expectedUserID   string
+	expectedSettings gcpspanner.NotificationChannelDeliverySettings
+	returnedError    error
+}
+
 type mockDeleteNotificationChannelConfig struct {
 	expectedChannelID string
 	expectedUserID    string
@@ -124,6 +151,31 @@ type mockListBaselineStatusCountsConfig struct {
 	returnedError error
 }
 
+type mockFeaturesSearchFacetCountsConfig struct {
+	expectedNode   *searchtypes.SearchNode
+	expectedFacets []gcpspanner.FeatureSearchFacet
+	result         []gcpspanner.FeatureSearchFacetCount
+	returnedError  error
+}
+
+type mockPersonalAccessTokensConfig struct {
+	expectedUserID        string
+	expectedCreateRequest gcpspanner.CreatePersonalAccessTokenRequest
+	createResult          *gcpspanner.PersonalAccessToken
+	createError           error
+	listResult            []gcpspanner.PersonalAccessToken
+	listError             error
+	expectedTokenID       string
+	deleteError           error
+}
+
+type mockListStatsForSearchConfig struct {
+	expectedSearchNode *searchtypes.SearchNode
+	baselineResult     *gcpspanner.BaselineStatusCountResultPage
+	browserResult      *gcpspanner.BrowserFeatureCountResultPage
+	returnedError      error
+}
+
 type mockListMissingOneImplCountsConfig struct {
 	result        *gcpspanner.MissingOneImplCountPage
 	returnedError error
@@ -222,6 +274,31 @@ type mockListSavedSearchSubscriptionsConfig struct {
 	returnedError   error
 }
 
+type mockWatchFeatureConfig struct {
+	expectedRequest gcpspanner.WatchFeatureRequest
+	result          *string
+	returnedError   error
+}
+
+type mockUnwatchFeatureConfig struct {
+	expectedRequest gcpspanner.UnwatchFeatureRequest
+	returnedError   error
+}
+
+type mockGetWatchedFeatureConfig struct {
+	expectedSubscriptionID string
+	expectedUserID         string
+	result                 *gcpspanner.WatchedFeature
+	returnedError          error
+}
+
+type mockListWatchedFeaturesConfig struct {
+	expectedRequest gcpspanner.ListWatchedFeaturesRequest
+	result          []gcpspanner.WatchedFeature
+	nextPageToken   *string
+	returnedError   error
+}
+
 type mockGetSystemGlobalSavedSearchConfig struct {
 	results map[string]*gcpspanner.SystemGlobalSavedSearchWithSortOption
 	errs    map[string]error
@@ -232,6 +309,108 @@ type mockGetSavedSearchConfig struct {
 	errs    map[string]error
 }
 
+type mockGetUserHotlistConfig struct {
+	results map[string]*gcpspanner.UserHotlist
+	errs    map[string]error
+}
+
+type mockCreateUserHotlistConfig struct {
+	expectedRequest gcpspanner.CreateUserHotlistRequest
+	result          *string
+	returnedError   error
+}
+
+type mockReplaceUserHotlistFeaturesConfig struct {
+	expectedRequest gcpspanner.ReplaceUserHotlistFeaturesRequest
+	result          int64
+	returnedError   error
+}
+
+type mockListSavedSearchCollaboratorsConfig struct {
+	expectedSavedSearchID string
+	expectedUserID        string
+	result                []gcpspanner.SavedSearchCollaborator
+	returnedError         error
+}
+
+type mockPutSavedSearchCollaboratorConfig struct {
+	expectedRequest gcpspanner.PutSavedSearchCollaboratorRequest
+	result          *gcpspanner.SavedSearchCollaborator
+	returnedError   error
+}
+
+type mockRemoveSavedSearchCollaboratorConfig struct {
+	expectedRequest gcpspanner.RemoveSavedSearchCollaboratorRequest
+	returnedError   error
+}
+
+type mockTransferSavedSearchOwnershipConfig struct {
+	expectedRequest gcpspanner.TransferSavedSearchOwnershipRequest
+	returnedError   error
+}
+
+type mockListSavedSearchDirectoryConfig struct {
+	expectedRequest gcpspanner.ListSavedSearchDirectoryRequest
+	result          *gcpspanner.SavedSearchDirectoryPage
+	returnedError   error
+}
+
+type mockImportUserDataConfig struct {
+	expectedRequest gcpspanner.ImportUserDataRequest
+	result          []gcpspanner.ImportUserDataItemResult
+	returnedError   error
+}
+
+type mockListSavedSearchSnapshotsConfig struct {
+	expectedRequest gcpspanner.ListSavedSearchSnapshotsRequest
+	result          *gcpspanner.SavedSearchSnapshotsPage
+	returnedError   error
+}
+
+type mockGetSavedSearchSnapshotConfig struct {
+	expectedRequest gcpspanner.GetSavedSearchSnapshotRequest
+	result          *gcpspanner.SavedSearchSnapshot
+	returnedError   error
+}
+
+type mockListSavedSearchRevisionsConfig struct {
+	expectedRequest gcpspanner.ListSavedSearchRevisionsRequest
+	result          *gcpspanner.SavedSearchRevisionsPage
+	returnedError   error
+}
+
+type mockGetSavedSearchRevisionPairConfig struct {
+	expectedRequest gcpspanner.GetSavedSearchRevisionPairRequest
+	base            *gcpspanner.SavedSearchRevision
+	revision        *gcpspanner.SavedSearchRevision
+	returnedError   error
+}
+
+type mockGetSavedSearchRevisionConfig struct {
+	expectedRequest gcpspanner.GetSavedSearchRevisionRequest
+	result          *gcpspanner.SavedSearchRevision
+	returnedError   error
+}
+
+type mockRevertSavedSearchConfig struct {
+	expectedRequest gcpspanner.RevertSavedSearchRequest
+	returnedError   error
+	// calls counts the reverts when set.
+	calls *int
+}
+
+type mockForkSavedSearchConfig struct {
+	expectedRequest gcpspanner.ForkSavedSearchRequest
+	result          *string
+	returnedError   error
+}
+
+type mockGetSavedSearchForkSourceConfig struct {
+	expectedSavedSearchID string
+	result                *gcpspanner.SavedSearchForkSource
+	returnedError         error
+}
+
 type mockGetReferencingSavedSearchIDsConfig struct {
 	results map[string][]string
 	errs    map[string]error
@@ -250,6 +429,8 @@ type mockBackendSpannerClient struct {
 	mockListMissingOneImplFeaturesCfg        mockListMissingOneImplFeaturesConfig
 	mockListBaselineStatusCountsCfg          mockListBaselineStatusCountsConfig
 	mockGetNotificationChannelCfg            *mockGetNotificationChannelConfig
+	mockGetDeliverySettingsCfg               *mockGetNotificationChannelDeliverySettingsConfig
+	mockUpsertDeliverySettingsCfg            *mockUpsertNotificationChannelDeliverySettingsConfig
 	mockDeleteNotificationChannelCfg         *mockDeleteNotificationChannelConfig
 	mockListNotificationChannelsCfg          *mockListNotificationChannelsConfig
 	mockCreateNotificationChannelCfg         *mockCreateNotificationChannelConfig
@@ -268,14 +449,40 @@ type mockBackendSpannerClient struct {
 	mockUpdateSavedSearchSubscriptionCfg     *mockUpdateSavedSearchSubscriptionConfig
 	mockDeleteSavedSearchSubscriptionCfg     *mockDeleteSavedSearchSubscriptionConfig
 	mockListSavedSearchSubscriptionsCfg      *mockListSavedSearchSubscriptionsConfig
+	mockWatchFeatureCfg                      *mockWatchFeatureConfig
+	mockUnwatchFeatureCfg                    *mockUnwatchFeatureConfig
+	mockGetWatchedFeatureCfg                 *mockGetWatchedFeatureConfig
+	mockListWatchedFeaturesCfg               *mockListWatchedFeaturesConfig
 	mockGetSystemGlobalSavedSearchCfg        *mockGetSystemGlobalSavedSearchConfig
 	mockGetSavedSearchCfg                    *mockGetSavedSearchConfig
 	mockGetReferencingSavedSearchIDsCfg      *mockGetReferencingSavedSearchIDsConfig
+	mockGetUserHotlistCfg                    *mockGetUserHotlistConfig
+	mockCreateUserHotlistCfg                 *mockCreateUserHotlistConfig
+	mockReplaceUserHotlistFeaturesCfg        *mockReplaceUserHotlistFeaturesConfig
+	mockListSavedSearchCollaboratorsCfg      *mockListSavedSearchCollaboratorsConfig
+	mockPutSavedSearchCollaboratorCfg        *mockPutSavedSearchCollaboratorConfig
+	mockRemoveSavedSearchCollaboratorCfg     *mockRemoveSavedSearchCollaboratorConfig
+	mockTransferSavedSearchOwnershipCfg      *mockTransferSavedSearchOwnershipConfig
+	mockListSavedSearchRevisionsCfg          *mockListSavedSearchRevisionsConfig
+	mockGetSavedSearchRevisionPairCfg        *mockGetSavedSearchRevisionPairConfig
+	mockGetSavedSearchRevisionCfg            *mockGetSavedSearchRevisionConfig
+	mockRevertSavedSearchCfg                 *mockRevertSavedSearchConfig
+	mockForkSavedSearchCfg                   *mockForkSavedSearchConfig
+	mockGetSavedSearchForkSourceCfg          *mockGetSavedSearchForkSourceConfig
+	mockListSavedSearchDirectoryCfg          *mockListSavedSearchDirectoryConfig
+	mockImportUserDataCfg                    *mockImportUserDataConfig
+	mockListSavedSearchSnapshotsCfg          *mockListSavedSearchSnapshotsConfig
+	mockGetSavedSearchSnapshotCfg            *mockGetSavedSearchSnapshotConfig
+	mockListStatsForSearchCfg                *mockListStatsForSearchConfig
+	mockFeaturesSearchFacetCountsCfg         *mockFeaturesSearchFacetCountsConfig
+	mockPersonalAccessTokensCfg              *mockPersonalAccessTokensConfig
 	pageToken                                *string
 	err                                      error
 
 	mockGetMovedWebFeatureDetailsByOriginalFeatureKeyCfg *mockGetMovedWebFeatureDetailsByOriginalFeatureKeyConfig
 	mockGetSplitWebFeatureByOriginalFeatureKeyCfg        *mockGetSplitWebFeatureByOriginalFeatureKeyConfig
+	mockGetFeatureTimelineCfg                            *mockGetFeatureTimelineConfig
+	mockGetOldestBrowserReleaseVersionWithStatusCfg      *mockGetOldestBrowserReleaseVersionWithStatusConfig
 	mockSyncUserProfileInfoCfg                           *mockSyncUserProfileInfoConfig
 }
 
@@ -317,6 +524,176 @@ func (c mockBackendSpannerClient) ListSystemGlobalSavedSearches(
 	return nil, nil, nil
 }
 
+func (c mockBackendSpannerClient) GetUserHotlist(
+	_ context.Context,
+	savedSearchID string,
+) (*gcpspanner.UserHotlist, error) {
+	if c.mockGetUserHotlistCfg != nil {
+		if err, ok := c.mockGetUserHotlistCfg.errs[savedSearchID]; ok {
+			return nil, err
+		}
+		if res, ok := c.mockGetUserHotlistCfg.results[savedSearchID]; ok {
+			return res, nil
+		}
+	}
+
+	return nil, gcpspanner.ErrQueryReturnedNoResults
+}
+
+func (c mockBackendSpannerClient) CreateUserHotlist(
+	_ context.Context, req gcpspanner.CreateUserHotlistRequest) (*string, error) {
+	if !reflect.DeepEqual(req, c.mockCreateUserHotlistCfg.expectedRequest) {
+		c.t.Errorf("unexpected input to mock. got %+v", req)
+	}
+
+	return c.mockCreateUserHotlistCfg.result, c.mockCreateUserHotlistCfg.returnedError
+}
+
+func (c mockBackendSpannerClient) ReplaceUserHotlistFeatures(
+	_ context.Context, req gcpspanner.ReplaceUserHotlistFeaturesRequest) (int64, error) {
+	if !reflect.DeepEqual(req, c.mockReplaceUserHotlistFeaturesCfg.expectedRequest) {
+		c.t.Errorf("unexpected input to mock. got %+v", req)
+	}
+
+	return c.mockReplaceUserHotlistFeaturesCfg.result, c.mockReplaceUserHotlistFeaturesCfg.returnedError
+}
+
+func (c mockBackendSpannerClient) ListSavedSearchCollaborators(
+	_ context.Context, savedSearchID string, requestingUserID string) ([]gcpspanner.SavedSearchCollaborator, error) {
+	if savedSearchID != c.mockListSavedSearchCollaboratorsCfg.expectedSavedSearchID ||
+		requestingUserID != c.mockListSavedSearchCollaboratorsCfg.expectedUserID {
+		c.t.Errorf("unexpected input to mock. got %s %s", savedSearchID, requestingUserID)
+	}
+
+	return c.mockListSavedSearchCollaboratorsCfg.result, c.mockListSavedSearchCollaboratorsCfg.returnedError
+}
+
+func (c mockBackendSpannerClient) PutSavedSearchCollaborator(
+	_ context.Context, req gcpspanner.PutSavedSearchCollaboratorRequest) (*gcpspanner.SavedSearchCollaborator, error) {
+	if !reflect.DeepEqual(req, c.mockPutSavedSearchCollaboratorCfg.expectedRequest) {
+		c.t.Errorf("unexpected input to mock. got %+v", req)
+	}
+
+	return c.mockPutSavedSearchCollaboratorCfg.result, c.mockPutSavedSearchCollaboratorCfg.returnedError
+}
+
+func (c mockBackendSpannerClient) RemoveSavedSearchCollaborator(
+	_ context.Context, req gcpspanner.RemoveSavedSearchCollaboratorRequest) error {
+	if !reflect.DeepEqual(req, c.mockRemoveSavedSearchCollaboratorCfg.expectedRequest) {
+		c.t.Errorf("unexpected input to mock. got %+v", req)
+	}
+
+	return c.mockRemoveSavedSearchCollaboratorCfg.returnedError
+}
+
+func (c mockBackendSpannerClient) TransferSavedSearchOwnership(
+	_ context.Context, req gcpspanner.TransferSavedSearchOwnershipRequest) error {
+	if !reflect.DeepEqual(req, c.mockTransferSavedSearchOwnershipCfg.expectedRequest) {
+		c.t.Errorf("unexpected input to mock. got %+v", req)
+	}
+
+	return c.mockTransferSavedSearchOwnershipCfg.returnedError
+}
+
+func (c mockBackendSpannerClient) ImportUserData(
+	_ context.Context, req gcpspanner.ImportUserDataRequest) ([]gcpspanner.ImportUserDataItemResult, error) {
+	if !reflect.DeepEqual(req, c.mockImportUserDataCfg.expectedRequest) {
+		c.t.Errorf("unexpected request %+v", req)
+	}
+
+	return c.mockImportUserDataCfg.result, c.mockImportUserDataCfg.returnedError
+}
+
+func (c mockBackendSpannerClient) ListSavedSearchSnapshots(
+	_ context.Context, req gcpspanner.ListSavedSearchSnapshotsRequest) (*gcpspanner.SavedSearchSnapshotsPage, error) {
+	if !reflect.DeepEqual(req, c.mockListSavedSearchSnapshotsCfg.expectedRequest) {
+		c.t.Errorf("unexpected input to mock. got %+v", req)
+	}
+
+	return c.mockListSavedSearchSnapshotsCfg.result, c.mockListSavedSearchSnapshotsCfg.returnedError
+}
+
+func (c mockBackendSpannerClient) GetSavedSearchSnapshot(
+	_ context.Context, req gcpspanner.GetSavedSearchSnapshotRequest) (*gcpspanner.SavedSearchSnapshot, error) {
+	if !reflect.DeepEqual(req, c.mockGetSavedSearchSnapshotCfg.expectedRequest) {
+		c.t.Errorf("unexpected input to mock. got %+v", req)
+	}
+
+	return c.mockGetSavedSearchSnapshotCfg.result, c.mockGetSavedSearchSnapshotCfg.returnedError
+}
+
+func (c mockBackendSpannerClient) ListSavedSearchDirectory(
+	_ context.Context, req gcpspanner.ListSavedSearchDirectoryRequest) (*gcpspanner.SavedSearchDirectoryPage, error) {
+	if !reflect.DeepEqual(req, c.mockListSavedSearchDirectoryCfg.expectedRequest) {
+		c.t.Errorf("unexpected input to mock. got %+v", req)
+	}
+
+	return c.mockListSavedSearchDirectoryCfg.result, c.mockListSavedSearchDirectoryCfg.returnedError
+}
+
+func (c mockBackendSpannerClient) ListSavedSearchRevisions(
+	_ context.Context, req gcpspanner.ListSavedSearchRevisionsRequest) (*gcpspanner.SavedSearchRevisionsPage, error) {
+	if !reflect.DeepEqual(req, c.mockListSavedSearchRevisionsCfg.expectedRequest) {
+		c.t.Errorf("unexpected input to mock. got %+v", req)
+	}
+
+	return c.mockListSavedSearchRevisionsCfg.result, c.mockListSavedSearchRevisionsCfg.returnedError
+}
+
+func (c mockBackendSpannerClient) GetSavedSearchRevisionPair(
+	_ context.Context, req gcpspanner.GetSavedSearchRevisionPairRequest) (
+	*gcpspanner.SavedSearchRevision, *gcpspanner.SavedSearchRevision, error) {
+	if !reflect.DeepEqual(req, c.mockGetSavedSearchRevisionPairCfg.expectedRequest) {
+		c.t.Errorf("unexpected input to mock. got %+v", req)
+	}
+
+	return c.mockGetSavedSearchRevisionPairCfg.base, c.mockGetSavedSearchRevisionPairCfg.revision,
+		c.mockGetSavedSearchRevisionPairCfg.returnedError
+}
+
+func (c mockBackendSpannerClient) GetSavedSearchRevision(
+	_ context.Context, req gcpspanner.GetSavedSearchRevisionRequest) (*gcpspanner.SavedSearchRevision, error) {
+	if !reflect.DeepEqual(req, c.mockGetSavedSearchRevisionCfg.expectedRequest) {
+		c.t.Errorf("unexpected input to mock. got %+v", req)
+	}
+
+	return c.mockGetSavedSearchRevisionCfg.result, c.mockGetSavedSearchRevisionCfg.returnedError
+}
+
+func (c mockBackendSpannerClient) RevertSavedSearch(
+	_ context.Context, req gcpspanner.RevertSavedSearchRequest) error {
+	if !reflect.DeepEqual(req, c.mockRevertSavedSearchCfg.expectedRequest) {
+		c.t.Errorf("unexpected input to mock. got %+v", req)
+	}
+	if c.mockRevertSavedSearchCfg.calls != nil {
+		*c.mockRevertSavedSearchCfg.calls++
+	}
+
+	return c.mockRevertSavedSearchCfg.returnedError
+}
+
+func (c mockBackendSpannerClient) ForkSavedSearch(
+	_ context.Context, req gcpspanner.ForkSavedSearchRequest) (*string, error) {
+	if !reflect.DeepEqual(req, c.mockForkSavedSearchCfg.expectedRequest) {
+		c.t.Errorf("unexpected input to mock. got %+v", req)
+	}
+
+	return c.mockForkSavedSearchCfg.result, c.mockForkSavedSearchCfg.returnedError
+}
+
+func (c mockBackendSpannerClient) GetSavedSearchForkSource(
+	_ context.Context, savedSearchID string) (*gcpspanner.SavedSearchForkSource, error) {
+	// Most saved searches are not forks.
+	if c.mockGetSavedSearchForkSourceCfg == nil {
+		return nil, nil
+	}
+	if savedSearchID != c.mockGetSavedSearchForkSourceCfg.expectedSavedSearchID {
+		c.t.Errorf("unexpected saved search id %s", savedSearchID)
+	}
+
+	return c.mockGetSavedSearchForkSourceCfg.result, c.mockGetSavedSearchForkSourceCfg.returnedError
+}
+
 func (c mockBackendSpannerClient) GetSavedSearch(
 	_ context.Context,
 	id string,
@@ -371,6 +748,28 @@ func (c mockBackendSpannerClient) GetSplitWebFeatureByOriginalFeatureKey(
 		c.mockGetSplitWebFeatureByOriginalFeatureKeyCfg.returnedError
 }
 
+// GetFeatureTimeline implements BackendSpannerClient.
+func (c mockBackendSpannerClient) GetFeatureTimeline(
+	_ context.Context, featureKey string) (*gcpspanner.FeatureTimeline, error) {
+	if featureKey != c.mockGetFeatureTimelineCfg.expectedFeatureKey {
+		c.t.Errorf("unexpected input to mock: %s", featureKey)
+	}
+
+	return c.mockGetFeatureTimelineCfg.result, c.mockGetFeatureTimelineCfg.returnedError
+}
+
+// GetOldestBrowserReleaseVersionWithStatus implements BackendSpannerClient.
+func (c mockBackendSpannerClient) GetOldestBrowserReleaseVersionWithStatus(
+	_ context.Context, browserName string, status string) (string, error) {
+	if browserName != c.mockGetOldestBrowserReleaseVersionWithStatusCfg.expectedBrowserName ||
+		status != c.mockGetOldestBrowserReleaseVersionWithStatusCfg.expectedStatus {
+		c.t.Errorf("unexpected input to mock: %s %s", browserName, status)
+	}
+
+	return c.mockGetOldestBrowserReleaseVersionWithStatusCfg.result,
+		c.mockGetOldestBrowserReleaseVersionWithStatusCfg.returnedError
+}
+
 // AddUserSearchBookmark implements BackendSpannerClient.
 func (c mockBackendSpannerClient) AddUserSearchBookmark(
 	_ context.Context, req gcpspanner.UserSavedSearchBookmark) error {
@@ -538,6 +937,26 @@ func (c mockBackendSpannerClient) GetNotificationChannel(
 	return c.mockGetNotificationChannelCfg.result, c.mockGetNotificationChannelCfg.returnedError
 }
 
+func (c mockBackendSpannerClient) GetNotificationChannelDeliverySettings(
+	_ context.Context, channelID string, userID string) (*gcpspanner.NotificationChannelDeliverySettings, error) {
+	if channelID != c.mockGetDeliverySettingsCfg.expectedChannelID ||
+		userID != c.mockGetDeliverySettingsCfg.expectedUserID {
+		c.t.Error("unexpected input to mock")
+	}
+
+	return c.mockGetDeliverySettingsCfg.result, c.mockGetDeliverySettingsCfg.returnedError
+}
+
+func (c mockBackendSpannerClient) UpsertNotificationChannelDeliverySettings(
+	_ context.Context, userID string, settings gcpspanner.NotificationChannelDeliverySettings) error {
+	if userID != c.mockUpsertDeliverySettingsCfg.expectedUserID ||
+		!reflect.DeepEqual(settings, c.mockUpsertDeliverySettingsCfg.expectedSettings) {
+		c.t.Error("unexpected input to mock")
+	}
+
+	return c.mockUpsertDeliverySettingsCfg.returnedError
+}
+
 func (c mockBackendSpannerClient) DeleteNotificationChannel(_ context.Context, channelID string, userID string) error {
 	if channelID != c.mockDeleteNotificationChannelCfg.expectedChannelID ||
 		userID != c.mockDeleteNotificationChannelCfg.expectedUserID {
@@ -597,6 +1016,55 @@ func (c mockBackendSpannerClient) FeaturesSearch(
 		c.mockFeaturesSearchCfg.returnedError
 }
 
+// FeaturesSearchFacetCounts implements BackendSpannerClient.
+func (c mockBackendSpannerClient) FeaturesSearchFacetCounts(
+	_ context.Context,
+	searchNode *searchtypes.SearchNode,
+	facets []gcpspanner.FeatureSearchFacet) ([]gcpspanner.FeatureSearchFacetCount, error) {
+	if !reflect.DeepEqual(searchNode, c.mockFeaturesSearchFacetCountsCfg.expectedNode) ||
+		!slices.Equal(facets, c.mockFeaturesSearchFacetCountsCfg.expectedFacets) {
+		c.t.Error("unexpected input to mock")
+	}
+
+	return c.mockFeaturesSearchFacetCountsCfg.result, c.mockFeaturesSearchFacetCountsCfg.returnedError
+}
+
+// CreatePersonalAccessToken implements BackendSpannerClient.
+func (c mockBackendSpannerClient) CreatePersonalAccessToken(
+	_ context.Context, req gcpspanner.CreatePersonalAccessTokenRequest) (*gcpspanner.PersonalAccessToken, error) {
+	expected := c.mockPersonalAccessTokensCfg.expectedCreateRequest
+	// The hash is random, only check that it is set.
+	if req.TokenHash == "" {
+		c.t.Error("expected a token hash")
+	}
+	expected.TokenHash = req.TokenHash
+	if req != expected {
+		c.t.Errorf("unexpected request %+v", req)
+	}
+
+	return c.mockPersonalAccessTokensCfg.createResult, c.mockPersonalAccessTokensCfg.createError
+}
+
+// ListPersonalAccessTokens implements BackendSpannerClient.
+func (c mockBackendSpannerClient) ListPersonalAccessTokens(
+	_ context.Context, userID string) ([]gcpspanner.PersonalAccessToken, error) {
+	if userID != c.mockPersonalAccessTokensCfg.expectedUserID {
+		c.t.Errorf("unexpected user %s", userID)
+	}
+
+	return c.mockPersonalAccessTokensCfg.listResult, c.mockPersonalAccessTokensCfg.listError
+}
+
+// DeletePersonalAccessToken implements BackendSpannerClient.
+func (c mockBackendSpannerClient) DeletePersonalAccessToken(_ context.Context, userID string, tokenID string) error {
+	if userID != c.mockPersonalAccessTokensCfg.expectedUserID ||
+		tokenID != c.mockPersonalAccessTokensCfg.expectedTokenID {
+		c.t.Errorf("unexpected input %s %s", userID, tokenID)
+	}
+
+	return c.mockPersonalAccessTokensCfg.deleteError
+}
+
 func (c mockBackendSpannerClient) ListMissingOneImplCounts(
 	ctx context.Context,
 	targetBrowser string,
@@ -643,6 +1111,44 @@ func (c mockBackendSpannerClient) ListMissingOneImplementationFeatures(
 	return c.mockListMissingOneImplFeaturesCfg.result, c.mockListMissingOneImplFeaturesCfg.returnedError
 }
 
+// ListBaselineStatusCountsForSearch implements BackendSpannerClient.
+func (c mockBackendSpannerClient) ListBaselineStatusCountsForSearch(
+	_ context.Context, searchNode *searchtypes.SearchNode, dateType gcpspanner.BaselineDateType, startAt time.Time,
+	endAt time.Time, pageSize int, pageToken *string) (*gcpspanner.BaselineStatusCountResultPage, error) {
+	if dateType != gcpspanner.BaselineDateTypeLow ||
+		!startAt.Equal(testStart) ||
+		!endAt.Equal(testEnd) ||
+		pageSize != 100 ||
+		pageToken != nonNilInputPageToken {
+		c.t.Error("unexpected input to mock")
+	}
+	if !reflect.DeepEqual(searchNode, c.mockListStatsForSearchCfg.expectedSearchNode) {
+		c.t.Errorf("unexpected search node %+v", searchNode)
+	}
+
+	return c.mockListStatsForSearchCfg.baselineResult, c.mockListStatsForSearchCfg.returnedError
+}
+
+// ListBrowserFeatureCountMetricForSearch implements BackendSpannerClient.
+func (c mockBackendSpannerClient) ListBrowserFeatureCountMetricForSearch(
+	_ context.Context, searchNode *searchtypes.SearchNode, targetBrowser string, targetMobileBrowser *string,
+	startAt time.Time, endAt time.Time, pageSize int, pageToken *string) (
+	*gcpspanner.BrowserFeatureCountResultPage, error) {
+	if targetBrowser != "mybrowser" ||
+		targetMobileBrowser != nil ||
+		!startAt.Equal(testStart) ||
+		!endAt.Equal(testEnd) ||
+		pageSize != 100 ||
+		pageToken != nonNilInputPageToken {
+		c.t.Error("unexpected input to mock")
+	}
+	if !reflect.DeepEqual(searchNode, c.mockListStatsForSearchCfg.expectedSearchNode) {
+		c.t.Errorf("unexpected search node %+v", searchNode)
+	}
+
+	return c.mockListStatsForSearchCfg.browserResult, c.mockListStatsForSearchCfg.returnedError
+}
+
 // ListBaselineStatusCounts implements BackendSpannerClient.
 func (c mockBackendSpannerClient) ListBaselineStatusCounts(
 	ctx context.Context, dateType gcpspanner.BaselineDateType, startAt time.Time,
@@ -710,6 +1216,52 @@ func (c mockBackendSpannerClient) DeleteSavedSearchSubscription(
 	return c.mockDeleteSavedSearchSubscriptionCfg.returnedError
 }
 
+// WatchFeature implements BackendSpannerClient.
+func (c mockBackendSpannerClient) WatchFeature(
+	_ context.Context, req gcpspanner.WatchFeatureRequest) (*string, error) {
+	if !reflect.DeepEqual(req, c.mockWatchFeatureCfg.expectedRequest) {
+		c.t.Error("unexpected input to mock")
+	}
+
+	return c.mockWatchFeatureCfg.result, c.mockWatchFeatureCfg.returnedError
+}
+
+// UnwatchFeature implements BackendSpannerClient.
+func (c mockBackendSpannerClient) UnwatchFeature(
+	_ context.Context, req gcpspanner.UnwatchFeatureRequest) error {
+	if !reflect.DeepEqual(req, c.mockUnwatchFeatureCfg.expectedRequest) {
+		c.t.Error("unexpected input to mock")
+	}
+
+	return c.mockUnwatchFeatureCfg.returnedError
+}
+
+// GetWatchedFeature implements BackendSpannerClient.
+func (c mockBackendSpannerClient) GetWatchedFeature(
+	_ context.Context,
+	subscriptionID string,
+	userID string) (*gcpspanner.WatchedFeature, error) {
+	if subscriptionID != c.mockGetWatchedFeatureCfg.expectedSubscriptionID ||
+		userID != c.mockGetWatchedFeatureCfg.expectedUserID {
+		c.t.Error("unexpected input to mock")
+	}
+
+	return c.mockGetWatchedFeatureCfg.result, c.mockGetWatchedFeatureCfg.returnedError
+}
+
+// ListWatchedFeatures implements BackendSpannerClient.
+func (c mockBackendSpannerClient) ListWatchedFeatures(
+	_ context.Context,
+	req gcpspanner.ListWatchedFeaturesRequest) ([]gcpspanner.WatchedFeature, *string, error) {
+	if !reflect.DeepEqual(req, c.mockListWatchedFeaturesCfg.expectedRequest) {
+		c.t.Error("unexpected input to mock")
+	}
+
+	return c.mockListWatchedFeaturesCfg.result,
+		c.mockListWatchedFeaturesCfg.nextPageToken,
+		c.mockListWatchedFeaturesCfg.returnedError
+}
+
 // GetSavedSearchSubscription implements BackendSpannerClient.
 func (c mockBackendSpannerClient) GetSavedSearchSubscription(
 	_ context.Context,
@@ -1378,9 +1930,224 @@ func TestListBaselineStatusCounts(t *testing.T) {
 	}
 }
 
-func TestConvertBaselineStatusBackendToSpanner(t *testing.T) {
-	var backendToSpannerTests = []struct {
-		name     string
+func TestListSavedSearchStats(t *testing.T) {
+	baselinePage := &gcpspanner.BaselineStatusCountResultPage{
+		NextPageToken: nil,
+		Metrics: []gcpspanner.BaselineStatusCountMetric{
+			{StatusCount: 3, Date: time.Date(2010, time.January, 10, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	browserPage := &gcpspanner.BrowserFeatureCountResultPage{
+		NextPageToken: nil,
+		Metrics: []gcpspanner.BrowserFeatureCountMetric{
+			{FeatureCount: 4, ReleaseDate: time.Date(2010, time.January, 9, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+	testCases := []struct {
+		name                 string
+		savedSearches        map[string]*gcpspanner.SavedSearch
+		cfg                  mockListStatsForSearchConfig
+		expectedBaselinePage *backend.BaselineStatusMetricsPage
+		expectedBrowserPage  *backend.BrowserReleaseFeatureMetricsPage
+		expectedErr          error
+	}{
+		{
+			name: "query of the saved search",
+			savedSearches: map[string]*gcpspanner.SavedSearch{
+				"search1": {Query: "id:foo"},
+			},
+			cfg: mockListStatsForSearchConfig{
+				expectedSearchNode: &searchtypes.SearchNode{
+					Keyword: searchtypes.KeywordRoot,
+					Term:    nil,
+					Children: []*searchtypes.SearchNode{
+						{
+							Keyword: searchtypes.KeywordNone,
+							Term: &searchtypes.SearchTerm{
+								Identifier: searchtypes.IdentifierID,
+								Value:      "foo",
+								Operator:   searchtypes.OperatorEq,
+							},
+							Children: nil,
+						},
+					},
+				},
+				baselineResult: baselinePage,
+				browserResult:  browserPage,
+				returnedError:  nil,
+			},
+			expectedBaselinePage: &backend.BaselineStatusMetricsPage{
+				Metadata: &backend.PageMetadata{NextPageToken: nil},
+				Data: []backend.BaselineStatusMetric{
+					{Count: new(int64(3)), Timestamp: time.Date(2010, time.January, 10, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+			expectedBrowserPage: &backend.BrowserReleaseFeatureMetricsPage{
+				Metadata: &backend.PageMetadata{NextPageToken: nil},
+				Data: []backend.BrowserReleaseFeatureMetric{
+					{Count: new(int64(4)), Timestamp: time.Date(2010, time.January, 9, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "empty query matches all features",
+			savedSearches: map[string]*gcpspanner.SavedSearch{
+				"search1": {Query: ""},
+			},
+			cfg: mockListStatsForSearchConfig{
+				expectedSearchNode: nil,
+				baselineResult:     baselinePage,
+				browserResult:      browserPage,
+				returnedError:      nil,
+			},
+			expectedBaselinePage: &backend.BaselineStatusMetricsPage{
+				Metadata: &backend.PageMetadata{NextPageToken: nil},
+				Data: []backend.BaselineStatusMetric{
+					{Count: new(int64(3)), Timestamp: time.Date(2010, time.January, 10, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+			expectedBrowserPage: &backend.BrowserReleaseFeatureMetricsPage{
+				Metadata: &backend.PageMetadata{NextPageToken: nil},
+				Data: []backend.BrowserReleaseFeatureMetric{
+					{Count: new(int64(4)), Timestamp: time.Date(2010, time.January, 9, 0, 0, 0, 0, time.UTC)},
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:          "saved search not found",
+			savedSearches: nil,
+			cfg: mockListStatsForSearchConfig{
+				expectedSearchNode: nil,
+				baselineResult:     nil,
+				browserResult:      nil,
+				returnedError:      nil,
+			},
+			expectedBaselinePage: nil,
+			expectedBrowserPage:  nil,
+			expectedErr:          backendtypes.ErrEntityDoesNotExist,
+		},
+		{
+			name: "invalid cursor",
+			savedSearches: map[string]*gcpspanner.SavedSearch{
+				"search1": {Query: ""},
+			},
+			cfg: mockListStatsForSearchConfig{
+				expectedSearchNode: nil,
+				baselineResult:     nil,
+				browserResult:      nil,
+				returnedError:      gcpspanner.ErrInvalidCursorFormat,
+			},
+			expectedBaselinePage: nil,
+			expectedBrowserPage:  nil,
+			expectedErr:          backendtypes.ErrInvalidPageToken,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                         t,
+				mockGetSavedSearchCfg:     &mockGetSavedSearchConfig{results: tc.savedSearches, errs: nil},
+				mockListStatsForSearchCfg: &tc.cfg,
+			}
+			backend := NewBackend(mock)
+
+			baselinePage, err := backend.ListSavedSearchBaselineStatusCounts(
+				context.Background(), "search1", testStart, testEnd, 100, nonNilInputPageToken)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("unexpected error %v", err)
+			}
+			if !reflect.DeepEqual(baselinePage, tc.expectedBaselinePage) {
+				t.Errorf("unexpected baseline page %+v", baselinePage)
+			}
+
+			browserPage, err := backend.ListSavedSearchBrowserFeatureCountMetric(
+				context.Background(), "search1", "mybrowser", nil, testStart, testEnd, 100, nonNilInputPageToken)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("unexpected error %v", err)
+			}
+			if !reflect.DeepEqual(browserPage, tc.expectedBrowserPage) {
+				t.Errorf("unexpected browser page %+v", browserPage)
+			}
+		})
+	}
+}
+
+func TestCountSavedSearchFeaturesByBaselineStatus(t *testing.T) {
+	testCases := []struct {
+		name          string
+		savedSearches map[string]*gcpspanner.SavedSearch
+		cfg           *mockFeaturesSearchFacetCountsConfig
+		expected      []backend.FeatureSearchFacetCount
+		expectedErr   error
+	}{
+		{
+			name: "success",
+			savedSearches: map[string]*gcpspanner.SavedSearch{
+				"search1": {Query: ""},
+			},
+			cfg: &mockFeaturesSearchFacetCountsConfig{
+				expectedNode:   searchtypes.EmptySearchNode(),
+				expectedFacets: []gcpspanner.FeatureSearchFacet{gcpspanner.FeatureSearchFacetBaselineStatus},
+				result: []gcpspanner.FeatureSearchFacetCount{
+					{Facet: gcpspanner.FeatureSearchFacetBaselineStatus, Value: "high", Count: 42},
+					{Facet: gcpspanner.FeatureSearchFacetBaselineStatus, Value: "none", Count: 8},
+				},
+				returnedError: nil,
+			},
+			expected: []backend.FeatureSearchFacetCount{
+				{Value: "widely", Count: 42},
+				{Value: "limited", Count: 8},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:          "saved search not found",
+			savedSearches: nil,
+			cfg:           nil,
+			expected:      nil,
+			expectedErr:   backendtypes.ErrEntityDoesNotExist,
+		},
+		{
+			name: "error",
+			savedSearches: map[string]*gcpspanner.SavedSearch{
+				"search1": {Query: ""},
+			},
+			cfg: &mockFeaturesSearchFacetCountsConfig{
+				expectedNode:   searchtypes.EmptySearchNode(),
+				expectedFacets: []gcpspanner.FeatureSearchFacet{gcpspanner.FeatureSearchFacetBaselineStatus},
+				result:         nil,
+				returnedError:  errTest,
+			},
+			expected:    nil,
+			expectedErr: errTest,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                                t,
+				mockGetSavedSearchCfg:            &mockGetSavedSearchConfig{results: tc.savedSearches, errs: nil},
+				mockFeaturesSearchFacetCountsCfg: tc.cfg,
+			}
+			bk := NewBackend(mock)
+			counts, err := bk.CountSavedSearchFeaturesByBaselineStatus(t.Context(), "search1")
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("unexpected error %v", err)
+			}
+			if !reflect.DeepEqual(counts, tc.expected) {
+				t.Errorf("unexpected counts %+v", counts)
+			}
+		})
+	}
+}
+
+func TestConvertBaselineStatusBackendToSpanner(t *testing.T) {
+	var backendToSpannerTests = []struct {
+		name     string
 		input    backend.BaselineInfoStatus
 		expected gcpspanner.BaselineStatus
 	}{
@@ -1642,6 +2409,7 @@ func TestFeaturesSearch(t *testing.T) {
 				"browser3",
 			},
 			expectedPage: &backend.FeaturePage{
+				Facets: nil,
 				Metadata: backend.PageMetadataWithTotal{
 					NextPageToken: nonNilNextPageToken,
 					Total:         100,
@@ -1809,7 +2577,8 @@ func TestFeaturesSearch(t *testing.T) {
 				tc.searchNode,
 				tc.sortOrder,
 				tc.inputWPTMetricView,
-				tc.inputBrowsers)
+				tc.inputBrowsers,
+				nil)
 			if !errors.Is(err, tc.cfg.returnedError) {
 				t.Error("unexpected error")
 			}
@@ -1822,6 +2591,97 @@ func TestFeaturesSearch(t *testing.T) {
 	}
 }
 
+func TestFeaturesSearchFacets(t *testing.T) {
+	testCases := []struct {
+		name           string
+		cfg            mockFeaturesSearchFacetCountsConfig
+		facets         []backend.FeatureSearchFacet
+		expectedFacets *backend.FeatureSearchFacets
+		expectedErr    error
+	}{
+		{
+			name: "all facets",
+			cfg: mockFeaturesSearchFacetCountsConfig{
+				expectedNode: nil,
+				expectedFacets: []gcpspanner.FeatureSearchFacet{
+					gcpspanner.FeatureSearchFacetBaselineStatus,
+					gcpspanner.FeatureSearchFacetAvailableOn,
+					gcpspanner.FeatureSearchFacetGroup,
+				},
+				result: []gcpspanner.FeatureSearchFacetCount{
+					{Facet: gcpspanner.FeatureSearchFacetAvailableOn, Value: "chrome", Count: 5},
+					{Facet: gcpspanner.FeatureSearchFacetAvailableOn, Value: "safari", Count: 2},
+					{Facet: gcpspanner.FeatureSearchFacetBaselineStatus, Value: "high", Count: 4},
+					{Facet: gcpspanner.FeatureSearchFacetBaselineStatus, Value: "low", Count: 2},
+					{Facet: gcpspanner.FeatureSearchFacetBaselineStatus, Value: "none", Count: 1},
+				},
+				returnedError: nil,
+			},
+			facets: []backend.FeatureSearchFacet{backend.FacetBaselineStatus, backend.FacetAvailableOn, backend.FacetGroup},
+			expectedFacets: &backend.FeatureSearchFacets{
+				AvailableOn: &[]backend.FeatureSearchFacetCount{
+					{Value: "chrome", Count: 5},
+					{Value: "safari", Count: 2},
+				},
+				BaselineStatus: &[]backend.FeatureSearchFacetCount{
+					{Value: "widely", Count: 4},
+					{Value: "newly", Count: 2},
+					{Value: "limited", Count: 1},
+				},
+				Group: &[]backend.FeatureSearchFacetCount{},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "only requested facets are present",
+			cfg: mockFeaturesSearchFacetCountsConfig{
+				expectedNode:   nil,
+				expectedFacets: []gcpspanner.FeatureSearchFacet{gcpspanner.FeatureSearchFacetGroup},
+				result: []gcpspanner.FeatureSearchFacetCount{
+					{Facet: gcpspanner.FeatureSearchFacetGroup, Value: "css", Count: 3},
+				},
+				returnedError: nil,
+			},
+			facets: []backend.FeatureSearchFacet{backend.FacetGroup},
+			expectedFacets: &backend.FeatureSearchFacets{
+				AvailableOn:    nil,
+				BaselineStatus: nil,
+				Group:          &[]backend.FeatureSearchFacetCount{{Value: "css", Count: 3}},
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error",
+			cfg: mockFeaturesSearchFacetCountsConfig{
+				expectedNode:   nil,
+				expectedFacets: []gcpspanner.FeatureSearchFacet{gcpspanner.FeatureSearchFacetGroup},
+				result:         nil,
+				returnedError:  errTest,
+			},
+			facets:         []backend.FeatureSearchFacet{backend.FacetGroup},
+			expectedFacets: nil,
+			expectedErr:    errTest,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                                t,
+				mockFeaturesSearchFacetCountsCfg: &tc.cfg,
+			}
+			bk := NewBackend(mock)
+			facets, err := bk.FeaturesSearchFacets(context.Background(), nil, tc.facets, nil)
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("unexpected error %v", err)
+			}
+			if !reflect.DeepEqual(facets, tc.expectedFacets) {
+				t.Errorf("unexpected facets\nexpected: %+v\nreceived: %+v", tc.expectedFacets, facets)
+			}
+		})
+	}
+}
+
 // CompareFeatures checks if two backend.Feature structs are deeply equal.
 func CompareFeatures(f1, f2 backend.Feature) bool {
 	// 1. Basic Equality Checks
@@ -2205,502 +3065,605 @@ func TestGetFeature(t *testing.T) {
 	}
 }
 
-// TestRegularFeatureVisitor expects a RegularFeatureResult and compares it.
-// Other Visit methods will cause an error.
-type TestRegularFeatureVisitor struct {
-	t        *testing.T
-	expected *backendtypes.RegularFeatureResult
-}
-
-func (v *TestRegularFeatureVisitor) VisitRegularFeature(_ context.Context,
-	actual backendtypes.RegularFeatureResult) error {
-	if !CompareFeatures(*actual.Feature(), *v.expected.Feature()) {
-		v.t.Error("unexpected feature")
-	}
-
-	return nil
-}
-
-func (v *TestRegularFeatureVisitor) VisitMovedFeature(_ context.Context, actual backendtypes.MovedFeatureResult) error {
-	v.t.Errorf("VisitMovedFeature called unexpectedly for a RegularFeature test. Actual: %+v", actual)
-
-	return nil
-}
-
-func (v *TestRegularFeatureVisitor) VisitSplitFeature(_ context.Context, actual backendtypes.SplitFeatureResult) error {
-	v.t.Errorf("VisitSplitFeature called unexpectedly for a RegularFeature test. Actual: %+v", actual)
-
-	return nil
-}
-
-// TestMovedFeatureVisitor expects a MovedFeatureResult and compares it.
-// Other Visit methods will cause an error.
-type TestMovedFeatureVisitor struct {
-	t        *testing.T
-	expected backendtypes.MovedFeatureResult
-}
-
-func (v *TestMovedFeatureVisitor) VisitMovedFeature(_ context.Context, actual backendtypes.MovedFeatureResult) error {
-	if !reflect.DeepEqual(v.expected, actual) {
-		v.t.Errorf("MovedFeature mismatch:\nExpected: %+v\nActual:   %+v", v.expected, actual)
+func TestBatchGetFeatures(t *testing.T) {
+	idNode := func(id string) *searchtypes.SearchNode {
+		return &searchtypes.SearchNode{
+			Keyword: searchtypes.KeywordNone,
+			Term: &searchtypes.SearchTerm{
+				Identifier: searchtypes.IdentifierID,
+				Operator:   searchtypes.OperatorEq,
+				Value:      id,
+			},
+			Children: nil,
+		}
 	}
+	searchNode := func(ids ...string) *searchtypes.SearchNode {
+		children := make([]*searchtypes.SearchNode, 0, len(ids))
+		for _, id := range ids {
+			children = append(children, idNode(id))
+		}
 
-	return nil
-}
-
-func (v *TestMovedFeatureVisitor) VisitRegularFeature(_ context.Context,
-	actual backendtypes.RegularFeatureResult) error {
-	v.t.Errorf("VisitRegularFeature called unexpectedly for a MovedFeature test. Actual: %+v", actual)
-
-	return nil
-}
-
-func (v *TestMovedFeatureVisitor) VisitSplitFeature(_ context.Context, actual backendtypes.SplitFeatureResult) error {
-	v.t.Errorf("VisitSplitFeature called unexpectedly for a MovedFeature test. Actual: %+v", actual)
-
-	return nil
-}
-
-// TestSplitFeatureVisitor expects a SplitFeatureResult and compares it.
-// Other Visit methods will cause an error.
-type TestSplitFeatureVisitor struct {
-	t        *testing.T
-	expected backendtypes.SplitFeatureResult
-}
-
-func (v *TestSplitFeatureVisitor) VisitSplitFeature(_ context.Context, actual backendtypes.SplitFeatureResult) error {
-	if !reflect.DeepEqual(v.expected, actual) {
-		v.t.Errorf("SplitFeature mismatch:\nExpected: %+v\nActual:   %+v", v.expected, actual)
+		return &searchtypes.SearchNode{
+			Keyword: searchtypes.KeywordRoot,
+			Term:    nil,
+			Children: []*searchtypes.SearchNode{
+				{
+					Keyword:  searchtypes.KeywordOR,
+					Term:     nil,
+					Children: children,
+				},
+			},
+		}
 	}
-
-	return nil
-}
-
-func (v *TestSplitFeatureVisitor) VisitRegularFeature(_ context.Context,
-	actual backendtypes.RegularFeatureResult) error {
-	v.t.Errorf("VisitRegularFeature called unexpectedly for a SplitFeature test. Actual: %+v", actual)
-
-	return nil
-}
-
-func (v *TestSplitFeatureVisitor) VisitMovedFeature(_ context.Context, actual backendtypes.MovedFeatureResult) error {
-	v.t.Errorf("VisitMovedFeature called unexpectedly for a SplitFeature test. Actual: %+v", actual)
-
-	return nil
-}
-
-func TestGetNotificationChannel(t *testing.T) {
-	const (
-		userID    = "user123"
-		channelID = "channel456"
-	)
-	now := time.Now()
-
 	testCases := []struct {
-		name          string
-		cfg           *mockGetNotificationChannelConfig
-		expected      *backend.NotificationChannelResponse
-		expectedError error
+		name            string
+		featureIDs      []string
+		searchCfg       mockFeaturesSearchConfig
+		movedFeatureCfg *mockGetMovedWebFeatureDetailsByOriginalFeatureKeyConfig
+		splitFeatureCfg *mockGetSplitWebFeatureByOriginalFeatureKeyConfig
+		expectedIDs     []string
+		visitors        map[string]func(t *testing.T) backendtypes.FeatureResultVisitor
+		expectedError   error
 	}{
 		{
-			name: "success",
-			cfg: &mockGetNotificationChannelConfig{
-				expectedChannelID: channelID,
-				expectedUserID:    userID,
-				result: &gcpspanner.NotificationChannel{
-					ID:     channelID,
-					UserID: userID,
-					Name:   "My Email",
-					Type:   "email",
-					EmailConfig: &gcpspanner.EmailConfig{
-						Address:           "test@example.com",
-						IsVerified:        false,
-						VerificationToken: nil,
+			name:       "found and moved",
+			featureIDs: []string{"Feature1", "feature2"},
+			searchCfg: mockFeaturesSearchConfig{
+				expectedPageToken:     nil,
+				expectedPageSize:      2,
+				expectedSortable:      gcpspanner.NewBaselineStatusSort(false),
+				expectedNode:          searchNode("Feature1", "feature2"),
+				expectedWPTMetricView: gcpspanner.WPTSubtestView,
+				expectedBrowsers:      []string{"browser1"},
+				result: &gcpspanner.FeatureResultPage{
+					Total:         1,
+					NextPageToken: nil,
+					Features: []gcpspanner.FeatureResult{
+						{
+							Name:                       "feature 1",
+							FeatureKey:                 "feature1",
+							Status:                     new("low"),
+							LowDate:                    nil,
+							HighDate:                   nil,
+							StableMetrics:              nil,
+							ExperimentalMetrics:        nil,
+							ImplementationStatuses:     nil,
+							SpecLinks:                  nil,
+							ChromiumUsage:              nil,
+							DeveloperSignalUpvotes:     nil,
+							DeveloperSignalLink:        nil,
+							Alternatives:               nil,
+							AccordingTo:                nil,
+							VendorPositions:            spanner.NullJSON{Value: nil, Valid: false},
+							SystemManagedSavedSearchID: nil,
+						},
 					},
-					WebhookConfig: nil,
-					CreatedAt:     now,
-					UpdatedAt:     now,
 				},
 				returnedError: nil,
 			},
-			expected: &backend.NotificationChannelResponse{
-				Id:   channelID,
-				Name: "My Email",
-				Type: backend.NotificationChannelResponseTypeEmail,
-				Config: newTestNotificationChannelConfig(t, backend.EmailConfig{
-					Type:    backend.EmailConfigTypeEmail,
-					Address: "test@example.com",
-				}),
-				Status:    backend.NotificationChannelStatusEnabled,
-				CreatedAt: now,
-				UpdatedAt: now,
+			movedFeatureCfg: &mockGetMovedWebFeatureDetailsByOriginalFeatureKeyConfig{
+				expectedFeatureKey: "feature2",
+				result: &gcpspanner.MovedWebFeature{
+					OriginalFeatureKey: "feature2",
+					NewFeatureKey:      "feature3",
+				},
+				returnedError: nil,
+			},
+			splitFeatureCfg: nil,
+			expectedIDs:     []string{"Feature1", "feature2"},
+			visitors: map[string]func(t *testing.T) backendtypes.FeatureResultVisitor{
+				"Feature1": func(t *testing.T) backendtypes.FeatureResultVisitor {
+					return &TestRegularFeatureVisitor{
+						t: t,
+						expected: backendtypes.NewRegularFeatureResult(&backend.Feature{
+							Baseline: &backend.BaselineInfo{
+								Status:   new(backend.Newly),
+								LowDate:  nil,
+								HighDate: nil,
+							},
+							FeatureId: "feature1",
+							Name:      "feature 1",
+							Spec:      nil,
+							Usage: &backend.BrowserUsage{
+								Chrome: &backend.ChromeUsageInfo{
+									Daily: nil,
+								},
+							},
+							Wpt:                        nil,
+							BrowserImplementations:     nil,
+							DeveloperSignals:           nil,
+							Discouraged:                nil,
+							VendorPositions:            nil,
+							SystemManagedSavedSearchId: nil,
+						}),
+					}
+				},
+				"feature2": func(t *testing.T) backendtypes.FeatureResultVisitor {
+					return &TestMovedFeatureVisitor{
+						t:        t,
+						expected: *backendtypes.NewMovedFeatureResult("feature3"),
+					}
+				},
 			},
-
 			expectedError: nil,
 		},
 		{
-			name: "not found",
-			cfg: &mockGetNotificationChannelConfig{
-				expectedChannelID: channelID,
-				expectedUserID:    userID,
-				result:            nil,
-				returnedError:     gcpspanner.ErrQueryReturnedNoResults,
+			name:       "not found",
+			featureIDs: []string{"feature2"},
+			searchCfg: mockFeaturesSearchConfig{
+				expectedPageToken:     nil,
+				expectedPageSize:      1,
+				expectedSortable:      gcpspanner.NewBaselineStatusSort(false),
+				expectedNode:          searchNode("feature2"),
+				expectedWPTMetricView: gcpspanner.WPTSubtestView,
+				expectedBrowsers:      []string{"browser1"},
+				result: &gcpspanner.FeatureResultPage{
+					Total:         0,
+					NextPageToken: nil,
+					Features:      nil,
+				},
+				returnedError: nil,
 			},
-			expected:      nil,
-			expectedError: backendtypes.ErrEntityDoesNotExist,
+			movedFeatureCfg: &mockGetMovedWebFeatureDetailsByOriginalFeatureKeyConfig{
+				expectedFeatureKey: "feature2",
+				result:             nil,
+				returnedError:      gcpspanner.ErrQueryReturnedNoResults,
+			},
+			splitFeatureCfg: &mockGetSplitWebFeatureByOriginalFeatureKeyConfig{
+				expectedFeatureKey: "feature2",
+				result:             nil,
+				returnedError:      gcpspanner.ErrQueryReturnedNoResults,
+			},
+			expectedIDs:   []string{},
+			visitors:      nil,
+			expectedError: nil,
 		},
 		{
-			name: "not authorized",
-			cfg: &mockGetNotificationChannelConfig{
-				expectedChannelID: channelID,
-				expectedUserID:    userID,
-				result:            nil,
-				returnedError:     gcpspanner.ErrMissingRequiredRole,
+			name:       "search error",
+			featureIDs: []string{"feature1"},
+			searchCfg: mockFeaturesSearchConfig{
+				expectedPageToken:     nil,
+				expectedPageSize:      1,
+				expectedSortable:      gcpspanner.NewBaselineStatusSort(false),
+				expectedNode:          searchNode("feature1"),
+				expectedWPTMetricView: gcpspanner.WPTSubtestView,
+				expectedBrowsers:      []string{"browser1"},
+				result:                nil,
+				returnedError:         errTest,
 			},
-			expected:      nil,
-			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
+			movedFeatureCfg: nil,
+			splitFeatureCfg: nil,
+			expectedIDs:     nil,
+			visitors:        nil,
+			expectedError:   errTest,
 		},
 		{
-			name: "other error",
-			cfg: &mockGetNotificationChannelConfig{
-				expectedChannelID: channelID,
-				expectedUserID:    userID,
-				result:            nil,
-				returnedError:     errTest,
+			name:       "moved lookup error",
+			featureIDs: []string{"feature2"},
+			searchCfg: mockFeaturesSearchConfig{
+				expectedPageToken:     nil,
+				expectedPageSize:      1,
+				expectedSortable:      gcpspanner.NewBaselineStatusSort(false),
+				expectedNode:          searchNode("feature2"),
+				expectedWPTMetricView: gcpspanner.WPTSubtestView,
+				expectedBrowsers:      []string{"browser1"},
+				result: &gcpspanner.FeatureResultPage{
+					Total:         0,
+					NextPageToken: nil,
+					Features:      nil,
+				},
+				returnedError: nil,
 			},
-			expected:      nil,
-			expectedError: errTest,
+			movedFeatureCfg: &mockGetMovedWebFeatureDetailsByOriginalFeatureKeyConfig{
+				expectedFeatureKey: "feature2",
+				result:             nil,
+				returnedError:      errTest,
+			},
+			splitFeatureCfg: nil,
+			expectedIDs:     nil,
+			visitors:        nil,
+			expectedError:   errTest,
 		},
 	}
-
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			//nolint: exhaustruct
 			mock := mockBackendSpannerClient{
-				t:                             t,
-				mockGetNotificationChannelCfg: tc.cfg,
+				t:                     t,
+				mockFeaturesSearchCfg: tc.searchCfg,
+				mockGetMovedWebFeatureDetailsByOriginalFeatureKeyCfg: tc.movedFeatureCfg,
+				mockGetSplitWebFeatureByOriginalFeatureKeyCfg:        tc.splitFeatureCfg,
 			}
-			b := NewBackend(mock)
-			resp, err := b.GetNotificationChannel(context.Background(), userID, channelID)
+			bk := NewBackend(mock)
+			results, err := bk.BatchGetFeatures(
+				t.Context(), tc.featureIDs, backend.SubtestCounts, []backend.BrowserPathParam{"browser1"})
 			if !errors.Is(err, tc.expectedError) {
-				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+				t.Errorf("unexpected error %v", err)
 			}
-			if diff := cmp.Diff(tc.expected, resp, getNotificationChannelCmpOption()); diff != "" {
-				t.Errorf("response mismatch (-want +got):\n%s", diff)
+			if tc.expectedError != nil {
+				return
+			}
+			if len(results) != len(tc.expectedIDs) {
+				t.Fatalf("expected %d results, got %d", len(tc.expectedIDs), len(results))
+			}
+			for _, id := range tc.expectedIDs {
+				result, found := results[id]
+				if !found {
+					t.Fatalf("missing result for %s", id)
+				}
+				err = result.Visit(t.Context(), tc.visitors[id](t))
+				if err != nil {
+					t.Error("unexpected error")
+				}
 			}
 		})
 	}
 }
 
-func TestCreateNotificationChannel(t *testing.T) {
-	const (
-		userID    = "user123"
-		channelID = "channel456"
-	)
-	now := time.Now()
-
+func TestGetFeatureTimeline(t *testing.T) {
+	chromeRelease := time.Date(2017, 3, 9, 0, 0, 0, 0, time.UTC)
+	firefoxRelease := time.Date(2017, 3, 7, 0, 0, 0, 0, time.UTC)
+	lowDate := time.Date(2017, 10, 17, 0, 0, 0, 0, time.UTC)
+	highDate := time.Date(2020, 4, 17, 0, 0, 0, 0, time.UTC)
+	renamed := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	moved := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
 	testCases := []struct {
-		name              string
-		req               backend.CreateNotificationChannelRequest
-		cfg               *mockCreateNotificationChannelConfig
-		expected          *backend.NotificationChannelResponse
-		expectedGetResult *gcpspanner.NotificationChannel
-		expectedError     error
+		name          string
+		cfg           *mockGetFeatureTimelineConfig
+		expected      *backend.FeatureTimeline
+		expectedError error
 	}{
 		{
-			name: "success webhook",
-			req: backend.CreateNotificationChannelRequest{
-				Name: "My Webhook",
-				Config: newTestCreateNotificationChannelConfig(t, backend.WebhookConfig{
-					Type: backend.Webhook,
-					Url:  "https://hooks.slack.com/services/123",
-				}),
-			},
-
-			cfg: &mockCreateNotificationChannelConfig{
-				expectedRequest: gcpspanner.CreateNotificationChannelRequest{
-					UserID:      userID,
-					Name:        "My Webhook",
-					Type:        gcpspanner.NotificationChannelTypeWebhook,
-					EmailConfig: nil,
-					WebhookConfig: &gcpspanner.WebhookConfig{
-						URL: "https://hooks.slack.com/services/123",
+			name: "success",
+			cfg: &mockGetFeatureTimelineConfig{
+				expectedFeatureKey: "grid",
+				result: &gcpspanner.FeatureTimeline{
+					BrowserReleases: []gcpspanner.FeatureTimelineBrowserRelease{
+						{BrowserName: "firefox", BrowserVersion: "52", ReleaseDate: firefoxRelease},
+						{BrowserName: "chrome", BrowserVersion: "57", ReleaseDate: chromeRelease},
+					},
+					BaselineLowDate:      &lowDate,
+					BaselineHighDate:     &highDate,
+					MovedFromFeatureKeys: []string{"css-grid", "old-grid"},
+					SplitFromFeatureKeys: []string{"layout"},
+					Changes: []gcpspanner.FeatureTimelineChange{
+						{Timestamp: renamed, OldName: new("CSS Grid"), NewName: new("Grid"), OldFeatureKey: nil},
+						{Timestamp: moved, OldName: nil, NewName: nil, OldFeatureKey: new("css-grid")},
 					},
 				},
-
-				result:        new(channelID),
 				returnedError: nil,
 			},
-			expectedGetResult: &gcpspanner.NotificationChannel{
-				ID:            channelID,
-				UserID:        userID,
-				Name:          "My Webhook",
-				Type:          gcpspanner.NotificationChannelTypeWebhook,
-				EmailConfig:   nil,
-				WebhookConfig: &gcpspanner.WebhookConfig{URL: "https://hooks.slack.com/services/123"},
-				CreatedAt:     now,
-				UpdatedAt:     now,
+			expected: &backend.FeatureTimeline{
+				FeatureId: "grid",
+				Events: []backend.FeatureTimelineEvent{
+					{
+						Type:              backend.TimelineBrowserImplementation,
+						Date:              &firefoxRelease,
+						Browser:           new("firefox"),
+						BrowserVersion:    new("52"),
+						OldName:           nil,
+						NewName:           nil,
+						PreviousFeatureId: nil,
+					},
+					{
+						Type:              backend.TimelineBrowserImplementation,
+						Date:              &chromeRelease,
+						Browser:           new("chrome"),
+						BrowserVersion:    new("57"),
+						OldName:           nil,
+						NewName:           nil,
+						PreviousFeatureId: nil,
+					},
+					newDatedTimelineEvent(backend.TimelineBaselineLow, lowDate),
+					{
+						Type:              backend.TimelineRenamed,
+						Date:              &renamed,
+						Browser:           nil,
+						BrowserVersion:    nil,
+						OldName:           new("CSS Grid"),
+						NewName:           new("Grid"),
+						PreviousFeatureId: nil,
+					},
+					{
+						Type:              backend.TimelineMovedFrom,
+						Date:              &moved,
+						Browser:           nil,
+						BrowserVersion:    nil,
+						OldName:           nil,
+						NewName:           nil,
+						PreviousFeatureId: new("css-grid"),
+					},
+					newDatedTimelineEvent(backend.TimelineBaselineHigh, highDate),
+					newUndatedReplacementTimelineEvent(backend.TimelineMovedFrom, "old-grid"),
+					newUndatedReplacementTimelineEvent(backend.TimelineSplitFrom, "layout"),
+				},
 			},
-			expected: &backend.NotificationChannelResponse{
-				Id:   channelID,
-				Name: "My Webhook",
-				Type: backend.NotificationChannelResponseTypeWebhook,
-				Config: newTestNotificationChannelConfig(t, backend.WebhookConfig{
-					Type: backend.Webhook,
-					Url:  "https://hooks.slack.com/services/123",
-				}),
-				Status:    backend.NotificationChannelStatusEnabled,
-				CreatedAt: now,
-				UpdatedAt: now,
+			expectedError: nil,
+		},
+		{
+			name: "no history",
+			cfg: &mockGetFeatureTimelineConfig{
+				expectedFeatureKey: "grid",
+				result: &gcpspanner.FeatureTimeline{
+					BrowserReleases:      nil,
+					BaselineLowDate:      nil,
+					BaselineHighDate:     nil,
+					MovedFromFeatureKeys: nil,
+					SplitFromFeatureKeys: nil,
+					Changes:              nil,
+				},
+				returnedError: nil,
+			},
+			expected: &backend.FeatureTimeline{
+				FeatureId: "grid",
+				Events:    []backend.FeatureTimelineEvent{},
 			},
-
 			expectedError: nil,
 		},
+		{
+			name: "not found",
+			cfg: &mockGetFeatureTimelineConfig{
+				expectedFeatureKey: "grid",
+				result:             nil,
+				returnedError:      gcpspanner.ErrQueryReturnedNoResults,
+			},
+			expected:      nil,
+			expectedError: backendtypes.ErrEntityDoesNotExist,
+		},
+		{
+			name: "error",
+			cfg: &mockGetFeatureTimelineConfig{
+				expectedFeatureKey: "grid",
+				result:             nil,
+				returnedError:      errTest,
+			},
+			expected:      nil,
+			expectedError: errTest,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			// nolint: exhaustruct
-			mock := &mockBackendSpannerClient{
-				t:                                t,
-				mockCreateNotificationChannelCfg: tc.cfg,
-				// Mock the Get call that happens after Create
-				mockGetNotificationChannelCfg: &mockGetNotificationChannelConfig{
-					expectedUserID:    userID,
-					expectedChannelID: channelID,
-					result:            tc.expectedGetResult,
-					returnedError:     nil,
-				},
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                         t,
+				mockGetFeatureTimelineCfg: tc.cfg,
 			}
-			b := NewBackend(mock)
-			resp, err := b.CreateNotificationChannel(context.Background(), userID, tc.req)
+			bk := NewBackend(mock)
+			timeline, err := bk.GetFeatureTimeline(t.Context(), "grid")
 			if !errors.Is(err, tc.expectedError) {
-				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+				t.Errorf("unexpected error %v", err)
 			}
-			if diff := cmp.Diff(tc.expected, resp, getNotificationChannelCmpOption()); diff != "" {
-				t.Errorf("response mismatch (-want +got):\n%s", diff)
+			if !reflect.DeepEqual(tc.expected, timeline) {
+				t.Errorf("unexpected timeline\nexpected: %+v\nreceived: %+v", tc.expected, timeline)
 			}
 		})
 	}
 }
 
-func TestUpdateNotificationChannel(t *testing.T) {
-	const (
-		userID    = "user123"
-		channelID = "channel456"
-	)
-	now := time.Now()
-
+func TestGetBrowserReleaseChannelVersion(t *testing.T) {
 	testCases := []struct {
-		name              string
-		req               backend.UpdateNotificationChannelRequest
-		cfg               *mockUpdateNotificationChannelConfig
-		expected          *backend.NotificationChannelResponse
-		expectedGetResult *gcpspanner.NotificationChannel
-		expectedError     error
+		name          string
+		cfg           *mockGetOldestBrowserReleaseVersionWithStatusConfig
+		expected      string
+		expectedError error
 	}{
 		{
-			name: "success update name",
-			req: backend.UpdateNotificationChannelRequest{
-				UpdateMask: []backend.UpdateNotificationChannelRequestUpdateMask{
-					backend.UpdateNotificationChannelRequestMaskName,
-				},
-				Name:   new("New Name"),
-				Config: nil,
+			name: "success",
+			cfg: &mockGetOldestBrowserReleaseVersionWithStatusConfig{
+				expectedBrowserName: "firefox",
+				expectedStatus:      "esr",
+				result:              "128",
+				returnedError:       nil,
 			},
-			cfg: &mockUpdateNotificationChannelConfig{
-				expectedRequest: gcpspanner.UpdateNotificationChannelRequest{
-					ID:     channelID,
-					UserID: userID,
-					Name:   gcpspanner.OptionallySet[string]{Value: "New Name", IsSet: true},
-					Type: gcpspanner.OptionallySet[gcpspanner.NotificationChannelType]{
-						Value: "",
-						IsSet: false,
-					},
-					EmailConfig:   gcpspanner.OptionallySet[*gcpspanner.EmailConfig]{Value: nil, IsSet: false},
-					WebhookConfig: gcpspanner.OptionallySet[*gcpspanner.WebhookConfig]{Value: nil, IsSet: false},
-				},
-				returnedError: nil,
+			expected:      "128",
+			expectedError: nil,
+		},
+		{
+			name: "no release in the channel",
+			cfg: &mockGetOldestBrowserReleaseVersionWithStatusConfig{
+				expectedBrowserName: "firefox",
+				expectedStatus:      "esr",
+				result:              "",
+				returnedError:       gcpspanner.ErrQueryReturnedNoResults,
 			},
-			expectedGetResult: &gcpspanner.NotificationChannel{
-				ID:            channelID,
-				UserID:        userID,
-				Name:          "New Name",
-				Type:          gcpspanner.NotificationChannelTypeWebhook,
-				EmailConfig:   nil,
-				WebhookConfig: &gcpspanner.WebhookConfig{URL: "https://hooks.slack.com/services/123"},
-				CreatedAt:     now,
-				UpdatedAt:     now,
-			},
-			expected: &backend.NotificationChannelResponse{
-				Id:   channelID,
-				Name: "New Name",
-				Type: backend.NotificationChannelResponseTypeWebhook,
-				Config: newTestNotificationChannelConfig(t, backend.WebhookConfig{
-					Type: backend.Webhook,
-					Url:  "https://hooks.slack.com/services/123",
-				}),
-				Status:    backend.NotificationChannelStatusEnabled,
-				CreatedAt: now,
-				UpdatedAt: now,
-			},
-
-			expectedError: nil,
-		},
-		{
-			name: "error update email channel",
-			req: backend.UpdateNotificationChannelRequest{
-				UpdateMask: []backend.UpdateNotificationChannelRequestUpdateMask{
-					backend.UpdateNotificationChannelRequestMaskName,
-				},
-				Name:   new("New Name"),
-				Config: nil,
-			},
-			cfg: nil, // Should not be called
-			expectedGetResult: &gcpspanner.NotificationChannel{
-				ID:     channelID,
-				UserID: userID,
-				Name:   "Old Name",
-				Type:   gcpspanner.NotificationChannelTypeEmail,
-				EmailConfig: &gcpspanner.EmailConfig{
-					Address:           "test@example.com",
-					IsVerified:        false,
-					VerificationToken: nil,
-				},
-				WebhookConfig: nil,
-				CreatedAt:     now,
-				UpdatedAt:     now,
-			},
-			expected:      nil,
-			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
+			expected:      "",
+			expectedError: backendtypes.ErrEntityDoesNotExist,
 		},
 		{
-			name: "success update config to webhook",
-			req: backend.UpdateNotificationChannelRequest{
-				UpdateMask: []backend.UpdateNotificationChannelRequestUpdateMask{
-					backend.UpdateNotificationChannelRequestMaskConfig,
-				},
-				Name: nil,
-				Config: newTestUpdateNotificationChannelRequestConfig(t, backend.WebhookConfig{
-					Type: backend.Webhook,
-					Url:  "https://hooks.slack.com/services/456",
-				}),
-			},
-			cfg: &mockUpdateNotificationChannelConfig{
-				expectedRequest: gcpspanner.UpdateNotificationChannelRequest{
-					ID:     channelID,
-					UserID: userID,
-					Name:   gcpspanner.OptionallySet[string]{Value: "", IsSet: false},
-					Type: gcpspanner.OptionallySet[gcpspanner.NotificationChannelType]{
-						Value: gcpspanner.NotificationChannelTypeWebhook,
-						IsSet: true,
-					},
-					EmailConfig: gcpspanner.OptionallySet[*gcpspanner.EmailConfig]{Value: nil, IsSet: false},
-					WebhookConfig: gcpspanner.OptionallySet[*gcpspanner.WebhookConfig]{
-						Value: &gcpspanner.WebhookConfig{URL: "https://hooks.slack.com/services/456"},
-						IsSet: true,
-					},
-				},
-				returnedError: nil,
-			},
-			expectedGetResult: &gcpspanner.NotificationChannel{
-				ID:            channelID,
-				UserID:        userID,
-				Name:          "My Email",
-				Type:          gcpspanner.NotificationChannelTypeWebhook,
-				EmailConfig:   nil,
-				WebhookConfig: &gcpspanner.WebhookConfig{URL: "https://hooks.slack.com/services/456"},
-				CreatedAt:     now,
-				UpdatedAt:     now,
-			},
-			expected: &backend.NotificationChannelResponse{
-				Id:   channelID,
-				Name: "My Email", // Name didn't change
-				Type: backend.NotificationChannelResponseTypeWebhook,
-				Config: newTestNotificationChannelConfig(t, backend.WebhookConfig{
-					Type: backend.Webhook,
-					Url:  "https://hooks.slack.com/services/456",
-				}),
-				Status:    backend.NotificationChannelStatusEnabled,
-				CreatedAt: now,
-				UpdatedAt: now,
+			name: "error",
+			cfg: &mockGetOldestBrowserReleaseVersionWithStatusConfig{
+				expectedBrowserName: "firefox",
+				expectedStatus:      "esr",
+				result:              "",
+				returnedError:       errTest,
 			},
-
-			expectedError: nil,
+			expected:      "",
+			expectedError: errTest,
 		},
 	}
-
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			// nolint: exhaustruct
-			mock := &mockBackendSpannerClient{
-				t:                                t,
-				mockUpdateNotificationChannelCfg: tc.cfg,
-				// Mock the Get call that happens after Update
-				mockGetNotificationChannelCfg: &mockGetNotificationChannelConfig{
-					expectedUserID:    userID,
-					expectedChannelID: channelID,
-					result:            tc.expectedGetResult,
-					returnedError:     nil,
-				},
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t: t,
+				mockGetOldestBrowserReleaseVersionWithStatusCfg: tc.cfg,
 			}
-
-			b := NewBackend(mock)
-			resp, err := b.UpdateNotificationChannel(context.Background(), userID, channelID, tc.req)
+			bk := NewBackend(mock)
+			version, err := bk.GetBrowserReleaseChannelVersion(t.Context(), backend.Firefox, "esr")
 			if !errors.Is(err, tc.expectedError) {
-				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+				t.Errorf("unexpected error %v", err)
 			}
-			if diff := cmp.Diff(tc.expected, resp, getNotificationChannelCmpOption()); diff != "" {
-				t.Errorf("response mismatch (-want +got):\n%s", diff)
+			if version != tc.expected {
+				t.Errorf("unexpected version. expected %s received %s", tc.expected, version)
 			}
 		})
 	}
 }
 
-func TestDeleteNotificationChannel(t *testing.T) {
+// TestRegularFeatureVisitor expects a RegularFeatureResult and compares it.
+// Other Visit methods will cause an error.
+type TestRegularFeatureVisitor struct {
+	t        *testing.T
+	expected *backendtypes.RegularFeatureResult
+}
+
+func (v *TestRegularFeatureVisitor) VisitRegularFeature(_ context.Context,
+	actual backendtypes.RegularFeatureResult) error {
+	if !CompareFeatures(*actual.Feature(), *v.expected.Feature()) {
+		v.t.Error("unexpected feature")
+	}
+
+	return nil
+}
+
+func (v *TestRegularFeatureVisitor) VisitMovedFeature(_ context.Context, actual backendtypes.MovedFeatureResult) error {
+	v.t.Errorf("VisitMovedFeature called unexpectedly for a RegularFeature test. Actual: %+v", actual)
+
+	return nil
+}
+
+func (v *TestRegularFeatureVisitor) VisitSplitFeature(_ context.Context, actual backendtypes.SplitFeatureResult) error {
+	v.t.Errorf("VisitSplitFeature called unexpectedly for a RegularFeature test. Actual: %+v", actual)
+
+	return nil
+}
+
+// TestMovedFeatureVisitor expects a MovedFeatureResult and compares it.
+// Other Visit methods will cause an error.
+type TestMovedFeatureVisitor struct {
+	t        *testing.T
+	expected backendtypes.MovedFeatureResult
+}
+
+func (v *TestMovedFeatureVisitor) VisitMovedFeature(_ context.Context, actual backendtypes.MovedFeatureResult) error {
+	if !reflect.DeepEqual(v.expected, actual) {
+		v.t.Errorf("MovedFeature mismatch:\nExpected: %+v\nActual:   %+v", v.expected, actual)
+	}
+
+	return nil
+}
+
+func (v *TestMovedFeatureVisitor) VisitRegularFeature(_ context.Context,
+	actual backendtypes.RegularFeatureResult) error {
+	v.t.Errorf("VisitRegularFeature called unexpectedly for a MovedFeature test. Actual: %+v", actual)
+
+	return nil
+}
+
+func (v *TestMovedFeatureVisitor) VisitSplitFeature(_ context.Context, actual backendtypes.SplitFeatureResult) error {
+	v.t.Errorf("VisitSplitFeature called unexpectedly for a MovedFeature test. Actual: %+v", actual)
+
+	return nil
+}
+
+// TestSplitFeatureVisitor expects a SplitFeatureResult and compares it.
+// Other Visit methods will cause an error.
+type TestSplitFeatureVisitor struct {
+	t        *testing.T
+	expected backendtypes.SplitFeatureResult
+}
+
+func (v *TestSplitFeatureVisitor) VisitSplitFeature(_ context.Context, actual backendtypes.SplitFeatureResult) error {
+	if !reflect.DeepEqual(v.expected, actual) {
+		v.t.Errorf("SplitFeature mismatch:\nExpected: %+v\nActual:   %+v", v.expected, actual)
+	}
+
+	return nil
+}
+
+func (v *TestSplitFeatureVisitor) VisitRegularFeature(_ context.Context,
+	actual backendtypes.RegularFeatureResult) error {
+	v.t.Errorf("VisitRegularFeature called unexpectedly for a SplitFeature test. Actual: %+v", actual)
+
+	return nil
+}
+
+func (v *TestSplitFeatureVisitor) VisitMovedFeature(_ context.Context, actual backendtypes.MovedFeatureResult) error {
+	v.t.Errorf("VisitMovedFeature called unexpectedly for a SplitFeature test. Actual: %+v", actual)
+
+	return nil
+}
+
+func TestGetNotificationChannel(t *testing.T) {
 	const (
 		userID    = "user123"
 		channelID = "channel456"
 	)
+	now := time.Now()
 
 	testCases := []struct {
 		name          string
-		cfg           *mockDeleteNotificationChannelConfig
+		cfg           *mockGetNotificationChannelConfig
+		expected      *backend.NotificationChannelResponse
 		expectedError error
 	}{
 		{
 			name: "success",
-			cfg: &mockDeleteNotificationChannelConfig{
+			cfg: &mockGetNotificationChannelConfig{
 				expectedChannelID: channelID,
 				expectedUserID:    userID,
-				returnedError:     nil,
+				result: &gcpspanner.NotificationChannel{
+					ID:     channelID,
+					UserID: userID,
+					Name:   "My Email",
+					Type:   "email",
+					EmailConfig: &gcpspanner.EmailConfig{
+						Address:           "test@example.com",
+						IsVerified:        false,
+						VerificationToken: nil,
+					},
+					WebhookConfig: nil,
+					CreatedAt:     now,
+					UpdatedAt:     now,
+				},
+				returnedError: nil,
+			},
+			expected: &backend.NotificationChannelResponse{
+				Id:   channelID,
+				Name: "My Email",
+				Type: backend.NotificationChannelResponseTypeEmail,
+				Config: newTestNotificationChannelConfig(t, backend.EmailConfig{
+					Type:    backend.EmailConfigTypeEmail,
+					Address: "test@example.com",
+				}),
+				Status:    backend.NotificationChannelStatusEnabled,
+				CreatedAt: now,
+				UpdatedAt: now,
 			},
+
 			expectedError: nil,
 		},
 		{
 			name: "not found",
-			cfg: &mockDeleteNotificationChannelConfig{
+			cfg: &mockGetNotificationChannelConfig{
 				expectedChannelID: channelID,
 				expectedUserID:    userID,
+				result:            nil,
 				returnedError:     gcpspanner.ErrQueryReturnedNoResults,
 			},
+			expected:      nil,
 			expectedError: backendtypes.ErrEntityDoesNotExist,
 		},
 		{
 			name: "not authorized",
-			cfg: &mockDeleteNotificationChannelConfig{
+			cfg: &mockGetNotificationChannelConfig{
 				expectedChannelID: channelID,
 				expectedUserID:    userID,
+				result:            nil,
 				returnedError:     gcpspanner.ErrMissingRequiredRole,
 			},
+			expected:      nil,
 			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
 		},
 		{
 			name: "other error",
-			cfg: &mockDeleteNotificationChannelConfig{
+			cfg: &mockGetNotificationChannelConfig{
 				expectedChannelID: channelID,
 				expectedUserID:    userID,
+				result:            nil,
 				returnedError:     errTest,
 			},
+			expected:      nil,
 			expectedError: errTest,
 		},
 	}
@@ -2709,178 +3672,102 @@ func TestDeleteNotificationChannel(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			//nolint: exhaustruct
 			mock := mockBackendSpannerClient{
-				t:                                t,
-				mockDeleteNotificationChannelCfg: tc.cfg,
+				t:                             t,
+				mockGetNotificationChannelCfg: tc.cfg,
 			}
 			b := NewBackend(mock)
-			err := b.DeleteNotificationChannel(context.Background(), userID, channelID)
+			resp, err := b.GetNotificationChannel(context.Background(), userID, channelID)
 			if !errors.Is(err, tc.expectedError) {
 				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
 			}
+			if diff := cmp.Diff(tc.expected, resp, getNotificationChannelCmpOption()); diff != "" {
+				t.Errorf("response mismatch (-want +got):\n%s", diff)
+			}
 		})
 	}
 }
 
-func TestListNotificationChannels(t *testing.T) {
+func TestCreateNotificationChannel(t *testing.T) {
 	const (
-		userID = "user123"
+		userID    = "user123"
+		channelID = "channel456"
 	)
 	now := time.Now()
 
 	testCases := []struct {
-		name          string
-		pageSize      int
-		pageToken     *string
-		cfg           *mockListNotificationChannelsConfig
-		expected      *backend.NotificationChannelPage
-		expectedError error
+		name              string
+		req               backend.CreateNotificationChannelRequest
+		cfg               *mockCreateNotificationChannelConfig
+		expected          *backend.NotificationChannelResponse
+		expectedGetResult *gcpspanner.NotificationChannel
+		expectedError     error
 	}{
 		{
-			name:      "success",
-			pageSize:  10,
-			pageToken: nil,
-			cfg: &mockListNotificationChannelsConfig{
-				expectedRequest: gcpspanner.ListNotificationChannelsRequest{
-					UserID:    userID,
-					PageSize:  10,
-					PageToken: nil,
-				},
-				result: []gcpspanner.NotificationChannel{
-					{
-						ID:     "id1",
-						Name:   "channel1",
-						UserID: userID,
-						Type:   "email",
-						EmailConfig: &gcpspanner.EmailConfig{
-							Address:           "b@test.com",
-							IsVerified:        false,
-							VerificationToken: nil,
-						},
-						WebhookConfig: nil,
-						CreatedAt:     now,
-						UpdatedAt:     now,
-					},
-					{
-						ID:     "id2",
-						Name:   "channel2",
-						UserID: userID,
-						Type:   "email",
-						EmailConfig: &gcpspanner.EmailConfig{
-							Address:           "a@test.com",
-							IsVerified:        false,
-							VerificationToken: nil,
-						},
-						WebhookConfig: nil,
-						CreatedAt:     now,
-						UpdatedAt:     now,
-					},
-					{
-						ID:     "id3",
-						Name:   "channel3",
-						UserID: userID,
-						Type:   "email",
-						EmailConfig: &gcpspanner.EmailConfig{
-							Address:           "c@test.com",
-							IsVerified:        false,
-							VerificationToken: nil,
-						},
-						WebhookConfig: nil,
-						CreatedAt:     now.Add(-1 * time.Hour),
-						UpdatedAt:     now.Add(-1 * time.Hour),
+			name: "success webhook",
+			req: backend.CreateNotificationChannelRequest{
+				Name: "My Webhook",
+				Config: newTestCreateNotificationChannelConfig(t, backend.WebhookConfig{
+					Type: backend.Webhook,
+					Url:  "https://hooks.slack.com/services/123",
+				}),
+			},
+
+			cfg: &mockCreateNotificationChannelConfig{
+				expectedRequest: gcpspanner.CreateNotificationChannelRequest{
+					UserID:      userID,
+					Name:        "My Webhook",
+					Type:        gcpspanner.NotificationChannelTypeWebhook,
+					EmailConfig: nil,
+					WebhookConfig: &gcpspanner.WebhookConfig{
+						URL: "https://hooks.slack.com/services/123",
 					},
 				},
-				nextPageToken: nil,
+
+				result:        new(channelID),
 				returnedError: nil,
 			},
-			expected: &backend.NotificationChannelPage{
-				Data: &[]backend.NotificationChannelResponse{
-					{
-						Id:   "id2",
-						Name: "channel2",
-						Type: backend.NotificationChannelResponseTypeEmail,
-						Config: newTestNotificationChannelConfig(t, backend.EmailConfig{
-							Type:    backend.EmailConfigTypeEmail,
-							Address: "a@test.com",
-						}),
-						Status:    backend.NotificationChannelStatusEnabled,
-						CreatedAt: now,
-						UpdatedAt: now,
-					},
-					{
-						Id:   "id1",
-						Name: "channel1",
-						Type: backend.NotificationChannelResponseTypeEmail,
-						Config: newTestNotificationChannelConfig(t, backend.EmailConfig{
-							Type:    backend.EmailConfigTypeEmail,
-							Address: "b@test.com",
-						}),
-						Status:    backend.NotificationChannelStatusEnabled,
-						CreatedAt: now,
-						UpdatedAt: now,
-					},
-					{
-						Id:   "id3",
-						Name: "channel3",
-						Type: backend.NotificationChannelResponseTypeEmail,
-						Config: newTestNotificationChannelConfig(t, backend.EmailConfig{
-							Type:    backend.EmailConfigTypeEmail,
-							Address: "c@test.com",
-						}),
-						Status:    backend.NotificationChannelStatusEnabled,
-						CreatedAt: now.Add(-1 * time.Hour),
-						UpdatedAt: now.Add(-1 * time.Hour),
-					},
-				},
-				Metadata: &backend.PageMetadata{NextPageToken: nil},
+			expectedGetResult: &gcpspanner.NotificationChannel{
+				ID:            channelID,
+				UserID:        userID,
+				Name:          "My Webhook",
+				Type:          gcpspanner.NotificationChannelTypeWebhook,
+				EmailConfig:   nil,
+				WebhookConfig: &gcpspanner.WebhookConfig{URL: "https://hooks.slack.com/services/123"},
+				CreatedAt:     now,
+				UpdatedAt:     now,
+			},
+			expected: &backend.NotificationChannelResponse{
+				Id:   channelID,
+				Name: "My Webhook",
+				Type: backend.NotificationChannelResponseTypeWebhook,
+				Config: newTestNotificationChannelConfig(t, backend.WebhookConfig{
+					Type: backend.Webhook,
+					Url:  "https://hooks.slack.com/services/123",
+				}),
+				Status:    backend.NotificationChannelStatusEnabled,
+				CreatedAt: now,
+				UpdatedAt: now,
 			},
 
 			expectedError: nil,
 		},
-		{
-			name:      "db error",
-			pageSize:  10,
-			pageToken: nil,
-			cfg: &mockListNotificationChannelsConfig{
-				expectedRequest: gcpspanner.ListNotificationChannelsRequest{
-					UserID:    userID,
-					PageSize:  10,
-					PageToken: nil,
-				},
-				result:        nil,
-				nextPageToken: nil,
-				returnedError: errTest,
-			},
-			expected:      nil,
-			expectedError: errTest,
-		},
-		{
-			name:      "invalid cursor",
-			pageSize:  10,
-			pageToken: nonNilInputPageToken,
-			cfg: &mockListNotificationChannelsConfig{
-				expectedRequest: gcpspanner.ListNotificationChannelsRequest{
-					UserID:    userID,
-					PageSize:  10,
-					PageToken: nonNilInputPageToken,
-				},
-				result:        nil,
-				nextPageToken: nil,
-				returnedError: gcpspanner.ErrInvalidCursorFormat,
-			},
-			expected:      nil,
-			expectedError: backendtypes.ErrInvalidPageToken,
-		},
 	}
-
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			//nolint: exhaustruct
-			mock := mockBackendSpannerClient{
-				t:                               t,
-				mockListNotificationChannelsCfg: tc.cfg,
+			// nolint: exhaustruct
+			mock := &mockBackendSpannerClient{
+				t:                                t,
+				mockCreateNotificationChannelCfg: tc.cfg,
+				// Mock the Get call that happens after Create
+				mockGetNotificationChannelCfg: &mockGetNotificationChannelConfig{
+					expectedUserID:    userID,
+					expectedChannelID: channelID,
+					result:            tc.expectedGetResult,
+					returnedError:     nil,
+				},
 			}
 			b := NewBackend(mock)
-			resp, err := b.ListNotificationChannels(context.Background(), userID, tc.pageSize, tc.pageToken)
+			resp, err := b.CreateNotificationChannel(context.Background(), userID, tc.req)
 			if !errors.Is(err, tc.expectedError) {
 				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
 			}
@@ -2891,539 +3778,426 @@ func TestListNotificationChannels(t *testing.T) {
 	}
 }
 
-func TestCreateUserSavedSearch(t *testing.T) {
-	testError := errors.New("test error")
+func TestUpdateNotificationChannel(t *testing.T) {
+	const (
+		userID    = "user123"
+		channelID = "channel456"
+	)
+	now := time.Now()
+
 	testCases := []struct {
-		name           string
-		createCfg      *mockCreateNewUserSavedSearchConfig
-		getCfg         *mockGetUserSavedSearchConfig
-		inputUserID    string
-		savedSearch    backend.SavedSearch
-		expectedOutput *backend.SavedSearchResponse
-		expectedError  error
+		name              string
+		req               backend.UpdateNotificationChannelRequest
+		cfg               *mockUpdateNotificationChannelConfig
+		expected          *backend.NotificationChannelResponse
+		expectedGetResult *gcpspanner.NotificationChannel
+		expectedError     error
 	}{
 		{
-			name:        "success",
-			inputUserID: "user1",
-			savedSearch: backend.SavedSearch{
-				Name:        "test search",
-				Description: new("test description"),
-				Query:       "test query",
-			},
-			createCfg: &mockCreateNewUserSavedSearchConfig{
-				expectedNewSearch: gcpspanner.CreateUserSavedSearchRequest{
-					OwnerUserID: "user1",
-					Query:       "test query",
-					Name:        "test search",
-					Description: new("test description"),
+			name: "success update name",
+			req: backend.UpdateNotificationChannelRequest{
+				UpdateMask: []backend.UpdateNotificationChannelRequestUpdateMask{
+					backend.UpdateNotificationChannelRequestMaskName,
 				},
-				result:        new("saved-search-id"),
-				returnedError: nil,
+				Name:   new("New Name"),
+				Config: nil,
 			},
-			getCfg: &mockGetUserSavedSearchConfig{
-				expectedAuthenticatedUserID: new("user1"),
-				expectedSavedSearchID:       "saved-search-id",
-				result: &gcpspanner.UserSavedSearch{
-					SavedSearch: gcpspanner.SavedSearch{
-						Name:        "test search",
-						Description: new("test description"),
-						Query:       "test query",
-						Scope:       gcpspanner.UserPublicScope,
-						AuthorID:    "user1",
-						CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-						UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-						ID:          "saved-search-id",
+			cfg: &mockUpdateNotificationChannelConfig{
+				expectedRequest: gcpspanner.UpdateNotificationChannelRequest{
+					ID:     channelID,
+					UserID: userID,
+					Name:   gcpspanner.OptionallySet[string]{Value: "New Name", IsSet: true},
+					Type: gcpspanner.OptionallySet[gcpspanner.NotificationChannelType]{
+						Value: "",
+						IsSet: false,
 					},
-					Role:         new(string(gcpspanner.SavedSearchOwner)),
-					IsBookmarked: new(true),
+					EmailConfig:   gcpspanner.OptionallySet[*gcpspanner.EmailConfig]{Value: nil, IsSet: false},
+					WebhookConfig: gcpspanner.OptionallySet[*gcpspanner.WebhookConfig]{Value: nil, IsSet: false},
 				},
 				returnedError: nil,
 			},
-			expectedOutput: &backend.SavedSearchResponse{
-				Id:          "saved-search-id",
-				CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-				UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-				Name:        "test search",
-				Description: new("test description"),
-				Query:       "test query",
-				Permissions: &backend.UserSavedSearchPermissions{
-					Role: new(backend.SavedSearchOwner),
-				},
-				BookmarkStatus: &backend.UserSavedSearchBookmark{
-					Status: backend.BookmarkActive,
-				},
+			expectedGetResult: &gcpspanner.NotificationChannel{
+				ID:            channelID,
+				UserID:        userID,
+				Name:          "New Name",
+				Type:          gcpspanner.NotificationChannelTypeWebhook,
+				EmailConfig:   nil,
+				WebhookConfig: &gcpspanner.WebhookConfig{URL: "https://hooks.slack.com/services/123"},
+				CreatedAt:     now,
+				UpdatedAt:     now,
+			},
+			expected: &backend.NotificationChannelResponse{
+				Id:   channelID,
+				Name: "New Name",
+				Type: backend.NotificationChannelResponseTypeWebhook,
+				Config: newTestNotificationChannelConfig(t, backend.WebhookConfig{
+					Type: backend.Webhook,
+					Url:  "https://hooks.slack.com/services/123",
+				}),
+				Status:    backend.NotificationChannelStatusEnabled,
+				CreatedAt: now,
+				UpdatedAt: now,
 			},
+
 			expectedError: nil,
 		},
 		{
-			name:        "limit failure",
-			inputUserID: "user1",
-			savedSearch: backend.SavedSearch{
-				Name:        "test search",
-				Description: new("test description"),
-				Query:       "test query",
-			},
-			createCfg: &mockCreateNewUserSavedSearchConfig{
-				expectedNewSearch: gcpspanner.CreateUserSavedSearchRequest{
-					OwnerUserID: "user1",
-					Query:       "test query",
-					Name:        "test search",
-					Description: new("test description"),
+			name: "error update email channel",
+			req: backend.UpdateNotificationChannelRequest{
+				UpdateMask: []backend.UpdateNotificationChannelRequestUpdateMask{
+					backend.UpdateNotificationChannelRequestMaskName,
 				},
-				result:        nil,
-				returnedError: gcpspanner.ErrOwnerSavedSearchLimitExceeded,
-			},
-			getCfg:         nil,
-			expectedOutput: nil,
-			expectedError:  backendtypes.ErrUserMaxSavedSearches,
-		},
-		{
-			name:        "general create failure",
-			inputUserID: "user1",
-			savedSearch: backend.SavedSearch{
-				Name:        "test search",
-				Description: new("test description"),
-				Query:       "test query",
+				Name:   new("New Name"),
+				Config: nil,
 			},
-			createCfg: &mockCreateNewUserSavedSearchConfig{
-				expectedNewSearch: gcpspanner.CreateUserSavedSearchRequest{
-					OwnerUserID: "user1",
-					Query:       "test query",
-					Name:        "test search",
-					Description: new("test description"),
+			cfg: nil, // Should not be called
+			expectedGetResult: &gcpspanner.NotificationChannel{
+				ID:     channelID,
+				UserID: userID,
+				Name:   "Old Name",
+				Type:   gcpspanner.NotificationChannelTypeEmail,
+				EmailConfig: &gcpspanner.EmailConfig{
+					Address:           "test@example.com",
+					IsVerified:        false,
+					VerificationToken: nil,
 				},
-				result:        nil,
-				returnedError: testError,
+				WebhookConfig: nil,
+				CreatedAt:     now,
+				UpdatedAt:     now,
 			},
-			getCfg:         nil,
-			expectedOutput: nil,
-			expectedError:  testError,
+			expected:      nil,
+			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
 		},
 		{
-			name:        "general get failure",
-			inputUserID: "user1",
-			savedSearch: backend.SavedSearch{
-				Name:        "test search",
-				Description: new("test description"),
-				Query:       "test query",
+			name: "success update config to webhook",
+			req: backend.UpdateNotificationChannelRequest{
+				UpdateMask: []backend.UpdateNotificationChannelRequestUpdateMask{
+					backend.UpdateNotificationChannelRequestMaskConfig,
+				},
+				Name: nil,
+				Config: newTestUpdateNotificationChannelRequestConfig(t, backend.WebhookConfig{
+					Type: backend.Webhook,
+					Url:  "https://hooks.slack.com/services/456",
+				}),
 			},
-			createCfg: &mockCreateNewUserSavedSearchConfig{
-				expectedNewSearch: gcpspanner.CreateUserSavedSearchRequest{
-					OwnerUserID: "user1",
-					Query:       "test query",
-					Name:        "test search",
-					Description: new("test description"),
+			cfg: &mockUpdateNotificationChannelConfig{
+				expectedRequest: gcpspanner.UpdateNotificationChannelRequest{
+					ID:     channelID,
+					UserID: userID,
+					Name:   gcpspanner.OptionallySet[string]{Value: "", IsSet: false},
+					Type: gcpspanner.OptionallySet[gcpspanner.NotificationChannelType]{
+						Value: gcpspanner.NotificationChannelTypeWebhook,
+						IsSet: true,
+					},
+					EmailConfig: gcpspanner.OptionallySet[*gcpspanner.EmailConfig]{Value: nil, IsSet: false},
+					WebhookConfig: gcpspanner.OptionallySet[*gcpspanner.WebhookConfig]{
+						Value: &gcpspanner.WebhookConfig{URL: "https://hooks.slack.com/services/456"},
+						IsSet: true,
+					},
 				},
-				result:        new("saved-search-id"),
 				returnedError: nil,
 			},
-			getCfg: &mockGetUserSavedSearchConfig{
-				expectedAuthenticatedUserID: new("user1"),
-				expectedSavedSearchID:       "saved-search-id",
-				result:                      nil,
-				returnedError:               testError,
+			expectedGetResult: &gcpspanner.NotificationChannel{
+				ID:            channelID,
+				UserID:        userID,
+				Name:          "My Email",
+				Type:          gcpspanner.NotificationChannelTypeWebhook,
+				EmailConfig:   nil,
+				WebhookConfig: &gcpspanner.WebhookConfig{URL: "https://hooks.slack.com/services/456"},
+				CreatedAt:     now,
+				UpdatedAt:     now,
 			},
-			expectedOutput: nil,
-			expectedError:  testError,
+			expected: &backend.NotificationChannelResponse{
+				Id:   channelID,
+				Name: "My Email", // Name didn't change
+				Type: backend.NotificationChannelResponseTypeWebhook,
+				Config: newTestNotificationChannelConfig(t, backend.WebhookConfig{
+					Type: backend.Webhook,
+					Url:  "https://hooks.slack.com/services/456",
+				}),
+				Status:    backend.NotificationChannelStatusEnabled,
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+
+			expectedError: nil,
 		},
 	}
+
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			//nolint: exhaustruct
-			mock := mockBackendSpannerClient{
-				t:                               t,
-				mockCreateNewUserSavedSearchCfg: tc.createCfg,
-				mockGetUserSavedSearchCfg:       tc.getCfg,
+			// nolint: exhaustruct
+			mock := &mockBackendSpannerClient{
+				t:                                t,
+				mockUpdateNotificationChannelCfg: tc.cfg,
+				// Mock the Get call that happens after Update
+				mockGetNotificationChannelCfg: &mockGetNotificationChannelConfig{
+					expectedUserID:    userID,
+					expectedChannelID: channelID,
+					result:            tc.expectedGetResult,
+					returnedError:     nil,
+				},
 			}
-			bk := NewBackend(mock)
-			output, err := bk.CreateUserSavedSearch(context.Background(), tc.inputUserID, tc.savedSearch)
+
+			b := NewBackend(mock)
+			resp, err := b.UpdateNotificationChannel(context.Background(), userID, channelID, tc.req)
 			if !errors.Is(err, tc.expectedError) {
-				t.Error("unexpected error")
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
 			}
-
-			if !reflect.DeepEqual(output, tc.expectedOutput) {
-				t.Error("unexpected output")
+			if diff := cmp.Diff(tc.expected, resp, getNotificationChannelCmpOption()); diff != "" {
+				t.Errorf("response mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestDeleteUserSavedSearch(t *testing.T) {
+func TestDeleteNotificationChannel(t *testing.T) {
+	const (
+		userID    = "user123"
+		channelID = "channel456"
+	)
+
 	testCases := []struct {
 		name          string
-		cfg           *mockDeleteUserSavedSearchConfig
-		userID        string
-		savedSearchID string
-		expectedErr   error
+		cfg           *mockDeleteNotificationChannelConfig
+		expectedError error
 	}{
 		{
 			name: "success",
-			cfg: &mockDeleteUserSavedSearchConfig{
-				expectedDeleteRequest: gcpspanner.DeleteUserSavedSearchRequest{
-					RequestingUserID: "user1",
-					SavedSearchID:    "saved-search-id",
-				},
-				returnedError: nil,
+			cfg: &mockDeleteNotificationChannelConfig{
+				expectedChannelID: channelID,
+				expectedUserID:    userID,
+				returnedError:     nil,
 			},
-			userID:        "user1",
-			savedSearchID: "saved-search-id",
-			expectedErr:   nil,
+			expectedError: nil,
 		},
 		{
-			name: "general failure",
-			cfg: &mockDeleteUserSavedSearchConfig{
-				expectedDeleteRequest: gcpspanner.DeleteUserSavedSearchRequest{
-					RequestingUserID: "user1",
-					SavedSearchID:    "saved-search-id",
-				},
-				returnedError: errTest,
+			name: "not found",
+			cfg: &mockDeleteNotificationChannelConfig{
+				expectedChannelID: channelID,
+				expectedUserID:    userID,
+				returnedError:     gcpspanner.ErrQueryReturnedNoResults,
 			},
-			userID:        "user1",
-			savedSearchID: "saved-search-id",
-			expectedErr:   errTest,
+			expectedError: backendtypes.ErrEntityDoesNotExist,
 		},
 		{
-			name: "missing required role error",
-			cfg: &mockDeleteUserSavedSearchConfig{
-				expectedDeleteRequest: gcpspanner.DeleteUserSavedSearchRequest{
-					RequestingUserID: "user1",
-					SavedSearchID:    "saved-search-id",
-				},
-				returnedError: gcpspanner.ErrMissingRequiredRole,
+			name: "not authorized",
+			cfg: &mockDeleteNotificationChannelConfig{
+				expectedChannelID: channelID,
+				expectedUserID:    userID,
+				returnedError:     gcpspanner.ErrMissingRequiredRole,
 			},
-			userID:        "user1",
-			savedSearchID: "saved-search-id",
-			expectedErr:   backendtypes.ErrUserNotAuthorizedForAction,
+			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
 		},
 		{
-			name: "entity does not exist error",
-			cfg: &mockDeleteUserSavedSearchConfig{
-				expectedDeleteRequest: gcpspanner.DeleteUserSavedSearchRequest{
-					RequestingUserID: "user1",
-					SavedSearchID:    "saved-search-id",
-				},
-				returnedError: gcpspanner.ErrQueryReturnedNoResults,
+			name: "other error",
+			cfg: &mockDeleteNotificationChannelConfig{
+				expectedChannelID: channelID,
+				expectedUserID:    userID,
+				returnedError:     errTest,
 			},
-			userID:        "user1",
-			savedSearchID: "saved-search-id",
-			expectedErr:   backendtypes.ErrEntityDoesNotExist,
+			expectedError: errTest,
 		},
 	}
+
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			//nolint: exhaustruct
 			mock := mockBackendSpannerClient{
-				t:                            t,
-				mockDeleteUserSavedSearchCfg: tc.cfg,
+				t:                                t,
+				mockDeleteNotificationChannelCfg: tc.cfg,
 			}
-			bk := NewBackend(mock)
-			err := bk.DeleteUserSavedSearch(context.Background(), tc.userID, tc.savedSearchID)
-
-			if !errors.Is(err, tc.expectedErr) {
-				t.Errorf("unexpected error %s", err)
+			b := NewBackend(mock)
+			err := b.DeleteNotificationChannel(context.Background(), userID, channelID)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
 			}
 		})
 	}
 }
 
-func TestGetSavedSearch(t *testing.T) {
+func TestGetNotificationChannelDeliverySettings(t *testing.T) {
+	const (
+		userID    = "user123"
+		channelID = "channel456"
+	)
+
 	testCases := []struct {
-		name           string
-		cfg            *mockGetUserSavedSearchConfig
-		userID         *string
-		savedSearchID  string
-		expectedOutput *backend.SavedSearchResponse
-		expectedError  error
+		name          string
+		cfg           *mockGetNotificationChannelDeliverySettingsConfig
+		expected      *backend.NotificationChannelDeliverySettings
+		expectedError error
 	}{
 		{
-			name:          "success authenticated user",
-			userID:        new("user1"),
-			savedSearchID: "saved-search-id",
-			cfg: &mockGetUserSavedSearchConfig{
-				expectedAuthenticatedUserID: new("user1"),
-				expectedSavedSearchID:       "saved-search-id",
-				result: &gcpspanner.UserSavedSearch{
-					SavedSearch: gcpspanner.SavedSearch{
-						Name:        "test search",
-						Description: new("test description"),
-						Query:       "test query",
-						Scope:       gcpspanner.UserPublicScope,
-						AuthorID:    "user1",
-						CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-						UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-						ID:          "saved-search-id",
-					},
-					Role:         new(string(gcpspanner.SavedSearchOwner)),
-					IsBookmarked: new(true),
+			name: "success",
+			cfg: &mockGetNotificationChannelDeliverySettingsConfig{
+				expectedChannelID: channelID,
+				expectedUserID:    userID,
+				result: &gcpspanner.NotificationChannelDeliverySettings{
+					ChannelID:            channelID,
+					QuietHoursStart:      new("22:00"),
+					QuietHoursEnd:        new("07:00"),
+					QuietHoursTimeZone:   new("Europe/Berlin"),
+					MaxDeliveriesPerHour: new(int64(10)),
+					CreatedAt:            time.Time{},
+					UpdatedAt:            time.Time{},
 				},
 				returnedError: nil,
 			},
-			expectedOutput: &backend.SavedSearchResponse{
-				Id:          "saved-search-id",
-				CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-				UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-				Name:        "test search",
-				Query:       "test query",
-				Description: new("test description"),
-				BookmarkStatus: &backend.UserSavedSearchBookmark{
-					Status: backend.BookmarkActive,
-				},
-				Permissions: &backend.UserSavedSearchPermissions{
-					Role: new(backend.SavedSearchOwner),
+			expected: &backend.NotificationChannelDeliverySettings{
+				QuietHours: &backend.QuietHours{
+					Start:    "22:00",
+					End:      "07:00",
+					TimeZone: "Europe/Berlin",
 				},
+				MaxDeliveriesPerHour: new(10),
 			},
 			expectedError: nil,
 		},
 		{
-			name:          "success unauthenticated user",
-			userID:        nil,
-			savedSearchID: "saved-search-id",
-			cfg: &mockGetUserSavedSearchConfig{
-				expectedAuthenticatedUserID: nil,
-				expectedSavedSearchID:       "saved-search-id",
-				result: &gcpspanner.UserSavedSearch{
-					SavedSearch: gcpspanner.SavedSearch{
-						Name:        "test search",
-						Description: new("test description"),
-						Query:       "test query",
-						Scope:       gcpspanner.UserPublicScope,
-						AuthorID:    "user1",
-						CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-						UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-						ID:          "saved-search-id",
-					},
-					Role:         nil,
-					IsBookmarked: nil,
-				},
-				returnedError: nil,
+			name: "no settings",
+			cfg: &mockGetNotificationChannelDeliverySettingsConfig{
+				expectedChannelID: channelID,
+				expectedUserID:    userID,
+				result:            nil,
+				returnedError:     gcpspanner.ErrQueryReturnedNoResults,
 			},
-			expectedOutput: &backend.SavedSearchResponse{
-				Id:             "saved-search-id",
-				CreatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-				UpdatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-				Name:           "test search",
-				Query:          "test query",
-				Description:    new("test description"),
-				BookmarkStatus: nil,
-				Permissions:    nil,
+			expected: &backend.NotificationChannelDeliverySettings{
+				QuietHours:           nil,
+				MaxDeliveriesPerHour: nil,
 			},
 			expectedError: nil,
 		},
 		{
-			name:          "search not found error",
-			userID:        nil,
-			savedSearchID: "saved-search-id",
-			cfg: &mockGetUserSavedSearchConfig{
-				expectedAuthenticatedUserID: nil,
-				expectedSavedSearchID:       "saved-search-id",
-				result:                      nil,
-				returnedError:               gcpspanner.ErrQueryReturnedNoResults,
+			name: "channel not found",
+			cfg: &mockGetNotificationChannelDeliverySettingsConfig{
+				expectedChannelID: channelID,
+				expectedUserID:    userID,
+				result:            nil,
+				returnedError:     gcpspanner.ErrNotificationChannelNotFound,
 			},
-			expectedOutput: nil,
-			expectedError:  backendtypes.ErrEntityDoesNotExist,
+			expected:      nil,
+			expectedError: backendtypes.ErrEntityDoesNotExist,
 		},
 		{
-			name:          "general error",
-			userID:        nil,
-			savedSearchID: "saved-search-id",
-			cfg: &mockGetUserSavedSearchConfig{
-				expectedAuthenticatedUserID: nil,
-				expectedSavedSearchID:       "saved-search-id",
-				result:                      nil,
-				returnedError:               errTest,
+			name: "other error",
+			cfg: &mockGetNotificationChannelDeliverySettingsConfig{
+				expectedChannelID: channelID,
+				expectedUserID:    userID,
+				result:            nil,
+				returnedError:     errTest,
 			},
-			expectedOutput: nil,
-			expectedError:  errTest,
+			expected:      nil,
+			expectedError: errTest,
 		},
 	}
+
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			//nolint: exhaustruct
 			mock := mockBackendSpannerClient{
-				t:                         t,
-				mockGetUserSavedSearchCfg: tc.cfg,
+				t:                          t,
+				mockGetDeliverySettingsCfg: tc.cfg,
 			}
-			bk := NewBackend(mock)
-			output, err := bk.GetSavedSearch(context.Background(), tc.savedSearchID, tc.userID)
-
+			b := NewBackend(mock)
+			settings, err := b.GetNotificationChannelDeliverySettings(context.Background(), userID, channelID)
 			if !errors.Is(err, tc.expectedError) {
-				t.Errorf("unexpected error %s", err)
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
 			}
-
-			if !reflect.DeepEqual(output, tc.expectedOutput) {
-				t.Errorf("unexpected output %v", output)
+			if diff := cmp.Diff(tc.expected, settings); diff != "" {
+				t.Errorf("unexpected settings (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestListUserSavedSearches(t *testing.T) {
+func TestPutNotificationChannelDeliverySettings(t *testing.T) {
+	const (
+		userID    = "user123"
+		channelID = "channel456"
+	)
+	quietHoursSettings := backend.NotificationChannelDeliverySettings{
+		QuietHours: &backend.QuietHours{
+			Start:    "22:00",
+			End:      "07:00",
+			TimeZone: "Europe/Berlin",
+		},
+		MaxDeliveriesPerHour: nil,
+	}
+	expectedQuietHours := gcpspanner.NotificationChannelDeliverySettings{
+		ChannelID:            channelID,
+		QuietHoursStart:      new("22:00"),
+		QuietHoursEnd:        new("07:00"),
+		QuietHoursTimeZone:   new("Europe/Berlin"),
+		MaxDeliveriesPerHour: nil,
+		CreatedAt:            time.Time{},
+		UpdatedAt:            time.Time{},
+	}
+
 	testCases := []struct {
 		name          string
-		userID        string
-		pageSize      int
-		pageToken     *string
-		cfg           *mockListUserSavedSearchesConfig
-		expectedPage  *backend.UserSavedSearchPage
+		settings      backend.NotificationChannelDeliverySettings
+		cfg           *mockUpsertNotificationChannelDeliverySettingsConfig
+		expected      *backend.NotificationChannelDeliverySettings
 		expectedError error
 	}{
 		{
-			name:      "success",
-			userID:    "user1",
-			pageSize:  10,
-			pageToken: nil,
-			cfg: &mockListUserSavedSearchesConfig{
-				expectedUserID:    "user1",
-				expectedPageSize:  10,
-				expectedPageToken: nil,
-				result: &gcpspanner.UserSavedSearchesPage{
-					NextPageToken: nil,
-					Searches: []gcpspanner.UserSavedSearch{
-						{
-							SavedSearch: gcpspanner.SavedSearch{
-								Name:        "z",
-								Description: new("test description"),
-								Query:       "test query",
-								Scope:       gcpspanner.UserPublicScope,
-								AuthorID:    "user1",
-								CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-								UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-								ID:          "saved-search-id-2",
-							},
-							IsBookmarked: new(true),
-							Role:         nil,
-						},
-					},
-				},
-				returnedError: nil,
-			},
-			expectedPage: &backend.UserSavedSearchPage{
-				Metadata: nil,
-				Data: new([]backend.SavedSearchResponse{
-					{
-						Id:          "saved-search-id-2",
-						CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-						UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-						Name:        "z",
-						Description: new("test description"),
-						Query:       "test query",
-						Permissions: nil,
-						BookmarkStatus: &backend.UserSavedSearchBookmark{
-							Status: backend.BookmarkActive,
-						},
-					},
-				}),
+			name:     "quiet hours",
+			settings: quietHoursSettings,
+			cfg: &mockUpsertNotificationChannelDeliverySettingsConfig{
+				expectedUserID:   userID,
+				expectedSettings: expectedQuietHours,
+				returnedError:    nil,
 			},
+			expected:      &quietHoursSettings,
 			expectedError: nil,
 		},
 		{
-			name:      "success w/ page token",
-			userID:    "user1",
-			pageSize:  10,
-			pageToken: new("inputToken"),
-			cfg: &mockListUserSavedSearchesConfig{
-				expectedUserID:    "user1",
-				expectedPageSize:  10,
-				expectedPageToken: new("inputToken"),
-				result: &gcpspanner.UserSavedSearchesPage{
-					NextPageToken: new("nextToken"),
-					Searches: []gcpspanner.UserSavedSearch{
-						{
-							SavedSearch: gcpspanner.SavedSearch{
-								Name:        "test search",
-								Description: new("test description"),
-								Query:       "test query",
-								Scope:       gcpspanner.UserPublicScope,
-								AuthorID:    "user1",
-								CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-								UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-								ID:          "saved-search-id",
-							},
-							Role:         new(string(gcpspanner.SavedSearchOwner)),
-							IsBookmarked: new(true),
-						},
-						{
-							SavedSearch: gcpspanner.SavedSearch{
-								Name:        "z",
-								Description: new("test description"),
-								Query:       "test query",
-								Scope:       gcpspanner.UserPublicScope,
-								AuthorID:    "user1",
-								CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-								UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-								ID:          "saved-search-id-2",
-							},
-							IsBookmarked: new(true),
-							Role:         nil,
-						},
-					},
+			name: "rate limit",
+			settings: backend.NotificationChannelDeliverySettings{
+				QuietHours:           nil,
+				MaxDeliveriesPerHour: new(5),
+			},
+			cfg: &mockUpsertNotificationChannelDeliverySettingsConfig{
+				expectedUserID: userID,
+				expectedSettings: gcpspanner.NotificationChannelDeliverySettings{
+					ChannelID:            channelID,
+					QuietHoursStart:      nil,
+					QuietHoursEnd:        nil,
+					QuietHoursTimeZone:   nil,
+					MaxDeliveriesPerHour: new(int64(5)),
+					CreatedAt:            time.Time{},
+					UpdatedAt:            time.Time{},
 				},
 				returnedError: nil,
 			},
-			expectedPage: &backend.UserSavedSearchPage{
-				Metadata: &backend.PageMetadata{
-					NextPageToken: new("nextToken"),
-				},
-				Data: new([]backend.SavedSearchResponse{
-					{
-						Id:          "saved-search-id",
-						CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-						UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-						Name:        "test search",
-						Description: new("test description"),
-						Query:       "test query",
-						Permissions: &backend.UserSavedSearchPermissions{
-							Role: new(backend.SavedSearchOwner),
-						},
-						BookmarkStatus: &backend.UserSavedSearchBookmark{
-							Status: backend.BookmarkActive,
-						},
-					},
-					{
-						Id:          "saved-search-id-2",
-						CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-						UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-						Name:        "z",
-						Description: new("test description"),
-						Query:       "test query",
-						Permissions: nil,
-						BookmarkStatus: &backend.UserSavedSearchBookmark{
-							Status: backend.BookmarkActive,
-						},
-					},
-				}),
+			expected: &backend.NotificationChannelDeliverySettings{
+				QuietHours:           nil,
+				MaxDeliveriesPerHour: new(5),
 			},
 			expectedError: nil,
 		},
 		{
-			name:      "general error",
-			userID:    "user1",
-			pageSize:  10,
-			pageToken: nil,
-			cfg: &mockListUserSavedSearchesConfig{
-				expectedUserID:    "user1",
-				expectedPageSize:  10,
-				expectedPageToken: nil,
-				result:            nil,
-				returnedError:     errTest,
+			name:     "channel not found",
+			settings: quietHoursSettings,
+			cfg: &mockUpsertNotificationChannelDeliverySettingsConfig{
+				expectedUserID:   userID,
+				expectedSettings: expectedQuietHours,
+				returnedError:    gcpspanner.ErrNotificationChannelNotFound,
 			},
-			expectedPage:  nil,
-			expectedError: errTest,
+			expected:      nil,
+			expectedError: backendtypes.ErrEntityDoesNotExist,
 		},
 		{
-			name:      "invalid cursor",
-			userID:    "user1",
-			pageSize:  10,
-			pageToken: nil,
-			cfg: &mockListUserSavedSearchesConfig{
-				expectedUserID:    "user1",
-				expectedPageSize:  10,
-				expectedPageToken: nil,
-				result:            nil,
-				returnedError:     gcpspanner.ErrInvalidCursorFormat,
+			name:     "other error",
+			settings: quietHoursSettings,
+			cfg: &mockUpsertNotificationChannelDeliverySettingsConfig{
+				expectedUserID:   userID,
+				expectedSettings: expectedQuietHours,
+				returnedError:    errTest,
 			},
-			expectedPage:  nil,
-			expectedError: backendtypes.ErrInvalidPageToken,
+			expected:      nil,
+			expectedError: errTest,
 		},
 	}
 
@@ -3431,370 +4205,489 @@ func TestListUserSavedSearches(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			//nolint: exhaustruct
 			mock := mockBackendSpannerClient{
-				t:                            t,
-				mockListUserSavedSearchesCfg: tc.cfg,
+				t:                             t,
+				mockUpsertDeliverySettingsCfg: tc.cfg,
 			}
-			backend := NewBackend(mock)
-			page, err := backend.ListUserSavedSearches(context.Background(), tc.userID, tc.pageSize, tc.pageToken)
+			b := NewBackend(mock)
+			settings, err := b.PutNotificationChannelDeliverySettings(
+				context.Background(), userID, channelID, tc.settings)
 			if !errors.Is(err, tc.expectedError) {
-				t.Error("unexpected error")
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
 			}
-
-			if !reflect.DeepEqual(page, tc.expectedPage) {
-				t.Error("unexpected page")
+			if diff := cmp.Diff(tc.expected, settings); diff != "" {
+				t.Errorf("unexpected settings (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestUpdateUserSavedSearch(t *testing.T) {
-	testSavedSearchID := "test-id"
-	testUserID := "test-user"
+func TestListNotificationChannels(t *testing.T) {
+	const (
+		userID = "user123"
+	)
+	now := time.Now()
 
 	testCases := []struct {
 		name          string
-		updateRequest *backend.SavedSearchUpdateRequest
-		mockUpdateCfg *mockUpdateUserSavedSearchConfig
-		mockGetCfg    *mockGetUserSavedSearchConfig
-		expectedResp  *backend.SavedSearchResponse
+		pageSize      int
+		pageToken     *string
+		cfg           *mockListNotificationChannelsConfig
+		expected      *backend.NotificationChannelPage
 		expectedError error
 	}{
 		{
-			name: "success",
-			updateRequest: &backend.SavedSearchUpdateRequest{
-				Name:        new("test search name"),
-				Description: new("test desc"),
-				Query:       new("test query"),
-				UpdateMask: []backend.SavedSearchUpdateRequestUpdateMask{
-					backend.SavedSearchUpdateRequestMaskName,
-					backend.SavedSearchUpdateRequestMaskDescription,
-					backend.SavedSearchUpdateRequestMaskQuery,
+			name:      "success",
+			pageSize:  10,
+			pageToken: nil,
+			cfg: &mockListNotificationChannelsConfig{
+				expectedRequest: gcpspanner.ListNotificationChannelsRequest{
+					UserID:    userID,
+					PageSize:  10,
+					PageToken: nil,
 				},
-			},
-			mockUpdateCfg: &mockUpdateUserSavedSearchConfig{
-				expectedRequest: gcpspanner.UpdateSavedSearchRequest{
-					ID:       "test-id",
-					AuthorID: "test-user",
-					Name: gcpspanner.OptionallySet[string]{
-						IsSet: true,
-						Value: "test search name",
+				result: []gcpspanner.NotificationChannel{
+					{
+						ID:     "id1",
+						Name:   "channel1",
+						UserID: userID,
+						Type:   "email",
+						EmailConfig: &gcpspanner.EmailConfig{
+							Address:           "b@test.com",
+							IsVerified:        false,
+							VerificationToken: nil,
+						},
+						WebhookConfig: nil,
+						CreatedAt:     now,
+						UpdatedAt:     now,
 					},
-					Description: gcpspanner.OptionallySet[*string]{
-						IsSet: true,
-						Value: new("test desc"),
+					{
+						ID:     "id2",
+						Name:   "channel2",
+						UserID: userID,
+						Type:   "email",
+						EmailConfig: &gcpspanner.EmailConfig{
+							Address:           "a@test.com",
+							IsVerified:        false,
+							VerificationToken: nil,
+						},
+						WebhookConfig: nil,
+						CreatedAt:     now,
+						UpdatedAt:     now,
 					},
-					Query: gcpspanner.OptionallySet[string]{
-						IsSet: true,
-						Value: "test query",
+					{
+						ID:     "id3",
+						Name:   "channel3",
+						UserID: userID,
+						Type:   "email",
+						EmailConfig: &gcpspanner.EmailConfig{
+							Address:           "c@test.com",
+							IsVerified:        false,
+							VerificationToken: nil,
+						},
+						WebhookConfig: nil,
+						CreatedAt:     now.Add(-1 * time.Hour),
+						UpdatedAt:     now.Add(-1 * time.Hour),
 					},
 				},
+				nextPageToken: nil,
 				returnedError: nil,
 			},
-			mockGetCfg: &mockGetUserSavedSearchConfig{
-				expectedAuthenticatedUserID: new("test-user"),
-				expectedSavedSearchID:       "test-id",
-				result: &gcpspanner.UserSavedSearch{
-					SavedSearch: gcpspanner.SavedSearch{
-						ID:          "test-id",
-						Name:        "test search name",
-						Description: new("test desc"),
-						Query:       "test query",
-						Scope:       gcpspanner.UserPublicScope,
-						AuthorID:    "test-user",
-						CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-						UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			expected: &backend.NotificationChannelPage{
+				Data: &[]backend.NotificationChannelResponse{
+					{
+						Id:   "id2",
+						Name: "channel2",
+						Type: backend.NotificationChannelResponseTypeEmail,
+						Config: newTestNotificationChannelConfig(t, backend.EmailConfig{
+							Type:    backend.EmailConfigTypeEmail,
+							Address: "a@test.com",
+						}),
+						Status:    backend.NotificationChannelStatusEnabled,
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+					{
+						Id:   "id1",
+						Name: "channel1",
+						Type: backend.NotificationChannelResponseTypeEmail,
+						Config: newTestNotificationChannelConfig(t, backend.EmailConfig{
+							Type:    backend.EmailConfigTypeEmail,
+							Address: "b@test.com",
+						}),
+						Status:    backend.NotificationChannelStatusEnabled,
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+					{
+						Id:   "id3",
+						Name: "channel3",
+						Type: backend.NotificationChannelResponseTypeEmail,
+						Config: newTestNotificationChannelConfig(t, backend.EmailConfig{
+							Type:    backend.EmailConfigTypeEmail,
+							Address: "c@test.com",
+						}),
+						Status:    backend.NotificationChannelStatusEnabled,
+						CreatedAt: now.Add(-1 * time.Hour),
+						UpdatedAt: now.Add(-1 * time.Hour),
 					},
-					Role:         new(string(gcpspanner.SavedSearchOwner)),
-					IsBookmarked: new(true),
-				},
-				returnedError: nil,
-			},
-			expectedResp: &backend.SavedSearchResponse{
-				Id:          "test-id",
-				CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-				UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
-				Name:        "test search name",
-				Description: new("test desc"),
-				Query:       "test query",
-				Permissions: &backend.UserSavedSearchPermissions{
-					Role: new(backend.SavedSearchOwner),
-				},
-				BookmarkStatus: &backend.UserSavedSearchBookmark{
-					Status: backend.BookmarkActive,
 				},
+				Metadata: &backend.PageMetadata{NextPageToken: nil},
 			},
+
 			expectedError: nil,
 		},
 		{
-			name: "get user saved search return no results",
-			updateRequest: &backend.SavedSearchUpdateRequest{
-				Name:        new("test search name"),
-				Description: new("test desc"),
-				Query:       new("test query"),
-				UpdateMask: []backend.SavedSearchUpdateRequestUpdateMask{
-					backend.SavedSearchUpdateRequestMaskName,
-					backend.SavedSearchUpdateRequestMaskDescription,
-					backend.SavedSearchUpdateRequestMaskQuery,
-				},
-			},
-			mockUpdateCfg: &mockUpdateUserSavedSearchConfig{
-				expectedRequest: gcpspanner.UpdateSavedSearchRequest{
-					ID:       "test-id",
-					AuthorID: "test-user",
-					Name: gcpspanner.OptionallySet[string]{
-						IsSet: true,
-						Value: "test search name",
-					},
-					Description: gcpspanner.OptionallySet[*string]{
-						IsSet: true,
-						Value: new("test desc"),
-					},
-					Query: gcpspanner.OptionallySet[string]{
-						IsSet: true,
-						Value: "test query",
-					},
+			name:      "db error",
+			pageSize:  10,
+			pageToken: nil,
+			cfg: &mockListNotificationChannelsConfig{
+				expectedRequest: gcpspanner.ListNotificationChannelsRequest{
+					UserID:    userID,
+					PageSize:  10,
+					PageToken: nil,
 				},
-				returnedError: nil,
-			},
-			mockGetCfg: &mockGetUserSavedSearchConfig{
-				expectedAuthenticatedUserID: new("test-user"),
-				expectedSavedSearchID:       "test-id",
-				result:                      nil,
-				returnedError:               gcpspanner.ErrQueryReturnedNoResults,
+				result:        nil,
+				nextPageToken: nil,
+				returnedError: errTest,
 			},
-			expectedResp:  nil,
-			expectedError: backendtypes.ErrEntityDoesNotExist,
+			expected:      nil,
+			expectedError: errTest,
 		},
 		{
-			name: "get user saved search returns other error",
-			updateRequest: &backend.SavedSearchUpdateRequest{
-				Name:        new("test search name"),
-				Description: new("test desc"),
-				Query:       new("test query"),
-				UpdateMask: []backend.SavedSearchUpdateRequestUpdateMask{
-					backend.SavedSearchUpdateRequestMaskName,
-					backend.SavedSearchUpdateRequestMaskDescription,
-					backend.SavedSearchUpdateRequestMaskQuery,
+			name:      "invalid cursor",
+			pageSize:  10,
+			pageToken: nonNilInputPageToken,
+			cfg: &mockListNotificationChannelsConfig{
+				expectedRequest: gcpspanner.ListNotificationChannelsRequest{
+					UserID:    userID,
+					PageSize:  10,
+					PageToken: nonNilInputPageToken,
 				},
-			},
-			mockUpdateCfg: &mockUpdateUserSavedSearchConfig{
-				expectedRequest: gcpspanner.UpdateSavedSearchRequest{
-					ID:       "test-id",
-					AuthorID: "test-user",
-					Name: gcpspanner.OptionallySet[string]{
-						IsSet: true,
-						Value: "test search name",
-					},
-					Description: gcpspanner.OptionallySet[*string]{
-						IsSet: true,
-						Value: new("test desc"),
-					},
-					Query: gcpspanner.OptionallySet[string]{
-						IsSet: true,
-						Value: "test query",
+				result:        nil,
+				nextPageToken: nil,
+				returnedError: gcpspanner.ErrInvalidCursorFormat,
+			},
+			expected:      nil,
+			expectedError: backendtypes.ErrInvalidPageToken,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                               t,
+				mockListNotificationChannelsCfg: tc.cfg,
+			}
+			b := NewBackend(mock)
+			resp, err := b.ListNotificationChannels(context.Background(), userID, tc.pageSize, tc.pageToken)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+			}
+			if diff := cmp.Diff(tc.expected, resp, getNotificationChannelCmpOption()); diff != "" {
+				t.Errorf("response mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreateUserSavedSearch(t *testing.T) {
+	testError := errors.New("test error")
+	testCases := []struct {
+		name           string
+		createCfg      *mockCreateNewUserSavedSearchConfig
+		getCfg         *mockGetUserSavedSearchConfig
+		inputUserID    string
+		savedSearch    backend.SavedSearch
+		expectedOutput *backend.SavedSearchResponse
+		expectedError  error
+	}{
+		{
+			name:        "success",
+			inputUserID: "user1",
+			savedSearch: backend.SavedSearch{
+				Name:        "test search",
+				Description: new("test description"),
+				Query:       "test query",
+				Tags:        nil,
+				Listed:      nil,
+				Parameters:  nil,
+			},
+			createCfg: &mockCreateNewUserSavedSearchConfig{
+				expectedNewSearch: gcpspanner.CreateUserSavedSearchRequest{
+					OwnerUserID:     "user1",
+					Query:           "test query",
+					Name:            "test search",
+					Description:     new("test description"),
+					Tags:            nil,
+					Listed:          false,
+					QueryParameters: nil,
+				},
+				result:        new("saved-search-id"),
+				returnedError: nil,
+			},
+			getCfg: &mockGetUserSavedSearchConfig{
+				expectedAuthenticatedUserID: new("user1"),
+				expectedSavedSearchID:       "saved-search-id",
+				result: &gcpspanner.UserSavedSearch{
+					SavedSearch: gcpspanner.SavedSearch{
+						Name:            "test search",
+						Description:     new("test description"),
+						Query:           "test query",
+						Scope:           gcpspanner.UserPublicScope,
+						AuthorID:        "user1",
+						CreatedAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						ID:              "saved-search-id",
+						Tags:            nil,
+						Listed:          false,
+						QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 					},
+					Role:         new(string(gcpspanner.SavedSearchOwner)),
+					IsBookmarked: new(true),
 				},
 				returnedError: nil,
 			},
-			mockGetCfg: &mockGetUserSavedSearchConfig{
-				expectedAuthenticatedUserID: new("test-user"),
-				expectedSavedSearchID:       "test-id",
-				result:                      nil,
-				returnedError:               errTest,
+			expectedOutput: &backend.SavedSearchResponse{
+				Id:          "saved-search-id",
+				CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+				Name:        "test search",
+				Description: new("test description"),
+				Query:       "test query",
+				Permissions: &backend.UserSavedSearchPermissions{
+					Role: new(backend.SavedSearchOwner),
+				},
+				BookmarkStatus: &backend.UserSavedSearchBookmark{
+					Status: backend.BookmarkActive,
+				},
+				ForkedFrom: nil,
+				Tags:       nil,
+				Listed:     new(false),
+				Parameters: nil,
 			},
-			expectedResp:  nil,
-			expectedError: errTest,
+			expectedError: nil,
 		},
 		{
-			name: "update user saved search return no results",
-			updateRequest: &backend.SavedSearchUpdateRequest{
-				Name:        new("test search name"),
-				Description: new("test desc"),
-				Query:       new("test query"),
-				UpdateMask: []backend.SavedSearchUpdateRequestUpdateMask{
-					backend.SavedSearchUpdateRequestMaskName,
-					backend.SavedSearchUpdateRequestMaskDescription,
-					backend.SavedSearchUpdateRequestMaskQuery,
-				},
+			name:        "limit failure",
+			inputUserID: "user1",
+			savedSearch: backend.SavedSearch{
+				Name:        "test search",
+				Description: new("test description"),
+				Query:       "test query",
+				Tags:        nil,
+				Listed:      nil,
+				Parameters:  nil,
 			},
-			mockUpdateCfg: &mockUpdateUserSavedSearchConfig{
-				expectedRequest: gcpspanner.UpdateSavedSearchRequest{
-					ID:       "test-id",
-					AuthorID: "test-user",
-					Name: gcpspanner.OptionallySet[string]{
-						IsSet: true,
-						Value: "test search name",
-					},
-					Description: gcpspanner.OptionallySet[*string]{
-						IsSet: true,
-						Value: new("test desc"),
-					},
-					Query: gcpspanner.OptionallySet[string]{
-						IsSet: true,
-						Value: "test query",
-					},
+			createCfg: &mockCreateNewUserSavedSearchConfig{
+				expectedNewSearch: gcpspanner.CreateUserSavedSearchRequest{
+					OwnerUserID:     "user1",
+					Query:           "test query",
+					Name:            "test search",
+					Description:     new("test description"),
+					Tags:            nil,
+					Listed:          false,
+					QueryParameters: nil,
 				},
-				returnedError: gcpspanner.ErrQueryReturnedNoResults,
+				result:        nil,
+				returnedError: gcpspanner.ErrOwnerSavedSearchLimitExceeded,
 			},
-			mockGetCfg:    nil,
-			expectedResp:  nil,
-			expectedError: backendtypes.ErrEntityDoesNotExist,
+			getCfg:         nil,
+			expectedOutput: nil,
+			expectedError:  backendtypes.ErrUserMaxSavedSearches,
 		},
 		{
-			name: "update user saved search return no required role error",
-			updateRequest: &backend.SavedSearchUpdateRequest{
-				Name:        new("test search name"),
-				Description: new("test desc"),
-				Query:       new("test query"),
-				UpdateMask: []backend.SavedSearchUpdateRequestUpdateMask{
-					backend.SavedSearchUpdateRequestMaskName,
-					backend.SavedSearchUpdateRequestMaskDescription,
-					backend.SavedSearchUpdateRequestMaskQuery,
-				},
+			name:        "general create failure",
+			inputUserID: "user1",
+			savedSearch: backend.SavedSearch{
+				Name:        "test search",
+				Description: new("test description"),
+				Query:       "test query",
+				Tags:        nil,
+				Listed:      nil,
+				Parameters:  nil,
 			},
-			mockUpdateCfg: &mockUpdateUserSavedSearchConfig{
-				expectedRequest: gcpspanner.UpdateSavedSearchRequest{
-					ID:       "test-id",
-					AuthorID: "test-user",
-					Name: gcpspanner.OptionallySet[string]{
-						IsSet: true,
-						Value: "test search name",
-					},
-					Description: gcpspanner.OptionallySet[*string]{
-						IsSet: true,
-						Value: new("test desc"),
-					},
-					Query: gcpspanner.OptionallySet[string]{
-						IsSet: true,
-						Value: "test query",
-					},
+			createCfg: &mockCreateNewUserSavedSearchConfig{
+				expectedNewSearch: gcpspanner.CreateUserSavedSearchRequest{
+					OwnerUserID:     "user1",
+					Query:           "test query",
+					Name:            "test search",
+					Description:     new("test description"),
+					Tags:            nil,
+					Listed:          false,
+					QueryParameters: nil,
 				},
-				returnedError: gcpspanner.ErrMissingRequiredRole,
+				result:        nil,
+				returnedError: testError,
 			},
-			mockGetCfg:    nil,
-			expectedResp:  nil,
-			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
+			getCfg:         nil,
+			expectedOutput: nil,
+			expectedError:  testError,
 		},
 		{
-			name: "update user saved search return other error",
-			updateRequest: &backend.SavedSearchUpdateRequest{
-				Name:        new("test search name"),
-				Description: new("test desc"),
-				Query:       new("test query"),
-				UpdateMask: []backend.SavedSearchUpdateRequestUpdateMask{
-					backend.SavedSearchUpdateRequestMaskName,
-					backend.SavedSearchUpdateRequestMaskDescription,
-					backend.SavedSearchUpdateRequestMaskQuery,
-				},
+			name:        "general get failure",
+			inputUserID: "user1",
+			savedSearch: backend.SavedSearch{
+				Name:        "test search",
+				Description: new("test description"),
+				Query:       "test query",
+				Tags:        nil,
+				Listed:      nil,
+				Parameters:  nil,
 			},
-			mockUpdateCfg: &mockUpdateUserSavedSearchConfig{
-				expectedRequest: gcpspanner.UpdateSavedSearchRequest{
-					ID:       "test-id",
-					AuthorID: "test-user",
-					Name: gcpspanner.OptionallySet[string]{
-						IsSet: true,
-						Value: "test search name",
-					},
-					Description: gcpspanner.OptionallySet[*string]{
-						IsSet: true,
-						Value: new("test desc"),
-					},
-					Query: gcpspanner.OptionallySet[string]{
-						IsSet: true,
-						Value: "test query",
-					},
+			createCfg: &mockCreateNewUserSavedSearchConfig{
+				expectedNewSearch: gcpspanner.CreateUserSavedSearchRequest{
+					OwnerUserID:     "user1",
+					Query:           "test query",
+					Name:            "test search",
+					Description:     new("test description"),
+					Tags:            nil,
+					Listed:          false,
+					QueryParameters: nil,
 				},
-				returnedError: errTest,
+				result:        new("saved-search-id"),
+				returnedError: nil,
 			},
-			mockGetCfg:    nil,
-			expectedResp:  nil,
-			expectedError: errTest,
+			getCfg: &mockGetUserSavedSearchConfig{
+				expectedAuthenticatedUserID: new("user1"),
+				expectedSavedSearchID:       "saved-search-id",
+				result:                      nil,
+				returnedError:               testError,
+			},
+			expectedOutput: nil,
+			expectedError:  testError,
 		},
 	}
-
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			//nolint: exhaustruct
 			mock := mockBackendSpannerClient{
-				t:                            t,
-				mockGetUserSavedSearchCfg:    tc.mockGetCfg,
-				mockUpdateUserSavedSearchCfg: tc.mockUpdateCfg,
+				t:                               t,
+				mockCreateNewUserSavedSearchCfg: tc.createCfg,
+				mockGetUserSavedSearchCfg:       tc.getCfg,
 			}
-
-			backend := NewBackend(mock)
-			resp, err := backend.UpdateUserSavedSearch(
-				context.Background(),
-				testSavedSearchID,
-				testUserID,
-				tc.updateRequest,
-			)
+			bk := NewBackend(mock)
+			output, err := bk.CreateUserSavedSearch(context.Background(), tc.inputUserID, tc.savedSearch)
 			if !errors.Is(err, tc.expectedError) {
 				t.Error("unexpected error")
 			}
 
-			if !reflect.DeepEqual(resp, tc.expectedResp) {
-				t.Error("unexpected response")
+			if !reflect.DeepEqual(output, tc.expectedOutput) {
+				t.Error("unexpected output")
 			}
 		})
 	}
 }
 
-// nolint: dupl // WONTFIX
-func TestAddUserSavedSearchBookmark(t *testing.T) {
-	userID := "test-add-user"
-	savedSearchID := "test-add-id"
-	bookmark := gcpspanner.UserSavedSearchBookmark{
-		UserID:        "test-add-user",
-		SavedSearchID: "test-add-id",
-	}
+func TestDeleteUserSavedSearch(t *testing.T) {
 	testCases := []struct {
-		name        string
-		cfg         *mockAddUserSearchBookmarkConfig
-		expectedErr error
+		name          string
+		cfg           *mockDeleteUserSavedSearchConfig
+		userID        string
+		savedSearchID string
+		force         bool
+		expectedErr   error
 	}{
 		{
 			name: "success",
-			cfg: &mockAddUserSearchBookmarkConfig{
-				expectedRequest: bookmark,
-				returnedError:   nil,
+			cfg: &mockDeleteUserSavedSearchConfig{
+				expectedDeleteRequest: gcpspanner.DeleteUserSavedSearchRequest{
+					RequestingUserID: "user1",
+					SavedSearchID:    "saved-search-id",
+					Force:            false,
+				},
+				returnedError: nil,
 			},
-			expectedErr: nil,
+			userID:        "user1",
+			savedSearchID: "saved-search-id",
+			force:         false,
+			expectedErr:   nil,
 		},
 		{
-			name: "limit exceeded error",
-			cfg: &mockAddUserSearchBookmarkConfig{
-				expectedRequest: bookmark,
-				returnedError:   gcpspanner.ErrUserSearchBookmarkLimitExceeded,
+			name: "general failure",
+			cfg: &mockDeleteUserSavedSearchConfig{
+				expectedDeleteRequest: gcpspanner.DeleteUserSavedSearchRequest{
+					RequestingUserID: "user1",
+					SavedSearchID:    "saved-search-id",
+					Force:            false,
+				},
+				returnedError: errTest,
 			},
-			expectedErr: backendtypes.ErrUserMaxBookmarks,
+			userID:        "user1",
+			savedSearchID: "saved-search-id",
+			force:         false,
+			expectedErr:   errTest,
 		},
 		{
-			name: "not found error",
-			cfg: &mockAddUserSearchBookmarkConfig{
-				expectedRequest: bookmark,
-				returnedError:   gcpspanner.ErrQueryReturnedNoResults,
+			name: "missing required role error",
+			cfg: &mockDeleteUserSavedSearchConfig{
+				expectedDeleteRequest: gcpspanner.DeleteUserSavedSearchRequest{
+					RequestingUserID: "user1",
+					SavedSearchID:    "saved-search-id",
+					Force:            false,
+				},
+				returnedError: gcpspanner.ErrMissingRequiredRole,
 			},
-			expectedErr: backendtypes.ErrEntityDoesNotExist,
+			userID:        "user1",
+			savedSearchID: "saved-search-id",
+			force:         false,
+			expectedErr:   backendtypes.ErrUserNotAuthorizedForAction,
 		},
 		{
-			name: "unknown error",
-			cfg: &mockAddUserSearchBookmarkConfig{
-				expectedRequest: bookmark,
-				returnedError:   errTest,
+			name: "entity does not exist error",
+			cfg: &mockDeleteUserSavedSearchConfig{
+				expectedDeleteRequest: gcpspanner.DeleteUserSavedSearchRequest{
+					RequestingUserID: "user1",
+					SavedSearchID:    "saved-search-id",
+					Force:            false,
+				},
+				returnedError: gcpspanner.ErrQueryReturnedNoResults,
 			},
-			expectedErr: errTest,
+			userID:        "user1",
+			savedSearchID: "saved-search-id",
+			force:         false,
+			expectedErr:   backendtypes.ErrEntityDoesNotExist,
+		},
+		{
+			name: "referenced by other saved searches error",
+			cfg: &mockDeleteUserSavedSearchConfig{
+				expectedDeleteRequest: gcpspanner.DeleteUserSavedSearchRequest{
+					RequestingUserID: "user1",
+					SavedSearchID:    "saved-search-id",
+					Force:            false,
+				},
+				returnedError: gcpspanner.ErrSavedSearchReferenced,
+			},
+			userID:        "user1",
+			savedSearchID: "saved-search-id",
+			force:         false,
+			expectedErr:   backendtypes.ErrSavedSearchReferenced,
+		},
+		{
+			name: "success with force",
+			cfg: &mockDeleteUserSavedSearchConfig{
+				expectedDeleteRequest: gcpspanner.DeleteUserSavedSearchRequest{
+					RequestingUserID: "user1",
+					SavedSearchID:    "saved-search-id",
+					Force:            true,
+				},
+				returnedError: nil,
+			},
+			userID:        "user1",
+			savedSearchID: "saved-search-id",
+			force:         true,
+			expectedErr:   nil,
 		},
 	}
-
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			//nolint: exhaustruct
 			mock := mockBackendSpannerClient{
 				t:                            t,
-				mockAddUserSearchBookmarkCfg: tc.cfg,
+				mockDeleteUserSavedSearchCfg: tc.cfg,
 			}
 			bk := NewBackend(mock)
-			err := bk.PutUserSavedSearchBookmark(context.Background(), userID, savedSearchID)
+			err := bk.DeleteUserSavedSearch(context.Background(), tc.userID, tc.savedSearchID, tc.force)
 
 			if !errors.Is(err, tc.expectedErr) {
 				t.Errorf("unexpected error %s", err)
@@ -3803,487 +4696,2926 @@ func TestAddUserSavedSearchBookmark(t *testing.T) {
 	}
 }
 
-// nolint: dupl // WONTFIX
-func TestRemoveUserSavedSearchBookmark(t *testing.T) {
-	userID := "test-remove-user"
-	savedSearchID := "test-remove-id"
-	bookmark := gcpspanner.UserSavedSearchBookmark{
-		UserID:        "test-remove-user",
-		SavedSearchID: "test-remove-id",
+func TestGetSavedSearchReferences_NotFound(t *testing.T) {
+	//nolint: exhaustruct
+	mock := mockBackendSpannerClient{
+		t:                     t,
+		mockGetSavedSearchCfg: &mockGetSavedSearchConfig{results: nil, errs: nil},
+	}
+	_, err := NewBackend(mock).GetSavedSearchReferences(context.Background(), "missing", nil)
+	if !errors.Is(err, backendtypes.ErrEntityDoesNotExist) {
+		t.Errorf("expected ErrEntityDoesNotExist, got %v", err)
+	}
+}
+
+func TestGetSavedSearchReferences_Inbound(t *testing.T) {
+	//nolint: exhaustruct
+	mock := mockBackendSpannerClient{
+		t: t,
+		mockGetSavedSearchCfg: &mockGetSavedSearchConfig{
+			results: map[string]*gcpspanner.SavedSearch{
+				"a": {ID: "a", Name: "A", Query: ""},
+				"b": {ID: "b", Name: "B", Query: "saved:a"},
+				"c": {ID: "c", Name: "C", Query: "saved:a"},
+				"d": {ID: "d", Name: "D", Query: "saved:b"},
+			},
+			errs: nil,
+		},
+		mockGetReferencingSavedSearchIDsCfg: &mockGetReferencingSavedSearchIDsConfig{
+			results: map[string][]string{
+				"a": {"c", "b"},
+				"b": {"d"},
+				// A cycle back to the saved search is only listed once.
+				"d": {"a"},
+				// Removed since it was found.
+				"c": {"removed"},
+			},
+			errs: nil,
+		},
+	}
+	refs, err := NewBackend(mock).GetSavedSearchReferences(context.Background(), "a", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := &backend.SavedSearchReferences{
+		Inbound: []backend.SavedSearchReference{
+			{Id: "b", Name: new("B"), Kind: backend.SavedSearchReferenceKindSavedSearch, Depth: 1, Exists: true},
+			{Id: "c", Name: new("C"), Kind: backend.SavedSearchReferenceKindSavedSearch, Depth: 1, Exists: true},
+			{Id: "d", Name: new("D"), Kind: backend.SavedSearchReferenceKindSavedSearch, Depth: 2, Exists: true},
+		},
+		Outbound: []backend.SavedSearchReference{},
+	}
+	if diff := cmp.Diff(expected, refs); diff != "" {
+		t.Errorf("references mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGetSavedSearchReferences_InboundUnlisted(t *testing.T) {
+	unlisted := func(id string) *gcpspanner.SavedSearch {
+		//nolint: exhaustruct
+		return &gcpspanner.SavedSearch{
+			ID: id, Name: strings.ToUpper(id), Query: "saved:a", Scope: gcpspanner.UserPublicScope, Listed: false}
 	}
 	testCases := []struct {
-		name        string
-		cfg         *mockDeleteUserSearchBookmarkConfig
-		expectedErr error
+		name           string
+		userID         *string
+		userSearchCfg  *mockGetUserSavedSearchConfig
+		expectedResult []backend.SavedSearchReference
 	}{
 		{
-			name: "success",
-			cfg: &mockDeleteUserSearchBookmarkConfig{
-				expectedRequest: bookmark,
-				returnedError:   nil,
-			},
-			expectedErr: nil,
+			name:           "unauthenticated user",
+			userID:         nil,
+			userSearchCfg:  nil,
+			expectedResult: []backend.SavedSearchReference{},
 		},
 		{
-			name: "owner cannot delete bookmark error",
-			cfg: &mockDeleteUserSearchBookmarkConfig{
-				expectedRequest: bookmark,
-				returnedError:   gcpspanner.ErrOwnerCannotDeleteBookmark,
+			name:   "user without a role",
+			userID: new("user1"),
+			userSearchCfg: &mockGetUserSavedSearchConfig{
+				expectedSavedSearchID:       "b",
+				expectedAuthenticatedUserID: new("user1"),
+				result: &gcpspanner.UserSavedSearch{
+					SavedSearch:  *unlisted("b"),
+					Role:         nil,
+					IsBookmarked: nil,
+				},
+				returnedError: nil,
 			},
-			expectedErr: backendtypes.ErrUserNotAuthorizedForAction,
+			expectedResult: []backend.SavedSearchReference{},
 		},
 		{
-			name: "not found error",
-			cfg: &mockDeleteUserSearchBookmarkConfig{
-				expectedRequest: bookmark,
-				returnedError:   gcpspanner.ErrQueryReturnedNoResults,
+			name:   "user with a role",
+			userID: new("user1"),
+			userSearchCfg: &mockGetUserSavedSearchConfig{
+				expectedSavedSearchID:       "b",
+				expectedAuthenticatedUserID: new("user1"),
+				result: &gcpspanner.UserSavedSearch{
+					SavedSearch:  *unlisted("b"),
+					Role:         new(string(gcpspanner.SavedSearchViewer)),
+					IsBookmarked: new(false),
+				},
+				returnedError: nil,
 			},
-			expectedErr: backendtypes.ErrEntityDoesNotExist,
-		},
-		{
-			name: "unknown error",
-			cfg: &mockDeleteUserSearchBookmarkConfig{
-				expectedRequest: bookmark,
-				returnedError:   errTest,
+			expectedResult: []backend.SavedSearchReference{
+				{Id: "b", Name: new("B"), Kind: backend.SavedSearchReferenceKindSavedSearch, Depth: 1, Exists: true},
 			},
-			expectedErr: errTest,
 		},
 	}
-
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			//nolint: exhaustruct
 			mock := mockBackendSpannerClient{
-				t:                               t,
-				mockDeleteUserSearchBookmarkCfg: tc.cfg,
+				t: t,
+				mockGetSavedSearchCfg: &mockGetSavedSearchConfig{
+					results: map[string]*gcpspanner.SavedSearch{
+						"a": {ID: "a", Name: "A", Query: ""},
+						"b": unlisted("b"),
+					},
+					errs: nil,
+				},
+				mockGetReferencingSavedSearchIDsCfg: &mockGetReferencingSavedSearchIDsConfig{
+					results: map[string][]string{"a": {"b"}},
+					errs:    nil,
+				},
+				mockGetUserSavedSearchCfg: tc.userSearchCfg,
 			}
-			bk := NewBackend(mock)
-			err := bk.RemoveUserSavedSearchBookmark(context.Background(), userID, savedSearchID)
-
-			if !errors.Is(err, tc.expectedErr) {
-				t.Errorf("unexpected error %s", err)
+			refs, err := NewBackend(mock).GetSavedSearchReferences(context.Background(), "a", tc.userID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.expectedResult, refs.Inbound); diff != "" {
+				t.Errorf("inbound references mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestBuildUpdateSavedSearchRequestForGCP(t *testing.T) {
-	testSavedSearchID := "test-id"
-	testUserID := "test-user"
-	testCases := []struct {
-		name string
-		req  *backend.SavedSearchUpdateRequest
-		want gcpspanner.UpdateSavedSearchRequest
-	}{
-		{
-			name: "empty mask gives no update",
-			req: &backend.SavedSearchUpdateRequest{
-				Name:        new("test name"),
-				Description: new("test description"),
-				Query:       new("test query"),
-				UpdateMask:  []backend.SavedSearchUpdateRequestUpdateMask{},
-			},
-			want: gcpspanner.UpdateSavedSearchRequest{
-				ID:       "test-id",
-				AuthorID: "test-user",
-				Name: gcpspanner.OptionallySet[string]{
-					IsSet: false,
-					Value: "",
-				},
-				Description: gcpspanner.OptionallySet[*string]{
-					IsSet: false,
-					Value: nil,
-				},
-				Query: gcpspanner.OptionallySet[string]{
-					IsSet: false,
-					Value: "",
-				},
+func TestGetSavedSearchReferences_Outbound(t *testing.T) {
+	//nolint: exhaustruct
+	mock := mockBackendSpannerClient{
+		t: t,
+		mockGetSavedSearchCfg: &mockGetSavedSearchConfig{
+			results: map[string]*gcpspanner.SavedSearch{
+				"a":    {ID: "a", Name: "A", Query: "saved:b OR hotlist:top OR hotlist:mine OR saved:missing"},
+				"b":    {ID: "b", Name: "B", Query: "saved:c"},
+				"c":    {ID: "c", Name: "C", Query: "saved:a"},
+				"mine": {ID: "mine", Name: "Mine", Query: "hotlist:mine"},
 			},
+			errs: nil,
 		},
-		{
-			name: "update mask contains all fields updates all fields",
-			req: &backend.SavedSearchUpdateRequest{
-				Name:        new("test name"),
-				Description: new("test description"),
-				Query:       new("test query"),
-				UpdateMask: []backend.SavedSearchUpdateRequestUpdateMask{
-					backend.SavedSearchUpdateRequestMaskName,
-					backend.SavedSearchUpdateRequestMaskDescription,
-					backend.SavedSearchUpdateRequestMaskQuery,
-				},
-			},
-			want: gcpspanner.UpdateSavedSearchRequest{
-				ID:       "test-id",
-				AuthorID: "test-user",
-				Name: gcpspanner.OptionallySet[string]{
-					IsSet: true,
-					Value: "test name",
-				},
-				Description: gcpspanner.OptionallySet[*string]{
-					IsSet: true,
-					Value: new("test description"),
-				},
-				Query: gcpspanner.OptionallySet[string]{
-					IsSet: true,
-					Value: "test query",
-				},
+		mockGetSystemGlobalSavedSearchCfg: &mockGetSystemGlobalSavedSearchConfig{
+			results: map[string]*gcpspanner.SystemGlobalSavedSearchWithSortOption{
+				"top": newMockSystemGlobalSavedSearchWithSortOption("top", "", true),
 			},
+			errs: nil,
 		},
 	}
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			req := buildUpdateSavedSearchRequestForGCP(testSavedSearchID, testUserID, tc.req)
-			if !reflect.DeepEqual(req, tc.want) {
-				t.Errorf("unexpected request %v", req)
-			}
-		})
+	refs, err := NewBackend(mock).GetSavedSearchReferences(context.Background(), "a", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := &backend.SavedSearchReferences{
+		Inbound: []backend.SavedSearchReference{},
+		Outbound: []backend.SavedSearchReference{
+			{Id: "b", Name: new("B"), Kind: backend.SavedSearchReferenceKindSavedSearch, Depth: 1, Exists: true},
+			{Id: "top", Name: new("Mock Name"), Kind: backend.SavedSearchReferenceKindHotlist, Depth: 1, Exists: true},
+			{Id: "mine", Name: new("Mine"), Kind: backend.SavedSearchReferenceKindHotlist, Depth: 1, Exists: true},
+			{Id: "missing", Name: nil, Kind: backend.SavedSearchReferenceKindSavedSearch, Depth: 1, Exists: false},
+			// The reference from c back to a is not listed.
+			{Id: "c", Name: new("C"), Kind: backend.SavedSearchReferenceKindSavedSearch, Depth: 2, Exists: true},
+		},
+	}
+	if diff := cmp.Diff(expected, refs); diff != "" {
+		t.Errorf("references mismatch (-want +got):\n%s", diff)
 	}
 }
 
-func TestGetFeatureSearchSortOrder(t *testing.T) {
-	sortOrderTests := []struct {
-		input *backend.ListFeaturesParamsSort
-		want  gcpspanner.Sortable
+func TestGetSavedSearch(t *testing.T) {
+	testCases := []struct {
+		name           string
+		cfg            *mockGetUserSavedSearchConfig
+		userID         *string
+		savedSearchID  string
+		expectedOutput *backend.SavedSearchResponse
+		expectedError  error
 	}{
-		{input: nil, want: gcpspanner.NewBaselineStatusSort(false)},
 		{
-			input: new(backend.NameAsc),
-			want:  gcpspanner.NewFeatureNameSort(true),
-		},
-		{
-			input: new(backend.NameDesc),
-			want:  gcpspanner.NewFeatureNameSort(false),
+			name:          "success authenticated user",
+			userID:        new("user1"),
+			savedSearchID: "saved-search-id",
+			cfg: &mockGetUserSavedSearchConfig{
+				expectedAuthenticatedUserID: new("user1"),
+				expectedSavedSearchID:       "saved-search-id",
+				result: &gcpspanner.UserSavedSearch{
+					SavedSearch: gcpspanner.SavedSearch{
+						Name:            "test search",
+						Description:     new("test description"),
+						Query:           "test query",
+						Scope:           gcpspanner.UserPublicScope,
+						AuthorID:        "user1",
+						CreatedAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						ID:              "saved-search-id",
+						Tags:            nil,
+						Listed:          false,
+						QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
+					},
+					Role:         new(string(gcpspanner.SavedSearchOwner)),
+					IsBookmarked: new(true),
+				},
+				returnedError: nil,
+			},
+			expectedOutput: &backend.SavedSearchResponse{
+				Id:          "saved-search-id",
+				CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+				Name:        "test search",
+				Query:       "test query",
+				Description: new("test description"),
+				BookmarkStatus: &backend.UserSavedSearchBookmark{
+					Status: backend.BookmarkActive,
+				},
+				Permissions: &backend.UserSavedSearchPermissions{
+					Role: new(backend.SavedSearchOwner),
+				},
+				ForkedFrom: nil,
+				Tags:       nil,
+				Listed:     new(false),
+				Parameters: nil,
+			},
+			expectedError: nil,
 		},
 		{
-			input: new(backend.BaselineStatusAsc),
-			want:  gcpspanner.NewBaselineStatusSort(true),
+			name:          "success unauthenticated user",
+			userID:        nil,
+			savedSearchID: "saved-search-id",
+			cfg: &mockGetUserSavedSearchConfig{
+				expectedAuthenticatedUserID: nil,
+				expectedSavedSearchID:       "saved-search-id",
+				result: &gcpspanner.UserSavedSearch{
+					SavedSearch: gcpspanner.SavedSearch{
+						Name:            "test search",
+						Description:     new("test description"),
+						Query:           "test query",
+						Scope:           gcpspanner.UserPublicScope,
+						AuthorID:        "user1",
+						CreatedAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						ID:              "saved-search-id",
+						Tags:            nil,
+						Listed:          false,
+						QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
+					},
+					Role:         nil,
+					IsBookmarked: nil,
+				},
+				returnedError: nil,
+			},
+			expectedOutput: &backend.SavedSearchResponse{
+				Id:             "saved-search-id",
+				CreatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:      time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+				Name:           "test search",
+				Query:          "test query",
+				Description:    new("test description"),
+				BookmarkStatus: nil,
+				Permissions:    nil,
+				ForkedFrom:     nil,
+				Tags:           nil,
+				Listed:         new(false),
+				Parameters:     nil,
+			},
+			expectedError: nil,
 		},
 		{
-			input: new(backend.BaselineStatusDesc),
-			want:  gcpspanner.NewBaselineStatusSort(false),
+			name:          "search not found error",
+			userID:        nil,
+			savedSearchID: "saved-search-id",
+			cfg: &mockGetUserSavedSearchConfig{
+				expectedAuthenticatedUserID: nil,
+				expectedSavedSearchID:       "saved-search-id",
+				result:                      nil,
+				returnedError:               gcpspanner.ErrQueryReturnedNoResults,
+			},
+			expectedOutput: nil,
+			expectedError:  backendtypes.ErrEntityDoesNotExist,
 		},
 		{
-			input: new(backend.ExperimentalChromeAsc),
-			want:  gcpspanner.NewBrowserImplSort(true, "chrome", false),
+			name:          "general error",
+			userID:        nil,
+			savedSearchID: "saved-search-id",
+			cfg: &mockGetUserSavedSearchConfig{
+				expectedAuthenticatedUserID: nil,
+				expectedSavedSearchID:       "saved-search-id",
+				result:                      nil,
+				returnedError:               errTest,
+			},
+			expectedOutput: nil,
+			expectedError:  errTest,
 		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                         t,
+				mockGetUserSavedSearchCfg: tc.cfg,
+			}
+			bk := NewBackend(mock)
+			output, err := bk.GetSavedSearch(context.Background(), tc.savedSearchID, tc.userID)
+
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("unexpected error %s", err)
+			}
+
+			if !reflect.DeepEqual(output, tc.expectedOutput) {
+				t.Errorf("unexpected output %v", output)
+			}
+		})
+	}
+}
+
+func TestListUserSavedSearches(t *testing.T) {
+	testCases := []struct {
+		name          string
+		userID        string
+		pageSize      int
+		pageToken     *string
+		cfg           *mockListUserSavedSearchesConfig
+		expectedPage  *backend.UserSavedSearchPage
+		expectedError error
+	}{
 		{
-			input: new(backend.ExperimentalChromeDesc),
-			want:  gcpspanner.NewBrowserImplSort(false, "chrome", false),
+			name:      "success",
+			userID:    "user1",
+			pageSize:  10,
+			pageToken: nil,
+			cfg: &mockListUserSavedSearchesConfig{
+				expectedUserID:    "user1",
+				expectedPageSize:  10,
+				expectedPageToken: nil,
+				result: &gcpspanner.UserSavedSearchesPage{
+					NextPageToken: nil,
+					Searches: []gcpspanner.UserSavedSearch{
+						{
+							SavedSearch: gcpspanner.SavedSearch{
+								Name:            "z",
+								Description:     new("test description"),
+								Query:           "test query",
+								Scope:           gcpspanner.UserPublicScope,
+								AuthorID:        "user1",
+								CreatedAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+								UpdatedAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+								ID:              "saved-search-id-2",
+								Tags:            nil,
+								Listed:          false,
+								QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
+							},
+							IsBookmarked: new(true),
+							Role:         nil,
+						},
+					},
+				},
+				returnedError: nil,
+			},
+			expectedPage: &backend.UserSavedSearchPage{
+				Metadata: nil,
+				Data: new([]backend.SavedSearchResponse{
+					{
+						Id:          "saved-search-id-2",
+						CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						Name:        "z",
+						Description: new("test description"),
+						Query:       "test query",
+						Permissions: nil,
+						BookmarkStatus: &backend.UserSavedSearchBookmark{
+							Status: backend.BookmarkActive,
+						},
+						ForkedFrom: nil,
+						Tags:       nil,
+						Listed:     new(false),
+						Parameters: nil,
+					},
+				}),
+			},
+			expectedError: nil,
 		},
 		{
-			input: new(backend.ExperimentalEdgeAsc),
-			want:  gcpspanner.NewBrowserImplSort(true, "edge", false),
+			name:      "success w/ page token",
+			userID:    "user1",
+			pageSize:  10,
+			pageToken: new("inputToken"),
+			cfg: &mockListUserSavedSearchesConfig{
+				expectedUserID:    "user1",
+				expectedPageSize:  10,
+				expectedPageToken: new("inputToken"),
+				result: &gcpspanner.UserSavedSearchesPage{
+					NextPageToken: new("nextToken"),
+					Searches: []gcpspanner.UserSavedSearch{
+						{
+							SavedSearch: gcpspanner.SavedSearch{
+								Name:            "test search",
+								Description:     new("test description"),
+								Query:           "test query",
+								Scope:           gcpspanner.UserPublicScope,
+								AuthorID:        "user1",
+								CreatedAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+								UpdatedAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+								ID:              "saved-search-id",
+								Tags:            nil,
+								Listed:          false,
+								QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
+							},
+							Role:         new(string(gcpspanner.SavedSearchOwner)),
+							IsBookmarked: new(true),
+						},
+						{
+							SavedSearch: gcpspanner.SavedSearch{
+								Name:            "z",
+								Description:     new("test description"),
+								Query:           "test query",
+								Scope:           gcpspanner.UserPublicScope,
+								AuthorID:        "user1",
+								CreatedAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+								UpdatedAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+								ID:              "saved-search-id-2",
+								Tags:            nil,
+								Listed:          false,
+								QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
+							},
+							IsBookmarked: new(true),
+							Role:         nil,
+						},
+					},
+				},
+				returnedError: nil,
+			},
+			expectedPage: &backend.UserSavedSearchPage{
+				Metadata: &backend.PageMetadata{
+					NextPageToken: new("nextToken"),
+				},
+				Data: new([]backend.SavedSearchResponse{
+					{
+						Id:          "saved-search-id",
+						CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						Name:        "test search",
+						Description: new("test description"),
+						Query:       "test query",
+						Permissions: &backend.UserSavedSearchPermissions{
+							Role: new(backend.SavedSearchOwner),
+						},
+						BookmarkStatus: &backend.UserSavedSearchBookmark{
+							Status: backend.BookmarkActive,
+						},
+						ForkedFrom: nil,
+						Tags:       nil,
+						Listed:     new(false),
+						Parameters: nil,
+					},
+					{
+						Id:          "saved-search-id-2",
+						CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						Name:        "z",
+						Description: new("test description"),
+						Query:       "test query",
+						Permissions: nil,
+						BookmarkStatus: &backend.UserSavedSearchBookmark{
+							Status: backend.BookmarkActive,
+						},
+						ForkedFrom: nil,
+						Tags:       nil,
+						Listed:     new(false),
+						Parameters: nil,
+					},
+				}),
+			},
+			expectedError: nil,
 		},
 		{
-			input: new(backend.ExperimentalEdgeDesc),
-			want:  gcpspanner.NewBrowserImplSort(false, "edge", false),
+			name:      "general error",
+			userID:    "user1",
+			pageSize:  10,
+			pageToken: nil,
+			cfg: &mockListUserSavedSearchesConfig{
+				expectedUserID:    "user1",
+				expectedPageSize:  10,
+				expectedPageToken: nil,
+				result:            nil,
+				returnedError:     errTest,
+			},
+			expectedPage:  nil,
+			expectedError: errTest,
 		},
 		{
-			input: new(backend.ExperimentalFirefoxAsc),
-			want:  gcpspanner.NewBrowserImplSort(true, "firefox", false),
+			name:      "invalid cursor",
+			userID:    "user1",
+			pageSize:  10,
+			pageToken: nil,
+			cfg: &mockListUserSavedSearchesConfig{
+				expectedUserID:    "user1",
+				expectedPageSize:  10,
+				expectedPageToken: nil,
+				result:            nil,
+				returnedError:     gcpspanner.ErrInvalidCursorFormat,
+			},
+			expectedPage:  nil,
+			expectedError: backendtypes.ErrInvalidPageToken,
 		},
-		{
-			input: new(backend.ExperimentalFirefoxDesc),
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                            t,
+				mockListUserSavedSearchesCfg: tc.cfg,
+			}
+			backend := NewBackend(mock)
+			page, err := backend.ListUserSavedSearches(context.Background(), tc.userID, tc.pageSize, tc.pageToken)
+			if !errors.Is(err, tc.expectedError) {
+				t.Error("unexpected error")
+			}
+
+			if !reflect.DeepEqual(page, tc.expectedPage) {
+				t.Error("unexpected page")
+			}
+		})
+	}
+}
+
+func TestUpdateUserSavedSearch(t *testing.T) {
+	testSavedSearchID := "test-id"
+	testUserID := "test-user"
+
+	testCases := []struct {
+		name          string
+		updateRequest *backend.SavedSearchUpdateRequest
+		mockUpdateCfg *mockUpdateUserSavedSearchConfig
+		mockGetCfg    *mockGetUserSavedSearchConfig
+		expectedResp  *backend.SavedSearchResponse
+		expectedError error
+	}{
+		{
+			name: "success",
+			updateRequest: &backend.SavedSearchUpdateRequest{
+				Name:        new("test search name"),
+				Description: new("test desc"),
+				Query:       new("test query"),
+				UpdateMask: []backend.SavedSearchUpdateRequestUpdateMask{
+					backend.SavedSearchUpdateRequestMaskName,
+					backend.SavedSearchUpdateRequestMaskDescription,
+					backend.SavedSearchUpdateRequestMaskQuery,
+				},
+				Tags:       nil,
+				Listed:     nil,
+				Parameters: nil,
+			},
+			mockUpdateCfg: &mockUpdateUserSavedSearchConfig{
+				expectedRequest: gcpspanner.UpdateSavedSearchRequest{
+					ID:       "test-id",
+					AuthorID: "test-user",
+					Name: gcpspanner.OptionallySet[string]{
+						IsSet: true,
+						Value: "test search name",
+					},
+					Description: gcpspanner.OptionallySet[*string]{
+						IsSet: true,
+						Value: new("test desc"),
+					},
+					Query: gcpspanner.OptionallySet[string]{
+						IsSet: true,
+						Value: "test query",
+					},
+					Tags:            gcpspanner.OptionallySet[[]string]{Value: nil, IsSet: false},
+					Listed:          gcpspanner.OptionallySet[bool]{Value: false, IsSet: false},
+					QueryParameters: gcpspanner.OptionallySet[[]gcpspanner.SavedSearchQueryParameter]{Value: nil, IsSet: false},
+				},
+				returnedError: nil,
+			},
+			mockGetCfg: &mockGetUserSavedSearchConfig{
+				expectedAuthenticatedUserID: new("test-user"),
+				expectedSavedSearchID:       "test-id",
+				result: &gcpspanner.UserSavedSearch{
+					SavedSearch: gcpspanner.SavedSearch{
+						ID:              "test-id",
+						Name:            "test search name",
+						Description:     new("test desc"),
+						Query:           "test query",
+						Scope:           gcpspanner.UserPublicScope,
+						AuthorID:        "test-user",
+						CreatedAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						UpdatedAt:       time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+						Tags:            nil,
+						Listed:          false,
+						QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
+					},
+					Role:         new(string(gcpspanner.SavedSearchOwner)),
+					IsBookmarked: new(true),
+				},
+				returnedError: nil,
+			},
+			expectedResp: &backend.SavedSearchResponse{
+				Id:          "test-id",
+				CreatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+				UpdatedAt:   time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+				Name:        "test search name",
+				Description: new("test desc"),
+				Query:       "test query",
+				Permissions: &backend.UserSavedSearchPermissions{
+					Role: new(backend.SavedSearchOwner),
+				},
+				BookmarkStatus: &backend.UserSavedSearchBookmark{
+					Status: backend.BookmarkActive,
+				},
+				ForkedFrom: nil,
+				Tags:       nil,
+				Listed:     new(false),
+				Parameters: nil,
+			},
+			expectedError: nil,
+		},
+		{
+			name: "get user saved search return no results",
+			updateRequest: &backend.SavedSearchUpdateRequest{
+				Name:        new("test search name"),
+				Description: new("test desc"),
+				Query:       new("test query"),
+				UpdateMask: []backend.SavedSearchUpdateRequestUpdateMask{
+					backend.SavedSearchUpdateRequestMaskName,
+					backend.SavedSearchUpdateRequestMaskDescription,
+					backend.SavedSearchUpdateRequestMaskQuery,
+				},
+				Tags:       nil,
+				Listed:     nil,
+				Parameters: nil,
+			},
+			mockUpdateCfg: &mockUpdateUserSavedSearchConfig{
+				expectedRequest: gcpspanner.UpdateSavedSearchRequest{
+					ID:       "test-id",
+					AuthorID: "test-user",
+					Name: gcpspanner.OptionallySet[string]{
+						IsSet: true,
+						Value: "test search name",
+					},
+					Description: gcpspanner.OptionallySet[*string]{
+						IsSet: true,
+						Value: new("test desc"),
+					},
+					Query: gcpspanner.OptionallySet[string]{
+						IsSet: true,
+						Value: "test query",
+					},
+					Tags:            gcpspanner.OptionallySet[[]string]{Value: nil, IsSet: false},
+					Listed:          gcpspanner.OptionallySet[bool]{Value: false, IsSet: false},
+					QueryParameters: gcpspanner.OptionallySet[[]gcpspanner.SavedSearchQueryParameter]{Value: nil, IsSet: false},
+				},
+				returnedError: nil,
+			},
+			mockGetCfg: &mockGetUserSavedSearchConfig{
+				expectedAuthenticatedUserID: new("test-user"),
+				expectedSavedSearchID:       "test-id",
+				result:                      nil,
+				returnedError:               gcpspanner.ErrQueryReturnedNoResults,
+			},
+			expectedResp:  nil,
+			expectedError: backendtypes.ErrEntityDoesNotExist,
+		},
+		{
+			name: "get user saved search returns other error",
+			updateRequest: &backend.SavedSearchUpdateRequest{
+				Name:        new("test search name"),
+				Description: new("test desc"),
+				Query:       new("test query"),
+				UpdateMask: []backend.SavedSearchUpdateRequestUpdateMask{
+					backend.SavedSearchUpdateRequestMaskName,
+					backend.SavedSearchUpdateRequestMaskDescription,
+					backend.SavedSearchUpdateRequestMaskQuery,
+				},
+				Tags:       nil,
+				Listed:     nil,
+				Parameters: nil,
+			},
+			mockUpdateCfg: &mockUpdateUserSavedSearchConfig{
+				expectedRequest: gcpspanner.UpdateSavedSearchRequest{
+					ID:       "test-id",
+					AuthorID: "test-user",
+					Name: gcpspanner.OptionallySet[string]{
+						IsSet: true,
+						Value: "test search name",
+					},
+					Description: gcpspanner.OptionallySet[*string]{
+						IsSet: true,
+						Value: new("test desc"),
+					},
+					Query: gcpspanner.OptionallySet[string]{
+						IsSet: true,
+						Value: "test query",
+					},
+					Tags:            gcpspanner.OptionallySet[[]string]{Value: nil, IsSet: false},
+					Listed:          gcpspanner.OptionallySet[bool]{Value: false, IsSet: false},
+					QueryParameters: gcpspanner.OptionallySet[[]gcpspanner.SavedSearchQueryParameter]{Value: nil, IsSet: false},
+				},
+				returnedError: nil,
+			},
+			mockGetCfg: &mockGetUserSavedSearchConfig{
+				expectedAuthenticatedUserID: new("test-user"),
+				expectedSavedSearchID:       "test-id",
+				result:                      nil,
+				returnedError:               errTest,
+			},
+			expectedResp:  nil,
+			expectedError: errTest,
+		},
+		{
+			name: "update user saved search return no results",
+			updateRequest: &backend.SavedSearchUpdateRequest{
+				Name:        new("test search name"),
+				Description: new("test desc"),
+				Query:       new("test query"),
+				UpdateMask: []backend.SavedSearchUpdateRequestUpdateMask{
+					backend.SavedSearchUpdateRequestMaskName,
+					backend.SavedSearchUpdateRequestMaskDescription,
+					backend.SavedSearchUpdateRequestMaskQuery,
+				},
+				Tags:       nil,
+				Listed:     nil,
+				Parameters: nil,
+			},
+			mockUpdateCfg: &mockUpdateUserSavedSearchConfig{
+				expectedRequest: gcpspanner.UpdateSavedSearchRequest{
+					ID:       "test-id",
+					AuthorID: "test-user",
+					Name: gcpspanner.OptionallySet[string]{
+						IsSet: true,
+						Value: "test search name",
+					},
+					Description: gcpspanner.OptionallySet[*string]{
+						IsSet: true,
+						Value: new("test desc"),
+					},
+					Query: gcpspanner.OptionallySet[string]{
+						IsSet: true,
+						Value: "test query",
+					},
+					Tags:            gcpspanner.OptionallySet[[]string]{Value: nil, IsSet: false},
+					Listed:          gcpspanner.OptionallySet[bool]{Value: false, IsSet: false},
+					QueryParameters: gcpspanner.OptionallySet[[]gcpspanner.SavedSearchQueryParameter]{Value: nil, IsSet: false},
+				},
+				returnedError: gcpspanner.ErrQueryReturnedNoResults,
+			},
+			mockGetCfg:    nil,
+			expectedResp:  nil,
+			expectedError: backendtypes.ErrEntityDoesNotExist,
+		},
+		{
+			name: "update user saved search return no required role error",
+			updateRequest: &backend.SavedSearchUpdateRequest{
+				Name:        new("test search name"),
+				Description: new("test desc"),
+				Query:       new("test query"),
+				UpdateMask: []backend.SavedSearchUpdateRequestUpdateMask{
+					backend.SavedSearchUpdateRequestMaskName,
+					backend.SavedSearchUpdateRequestMaskDescription,
+					backend.SavedSearchUpdateRequestMaskQuery,
+				},
+				Tags:       nil,
+				Listed:     nil,
+				Parameters: nil,
+			},
+			mockUpdateCfg: &mockUpdateUserSavedSearchConfig{
+				expectedRequest: gcpspanner.UpdateSavedSearchRequest{
+					ID:       "test-id",
+					AuthorID: "test-user",
+					Name: gcpspanner.OptionallySet[string]{
+						IsSet: true,
+						Value: "test search name",
+					},
+					Description: gcpspanner.OptionallySet[*string]{
+						IsSet: true,
+						Value: new("test desc"),
+					},
+					Query: gcpspanner.OptionallySet[string]{
+						IsSet: true,
+						Value: "test query",
+					},
+					Tags:            gcpspanner.OptionallySet[[]string]{Value: nil, IsSet: false},
+					Listed:          gcpspanner.OptionallySet[bool]{Value: false, IsSet: false},
+					QueryParameters: gcpspanner.OptionallySet[[]gcpspanner.SavedSearchQueryParameter]{Value: nil, IsSet: false},
+				},
+				returnedError: gcpspanner.ErrMissingRequiredRole,
+			},
+			mockGetCfg:    nil,
+			expectedResp:  nil,
+			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
+		},
+		{
+			name: "update user saved search return other error",
+			updateRequest: &backend.SavedSearchUpdateRequest{
+				Name:        new("test search name"),
+				Description: new("test desc"),
+				Query:       new("test query"),
+				UpdateMask: []backend.SavedSearchUpdateRequestUpdateMask{
+					backend.SavedSearchUpdateRequestMaskName,
+					backend.SavedSearchUpdateRequestMaskDescription,
+					backend.SavedSearchUpdateRequestMaskQuery,
+				},
+				Tags:       nil,
+				Listed:     nil,
+				Parameters: nil,
+			},
+			mockUpdateCfg: &mockUpdateUserSavedSearchConfig{
+				expectedRequest: gcpspanner.UpdateSavedSearchRequest{
+					ID:       "test-id",
+					AuthorID: "test-user",
+					Name: gcpspanner.OptionallySet[string]{
+						IsSet: true,
+						Value: "test search name",
+					},
+					Description: gcpspanner.OptionallySet[*string]{
+						IsSet: true,
+						Value: new("test desc"),
+					},
+					Query: gcpspanner.OptionallySet[string]{
+						IsSet: true,
+						Value: "test query",
+					},
+					Tags:            gcpspanner.OptionallySet[[]string]{Value: nil, IsSet: false},
+					Listed:          gcpspanner.OptionallySet[bool]{Value: false, IsSet: false},
+					QueryParameters: gcpspanner.OptionallySet[[]gcpspanner.SavedSearchQueryParameter]{Value: nil, IsSet: false},
+				},
+				returnedError: errTest,
+			},
+			mockGetCfg:    nil,
+			expectedResp:  nil,
+			expectedError: errTest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                            t,
+				mockGetUserSavedSearchCfg:    tc.mockGetCfg,
+				mockUpdateUserSavedSearchCfg: tc.mockUpdateCfg,
+			}
+
+			backend := NewBackend(mock)
+			resp, err := backend.UpdateUserSavedSearch(
+				context.Background(),
+				testSavedSearchID,
+				testUserID,
+				tc.updateRequest,
+			)
+			if !errors.Is(err, tc.expectedError) {
+				t.Error("unexpected error")
+			}
+
+			if !reflect.DeepEqual(resp, tc.expectedResp) {
+				t.Error("unexpected response")
+			}
+		})
+	}
+}
+
+// nolint: dupl // WONTFIX
+func TestAddUserSavedSearchBookmark(t *testing.T) {
+	userID := "test-add-user"
+	savedSearchID := "test-add-id"
+	bookmark := gcpspanner.UserSavedSearchBookmark{
+		UserID:        "test-add-user",
+		SavedSearchID: "test-add-id",
+	}
+	testCases := []struct {
+		name        string
+		cfg         *mockAddUserSearchBookmarkConfig
+		expectedErr error
+	}{
+		{
+			name: "success",
+			cfg: &mockAddUserSearchBookmarkConfig{
+				expectedRequest: bookmark,
+				returnedError:   nil,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "limit exceeded error",
+			cfg: &mockAddUserSearchBookmarkConfig{
+				expectedRequest: bookmark,
+				returnedError:   gcpspanner.ErrUserSearchBookmarkLimitExceeded,
+			},
+			expectedErr: backendtypes.ErrUserMaxBookmarks,
+		},
+		{
+			name: "not found error",
+			cfg: &mockAddUserSearchBookmarkConfig{
+				expectedRequest: bookmark,
+				returnedError:   gcpspanner.ErrQueryReturnedNoResults,
+			},
+			expectedErr: backendtypes.ErrEntityDoesNotExist,
+		},
+		{
+			name: "unknown error",
+			cfg: &mockAddUserSearchBookmarkConfig{
+				expectedRequest: bookmark,
+				returnedError:   errTest,
+			},
+			expectedErr: errTest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                            t,
+				mockAddUserSearchBookmarkCfg: tc.cfg,
+			}
+			bk := NewBackend(mock)
+			err := bk.PutUserSavedSearchBookmark(context.Background(), userID, savedSearchID)
+
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("unexpected error %s", err)
+			}
+		})
+	}
+}
+
+// nolint: dupl // WONTFIX
+func TestRemoveUserSavedSearchBookmark(t *testing.T) {
+	userID := "test-remove-user"
+	savedSearchID := "test-remove-id"
+	bookmark := gcpspanner.UserSavedSearchBookmark{
+		UserID:        "test-remove-user",
+		SavedSearchID: "test-remove-id",
+	}
+	testCases := []struct {
+		name        string
+		cfg         *mockDeleteUserSearchBookmarkConfig
+		expectedErr error
+	}{
+		{
+			name: "success",
+			cfg: &mockDeleteUserSearchBookmarkConfig{
+				expectedRequest: bookmark,
+				returnedError:   nil,
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "owner cannot delete bookmark error",
+			cfg: &mockDeleteUserSearchBookmarkConfig{
+				expectedRequest: bookmark,
+				returnedError:   gcpspanner.ErrOwnerCannotDeleteBookmark,
+			},
+			expectedErr: backendtypes.ErrUserNotAuthorizedForAction,
+		},
+		{
+			name: "not found error",
+			cfg: &mockDeleteUserSearchBookmarkConfig{
+				expectedRequest: bookmark,
+				returnedError:   gcpspanner.ErrQueryReturnedNoResults,
+			},
+			expectedErr: backendtypes.ErrEntityDoesNotExist,
+		},
+		{
+			name: "unknown error",
+			cfg: &mockDeleteUserSearchBookmarkConfig{
+				expectedRequest: bookmark,
+				returnedError:   errTest,
+			},
+			expectedErr: errTest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                               t,
+				mockDeleteUserSearchBookmarkCfg: tc.cfg,
+			}
+			bk := NewBackend(mock)
+			err := bk.RemoveUserSavedSearchBookmark(context.Background(), userID, savedSearchID)
+
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("unexpected error %s", err)
+			}
+		})
+	}
+}
+
+func TestBuildUpdateSavedSearchRequestForGCP(t *testing.T) {
+	testSavedSearchID := "test-id"
+	testUserID := "test-user"
+	testCases := []struct {
+		name string
+		req  *backend.SavedSearchUpdateRequest
+		want gcpspanner.UpdateSavedSearchRequest
+	}{
+		{
+			name: "empty mask gives no update",
+			req: &backend.SavedSearchUpdateRequest{
+				Name:        new("test name"),
+				Description: new("test description"),
+				Query:       new("test query"),
+				UpdateMask:  []backend.SavedSearchUpdateRequestUpdateMask{},
+				Tags:        nil,
+				Listed:      nil,
+				Parameters:  nil,
+			},
+			want: gcpspanner.UpdateSavedSearchRequest{
+				ID:       "test-id",
+				AuthorID: "test-user",
+				Name: gcpspanner.OptionallySet[string]{
+					IsSet: false,
+					Value: "",
+				},
+				Description: gcpspanner.OptionallySet[*string]{
+					IsSet: false,
+					Value: nil,
+				},
+				Query: gcpspanner.OptionallySet[string]{
+					IsSet: false,
+					Value: "",
+				},
+				Tags:            gcpspanner.OptionallySet[[]string]{Value: nil, IsSet: false},
+				Listed:          gcpspanner.OptionallySet[bool]{Value: false, IsSet: false},
+				QueryParameters: gcpspanner.OptionallySet[[]gcpspanner.SavedSearchQueryParameter]{Value: nil, IsSet: false},
+			},
+		},
+		{
+			name: "update mask contains all fields updates all fields",
+			req: &backend.SavedSearchUpdateRequest{
+				Name:        new("test name"),
+				Description: new("test description"),
+				Query:       new("test query"),
+				UpdateMask: []backend.SavedSearchUpdateRequestUpdateMask{
+					backend.SavedSearchUpdateRequestMaskName,
+					backend.SavedSearchUpdateRequestMaskDescription,
+					backend.SavedSearchUpdateRequestMaskQuery,
+				},
+				Tags:       nil,
+				Listed:     nil,
+				Parameters: nil,
+			},
+			want: gcpspanner.UpdateSavedSearchRequest{
+				ID:       "test-id",
+				AuthorID: "test-user",
+				Name: gcpspanner.OptionallySet[string]{
+					IsSet: true,
+					Value: "test name",
+				},
+				Description: gcpspanner.OptionallySet[*string]{
+					IsSet: true,
+					Value: new("test description"),
+				},
+				Query: gcpspanner.OptionallySet[string]{
+					IsSet: true,
+					Value: "test query",
+				},
+				Tags:            gcpspanner.OptionallySet[[]string]{Value: nil, IsSet: false},
+				Listed:          gcpspanner.OptionallySet[bool]{Value: false, IsSet: false},
+				QueryParameters: gcpspanner.OptionallySet[[]gcpspanner.SavedSearchQueryParameter]{Value: nil, IsSet: false},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := buildUpdateSavedSearchRequestForGCP(testSavedSearchID, testUserID, tc.req)
+			if !reflect.DeepEqual(req, tc.want) {
+				t.Errorf("unexpected request %v", req)
+			}
+		})
+	}
+}
+
+func TestGetFeatureSearchSortOrder(t *testing.T) {
+	sortOrderTests := []struct {
+		input *backend.ListFeaturesParamsSort
+		want  gcpspanner.Sortable
+	}{
+		{input: nil, want: gcpspanner.NewBaselineStatusSort(false)},
+		{
+			input: new(backend.NameAsc),
+			want:  gcpspanner.NewFeatureNameSort(true),
+		},
+		{
+			input: new(backend.NameDesc),
+			want:  gcpspanner.NewFeatureNameSort(false),
+		},
+		{
+			input: new(backend.BaselineStatusAsc),
+			want:  gcpspanner.NewBaselineStatusSort(true),
+		},
+		{
+			input: new(backend.BaselineStatusDesc),
+			want:  gcpspanner.NewBaselineStatusSort(false),
+		},
+		{
+			input: new(backend.ExperimentalChromeAsc),
+			want:  gcpspanner.NewBrowserImplSort(true, "chrome", false),
+		},
+		{
+			input: new(backend.ExperimentalChromeDesc),
+			want:  gcpspanner.NewBrowserImplSort(false, "chrome", false),
+		},
+		{
+			input: new(backend.ExperimentalEdgeAsc),
+			want:  gcpspanner.NewBrowserImplSort(true, "edge", false),
+		},
+		{
+			input: new(backend.ExperimentalEdgeDesc),
+			want:  gcpspanner.NewBrowserImplSort(false, "edge", false),
+		},
+		{
+			input: new(backend.ExperimentalFirefoxAsc),
+			want:  gcpspanner.NewBrowserImplSort(true, "firefox", false),
+		},
+		{
+			input: new(backend.ExperimentalFirefoxDesc),
 			want:  gcpspanner.NewBrowserImplSort(false, "firefox", false),
 		},
 		{
-			input: new(backend.ExperimentalSafariAsc),
-			want:  gcpspanner.NewBrowserImplSort(true, "safari", false),
+			input: new(backend.ExperimentalSafariAsc),
+			want:  gcpspanner.NewBrowserImplSort(true, "safari", false),
+		},
+		{
+			input: new(backend.ExperimentalSafariDesc),
+			want:  gcpspanner.NewBrowserImplSort(false, "safari", false),
+		},
+		{
+			input: new(backend.StableChromeAsc),
+			want:  gcpspanner.NewBrowserImplSort(true, "chrome", true),
+		},
+		{
+			input: new(backend.StableChromeDesc),
+			want:  gcpspanner.NewBrowserImplSort(false, "chrome", true),
+		},
+		{
+			input: new(backend.StableEdgeAsc),
+			want:  gcpspanner.NewBrowserImplSort(true, "edge", true),
+		},
+		{
+			input: new(backend.StableEdgeDesc),
+			want:  gcpspanner.NewBrowserImplSort(false, "edge", true),
+		},
+		{
+			input: new(backend.StableFirefoxAsc),
+			want:  gcpspanner.NewBrowserImplSort(true, "firefox", true),
+		},
+		{
+			input: new(backend.StableFirefoxDesc),
+			want:  gcpspanner.NewBrowserImplSort(false, "firefox", true),
+		},
+		{
+			input: new(backend.StableSafariAsc),
+			want:  gcpspanner.NewBrowserImplSort(true, "safari", true),
+		},
+		{
+			input: new(backend.StableSafariDesc),
+			want:  gcpspanner.NewBrowserImplSort(false, "safari", true),
+		},
+		{
+			input: new(backend.AvailabilityChromeAsc),
+			want:  gcpspanner.NewBrowserFeatureSupportSort(true, "chrome"),
+		},
+		{
+			input: new(backend.AvailabilityChromeDesc),
+			want:  gcpspanner.NewBrowserFeatureSupportSort(false, "chrome"),
+		},
+		{
+			input: new(backend.AvailabilityEdgeAsc),
+			want:  gcpspanner.NewBrowserFeatureSupportSort(true, "edge"),
+		},
+		{
+			input: new(backend.AvailabilityEdgeDesc),
+			want:  gcpspanner.NewBrowserFeatureSupportSort(false, "edge"),
+		},
+		{
+			input: new(backend.AvailabilityFirefoxAsc),
+			want:  gcpspanner.NewBrowserFeatureSupportSort(true, "firefox"),
+		},
+		{
+			input: new(backend.AvailabilityFirefoxDesc),
+			want:  gcpspanner.NewBrowserFeatureSupportSort(false, "firefox"),
+		},
+		{
+			input: new(backend.AvailabilitySafariAsc),
+			want:  gcpspanner.NewBrowserFeatureSupportSort(true, "safari"),
+		},
+		{
+			input: new(backend.AvailabilitySafariDesc),
+			want:  gcpspanner.NewBrowserFeatureSupportSort(false, "safari"),
+		},
+		{
+			input: new(backend.DeveloperSignalUpvotesAsc),
+			want:  gcpspanner.NewDeveloperSignalUpvotesSort(true),
+		},
+		{
+			input: new(backend.DeveloperSignalUpvotesDesc),
+			want:  gcpspanner.NewDeveloperSignalUpvotesSort(false),
+		},
+	}
+
+	for _, tt := range sortOrderTests {
+		got := getFeatureSearchSortOrder(tt.input)
+
+		// Compare 'got' and 'tt.want' (Consider using a deep equality check library)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("got: %v, want: %v", got, tt.want)
+		}
+	}
+}
+
+func TestConvertFeatureResult(t *testing.T) {
+	testCases := []struct {
+		name            string
+		featureResult   *gcpspanner.FeatureResult
+		expectedFeature *backend.Feature
+	}{
+		{
+			name: "nil PassRate edge case",
+			featureResult: &gcpspanner.FeatureResult{
+				Name:                       "feature 1",
+				FeatureKey:                 "feature1",
+				Status:                     new("low"),
+				LowDate:                    new(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)),
+				HighDate:                   nil,
+				SystemManagedSavedSearchID: nil,
+				StableMetrics: []*gcpspanner.FeatureResultMetric{
+					{
+						BrowserName: "browser3",
+						PassRate:    nil,
+					},
+				},
+				ExperimentalMetrics: []*gcpspanner.FeatureResultMetric{
+					{
+						BrowserName: "browser3",
+						PassRate:    nil,
+					},
+				},
+				ImplementationStatuses: nil,
+				SpecLinks:              nil,
+				ChromiumUsage:          big.NewRat(8, 100),
+				DeveloperSignalUpvotes: nil,
+				DeveloperSignalLink:    nil,
+				AccordingTo:            nil,
+				Alternatives:           nil,
+				VendorPositions:        spanner.NullJSON{Valid: false, Value: nil},
+			},
+
+			expectedFeature: &backend.Feature{
+				Baseline: &backend.BaselineInfo{
+					Status: new(backend.Newly),
+					LowDate: new(
+						openapi_types.Date{Time: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)},
+					),
+					HighDate: nil,
+				},
+				FeatureId: "feature1",
+				Name:      "feature 1",
+				Spec:      nil,
+				Usage: &backend.BrowserUsage{
+					Chrome: &backend.ChromeUsageInfo{
+						Daily: new(0.08),
+					},
+				},
+				Wpt:                        nil,
+				BrowserImplementations:     nil,
+				DeveloperSignals:           nil,
+				Discouraged:                nil,
+				VendorPositions:            nil,
+				SystemManagedSavedSearchId: nil,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := Backend{client: nil}
+			feature := b.convertFeatureResult(tc.featureResult)
+			if !CompareFeatures(*tc.expectedFeature, *feature) {
+				t.Errorf("unexpected feature %v", *feature)
+			}
+		})
+	}
+}
+
+func TestCreateSavedSearchSubscription(t *testing.T) {
+	const (
+		userID        = "user123"
+		subID         = "sub-id"
+		savedSearchID = "search"
+	)
+	now := time.Now()
+	channelID := "channel-id"
+	testCases := []struct {
+		name          string
+		input         backend.Subscription
+		createCfg     *mockCreateSavedSearchSubscriptionConfig
+		getCfg        *mockGetSavedSearchSubscriptionConfig
+		expected      *backend.SubscriptionResponse
+		expectedError error
+	}{
+		{
+			name: "success",
+			input: backend.Subscription{
+				ChannelId:     &channelID,
+				SavedSearchId: savedSearchID,
+				Triggers: []backend.SubscriptionTriggerWritable{
+					backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete},
+				Frequency:   backend.SubscriptionFrequencyImmediate,
+				ChannelType: nil,
+			},
+			createCfg: &mockCreateSavedSearchSubscriptionConfig{
+				expectedRequest: gcpspanner.CreateSavedSearchSubscriptionRequest{
+					UserID:        userID,
+					ChannelID:     channelID,
+					SavedSearchID: savedSearchID,
+					Triggers: []gcpspanner.SubscriptionTrigger{
+						gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete},
+					Frequency:   gcpspanner.SavedSearchSnapshotTypeImmediate,
+					ChannelType: nil,
+				},
+				result:        new(subID),
+				returnedError: nil,
+			},
+			getCfg: &mockGetSavedSearchSubscriptionConfig{
+				expectedSubscriptionID: subID,
+				expectedUserID:         userID,
+				result: &gcpspanner.SavedSearchSubscriptionView{
+					SavedSearchSubscription: gcpspanner.SavedSearchSubscription{
+						ID:            subID,
+						ChannelID:     channelID,
+						SavedSearchID: savedSearchID,
+						Triggers: []gcpspanner.SubscriptionTrigger{
+							gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete,
+						},
+						Frequency: gcpspanner.SavedSearchSnapshotTypeImmediate,
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+					SavedSearchName: "Feature name",
+					ChannelType:     "",
+				},
+				returnedError: nil,
+			},
+			expected: &backend.SubscriptionResponse{
+				Id:           subID,
+				ChannelId:    channelID,
+				Subscribable: backend.SavedSearchInfo{Id: savedSearchID, Name: "Feature name"},
+				Triggers: []backend.SubscriptionTriggerResponseItem{
+					{
+						Value: backendtypes.AttemptToStoreSubscriptionTrigger(
+							backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete),
+						RawValue: nil,
+					},
+				},
+				Frequency:   backend.SubscriptionFrequencyImmediate,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+				ChannelType: "",
+			},
+			expectedError: nil,
+		},
+		{
+			name: "create unauthorized",
+			input: backend.Subscription{
+				ChannelId:     &channelID,
+				SavedSearchId: savedSearchID,
+				Triggers: []backend.SubscriptionTriggerWritable{
+					backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete},
+				Frequency:   backend.SubscriptionFrequencyImmediate,
+				ChannelType: nil,
+			},
+			createCfg: &mockCreateSavedSearchSubscriptionConfig{
+				expectedRequest: gcpspanner.CreateSavedSearchSubscriptionRequest{
+					UserID:        userID,
+					ChannelID:     channelID,
+					SavedSearchID: savedSearchID,
+					Triggers: []gcpspanner.SubscriptionTrigger{
+						gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete,
+					},
+					Frequency:   gcpspanner.SavedSearchSnapshotTypeImmediate,
+					ChannelType: nil,
+				},
+				result:        nil,
+				returnedError: gcpspanner.ErrMissingRequiredRole,
+			},
+			getCfg:        nil,
+			expected:      nil,
+			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
+		},
+		{
+			name: "create error",
+			input: backend.Subscription{
+				ChannelId:     &channelID,
+				SavedSearchId: savedSearchID,
+				Triggers: []backend.SubscriptionTriggerWritable{
+					backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete},
+				Frequency:   backend.SubscriptionFrequencyImmediate,
+				ChannelType: nil,
+			},
+			createCfg: &mockCreateSavedSearchSubscriptionConfig{
+				expectedRequest: gcpspanner.CreateSavedSearchSubscriptionRequest{
+					UserID:        userID,
+					ChannelID:     channelID,
+					SavedSearchID: savedSearchID,
+					Triggers: []gcpspanner.SubscriptionTrigger{
+						gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete,
+					},
+					Frequency:   gcpspanner.SavedSearchSnapshotTypeImmediate,
+					ChannelType: nil,
+				},
+				result:        nil,
+				returnedError: errTest,
+			},
+			getCfg:        nil,
+			expected:      nil,
+			expectedError: errTest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                                    t,
+				mockCreateSavedSearchSubscriptionCfg: tc.createCfg,
+				mockGetSavedSearchSubscriptionCfg:    tc.getCfg,
+			}
+			b := NewBackend(mock)
+			resp, err := b.CreateSavedSearchSubscription(context.Background(), userID, tc.input)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+			}
+			if diff := cmp.Diff(tc.expected, resp, getTriggerCmpOption()); diff != "" {
+				t.Errorf("response mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestListSavedSearchSubscriptions(t *testing.T) {
+	const (
+		userID = "user123"
+	)
+	now := time.Now()
+
+	testCases := []struct {
+		name          string
+		pageSize      int
+		pageToken     *string
+		cfg           *mockListSavedSearchSubscriptionsConfig
+		expected      *backend.SubscriptionPage
+		expectedError error
+	}{
+		{
+			name:      "success",
+			pageSize:  10,
+			pageToken: nil,
+			cfg: &mockListSavedSearchSubscriptionsConfig{
+				expectedRequest: gcpspanner.ListSavedSearchSubscriptionsRequest{
+					UserID:    userID,
+					PageSize:  10,
+					PageToken: nil,
+				},
+				result: []gcpspanner.SavedSearchSubscriptionView{
+					{
+						SavedSearchSubscription: gcpspanner.SavedSearchSubscription{
+							ID:            "sub1",
+							ChannelID:     "chan1",
+							SavedSearchID: "search1",
+							Triggers: []gcpspanner.SubscriptionTrigger{
+								gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete,
+							},
+							Frequency: gcpspanner.SavedSearchSnapshotTypeImmediate,
+							CreatedAt: now,
+							UpdatedAt: now,
+						},
+						SavedSearchName: "Feature name",
+						ChannelType:     "",
+					},
+				},
+				nextPageToken: nonNilNextPageToken,
+				returnedError: nil,
+			},
+			expected: &backend.SubscriptionPage{
+				Data: &[]backend.SubscriptionResponse{
+					{
+						Id:           "sub1",
+						ChannelId:    "chan1",
+						Subscribable: backend.SavedSearchInfo{Id: "search1", Name: "Feature name"},
+						Triggers: []backend.SubscriptionTriggerResponseItem{
+							{
+								Value: backendtypes.AttemptToStoreSubscriptionTrigger(
+									backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete),
+								RawValue: nil,
+							},
+						},
+						Frequency:   backend.SubscriptionFrequencyImmediate,
+						CreatedAt:   now,
+						UpdatedAt:   now,
+						ChannelType: "",
+					},
+				},
+				Metadata: &backend.PageMetadata{
+					NextPageToken: nonNilNextPageToken,
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name:      "db error",
+			pageSize:  10,
+			pageToken: nil,
+			cfg: &mockListSavedSearchSubscriptionsConfig{
+				expectedRequest: gcpspanner.ListSavedSearchSubscriptionsRequest{
+					UserID:    userID,
+					PageSize:  10,
+					PageToken: nil,
+				},
+				nextPageToken: nil,
+				result:        nil,
+				returnedError: errTest,
+			},
+			expected:      nil,
+			expectedError: errTest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                                   t,
+				mockListSavedSearchSubscriptionsCfg: tc.cfg,
+			}
+			b := NewBackend(mock)
+			resp, err := b.ListSavedSearchSubscriptions(context.Background(), userID, tc.pageSize, tc.pageToken)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+			}
+			if diff := cmp.Diff(tc.expected, resp, getTriggerCmpOption()); diff != "" {
+				t.Errorf("response mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetSavedSearchSubscription(t *testing.T) {
+	const (
+		userID = "user123"
+		subID  = "sub456"
+	)
+	now := time.Now()
+
+	testCases := []struct {
+		name          string
+		cfg           *mockGetSavedSearchSubscriptionConfig
+		expected      *backend.SubscriptionResponse
+		expectedError error
+	}{
+		{
+			name: "success",
+			cfg: &mockGetSavedSearchSubscriptionConfig{
+				expectedSubscriptionID: subID,
+				expectedUserID:         userID,
+				result: &gcpspanner.SavedSearchSubscriptionView{
+					SavedSearchSubscription: gcpspanner.SavedSearchSubscription{
+						ID:            subID,
+						ChannelID:     "chan1",
+						SavedSearchID: "search1",
+						Triggers: []gcpspanner.SubscriptionTrigger{
+							gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete,
+						},
+						Frequency: gcpspanner.SavedSearchSnapshotTypeImmediate,
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+					SavedSearchName: "Feature name",
+					ChannelType:     "",
+				},
+				returnedError: nil,
+			},
+			expected: &backend.SubscriptionResponse{
+				Id:           subID,
+				ChannelId:    "chan1",
+				Subscribable: backend.SavedSearchInfo{Id: "search1", Name: "Feature name"},
+				Triggers: []backend.SubscriptionTriggerResponseItem{
+					{
+						Value: backendtypes.AttemptToStoreSubscriptionTrigger(
+							backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete),
+						RawValue: nil,
+					},
+				},
+				Frequency:   backend.SubscriptionFrequencyImmediate,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+				ChannelType: "",
+			},
+			expectedError: nil,
+		},
+		{
+			name: "not found",
+			cfg: &mockGetSavedSearchSubscriptionConfig{
+				expectedSubscriptionID: subID,
+				expectedUserID:         userID,
+				result:                 nil,
+				returnedError:          gcpspanner.ErrQueryReturnedNoResults,
+			},
+			expected:      nil,
+			expectedError: backendtypes.ErrEntityDoesNotExist,
+		},
+		{
+			name: "not authorized",
+			cfg: &mockGetSavedSearchSubscriptionConfig{
+				expectedSubscriptionID: subID,
+				expectedUserID:         userID,
+				result:                 nil,
+				returnedError:          gcpspanner.ErrMissingRequiredRole,
+			},
+			expected:      nil,
+			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                                 t,
+				mockGetSavedSearchSubscriptionCfg: tc.cfg,
+			}
+			b := NewBackend(mock)
+			resp, err := b.GetSavedSearchSubscription(context.Background(), userID, subID)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+			}
+			if diff := cmp.Diff(tc.expected, resp, getTriggerCmpOption()); diff != "" {
+				t.Errorf("response mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateSavedSearchSubscription(t *testing.T) {
+	const (
+		userID = "user123"
+		subID  = "sub456"
+	)
+	now := time.Now()
+	updatedTriggers := []backend.SubscriptionTriggerWritable{
+		backend.SubscriptionTriggerFeatureBaselineToNewly,
+		backend.SubscriptionTriggerFeatureBaselineRegressionToLimited,
+	}
+	updatedFrequency := backend.SubscriptionFrequencyImmediate
+	updatedSpannerFrequency := gcpspanner.SavedSearchSnapshotTypeImmediate
+
+	testCases := []struct {
+		name          string
+		input         backend.UpdateSubscriptionRequest
+		updateCfg     *mockUpdateSavedSearchSubscriptionConfig
+		getCfg        *mockGetSavedSearchSubscriptionConfig
+		expected      *backend.SubscriptionResponse
+		expectedError error
+	}{
+		{
+			name: "success update triggers",
+			input: backend.UpdateSubscriptionRequest{
+				UpdateMask: []backend.UpdateSubscriptionRequestUpdateMask{
+					backend.UpdateSubscriptionRequestMaskTriggers},
+				Triggers:  &updatedTriggers,
+				Frequency: nil,
+			},
+			updateCfg: &mockUpdateSavedSearchSubscriptionConfig{
+				expectedRequest: gcpspanner.UpdateSavedSearchSubscriptionRequest{
+					ID:     subID,
+					UserID: userID,
+					Triggers: gcpspanner.OptionallySet[[]gcpspanner.SubscriptionTrigger]{
+						Value: []gcpspanner.SubscriptionTrigger{
+							gcpspanner.SubscriptionTriggerFeatureBaselinePromoteToNewly,
+							gcpspanner.SubscriptionTriggerFeatureBaselineRegressionToLimited,
+						}, IsSet: true,
+					},
+					Frequency: gcpspanner.OptionallySet[gcpspanner.SavedSearchSnapshotType]{IsSet: false, Value: ""},
+				},
+				returnedError: nil,
+			},
+			getCfg: &mockGetSavedSearchSubscriptionConfig{
+				expectedSubscriptionID: subID,
+				expectedUserID:         userID,
+				result: &gcpspanner.SavedSearchSubscriptionView{
+					SavedSearchSubscription: gcpspanner.SavedSearchSubscription{
+						ID: subID,
+						Triggers: []gcpspanner.SubscriptionTrigger{
+							gcpspanner.SubscriptionTriggerFeatureBaselinePromoteToNewly},
+						ChannelID:     "channel",
+						SavedSearchID: "savedsearch",
+						Frequency:     gcpspanner.SavedSearchSnapshotTypeImmediate,
+						CreatedAt:     now,
+						UpdatedAt:     now,
+					},
+					SavedSearchName: "Feature name",
+					ChannelType:     "",
+				},
+				returnedError: nil,
+			},
+			expected: &backend.SubscriptionResponse{
+				Id: subID,
+				Triggers: []backend.SubscriptionTriggerResponseItem{
+					{
+						Value: backendtypes.AttemptToStoreSubscriptionTrigger(
+							backend.SubscriptionTriggerFeatureBaselineToNewly),
+						RawValue: nil,
+					},
+				},
+				ChannelId: "channel",
+				Subscribable: backend.SavedSearchInfo{
+					Id:   "savedsearch",
+					Name: "Feature name",
+				},
+				Frequency:   backend.SubscriptionFrequencyImmediate,
+				CreatedAt:   now,
+				UpdatedAt:   now,
+				ChannelType: "",
+			},
+			expectedError: nil,
+		},
+		{
+			name: "success update frequency",
+			input: backend.UpdateSubscriptionRequest{
+				UpdateMask: []backend.UpdateSubscriptionRequestUpdateMask{
+					backend.UpdateSubscriptionRequestMaskFrequency},
+				Frequency: &updatedFrequency,
+				Triggers:  nil,
+			},
+			updateCfg: &mockUpdateSavedSearchSubscriptionConfig{
+				expectedRequest: gcpspanner.UpdateSavedSearchSubscriptionRequest{
+					ID:       subID,
+					UserID:   userID,
+					Triggers: gcpspanner.OptionallySet[[]gcpspanner.SubscriptionTrigger]{IsSet: false, Value: nil},
+					Frequency: gcpspanner.OptionallySet[gcpspanner.SavedSearchSnapshotType]{
+						Value: gcpspanner.SavedSearchSnapshotTypeImmediate, IsSet: true,
+					},
+				},
+				returnedError: nil,
+			},
+			getCfg: &mockGetSavedSearchSubscriptionConfig{
+				expectedSubscriptionID: subID,
+				expectedUserID:         userID,
+				result: &gcpspanner.SavedSearchSubscriptionView{
+					SavedSearchSubscription: gcpspanner.SavedSearchSubscription{
+						ID:            subID,
+						ChannelID:     "channel",
+						SavedSearchID: "savedsearchid",
+						Triggers: []gcpspanner.SubscriptionTrigger{
+							gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete},
+						Frequency: updatedSpannerFrequency,
+						CreatedAt: now,
+						UpdatedAt: now,
+					},
+					SavedSearchName: "Feature name",
+					ChannelType:     "",
+				},
+				returnedError: nil,
+			},
+			expected: &backend.SubscriptionResponse{
+				Id:        subID,
+				ChannelId: "channel",
+				Subscribable: backend.SavedSearchInfo{
+					Id:   "savedsearchid",
+					Name: "Feature name",
+				},
+				Triggers: []backend.SubscriptionTriggerResponseItem{
+					{
+						Value: backendtypes.AttemptToStoreSubscriptionTrigger(
+							backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete),
+						RawValue: nil,
+					},
+				},
+				Frequency:   backend.SubscriptionFrequency(updatedFrequency),
+				CreatedAt:   now,
+				UpdatedAt:   now,
+				ChannelType: "",
+			},
+			expectedError: nil,
+		},
+		{
+			name: "not found",
+			input: backend.UpdateSubscriptionRequest{
+				UpdateMask: []backend.UpdateSubscriptionRequestUpdateMask{
+					backend.UpdateSubscriptionRequestMaskTriggers},
+				Triggers:  &updatedTriggers,
+				Frequency: nil,
+			},
+			updateCfg: &mockUpdateSavedSearchSubscriptionConfig{
+				expectedRequest: gcpspanner.UpdateSavedSearchSubscriptionRequest{
+					ID:     subID,
+					UserID: userID,
+					Triggers: gcpspanner.OptionallySet[[]gcpspanner.SubscriptionTrigger]{
+						Value: []gcpspanner.SubscriptionTrigger{
+							gcpspanner.SubscriptionTriggerFeatureBaselinePromoteToNewly,
+							gcpspanner.SubscriptionTriggerFeatureBaselineRegressionToLimited,
+						}, IsSet: true,
+					},
+					Frequency: gcpspanner.OptionallySet[gcpspanner.SavedSearchSnapshotType]{
+						Value: "",
+						IsSet: false,
+					},
+				},
+				returnedError: gcpspanner.ErrQueryReturnedNoResults,
+			},
+			getCfg:        nil,
+			expected:      nil,
+			expectedError: backendtypes.ErrEntityDoesNotExist,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                                    t,
+				mockUpdateSavedSearchSubscriptionCfg: tc.updateCfg,
+				mockGetSavedSearchSubscriptionCfg:    tc.getCfg,
+			}
+			b := NewBackend(mock)
+			resp, err := b.UpdateSavedSearchSubscription(context.Background(), userID, subID, tc.input)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+			}
+			if diff := cmp.Diff(tc.expected, resp, getTriggerCmpOption()); diff != "" {
+				t.Errorf("response mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeleteSavedSearchSubscription(t *testing.T) {
+	const (
+		userID = "user123"
+		subID  = "sub456"
+	)
+
+	testCases := []struct {
+		name          string
+		cfg           *mockDeleteSavedSearchSubscriptionConfig
+		expectedError error
+	}{
+		{
+			name: "success",
+			cfg: &mockDeleteSavedSearchSubscriptionConfig{
+				expectedSubscriptionID: subID,
+				expectedUserID:         userID,
+				returnedError:          nil,
+			},
+			expectedError: nil,
+		},
+		{
+			name: "not found",
+			cfg: &mockDeleteSavedSearchSubscriptionConfig{
+				expectedSubscriptionID: subID,
+				expectedUserID:         userID,
+				returnedError:          gcpspanner.ErrQueryReturnedNoResults,
+			},
+			expectedError: backendtypes.ErrEntityDoesNotExist,
+		},
+		{
+			name: "not authorized",
+			cfg: &mockDeleteSavedSearchSubscriptionConfig{
+				expectedSubscriptionID: subID,
+				expectedUserID:         userID,
+				returnedError:          gcpspanner.ErrMissingRequiredRole,
+			},
+			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                                    t,
+				mockDeleteSavedSearchSubscriptionCfg: tc.cfg,
+			}
+			b := NewBackend(mock)
+			err := b.DeleteSavedSearchSubscription(context.Background(), userID, subID)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestPutWatchedFeature(t *testing.T) {
+	const (
+		userID        = "user123"
+		subID         = "sub-id"
+		featureID     = "grid"
+		savedSearchID = "system-search"
+	)
+	now := time.Now()
+	channelID := "channel-id"
+	rss := backend.WatchFeatureRequestChannelTypeRss
+	spannerRSS := gcpspanner.NotificationChannelTypeRSS
+	watched := &gcpspanner.WatchedFeature{
+		SavedSearchSubscriptionView: gcpspanner.SavedSearchSubscriptionView{
+			SavedSearchSubscription: gcpspanner.SavedSearchSubscription{
+				ID:            subID,
+				ChannelID:     channelID,
+				SavedSearchID: savedSearchID,
+				Triggers: []gcpspanner.SubscriptionTrigger{
+					gcpspanner.SubscriptionTriggerFeatureBaselinePromoteToWidely,
+				},
+				Frequency: gcpspanner.SavedSearchSnapshotTypeWeekly,
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+			SavedSearchName: "Grid",
+			ChannelType:     "email",
+		},
+		FeatureKey:  featureID,
+		FeatureName: "CSS Grid",
+	}
+	expected := &backend.WatchedFeature{
+		FeatureId:   featureID,
+		FeatureName: "CSS Grid",
+		Subscription: backend.SubscriptionResponse{
+			Id:           subID,
+			ChannelId:    channelID,
+			Subscribable: backend.SavedSearchInfo{Id: savedSearchID, Name: "Grid"},
+			Triggers: []backend.SubscriptionTriggerResponseItem{
+				{
+					Value: backendtypes.AttemptToStoreSubscriptionTrigger(
+						backend.SubscriptionTriggerFeatureBaselineToWidely),
+					RawValue: nil,
+				},
+			},
+			Frequency:   backend.SubscriptionFrequencyWeekly,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			ChannelType: backend.SubscriptionResponseChannelTypeEmail,
+		},
+	}
+	testCases := []struct {
+		name          string
+		input         backend.WatchFeatureRequest
+		watchCfg      *mockWatchFeatureConfig
+		getCfg        *mockGetWatchedFeatureConfig
+		expected      *backend.WatchedFeature
+		expectedError error
+	}{
+		{
+			name: "success with channel id",
+			input: backend.WatchFeatureRequest{
+				ChannelId:   &channelID,
+				ChannelType: nil,
+				Triggers: []backend.SubscriptionTriggerWritable{
+					backend.SubscriptionTriggerFeatureBaselineToWidely},
+				Frequency: backend.SubscriptionFrequencyWeekly,
+			},
+			watchCfg: &mockWatchFeatureConfig{
+				expectedRequest: gcpspanner.WatchFeatureRequest{
+					UserID:      userID,
+					FeatureKey:  featureID,
+					ChannelID:   channelID,
+					ChannelType: nil,
+					Triggers: []gcpspanner.SubscriptionTrigger{
+						gcpspanner.SubscriptionTriggerFeatureBaselinePromoteToWidely},
+					Frequency: gcpspanner.SavedSearchSnapshotTypeWeekly,
+				},
+				result:        new(subID),
+				returnedError: nil,
+			},
+			getCfg: &mockGetWatchedFeatureConfig{
+				expectedSubscriptionID: subID,
+				expectedUserID:         userID,
+				result:                 watched,
+				returnedError:          nil,
+			},
+			expected:      expected,
+			expectedError: nil,
 		},
 		{
-			input: new(backend.ExperimentalSafariDesc),
-			want:  gcpspanner.NewBrowserImplSort(false, "safari", false),
+			name: "success with channel type",
+			input: backend.WatchFeatureRequest{
+				ChannelId:   nil,
+				ChannelType: &rss,
+				Triggers: []backend.SubscriptionTriggerWritable{
+					backend.SubscriptionTriggerFeatureBaselineToWidely},
+				Frequency: backend.SubscriptionFrequencyWeekly,
+			},
+			watchCfg: &mockWatchFeatureConfig{
+				expectedRequest: gcpspanner.WatchFeatureRequest{
+					UserID:      userID,
+					FeatureKey:  featureID,
+					ChannelID:   "",
+					ChannelType: &spannerRSS,
+					Triggers: []gcpspanner.SubscriptionTrigger{
+						gcpspanner.SubscriptionTriggerFeatureBaselinePromoteToWidely},
+					Frequency: gcpspanner.SavedSearchSnapshotTypeWeekly,
+				},
+				result:        new(subID),
+				returnedError: nil,
+			},
+			getCfg: &mockGetWatchedFeatureConfig{
+				expectedSubscriptionID: subID,
+				expectedUserID:         userID,
+				result:                 watched,
+				returnedError:          nil,
+			},
+			expected:      expected,
+			expectedError: nil,
+		},
+		{
+			name: "feature not found",
+			input: backend.WatchFeatureRequest{
+				ChannelId:   &channelID,
+				ChannelType: nil,
+				Triggers:    []backend.SubscriptionTriggerWritable{},
+				Frequency:   backend.SubscriptionFrequencyWeekly,
+			},
+			watchCfg: &mockWatchFeatureConfig{
+				expectedRequest: gcpspanner.WatchFeatureRequest{
+					UserID:      userID,
+					FeatureKey:  featureID,
+					ChannelID:   channelID,
+					ChannelType: nil,
+					Triggers:    []gcpspanner.SubscriptionTrigger{},
+					Frequency:   gcpspanner.SavedSearchSnapshotTypeWeekly,
+				},
+				result:        nil,
+				returnedError: gcpspanner.ErrQueryReturnedNoResults,
+			},
+			getCfg:        nil,
+			expected:      nil,
+			expectedError: backendtypes.ErrEntityDoesNotExist,
+		},
+		{
+			name: "unauthorized",
+			input: backend.WatchFeatureRequest{
+				ChannelId:   &channelID,
+				ChannelType: nil,
+				Triggers:    []backend.SubscriptionTriggerWritable{},
+				Frequency:   backend.SubscriptionFrequencyWeekly,
+			},
+			watchCfg: &mockWatchFeatureConfig{
+				expectedRequest: gcpspanner.WatchFeatureRequest{
+					UserID:      userID,
+					FeatureKey:  featureID,
+					ChannelID:   channelID,
+					ChannelType: nil,
+					Triggers:    []gcpspanner.SubscriptionTrigger{},
+					Frequency:   gcpspanner.SavedSearchSnapshotTypeWeekly,
+				},
+				result:        nil,
+				returnedError: gcpspanner.ErrMissingRequiredRole,
+			},
+			getCfg:        nil,
+			expected:      nil,
+			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
+		},
+		{
+			name: "limit reached",
+			input: backend.WatchFeatureRequest{
+				ChannelId:   &channelID,
+				ChannelType: nil,
+				Triggers:    []backend.SubscriptionTriggerWritable{},
+				Frequency:   backend.SubscriptionFrequencyWeekly,
+			},
+			watchCfg: &mockWatchFeatureConfig{
+				expectedRequest: gcpspanner.WatchFeatureRequest{
+					UserID:      userID,
+					FeatureKey:  featureID,
+					ChannelID:   channelID,
+					ChannelType: nil,
+					Triggers:    []gcpspanner.SubscriptionTrigger{},
+					Frequency:   gcpspanner.SavedSearchSnapshotTypeWeekly,
+				},
+				result:        nil,
+				returnedError: gcpspanner.ErrSubscriptionLimitExceeded,
+			},
+			getCfg:        nil,
+			expected:      nil,
+			expectedError: backendtypes.ErrUserMaxSubscriptions,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                        t,
+				mockWatchFeatureCfg:      tc.watchCfg,
+				mockGetWatchedFeatureCfg: tc.getCfg,
+			}
+			b := NewBackend(mock)
+			resp, err := b.PutWatchedFeature(context.Background(), userID, featureID, tc.input)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+			}
+			if diff := cmp.Diff(tc.expected, resp, getTriggerCmpOption()); diff != "" {
+				t.Errorf("response mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeleteWatchedFeature(t *testing.T) {
+	const (
+		userID    = "user123"
+		featureID = "grid"
+	)
+	channelID := "channel-id"
+
+	testCases := []struct {
+		name          string
+		channelID     *string
+		cfg           *mockUnwatchFeatureConfig
+		expectedError error
+	}{
+		{
+			name:      "success all channels",
+			channelID: nil,
+			cfg: &mockUnwatchFeatureConfig{
+				expectedRequest: gcpspanner.UnwatchFeatureRequest{
+					UserID:     userID,
+					FeatureKey: featureID,
+					ChannelID:  nil,
+				},
+				returnedError: nil,
+			},
+			expectedError: nil,
+		},
+		{
+			name:      "success single channel",
+			channelID: &channelID,
+			cfg: &mockUnwatchFeatureConfig{
+				expectedRequest: gcpspanner.UnwatchFeatureRequest{
+					UserID:     userID,
+					FeatureKey: featureID,
+					ChannelID:  &channelID,
+				},
+				returnedError: nil,
+			},
+			expectedError: nil,
+		},
+		{
+			name:      "not found",
+			channelID: nil,
+			cfg: &mockUnwatchFeatureConfig{
+				expectedRequest: gcpspanner.UnwatchFeatureRequest{
+					UserID:     userID,
+					FeatureKey: featureID,
+					ChannelID:  nil,
+				},
+				returnedError: gcpspanner.ErrQueryReturnedNoResults,
+			},
+			expectedError: backendtypes.ErrEntityDoesNotExist,
+		},
+		{
+			name:      "db error",
+			channelID: nil,
+			cfg: &mockUnwatchFeatureConfig{
+				expectedRequest: gcpspanner.UnwatchFeatureRequest{
+					UserID:     userID,
+					FeatureKey: featureID,
+					ChannelID:  nil,
+				},
+				returnedError: errTest,
+			},
+			expectedError: errTest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                     t,
+				mockUnwatchFeatureCfg: tc.cfg,
+			}
+			b := NewBackend(mock)
+			err := b.DeleteWatchedFeature(context.Background(), userID, featureID, tc.channelID)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestListWatchedFeatures(t *testing.T) {
+	const (
+		userID = "user123"
+	)
+	now := time.Now()
+
+	testCases := []struct {
+		name          string
+		cfg           *mockListWatchedFeaturesConfig
+		expected      *backend.WatchedFeaturePage
+		expectedError error
+	}{
+		{
+			name: "success",
+			cfg: &mockListWatchedFeaturesConfig{
+				expectedRequest: gcpspanner.ListWatchedFeaturesRequest{
+					UserID:    userID,
+					PageSize:  10,
+					PageToken: nil,
+				},
+				result: []gcpspanner.WatchedFeature{
+					{
+						SavedSearchSubscriptionView: gcpspanner.SavedSearchSubscriptionView{
+							SavedSearchSubscription: gcpspanner.SavedSearchSubscription{
+								ID:            "sub1",
+								ChannelID:     "chan1",
+								SavedSearchID: "search1",
+								Triggers:      []gcpspanner.SubscriptionTrigger{},
+								Frequency:     gcpspanner.SavedSearchSnapshotTypeImmediate,
+								CreatedAt:     now,
+								UpdatedAt:     now,
+							},
+							SavedSearchName: "Grid",
+							ChannelType:     "rss",
+						},
+						FeatureKey:  "grid",
+						FeatureName: "CSS Grid",
+					},
+				},
+				nextPageToken: nonNilNextPageToken,
+				returnedError: nil,
+			},
+			expected: &backend.WatchedFeaturePage{
+				Data: &[]backend.WatchedFeature{
+					{
+						FeatureId:   "grid",
+						FeatureName: "CSS Grid",
+						Subscription: backend.SubscriptionResponse{
+							Id:           "sub1",
+							ChannelId:    "chan1",
+							Subscribable: backend.SavedSearchInfo{Id: "search1", Name: "Grid"},
+							Triggers:     []backend.SubscriptionTriggerResponseItem{},
+							Frequency:    backend.SubscriptionFrequencyImmediate,
+							CreatedAt:    now,
+							UpdatedAt:    now,
+							ChannelType:  backend.SubscriptionResponseChannelTypeRss,
+						},
+					},
+				},
+				Metadata: &backend.PageMetadata{
+					NextPageToken: nonNilNextPageToken,
+				},
+			},
+			expectedError: nil,
 		},
 		{
-			input: new(backend.StableChromeAsc),
-			want:  gcpspanner.NewBrowserImplSort(true, "chrome", true),
+			name: "db error",
+			cfg: &mockListWatchedFeaturesConfig{
+				expectedRequest: gcpspanner.ListWatchedFeaturesRequest{
+					UserID:    userID,
+					PageSize:  10,
+					PageToken: nil,
+				},
+				result:        nil,
+				nextPageToken: nil,
+				returnedError: errTest,
+			},
+			expected:      nil,
+			expectedError: errTest,
 		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                          t,
+				mockListWatchedFeaturesCfg: tc.cfg,
+			}
+			b := NewBackend(mock)
+			resp, err := b.ListWatchedFeatures(context.Background(), userID, 10, nil)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+			}
+			if diff := cmp.Diff(tc.expected, resp, getTriggerCmpOption()); diff != "" {
+				t.Errorf("response mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func assertKnownTrigger(t *testing.T, itemIndex int,
+	actual backend.SubscriptionTriggerResponseItem, expectedValue string) {
+	t.Helper() // Marks this as a helper function for better test failure reporting.
+
+	val, err := actual.Value.AsSubscriptionTriggerWritable()
+	if err != nil {
+		t.Errorf("item %d: expected SubscriptionTriggerWritable, but it was not. err: %s", itemIndex, err)
+	}
+	if string(val) != expectedValue {
+		t.Errorf("item %d: unexpected value: got %q, want %q", itemIndex, val, expectedValue)
+	}
+	if actual.RawValue != nil {
+		t.Errorf("item %d: RawValue should be nil for known trigger, got %q", itemIndex, *actual.RawValue)
+	}
+}
+
+func assertUnknownTrigger(t *testing.T, itemIndex int,
+	actual backend.SubscriptionTriggerResponseItem, expectedValue string, expectedRawValue *string) {
+	t.Helper()
+
+	val, err := actual.Value.AsEnumUnknown()
+	if err != nil {
+		t.Errorf("item %d: expected EnumUnknown, but it was not. err : %s", itemIndex, err)
+	}
+	if string(val) != expectedValue {
+		t.Errorf("item %d: unexpected unknown value: got %q, want %q", itemIndex, val, expectedValue)
+	}
+	if actual.RawValue == nil || expectedRawValue == nil || *actual.RawValue != *expectedRawValue {
+		t.Errorf("item %d: incorrect RawValue for unknown trigger: got %v, want %v",
+			itemIndex, actual.RawValue, expectedRawValue)
+	}
+}
+
+//nolint:ireturn
+func TestSpannerTriggersToBackendTriggers(t *testing.T) {
+	testCases := []struct {
+		name          string
+		inputTriggers []gcpspanner.SubscriptionTrigger
+		expectedItems []struct {
+			IsUnknown bool
+			Value     string
+			RawValue  *string
+		}
+	}{
 		{
-			input: new(backend.StableChromeDesc),
-			want:  gcpspanner.NewBrowserImplSort(false, "chrome", true),
+			name: "All Valid Triggers",
+			inputTriggers: []gcpspanner.SubscriptionTrigger{
+				gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete,
+				gcpspanner.SubscriptionTriggerFeatureBaselinePromoteToNewly,
+			},
+			expectedItems: []struct {
+				IsUnknown bool
+				Value     string
+				RawValue  *string
+			}{
+				{IsUnknown: false,
+					Value:    string(backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete),
+					RawValue: nil},
+				{IsUnknown: false,
+					Value:    string(backend.SubscriptionTriggerFeatureBaselineToNewly),
+					RawValue: nil},
+			},
 		},
 		{
-			input: new(backend.StableEdgeAsc),
-			want:  gcpspanner.NewBrowserImplSort(true, "edge", true),
+			name: "Mixed Valid and Unknown Triggers",
+			inputTriggers: []gcpspanner.SubscriptionTrigger{
+				gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete,
+				"deprecated_trigger",
+				gcpspanner.SubscriptionTriggerFeatureBaselineRegressionToLimited,
+				"another_unknown",
+			},
+			expectedItems: []struct {
+				IsUnknown bool
+				Value     string
+				RawValue  *string
+			}{
+				{IsUnknown: false,
+					Value:    string(backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete),
+					RawValue: nil},
+				{IsUnknown: true,
+					Value:    string(backend.EnumUnknownValue),
+					RawValue: new("deprecated_trigger")},
+				{IsUnknown: false,
+					Value:    string(backend.SubscriptionTriggerFeatureBaselineRegressionToLimited),
+					RawValue: nil},
+				{IsUnknown: true,
+					Value:    string(backend.EnumUnknownValue),
+					RawValue: new("another_unknown")},
+			},
 		},
 		{
-			input: new(backend.StableEdgeDesc),
-			want:  gcpspanner.NewBrowserImplSort(false, "edge", true),
+			name:          "All Unknown Triggers",
+			inputTriggers: []gcpspanner.SubscriptionTrigger{"unknown1", "unknown2"},
+			expectedItems: []struct {
+				IsUnknown bool
+				Value     string
+				RawValue  *string
+			}{
+				{IsUnknown: true, Value: string(backend.EnumUnknownValue), RawValue: new("unknown1")},
+				{IsUnknown: true, Value: string(backend.EnumUnknownValue), RawValue: new("unknown2")},
+			},
 		},
 		{
-			input: new(backend.StableFirefoxAsc),
-			want:  gcpspanner.NewBrowserImplSort(true, "firefox", true),
+			name:          "Empty Triggers",
+			inputTriggers: []gcpspanner.SubscriptionTrigger{},
+			expectedItems: []struct {
+				IsUnknown bool
+				Value     string
+				RawValue  *string
+			}{},
 		},
 		{
-			input: new(backend.StableFirefoxDesc),
-			want:  gcpspanner.NewBrowserImplSort(false, "firefox", true),
+			name:          "Nil Triggers",
+			inputTriggers: nil,
+			expectedItems: nil,
 		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actualItems := spannerTriggersToBackendTriggers(tc.inputTriggers)
+			if tc.name == "Nil Triggers" {
+				if len(actualItems) == 0 && tc.expectedItems == nil {
+					actualItems = nil
+				} else if actualItems != nil && tc.expectedItems == nil {
+					t.Fatalf("unexpected non-nil slice for nil input: got %v", actualItems)
+				}
+			}
+
+			if len(actualItems) != len(tc.expectedItems) {
+				t.Fatalf("length mismatch: got %d, want %d", len(actualItems), len(tc.expectedItems))
+			}
+
+			for i, actual := range actualItems {
+				expected := tc.expectedItems[i]
+				if expected.IsUnknown {
+					assertUnknownTrigger(t, i, actual, expected.Value, expected.RawValue)
+				} else {
+					assertKnownTrigger(t, i, actual, expected.Value)
+				}
+			}
+		})
+	}
+}
+
+// nolint:ireturn // Required to return the cmp.Option interface for use with go-cmp.
+func getNotificationChannelCmpOption() cmp.Option {
+	return cmp.AllowUnexported(backend.NotificationChannelResponse_Config{})
+}
+
+// nolint:ireturn // Required to return the cmp.Option interface for use with go-cmp.
+func getTriggerCmpOption() cmp.Option {
+	return cmp.Transformer("triggerValue", func(in backend.SubscriptionTriggerResponseValue) string {
+		// AsSubscriptionTriggerWritable returns the value and a boolean indicating if it was that type.
+		v1, err1 := in.AsSubscriptionTriggerWritable()
+		if err1 == nil {
+			return string(v1)
+		}
+		v2, err2 := in.AsEnumUnknown()
+		if err2 == nil {
+			return string(v2)
+		}
+		// Should not happen
+		panic(fmt.Sprintf("received the following errors trying to conver trigger value. err1: %s err2: %s",
+			err1, err2))
+	})
+}
+
+func newTestNotificationChannelConfig(t *testing.T, config any) backend.NotificationChannelResponse_Config {
+	t.Helper()
+
+	var c backend.NotificationChannelResponse_Config
+
+	bytes, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal test config: %v", err)
+	}
+
+	if err := c.UnmarshalJSON(bytes); err != nil {
+		t.Fatalf("failed to unmarshal test config into wrapper: %v", err)
+	}
+
+	return c
+}
+
+func newTestCreateNotificationChannelConfig(t *testing.T, config any) backend.CreateNotificationChannelRequest_Config {
+	t.Helper()
+
+	var c backend.CreateNotificationChannelRequest_Config
+
+	bytes, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal test config: %v", err)
+	}
+
+	if err := c.UnmarshalJSON(bytes); err != nil {
+		t.Fatalf("failed to unmarshal test config into wrapper: %v", err)
+	}
+
+	return c
+}
+
+func newTestUpdateNotificationChannelRequestConfig(
+	t *testing.T, config any) *backend.UpdateNotificationChannelRequest_Config {
+	t.Helper()
+
+	var c backend.UpdateNotificationChannelRequest_Config
+
+	bytes, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal test config: %v", err)
+	}
+
+	if err := c.UnmarshalJSON(bytes); err != nil {
+		t.Fatalf("failed to unmarshal test config into wrapper: %v", err)
+	}
+
+	return &c
+}
+
+func TestValidateQueryReferences(t *testing.T) {
+	testCases := []struct {
+		name                string
+		query               string
+		params              *backend.SavedSearchQueryParameters
+		updateID            *string
+		systemSearches      map[string]*gcpspanner.SystemGlobalSavedSearchWithSortOption
+		userSearches        map[string]*gcpspanner.SavedSearch
+		referencingSearches map[string][]string
+		expectedError       error
+	}{
 		{
-			input: new(backend.StableSafariAsc),
-			want:  gcpspanner.NewBrowserImplSort(true, "safari", true),
+			name:                "valid simple query",
+			query:               "name:\"flexbox\"",
+			params:              nil,
+			updateID:            nil,
+			systemSearches:      nil,
+			userSearches:        nil,
+			referencingSearches: nil,
+			expectedError:       nil,
 		},
 		{
-			input: new(backend.StableSafariDesc),
-			want:  gcpspanner.NewBrowserImplSort(false, "safari", true),
+			name:           "valid saved search reference",
+			query:          "(saved:search1) name:\"flexbox\"",
+			params:         nil,
+			updateID:       nil,
+			systemSearches: nil,
+			userSearches: map[string]*gcpspanner.SavedSearch{
+				"search1": {Query: "name:\"flexbox\""},
+			},
+			referencingSearches: nil,
+			expectedError:       nil,
 		},
 		{
-			input: new(backend.AvailabilityChromeAsc),
-			want:  gcpspanner.NewBrowserFeatureSupportSort(true, "chrome"),
+			name:     "valid hotlist reference",
+			query:    "(hotlist:search1) name:\"flexbox\"",
+			params:   nil,
+			updateID: nil,
+			systemSearches: map[string]*gcpspanner.SystemGlobalSavedSearchWithSortOption{
+				"search1": {SystemGlobalSavedSearch: gcpspanner.SystemGlobalSavedSearch{
+					ID:           "",
+					Name:         "",
+					Description:  nil,
+					Scope:        "",
+					AuthorID:     "",
+					CreatedAt:    time.Time{},
+					UpdatedAt:    time.Time{},
+					DisplayOrder: 0,
+					Status:       "",
+					Query:        "name:\"flexbox\"",
+				}},
+			},
+			userSearches:        nil,
+			referencingSearches: nil,
+			expectedError:       nil,
 		},
 		{
-			input: new(backend.AvailabilityChromeDesc),
-			want:  gcpspanner.NewBrowserFeatureSupportSort(false, "chrome"),
+			name:                "invalid saved search - missing",
+			query:               "(saved:missing) name:\"flexbox\"",
+			params:              nil,
+			updateID:            nil,
+			systemSearches:      nil,
+			userSearches:        nil,
+			referencingSearches: nil,
+			expectedError:       backendtypes.ErrSavedSearchNotFound,
 		},
 		{
-			input: new(backend.AvailabilityEdgeAsc),
-			want:  gcpspanner.NewBrowserFeatureSupportSort(true, "edge"),
+			name:                "invalid hotlist - missing",
+			query:               "(hotlist:missing) name:\"flexbox\"",
+			params:              nil,
+			updateID:            nil,
+			systemSearches:      nil,
+			userSearches:        nil,
+			referencingSearches: nil,
+			expectedError:       backendtypes.ErrHotlistNotFound,
 		},
 		{
-			input: new(backend.AvailabilityEdgeDesc),
-			want:  gcpspanner.NewBrowserFeatureSupportSort(false, "edge"),
+			name:           "invalid depth - 3 levels",
+			query:          "(saved:search1) name:\"flexbox\"",
+			params:         nil,
+			updateID:       nil,
+			systemSearches: nil,
+			userSearches: map[string]*gcpspanner.SavedSearch{
+				"search1": {Query: "saved:search2"},
+				"search2": {Query: "saved:search3"},
+				"search3": {Query: "name:\"flexbox\""},
+			},
+			referencingSearches: nil,
+			expectedError:       backendtypes.ErrSavedSearchMaxDepthExceeded,
 		},
 		{
-			input: new(backend.AvailabilityFirefoxAsc),
-			want:  gcpspanner.NewBrowserFeatureSupportSort(true, "firefox"),
+			name:           "valid depth - 2 levels",
+			query:          "(saved:search1) name:\"flexbox\"",
+			params:         nil,
+			updateID:       nil,
+			systemSearches: nil,
+			userSearches: map[string]*gcpspanner.SavedSearch{
+				"search1": {Query: "saved:search2"},
+				"search2": {Query: "name:\"flexbox\""},
+			},
+			referencingSearches: nil,
+			expectedError:       nil,
 		},
 		{
-			input: new(backend.AvailabilityFirefoxDesc),
-			want:  gcpspanner.NewBrowserFeatureSupportSort(false, "firefox"),
+			name:           "cycle detection - direct",
+			query:          "(saved:search1) name:\"flexbox\"",
+			params:         nil,
+			updateID:       nil,
+			systemSearches: nil,
+			userSearches: map[string]*gcpspanner.SavedSearch{
+				"search1": {Query: "saved:search1"},
+			},
+			referencingSearches: nil,
+			expectedError:       backendtypes.ErrSavedSearchCycleDetected,
 		},
 		{
-			input: new(backend.AvailabilitySafariAsc),
-			want:  gcpspanner.NewBrowserFeatureSupportSort(true, "safari"),
+			name:           "cycle detection - indirect",
+			query:          "(saved:search1) name:\"flexbox\"",
+			params:         nil,
+			updateID:       nil,
+			systemSearches: nil,
+			userSearches: map[string]*gcpspanner.SavedSearch{
+				"search1": {Query: "saved:search2"},
+				"search2": {Query: "saved:search1"},
+			},
+			referencingSearches: nil,
+			expectedError:       backendtypes.ErrSavedSearchCycleDetected,
 		},
 		{
-			input: new(backend.AvailabilitySafariDesc),
-			want:  gcpspanner.NewBrowserFeatureSupportSort(false, "safari"),
+			name:           "transitive depth violation during update",
+			query:          "(saved:child) name:\"flexbox\"",
+			params:         nil,
+			updateID:       new("parent"),
+			systemSearches: nil,
+			userSearches: map[string]*gcpspanner.SavedSearch{
+				"child":      {Query: "saved:grandchild"},
+				"grandchild": {Query: "name:\"flexbox\""},
+			},
+			referencingSearches: map[string][]string{
+				"parent": {"ancestor"},
+			},
+			expectedError: backendtypes.ErrSavedSearchMaxDepthExceeded,
 		},
 		{
-			input: new(backend.DeveloperSignalUpvotesAsc),
-			want:  gcpspanner.NewDeveloperSignalUpvotesSort(true),
+			name:                "entirely single saved search",
+			query:               "saved:search1",
+			params:              nil,
+			updateID:            nil,
+			systemSearches:      nil,
+			userSearches:        nil,
+			referencingSearches: nil,
+			expectedError:       backendtypes.ErrQueryConsistsEntirelyOfSavedSearch,
 		},
 		{
-			input: new(backend.DeveloperSignalUpvotesDesc),
-			want:  gcpspanner.NewDeveloperSignalUpvotesSort(false),
+			name:                "entirely single hotlist",
+			query:               "hotlist:search1",
+			params:              nil,
+			updateID:            nil,
+			systemSearches:      nil,
+			userSearches:        nil,
+			referencingSearches: nil,
+			expectedError:       backendtypes.ErrQueryConsistsEntirelyOfSavedSearch,
 		},
-	}
-
-	for _, tt := range sortOrderTests {
-		got := getFeatureSearchSortOrder(tt.input)
-
-		// Compare 'got' and 'tt.want' (Consider using a deep equality check library)
-		if !reflect.DeepEqual(got, tt.want) {
-			t.Errorf("got: %v, want: %v", got, tt.want)
-		}
-	}
-}
-
-func TestConvertFeatureResult(t *testing.T) {
-	testCases := []struct {
-		name            string
-		featureResult   *gcpspanner.FeatureResult
-		expectedFeature *backend.Feature
-	}{
 		{
-			name: "nil PassRate edge case",
-			featureResult: &gcpspanner.FeatureResult{
-				Name:                       "feature 1",
-				FeatureKey:                 "feature1",
-				Status:                     new("low"),
-				LowDate:                    new(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)),
-				HighDate:                   nil,
-				SystemManagedSavedSearchID: nil,
-				StableMetrics: []*gcpspanner.FeatureResultMetric{
-					{
-						BrowserName: "browser3",
-						PassRate:    nil,
-					},
-				},
-				ExperimentalMetrics: []*gcpspanner.FeatureResultMetric{
-					{
-						BrowserName: "browser3",
-						PassRate:    nil,
-					},
-				},
-				ImplementationStatuses: nil,
-				SpecLinks:              nil,
-				ChromiumUsage:          big.NewRat(8, 100),
-				DeveloperSignalUpvotes: nil,
-				DeveloperSignalLink:    nil,
-				AccordingTo:            nil,
-				Alternatives:           nil,
-				VendorPositions:        spanner.NullJSON{Valid: false, Value: nil},
-			},
+			name:                "expanded query exceeds max AST complexity",
+			query:               "(saved:child) OR id:extra",
+			params:              nil,
+			updateID:            nil,
+			systemSearches:      nil,
+			referencingSearches: nil,
+			userSearches: func() map[string]*gcpspanner.SavedSearch {
+				terms := make([]string, 25)
+				for i := range terms {
+					terms[i] = fmt.Sprintf("id:feat-%d", i)
+				}
 
-			expectedFeature: &backend.Feature{
-				Baseline: &backend.BaselineInfo{
-					Status: new(backend.Newly),
-					LowDate: new(
-						openapi_types.Date{Time: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)},
-					),
-					HighDate: nil,
-				},
-				FeatureId: "feature1",
-				Name:      "feature 1",
-				Spec:      nil,
-				Usage: &backend.BrowserUsage{
-					Chrome: &backend.ChromeUsageInfo{
-						Daily: new(0.08),
-					},
-				},
-				Wpt:                        nil,
-				BrowserImplementations:     nil,
-				DeveloperSignals:           nil,
-				Discouraged:                nil,
-				VendorPositions:            nil,
-				SystemManagedSavedSearchId: nil,
-			},
+				return map[string]*gcpspanner.SavedSearch{
+					"child": {Query: strings.Join(terms, " OR ")},
+				}
+			}(),
+			expectedError: backendtypes.ErrQueryComplexityExceeded,
+		},
+		{
+			name:                "declared query variable",
+			query:               "baseline_status:$status",
+			params:              &backend.SavedSearchQueryParameters{{Name: "status", DefaultValue: "widely"}},
+			updateID:            nil,
+			systemSearches:      nil,
+			userSearches:        nil,
+			referencingSearches: nil,
+			expectedError:       nil,
+		},
+		{
+			name:                "undeclared query variable",
+			query:               "baseline_status:$status",
+			params:              nil,
+			updateID:            nil,
+			systemSearches:      nil,
+			userSearches:        nil,
+			referencingSearches: nil,
+			expectedError:       searchtypes.ErrUnboundQueryVariable,
 		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			b := Backend{client: nil}
-			feature := b.convertFeatureResult(tc.featureResult)
-			if !CompareFeatures(*tc.expectedFeature, *feature) {
-				t.Errorf("unexpected feature %v", *feature)
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t: t,
+				mockGetSystemGlobalSavedSearchCfg: &mockGetSystemGlobalSavedSearchConfig{
+					results: tc.systemSearches,
+				},
+				mockGetSavedSearchCfg: &mockGetSavedSearchConfig{
+					results: tc.userSearches,
+				},
+				mockGetReferencingSavedSearchIDsCfg: &mockGetReferencingSavedSearchIDsConfig{
+					results: tc.referencingSearches,
+				},
+			}
+			backend := NewBackend(mock)
+			err := backend.ValidateQueryReferences(context.Background(), tc.query, tc.params, tc.updateID)
+			if (tc.expectedError != nil || err != nil) && !errors.Is(err, tc.expectedError) {
+				t.Errorf("expected error %v, got %v", tc.expectedError, err)
 			}
 		})
 	}
 }
 
-func TestCreateSavedSearchSubscription(t *testing.T) {
-	const (
-		userID        = "user123"
-		subID         = "sub-id"
-		savedSearchID = "search"
-	)
-	now := time.Now()
-	channelID := "channel-id"
+func TestFetchUserSearchQuery_Bindings(t *testing.T) {
+	statusParams := spanner.NullJSON{
+		Value: []gcpspanner.SavedSearchQueryParameter{{Name: "status", DefaultValue: "widely"}},
+		Valid: true,
+	}
 	testCases := []struct {
 		name          string
-		input         backend.Subscription
-		createCfg     *mockCreateSavedSearchSubscriptionConfig
-		getCfg        *mockGetSavedSearchSubscriptionConfig
-		expected      *backend.SubscriptionResponse
+		search        *gcpspanner.SavedSearch
+		bindings      map[string]string
+		expectedQuery string
 		expectedError error
 	}{
 		{
-			name: "success",
-			input: backend.Subscription{
-				ChannelId:     &channelID,
-				SavedSearchId: savedSearchID,
-				Triggers: []backend.SubscriptionTriggerWritable{
-					backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete},
-				Frequency:   backend.SubscriptionFrequencyImmediate,
-				ChannelType: nil,
-			},
-			createCfg: &mockCreateSavedSearchSubscriptionConfig{
-				expectedRequest: gcpspanner.CreateSavedSearchSubscriptionRequest{
-					UserID:        userID,
-					ChannelID:     channelID,
-					SavedSearchID: savedSearchID,
-					Triggers: []gcpspanner.SubscriptionTrigger{
-						gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete},
-					Frequency:   gcpspanner.SavedSearchSnapshotTypeImmediate,
-					ChannelType: nil,
-				},
-				result:        new(subID),
-				returnedError: nil,
-			},
-			getCfg: &mockGetSavedSearchSubscriptionConfig{
-				expectedSubscriptionID: subID,
-				expectedUserID:         userID,
-				result: &gcpspanner.SavedSearchSubscriptionView{
-					SavedSearchSubscription: gcpspanner.SavedSearchSubscription{
-						ID:            subID,
-						ChannelID:     channelID,
-						SavedSearchID: savedSearchID,
-						Triggers: []gcpspanner.SubscriptionTrigger{
-							gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete,
-						},
-						Frequency: gcpspanner.SavedSearchSnapshotTypeImmediate,
-						CreatedAt: now,
-						UpdatedAt: now,
-					},
-					SavedSearchName: "Feature name",
-					ChannelType:     "",
-				},
-				returnedError: nil,
-			},
-			expected: &backend.SubscriptionResponse{
-				Id:           subID,
-				ChannelId:    channelID,
-				Subscribable: backend.SavedSearchInfo{Id: savedSearchID, Name: "Feature name"},
-				Triggers: []backend.SubscriptionTriggerResponseItem{
-					{
-						Value: backendtypes.AttemptToStoreSubscriptionTrigger(
-							backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete),
-						RawValue: nil,
-					},
-				},
-				Frequency:   backend.SubscriptionFrequencyImmediate,
-				CreatedAt:   now,
-				UpdatedAt:   now,
-				ChannelType: "",
-			},
+			name:          "query without variables",
+			search:        &gcpspanner.SavedSearch{Query: "name:grid"},
+			bindings:      map[string]string{"status": "newly"},
+			expectedQuery: "name:grid",
 			expectedError: nil,
 		},
 		{
-			name: "create unauthorized",
-			input: backend.Subscription{
-				ChannelId:     &channelID,
-				SavedSearchId: savedSearchID,
-				Triggers: []backend.SubscriptionTriggerWritable{
-					backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete},
-				Frequency:   backend.SubscriptionFrequencyImmediate,
-				ChannelType: nil,
-			},
-			createCfg: &mockCreateSavedSearchSubscriptionConfig{
-				expectedRequest: gcpspanner.CreateSavedSearchSubscriptionRequest{
-					UserID:        userID,
-					ChannelID:     channelID,
-					SavedSearchID: savedSearchID,
-					Triggers: []gcpspanner.SubscriptionTrigger{
-						gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete,
-					},
-					Frequency:   gcpspanner.SavedSearchSnapshotTypeImmediate,
-					ChannelType: nil,
+			name:          "default value",
+			search:        &gcpspanner.SavedSearch{Query: "baseline_status:$status", QueryParameters: statusParams},
+			bindings:      nil,
+			expectedQuery: "baseline_status:widely",
+			expectedError: nil,
+		},
+		{
+			name:          "bound value",
+			search:        &gcpspanner.SavedSearch{Query: "baseline_status:$status", QueryParameters: statusParams},
+			bindings:      map[string]string{"status": "newly"},
+			expectedQuery: "baseline_status:newly",
+			expectedError: nil,
+		},
+		{
+			name:          "undeclared variable",
+			search:        &gcpspanner.SavedSearch{Query: "baseline_status:$status"},
+			bindings:      map[string]string{"status": "newly"},
+			expectedQuery: "",
+			expectedError: searchtypes.ErrUnboundQueryVariable,
+		},
+		{
+			name:          "invalid value",
+			search:        &gcpspanner.SavedSearch{Query: "baseline_status:$status", QueryParameters: statusParams},
+			bindings:      map[string]string{"status": "newly OR name:grid"},
+			expectedQuery: "",
+			expectedError: searchtypes.ErrInvalidQueryParameter,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t: t,
+				mockGetSavedSearchCfg: &mockGetSavedSearchConfig{
+					results: map[string]*gcpspanner.SavedSearch{"search1": tc.search},
+					errs:    nil,
 				},
-				result:        nil,
-				returnedError: gcpspanner.ErrMissingRequiredRole,
+			}
+			query, err := NewBackend(mock).fetchUserSearchQuery(context.Background(), "search1", tc.bindings)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("expected error %v, got %v", tc.expectedError, err)
+			}
+			if query != tc.expectedQuery {
+				t.Errorf("expected query %q, got %q", tc.expectedQuery, query)
+			}
+		})
+	}
+}
+
+func newMockSystemGlobalSavedSearchWithSortOption(
+	id string, query string, hasCustomSortOrder bool) *gcpspanner.SystemGlobalSavedSearchWithSortOption {
+	return &gcpspanner.SystemGlobalSavedSearchWithSortOption{
+		SystemGlobalSavedSearch: gcpspanner.SystemGlobalSavedSearch{
+			ID:           id,
+			Name:         "Mock Name",
+			Description:  nil,
+			Query:        query,
+			Scope:        gcpspanner.SystemGlobalScope,
+			AuthorID:     "system",
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+			DisplayOrder: 0,
+			Status:       "LISTED",
+		},
+		HasCustomSortOrder: hasCustomSortOrder,
+	}
+}
+
+func TestExpandSavedSearches_Success(t *testing.T) {
+	customID := "h-custom"
+	testCases := []struct {
+		name               string
+		id                 string
+		globalSearches     map[string]*gcpspanner.SystemGlobalSavedSearchWithSortOption
+		expectedNode       *searchtypes.SearchNode
+		expectedSortTarget *string
+	}{
+		// This test case verifies that 'hotlist:all' expands to an empty query AST.
+		// This AST is used directly in TestFeaturesSearch_HotlistAll in lib/gcpspanner/hotlist_subquery_test.go.
+		// If this behavior changes, update both tests!
+		{
+			name: "empty query returns special node",
+			id:   "all",
+			globalSearches: map[string]*gcpspanner.SystemGlobalSavedSearchWithSortOption{
+				"all": newMockSystemGlobalSavedSearchWithSortOption("all", "", false),
 			},
-			getCfg:        nil,
-			expected:      nil,
-			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
+			expectedNode:       searchtypes.EmptySearchNode(),
+			expectedSortTarget: nil,
 		},
 		{
-			name: "create error",
-			input: backend.Subscription{
-				ChannelId:     &channelID,
-				SavedSearchId: savedSearchID,
-				Triggers: []backend.SubscriptionTriggerWritable{
-					backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete},
-				Frequency:   backend.SubscriptionFrequencyImmediate,
-				ChannelType: nil,
+			name: "hotlist with custom sort order and empty query",
+			id:   "h-custom",
+			globalSearches: map[string]*gcpspanner.SystemGlobalSavedSearchWithSortOption{
+				"h-custom": newMockSystemGlobalSavedSearchWithSortOption("h-custom", "", true),
 			},
-			createCfg: &mockCreateSavedSearchSubscriptionConfig{
-				expectedRequest: gcpspanner.CreateSavedSearchSubscriptionRequest{
-					UserID:        userID,
-					ChannelID:     channelID,
-					SavedSearchID: savedSearchID,
-					Triggers: []gcpspanner.SubscriptionTrigger{
-						gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete,
-					},
-					Frequency:   gcpspanner.SavedSearchSnapshotTypeImmediate,
-					ChannelType: nil,
+			expectedNode:       searchtypes.EmptySearchNode(),
+			expectedSortTarget: &customID,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t: t,
+				mockGetSystemGlobalSavedSearchCfg: &mockGetSystemGlobalSavedSearchConfig{
+					results: tc.globalSearches,
 				},
-				result:        nil,
-				returnedError: errTest,
+			}
+			backend := NewBackend(mock)
+			node := &searchtypes.SearchNode{
+				Keyword: searchtypes.KeywordNone,
+				Term: &searchtypes.SearchTerm{
+					Identifier: searchtypes.IdentifierHotlist,
+					Operator:   searchtypes.OperatorNone,
+					Value:      tc.id,
+				},
+				Children: nil,
+			}
+
+			expanded, sortTgt, err := backend.expandSavedSearches(context.Background(), node, 0, map[string]struct{}{}, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(expanded, tc.expectedNode) {
+				t.Errorf("expected node %+v, got %+v", tc.expectedNode, expanded)
+			}
+			if !reflect.DeepEqual(sortTgt, tc.expectedSortTarget) {
+				t.Errorf("expected sort target %+v, got %+v", tc.expectedSortTarget, sortTgt)
+			}
+		})
+	}
+}
+
+func TestExpandSavedSearches_Error(t *testing.T) {
+	testCases := []struct {
+		name           string
+		id             string
+		globalSearches map[string]*gcpspanner.SystemGlobalSavedSearchWithSortOption
+		expectedError  error
+	}{
+		{
+			name: "max depth exceeded",
+			id:   "h1",
+			globalSearches: map[string]*gcpspanner.SystemGlobalSavedSearchWithSortOption{
+				"h1": newMockSystemGlobalSavedSearchWithSortOption("h1", "hotlist:h2", false),
+				"h2": newMockSystemGlobalSavedSearchWithSortOption("h2", "hotlist:h3", false),
+				"h3": newMockSystemGlobalSavedSearchWithSortOption("h3", "feat1", false),
 			},
-			getCfg:        nil,
-			expected:      nil,
-			expectedError: errTest,
+			expectedError: backendtypes.ErrSavedSearchMaxDepthExceeded,
+		},
+		{
+			name:           "hotlist not found",
+			id:             "nonexistent",
+			globalSearches: map[string]*gcpspanner.SystemGlobalSavedSearchWithSortOption{},
+			expectedError:  backendtypes.ErrHotlistNotFound,
 		},
 	}
 
@@ -4291,107 +7623,203 @@ func TestCreateSavedSearchSubscription(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			//nolint: exhaustruct
 			mock := mockBackendSpannerClient{
-				t:                                    t,
-				mockCreateSavedSearchSubscriptionCfg: tc.createCfg,
-				mockGetSavedSearchSubscriptionCfg:    tc.getCfg,
+				t: t,
+				mockGetSystemGlobalSavedSearchCfg: &mockGetSystemGlobalSavedSearchConfig{
+					results: tc.globalSearches,
+				},
 			}
-			b := NewBackend(mock)
-			resp, err := b.CreateSavedSearchSubscription(context.Background(), userID, tc.input)
-			if !errors.Is(err, tc.expectedError) {
-				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+			backend := NewBackend(mock)
+			node := &searchtypes.SearchNode{
+				Keyword: searchtypes.KeywordNone,
+				Term: &searchtypes.SearchTerm{
+					Identifier: searchtypes.IdentifierHotlist,
+					Operator:   searchtypes.OperatorNone,
+					Value:      tc.id,
+				},
+				Children: nil,
 			}
-			if diff := cmp.Diff(tc.expected, resp, getTriggerCmpOption()); diff != "" {
-				t.Errorf("response mismatch (-want +got):\n%s", diff)
+
+			_, _, err := backend.expandSavedSearches(context.Background(), node, 0, map[string]struct{}{}, nil)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("expected error %v, got %v", tc.expectedError, err)
 			}
 		})
 	}
 }
 
-func TestListSavedSearchSubscriptions(t *testing.T) {
-	const (
-		userID = "user123"
+func TestListSavedSearchNotificationEvents(t *testing.T) {
+	ctx := context.Background()
+	//nolint:exhaustruct
+	mockClient := &mockBackendSpannerClient{t: t}
+	backendObj := &Backend{client: mockClient}
+
+	// 1. Verify OpenAPI Immediate frequency translates to Spanner snapshot type.
+	mockClient.mockListSavedSearchNotificationEventsCfg = &mockListSavedSearchNotificationEventsConfig{
+		expectedSavedSearchID: "search-id",
+		expectedSnapshotType:  string(gcpspanner.SavedSearchSnapshotTypeImmediate),
+		expectedPageSize:      10,
+		expectedPageToken:     nil,
+		result:                []gcpspanner.SavedSearchNotificationEvent{},
+		outputNextPageToken:   nil,
+		returnedError:         nil,
+	}
+
+	_, _, err := backendObj.ListSavedSearchNotificationEvents(
+		ctx,
+		"search-id",
+		backend.SubscriptionFrequencyImmediate,
+		10,
+		nil,
 	)
-	now := time.Now()
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestExpandSavedSearches_UserHotlist(t *testing.T) {
+	const hotlistID = "user-hotlist"
+	hotlistTerm := &searchtypes.SearchNode{
+		Keyword: searchtypes.KeywordNone,
+		Term: &searchtypes.SearchTerm{
+			Identifier: searchtypes.IdentifierHotlist,
+			Operator:   searchtypes.OperatorEq,
+			Value:      hotlistID,
+		},
+		Children: nil,
+	}
+	//nolint: exhaustruct
+	mock := mockBackendSpannerClient{
+		t: t,
+		mockGetUserHotlistCfg: &mockGetUserHotlistConfig{
+			results: map[string]*gcpspanner.UserHotlist{
+				hotlistID: {
+					SavedSearchID: hotlistID,
+					OrderVersion:  1,
+					FeatureKeys:   []string{"b", "a"},
+					CreatedAt:     time.Time{},
+					UpdatedAt:     time.Time{},
+				},
+			},
+			errs: nil,
+		},
+		mockGetSavedSearchCfg: &mockGetSavedSearchConfig{
+			results: map[string]*gcpspanner.SavedSearch{
+				hotlistID: {
+					ID:          hotlistID,
+					Name:        "Hotlist",
+					Description: nil,
+					Query:       gcpspanner.UserHotlistQuery(hotlistID),
+					Scope:       gcpspanner.UserPublicScope,
+					AuthorID:    "user",
+					CreatedAt:   time.Time{},
+					UpdatedAt:   time.Time{},
+				},
+			},
+			errs: nil,
+		},
+	}
+	b := NewBackend(mock)
+
+	// The hotlist term of a user hotlist is kept and its order becomes the sort target.
+	expanded, sortTgt, err := b.expandSavedSearches(context.Background(), hotlistTerm, 0, map[string]struct{}{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(expanded, hotlistTerm) {
+		t.Errorf("expected node %+v, got %+v", hotlistTerm, expanded)
+	}
+	if sortTgt == nil || *sortTgt != hotlistID {
+		t.Errorf("expected sort target %q, got %v", hotlistID, sortTgt)
+	}
+
+	// The saved search of a hotlist references itself without forming a cycle.
+	savedTerm := &searchtypes.SearchNode{
+		Keyword: searchtypes.KeywordNone,
+		Term: &searchtypes.SearchTerm{
+			Identifier: searchtypes.IdentifierSavedSearch,
+			Operator:   searchtypes.OperatorEq,
+			Value:      hotlistID,
+		},
+		Children: nil,
+	}
+	_, sortTgt, err = b.expandSavedSearches(context.Background(), savedTerm, 0, map[string]struct{}{}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sortTgt == nil || *sortTgt != hotlistID {
+		t.Errorf("expected sort target %q, got %v", hotlistID, sortTgt)
+	}
+
+	// Unknown hotlists are still reported as not found.
+	missing := &searchtypes.SearchNode{
+		Keyword: searchtypes.KeywordNone,
+		Term: &searchtypes.SearchTerm{
+			Identifier: searchtypes.IdentifierHotlist,
+			Operator:   searchtypes.OperatorEq,
+			Value:      "missing",
+		},
+		Children: nil,
+	}
+	_, _, err = b.expandSavedSearches(context.Background(), missing, 0, map[string]struct{}{}, nil)
+	if !errors.Is(err, backendtypes.ErrHotlistNotFound) {
+		t.Errorf("expected ErrHotlistNotFound, got %v", err)
+	}
+}
+
+func TestCreateHotlist(t *testing.T) {
+	const userID = "user123"
+	id := "hotlist-id"
+	req := backend.CreateHotlistRequest{
+		Name:        "Interop",
+		Description: nil,
+		FeatureIds:  &[]string{"grid", "has"},
+	}
+	expectedRequest := gcpspanner.CreateUserHotlistRequest{
+		Name:        "Interop",
+		Description: nil,
+		OwnerUserID: userID,
+		FeatureKeys: []string{"grid", "has"},
+	}
 
 	testCases := []struct {
 		name          string
-		pageSize      int
-		pageToken     *string
-		cfg           *mockListSavedSearchSubscriptionsConfig
-		expected      *backend.SubscriptionPage
+		cfg           *mockCreateUserHotlistConfig
+		expected      *backend.Hotlist
 		expectedError error
 	}{
 		{
-			name:      "success",
-			pageSize:  10,
-			pageToken: nil,
-			cfg: &mockListSavedSearchSubscriptionsConfig{
-				expectedRequest: gcpspanner.ListSavedSearchSubscriptionsRequest{
-					UserID:    userID,
-					PageSize:  10,
-					PageToken: nil,
-				},
-				result: []gcpspanner.SavedSearchSubscriptionView{
-					{
-						SavedSearchSubscription: gcpspanner.SavedSearchSubscription{
-							ID:            "sub1",
-							ChannelID:     "chan1",
-							SavedSearchID: "search1",
-							Triggers: []gcpspanner.SubscriptionTrigger{
-								gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete,
-							},
-							Frequency: gcpspanner.SavedSearchSnapshotTypeImmediate,
-							CreatedAt: now,
-							UpdatedAt: now,
-						},
-						SavedSearchName: "Feature name",
-						ChannelType:     "",
-					},
-				},
-				nextPageToken: nonNilNextPageToken,
-				returnedError: nil,
+			name: "success",
+			cfg: &mockCreateUserHotlistConfig{
+				expectedRequest: expectedRequest,
+				result:          &id,
+				returnedError:   nil,
 			},
-			expected: &backend.SubscriptionPage{
-				Data: &[]backend.SubscriptionResponse{
-					{
-						Id:           "sub1",
-						ChannelId:    "chan1",
-						Subscribable: backend.SavedSearchInfo{Id: "search1", Name: "Feature name"},
-						Triggers: []backend.SubscriptionTriggerResponseItem{
-							{
-								Value: backendtypes.AttemptToStoreSubscriptionTrigger(
-									backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete),
-								RawValue: nil,
-							},
-						},
-						Frequency:   backend.SubscriptionFrequencyImmediate,
-						CreatedAt:   now,
-						UpdatedAt:   now,
-						ChannelType: "",
-					},
-				},
-				Metadata: &backend.PageMetadata{
-					NextPageToken: nonNilNextPageToken,
-				},
+			expected: &backend.Hotlist{
+				Id:         id,
+				Version:    1,
+				FeatureIds: []string{"grid", "has"},
 			},
 			expectedError: nil,
 		},
 		{
-			name:      "db error",
-			pageSize:  10,
-			pageToken: nil,
-			cfg: &mockListSavedSearchSubscriptionsConfig{
-				expectedRequest: gcpspanner.ListSavedSearchSubscriptionsRequest{
-					UserID:    userID,
-					PageSize:  10,
-					PageToken: nil,
-				},
-				nextPageToken: nil,
-				result:        nil,
-				returnedError: errTest,
+			name: "saved search limit",
+			cfg: &mockCreateUserHotlistConfig{
+				expectedRequest: expectedRequest,
+				result:          nil,
+				returnedError:   gcpspanner.ErrOwnerSavedSearchLimitExceeded,
 			},
 			expected:      nil,
-			expectedError: errTest,
+			expectedError: backendtypes.ErrUserMaxSavedSearches,
+		},
+		{
+			name: "unknown feature",
+			cfg: &mockCreateUserHotlistConfig{
+				expectedRequest: expectedRequest,
+				result:          nil,
+				returnedError:   gcpspanner.ErrUnknownHotlistFeature,
+			},
+			expected:      nil,
+			expectedError: backendtypes.ErrInvalidHotlistFeatures,
 		},
 	}
 
@@ -4399,96 +7827,197 @@ func TestListSavedSearchSubscriptions(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			//nolint: exhaustruct
 			mock := mockBackendSpannerClient{
-				t:                                   t,
-				mockListSavedSearchSubscriptionsCfg: tc.cfg,
+				t:                        t,
+				mockCreateUserHotlistCfg: tc.cfg,
+				mockGetUserHotlistCfg: &mockGetUserHotlistConfig{
+					results: map[string]*gcpspanner.UserHotlist{
+						id: {
+							SavedSearchID: id,
+							OrderVersion:  1,
+							FeatureKeys:   []string{"grid", "has"},
+							CreatedAt:     time.Time{},
+							UpdatedAt:     time.Time{},
+						},
+					},
+					errs: nil,
+				},
 			}
 			b := NewBackend(mock)
-			resp, err := b.ListSavedSearchSubscriptions(context.Background(), userID, tc.pageSize, tc.pageToken)
+			hotlist, err := b.CreateHotlist(context.Background(), userID, req)
 			if !errors.Is(err, tc.expectedError) {
 				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
 			}
-			if diff := cmp.Diff(tc.expected, resp, getTriggerCmpOption()); diff != "" {
-				t.Errorf("response mismatch (-want +got):\n%s", diff)
+			if diff := cmp.Diff(tc.expected, hotlist); diff != "" {
+				t.Errorf("unexpected hotlist (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-func TestGetSavedSearchSubscription(t *testing.T) {
+func TestUpdateHotlistFeatures(t *testing.T) {
 	const (
-		userID = "user123"
-		subID  = "sub456"
+		userID    = "user123"
+		hotlistID = "hotlist-id"
 	)
-	now := time.Now()
+	current := &gcpspanner.UserHotlist{
+		SavedSearchID: hotlistID,
+		OrderVersion:  3,
+		FeatureKeys:   []string{"a", "b", "c"},
+		CreatedAt:     time.Time{},
+		UpdatedAt:     time.Time{},
+	}
+	replaceReq := func(keys ...string) gcpspanner.ReplaceUserHotlistFeaturesRequest {
+		return gcpspanner.ReplaceUserHotlistFeaturesRequest{
+			SavedSearchID:        hotlistID,
+			UserID:               userID,
+			ExpectedOrderVersion: 3,
+			FeatureKeys:          keys,
+		}
+	}
 
 	testCases := []struct {
 		name          string
-		cfg           *mockGetSavedSearchSubscriptionConfig
-		expected      *backend.SubscriptionResponse
+		update        func(b *Backend) (*backend.Hotlist, error)
+		replaceCfg    *mockReplaceUserHotlistFeaturesConfig
+		expected      *backend.Hotlist
 		expectedError error
 	}{
 		{
-			name: "success",
-			cfg: &mockGetSavedSearchSubscriptionConfig{
-				expectedSubscriptionID: subID,
-				expectedUserID:         userID,
-				result: &gcpspanner.SavedSearchSubscriptionView{
-					SavedSearchSubscription: gcpspanner.SavedSearchSubscription{
-						ID:            subID,
-						ChannelID:     "chan1",
-						SavedSearchID: "search1",
-						Triggers: []gcpspanner.SubscriptionTrigger{
-							gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete,
-						},
-						Frequency: gcpspanner.SavedSearchSnapshotTypeImmediate,
-						CreatedAt: now,
-						UpdatedAt: now,
-					},
-					SavedSearchName: "Feature name",
-					ChannelType:     "",
-				},
-				returnedError: nil,
+			name: "reorder",
+			update: func(b *Backend) (*backend.Hotlist, error) {
+				return b.ReorderHotlist(context.Background(), userID, hotlistID, backend.ReorderHotlistRequest{
+					Version:    3,
+					FeatureIds: []string{"c", "a", "b"},
+				})
 			},
-			expected: &backend.SubscriptionResponse{
-				Id:           subID,
-				ChannelId:    "chan1",
-				Subscribable: backend.SavedSearchInfo{Id: "search1", Name: "Feature name"},
-				Triggers: []backend.SubscriptionTriggerResponseItem{
-					{
-						Value: backendtypes.AttemptToStoreSubscriptionTrigger(
-							backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete),
-						RawValue: nil,
-					},
-				},
-				Frequency:   backend.SubscriptionFrequencyImmediate,
-				CreatedAt:   now,
-				UpdatedAt:   now,
-				ChannelType: "",
+			replaceCfg: &mockReplaceUserHotlistFeaturesConfig{
+				expectedRequest: replaceReq("c", "a", "b"),
+				result:          4,
+				returnedError:   nil,
+			},
+			expected:      &backend.Hotlist{Id: hotlistID, Version: 4, FeatureIds: []string{"c", "a", "b"}},
+			expectedError: nil,
+		},
+		{
+			name: "reorder with different features",
+			update: func(b *Backend) (*backend.Hotlist, error) {
+				return b.ReorderHotlist(context.Background(), userID, hotlistID, backend.ReorderHotlistRequest{
+					Version:    3,
+					FeatureIds: []string{"c", "a", "d"},
+				})
+			},
+			replaceCfg:    nil,
+			expected:      nil,
+			expectedError: backendtypes.ErrInvalidHotlistFeatures,
+		},
+		{
+			name: "append new feature",
+			update: func(b *Backend) (*backend.Hotlist, error) {
+				return b.PutHotlistFeature(context.Background(), userID, hotlistID, "d",
+					backend.PutHotlistFeatureRequest{Version: 3, Position: nil})
+			},
+			replaceCfg: &mockReplaceUserHotlistFeaturesConfig{
+				expectedRequest: replaceReq("a", "b", "c", "d"),
+				result:          4,
+				returnedError:   nil,
+			},
+			expected:      &backend.Hotlist{Id: hotlistID, Version: 4, FeatureIds: []string{"a", "b", "c", "d"}},
+			expectedError: nil,
+		},
+		{
+			name: "move existing feature",
+			update: func(b *Backend) (*backend.Hotlist, error) {
+				return b.PutHotlistFeature(context.Background(), userID, hotlistID, "c",
+					backend.PutHotlistFeatureRequest{Version: 3, Position: new(0)})
+			},
+			replaceCfg: &mockReplaceUserHotlistFeaturesConfig{
+				expectedRequest: replaceReq("c", "a", "b"),
+				result:          4,
+				returnedError:   nil,
+			},
+			expected:      &backend.Hotlist{Id: hotlistID, Version: 4, FeatureIds: []string{"c", "a", "b"}},
+			expectedError: nil,
+		},
+		{
+			name: "insert past the end",
+			update: func(b *Backend) (*backend.Hotlist, error) {
+				return b.PutHotlistFeature(context.Background(), userID, hotlistID, "a",
+					backend.PutHotlistFeatureRequest{Version: 3, Position: new(10)})
+			},
+			replaceCfg: &mockReplaceUserHotlistFeaturesConfig{
+				expectedRequest: replaceReq("b", "c", "a"),
+				result:          4,
+				returnedError:   nil,
+			},
+			expected:      &backend.Hotlist{Id: hotlistID, Version: 4, FeatureIds: []string{"b", "c", "a"}},
+			expectedError: nil,
+		},
+		{
+			name: "remove feature",
+			update: func(b *Backend) (*backend.Hotlist, error) {
+				return b.RemoveHotlistFeature(context.Background(), userID, hotlistID, "b", 3)
+			},
+			replaceCfg: &mockReplaceUserHotlistFeaturesConfig{
+				expectedRequest: replaceReq("a", "c"),
+				result:          4,
+				returnedError:   nil,
 			},
+			expected:      &backend.Hotlist{Id: hotlistID, Version: 4, FeatureIds: []string{"a", "c"}},
 			expectedError: nil,
 		},
 		{
-			name: "not found",
-			cfg: &mockGetSavedSearchSubscriptionConfig{
-				expectedSubscriptionID: subID,
-				expectedUserID:         userID,
-				result:                 nil,
-				returnedError:          gcpspanner.ErrQueryReturnedNoResults,
+			name: "remove missing feature",
+			update: func(b *Backend) (*backend.Hotlist, error) {
+				return b.RemoveHotlistFeature(context.Background(), userID, hotlistID, "z", 3)
 			},
+			replaceCfg:    nil,
 			expected:      nil,
 			expectedError: backendtypes.ErrEntityDoesNotExist,
 		},
 		{
-			name: "not authorized",
-			cfg: &mockGetSavedSearchSubscriptionConfig{
-				expectedSubscriptionID: subID,
-				expectedUserID:         userID,
-				result:                 nil,
-				returnedError:          gcpspanner.ErrMissingRequiredRole,
+			name: "stale version",
+			update: func(b *Backend) (*backend.Hotlist, error) {
+				return b.RemoveHotlistFeature(context.Background(), userID, hotlistID, "b", 2)
+			},
+			replaceCfg:    nil,
+			expected:      nil,
+			expectedError: backendtypes.ErrHotlistVersionConflict,
+		},
+		{
+			name: "concurrent change",
+			update: func(b *Backend) (*backend.Hotlist, error) {
+				return b.RemoveHotlistFeature(context.Background(), userID, hotlistID, "b", 3)
+			},
+			replaceCfg: &mockReplaceUserHotlistFeaturesConfig{
+				expectedRequest: replaceReq("a", "c"),
+				result:          0,
+				returnedError:   gcpspanner.ErrHotlistVersionMismatch,
+			},
+			expected:      nil,
+			expectedError: backendtypes.ErrHotlistVersionConflict,
+		},
+		{
+			name: "not the owner",
+			update: func(b *Backend) (*backend.Hotlist, error) {
+				return b.RemoveHotlistFeature(context.Background(), userID, hotlistID, "b", 3)
+			},
+			replaceCfg: &mockReplaceUserHotlistFeaturesConfig{
+				expectedRequest: replaceReq("a", "c"),
+				result:          0,
+				returnedError:   gcpspanner.ErrMissingRequiredRole,
 			},
 			expected:      nil,
 			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
 		},
+		{
+			name: "not a hotlist",
+			update: func(b *Backend) (*backend.Hotlist, error) {
+				return b.RemoveHotlistFeature(context.Background(), userID, "other", "b", 3)
+			},
+			replaceCfg:    nil,
+			expected:      nil,
+			expectedError: backendtypes.ErrEntityDoesNotExist,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -4496,846 +8025,1401 @@ func TestGetSavedSearchSubscription(t *testing.T) {
 			//nolint: exhaustruct
 			mock := mockBackendSpannerClient{
 				t:                                 t,
-				mockGetSavedSearchSubscriptionCfg: tc.cfg,
+				mockReplaceUserHotlistFeaturesCfg: tc.replaceCfg,
+				mockGetUserHotlistCfg: &mockGetUserHotlistConfig{
+					results: map[string]*gcpspanner.UserHotlist{hotlistID: current},
+					errs:    nil,
+				},
 			}
-			b := NewBackend(mock)
-			resp, err := b.GetSavedSearchSubscription(context.Background(), userID, subID)
+			hotlist, err := tc.update(NewBackend(mock))
 			if !errors.Is(err, tc.expectedError) {
 				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
 			}
-			if diff := cmp.Diff(tc.expected, resp, getTriggerCmpOption()); diff != "" {
-				t.Errorf("response mismatch (-want +got):\n%s", diff)
+			if diff := cmp.Diff(tc.expected, hotlist); diff != "" {
+				t.Errorf("unexpected hotlist (-want +got):\n%s", diff)
+			}
+			if !slices.Equal(current.FeatureKeys, []string{"a", "b", "c"}) {
+				t.Errorf("the current features were modified: %v", current.FeatureKeys)
 			}
 		})
 	}
 }
 
-func TestUpdateSavedSearchSubscription(t *testing.T) {
-	const (
-		userID = "user123"
-		subID  = "sub456"
-	)
-	now := time.Now()
-	updatedTriggers := []backend.SubscriptionTriggerWritable{
-		backend.SubscriptionTriggerFeatureBaselineToNewly,
-		backend.SubscriptionTriggerFeatureBaselineRegressionToLimited,
+func TestListSavedSearchCollaborators(t *testing.T) {
+	//nolint: exhaustruct
+	mock := mockBackendSpannerClient{
+		t: t,
+		mockListSavedSearchCollaboratorsCfg: &mockListSavedSearchCollaboratorsConfig{
+			expectedSavedSearchID: "search-id",
+			expectedUserID:        "user123",
+			result: []gcpspanner.SavedSearchCollaborator{
+				{UserID: "user123", Role: gcpspanner.SavedSearchOwner, GitHubUsername: new("owner")},
+				{UserID: "user456", Role: gcpspanner.SavedSearchEditor, GitHubUsername: nil},
+				{UserID: "user789", Role: gcpspanner.SavedSearchViewer, GitHubUsername: new("viewer")},
+				{UserID: "user000", Role: gcpspanner.SavedSearchRole("UNKNOWN"), GitHubUsername: nil},
+			},
+			returnedError: nil,
+		},
 	}
-	updatedFrequency := backend.SubscriptionFrequencyImmediate
-	updatedSpannerFrequency := gcpspanner.SavedSearchSnapshotTypeImmediate
+	b := NewBackend(mock)
+	list, err := b.ListSavedSearchCollaborators(context.Background(), "user123", "search-id")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := &backend.SavedSearchCollaboratorList{
+		Data: []backend.SavedSearchCollaborator{
+			{GithubUsername: new("owner"), Role: backend.SavedSearchOwner},
+			{GithubUsername: nil, Role: backend.SavedSearchEditor},
+			{GithubUsername: new("viewer"), Role: backend.SavedSearchViewer},
+		},
+	}
+	if diff := cmp.Diff(expected, list); diff != "" {
+		t.Errorf("unexpected collaborators (-want +got):\n%s", diff)
+	}
+}
 
+func TestPutSavedSearchCollaborator(t *testing.T) {
+	expectedRequest := gcpspanner.PutSavedSearchCollaboratorRequest{
+		SavedSearchID:    "search-id",
+		RequestingUserID: "user123",
+		GitHubUsername:   "octocat",
+		Role:             gcpspanner.SavedSearchEditor,
+	}
 	testCases := []struct {
 		name          string
-		input         backend.UpdateSubscriptionRequest
-		updateCfg     *mockUpdateSavedSearchSubscriptionConfig
-		getCfg        *mockGetSavedSearchSubscriptionConfig
-		expected      *backend.SubscriptionResponse
+		returnedError error
+		expected      *backend.SavedSearchCollaborator
 		expectedError error
 	}{
 		{
-			name: "success update triggers",
-			input: backend.UpdateSubscriptionRequest{
-				UpdateMask: []backend.UpdateSubscriptionRequestUpdateMask{
-					backend.UpdateSubscriptionRequestMaskTriggers},
-				Triggers:  &updatedTriggers,
-				Frequency: nil,
-			},
-			updateCfg: &mockUpdateSavedSearchSubscriptionConfig{
-				expectedRequest: gcpspanner.UpdateSavedSearchSubscriptionRequest{
-					ID:     subID,
-					UserID: userID,
-					Triggers: gcpspanner.OptionallySet[[]gcpspanner.SubscriptionTrigger]{
-						Value: []gcpspanner.SubscriptionTrigger{
-							gcpspanner.SubscriptionTriggerFeatureBaselinePromoteToNewly,
-							gcpspanner.SubscriptionTriggerFeatureBaselineRegressionToLimited,
-						}, IsSet: true,
-					},
-					Frequency: gcpspanner.OptionallySet[gcpspanner.SavedSearchSnapshotType]{IsSet: false, Value: ""},
-				},
-				returnedError: nil,
-			},
-			getCfg: &mockGetSavedSearchSubscriptionConfig{
-				expectedSubscriptionID: subID,
-				expectedUserID:         userID,
-				result: &gcpspanner.SavedSearchSubscriptionView{
-					SavedSearchSubscription: gcpspanner.SavedSearchSubscription{
-						ID: subID,
-						Triggers: []gcpspanner.SubscriptionTrigger{
-							gcpspanner.SubscriptionTriggerFeatureBaselinePromoteToNewly},
-						ChannelID:     "channel",
-						SavedSearchID: "savedsearch",
-						Frequency:     gcpspanner.SavedSearchSnapshotTypeImmediate,
-						CreatedAt:     now,
-						UpdatedAt:     now,
-					},
-					SavedSearchName: "Feature name",
-					ChannelType:     "",
-				},
-				returnedError: nil,
-			},
-			expected: &backend.SubscriptionResponse{
-				Id: subID,
-				Triggers: []backend.SubscriptionTriggerResponseItem{
-					{
-						Value: backendtypes.AttemptToStoreSubscriptionTrigger(
-							backend.SubscriptionTriggerFeatureBaselineToNewly),
-						RawValue: nil,
-					},
-				},
-				ChannelId: "channel",
-				Subscribable: backend.SavedSearchInfo{
-					Id:   "savedsearch",
-					Name: "Feature name",
-				},
-				Frequency:   backend.SubscriptionFrequencyImmediate,
-				CreatedAt:   now,
-				UpdatedAt:   now,
-				ChannelType: "",
-			},
+			name:          "success",
+			returnedError: nil,
+			expected:      &backend.SavedSearchCollaborator{GithubUsername: new("octocat"), Role: backend.SavedSearchEditor},
 			expectedError: nil,
 		},
 		{
-			name: "success update frequency",
-			input: backend.UpdateSubscriptionRequest{
-				UpdateMask: []backend.UpdateSubscriptionRequestUpdateMask{
-					backend.UpdateSubscriptionRequestMaskFrequency},
-				Frequency: &updatedFrequency,
-				Triggers:  nil,
-			},
-			updateCfg: &mockUpdateSavedSearchSubscriptionConfig{
-				expectedRequest: gcpspanner.UpdateSavedSearchSubscriptionRequest{
-					ID:       subID,
-					UserID:   userID,
-					Triggers: gcpspanner.OptionallySet[[]gcpspanner.SubscriptionTrigger]{IsSet: false, Value: nil},
-					Frequency: gcpspanner.OptionallySet[gcpspanner.SavedSearchSnapshotType]{
-						Value: gcpspanner.SavedSearchSnapshotTypeImmediate, IsSet: true,
-					},
+			name:          "unknown username",
+			returnedError: gcpspanner.ErrUnknownGitHubUsername,
+			expected:      nil,
+			expectedError: backendtypes.ErrEntityDoesNotExist,
+		},
+		{
+			name:          "not the owner",
+			returnedError: gcpspanner.ErrMissingRequiredRole,
+			expected:      nil,
+			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
+		},
+		{
+			name:          "owner role",
+			returnedError: gcpspanner.ErrCannotChangeOwnerRole,
+			expected:      nil,
+			expectedError: backendtypes.ErrInvalidCollaboratorChange,
+		},
+		{
+			name:          "limit",
+			returnedError: gcpspanner.ErrSavedSearchCollaboratorLimitExceeded,
+			expected:      nil,
+			expectedError: backendtypes.ErrSavedSearchMaxCollaborators,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var result *gcpspanner.SavedSearchCollaborator
+			if tc.returnedError == nil {
+				result = &gcpspanner.SavedSearchCollaborator{
+					UserID:         "user456",
+					Role:           gcpspanner.SavedSearchEditor,
+					GitHubUsername: new("octocat"),
+				}
+			}
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t: t,
+				mockPutSavedSearchCollaboratorCfg: &mockPutSavedSearchCollaboratorConfig{
+					expectedRequest: expectedRequest,
+					result:          result,
+					returnedError:   tc.returnedError,
 				},
-				returnedError: nil,
-			},
-			getCfg: &mockGetSavedSearchSubscriptionConfig{
-				expectedSubscriptionID: subID,
-				expectedUserID:         userID,
-				result: &gcpspanner.SavedSearchSubscriptionView{
-					SavedSearchSubscription: gcpspanner.SavedSearchSubscription{
-						ID:            subID,
-						ChannelID:     "channel",
-						SavedSearchID: "savedsearchid",
-						Triggers: []gcpspanner.SubscriptionTrigger{
-							gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete},
-						Frequency: updatedSpannerFrequency,
-						CreatedAt: now,
-						UpdatedAt: now,
+			}
+			b := NewBackend(mock)
+			collaborator, err := b.PutSavedSearchCollaborator(context.Background(), "user123", "search-id", "octocat",
+				backend.PutSavedSearchCollaboratorRequest{Role: backend.SavedSearchEditor})
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+			}
+			if diff := cmp.Diff(tc.expected, collaborator); diff != "" {
+				t.Errorf("unexpected collaborator (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTransferSavedSearchOwnership(t *testing.T) {
+	testCases := []struct {
+		name          string
+		returnedError error
+		expectedError error
+	}{
+		{name: "success", returnedError: nil, expectedError: nil},
+		{
+			name:          "new owner at limit",
+			returnedError: gcpspanner.ErrOwnerSavedSearchLimitExceeded,
+			expectedError: backendtypes.ErrUserMaxSavedSearches,
+		},
+		{
+			name:          "not the owner",
+			returnedError: gcpspanner.ErrMissingRequiredRole,
+			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t: t,
+				mockTransferSavedSearchOwnershipCfg: &mockTransferSavedSearchOwnershipConfig{
+					expectedRequest: gcpspanner.TransferSavedSearchOwnershipRequest{
+						SavedSearchID:          "search-id",
+						RequestingUserID:       "user123",
+						NewOwnerGitHubUsername: "octocat",
 					},
-					SavedSearchName: "Feature name",
-					ChannelType:     "",
+					returnedError: tc.returnedError,
 				},
-				returnedError: nil,
+			}
+			b := NewBackend(mock)
+			err := b.TransferSavedSearchOwnership(context.Background(), "user123", "search-id",
+				backend.TransferSavedSearchOwnershipRequest{GithubUsername: "octocat"})
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestListSavedSearchRevisions(t *testing.T) {
+	createdAt := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	//nolint: exhaustruct
+	mock := mockBackendSpannerClient{
+		t: t,
+		mockListSavedSearchRevisionsCfg: &mockListSavedSearchRevisionsConfig{
+			expectedRequest: gcpspanner.ListSavedSearchRevisionsRequest{
+				SavedSearchID:    "search-id",
+				RequestingUserID: "user123",
+				PageSize:         2,
+				PageToken:        nonNilInputPageToken,
 			},
-			expected: &backend.SubscriptionResponse{
-				Id:        subID,
-				ChannelId: "channel",
-				Subscribable: backend.SavedSearchInfo{
-					Id:   "savedsearchid",
-					Name: "Feature name",
-				},
-				Triggers: []backend.SubscriptionTriggerResponseItem{
+			result: &gcpspanner.SavedSearchRevisionsPage{
+				NextPageToken: nonNilNextPageToken,
+				Revisions: []gcpspanner.SavedSearchRevision{
 					{
-						Value: backendtypes.AttemptToStoreSubscriptionTrigger(
-							backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete),
-						RawValue: nil,
+						SavedSearchID:        "search-id",
+						RevisionNumber:       3,
+						Name:                 "name",
+						Description:          nil,
+						Query:                "group:css",
+						AuthorID:             "user123",
+						RevertedFromRevision: new(int64(1)),
+						CreatedAt:            createdAt,
+						QueryParameters:      spanner.NullJSON{Value: nil, Valid: false},
+						AuthorGitHubUsername: new("octocat"),
 					},
 				},
-				Frequency:   backend.SubscriptionFrequency(updatedFrequency),
-				CreatedAt:   now,
-				UpdatedAt:   now,
-				ChannelType: "",
 			},
-			expectedError: nil,
+			returnedError: nil,
 		},
-		{
-			name: "not found",
-			input: backend.UpdateSubscriptionRequest{
-				UpdateMask: []backend.UpdateSubscriptionRequestUpdateMask{
-					backend.UpdateSubscriptionRequestMaskTriggers},
-				Triggers:  &updatedTriggers,
-				Frequency: nil,
-			},
-			updateCfg: &mockUpdateSavedSearchSubscriptionConfig{
-				expectedRequest: gcpspanner.UpdateSavedSearchSubscriptionRequest{
-					ID:     subID,
-					UserID: userID,
-					Triggers: gcpspanner.OptionallySet[[]gcpspanner.SubscriptionTrigger]{
-						Value: []gcpspanner.SubscriptionTrigger{
-							gcpspanner.SubscriptionTriggerFeatureBaselinePromoteToNewly,
-							gcpspanner.SubscriptionTriggerFeatureBaselineRegressionToLimited,
-						}, IsSet: true,
-					},
-					Frequency: gcpspanner.OptionallySet[gcpspanner.SavedSearchSnapshotType]{
-						Value: "",
-						IsSet: false,
-					},
-				},
-				returnedError: gcpspanner.ErrQueryReturnedNoResults,
+	}
+	b := NewBackend(mock)
+	page, err := b.ListSavedSearchRevisions(context.Background(), "user123", "search-id", 2, nonNilInputPageToken)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := &backend.SavedSearchRevisionPage{
+		Metadata: &backend.PageMetadata{NextPageToken: nonNilNextPageToken},
+		Data: &[]backend.SavedSearchRevision{
+			{
+				RevisionNumber:       3,
+				Name:                 "name",
+				Description:          nil,
+				Query:                "group:css",
+				AuthorGithubUsername: new("octocat"),
+				RevertedFromRevision: new(int64(1)),
+				CreatedAt:            createdAt,
 			},
-			getCfg:        nil,
-			expected:      nil,
-			expectedError: backendtypes.ErrEntityDoesNotExist,
 		},
 	}
-
+	if diff := cmp.Diff(expected, page); diff != "" {
+		t.Errorf("unexpected page (-want +got):\n%s", diff)
+	}
+}
+
+func TestListSavedSearchRevisions_Error(t *testing.T) {
+	testCases := []struct {
+		name          string
+		returnedError error
+		expectedError error
+	}{
+		{
+			name:          "invalid page token",
+			returnedError: gcpspanner.ErrInvalidCursorFormat,
+			expectedError: backendtypes.ErrInvalidPageToken,
+		},
+		{
+			name:          "no role",
+			returnedError: gcpspanner.ErrMissingRequiredRole,
+			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
+		},
+	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			//nolint: exhaustruct
 			mock := mockBackendSpannerClient{
-				t:                                    t,
-				mockUpdateSavedSearchSubscriptionCfg: tc.updateCfg,
-				mockGetSavedSearchSubscriptionCfg:    tc.getCfg,
+				t: t,
+				mockListSavedSearchRevisionsCfg: &mockListSavedSearchRevisionsConfig{
+					expectedRequest: gcpspanner.ListSavedSearchRevisionsRequest{
+						SavedSearchID:    "search-id",
+						RequestingUserID: "user123",
+						PageSize:         2,
+						PageToken:        nil,
+					},
+					result:        nil,
+					returnedError: tc.returnedError,
+				},
 			}
 			b := NewBackend(mock)
-			resp, err := b.UpdateSavedSearchSubscription(context.Background(), userID, subID, tc.input)
+			_, err := b.ListSavedSearchRevisions(context.Background(), "user123", "search-id", 2, nil)
 			if !errors.Is(err, tc.expectedError) {
 				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
 			}
-			if diff := cmp.Diff(tc.expected, resp, getTriggerCmpOption()); diff != "" {
-				t.Errorf("response mismatch (-want +got):\n%s", diff)
-			}
 		})
 	}
 }
 
-func TestDeleteSavedSearchSubscription(t *testing.T) {
-	const (
-		userID = "user123"
-		subID  = "sub456"
-	)
-
+func TestGetSavedSearchRevisionDiff(t *testing.T) {
+	revision := func(number int64, name, query string, description *string) *gcpspanner.SavedSearchRevision {
+		//nolint: exhaustruct
+		return &gcpspanner.SavedSearchRevision{
+			SavedSearchID:  "search-id",
+			RevisionNumber: number,
+			Name:           name,
+			Query:          query,
+			Description:    description,
+		}
+	}
 	testCases := []struct {
-		name          string
-		cfg           *mockDeleteSavedSearchSubscriptionConfig
-		expectedError error
+		name     string
+		base     *gcpspanner.SavedSearchRevision
+		revision *gcpspanner.SavedSearchRevision
+		expected *backend.SavedSearchRevisionDiff
 	}{
 		{
-			name: "success",
-			cfg: &mockDeleteSavedSearchSubscriptionConfig{
-				expectedSubscriptionID: subID,
-				expectedUserID:         userID,
-				returnedError:          nil,
-			},
-			expectedError: nil,
-		},
-		{
-			name: "not found",
-			cfg: &mockDeleteSavedSearchSubscriptionConfig{
-				expectedSubscriptionID: subID,
-				expectedUserID:         userID,
-				returnedError:          gcpspanner.ErrQueryReturnedNoResults,
+			name:     "query and description changed",
+			base:     revision(1, "name", "group:css", new("old")),
+			revision: revision(2, "name", "group:html", nil),
+			expected: &backend.SavedSearchRevisionDiff{
+				BaseRevision: new(int64(1)),
+				Revision:     2,
+				Name:         nil,
+				Query: &backend.SavedSearchRevisionFieldChange{
+					OldValue: new("group:css"),
+					NewValue: new("group:html"),
+				},
+				Description: &backend.SavedSearchRevisionFieldChange{
+					OldValue: new("old"),
+					NewValue: nil,
+				},
 			},
-			expectedError: backendtypes.ErrEntityDoesNotExist,
 		},
 		{
-			name: "not authorized",
-			cfg: &mockDeleteSavedSearchSubscriptionConfig{
-				expectedSubscriptionID: subID,
-				expectedUserID:         userID,
-				returnedError:          gcpspanner.ErrMissingRequiredRole,
+			name:     "first revision",
+			base:     nil,
+			revision: revision(1, "name", "group:css", nil),
+			expected: &backend.SavedSearchRevisionDiff{
+				BaseRevision: nil,
+				Revision:     1,
+				Name:         &backend.SavedSearchRevisionFieldChange{OldValue: nil, NewValue: new("name")},
+				Query:        &backend.SavedSearchRevisionFieldChange{OldValue: nil, NewValue: new("group:css")},
+				Description:  nil,
 			},
-			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
 		},
 	}
-
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			//nolint: exhaustruct
 			mock := mockBackendSpannerClient{
-				t:                                    t,
-				mockDeleteSavedSearchSubscriptionCfg: tc.cfg,
+				t: t,
+				mockGetSavedSearchRevisionPairCfg: &mockGetSavedSearchRevisionPairConfig{
+					expectedRequest: gcpspanner.GetSavedSearchRevisionPairRequest{
+						SavedSearchID:      "search-id",
+						RequestingUserID:   "user123",
+						RevisionNumber:     tc.revision.RevisionNumber,
+						BaseRevisionNumber: nil,
+					},
+					base:          tc.base,
+					revision:      tc.revision,
+					returnedError: nil,
+				},
 			}
 			b := NewBackend(mock)
-			err := b.DeleteSavedSearchSubscription(context.Background(), userID, subID)
-			if !errors.Is(err, tc.expectedError) {
-				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+			diff, err := b.GetSavedSearchRevisionDiff(
+				context.Background(), "user123", "search-id", tc.revision.RevisionNumber, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d := cmp.Diff(tc.expected, diff); d != "" {
+				t.Errorf("unexpected diff (-want +got):\n%s", d)
 			}
 		})
 	}
 }
 
-func assertKnownTrigger(t *testing.T, itemIndex int,
-	actual backend.SubscriptionTriggerResponseItem, expectedValue string) {
-	t.Helper() // Marks this as a helper function for better test failure reporting.
-
-	val, err := actual.Value.AsSubscriptionTriggerWritable()
-	if err != nil {
-		t.Errorf("item %d: expected SubscriptionTriggerWritable, but it was not. err: %s", itemIndex, err)
-	}
-	if string(val) != expectedValue {
-		t.Errorf("item %d: unexpected value: got %q, want %q", itemIndex, val, expectedValue)
-	}
-	if actual.RawValue != nil {
-		t.Errorf("item %d: RawValue should be nil for known trigger, got %q", itemIndex, *actual.RawValue)
+func TestListSavedSearchSnapshots(t *testing.T) {
+	createdAt := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	//nolint: exhaustruct
+	mock := mockBackendSpannerClient{
+		t: t,
+		mockListSavedSearchSnapshotsCfg: &mockListSavedSearchSnapshotsConfig{
+			expectedRequest: gcpspanner.ListSavedSearchSnapshotsRequest{
+				SavedSearchID:    "search-id",
+				RequestingUserID: "user123",
+				SnapshotType:     gcpspanner.SavedSearchSnapshotTypeWeekly,
+				At:               &createdAt,
+				PageSize:         2,
+				PageToken:        nonNilInputPageToken,
+			},
+			result: &gcpspanner.SavedSearchSnapshotsPage{
+				NextPageToken: nonNilNextPageToken,
+				Snapshots: []gcpspanner.SavedSearchSnapshot{
+					{
+						ID:            "event-1",
+						SavedSearchID: "search-id",
+						SnapshotType:  gcpspanner.SavedSearchSnapshotTypeWeekly,
+						Timestamp:     &createdAt,
+						Reasons:       []string{"QUERY_CHANGED", "SOMETHING_NEW", "DATA_UPDATED"},
+						BlobPath:      "path/state-1",
+					},
+				},
+			},
+			returnedError: nil,
+		},
 	}
-}
-
-func assertUnknownTrigger(t *testing.T, itemIndex int,
-	actual backend.SubscriptionTriggerResponseItem, expectedValue string, expectedRawValue *string) {
-	t.Helper()
-
-	val, err := actual.Value.AsEnumUnknown()
+	b := NewBackend(mock)
+	page, err := b.ListSavedSearchSnapshots(context.Background(), "user123", "search-id",
+		backend.SubscriptionFrequencyWeekly, &createdAt, 2, nonNilInputPageToken)
 	if err != nil {
-		t.Errorf("item %d: expected EnumUnknown, but it was not. err : %s", itemIndex, err)
-	}
-	if string(val) != expectedValue {
-		t.Errorf("item %d: unexpected unknown value: got %q, want %q", itemIndex, val, expectedValue)
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := &backend.SavedSearchSnapshotPage{
+		Metadata: &backend.PageMetadata{NextPageToken: nonNilNextPageToken},
+		Data: &[]backend.SavedSearchSnapshotMetadata{
+			{
+				Id:           "event-1",
+				SnapshotType: backend.SubscriptionFrequencyWeekly,
+				CreatedAt:    createdAt,
+				Reasons: &[]backend.SavedSearchSnapshotReason{
+					backend.SavedSearchSnapshotReasonQueryChanged,
+					backend.SavedSearchSnapshotReasonDataUpdated,
+				},
+			},
+		},
 	}
-	if actual.RawValue == nil || expectedRawValue == nil || *actual.RawValue != *expectedRawValue {
-		t.Errorf("item %d: incorrect RawValue for unknown trigger: got %v, want %v",
-			itemIndex, actual.RawValue, expectedRawValue)
+	if diff := cmp.Diff(expected, page); diff != "" {
+		t.Errorf("unexpected page (-want +got):\n%s", diff)
 	}
 }
 
-//nolint:ireturn
-func TestSpannerTriggersToBackendTriggers(t *testing.T) {
+func TestGetSavedSearchSnapshotRef(t *testing.T) {
+	at := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
 	testCases := []struct {
 		name          string
-		inputTriggers []gcpspanner.SubscriptionTrigger
-		expectedItems []struct {
-			IsUnknown bool
-			Value     string
-			RawValue  *string
-		}
+		result        *gcpspanner.SavedSearchSnapshot
+		returnedError error
+		expected      *backendtypes.SavedSearchSnapshotRef
+		expectedError error
 	}{
 		{
-			name: "All Valid Triggers",
-			inputTriggers: []gcpspanner.SubscriptionTrigger{
-				gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete,
-				gcpspanner.SubscriptionTriggerFeatureBaselinePromoteToNewly,
-			},
-			expectedItems: []struct {
-				IsUnknown bool
-				Value     string
-				RawValue  *string
-			}{
-				{IsUnknown: false,
-					Value:    string(backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete),
-					RawValue: nil},
-				{IsUnknown: false,
-					Value:    string(backend.SubscriptionTriggerFeatureBaselineToNewly),
-					RawValue: nil},
-			},
-		},
-		{
-			name: "Mixed Valid and Unknown Triggers",
-			inputTriggers: []gcpspanner.SubscriptionTrigger{
-				gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete,
-				"deprecated_trigger",
-				gcpspanner.SubscriptionTriggerFeatureBaselineRegressionToLimited,
-				"another_unknown",
-			},
-			expectedItems: []struct {
-				IsUnknown bool
-				Value     string
-				RawValue  *string
-			}{
-				{IsUnknown: false,
-					Value:    string(backend.SubscriptionTriggerFeatureBrowserImplementationAnyComplete),
-					RawValue: nil},
-				{IsUnknown: true,
-					Value:    string(backend.EnumUnknownValue),
-					RawValue: new("deprecated_trigger")},
-				{IsUnknown: false,
-					Value:    string(backend.SubscriptionTriggerFeatureBaselineRegressionToLimited),
-					RawValue: nil},
-				{IsUnknown: true,
-					Value:    string(backend.EnumUnknownValue),
-					RawValue: new("another_unknown")},
-			},
-		},
-		{
-			name:          "All Unknown Triggers",
-			inputTriggers: []gcpspanner.SubscriptionTrigger{"unknown1", "unknown2"},
-			expectedItems: []struct {
-				IsUnknown bool
-				Value     string
-				RawValue  *string
-			}{
-				{IsUnknown: true, Value: string(backend.EnumUnknownValue), RawValue: new("unknown1")},
-				{IsUnknown: true, Value: string(backend.EnumUnknownValue), RawValue: new("unknown2")},
+			name: "success",
+			result: &gcpspanner.SavedSearchSnapshot{
+				ID:            gcpspanner.LatestSavedSearchSnapshotID,
+				SavedSearchID: "search-id",
+				SnapshotType:  gcpspanner.SavedSearchSnapshotTypeImmediate,
+				Timestamp:     nil,
+				Reasons:       nil,
+				BlobPath:      "path/state-2",
+			},
+			returnedError: nil,
+			expected: &backendtypes.SavedSearchSnapshotRef{
+				ID:           gcpspanner.LatestSavedSearchSnapshotID,
+				SnapshotType: backend.SubscriptionFrequencyImmediate,
+				BlobPath:     "path/state-2",
 			},
+			expectedError: nil,
 		},
 		{
-			name:          "Empty Triggers",
-			inputTriggers: []gcpspanner.SubscriptionTrigger{},
-			expectedItems: []struct {
-				IsUnknown bool
-				Value     string
-				RawValue  *string
-			}{},
+			name:          "not found",
+			result:        nil,
+			returnedError: gcpspanner.ErrQueryReturnedNoResults,
+			expected:      nil,
+			expectedError: backendtypes.ErrEntityDoesNotExist,
 		},
 		{
-			name:          "Nil Triggers",
-			inputTriggers: nil,
-			expectedItems: nil,
+			name:          "no role",
+			result:        nil,
+			returnedError: gcpspanner.ErrMissingRequiredRole,
+			expected:      nil,
+			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
 		},
 	}
-
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			actualItems := spannerTriggersToBackendTriggers(tc.inputTriggers)
-			if tc.name == "Nil Triggers" {
-				if len(actualItems) == 0 && tc.expectedItems == nil {
-					actualItems = nil
-				} else if actualItems != nil && tc.expectedItems == nil {
-					t.Fatalf("unexpected non-nil slice for nil input: got %v", actualItems)
-				}
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t: t,
+				mockGetSavedSearchSnapshotCfg: &mockGetSavedSearchSnapshotConfig{
+					expectedRequest: gcpspanner.GetSavedSearchSnapshotRequest{
+						SavedSearchID:    "search-id",
+						RequestingUserID: "user123",
+						SnapshotType:     gcpspanner.SavedSearchSnapshotTypeImmediate,
+						SnapshotID:       gcpspanner.LatestSavedSearchSnapshotID,
+						At:               &at,
+					},
+					result:        tc.result,
+					returnedError: tc.returnedError,
+				},
 			}
-
-			if len(actualItems) != len(tc.expectedItems) {
-				t.Fatalf("length mismatch: got %d, want %d", len(actualItems), len(tc.expectedItems))
+			b := NewBackend(mock)
+			ref, err := b.GetSavedSearchSnapshotRef(context.Background(), "user123", "search-id",
+				gcpspanner.LatestSavedSearchSnapshotID, backend.SubscriptionFrequencyImmediate, &at)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
 			}
-
-			for i, actual := range actualItems {
-				expected := tc.expectedItems[i]
-				if expected.IsUnknown {
-					assertUnknownTrigger(t, i, actual, expected.Value, expected.RawValue)
-				} else {
-					assertKnownTrigger(t, i, actual, expected.Value)
-				}
+			if diff := cmp.Diff(tc.expected, ref); diff != "" {
+				t.Errorf("unexpected ref (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
 
-// nolint:ireturn // Required to return the cmp.Option interface for use with go-cmp.
-func getNotificationChannelCmpOption() cmp.Option {
-	return cmp.AllowUnexported(backend.NotificationChannelResponse_Config{})
-}
-
-// nolint:ireturn // Required to return the cmp.Option interface for use with go-cmp.
-func getTriggerCmpOption() cmp.Option {
-	return cmp.Transformer("triggerValue", func(in backend.SubscriptionTriggerResponseValue) string {
-		// AsSubscriptionTriggerWritable returns the value and a boolean indicating if it was that type.
-		v1, err1 := in.AsSubscriptionTriggerWritable()
-		if err1 == nil {
-			return string(v1)
-		}
-		v2, err2 := in.AsEnumUnknown()
-		if err2 == nil {
-			return string(v2)
-		}
-		// Should not happen
-		panic(fmt.Sprintf("received the following errors trying to conver trigger value. err1: %s err2: %s",
-			err1, err2))
-	})
-}
-
-func newTestNotificationChannelConfig(t *testing.T, config any) backend.NotificationChannelResponse_Config {
-	t.Helper()
-
-	var c backend.NotificationChannelResponse_Config
-
-	bytes, err := json.Marshal(config)
-	if err != nil {
-		t.Fatalf("failed to marshal test config: %v", err)
+func TestRevertSavedSearch(t *testing.T) {
+	testCases := []struct {
+		name          string
+		revisionQuery string
+		revisionError error
+		revertCalled  bool
+		expectedError error
+	}{
+		{
+			name:          "success",
+			revisionQuery: "group:css",
+			revisionError: nil,
+			revertCalled:  true,
+			expectedError: nil,
+		},
+		{
+			name:          "unknown revision",
+			revisionQuery: "",
+			revisionError: gcpspanner.ErrQueryReturnedNoResults,
+			revertCalled:  false,
+			expectedError: backendtypes.ErrEntityDoesNotExist,
+		},
+		{
+			name:          "no role",
+			revisionQuery: "",
+			revisionError: gcpspanner.ErrMissingRequiredRole,
+			revertCalled:  false,
+			expectedError: backendtypes.ErrUserNotAuthorizedForAction,
+		},
+		{
+			name:          "revision references a missing saved search",
+			revisionQuery: "(saved:missing) group:css",
+			revisionError: nil,
+			revertCalled:  false,
+			expectedError: backendtypes.ErrSavedSearchNotFound,
+		},
+		{
+			name:          "revision uses an undeclared variable",
+			revisionQuery: "baseline_status:$status",
+			revisionError: nil,
+			revertCalled:  false,
+			expectedError: searchtypes.ErrUnboundQueryVariable,
+		},
 	}
-
-	if err := c.UnmarshalJSON(bytes); err != nil {
-		t.Fatalf("failed to unmarshal test config into wrapper: %v", err)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var revision *gcpspanner.SavedSearchRevision
+			if tc.revisionError == nil {
+				//nolint: exhaustruct
+				revision = &gcpspanner.SavedSearchRevision{
+					SavedSearchID:  "search-id",
+					RevisionNumber: 1,
+					Name:           "name",
+					Query:          tc.revisionQuery,
+				}
+			}
+			revertCalls := 0
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t: t,
+				mockGetSavedSearchRevisionCfg: &mockGetSavedSearchRevisionConfig{
+					expectedRequest: gcpspanner.GetSavedSearchRevisionRequest{
+						SavedSearchID:    "search-id",
+						RequestingUserID: "user123",
+						RevisionNumber:   1,
+					},
+					result:        revision,
+					returnedError: tc.revisionError,
+				},
+				mockGetSavedSearchCfg: &mockGetSavedSearchConfig{
+					results: nil,
+					errs:    nil,
+				},
+				mockGetSystemGlobalSavedSearchCfg: &mockGetSystemGlobalSavedSearchConfig{
+					results: nil,
+					errs:    nil,
+				},
+				mockGetReferencingSavedSearchIDsCfg: &mockGetReferencingSavedSearchIDsConfig{
+					results: nil,
+					errs:    nil,
+				},
+				mockRevertSavedSearchCfg: &mockRevertSavedSearchConfig{
+					expectedRequest: gcpspanner.RevertSavedSearchRequest{
+						SavedSearchID:    "search-id",
+						RequestingUserID: "user123",
+						RevisionNumber:   1,
+					},
+					returnedError: nil,
+					calls:         &revertCalls,
+				},
+				mockGetUserSavedSearchCfg: &mockGetUserSavedSearchConfig{
+					expectedSavedSearchID:       "search-id",
+					expectedAuthenticatedUserID: new("user123"),
+					//nolint: exhaustruct
+					result: &gcpspanner.UserSavedSearch{
+						SavedSearch: gcpspanner.SavedSearch{
+							ID:    "search-id",
+							Name:  "name",
+							Query: "group:css",
+						},
+					},
+					returnedError: nil,
+				},
+			}
+			b := NewBackend(mock)
+			resp, err := b.RevertSavedSearch(context.Background(), "user123", "search-id", 1)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+			}
+			if tc.expectedError == nil && (resp == nil || resp.Query != "group:css") {
+				t.Errorf("unexpected response %+v", resp)
+			}
+			if (revertCalls == 1) != tc.revertCalled {
+				t.Errorf("unexpected number of reverts %d", revertCalls)
+			}
+		})
 	}
-
-	return c
 }
 
-func newTestCreateNotificationChannelConfig(t *testing.T, config any) backend.CreateNotificationChannelRequest_Config {
-	t.Helper()
-
-	var c backend.CreateNotificationChannelRequest_Config
-
-	bytes, err := json.Marshal(config)
-	if err != nil {
-		t.Fatalf("failed to marshal test config: %v", err)
-	}
-
-	if err := c.UnmarshalJSON(bytes); err != nil {
-		t.Fatalf("failed to unmarshal test config into wrapper: %v", err)
+func TestForkSavedSearch(t *testing.T) {
+	createdAt := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	//nolint: exhaustruct
+	mock := mockBackendSpannerClient{
+		t: t,
+		mockForkSavedSearchCfg: &mockForkSavedSearchConfig{
+			expectedRequest: gcpspanner.ForkSavedSearchRequest{
+				SourceSavedSearchID: "search-id",
+				OwnerUserID:         "user123",
+				Name:                new("my fork"),
+				Description:         nil,
+			},
+			result:        new("fork-id"),
+			returnedError: nil,
+		},
+		mockGetUserSavedSearchCfg: &mockGetUserSavedSearchConfig{
+			expectedSavedSearchID:       "fork-id",
+			expectedAuthenticatedUserID: new("user123"),
+			result: &gcpspanner.UserSavedSearch{
+				SavedSearch: gcpspanner.SavedSearch{
+					ID:              "fork-id",
+					Name:            "my fork",
+					Description:     nil,
+					Query:           "group:css",
+					Scope:           gcpspanner.UserPublicScope,
+					AuthorID:        "user123",
+					CreatedAt:       createdAt,
+					UpdatedAt:       createdAt,
+					Tags:            nil,
+					Listed:          false,
+					QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
+				},
+				Role:         new(string(gcpspanner.SavedSearchOwner)),
+				IsBookmarked: new(true),
+			},
+			returnedError: nil,
+		},
+		mockGetSavedSearchForkSourceCfg: &mockGetSavedSearchForkSourceConfig{
+			expectedSavedSearchID: "fork-id",
+			result: &gcpspanner.SavedSearchForkSource{
+				SavedSearchID:  "search-id",
+				RevisionNumber: new(int64(2)),
+				Name:           new("interop"),
+			},
+			returnedError: nil,
+		},
 	}
-
-	return c
-}
-
-func newTestUpdateNotificationChannelRequestConfig(
-	t *testing.T, config any) *backend.UpdateNotificationChannelRequest_Config {
-	t.Helper()
-
-	var c backend.UpdateNotificationChannelRequest_Config
-
-	bytes, err := json.Marshal(config)
+	b := NewBackend(mock)
+	resp, err := b.ForkSavedSearch(context.Background(), "user123", "search-id",
+		&backend.ForkSavedSearchRequest{Name: new("my fork"), Description: nil})
 	if err != nil {
-		t.Fatalf("failed to marshal test config: %v", err)
+		t.Fatalf("unexpected error: %v", err)
 	}
-
-	if err := c.UnmarshalJSON(bytes); err != nil {
-		t.Fatalf("failed to unmarshal test config into wrapper: %v", err)
+	expected := &backend.SavedSearchResponse{
+		Id:          "fork-id",
+		Name:        "my fork",
+		Description: nil,
+		Query:       "group:css",
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+		Permissions: &backend.UserSavedSearchPermissions{Role: new(backend.SavedSearchOwner)},
+		BookmarkStatus: &backend.UserSavedSearchBookmark{
+			Status: backend.BookmarkActive,
+		},
+		ForkedFrom: &backend.SavedSearchForkSource{
+			Id:             "search-id",
+			Name:           new("interop"),
+			RevisionNumber: new(int64(2)),
+		},
+		Tags:       nil,
+		Listed:     new(false),
+		Parameters: nil,
+	}
+	if diff := cmp.Diff(expected, resp); diff != "" {
+		t.Errorf("unexpected fork (-want +got):\n%s", diff)
 	}
-
-	return &c
 }
 
-func TestValidateQueryReferences(t *testing.T) {
+func TestForkSavedSearch_Error(t *testing.T) {
 	testCases := []struct {
-		name                string
-		query               string
-		updateID            *string
-		systemSearches      map[string]*gcpspanner.SystemGlobalSavedSearchWithSortOption
-		userSearches        map[string]*gcpspanner.SavedSearch
-		referencingSearches map[string][]string
-		expectedError       error
+		name          string
+		returnedError error
+		expectedError error
 	}{
 		{
-			name:                "valid simple query",
-			query:               "name:\"flexbox\"",
-			updateID:            nil,
-			systemSearches:      nil,
-			userSearches:        nil,
-			referencingSearches: nil,
-			expectedError:       nil,
+			name:          "saved search limit",
+			returnedError: gcpspanner.ErrOwnerSavedSearchLimitExceeded,
+			expectedError: backendtypes.ErrUserMaxSavedSearches,
 		},
 		{
-			name:           "valid saved search reference",
-			query:          "(saved:search1) name:\"flexbox\"",
-			updateID:       nil,
-			systemSearches: nil,
-			userSearches: map[string]*gcpspanner.SavedSearch{
-				"search1": {Query: "name:\"flexbox\""},
-			},
-			referencingSearches: nil,
-			expectedError:       nil,
+			name:          "source not found",
+			returnedError: gcpspanner.ErrQueryReturnedNoResults,
+			expectedError: backendtypes.ErrEntityDoesNotExist,
 		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t: t,
+				mockForkSavedSearchCfg: &mockForkSavedSearchConfig{
+					expectedRequest: gcpspanner.ForkSavedSearchRequest{
+						SourceSavedSearchID: "search-id",
+						OwnerUserID:         "user123",
+						Name:                nil,
+						Description:         nil,
+					},
+					result:        nil,
+					returnedError: tc.returnedError,
+				},
+			}
+			b := NewBackend(mock)
+			_, err := b.ForkSavedSearch(context.Background(), "user123", "search-id", nil)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestListSavedSearchDirectory(t *testing.T) {
+	createdAt := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name          string
+		cfg           *mockListSavedSearchDirectoryConfig
+		query         *string
+		tag           *string
+		sort          backend.ListSavedSearchDirectoryParamsSort
+		pageToken     *string
+		expected      *backend.SavedSearchDirectoryPage
+		expectedError error
+	}{
 		{
-			name:     "valid hotlist reference",
-			query:    "(hotlist:search1) name:\"flexbox\"",
-			updateID: nil,
-			systemSearches: map[string]*gcpspanner.SystemGlobalSavedSearchWithSortOption{
-				"search1": {SystemGlobalSavedSearch: gcpspanner.SystemGlobalSavedSearch{
-					ID:           "",
-					Name:         "",
-					Description:  nil,
-					Scope:        "",
-					AuthorID:     "",
-					CreatedAt:    time.Time{},
-					UpdatedAt:    time.Time{},
-					DisplayOrder: 0,
-					Status:       "",
-					Query:        "name:\"flexbox\"",
-				}},
+			name: "success",
+			cfg: &mockListSavedSearchDirectoryConfig{
+				expectedRequest: gcpspanner.ListSavedSearchDirectoryRequest{
+					Query:     new("css"),
+					Tag:       new("interop"),
+					Sort:      gcpspanner.SavedSearchDirectorySortPopular,
+					PageSize:  10,
+					PageToken: nil,
+				},
+				result: &gcpspanner.SavedSearchDirectoryPage{
+					NextPageToken: new("next"),
+					Entries: []gcpspanner.SavedSearchDirectoryEntry{
+						{
+							SavedSearch: gcpspanner.SavedSearch{
+								ID:              "search-id",
+								Name:            "css",
+								Description:     nil,
+								Query:           "group:css",
+								Scope:           gcpspanner.UserPublicScope,
+								AuthorID:        "user1",
+								CreatedAt:       createdAt,
+								UpdatedAt:       createdAt,
+								Tags:            []string{"interop"},
+								Listed:          true,
+								QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
+							},
+							BookmarkCount:   3,
+							SubscriberCount: 2,
+						},
+					},
+				},
+				returnedError: nil,
 			},
-			userSearches:        nil,
-			referencingSearches: nil,
-			expectedError:       nil,
+			query:     new("css"),
+			tag:       new("interop"),
+			sort:      backend.SavedSearchDirectorySortPopular,
+			pageToken: nil,
+			expected: &backend.SavedSearchDirectoryPage{
+				Metadata: &backend.PageMetadata{
+					NextPageToken: new("next"),
+				},
+				Data: &[]backend.SavedSearchDirectoryEntry{
+					{
+						Id:              "search-id",
+						Name:            "css",
+						Description:     nil,
+						Query:           "group:css",
+						Tags:            &[]string{"interop"},
+						Listed:          new(true),
+						Parameters:      nil,
+						CreatedAt:       createdAt,
+						UpdatedAt:       createdAt,
+						BookmarkCount:   3,
+						SubscriberCount: 2,
+					},
+				},
+			},
+			expectedError: nil,
 		},
 		{
-			name:                "invalid saved search - missing",
-			query:               "(saved:missing) name:\"flexbox\"",
-			updateID:            nil,
-			systemSearches:      nil,
-			userSearches:        nil,
-			referencingSearches: nil,
-			expectedError:       backendtypes.ErrSavedSearchNotFound,
+			name: "invalid page token",
+			cfg: &mockListSavedSearchDirectoryConfig{
+				expectedRequest: gcpspanner.ListSavedSearchDirectoryRequest{
+					Query:     nil,
+					Tag:       nil,
+					Sort:      gcpspanner.SavedSearchDirectorySortRecent,
+					PageSize:  10,
+					PageToken: new("bad"),
+				},
+				result:        nil,
+				returnedError: gcpspanner.ErrInvalidCursorFormat,
+			},
+			query:         nil,
+			tag:           nil,
+			sort:          backend.SavedSearchDirectorySortRecent,
+			pageToken:     new("bad"),
+			expected:      nil,
+			expectedError: backendtypes.ErrInvalidPageToken,
 		},
-		{
-			name:                "invalid hotlist - missing",
-			query:               "(hotlist:missing) name:\"flexbox\"",
-			updateID:            nil,
-			systemSearches:      nil,
-			userSearches:        nil,
-			referencingSearches: nil,
-			expectedError:       backendtypes.ErrHotlistNotFound,
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			//nolint: exhaustruct
+			mock := mockBackendSpannerClient{
+				t:                               t,
+				mockListSavedSearchDirectoryCfg: tc.cfg,
+			}
+			b := NewBackend(mock)
+			page, err := b.ListSavedSearchDirectory(context.Background(), tc.query, tc.tag, tc.sort, 10, tc.pageToken)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+			}
+			if diff := cmp.Diff(tc.expected, page); diff != "" {
+				t.Errorf("unexpected page (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExportUserData(t *testing.T) {
+	userID := "user1"
+	//nolint: exhaustruct
+	mock := mockBackendSpannerClient{
+		t: t,
+		mockListUserSavedSearchesCfg: &mockListUserSavedSearchesConfig{
+			expectedUserID:    userID,
+			expectedPageSize:  userDataExportPageSize,
+			expectedPageToken: nil,
+			result: &gcpspanner.UserSavedSearchesPage{
+				NextPageToken: nil,
+				Searches: []gcpspanner.UserSavedSearch{
+					{
+						SavedSearch: gcpspanner.SavedSearch{
+							ID:              "search1",
+							Name:            "CSS",
+							Description:     new("desc"),
+							Query:           "group:css",
+							Scope:           gcpspanner.UserPublicScope,
+							AuthorID:        userID,
+							CreatedAt:       time.Time{},
+							UpdatedAt:       time.Time{},
+							Tags:            []string{"css"},
+							Listed:          true,
+							QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
+						},
+						Role:         new(string(gcpspanner.SavedSearchOwner)),
+						IsBookmarked: new(true),
+					},
+					{
+						SavedSearch: gcpspanner.SavedSearch{
+							ID:              "hotlist1",
+							Name:            "Hotlist",
+							Description:     nil,
+							Query:           gcpspanner.UserHotlistQuery("hotlist1"),
+							Scope:           gcpspanner.UserPublicScope,
+							AuthorID:        userID,
+							CreatedAt:       time.Time{},
+							UpdatedAt:       time.Time{},
+							Tags:            nil,
+							Listed:          false,
+							QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
+						},
+						Role:         new(string(gcpspanner.SavedSearchOwner)),
+						IsBookmarked: new(true),
+					},
+					{
+						SavedSearch: gcpspanner.SavedSearch{
+							ID:              "other1",
+							Name:            "Other",
+							Description:     nil,
+							Query:           "group:html",
+							Scope:           gcpspanner.UserPublicScope,
+							AuthorID:        "user2",
+							CreatedAt:       time.Time{},
+							UpdatedAt:       time.Time{},
+							Tags:            nil,
+							Listed:          true,
+							QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
+						},
+						Role:         nil,
+						IsBookmarked: new(true),
+					},
+				},
+			},
+			returnedError: nil,
 		},
-		{
-			name:           "invalid depth - 3 levels",
-			query:          "(saved:search1) name:\"flexbox\"",
-			updateID:       nil,
-			systemSearches: nil,
-			userSearches: map[string]*gcpspanner.SavedSearch{
-				"search1": {Query: "saved:search2"},
-				"search2": {Query: "saved:search3"},
-				"search3": {Query: "name:\"flexbox\""},
+		mockGetUserHotlistCfg: &mockGetUserHotlistConfig{
+			results: map[string]*gcpspanner.UserHotlist{
+				"hotlist1": {
+					SavedSearchID: "hotlist1",
+					OrderVersion:  2,
+					FeatureKeys:   []string{"grid", "subgrid"},
+					CreatedAt:     time.Time{},
+					UpdatedAt:     time.Time{},
+				},
 			},
-			referencingSearches: nil,
-			expectedError:       backendtypes.ErrSavedSearchMaxDepthExceeded,
+			errs: nil,
 		},
-		{
-			name:           "valid depth - 2 levels",
-			query:          "(saved:search1) name:\"flexbox\"",
-			updateID:       nil,
-			systemSearches: nil,
-			userSearches: map[string]*gcpspanner.SavedSearch{
-				"search1": {Query: "saved:search2"},
-				"search2": {Query: "name:\"flexbox\""},
+		mockListNotificationChannelsCfg: &mockListNotificationChannelsConfig{
+			expectedRequest: gcpspanner.ListNotificationChannelsRequest{
+				UserID:    userID,
+				PageSize:  userDataExportPageSize,
+				PageToken: nil,
 			},
-			referencingSearches: nil,
-			expectedError:       nil,
+			result: []gcpspanner.NotificationChannel{
+				{
+					ID:     "email1",
+					UserID: userID,
+					Name:   "Email",
+					Type:   gcpspanner.NotificationChannelTypeEmail,
+					EmailConfig: &gcpspanner.EmailConfig{
+						Address:           "user@example.com",
+						IsVerified:        true,
+						VerificationToken: new("secret-token"),
+					},
+					WebhookConfig: nil,
+					CreatedAt:     time.Time{},
+					UpdatedAt:     time.Time{},
+				},
+				{
+					ID:            "webhook1",
+					UserID:        userID,
+					Name:          "Slack",
+					Type:          gcpspanner.NotificationChannelTypeWebhook,
+					EmailConfig:   nil,
+					WebhookConfig: &gcpspanner.WebhookConfig{URL: "https://hooks.slack.com/services/secret"},
+					CreatedAt:     time.Time{},
+					UpdatedAt:     time.Time{},
+				},
+			},
+			nextPageToken: nil,
+			returnedError: nil,
 		},
-		{
-			name:           "cycle detection - direct",
-			query:          "(saved:search1) name:\"flexbox\"",
-			updateID:       nil,
-			systemSearches: nil,
-			userSearches: map[string]*gcpspanner.SavedSearch{
-				"search1": {Query: "saved:search1"},
+		mockListSavedSearchSubscriptionsCfg: &mockListSavedSearchSubscriptionsConfig{
+			expectedRequest: gcpspanner.ListSavedSearchSubscriptionsRequest{
+				UserID:    userID,
+				PageSize:  userDataExportPageSize,
+				PageToken: nil,
+			},
+			result: []gcpspanner.SavedSearchSubscriptionView{
+				{
+					SavedSearchSubscription: gcpspanner.SavedSearchSubscription{
+						ID:            "sub1",
+						ChannelID:     "webhook1",
+						SavedSearchID: "search1",
+						Triggers: []gcpspanner.SubscriptionTrigger{
+							gcpspanner.SubscriptionTriggerFeatureBaselinePromoteToWidely,
+							gcpspanner.SubscriptionTrigger("deprecated"),
+						},
+						Frequency: gcpspanner.SavedSearchSnapshotTypeWeekly,
+						CreatedAt: time.Time{},
+						UpdatedAt: time.Time{},
+					},
+					SavedSearchName: "CSS",
+					ChannelType:     "webhook",
+				},
+				{
+					SavedSearchSubscription: gcpspanner.SavedSearchSubscription{
+						ID:            "sub2",
+						ChannelID:     "rss1",
+						SavedSearchID: "other1",
+						Triggers:      nil,
+						Frequency:     gcpspanner.SavedSearchSnapshotTypeImmediate,
+						CreatedAt:     time.Time{},
+						UpdatedAt:     time.Time{},
+					},
+					SavedSearchName: "Other",
+					ChannelType:     "rss",
+				},
 			},
-			referencingSearches: nil,
-			expectedError:       backendtypes.ErrSavedSearchCycleDetected,
+			nextPageToken: nil,
+			returnedError: nil,
 		},
-		{
-			name:           "cycle detection - indirect",
-			query:          "(saved:search1) name:\"flexbox\"",
-			updateID:       nil,
-			systemSearches: nil,
-			userSearches: map[string]*gcpspanner.SavedSearch{
-				"search1": {Query: "saved:search2"},
-				"search2": {Query: "saved:search1"},
+	}
+	b := NewBackend(mock)
+	export, err := b.ExportUserData(context.Background(), userID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if export.ExportedAt.IsZero() {
+		t.Error("expected exported_at to be set")
+	}
+	export.ExportedAt = time.Time{}
+
+	expected := &backend.UserDataExport{
+		Version:    backendtypes.UserDataExportVersion,
+		ExportedAt: time.Time{},
+		SavedSearches: []backend.UserDataSavedSearch{
+			{
+				Id:                "search1",
+				Name:              "CSS",
+				Description:       new("desc"),
+				Query:             "group:css",
+				Tags:              &backend.SavedSearchTags{"css"},
+				Listed:            true,
+				Parameters:        nil,
+				HotlistFeatureIds: nil,
+			},
+			{
+				Id:                "hotlist1",
+				Name:              "Hotlist",
+				Description:       nil,
+				Query:             gcpspanner.UserHotlistQuery("hotlist1"),
+				Tags:              nil,
+				Listed:            false,
+				Parameters:        nil,
+				HotlistFeatureIds: &[]string{"grid", "subgrid"},
+			},
+		},
+		Bookmarks: []backend.UserDataBookmark{{SavedSearchId: "other1"}},
+		NotificationChannels: []backend.UserDataNotificationChannel{
+			{
+				Id:           "email1",
+				Name:         "Email",
+				Type:         backend.UserDataNotificationChannelTypeEmail,
+				EmailAddress: new(openapi_types.Email("user@example.com")),
+				WebhookUrl:   nil,
+			},
+			{
+				Id:           "webhook1",
+				Name:         "Slack",
+				Type:         backend.UserDataNotificationChannelTypeWebhook,
+				EmailAddress: nil,
+				WebhookUrl:   nil,
+			},
+			{
+				Id:           "rss1",
+				Name:         "RSS",
+				Type:         backend.UserDataNotificationChannelTypeRSS,
+				EmailAddress: nil,
+				WebhookUrl:   nil,
+			},
+		},
+		Subscriptions: []backend.UserDataSubscription{
+			{
+				Id:            "sub1",
+				SavedSearchId: "search1",
+				ChannelId:     "webhook1",
+				Triggers:      []backend.SubscriptionTriggerWritable{backend.SubscriptionTriggerFeatureBaselineToWidely},
+				Frequency:     backend.SubscriptionFrequencyWeekly,
+			},
+			{
+				Id:            "sub2",
+				SavedSearchId: "other1",
+				ChannelId:     "rss1",
+				Triggers:      []backend.SubscriptionTriggerWritable{},
+				Frequency:     backend.SubscriptionFrequencyImmediate,
+			},
+		},
+	}
+	if diff := cmp.Diff(expected, export); diff != "" {
+		t.Errorf("unexpected export (-want +got):\n%s", diff)
+	}
+}
+
+func TestImportUserData(t *testing.T) {
+	data := backend.UserDataExport{
+		Version:    backendtypes.UserDataExportVersion,
+		ExportedAt: time.Time{},
+		SavedSearches: []backend.UserDataSavedSearch{
+			{
+				Id:                "hotlist1",
+				Name:              "Hotlist",
+				Description:       nil,
+				Query:             "hotlist:hotlist1",
+				Tags:              &backend.SavedSearchTags{"css"},
+				Listed:            false,
+				Parameters:        nil,
+				HotlistFeatureIds: &[]string{"grid"},
+			},
+		},
+		Bookmarks: []backend.UserDataBookmark{{SavedSearchId: "other1"}},
+		NotificationChannels: []backend.UserDataNotificationChannel{
+			{
+				Id:           "webhook1",
+				Name:         "Slack",
+				Type:         backend.UserDataNotificationChannelTypeWebhook,
+				EmailAddress: nil,
+				WebhookUrl:   new("https://hooks.slack.com/services/new"),
+			},
+		},
+		Subscriptions: []backend.UserDataSubscription{
+			{
+				Id:            "sub1",
+				SavedSearchId: "hotlist1",
+				ChannelId:     "webhook1",
+				Triggers:      []backend.SubscriptionTriggerWritable{backend.SubscriptionTriggerFeatureBaselineToNewly},
+				Frequency:     backend.SubscriptionFrequencyMonthly,
+			},
+		},
+	}
+	expectedRequest := gcpspanner.ImportUserDataRequest{
+		UserID: "user1",
+		SavedSearches: []gcpspanner.ImportSavedSearch{
+			{
+				SourceID:           "hotlist1",
+				Name:               "Hotlist",
+				Description:        nil,
+				Query:              "hotlist:hotlist1",
+				Tags:               []string{"css"},
+				Listed:             false,
+				QueryParameters:    nil,
+				IsHotlist:          true,
+				HotlistFeatureKeys: []string{"grid"},
+			},
+		},
+		BookmarkedSavedSearchIDs: []string{"other1"},
+		NotificationChannels: []gcpspanner.ImportNotificationChannel{
+			{
+				SourceID:     "webhook1",
+				Name:         "Slack",
+				Type:         gcpspanner.NotificationChannelTypeWebhook,
+				EmailAddress: "",
+				WebhookURL:   "https://hooks.slack.com/services/new",
+			},
+		},
+		Subscriptions: []gcpspanner.ImportSavedSearchSubscription{
+			{
+				SourceID:      "sub1",
+				SavedSearchID: "hotlist1",
+				ChannelID:     "webhook1",
+				Triggers: []gcpspanner.SubscriptionTrigger{
+					gcpspanner.SubscriptionTriggerFeatureBaselinePromoteToNewly,
+				},
+				Frequency: gcpspanner.SavedSearchSnapshotTypeMonthly,
 			},
-			referencingSearches: nil,
-			expectedError:       backendtypes.ErrSavedSearchCycleDetected,
 		},
+	}
+	testCases := []struct {
+		name          string
+		cfg           *mockImportUserDataConfig
+		expected      *backend.UserDataImportResult
+		expectedError error
+	}{
 		{
-			name:           "transitive depth violation during update",
-			query:          "(saved:child) name:\"flexbox\"",
-			updateID:       new("parent"),
-			systemSearches: nil,
-			userSearches: map[string]*gcpspanner.SavedSearch{
-				"child":      {Query: "saved:grandchild"},
-				"grandchild": {Query: "name:\"flexbox\""},
+			name: "success",
+			cfg: &mockImportUserDataConfig{
+				expectedRequest: expectedRequest,
+				result: []gcpspanner.ImportUserDataItemResult{
+					{
+						Kind:     gcpspanner.ImportUserDataItemSavedSearch,
+						SourceID: "hotlist1",
+						Status:   gcpspanner.ImportUserDataItemCreated,
+						ID:       "new-hotlist",
+					},
+					{
+						Kind:     gcpspanner.ImportUserDataItemBookmark,
+						SourceID: "other1",
+						Status:   gcpspanner.ImportUserDataItemExisting,
+						ID:       "other1",
+					},
+				},
+				returnedError: nil,
 			},
-			referencingSearches: map[string][]string{
-				"parent": {"ancestor"},
+			expected: &backend.UserDataImportResult{
+				Committed: true,
+				Results: []backend.UserDataImportItemResult{
+					{
+						Kind:     backend.UserDataImportItemKindSavedSearch,
+						SourceId: "hotlist1",
+						Status:   backend.UserDataImportItemStatusCreated,
+						Id:       new("new-hotlist"),
+						Message:  nil,
+					},
+					{
+						Kind:     backend.UserDataImportItemKindBookmark,
+						SourceId: "other1",
+						Status:   backend.UserDataImportItemStatusExisting,
+						Id:       new("other1"),
+						Message:  nil,
+					},
+				},
 			},
-			expectedError: backendtypes.ErrSavedSearchMaxDepthExceeded,
-		},
-		{
-			name:                "entirely single saved search",
-			query:               "saved:search1",
-			updateID:            nil,
-			systemSearches:      nil,
-			userSearches:        nil,
-			referencingSearches: nil,
-			expectedError:       backendtypes.ErrQueryConsistsEntirelyOfSavedSearch,
+			expectedError: nil,
 		},
 		{
-			name:                "entirely single hotlist",
-			query:               "hotlist:search1",
-			updateID:            nil,
-			systemSearches:      nil,
-			userSearches:        nil,
-			referencingSearches: nil,
-			expectedError:       backendtypes.ErrQueryConsistsEntirelyOfSavedSearch,
+			name: "item error",
+			cfg: &mockImportUserDataConfig{
+				expectedRequest: expectedRequest,
+				result:          nil,
+				returnedError: &gcpspanner.ImportUserDataItemError{
+					Kind:     gcpspanner.ImportUserDataItemBookmark,
+					SourceID: "other1",
+					Err:      gcpspanner.ErrImportReferenceNotFound,
+				},
+			},
+			expected:      nil,
+			expectedError: backendtypes.ErrEntityDoesNotExist,
 		},
 		{
-			name:                "expanded query exceeds max AST complexity",
-			query:               "(saved:child) OR id:extra",
-			updateID:            nil,
-			systemSearches:      nil,
-			referencingSearches: nil,
-			userSearches: func() map[string]*gcpspanner.SavedSearch {
-				terms := make([]string, 25)
-				for i := range terms {
-					terms[i] = fmt.Sprintf("id:feat-%d", i)
-				}
-
-				return map[string]*gcpspanner.SavedSearch{
-					"child": {Query: strings.Join(terms, " OR ")},
-				}
-			}(),
-			expectedError: backendtypes.ErrQueryComplexityExceeded,
+			name: "limit exceeded",
+			cfg: &mockImportUserDataConfig{
+				expectedRequest: expectedRequest,
+				result:          nil,
+				returnedError:   gcpspanner.ErrSubscriptionLimitExceeded,
+			},
+			expected:      nil,
+			expectedError: backendtypes.ErrUserMaxSubscriptions,
 		},
 	}
-
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			//nolint: exhaustruct
 			mock := mockBackendSpannerClient{
-				t: t,
-				mockGetSystemGlobalSavedSearchCfg: &mockGetSystemGlobalSavedSearchConfig{
-					results: tc.systemSearches,
-				},
-				mockGetSavedSearchCfg: &mockGetSavedSearchConfig{
-					results: tc.userSearches,
-				},
-				mockGetReferencingSavedSearchIDsCfg: &mockGetReferencingSavedSearchIDsConfig{
-					results: tc.referencingSearches,
-				},
+				t:                     t,
+				mockImportUserDataCfg: tc.cfg,
 			}
-			backend := NewBackend(mock)
-			err := backend.ValidateQueryReferences(context.Background(), tc.query, tc.updateID)
-			if (tc.expectedError != nil || err != nil) && !errors.Is(err, tc.expectedError) {
-				t.Errorf("expected error %v, got %v", tc.expectedError, err)
+			b := NewBackend(mock)
+			result, err := b.ImportUserData(context.Background(), "user1", data)
+			if !errors.Is(err, tc.expectedError) {
+				t.Errorf("unexpected error. got %v, want %v", err, tc.expectedError)
+			}
+			if diff := cmp.Diff(tc.expected, result); diff != "" {
+				t.Errorf("unexpected result (-want +got):\n%s", diff)
 			}
 		})
 	}
-}
 
-func newMockSystemGlobalSavedSearchWithSortOption(
-	id string, query string, hasCustomSortOrder bool) *gcpspanner.SystemGlobalSavedSearchWithSortOption {
-	return &gcpspanner.SystemGlobalSavedSearchWithSortOption{
-		SystemGlobalSavedSearch: gcpspanner.SystemGlobalSavedSearch{
-			ID:           id,
-			Name:         "Mock Name",
-			Description:  nil,
-			Query:        query,
-			Scope:        gcpspanner.SystemGlobalScope,
-			AuthorID:     "system",
-			CreatedAt:    time.Now(),
-			UpdatedAt:    time.Now(),
-			DisplayOrder: 0,
-			Status:       "LISTED",
+	// Item errors identify the item that failed.
+	//nolint: exhaustruct
+	mock := mockBackendSpannerClient{
+		t: t,
+		mockImportUserDataCfg: &mockImportUserDataConfig{
+			expectedRequest: expectedRequest,
+			result:          nil,
+			returnedError: &gcpspanner.ImportUserDataItemError{
+				Kind:     gcpspanner.ImportUserDataItemSubscription,
+				SourceID: "sub1",
+				Err:      gcpspanner.ErrSubscriptionConflict,
+			},
 		},
-		HasCustomSortOrder: hasCustomSortOrder,
+	}
+	_, err := NewBackend(mock).ImportUserData(context.Background(), "user1", data)
+	var itemErr *backendtypes.UserDataImportItemError
+	if !errors.As(err, &itemErr) || itemErr.Kind != backend.UserDataImportItemKindSubscription ||
+		itemErr.SourceID != "sub1" || !errors.Is(err, backendtypes.ErrSubscriptionConflict) {
+		t.Errorf("unexpected error %v", err)
 	}
 }
 
-func TestExpandSavedSearches_Success(t *testing.T) {
-	customID := "h-custom"
+func TestCreatePersonalAccessToken(t *testing.T) {
+	createdAt := time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
 	testCases := []struct {
-		name               string
-		id                 string
-		globalSearches     map[string]*gcpspanner.SystemGlobalSavedSearchWithSortOption
-		expectedNode       *searchtypes.SearchNode
-		expectedSortTarget *string
+		name          string
+		createResult  *gcpspanner.PersonalAccessToken
+		createError   error
+		expectedToken *backend.CreatedPersonalAccessToken
+		expectedErr   error
 	}{
-		// This test case verifies that 'hotlist:all' expands to an empty query AST.
-		// This AST is used directly in TestFeaturesSearch_HotlistAll in lib/gcpspanner/hotlist_subquery_test.go.
-		// If this behavior changes, update both tests!
 		{
-			name: "empty query returns special node",
-			id:   "all",
-			globalSearches: map[string]*gcpspanner.SystemGlobalSavedSearchWithSortOption{
-				"all": newMockSystemGlobalSavedSearchWithSortOption("all", "", false),
+			name: "success",
+			createResult: &gcpspanner.PersonalAccessToken{
+				ID:         "token-id",
+				UserID:     "user",
+				Name:       "ci",
+				Scope:      gcpspanner.PersonalAccessTokenScopeReadOnly,
+				CreatedAt:  createdAt,
+				LastUsedAt: nil,
+			},
+			createError: nil,
+			expectedToken: &backend.CreatedPersonalAccessToken{
+				Id:         "token-id",
+				Name:       "ci",
+				Scope:      backend.PersonalAccessTokenScopeReadOnly,
+				CreatedAt:  createdAt,
+				LastUsedAt: nil,
+				// Token is random and checked separately.
+				Token: "",
 			},
-			expectedNode:       searchtypes.EmptySearchNode(),
-			expectedSortTarget: nil,
+			expectedErr: nil,
 		},
 		{
-			name: "hotlist with custom sort order and empty query",
-			id:   "h-custom",
-			globalSearches: map[string]*gcpspanner.SystemGlobalSavedSearchWithSortOption{
-				"h-custom": newMockSystemGlobalSavedSearchWithSortOption("h-custom", "", true),
-			},
-			expectedNode:       searchtypes.EmptySearchNode(),
-			expectedSortTarget: &customID,
+			name:          "limit exceeded",
+			createResult:  nil,
+			createError:   gcpspanner.ErrPersonalAccessTokenLimitExceeded,
+			expectedToken: nil,
+			expectedErr:   backendtypes.ErrUserMaxPersonalAccessTokens,
 		},
 	}
-
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			//nolint: exhaustruct
 			mock := mockBackendSpannerClient{
 				t: t,
-				mockGetSystemGlobalSavedSearchCfg: &mockGetSystemGlobalSavedSearchConfig{
-					results: tc.globalSearches,
-				},
-			}
-			backend := NewBackend(mock)
-			node := &searchtypes.SearchNode{
-				Keyword: searchtypes.KeywordNone,
-				Term: &searchtypes.SearchTerm{
-					Identifier: searchtypes.IdentifierHotlist,
-					Operator:   searchtypes.OperatorNone,
-					Value:      tc.id,
+				mockPersonalAccessTokensCfg: &mockPersonalAccessTokensConfig{
+					expectedCreateRequest: gcpspanner.CreatePersonalAccessTokenRequest{
+						UserID:    "user",
+						Name:      "ci",
+						Scope:     gcpspanner.PersonalAccessTokenScopeReadOnly,
+						TokenHash: "",
+					},
+					createResult: tc.createResult,
+					createError:  tc.createError,
 				},
-				Children: nil,
 			}
-
-			expanded, sortTgt, err := backend.expandSavedSearches(context.Background(), node, 0, map[string]struct{}{})
-			if err != nil {
-				t.Fatalf("unexpected error: %v", err)
+			bk := NewBackend(mock)
+			token, err := bk.CreatePersonalAccessToken(context.Background(), "user",
+				backend.CreatePersonalAccessTokenRequest{Name: "ci", Scope: backend.PersonalAccessTokenScopeReadOnly})
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("unexpected error %v", err)
 			}
-			if !reflect.DeepEqual(expanded, tc.expectedNode) {
-				t.Errorf("expected node %+v, got %+v", tc.expectedNode, expanded)
+			if token != nil {
+				if !strings.HasPrefix(token.Token, auth.PersonalAccessTokenPrefix) {
+					t.Errorf("unexpected token %q", token.Token)
+				}
+				token.Token = ""
 			}
-			if !reflect.DeepEqual(sortTgt, tc.expectedSortTarget) {
-				t.Errorf("expected sort target %+v, got %+v", tc.expectedSortTarget, sortTgt)
+			if !reflect.DeepEqual(token, tc.expectedToken) {
+				t.Errorf("unexpected token\nexpected: %+v\nreceived: %+v", tc.expectedToken, token)
 			}
 		})
 	}
 }
 
-func TestExpandSavedSearches_Error(t *testing.T) {
+func TestListPersonalAccessTokens(t *testing.T) {
+	createdAt := time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
+	lastUsedAt := time.Date(2026, time.October, 2, 0, 0, 0, 0, time.UTC)
+	//nolint: exhaustruct
+	mock := mockBackendSpannerClient{
+		t: t,
+		mockPersonalAccessTokensCfg: &mockPersonalAccessTokensConfig{
+			expectedUserID: "user",
+			listResult: []gcpspanner.PersonalAccessToken{
+				{
+					ID:         "token-id",
+					UserID:     "user",
+					Name:       "ci",
+					Scope:      gcpspanner.PersonalAccessTokenScopeManageSubscriptions,
+					CreatedAt:  createdAt,
+					LastUsedAt: &lastUsedAt,
+				},
+			},
+			listError: nil,
+		},
+	}
+	bk := NewBackend(mock)
+	page, err := bk.ListPersonalAccessTokens(context.Background(), "user")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	expected := &backend.PersonalAccessTokenPage{
+		Data: []backend.PersonalAccessToken{
+			{
+				Id:         "token-id",
+				Name:       "ci",
+				Scope:      backend.PersonalAccessTokenScopeManageSubscriptions,
+				CreatedAt:  createdAt,
+				LastUsedAt: &lastUsedAt,
+			},
+		},
+	}
+	if !reflect.DeepEqual(page, expected) {
+		t.Errorf("unexpected page\nexpected: %+v\nreceived: %+v", expected, page)
+	}
+}
+
+func TestRevokePersonalAccessToken(t *testing.T) {
 	testCases := []struct {
-		name           string
-		id             string
-		globalSearches map[string]*gcpspanner.SystemGlobalSavedSearchWithSortOption
-		expectedError  error
+		name        string
+		deleteError error
+		expectedErr error
 	}{
 		{
-			name: "max depth exceeded",
-			id:   "h1",
-			globalSearches: map[string]*gcpspanner.SystemGlobalSavedSearchWithSortOption{
-				"h1": newMockSystemGlobalSavedSearchWithSortOption("h1", "hotlist:h2", false),
-				"h2": newMockSystemGlobalSavedSearchWithSortOption("h2", "hotlist:h3", false),
-				"h3": newMockSystemGlobalSavedSearchWithSortOption("h3", "feat1", false),
-			},
-			expectedError: backendtypes.ErrSavedSearchMaxDepthExceeded,
+			name:        "success",
+			deleteError: nil,
+			expectedErr: nil,
 		},
 		{
-			name:           "hotlist not found",
-			id:             "nonexistent",
-			globalSearches: map[string]*gcpspanner.SystemGlobalSavedSearchWithSortOption{},
-			expectedError:  backendtypes.ErrHotlistNotFound,
+			name:        "not found",
+			deleteError: gcpspanner.ErrPersonalAccessTokenNotFound,
+			expectedErr: backendtypes.ErrEntityDoesNotExist,
 		},
 	}
-
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			//nolint: exhaustruct
 			mock := mockBackendSpannerClient{
 				t: t,
-				mockGetSystemGlobalSavedSearchCfg: &mockGetSystemGlobalSavedSearchConfig{
-					results: tc.globalSearches,
-				},
-			}
-			backend := NewBackend(mock)
-			node := &searchtypes.SearchNode{
-				Keyword: searchtypes.KeywordNone,
-				Term: &searchtypes.SearchTerm{
-					Identifier: searchtypes.IdentifierHotlist,
-					Operator:   searchtypes.OperatorNone,
-					Value:      tc.id,
+				mockPersonalAccessTokensCfg: &mockPersonalAccessTokensConfig{
+					expectedUserID:  "user",
+					expectedTokenID: "token-id",
+					deleteError:     tc.deleteError,
 				},
-				Children: nil,
 			}
-
-			_, _, err := backend.expandSavedSearches(context.Background(), node, 0, map[string]struct{}{})
-			if !errors.Is(err, tc.expectedError) {
-				t.Errorf("expected error %v, got %v", tc.expectedError, err)
+			bk := NewBackend(mock)
+			err := bk.RevokePersonalAccessToken(context.Background(), "user", "token-id")
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("unexpected error %v", err)
 			}
 		})
 	}
 }
-
-func TestListSavedSearchNotificationEvents(t *testing.T) {
-	ctx := context.Background()
-	//nolint:exhaustruct
-	mockClient := &mockBackendSpannerClient{t: t}
-	backendObj := &Backend{client: mockClient}
-
-	// 1. Verify OpenAPI Immediate frequency translates to Spanner snapshot type.
-	mockClient.mockListSavedSearchNotificationEventsCfg = &mockListSavedSearchNotificationEventsConfig{
-		expectedSavedSearchID: "search-id",
-		expectedSnapshotType:  string(gcpspanner.SavedSearchSnapshotTypeImmediate),
-		expectedPageSize:      10,
-		expectedPageToken:     nil,
-		result:                []gcpspanner.SavedSearchNotificationEvent{},
-		outputNextPageToken:   nil,
-		returnedError:         nil,
-	}
-
-	_, _, err := backendObj.ListSavedSearchNotificationEvents(
-		ctx,
-		"search-id",
-		backend.SubscriptionFrequencyImmediate,
-		10,
-		nil,
-	)
-	if err != nil {
-		t.Errorf("expected no error, got %v", err)
-	}
-}
@@ -41,10 +41,15 @@ type BCDConsumer struct {
 
 func (b *BCDConsumer) InsertBrowserReleases(ctx context.Context, releases []bcdconsumertypes.BrowserRelease) error {
 	for _, release := range releases {
+		var releaseStatus *string
+		if release.ReleaseStatus != "" {
+			releaseStatus = &release.ReleaseStatus
+		}
 		err := b.client.InsertBrowserRelease(ctx, gcpspanner.BrowserRelease{
 			BrowserName:    string(release.BrowserName),
 			BrowserVersion: release.BrowserVersion,
 			ReleaseDate:    release.ReleaseDate,
+			ReleaseStatus:  releaseStatus,
 		})
 		if err != nil {
 			return errors.Join(bcdconsumertypes.ErrUnableToStoreBrowserRelease, err)
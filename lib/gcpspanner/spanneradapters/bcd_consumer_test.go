@@ -34,6 +34,7 @@ func getFailureRelease() bcdconsumertypes.BrowserRelease {
 		BrowserName:    "failureBrowser",
 		BrowserVersion: "failureVersion",
 		ReleaseDate:    time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+		ReleaseStatus:  "",
 	}
 }
 
@@ -60,6 +61,13 @@ func TestInsertBrowserReleases(t *testing.T) {
 					BrowserName:    "Chrome",
 					BrowserVersion: "100",
 					ReleaseDate:    time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC),
+					ReleaseStatus:  "current",
+				},
+				{
+					BrowserName:    "Firefox",
+					BrowserVersion: "115",
+					ReleaseDate:    time.Date(2023, time.July, 4, 0, 0, 0, 0, time.UTC),
+					ReleaseStatus:  "",
 				},
 			},
 			expectedError: nil,
@@ -71,6 +79,7 @@ func TestInsertBrowserReleases(t *testing.T) {
 					BrowserName:    "Chrome",
 					BrowserVersion: "100",
 					ReleaseDate:    time.Date(2024, time.January, 2, 0, 0, 0, 0, time.UTC),
+					ReleaseStatus:  "current",
 				},
 				getFailureRelease(),
 			},
@@ -106,5 +115,7 @@ func TestInsertBrowserReleases(t *testing.T) {
 func compareReleases(r1 bcdconsumertypes.BrowserRelease, r2 gcpspanner.BrowserRelease) bool {
 	return string(r1.BrowserName) == r2.BrowserName &&
 		r1.BrowserVersion == r2.BrowserVersion &&
-		r1.ReleaseDate.Equal(r2.ReleaseDate)
+		r1.ReleaseDate.Equal(r2.ReleaseDate) &&
+		(r1.ReleaseStatus == "" && r2.ReleaseStatus == nil ||
+			r2.ReleaseStatus != nil && r1.ReleaseStatus == *r2.ReleaseStatus)
 }
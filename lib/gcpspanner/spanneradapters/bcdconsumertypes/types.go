@@ -25,6 +25,8 @@ type BrowserRelease struct {
 	BrowserName    BrowserName
 	BrowserVersion string
 	ReleaseDate    time.Time
+	// ReleaseStatus is the BCD status of the release, such as current or esr.
+	ReleaseStatus string
 }
 
 // BrowserName is an enumeration of the high-level keys found in the data.json
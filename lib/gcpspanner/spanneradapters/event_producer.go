@@ -46,6 +46,7 @@ type BackendAdapterForEventProducerDiffer interface {
 		sortOrder *backend.ListFeaturesParamsSort,
 		wptMetricView backend.WPTMetricView,
 		browsers []backend.BrowserPathParam,
+		queryParams map[string]string,
 	) (*backend.FeaturePage, error)
 }
 
@@ -109,6 +110,8 @@ func (e *EventProducerDiffer) FetchFeatures(ctx context.Context, query string) (
 			//TODO: Use helper for test type https://github.com/GoogleChrome/webstatus.dev/issues/2122
 			backend.TestCounts,
 			backendtypes.DefaultBrowsers(),
+			// The query of the search job is already bound.
+			nil,
 		)
 		if err != nil {
 			qErrs := interpretFetchFeaturesError(err)
@@ -267,14 +270,15 @@ func (e *EventProducer) PublishEvent(ctx context.Context, req workertypes.Publis
 	}
 	snapshotType := convertFrequencyToSnapshotType(req.Frequency)
 	_, err := e.client.PublishSavedSearchNotificationEvent(ctx, gcpspanner.SavedSearchNotificationCreateRequest{
-		SavedSearchID: req.SearchID,
-		SnapshotType:  snapshotType,
-		Timestamp:     req.GeneratedAt,
-		EventType:     "", // TODO: Set appropriate event type
-		Reasons:       convertWorktypeReasonsToSpanner(req.Reasons),
-		BlobPath:      req.StateBlobPath,
-		DiffBlobPath:  req.DiffBlobPath,
-		Summary:       spanner.NullJSON{Value: map[string]any{"summary": summaryObj}, Valid: req.Summary != nil},
+		SavedSearchID:  req.SearchID,
+		SnapshotType:   snapshotType,
+		Timestamp:      req.GeneratedAt,
+		EventType:      "", // TODO: Set appropriate event type
+		Reasons:        convertWorktypeReasonsToSpanner(req.Reasons),
+		BlobPath:       req.StateBlobPath,
+		DiffBlobPath:   req.DiffBlobPath,
+		Summary:        spanner.NullJSON{Value: map[string]any{"summary": summaryObj}, Valid: req.Summary != nil},
+		FeatureChanges: featureTimelineChangesFromSummary(ctx, req.EventID, req.Summary),
 	},
 		req.StateBlobPath,
 		req.EventID,
@@ -289,6 +293,52 @@ func (e *EventProducer) PublishEvent(ctx context.Context, req workertypes.Publis
 	return nil
 }
 
+// featureTimelineChangesVisitor collects the renames and moves of features from an event summary.
+type featureTimelineChangesVisitor struct {
+	changes []gcpspanner.FeatureTimelineChangeRecord
+}
+
+func (v *featureTimelineChangesVisitor) VisitV1(s workertypes.EventSummary) error {
+	for _, highlight := range s.Highlights {
+		switch {
+		case highlight.Moved != nil:
+			v.changes = append(v.changes, gcpspanner.FeatureTimelineChangeRecord{
+				FeatureKey:    highlight.FeatureID,
+				OldName:       nil,
+				NewName:       nil,
+				OldFeatureKey: &highlight.Moved.From.ID,
+			})
+		case highlight.NameChange != nil:
+			v.changes = append(v.changes, gcpspanner.FeatureTimelineChangeRecord{
+				FeatureKey:    highlight.FeatureID,
+				OldName:       &highlight.NameChange.From,
+				NewName:       &highlight.NameChange.To,
+				OldFeatureKey: nil,
+			})
+		}
+	}
+
+	return nil
+}
+
+// featureTimelineChangesFromSummary returns the renames and moves of features reported by the event summary.
+// A summary that cannot be parsed has no changes. The event is still published.
+func featureTimelineChangesFromSummary(
+	ctx context.Context, eventID string, summary []byte) []gcpspanner.FeatureTimelineChangeRecord {
+	if summary == nil {
+		return nil
+	}
+	visitor := new(featureTimelineChangesVisitor)
+	if err := workertypes.ParseEventSummary(summary, visitor); err != nil {
+		slog.WarnContext(ctx, "unable to read the feature changes of the event summary", "error", err,
+			"eventID", eventID)
+
+		return nil
+	}
+
+	return visitor.changes
+}
+
 type BatchEventProducerSpannerClient interface {
 	ListAllSavedSearches(
 		ctx context.Context) ([]gcpspanner.SavedSearchBriefDetails, error)
@@ -310,7 +360,14 @@ func (b *BatchEventProducer) ListAllSavedSearches(ctx context.Context) ([]worker
 
 	jobs := make([]workertypes.SearchJob, 0, len(details))
 	for _, detail := range details {
-		jobs = append(jobs, workertypes.SearchJob{ID: detail.ID, Name: detail.Name, Query: detail.Query})
+		// Saved searches with parameters are refreshed with the default values of the parameters.
+		query, err := gcpspanner.BindSavedSearchQuery(detail.Query, detail.QueryParameters, nil)
+		if err != nil {
+			// Keep the query as is. The differ reports it as an invalid query.
+			slog.WarnContext(ctx, "unable to bind saved search query", "id", detail.ID, "error", err)
+			query = detail.Query
+		}
+		jobs = append(jobs, workertypes.SearchJob{ID: detail.ID, Name: detail.Name, Query: query})
 	}
 
 	return jobs, nil
@@ -16,6 +16,7 @@ package spanneradapters
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -251,6 +252,15 @@ func TestEventProducer_ReleaseLock(t *testing.T) {
 func TestEventProducer_PublishEvent(t *testing.T) {
 	generatedAt := time.Now()
 	summaryJSON := `{"added": 1, "removed": 2}`
+	featureChangesSummaryJSON := `{"schemaVersion": "v1", "highlights": [
+		{"type": "Changed", "feature_id": "grid", "name_change": {"from": "CSS Grid", "to": "Grid"}},
+		{"type": "Added", "feature_id": "subgrid"},
+		{"type": "Moved", "feature_id": "grid", "moved": {"from": {"id": "css-grid"}, "to": {"id": "grid"}}}
+	]}`
+	var featureChangesSummary any
+	if err := json.Unmarshal([]byte(featureChangesSummaryJSON), &featureChangesSummary); err != nil {
+		t.Fatal(err)
+	}
 
 	// Defined named struct for better type safety and comparison in tests
 	type expectedRequest struct {
@@ -303,6 +313,7 @@ func TestEventProducer_PublishEvent(t *testing.T) {
 							"removed": float64(2),
 						},
 					}, Valid: true},
+					FeatureChanges: nil,
 				},
 				NewStatePath: "gs://bucket/state",
 				WorkerID:     "event-1",
@@ -343,6 +354,48 @@ func TestEventProducer_PublishEvent(t *testing.T) {
 							"removed": float64(2),
 						},
 					}, Valid: true},
+					FeatureChanges: nil,
+				},
+				NewStatePath: "gs://bucket/state",
+				WorkerID:     "event-1",
+			},
+		},
+		{
+			name: "summary with renamed and moved features",
+			req: workertypes.PublishEventRequest{
+				EventID:       "event-1",
+				SearchID:      "search-1",
+				SearchName:    "Search 1",
+				StateID:       "state-1",
+				StateBlobPath: "gs://bucket/state",
+				DiffID:        "diff-1",
+				DiffBlobPath:  "gs://bucket/diff",
+				Summary:       []byte(featureChangesSummaryJSON),
+				Reasons:       []workertypes.Reason{workertypes.ReasonDataUpdated},
+				Frequency:     workertypes.FrequencyWeekly,
+				Query:         "query",
+				GeneratedAt:   generatedAt,
+			},
+			mockPublishErr:  nil,
+			mockPublishResp: new("new-event-id"),
+			wantErr:         false,
+			expectCall:      true,
+			expectedReq: &expectedRequest{
+				Event: gcpspanner.SavedSearchNotificationCreateRequest{
+					SavedSearchID: "search-1",
+					SnapshotType:  gcpspanner.SavedSearchSnapshotTypeWeekly,
+					Timestamp:     generatedAt,
+					EventType:     "",
+					Reasons:       []string{"DATA_UPDATED"},
+					BlobPath:      "gs://bucket/state",
+					DiffBlobPath:  "gs://bucket/diff",
+					Summary: spanner.NullJSON{Value: map[string]any{
+						"summary": featureChangesSummary,
+					}, Valid: true},
+					FeatureChanges: []gcpspanner.FeatureTimelineChangeRecord{
+						{FeatureKey: "grid", OldName: new("CSS Grid"), NewName: new("Grid"), OldFeatureKey: nil},
+						{FeatureKey: "grid", OldName: nil, NewName: nil, OldFeatureKey: new("css-grid")},
+					},
 				},
 				NewStatePath: "gs://bucket/state",
 				WorkerID:     "event-1",
@@ -533,6 +586,7 @@ func (m *mockBackendAdapterForEventProducer) FeaturesSearch(
 	_ *backend.ListFeaturesParamsSort,
 	_ backend.WPTMetricView,
 	_ []backend.BrowserPathParam,
+	_ map[string]string,
 ) (*backend.FeaturePage, error) {
 	m.featuresSearchCalled = true
 	m.FeaturesSearchReqs = append(m.FeaturesSearchReqs, struct {
@@ -657,6 +711,7 @@ func TestEventProducerDiffer_FetchFeatures_Success(t *testing.T) {
 					Data: []backend.Feature{
 						newTestBackendFeature("f1", "Feature 1"),
 					},
+					Facets: nil,
 					Metadata: backend.PageMetadataWithTotal{
 						Total:         1000,
 						NextPageToken: new("token-1"),
@@ -666,6 +721,7 @@ func TestEventProducerDiffer_FetchFeatures_Success(t *testing.T) {
 					Data: []backend.Feature{
 						newTestBackendFeature("f2", "Feature 2"),
 					},
+					Facets: nil,
 					Metadata: backend.PageMetadataWithTotal{
 						Total:         1000,
 						NextPageToken: nil,
@@ -747,13 +803,23 @@ func TestBatchEventProducer_ListAllSavedSearches(t *testing.T) {
 		{
 			name: "success with searches",
 			mockResp: []gcpspanner.SavedSearchBriefDetails{
-				{ID: "s1", Name: "Search 1", Query: "q1"},
-				{ID: "s2", Name: "Search 2", Query: "q2"},
+				{ID: "s1", Name: "Search 1", Query: "q1", QueryParameters: spanner.NullJSON{Value: nil, Valid: false}},
+				{ID: "s2", Name: "Search 2", Query: "q2", QueryParameters: spanner.NullJSON{Value: nil, Valid: false}},
+				{
+					ID:    "s3",
+					Name:  "Search 3",
+					Query: "baseline_status:$status",
+					QueryParameters: spanner.NullJSON{
+						Value: []gcpspanner.SavedSearchQueryParameter{{Name: "status", DefaultValue: "newly"}},
+						Valid: true,
+					},
+				},
 			},
 			mockErr: nil,
 			wantSearchJobs: []workertypes.SearchJob{
 				{ID: "s1", Name: "Search 1", Query: "q1"},
 				{ID: "s2", Name: "Search 2", Query: "q2"},
+				{ID: "s3", Name: "Search 3", Query: "baseline_status:newly"},
 			},
 			wantErr: false,
 		},
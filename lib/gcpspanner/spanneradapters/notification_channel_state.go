@@ -16,7 +16,10 @@ package spanneradapters
 
 import (
 	"context"
+	"errors"
 	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner"
 )
 
 // NotificationChannelStateSpannerClient defines the Spanner methods needed for state management.
@@ -24,6 +27,12 @@ type NotificationChannelStateSpannerClient interface {
 	RecordNotificationChannelSuccess(ctx context.Context, channelID string, timestamp time.Time, eventID string) error
 	RecordNotificationChannelFailure(ctx context.Context, channelID string, errorMsg string, timestamp time.Time,
 		isPermanent bool, eventID string) error
+	RecordNotificationChannelRetriedFailure(ctx context.Context, channelID string, errorMsg string,
+		timestamp time.Time, attempt int, eventID string) error
+	ClaimNotificationDeliveryKey(ctx context.Context, channelID string, deliveryKey string,
+		timestamp time.Time) error
+	CompleteNotificationDeliveryKey(ctx context.Context, channelID string, deliveryKey string,
+		timestamp time.Time) error
 }
 
 // NotificationChannelStateManager provides methods to record delivery outcomes.
@@ -52,3 +61,36 @@ func (s *NotificationChannelStateManager) RecordFailure(ctx context.Context, cha
 
 	return s.client.RecordNotificationChannelFailure(ctx, channelID, msg, timestamp, isPermanent, eventID)
 }
+
+// RecordRetriedFailure records a failed delivery attempt that is going to be retried.
+// It does not count toward the channel's consecutive failures.
+func (s *NotificationChannelStateManager) RecordRetriedFailure(ctx context.Context, channelID string, err error,
+	timestamp time.Time, attempt int, eventID string) error {
+	msg := ""
+	if err != nil {
+		msg = err.Error()
+	}
+
+	return s.client.RecordNotificationChannelRetriedFailure(ctx, channelID, msg, timestamp, attempt, eventID)
+}
+
+// ClaimDelivery records the delivery key before a notification is sent.
+// It returns false if a delivery with the same key already completed, in which case the
+// caller must skip the send.
+func (s *NotificationChannelStateManager) ClaimDelivery(ctx context.Context, channelID string,
+	deliveryKey string, timestamp time.Time) (bool, error) {
+	err := s.client.ClaimNotificationDeliveryKey(ctx, channelID, deliveryKey, timestamp)
+	if errors.Is(err, gcpspanner.ErrDeliveryKeyAlreadyCompleted) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CompleteDelivery marks the delivery key as completed after a successful send.
+func (s *NotificationChannelStateManager) CompleteDelivery(ctx context.Context, channelID string,
+	deliveryKey string, timestamp time.Time) error {
+	return s.client.CompleteNotificationDeliveryKey(ctx, channelID, deliveryKey, timestamp)
+}
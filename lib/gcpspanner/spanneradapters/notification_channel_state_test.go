@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -41,6 +42,30 @@ type mockChannelStateSpannerClient struct {
 		EventID     string
 	}
 	failureErr error
+
+	retriedCalled bool
+	retriedReq    struct {
+		ChannelID string
+		Msg       string
+		Timestamp time.Time
+		Attempt   int
+		EventID   string
+	}
+	retriedErr error
+
+	claimCalled bool
+	claimReq    deliveryKeyReq
+	claimErr    error
+
+	completeCalled bool
+	completeReq    deliveryKeyReq
+	completeErr    error
+}
+
+type deliveryKeyReq struct {
+	ChannelID   string
+	DeliveryKey string
+	Timestamp   time.Time
 }
 
 func (m *mockChannelStateSpannerClient) RecordNotificationChannelSuccess(
@@ -65,6 +90,34 @@ func (m *mockChannelStateSpannerClient) RecordNotificationChannelFailure(
 	return m.failureErr
 }
 
+func (m *mockChannelStateSpannerClient) RecordNotificationChannelRetriedFailure(
+	_ context.Context, channelID, errorMsg string, timestamp time.Time, attempt int, eventID string) error {
+	m.retriedCalled = true
+	m.retriedReq.ChannelID = channelID
+	m.retriedReq.Msg = errorMsg
+	m.retriedReq.Timestamp = timestamp
+	m.retriedReq.Attempt = attempt
+	m.retriedReq.EventID = eventID
+
+	return m.retriedErr
+}
+
+func (m *mockChannelStateSpannerClient) ClaimNotificationDeliveryKey(
+	_ context.Context, channelID, deliveryKey string, timestamp time.Time) error {
+	m.claimCalled = true
+	m.claimReq = deliveryKeyReq{ChannelID: channelID, DeliveryKey: deliveryKey, Timestamp: timestamp}
+
+	return m.claimErr
+}
+
+func (m *mockChannelStateSpannerClient) CompleteNotificationDeliveryKey(
+	_ context.Context, channelID, deliveryKey string, timestamp time.Time) error {
+	m.completeCalled = true
+	m.completeReq = deliveryKeyReq{ChannelID: channelID, DeliveryKey: deliveryKey, Timestamp: timestamp}
+
+	return m.completeErr
+}
+
 func TestRecordSuccess(t *testing.T) {
 	mock := new(mockChannelStateSpannerClient)
 	adapter := NewNotificationChannelStateManager(mock)
@@ -132,3 +185,109 @@ func TestRecordFailure(t *testing.T) {
 		t.Errorf("RecordNotificationChannelFailure request mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestRecordRetriedFailure(t *testing.T) {
+	mock := new(mockChannelStateSpannerClient)
+	adapter := NewNotificationChannelStateManager(mock)
+
+	ts := time.Now()
+	err := adapter.RecordRetriedFailure(context.Background(), "chan-1", errors.New("status 429"), ts, 2, "evt-3")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !mock.retriedCalled {
+		t.Error("RecordNotificationChannelRetriedFailure not called")
+	}
+	if mock.failureCalled {
+		t.Error("RecordNotificationChannelFailure should not be called for retried attempts")
+	}
+
+	expectedReq := struct {
+		ChannelID string
+		Msg       string
+		Timestamp time.Time
+		Attempt   int
+		EventID   string
+	}{
+		ChannelID: "chan-1",
+		Msg:       "status 429",
+		Timestamp: ts,
+		Attempt:   2,
+		EventID:   "evt-3",
+	}
+	if diff := cmp.Diff(expectedReq, mock.retriedReq); diff != "" {
+		t.Errorf("RecordNotificationChannelRetriedFailure request mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestClaimDelivery(t *testing.T) {
+	ts := time.Now()
+	dbErr := errors.New("db error")
+	testCases := []struct {
+		name        string
+		claimErr    error
+		wantClaimed bool
+		wantErr     error
+	}{
+		{
+			name:        "new or pending key is claimed",
+			claimErr:    nil,
+			wantClaimed: true,
+			wantErr:     nil,
+		},
+		{
+			name:        "completed key is not claimed",
+			claimErr:    gcpspanner.ErrDeliveryKeyAlreadyCompleted,
+			wantClaimed: false,
+			wantErr:     nil,
+		},
+		{
+			name:        "database error",
+			claimErr:    dbErr,
+			wantClaimed: false,
+			wantErr:     dbErr,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := new(mockChannelStateSpannerClient)
+			mock.claimErr = tc.claimErr
+			adapter := NewNotificationChannelStateManager(mock)
+
+			claimed, err := adapter.ClaimDelivery(context.Background(), "chan-1", "key-1", ts)
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("expected error %v, got %v", tc.wantErr, err)
+			}
+			if claimed != tc.wantClaimed {
+				t.Errorf("expected claimed %v, got %v", tc.wantClaimed, claimed)
+			}
+
+			expectedReq := deliveryKeyReq{ChannelID: "chan-1", DeliveryKey: "key-1", Timestamp: ts}
+			if diff := cmp.Diff(expectedReq, mock.claimReq); diff != "" {
+				t.Errorf("ClaimNotificationDeliveryKey request mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCompleteDelivery(t *testing.T) {
+	mock := new(mockChannelStateSpannerClient)
+	adapter := NewNotificationChannelStateManager(mock)
+
+	ts := time.Now()
+	err := adapter.CompleteDelivery(context.Background(), "chan-1", "key-1", ts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !mock.completeCalled {
+		t.Error("CompleteNotificationDeliveryKey not called")
+	}
+
+	expectedReq := deliveryKeyReq{ChannelID: "chan-1", DeliveryKey: "key-1", Timestamp: ts}
+	if diff := cmp.Diff(expectedReq, mock.completeReq); diff != "" {
+		t.Errorf("CompleteNotificationDeliveryKey request mismatch (-want +got):\n%s", diff)
+	}
+}
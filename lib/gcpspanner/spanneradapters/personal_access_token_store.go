@@ -0,0 +1,68 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanneradapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner"
+)
+
+// PersonalAccessTokenSpannerClient defines the Spanner methods needed to authenticate personal access tokens.
+type PersonalAccessTokenSpannerClient interface {
+	GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (*gcpspanner.PersonalAccessToken, error)
+}
+
+// PersonalAccessTokenStore looks up the owners of personal access tokens in Spanner.
+// It implements auth.PersonalAccessTokenStore.
+type PersonalAccessTokenStore struct {
+	client PersonalAccessTokenSpannerClient
+}
+
+// NewPersonalAccessTokenStore creates a new PersonalAccessTokenStore.
+func NewPersonalAccessTokenStore(client PersonalAccessTokenSpannerClient) *PersonalAccessTokenStore {
+	return &PersonalAccessTokenStore{client: client}
+}
+
+// LookupPersonalAccessToken returns the owner of the token with the given hash, with the scope of the token.
+func (s *PersonalAccessTokenStore) LookupPersonalAccessToken(
+	ctx context.Context, tokenHash string) (*auth.User, error) {
+	token, err := s.client.GetPersonalAccessTokenByHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, gcpspanner.ErrPersonalAccessTokenNotFound) {
+			return nil, errors.Join(err, auth.ErrInvalidPersonalAccessToken)
+		}
+
+		return nil, err
+	}
+
+	var scope auth.TokenScope
+	switch token.Scope {
+	case gcpspanner.PersonalAccessTokenScopeReadOnly:
+		scope = auth.TokenScopeReadOnly
+	case gcpspanner.PersonalAccessTokenScopeManageSubscriptions:
+		scope = auth.TokenScopeManageSubscriptions
+	default:
+		return nil, auth.ErrInvalidPersonalAccessToken
+	}
+
+	return &auth.User{
+		ID:           token.UserID,
+		GitHubUserID: nil,
+		TokenScope:   &scope,
+	}, nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanneradapters
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner"
+)
+
+type mockPersonalAccessTokenSpannerClient struct {
+	t                 *testing.T
+	expectedTokenHash string
+	token             *gcpspanner.PersonalAccessToken
+	err               error
+}
+
+func (m *mockPersonalAccessTokenSpannerClient) GetPersonalAccessTokenByHash(
+	_ context.Context, tokenHash string) (*gcpspanner.PersonalAccessToken, error) {
+	if tokenHash != m.expectedTokenHash {
+		m.t.Errorf("unexpected token hash %s", tokenHash)
+	}
+
+	return m.token, m.err
+}
+
+func TestPersonalAccessTokenStore_LookupPersonalAccessToken(t *testing.T) {
+	readOnly := auth.TokenScopeReadOnly
+	manageSubscriptions := auth.TokenScopeManageSubscriptions
+	testCases := []struct {
+		name         string
+		token        *gcpspanner.PersonalAccessToken
+		err          error
+		expectedUser *auth.User
+		expectedErr  error
+	}{
+		{
+			name: "read only token",
+			token: &gcpspanner.PersonalAccessToken{
+				ID:         "id",
+				UserID:     "user",
+				Name:       "ci",
+				Scope:      gcpspanner.PersonalAccessTokenScopeReadOnly,
+				CreatedAt:  time.Time{},
+				LastUsedAt: nil,
+			},
+			err:          nil,
+			expectedUser: &auth.User{ID: "user", GitHubUserID: nil, TokenScope: &readOnly},
+			expectedErr:  nil,
+		},
+		{
+			name: "manage subscriptions token",
+			token: &gcpspanner.PersonalAccessToken{
+				ID:         "id",
+				UserID:     "user",
+				Name:       "ci",
+				Scope:      gcpspanner.PersonalAccessTokenScopeManageSubscriptions,
+				CreatedAt:  time.Time{},
+				LastUsedAt: nil,
+			},
+			err:          nil,
+			expectedUser: &auth.User{ID: "user", GitHubUserID: nil, TokenScope: &manageSubscriptions},
+			expectedErr:  nil,
+		},
+		{
+			name: "unknown scope",
+			token: &gcpspanner.PersonalAccessToken{
+				ID:         "id",
+				UserID:     "user",
+				Name:       "ci",
+				Scope:      "admin",
+				CreatedAt:  time.Time{},
+				LastUsedAt: nil,
+			},
+			err:          nil,
+			expectedUser: nil,
+			expectedErr:  auth.ErrInvalidPersonalAccessToken,
+		},
+		{
+			name:         "not found",
+			token:        nil,
+			err:          gcpspanner.ErrPersonalAccessTokenNotFound,
+			expectedUser: nil,
+			expectedErr:  auth.ErrInvalidPersonalAccessToken,
+		},
+		{
+			name:         "other error",
+			token:        nil,
+			err:          errTest,
+			expectedUser: nil,
+			expectedErr:  errTest,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := NewPersonalAccessTokenStore(&mockPersonalAccessTokenSpannerClient{
+				t:                 t,
+				expectedTokenHash: "hash",
+				token:             tc.token,
+				err:               tc.err,
+			})
+			user, err := store.LookupPersonalAccessToken(context.Background(), "hash")
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("unexpected error %v", err)
+			}
+			if !reflect.DeepEqual(user, tc.expectedUser) {
+				t.Errorf("unexpected user %+v", user)
+			}
+		})
+	}
+}
@@ -56,22 +56,24 @@ func (f *PushDeliverySubscriberFinder) FindSubscribers(ctx context.Context, sear
 		// If EmailConfig is set, it's an email subscriber.
 		if dest.EmailConfig != nil {
 			set.Emails = append(set.Emails, workertypes.EmailSubscriber{
-				SubscriptionID: dest.SubscriptionID,
-				UserID:         dest.UserID,
-				Triggers:       convertSpannerTriggersToJobTriggers(dest.Triggers),
-				EmailAddress:   dest.EmailConfig.Address,
-				ChannelID:      dest.ChannelID,
+				SubscriptionID:   dest.SubscriptionID,
+				UserID:           dest.UserID,
+				Triggers:         convertSpannerTriggersToJobTriggers(dest.Triggers),
+				EmailAddress:     dest.EmailConfig.Address,
+				ChannelID:        dest.ChannelID,
+				DeliverySettings: convertSpannerDeliverySettings(dest.DeliverySettings),
 			})
 		}
 		// If WebhookConfig is set, it's a webhook subscriber.
 		if dest.WebhookConfig != nil {
 			set.Webhooks = append(set.Webhooks, workertypes.WebhookSubscriber{
-				SubscriptionID: dest.SubscriptionID,
-				UserID:         dest.UserID,
-				Triggers:       convertSpannerTriggersToJobTriggers(dest.Triggers),
-				WebhookURL:     dest.WebhookConfig.URL,
-				WebhookType:    workertypes.WebhookTypeSlack,
-				ChannelID:      dest.ChannelID,
+				SubscriptionID:   dest.SubscriptionID,
+				UserID:           dest.UserID,
+				Triggers:         convertSpannerTriggersToJobTriggers(dest.Triggers),
+				WebhookURL:       dest.WebhookConfig.URL,
+				WebhookType:      workertypes.WebhookTypeSlack,
+				ChannelID:        dest.ChannelID,
+				DeliverySettings: convertSpannerDeliverySettings(dest.DeliverySettings),
 			})
 		}
 	}
@@ -79,6 +81,26 @@ func (f *PushDeliverySubscriberFinder) FindSubscribers(ctx context.Context, sear
 	return set, nil
 }
 
+func convertSpannerDeliverySettings(
+	settings *gcpspanner.NotificationChannelDeliverySettings) *workertypes.ChannelDeliverySettings {
+	if settings == nil {
+		return nil
+	}
+	ret := &workertypes.ChannelDeliverySettings{
+		QuietHours:           nil,
+		MaxDeliveriesPerHour: settings.MaxDeliveriesPerHour,
+	}
+	if settings.QuietHoursStart != nil && settings.QuietHoursEnd != nil && settings.QuietHoursTimeZone != nil {
+		ret.QuietHours = &workertypes.QuietHours{
+			Start:    *settings.QuietHoursStart,
+			End:      *settings.QuietHoursEnd,
+			TimeZone: *settings.QuietHoursTimeZone,
+		}
+	}
+
+	return ret
+}
+
 func convertSpannerTriggersToJobTriggers(triggers []gcpspanner.SubscriptionTrigger) []workertypes.JobTrigger {
 	if triggers == nil {
 		return nil
@@ -0,0 +1,391 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanneradapters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner"
+	"github.com/GoogleChrome/webstatus.dev/lib/workertypes"
+)
+
+type PushDeliveryLimiterSpannerClient interface {
+	ReserveNotificationChannelDelivery(
+		ctx context.Context, channelID string, deliveryKey string, windowStart time.Time, limit int64) (bool, error)
+	HoldNotificationChannelDelivery(
+		ctx context.Context,
+		channelID string,
+		subscriptionID string,
+		job []byte,
+		releaseAt time.Time,
+		coalesce func(held, incoming []byte) ([]byte, bool, error),
+	) error
+	GetNotificationChannelHeldDelivery(ctx context.Context, channelID string,
+		subscriptionID string) (*gcpspanner.NotificationChannelHeldDelivery, error)
+	ClaimDueNotificationChannelHeldDeliveries(ctx context.Context, now time.Time, limit int,
+		leaseDuration time.Duration) ([]gcpspanner.NotificationChannelHeldDelivery, error)
+	CompleteNotificationChannelHeldDelivery(
+		ctx context.Context,
+		channelID string,
+		subscriptionID string,
+		leaseID string,
+		prune func(held []byte) ([]byte, int64, error),
+	) error
+	RescheduleNotificationChannelHeldDelivery(
+		ctx context.Context, channelID string, subscriptionID string, leaseID string, releaseAt time.Time) error
+	GetNotificationChannelDeliverySettingsByChannelID(
+		ctx context.Context, channelID string) (*gcpspanner.NotificationChannelDeliverySettings, error)
+}
+
+// PushDeliveryLimiter persists the state the push delivery dispatcher needs to enforce the
+// delivery settings of channels.
+type PushDeliveryLimiter struct {
+	client PushDeliveryLimiterSpannerClient
+}
+
+func NewPushDeliveryLimiter(client PushDeliveryLimiterSpannerClient) *PushDeliveryLimiter {
+	return &PushDeliveryLimiter{client: client}
+}
+
+var (
+	errInvalidHeldJob = errors.New("held delivery job must be either an email or a webhook job")
+	errEmptyHeldJob   = errors.New("held delivery job has no events")
+)
+
+// heldJobKind identifies the type of a serialized held delivery job.
+type heldJobKind string
+
+const (
+	heldJobKindEmail   heldJobKind = "email"
+	heldJobKindWebhook heldJobKind = "webhook"
+)
+
+// heldJob is the serialized form of a held delivery job stored in Spanner.
+type heldJob struct {
+	Kind           heldJobKind `json:"kind"`
+	SubscriptionID string      `json:"subscriptionId"`
+	ChannelID      string      `json:"channelId"`
+	RecipientEmail string      `json:"recipientEmail,omitempty"`
+	WebhookURL     string      `json:"webhookUrl,omitempty"`
+	WebhookType    string      `json:"webhookType,omitempty"`
+	Triggers       []string    `json:"triggers"`
+	// Events are the events coalesced into the job, oldest first. They are kept apart so that an event is
+	// only coalesced once and so that the delivered events can be removed from the job.
+	Events []heldJobEvent `json:"events"`
+}
+
+type heldJobEvent struct {
+	Summary  json.RawMessage `json:"summary"`
+	Metadata heldJobMetadata `json:"metadata"`
+}
+
+type heldJobMetadata struct {
+	EventID     string    `json:"eventId"`
+	SearchID    string    `json:"searchId"`
+	SearchName  string    `json:"searchName"`
+	Query       string    `json:"query"`
+	Frequency   string    `json:"frequency"`
+	GeneratedAt time.Time `json:"generatedAt"`
+}
+
+func newHeldJobMetadata(m workertypes.DeliveryMetadata) heldJobMetadata {
+	return heldJobMetadata{
+		EventID:     m.EventID,
+		SearchID:    m.SearchID,
+		SearchName:  m.SearchName,
+		Query:       m.Query,
+		Frequency:   string(m.Frequency),
+		GeneratedAt: m.GeneratedAt,
+	}
+}
+
+func (m heldJobMetadata) toWorkerTypes() workertypes.DeliveryMetadata {
+	return workertypes.DeliveryMetadata{
+		EventID:     m.EventID,
+		SearchID:    m.SearchID,
+		SearchName:  m.SearchName,
+		Query:       m.Query,
+		Frequency:   workertypes.JobFrequency(m.Frequency),
+		GeneratedAt: m.GeneratedAt,
+	}
+}
+
+func (j heldJob) hasEvent(eventID string) bool {
+	return slices.ContainsFunc(j.Events, func(e heldJobEvent) bool { return e.Metadata.EventID == eventID })
+}
+
+func (j heldJob) eventIDs() []string {
+	ret := make([]string, 0, len(j.Events))
+	for _, e := range j.Events {
+		ret = append(ret, e.Metadata.EventID)
+	}
+
+	return ret
+}
+
+// summary merges the summaries of all the events of the job.
+func (j heldJob) summary() (json.RawMessage, error) {
+	var ret json.RawMessage
+	for i, e := range j.Events {
+		if i == 0 {
+			ret = e.Summary
+
+			continue
+		}
+		merged, err := workertypes.CoalesceEventSummaries(ret, e.Summary)
+		if err != nil {
+			return nil, err
+		}
+		ret = merged
+	}
+
+	return ret, nil
+}
+
+func triggersToStrings(triggers []workertypes.JobTrigger) []string {
+	ret := make([]string, 0, len(triggers))
+	for _, t := range triggers {
+		ret = append(ret, string(t))
+	}
+
+	return ret
+}
+
+func stringsToTriggers(triggers []string) []workertypes.JobTrigger {
+	ret := make([]workertypes.JobTrigger, 0, len(triggers))
+	for _, t := range triggers {
+		ret = append(ret, workertypes.JobTrigger(t))
+	}
+
+	return ret
+}
+
+// coalesceHeldJobs keeps the recipient of the newest job and adds the events that are not held yet.
+// It returns false if every event of the incoming job is already held.
+func coalesceHeldJobs(held, incoming []byte) ([]byte, bool, error) {
+	var older, newer heldJob
+	if err := json.Unmarshal(held, &older); err != nil {
+		return nil, false, fmt.Errorf("unable to parse held job: %w", err)
+	}
+	if err := json.Unmarshal(incoming, &newer); err != nil {
+		return nil, false, fmt.Errorf("unable to parse incoming job: %w", err)
+	}
+	events := older.Events
+	for _, e := range newer.Events {
+		if !older.hasEvent(e.Metadata.EventID) {
+			events = append(events, e)
+		}
+	}
+	if len(events) == len(older.Events) {
+		return held, false, nil
+	}
+	newer.Events = events
+	b, err := json.Marshal(newer)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return b, true, nil
+}
+
+// pruneHeldJob returns a function that removes the given events from a held job.
+func pruneHeldJob(eventIDs []string) func(held []byte) ([]byte, int64, error) {
+	return func(held []byte) ([]byte, int64, error) {
+		var job heldJob
+		if err := json.Unmarshal(held, &job); err != nil {
+			return nil, 0, fmt.Errorf("unable to parse held job: %w", err)
+		}
+		job.Events = slices.DeleteFunc(job.Events, func(e heldJobEvent) bool {
+			return slices.Contains(eventIDs, e.Metadata.EventID)
+		})
+		b, err := json.Marshal(job)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return b, int64(len(job.Events)), nil
+	}
+}
+
+// ReserveDelivery counts a delivery against the hourly limit of a channel.
+// A delivery is only counted once per window, no matter how often it is reserved.
+func (l *PushDeliveryLimiter) ReserveDelivery(
+	ctx context.Context, channelID string, deliveryKey string, windowStart time.Time, limit int64) (bool, error) {
+	return l.client.ReserveNotificationChannelDelivery(ctx, channelID, deliveryKey, windowStart, limit)
+}
+
+// GetChannelDeliverySettings returns the current delivery settings of a channel, or nil if it has none.
+func (l *PushDeliveryLimiter) GetChannelDeliverySettings(
+	ctx context.Context, channelID string) (*workertypes.ChannelDeliverySettings, error) {
+	settings, err := l.client.GetNotificationChannelDeliverySettingsByChannelID(ctx, channelID)
+	if errors.Is(err, gcpspanner.ErrQueryReturnedNoResults) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return convertSpannerDeliverySettings(settings), nil
+}
+
+// IsEventHeld returns true if the event is already held for the subscription.
+func (l *PushDeliveryLimiter) IsEventHeld(
+	ctx context.Context, channelID string, subscriptionID string, eventID string) (bool, error) {
+	row, err := l.client.GetNotificationChannelHeldDelivery(ctx, channelID, subscriptionID)
+	if errors.Is(err, gcpspanner.ErrQueryReturnedNoResults) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	var job heldJob
+	if err := json.Unmarshal(row.Job, &job); err != nil {
+		return false, fmt.Errorf("unable to parse held job for subscription %s: %w", subscriptionID, err)
+	}
+
+	return job.hasEvent(eventID), nil
+}
+
+// HoldEmailJob stores an email job until releaseAt, coalescing it with a job already held for the subscription.
+func (l *PushDeliveryLimiter) HoldEmailJob(
+	ctx context.Context, job workertypes.EmailDeliveryJob, releaseAt time.Time) error {
+	return l.hold(ctx, heldJob{
+		Kind:           heldJobKindEmail,
+		SubscriptionID: job.SubscriptionID,
+		ChannelID:      job.ChannelID,
+		RecipientEmail: job.RecipientEmail,
+		WebhookURL:     "",
+		WebhookType:    "",
+		Triggers:       triggersToStrings(job.Triggers),
+		Events:         []heldJobEvent{{Summary: job.SummaryRaw, Metadata: newHeldJobMetadata(job.Metadata)}},
+	}, releaseAt)
+}
+
+// HoldWebhookJob stores a webhook job until releaseAt, coalescing it with a job already held for the subscription.
+func (l *PushDeliveryLimiter) HoldWebhookJob(
+	ctx context.Context, job workertypes.WebhookDeliveryJob, releaseAt time.Time) error {
+	return l.hold(ctx, heldJob{
+		Kind:           heldJobKindWebhook,
+		SubscriptionID: job.SubscriptionID,
+		ChannelID:      job.ChannelID,
+		RecipientEmail: "",
+		WebhookURL:     job.WebhookURL,
+		WebhookType:    string(job.WebhookType),
+		Triggers:       triggersToStrings(job.Triggers),
+		Events:         []heldJobEvent{{Summary: job.SummaryRaw, Metadata: newHeldJobMetadata(job.Metadata)}},
+	}, releaseAt)
+}
+
+func (l *PushDeliveryLimiter) hold(ctx context.Context, job heldJob, releaseAt time.Time) error {
+	b, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("unable to serialize held job: %w", err)
+	}
+
+	return l.client.HoldNotificationChannelDelivery(ctx, job.ChannelID, job.SubscriptionID, b, releaseAt,
+		coalesceHeldJobs)
+}
+
+// ClaimDueDeliveries leases and returns up to limit held jobs whose release time is at or before now.
+// The jobs stay held until they are completed or rescheduled, or until the lease expires.
+func (l *PushDeliveryLimiter) ClaimDueDeliveries(ctx context.Context, now time.Time, limit int,
+	leaseDuration time.Duration) ([]workertypes.HeldDeliveryJob, error) {
+	rows, err := l.client.ClaimDueNotificationChannelHeldDeliveries(ctx, now, limit, leaseDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]workertypes.HeldDeliveryJob, 0, len(rows))
+	for _, row := range rows {
+		held, err := newHeldDeliveryJob(row)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse held job for subscription %s: %w", row.SubscriptionID, err)
+		}
+		ret = append(ret, *held)
+	}
+
+	return ret, nil
+}
+
+func newHeldDeliveryJob(row gcpspanner.NotificationChannelHeldDelivery) (*workertypes.HeldDeliveryJob, error) {
+	var job heldJob
+	if err := json.Unmarshal(row.Job, &job); err != nil {
+		return nil, err
+	}
+	if len(job.Events) == 0 {
+		return nil, errEmptyHeldJob
+	}
+	summary, err := job.summary()
+	if err != nil {
+		return nil, err
+	}
+	// The newest event identifies the coalesced delivery.
+	metadata := job.Events[len(job.Events)-1].Metadata.toWorkerTypes()
+
+	held := &workertypes.HeldDeliveryJob{
+		Email:     nil,
+		Webhook:   nil,
+		HeldCount: row.HeldCount,
+		LeaseID:   "",
+		EventIDs:  job.eventIDs(),
+	}
+	if row.LeaseID != nil {
+		held.LeaseID = *row.LeaseID
+	}
+	switch job.Kind {
+	case heldJobKindEmail:
+		held.Email = &workertypes.EmailDeliveryJob{
+			SubscriptionID: job.SubscriptionID,
+			RecipientEmail: job.RecipientEmail,
+			ChannelID:      job.ChannelID,
+			Triggers:       stringsToTriggers(job.Triggers),
+			SummaryRaw:     summary,
+			Metadata:       metadata,
+		}
+	case heldJobKindWebhook:
+		held.Webhook = &workertypes.WebhookDeliveryJob{
+			SubscriptionID: job.SubscriptionID,
+			WebhookURL:     job.WebhookURL,
+			WebhookType:    workertypes.WebhookType(job.WebhookType),
+			ChannelID:      job.ChannelID,
+			Triggers:       stringsToTriggers(job.Triggers),
+			SummaryRaw:     summary,
+			Metadata:       metadata,
+		}
+	default:
+		return nil, fmt.Errorf("%w: %q", errInvalidHeldJob, job.Kind)
+	}
+
+	return held, nil
+}
+
+// CompleteHeldDelivery removes the delivered events of a claimed job. Events that were held while the job
+// was claimed stay held.
+func (l *PushDeliveryLimiter) CompleteHeldDelivery(ctx context.Context, job workertypes.HeldDeliveryJob) error {
+	return l.client.CompleteNotificationChannelHeldDelivery(ctx, job.ChannelID(), job.SubscriptionID(), job.LeaseID,
+		pruneHeldJob(job.EventIDs))
+}
+
+// RescheduleHeldDelivery releases the claim of a job that was not delivered and keeps it held until releaseAt.
+func (l *PushDeliveryLimiter) RescheduleHeldDelivery(
+	ctx context.Context, job workertypes.HeldDeliveryJob, releaseAt time.Time) error {
+	return l.client.RescheduleNotificationChannelHeldDelivery(ctx, job.ChannelID(), job.SubscriptionID(),
+		job.LeaseID, releaseAt)
+}
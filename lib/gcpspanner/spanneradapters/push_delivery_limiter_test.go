@@ -0,0 +1,367 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spanneradapters
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner"
+	"github.com/GoogleChrome/webstatus.dev/lib/workertypes"
+	"github.com/google/go-cmp/cmp"
+)
+
+// fakeHeldDeliveryClient keeps the held deliveries in memory and coalesces them like the Spanner client.
+type fakeHeldDeliveryClient struct {
+	rows     map[string]gcpspanner.NotificationChannelHeldDelivery
+	reserve  func(channelID, deliveryKey string, windowStart time.Time, limit int64) (bool, error)
+	settings map[string]*gcpspanner.NotificationChannelDeliverySettings
+}
+
+func (f *fakeHeldDeliveryClient) ReserveNotificationChannelDelivery(
+	_ context.Context, channelID string, deliveryKey string, windowStart time.Time, limit int64) (bool, error) {
+	return f.reserve(channelID, deliveryKey, windowStart, limit)
+}
+
+func (f *fakeHeldDeliveryClient) HoldNotificationChannelDelivery(
+	_ context.Context,
+	channelID string,
+	subscriptionID string,
+	job []byte,
+	releaseAt time.Time,
+	coalesce func(held, incoming []byte) ([]byte, bool, error),
+) error {
+	key := channelID + "/" + subscriptionID
+	row, found := f.rows[key]
+	if !found {
+		f.rows[key] = gcpspanner.NotificationChannelHeldDelivery{
+			ChannelID:      channelID,
+			SubscriptionID: subscriptionID,
+			Job:            job,
+			HeldCount:      1,
+			ReleaseAt:      releaseAt,
+			LeaseID:        nil,
+			LeaseExpiresAt: nil,
+			CreatedAt:      time.Time{},
+			UpdatedAt:      time.Time{},
+		}
+
+		return nil
+	}
+	merged, added, err := coalesce(row.Job, job)
+	if err != nil || !added {
+		return err
+	}
+	row.Job = merged
+	row.HeldCount++
+	f.rows[key] = row
+
+	return nil
+}
+
+func (f *fakeHeldDeliveryClient) GetNotificationChannelHeldDelivery(
+	_ context.Context, channelID string, subscriptionID string) (*gcpspanner.NotificationChannelHeldDelivery, error) {
+	row, found := f.rows[channelID+"/"+subscriptionID]
+	if !found {
+		return nil, gcpspanner.ErrQueryReturnedNoResults
+	}
+
+	return &row, nil
+}
+
+func (f *fakeHeldDeliveryClient) ClaimDueNotificationChannelHeldDeliveries(_ context.Context, now time.Time, _ int,
+	leaseDuration time.Duration) ([]gcpspanner.NotificationChannelHeldDelivery, error) {
+	var ret []gcpspanner.NotificationChannelHeldDelivery
+	for key, row := range f.rows {
+		if row.ReleaseAt.After(now) || (row.LeaseExpiresAt != nil && row.LeaseExpiresAt.After(now)) {
+			continue
+		}
+		leaseExpiresAt := now.Add(leaseDuration)
+		row.LeaseID = new("lease-" + key)
+		row.LeaseExpiresAt = &leaseExpiresAt
+		f.rows[key] = row
+		ret = append(ret, row)
+	}
+
+	return ret, nil
+}
+
+func (f *fakeHeldDeliveryClient) CompleteNotificationChannelHeldDelivery(
+	_ context.Context,
+	channelID string,
+	subscriptionID string,
+	_ string,
+	prune func(held []byte) ([]byte, int64, error),
+) error {
+	key := channelID + "/" + subscriptionID
+	row, found := f.rows[key]
+	if !found {
+		return nil
+	}
+	remaining, count, err := prune(row.Job)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		delete(f.rows, key)
+
+		return nil
+	}
+	row.Job = remaining
+	row.HeldCount = count
+	row.LeaseID = nil
+	row.LeaseExpiresAt = nil
+	f.rows[key] = row
+
+	return nil
+}
+
+func (f *fakeHeldDeliveryClient) RescheduleNotificationChannelHeldDelivery(
+	_ context.Context, channelID string, subscriptionID string, _ string, releaseAt time.Time) error {
+	key := channelID + "/" + subscriptionID
+	row := f.rows[key]
+	row.ReleaseAt = releaseAt
+	row.LeaseID = nil
+	row.LeaseExpiresAt = nil
+	f.rows[key] = row
+
+	return nil
+}
+
+func (f *fakeHeldDeliveryClient) GetNotificationChannelDeliverySettingsByChannelID(
+	_ context.Context, channelID string) (*gcpspanner.NotificationChannelDeliverySettings, error) {
+	settings, found := f.settings[channelID]
+	if !found {
+		return nil, gcpspanner.ErrQueryReturnedNoResults
+	}
+
+	return settings, nil
+}
+
+func newFakeHeldDeliveryClient() *fakeHeldDeliveryClient {
+	return &fakeHeldDeliveryClient{
+		rows:     make(map[string]gcpspanner.NotificationChannelHeldDelivery),
+		reserve:  nil,
+		settings: make(map[string]*gcpspanner.NotificationChannelDeliverySettings),
+	}
+}
+
+func testSummary(t *testing.T, added int, featureID string) []byte {
+	t.Helper()
+	s := workertypes.NewEmptyEventSummary()
+	s.Categories.Added = added
+	s.Highlights = []workertypes.SummaryHighlight{
+		{
+			Type:           workertypes.SummaryHighlightTypeAdded,
+			FeatureID:      featureID,
+			FeatureName:    featureID,
+			Docs:           nil,
+			NameChange:     nil,
+			BaselineChange: nil,
+			BrowserChanges: nil,
+			Moved:          nil,
+			Split:          nil,
+		},
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("failed to marshal summary: %v", err)
+	}
+
+	return b
+}
+
+func TestPushDeliveryLimiter_HoldAndClaim(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeHeldDeliveryClient()
+	limiter := NewPushDeliveryLimiter(client)
+	releaseAt := time.Date(2026, 3, 11, 7, 0, 0, 0, time.UTC)
+	newJob := func(eventID string, summary []byte) workertypes.EmailDeliveryJob {
+		return workertypes.EmailDeliveryJob{
+			SubscriptionID: "sub-1",
+			RecipientEmail: "user@example.com",
+			ChannelID:      "chan-1",
+			Triggers:       []workertypes.JobTrigger{workertypes.FeaturePromotedToNewly},
+			SummaryRaw:     summary,
+			Metadata: workertypes.DeliveryMetadata{
+				EventID:     eventID,
+				SearchID:    "search-1",
+				SearchName:  "Search",
+				Query:       "group:css",
+				Frequency:   workertypes.FrequencyImmediate,
+				GeneratedAt: releaseAt.Add(-time.Hour),
+			},
+		}
+	}
+
+	for _, job := range []workertypes.EmailDeliveryJob{
+		newJob("evt-1", testSummary(t, 1, "a")),
+		newJob("evt-2", testSummary(t, 1, "b")),
+		// A redelivered event is not coalesced twice.
+		newJob("evt-1", testSummary(t, 1, "a")),
+	} {
+		if err := limiter.HoldEmailJob(ctx, job, releaseAt); err != nil {
+			t.Fatalf("HoldEmailJob failed: %v", err)
+		}
+	}
+	if err := limiter.HoldWebhookJob(ctx, workertypes.WebhookDeliveryJob{
+		SubscriptionID: "sub-2",
+		WebhookURL:     "https://hooks.slack.com/services/123",
+		WebhookType:    workertypes.WebhookTypeSlack,
+		ChannelID:      "chan-2",
+		Triggers:       nil,
+		SummaryRaw:     testSummary(t, 1, "c"),
+		Metadata:       newJob("evt-3", nil).Metadata,
+	}, releaseAt.Add(time.Hour)); err != nil {
+		t.Fatalf("HoldWebhookJob failed: %v", err)
+	}
+
+	held, err := limiter.IsEventHeld(ctx, "chan-1", "sub-1", "evt-2")
+	if err != nil || !held {
+		t.Errorf("IsEventHeld(evt-2) = %v, %v, want true, nil", held, err)
+	}
+	held, err = limiter.IsEventHeld(ctx, "chan-1", "sub-1", "evt-3")
+	if err != nil || held {
+		t.Errorf("IsEventHeld(evt-3) = %v, %v, want false, nil", held, err)
+	}
+
+	// Only the email job is due.
+	jobs, err := limiter.ClaimDueDeliveries(ctx, releaseAt, 10, time.Minute)
+	if err != nil {
+		t.Fatalf("ClaimDueDeliveries failed: %v", err)
+	}
+	if len(jobs) != 1 {
+		t.Fatalf("expected 1 due job, got %d", len(jobs))
+	}
+	got := jobs[0]
+	if got.Email == nil || got.Webhook != nil {
+		t.Fatalf("expected an email job, got %+v", got)
+	}
+	if got.HeldCount != 2 || got.LeaseID == "" {
+		t.Errorf("unexpected claim %+v", got)
+	}
+	if diff := cmp.Diff([]string{"evt-1", "evt-2"}, got.EventIDs); diff != "" {
+		t.Errorf("event ids mismatch (-want +got):\n%s", diff)
+	}
+	// The newest job wins, with both summaries merged.
+	want := newJob("evt-2", nil)
+	want.SummaryRaw = got.Email.SummaryRaw
+	if diff := cmp.Diff(want, *got.Email); diff != "" {
+		t.Errorf("job mismatch (-want +got):\n%s", diff)
+	}
+	var summary workertypes.EventSummary
+	if err := json.Unmarshal(got.Email.SummaryRaw, &summary); err != nil {
+		t.Fatalf("failed to unmarshal summary: %v", err)
+	}
+	if summary.Categories.Added != 2 || len(summary.Highlights) != 2 {
+		t.Errorf("expected summaries to be coalesced, got %+v", summary)
+	}
+
+	// An event held while the job is claimed stays held after the claim is completed.
+	if err := limiter.HoldEmailJob(ctx, newJob("evt-4", testSummary(t, 1, "d")), releaseAt); err != nil {
+		t.Fatalf("HoldEmailJob failed: %v", err)
+	}
+	if err := limiter.CompleteHeldDelivery(ctx, got); err != nil {
+		t.Fatalf("CompleteHeldDelivery failed: %v", err)
+	}
+	jobs, err = limiter.ClaimDueDeliveries(ctx, releaseAt, 10, time.Minute)
+	if err != nil {
+		t.Fatalf("ClaimDueDeliveries failed: %v", err)
+	}
+	if len(jobs) != 1 || jobs[0].Email == nil {
+		t.Fatalf("expected the remaining email job, got %+v", jobs)
+	}
+	if diff := cmp.Diff([]string{"evt-4"}, jobs[0].EventIDs); diff != "" {
+		t.Errorf("event ids mismatch (-want +got):\n%s", diff)
+	}
+	if err := limiter.CompleteHeldDelivery(ctx, jobs[0]); err != nil {
+		t.Fatalf("CompleteHeldDelivery failed: %v", err)
+	}
+
+	jobs, err = limiter.ClaimDueDeliveries(ctx, releaseAt.Add(time.Hour), 10, time.Minute)
+	if err != nil {
+		t.Fatalf("ClaimDueDeliveries failed: %v", err)
+	}
+	if len(jobs) != 1 || jobs[0].Webhook == nil {
+		t.Fatalf("expected the webhook job, got %+v", jobs)
+	}
+	if jobs[0].Webhook.WebhookType != workertypes.WebhookTypeSlack {
+		t.Errorf("unexpected webhook job %+v", jobs[0])
+	}
+
+	// A rescheduled job is released at the new time.
+	if err := limiter.RescheduleHeldDelivery(ctx, jobs[0], releaseAt.Add(2*time.Hour)); err != nil {
+		t.Fatalf("RescheduleHeldDelivery failed: %v", err)
+	}
+	jobs, err = limiter.ClaimDueDeliveries(ctx, releaseAt.Add(time.Hour), 10, time.Minute)
+	if err != nil {
+		t.Fatalf("ClaimDueDeliveries failed: %v", err)
+	}
+	if len(jobs) != 0 {
+		t.Errorf("expected no due jobs, got %+v", jobs)
+	}
+}
+
+func TestPushDeliveryLimiter_GetChannelDeliverySettings(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeHeldDeliveryClient()
+	client.settings["chan-1"] = &gcpspanner.NotificationChannelDeliverySettings{
+		ChannelID:            "chan-1",
+		QuietHoursStart:      new("22:00"),
+		QuietHoursEnd:        new("07:00"),
+		QuietHoursTimeZone:   new("UTC"),
+		MaxDeliveriesPerHour: new(int64(5)),
+		CreatedAt:            time.Time{},
+		UpdatedAt:            time.Time{},
+	}
+	limiter := NewPushDeliveryLimiter(client)
+
+	got, err := limiter.GetChannelDeliverySettings(ctx, "chan-1")
+	if err != nil {
+		t.Fatalf("GetChannelDeliverySettings failed: %v", err)
+	}
+	want := &workertypes.ChannelDeliverySettings{
+		QuietHours:           &workertypes.QuietHours{Start: "22:00", End: "07:00", TimeZone: "UTC"},
+		MaxDeliveriesPerHour: new(int64(5)),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("settings mismatch (-want +got):\n%s", diff)
+	}
+
+	// Channels without settings do not limit their deliveries.
+	got, err = limiter.GetChannelDeliverySettings(ctx, "chan-2")
+	if err != nil || got != nil {
+		t.Errorf("GetChannelDeliverySettings() = %+v, %v, want nil, nil", got, err)
+	}
+}
+
+func TestPushDeliveryLimiter_ReserveDelivery(t *testing.T) {
+	windowStart := time.Date(2026, 3, 11, 7, 0, 0, 0, time.UTC)
+	client := newFakeHeldDeliveryClient()
+	client.reserve = func(channelID, deliveryKey string, gotWindow time.Time, limit int64) (bool, error) {
+		if channelID != "chan-1" || deliveryKey != "key-1" || !gotWindow.Equal(windowStart) || limit != 3 {
+			t.Errorf("unexpected reservation %s %s %v %d", channelID, deliveryKey, gotWindow, limit)
+		}
+
+		return true, nil
+	}
+	reserved, err := NewPushDeliveryLimiter(client).ReserveDelivery(context.Background(), "chan-1", "key-1",
+		windowStart, 3)
+	if err != nil || !reserved {
+		t.Errorf("ReserveDelivery() = %v, %v, want true, nil", reserved, err)
+	}
+}
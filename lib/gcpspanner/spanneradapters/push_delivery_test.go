@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner"
 	"github.com/GoogleChrome/webstatus.dev/lib/workertypes"
@@ -83,6 +84,7 @@ func TestFindSubscribers(t *testing.T) {
 						gcpspanner.SubscriptionTriggerFeatureBaselinePromoteToNewly,
 						gcpspanner.SubscriptionTriggerFeatureBaselinePromoteToWidely,
 					},
+					DeliverySettings: nil,
 				},
 			},
 			expectedSet: &workertypes.SubscriberSet{
@@ -95,7 +97,8 @@ func TestFindSubscribers(t *testing.T) {
 							workertypes.FeaturePromotedToNewly,
 							workertypes.FeaturePromotedToWidely,
 						},
-						ChannelID: "chan-1",
+						ChannelID:        "chan-1",
+						DeliverySettings: nil,
 					},
 				},
 				Webhooks: []workertypes.WebhookSubscriber{},
@@ -125,15 +128,17 @@ func TestFindSubscribers(t *testing.T) {
 					Triggers: []gcpspanner.SubscriptionTrigger{
 						gcpspanner.SubscriptionTriggerBrowserImplementationAnyComplete,
 					},
+					DeliverySettings: nil,
 				},
 				{
-					SubscriptionID: "sub-2",
-					Type:           "WEBHOOK",
-					ChannelID:      "chan-2",
-					EmailConfig:    nil, // Webhooks don't have EmailConfig
-					WebhookConfig:  nil,
-					Triggers:       nil,
-					UserID:         "user-3",
+					SubscriptionID:   "sub-2",
+					Type:             "WEBHOOK",
+					ChannelID:        "chan-2",
+					EmailConfig:      nil, // Webhooks don't have EmailConfig
+					WebhookConfig:    nil,
+					Triggers:         nil,
+					UserID:           "user-3",
+					DeliverySettings: nil,
 				},
 			},
 			expectedSet: &workertypes.SubscriberSet{
@@ -145,7 +150,8 @@ func TestFindSubscribers(t *testing.T) {
 						Triggers: []workertypes.JobTrigger{
 							workertypes.BrowserImplementationAnyComplete,
 						},
-						ChannelID: "chan-1",
+						ChannelID:        "chan-1",
+						DeliverySettings: nil,
 					},
 				},
 				Webhooks: []workertypes.WebhookSubscriber{},
@@ -171,13 +177,14 @@ func TestFindSubscribers(t *testing.T) {
 			},
 			dests: []gcpspanner.SubscriberDestination{
 				{
-					UserID:         "user-1",
-					SubscriptionID: "sub-1",
-					Type:           gcpspanner.NotificationChannelTypeEmail,
-					ChannelID:      "chan-1",
-					Triggers:       nil,
-					EmailConfig:    nil, // Missing config should be skipped
-					WebhookConfig:  nil,
+					UserID:           "user-1",
+					SubscriptionID:   "sub-1",
+					Type:             gcpspanner.NotificationChannelTypeEmail,
+					ChannelID:        "chan-1",
+					Triggers:         nil,
+					EmailConfig:      nil, // Missing config should be skipped
+					WebhookConfig:    nil,
+					DeliverySettings: nil,
 				},
 			},
 			clientErr: nil,
@@ -208,6 +215,7 @@ func TestFindSubscribers(t *testing.T) {
 					Triggers: []gcpspanner.SubscriptionTrigger{
 						"some_unknown_trigger",
 					},
+					DeliverySettings: nil,
 				},
 			},
 			clientErr: nil,
@@ -220,7 +228,8 @@ func TestFindSubscribers(t *testing.T) {
 						Triggers: []workertypes.JobTrigger{
 							"", // Unknown triggers map to empty string/zero value in current implementation
 						},
-						ChannelID: "chan-1",
+						ChannelID:        "chan-1",
+						DeliverySettings: nil,
 					},
 				},
 				Webhooks: []workertypes.WebhookSubscriber{},
@@ -252,3 +261,26 @@ func TestFindSubscribers(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertSpannerDeliverySettings(t *testing.T) {
+	if got := convertSpannerDeliverySettings(nil); got != nil {
+		t.Errorf("expected nil settings, got %+v", got)
+	}
+
+	got := convertSpannerDeliverySettings(&gcpspanner.NotificationChannelDeliverySettings{
+		ChannelID:            "chan-1",
+		QuietHoursStart:      new("22:00"),
+		QuietHoursEnd:        new("07:00"),
+		QuietHoursTimeZone:   new("Europe/Berlin"),
+		MaxDeliveriesPerHour: new(int64(10)),
+		CreatedAt:            time.Time{},
+		UpdatedAt:            time.Time{},
+	})
+	want := &workertypes.ChannelDeliverySettings{
+		QuietHours:           &workertypes.QuietHours{Start: "22:00", End: "07:00", TimeZone: "Europe/Berlin"},
+		MaxDeliveriesPerHour: new(int64(10)),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("convertSpannerDeliverySettings mismatch (-want +got):\n%s", diff)
+	}
+}
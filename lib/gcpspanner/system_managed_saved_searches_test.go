@@ -51,14 +51,17 @@ func TestGetSystemManagedSavedSearchByFeatureID(t *testing.T) {
 
 		desc := systemSearchDesc
 		savedSearchMutation, err := spanner.InsertStruct(savedSearchesTable, &SavedSearch{
-			ID:          savedSearchID,
-			Name:        "my system search",
-			Query:       "feature:is(\"foo\")",
-			Scope:       SystemManagedScope,
-			AuthorID:    "system",
-			Description: &desc,
-			CreatedAt:   spanner.CommitTimestamp,
-			UpdatedAt:   spanner.CommitTimestamp,
+			ID:              savedSearchID,
+			Name:            "my system search",
+			Query:           "feature:is(\"foo\")",
+			Scope:           SystemManagedScope,
+			AuthorID:        "system",
+			Description:     &desc,
+			CreatedAt:       spanner.CommitTimestamp,
+			UpdatedAt:       spanner.CommitTimestamp,
+			Tags:            nil,
+			Listed:          false,
+			QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 		})
 		if err != nil {
 			return err
@@ -120,14 +123,17 @@ func TestUpsertAndListSystemManagedSavedSearches(t *testing.T) {
 
 		desc := systemSearchDesc
 		savedSearchMutation, err := spanner.InsertStruct(savedSearchesTable, &SavedSearch{
-			ID:          savedSearchID,
-			Name:        "my system search",
-			Query:       "feature:is(\"foo\")",
-			Scope:       SystemManagedScope,
-			AuthorID:    "system",
-			Description: &desc,
-			CreatedAt:   spanner.CommitTimestamp,
-			UpdatedAt:   spanner.CommitTimestamp,
+			ID:              savedSearchID,
+			Name:            "my system search",
+			Query:           "feature:is(\"foo\")",
+			Scope:           SystemManagedScope,
+			AuthorID:        "system",
+			Description:     &desc,
+			CreatedAt:       spanner.CommitTimestamp,
+			UpdatedAt:       spanner.CommitTimestamp,
+			Tags:            nil,
+			Listed:          false,
+			QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 		})
 		if err != nil {
 			return err
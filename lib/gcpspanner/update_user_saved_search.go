@@ -33,6 +33,10 @@ type UpdateSavedSearchRequest struct {
 	Query       OptionallySet[string]
 	Name        OptionallySet[string]
 	Description OptionallySet[*string]
+	Tags        OptionallySet[[]string]
+	Listed      OptionallySet[bool]
+	// QueryParameters replaces the declared variables of the query.
+	QueryParameters OptionallySet[[]SavedSearchQueryParameter]
 }
 
 type updateUserSavedSearchMapper struct {
@@ -48,6 +52,9 @@ func (m updateUserSavedSearchMapper) Table() string { return savedSearchesTable
 func (m updateUserSavedSearchMapper) Merge(req UpdateSavedSearchRequest, existing SavedSearch) SavedSearch {
 	var newName, newQuery string
 	var newDescription *string
+	newTags := existing.Tags
+	newListed := existing.Listed
+	newQueryParameters := existing.QueryParameters
 	if req.Name.IsSet {
 		newName = req.Name.Value
 	} else {
@@ -63,40 +70,81 @@ func (m updateUserSavedSearchMapper) Merge(req UpdateSavedSearchRequest, existin
 	} else {
 		newDescription = existing.Description
 	}
+	if req.Tags.IsSet {
+		newTags = req.Tags.Value
+	}
+	if req.Listed.IsSet {
+		newListed = req.Listed.Value
+	}
+	if req.QueryParameters.IsSet {
+		newQueryParameters = savedSearchQueryParametersJSON(req.QueryParameters.Value)
+	}
 
 	return SavedSearch{
-		ID:          existing.ID,
-		Name:        newName,
-		Query:       newQuery,
-		Description: newDescription,
-		Scope:       existing.Scope,
-		AuthorID:    req.AuthorID,
-		CreatedAt:   existing.CreatedAt,
-		UpdatedAt:   spanner.CommitTimestamp,
+		ID:              existing.ID,
+		Name:            newName,
+		Query:           newQuery,
+		Description:     newDescription,
+		Scope:           existing.Scope,
+		AuthorID:        req.AuthorID,
+		CreatedAt:       existing.CreatedAt,
+		UpdatedAt:       spanner.CommitTimestamp,
+		Tags:            newTags,
+		Listed:          newListed,
+		QueryParameters: newQueryParameters,
 	}
 }
 
 func (c *Client) UpdateUserSavedSearch(ctx context.Context, req UpdateSavedSearchRequest) error {
 	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
-		// 1. Check if the user has permission to update (OWNER role)
-		err := c.checkForSavedSearchRole(ctx, txn, SavedSearchOwner, req.AuthorID, req.ID)
+		// 1. Check if the user has permission to update (OWNER or EDITOR role)
+		err := c.checkForSavedSearchRole(ctx, txn, SavedSearchEditor, req.AuthorID, req.ID)
 		if err != nil {
 			return err
 		}
 
-		// 2. Read and update the existing saved search
-		_, err = newEntityWriter[updateUserSavedSearchMapper](c).updateWithTransaction(ctx, txn, req)
-		if err != nil {
-			slog.ErrorContext(ctx, "failed to update the saved search", "error", err)
+		// 2. Update the saved search and record the revision
+		return c.updateUserSavedSearchWithTransaction(ctx, txn, req, nil)
+	})
+	if err != nil {
+		return err
+	}
 
-			return err
-		}
+	return nil
+}
 
+// updateUserSavedSearchWithTransaction updates the saved search and records the change as a new revision.
+// No revision is recorded when the name, query, query parameters and description stay the same.
+func (c *Client) updateUserSavedSearchWithTransaction(
+	ctx context.Context,
+	txn *spanner.ReadWriteTransaction,
+	req UpdateSavedSearchRequest,
+	revertedFromRevision *int64) error {
+	existing, err := newEntityReader[savedSearchMapper, SavedSearch, string](c).
+		readRowByKeyWithTransaction(ctx, req.ID, txn)
+	if err != nil {
+		return err
+	}
+
+	_, err = newEntityWriter[updateUserSavedSearchMapper](c).updateWithTransaction(ctx, txn, req)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to update the saved search", "error", err)
+
+		return err
+	}
+
+	updated := updateUserSavedSearchMapper{}.Merge(req, *existing)
+	if savedSearchContentEqual(*existing, updated) {
 		return nil
-	})
+	}
+	mutations, err := savedSearchRevisionMutations(ctx, txn, *existing, updated, revertedFromRevision)
 	if err != nil {
 		return err
 	}
+	err = txn.BufferWrite(mutations)
+	if err != nil {
+		return errors.Join(ErrInternalQueryFailure, err)
+	}
 
 	return nil
 }
@@ -27,10 +27,13 @@ func TestUpdateUserSavedSearch(t *testing.T) {
 	ctx := context.Background()
 
 	savedSearchID, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-		Name:        "my little search",
-		Query:       "group:css",
-		OwnerUserID: "userID1",
-		Description: new("initial description"),
+		Name:            "my little search",
+		Query:           "group:css",
+		OwnerUserID:     "userID1",
+		Description:     new("initial description"),
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
 	})
 	if err != nil {
 		t.Errorf("expected nil error. received %s", err)
@@ -51,8 +54,11 @@ func TestUpdateUserSavedSearch(t *testing.T) {
 				AuthorID:    "userID1",
 				Description: new("initial description"),
 				// Don't actually compare the last two values.
-				CreatedAt: spanner.CommitTimestamp,
-				UpdatedAt: spanner.CommitTimestamp,
+				CreatedAt:       spanner.CommitTimestamp,
+				UpdatedAt:       spanner.CommitTimestamp,
+				Tags:            nil,
+				Listed:          false,
+				QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 			},
 		}
 		err := spannerClient.UpdateUserSavedSearch(ctx, UpdateSavedSearchRequest{
@@ -70,6 +76,9 @@ func TestUpdateUserSavedSearch(t *testing.T) {
 				IsSet: true,
 				Value: new("junkdesc"),
 			},
+			Tags:            OptionallySet[[]string]{Value: nil, IsSet: false},
+			Listed:          OptionallySet[bool]{Value: false, IsSet: false},
+			QueryParameters: OptionallySet[[]SavedSearchQueryParameter]{Value: nil, IsSet: false},
 		})
 		if !errors.Is(err, ErrMissingRequiredRole) {
 			t.Errorf("expected error trying to update %s", err)
@@ -94,8 +103,11 @@ func TestUpdateUserSavedSearch(t *testing.T) {
 				AuthorID:    "userID1",
 				Description: new("initial description"),
 				// Don't actually compare the last two values.
-				CreatedAt: spanner.CommitTimestamp,
-				UpdatedAt: spanner.CommitTimestamp,
+				CreatedAt:       spanner.CommitTimestamp,
+				UpdatedAt:       spanner.CommitTimestamp,
+				Tags:            nil,
+				Listed:          false,
+				QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 			},
 		}
 		err := spannerClient.UpdateUserSavedSearch(ctx, UpdateSavedSearchRequest{
@@ -113,6 +125,9 @@ func TestUpdateUserSavedSearch(t *testing.T) {
 				IsSet: false,
 				Value: nil,
 			},
+			Tags:            OptionallySet[[]string]{Value: nil, IsSet: false},
+			Listed:          OptionallySet[bool]{Value: false, IsSet: false},
+			QueryParameters: OptionallySet[[]SavedSearchQueryParameter]{Value: nil, IsSet: false},
 		})
 		if !errors.Is(err, nil) {
 			t.Errorf("expected nil error trying to update %s", err)
@@ -137,8 +152,11 @@ func TestUpdateUserSavedSearch(t *testing.T) {
 				AuthorID:    "userID1",
 				Description: nil,
 				// Don't actually compare the last two values.
-				CreatedAt: spanner.CommitTimestamp,
-				UpdatedAt: spanner.CommitTimestamp,
+				CreatedAt:       spanner.CommitTimestamp,
+				UpdatedAt:       spanner.CommitTimestamp,
+				Tags:            nil,
+				Listed:          false,
+				QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 			},
 		}
 		err := spannerClient.UpdateUserSavedSearch(ctx, UpdateSavedSearchRequest{
@@ -156,6 +174,9 @@ func TestUpdateUserSavedSearch(t *testing.T) {
 				IsSet: true,
 				Value: nil,
 			},
+			Tags:            OptionallySet[[]string]{Value: nil, IsSet: false},
+			Listed:          OptionallySet[bool]{Value: false, IsSet: false},
+			QueryParameters: OptionallySet[[]SavedSearchQueryParameter]{Value: nil, IsSet: false},
 		})
 		if !errors.Is(err, nil) {
 			t.Errorf("expected nil error trying to update %s", err)
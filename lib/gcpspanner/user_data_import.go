@@ -0,0 +1,508 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+	"google.golang.org/api/iterator"
+)
+
+// ImportUserDataItemKind is the kind of resource an import item describes.
+type ImportUserDataItemKind string
+
+const (
+	ImportUserDataItemSavedSearch         ImportUserDataItemKind = "saved_search"
+	ImportUserDataItemBookmark            ImportUserDataItemKind = "bookmark"
+	ImportUserDataItemNotificationChannel ImportUserDataItemKind = "notification_channel"
+	ImportUserDataItemSubscription        ImportUserDataItemKind = "subscription"
+)
+
+// ImportUserDataItemStatus is the outcome of importing a single item.
+type ImportUserDataItemStatus string
+
+const (
+	// ImportUserDataItemCreated indicates a new resource was created for the item.
+	ImportUserDataItemCreated ImportUserDataItemStatus = "created"
+	// ImportUserDataItemExisting indicates the user already had an equivalent resource.
+	ImportUserDataItemExisting ImportUserDataItemStatus = "existing"
+)
+
+var (
+	// ErrImportReferenceNotFound indicates an item references a resource that does not exist.
+	ErrImportReferenceNotFound = errors.New("referenced resource not found")
+	// ErrImportEmailChannelNotFound indicates the user has no email channel with the imported address.
+	ErrImportEmailChannelNotFound = errors.New("no email channel with this address")
+)
+
+// importedQueryReferencePattern matches the saved search and hotlist terms of a query.
+var importedQueryReferencePattern = regexp.MustCompile(`(^|[\s(])(saved|hotlist):("?)([^\s()"]+)`)
+
+// ImportUserDataItemError identifies the item that caused an import to be rolled back.
+type ImportUserDataItemError struct {
+	Kind     ImportUserDataItemKind
+	SourceID string
+	Err      error
+}
+
+func (e *ImportUserDataItemError) Error() string {
+	return fmt.Sprintf("unable to import %s %s: %v", e.Kind, e.SourceID, e.Err)
+}
+
+func (e *ImportUserDataItemError) Unwrap() error {
+	return e.Err
+}
+
+// ImportSavedSearch is a saved search to recreate for the user.
+type ImportSavedSearch struct {
+	// SourceID is the ID of the saved search in the exported document.
+	SourceID    string
+	Name        string
+	Description *string
+	// Query is ignored for hotlists. References to the source IDs of other imported saved searches are
+	// rewritten to their new IDs.
+	Query  string
+	Tags   []string
+	Listed bool
+	// QueryParameters declares the variables used in Query.
+	QueryParameters []SavedSearchQueryParameter
+	// IsHotlist indicates the saved search is a hotlist of HotlistFeatureKeys.
+	IsHotlist          bool
+	HotlistFeatureKeys []string
+}
+
+// ImportNotificationChannel is a notification channel to recreate for the user.
+// Email channels are matched against the existing channels of the user by address.
+// RSS channels resolve to the single RSS channel of the user.
+type ImportNotificationChannel struct {
+	// SourceID is the ID of the channel in the exported document.
+	SourceID     string
+	Name         string
+	Type         NotificationChannelType
+	EmailAddress string
+	WebhookURL   string
+}
+
+// ImportSavedSearchSubscription is a subscription to recreate for the user.
+type ImportSavedSearchSubscription struct {
+	// SourceID is the ID of the subscription in the exported document.
+	SourceID string
+	// SavedSearchID is either the SourceID of an imported saved search or the ID of an existing saved search.
+	SavedSearchID string
+	// ChannelID is the SourceID of an imported notification channel.
+	ChannelID string
+	Triggers  []SubscriptionTrigger
+	Frequency SavedSearchSnapshotType
+}
+
+// ImportUserDataRequest contains the resources to recreate for a user.
+type ImportUserDataRequest struct {
+	UserID        string
+	SavedSearches []ImportSavedSearch
+	// BookmarkedSavedSearchIDs are the IDs of existing saved searches to bookmark.
+	BookmarkedSavedSearchIDs []string
+	NotificationChannels     []ImportNotificationChannel
+	Subscriptions            []ImportSavedSearchSubscription
+}
+
+// ImportUserDataItemResult is the outcome of importing a single item.
+type ImportUserDataItemResult struct {
+	Kind     ImportUserDataItemKind
+	SourceID string
+	Status   ImportUserDataItemStatus
+	// ID is the ID of the resource the item resolved to.
+	ID string
+}
+
+// userDataImport holds the state of a single attempt of the import transaction.
+type userDataImport struct {
+	c   *Client
+	txn *spanner.ReadWriteTransaction
+	req ImportUserDataRequest
+	// savedSearchIDs maps the source IDs of imported saved searches to their new IDs.
+	savedSearchIDs map[string]string
+	// channels maps the source IDs of imported channels to the result of importing them.
+	channels map[string]ImportUserDataItemResult
+	results  []ImportUserDataItemResult
+}
+
+// ImportUserData recreates the given resources for the user in a single transaction.
+// If any item cannot be imported, nothing is written and an *ImportUserDataItemError is returned.
+func (c *Client) ImportUserData(ctx context.Context, req ImportUserDataRequest) ([]ImportUserDataItemResult, error) {
+	var results []ImportUserDataItemResult
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		// The transaction may be retried, so start from a clean state every time.
+		imp := &userDataImport{
+			c:              c,
+			txn:            txn,
+			req:            req,
+			savedSearchIDs: make(map[string]string, len(req.SavedSearches)),
+			channels:       make(map[string]ImportUserDataItemResult, len(req.NotificationChannels)),
+			results:        nil,
+		}
+		if err := imp.run(ctx); err != nil {
+			return err
+		}
+		results = imp.results
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (i *userDataImport) run(ctx context.Context) error {
+	// Reads in a read write transaction do not observe the buffered writes of the same transaction,
+	// so every limit is checked once upfront against the whole request.
+	if err := i.checkLimits(ctx); err != nil {
+		return err
+	}
+	// The IDs are assigned upfront so that the imported saved searches can reference each other in any order.
+	for _, s := range i.req.SavedSearches {
+		i.savedSearchIDs[s.SourceID] = uuid.NewString()
+	}
+	for _, s := range i.req.SavedSearches {
+		if err := i.importSavedSearch(ctx, s); err != nil {
+			return &ImportUserDataItemError{Kind: ImportUserDataItemSavedSearch, SourceID: s.SourceID, Err: err}
+		}
+	}
+	for _, id := range i.req.BookmarkedSavedSearchIDs {
+		if err := i.importBookmark(ctx, id); err != nil {
+			return &ImportUserDataItemError{Kind: ImportUserDataItemBookmark, SourceID: id, Err: err}
+		}
+	}
+	for _, ch := range i.req.NotificationChannels {
+		if err := i.importNotificationChannel(ctx, ch); err != nil {
+			return &ImportUserDataItemError{Kind: ImportUserDataItemNotificationChannel, SourceID: ch.SourceID, Err: err}
+		}
+	}
+	for _, sub := range i.req.Subscriptions {
+		if err := i.importSubscription(ctx, sub); err != nil {
+			return &ImportUserDataItemError{Kind: ImportUserDataItemSubscription, SourceID: sub.SourceID, Err: err}
+		}
+	}
+
+	return nil
+}
+
+func (i *userDataImport) addResult(kind ImportUserDataItemKind, sourceID string,
+	status ImportUserDataItemStatus, id string) ImportUserDataItemResult {
+	result := ImportUserDataItemResult{Kind: kind, SourceID: sourceID, Status: status, ID: id}
+	i.results = append(i.results, result)
+
+	return result
+}
+
+func (i *userDataImport) count(ctx context.Context, stmt spanner.Statement) (int64, error) {
+	row, err := i.txn.Query(ctx, stmt).Next()
+	if err != nil {
+		return 0, errors.Join(ErrInternalQueryFailure, err)
+	}
+	var count int64
+	if err := row.Columns(&count); err != nil {
+		return 0, errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return count, nil
+}
+
+func (i *userDataImport) checkLimits(ctx context.Context) error {
+	c := i.c
+	if len(i.req.SavedSearches) > 0 {
+		owned, err := i.count(ctx, spanner.Statement{
+			SQL: fmt.Sprintf(`SELECT COUNT(*) FROM %s WHERE UserID = @userID AND UserRole = @role`,
+				savedSearchUserRolesTable),
+			Params: map[string]any{"userID": i.req.UserID, "role": SavedSearchOwner},
+		})
+		if err != nil {
+			return err
+		}
+		if owned+int64(len(i.req.SavedSearches)) > int64(c.searchCfg.maxOwnedSearchesPerUser) {
+			return ErrOwnerSavedSearchLimitExceeded
+		}
+	}
+
+	if len(i.req.BookmarkedSavedSearchIDs) > 0 {
+		// Only count the bookmarks the user does not have yet.
+		stmt := spanner.Statement{
+			SQL: fmt.Sprintf(`
+			SELECT
+				(SELECT COUNT(us.SavedSearchID)
+				FROM %[1]s us
+				LEFT JOIN %[2]s sr ON us.SavedSearchID = sr.SavedSearchID AND us.UserID = sr.UserID
+				WHERE us.UserID = @userID AND (sr.UserRole != @role OR sr.UserRole IS NULL))
+				+
+				(SELECT COUNT(*)
+				FROM UNNEST(@savedSearchIDs) AS id
+				WHERE id NOT IN (SELECT SavedSearchID FROM %[1]s WHERE UserID = @userID))`,
+				userSavedSearchBookmarksTable, savedSearchUserRolesTable),
+			Params: map[string]any{
+				"userID":         i.req.UserID,
+				"role":           SavedSearchOwner,
+				"savedSearchIDs": i.req.BookmarkedSavedSearchIDs,
+			},
+		}
+		total, err := i.count(ctx, stmt)
+		if err != nil {
+			return err
+		}
+		if total > int64(c.searchCfg.maxBookmarksPerUser) {
+			return ErrUserSearchBookmarkLimitExceeded
+		}
+	}
+
+	var newWebhooks int64
+	for _, ch := range i.req.NotificationChannels {
+		if ch.Type == NotificationChannelTypeWebhook {
+			newWebhooks++
+		}
+	}
+	if newWebhooks > 0 {
+		channels, err := c.countNotificationChannels(ctx, i.req.UserID, i.txn)
+		if err != nil {
+			return err
+		}
+		if channels+newWebhooks > int64(c.notificationLimits.maxChannelsPerUser) {
+			return ErrOwnerNotificationChannelLimitExceeded
+		}
+	}
+
+	if len(i.req.Subscriptions) > 0 {
+		subscriptions, err := i.count(ctx, spanner.Statement{
+			SQL: `SELECT COUNT(*)
+              FROM SavedSearchSubscriptions sc
+              JOIN NotificationChannels nc ON sc.ChannelID = nc.ID
+              WHERE nc.UserID = @userID`,
+			Params: map[string]any{"userID": i.req.UserID},
+		})
+		if err != nil {
+			return err
+		}
+		if subscriptions+int64(len(i.req.Subscriptions)) > int64(c.searchCfg.maxSubscriptionsPerUser) {
+			return ErrSubscriptionLimitExceeded
+		}
+	}
+
+	return nil
+}
+
+func (i *userDataImport) importSavedSearch(ctx context.Context, s ImportSavedSearch) error {
+	id := i.savedSearchIDs[s.SourceID]
+	query := rewriteImportedQueryReferences(s.Query, i.savedSearchIDs)
+	if s.IsHotlist {
+		query = UserHotlistQuery(id)
+	}
+	_, err := i.c.createNewUserSavedSearchWithTransaction(ctx, i.txn, CreateUserSavedSearchRequest{
+		Name:            s.Name,
+		Query:           query,
+		OwnerUserID:     i.req.UserID,
+		Description:     s.Description,
+		Tags:            s.Tags,
+		Listed:          s.Listed,
+		QueryParameters: s.QueryParameters,
+	}, WithID(id))
+	if err != nil {
+		return err
+	}
+	if s.IsHotlist {
+		if err := i.c.createUserHotlistWithTransaction(ctx, i.txn, id, s.HotlistFeatureKeys); err != nil {
+			return err
+		}
+	}
+	i.addResult(ImportUserDataItemSavedSearch, s.SourceID, ImportUserDataItemCreated, id)
+
+	return nil
+}
+
+// rewriteImportedQueryReferences replaces the source IDs of the saved search and hotlist terms of the query
+// with the new IDs of the imported saved searches. Other references are kept as is.
+func rewriteImportedQueryReferences(query string, savedSearchIDs map[string]string) string {
+	return importedQueryReferencePattern.ReplaceAllStringFunc(query, func(term string) string {
+		match := importedQueryReferencePattern.FindStringSubmatch(term)
+		newID, found := savedSearchIDs[match[4]]
+		if !found {
+			return term
+		}
+
+		return match[1] + match[2] + ":" + match[3] + newID
+	})
+}
+
+func (i *userDataImport) importBookmark(ctx context.Context, savedSearchID string) error {
+	search, err := newEntityReader[
+		authenticatedUserSavedSearchMapper,
+		UserSavedSearch,
+		authenticatedUserSavedSearchMapperKey,
+	](i.c).readRowByKeyWithTransaction(ctx, authenticatedUserSavedSearchMapperKey{
+		UserID: i.req.UserID,
+		ID:     savedSearchID,
+	}, i.txn)
+	if errors.Is(err, ErrQueryReturnedNoResults) {
+		return ErrImportReferenceNotFound
+	} else if err != nil {
+		return err
+	}
+	if search.IsBookmarked != nil && *search.IsBookmarked {
+		i.addResult(ImportUserDataItemBookmark, savedSearchID, ImportUserDataItemExisting, savedSearchID)
+
+		return nil
+	}
+
+	_, err = newEntityWriter[userSavedSearchBookmarkMapper](i.c).upsertWithTransaction(ctx, i.txn,
+		UserSavedSearchBookmark{UserID: i.req.UserID, SavedSearchID: savedSearchID})
+	if err != nil {
+		return err
+	}
+	i.addResult(ImportUserDataItemBookmark, savedSearchID, ImportUserDataItemCreated, savedSearchID)
+
+	return nil
+}
+
+func (i *userDataImport) importNotificationChannel(ctx context.Context, ch ImportNotificationChannel) error {
+	var (
+		id     string
+		status ImportUserDataItemStatus
+	)
+	switch ch.Type {
+	case NotificationChannelTypeEmail:
+		existingID, err := i.findEmailChannel(ctx, ch.EmailAddress)
+		if err != nil {
+			return err
+		}
+		if existingID == nil {
+			return ErrImportEmailChannelNotFound
+		}
+		id, status = *existingID, ImportUserDataItemExisting
+	case NotificationChannelTypeRSS:
+		existingID, err := i.c.findRSSChannel(ctx, i.req.UserID, i.txn)
+		if err != nil {
+			return err
+		}
+		if existingID != nil {
+			id, status = *existingID, ImportUserDataItemExisting
+		} else {
+			id, err = i.c.findOrCreateRSSChannel(ctx, i.req.UserID, i.txn)
+			if err != nil {
+				return err
+			}
+			status = ImportUserDataItemCreated
+		}
+	case NotificationChannelTypeWebhook:
+		newID, err := i.c.createNotificationChannelWithTransaction(ctx, i.txn, CreateNotificationChannelRequest{
+			UserID:        i.req.UserID,
+			Name:          ch.Name,
+			Type:          NotificationChannelTypeWebhook,
+			EmailConfig:   nil,
+			WebhookConfig: &WebhookConfig{URL: ch.WebhookURL},
+		})
+		if err != nil {
+			return err
+		}
+		id, status = *newID, ImportUserDataItemCreated
+	}
+	i.channels[ch.SourceID] = i.addResult(ImportUserDataItemNotificationChannel, ch.SourceID, status, id)
+
+	return nil
+}
+
+// findEmailChannel returns the ID of the email channel of the user with the given address,
+// or nil if the user has none.
+func (i *userDataImport) findEmailChannel(ctx context.Context, address string) (*string, error) {
+	stmt := spanner.Statement{
+		SQL: fmt.Sprintf(`SELECT ID FROM %s
+              WHERE UserID = @userID AND Type = 'email' AND JSON_VALUE(Config, '$.address') = @address
+              LIMIT 1`, notificationChannelTable),
+		Params: map[string]any{"userID": i.req.UserID, "address": address},
+	}
+	iter := i.txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if errors.Is(err, iterator.Done) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+	var id string
+	if err := row.Column(0, &id); err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return &id, nil
+}
+
+func (i *userDataImport) importSubscription(ctx context.Context, sub ImportSavedSearchSubscription) error {
+	channel, found := i.channels[sub.ChannelID]
+	if !found {
+		return ErrImportReferenceNotFound
+	}
+
+	savedSearchID, imported := i.savedSearchIDs[sub.SavedSearchID]
+	if !imported {
+		savedSearchID = sub.SavedSearchID
+		_, err := newEntityReader[savedSearchMapper, SavedSearch, string](i.c).
+			readRowByKeyWithTransaction(ctx, savedSearchID, i.txn)
+		if errors.Is(err, ErrQueryReturnedNoResults) {
+			return ErrImportReferenceNotFound
+		} else if err != nil {
+			return err
+		}
+	}
+
+	// Only a pre-existing channel and saved search can already have a subscription.
+	if !imported && channel.Status == ImportUserDataItemExisting {
+		existing, err := i.c.findSavedSearchSubscriptionByChannel(ctx, i.txn, channel.ID, savedSearchID)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			reqTriggers := slices.Clone(sub.Triggers)
+			slices.Sort(reqTriggers)
+			existingTriggers := slices.Clone(existing.Triggers)
+			slices.Sort(existingTriggers)
+			if existing.Frequency != sub.Frequency || !slices.Equal(existingTriggers, reqTriggers) {
+				return ErrSubscriptionConflict
+			}
+			i.addResult(ImportUserDataItemSubscription, sub.SourceID, ImportUserDataItemExisting, existing.ID)
+
+			return nil
+		}
+	}
+
+	id, err := newEntityCreator[savedSearchSubscriptionMapper](i.c).createWithTransaction(ctx, i.txn,
+		CreateSavedSearchSubscriptionRequest{
+			UserID:        i.req.UserID,
+			ChannelID:     channel.ID,
+			ChannelType:   nil,
+			SavedSearchID: savedSearchID,
+			Triggers:      sub.Triggers,
+			Frequency:     sub.Frequency,
+		})
+	if err != nil {
+		return err
+	}
+	i.addResult(ImportUserDataItemSubscription, sub.SourceID, ImportUserDataItemCreated, *id)
+
+	return nil
+}
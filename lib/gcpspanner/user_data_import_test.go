@@ -0,0 +1,226 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestImportUserData(t *testing.T) {
+	restartDatabaseContainer(t)
+	ctx := context.Background()
+	insertMockFeatures(ctx, t, map[string]bool{
+		"import1": true,
+		"import2": true,
+	})
+	userID := uuid.NewString()
+
+	emailChannelID, err := spannerClient.CreateNotificationChannel(ctx, CreateNotificationChannelRequest{
+		UserID:        userID,
+		Name:          "Email",
+		Type:          NotificationChannelTypeEmail,
+		EmailConfig:   &EmailConfig{Address: "user@example.com", IsVerified: true, VerificationToken: nil},
+		WebhookConfig: nil,
+	})
+	if err != nil {
+		t.Fatalf("CreateNotificationChannel failed: %v", err)
+	}
+	otherSearchID, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
+		Name:            "Other user search",
+		Query:           "group:css",
+		OwnerUserID:     uuid.NewString(),
+		Description:     nil,
+		Tags:            nil,
+		Listed:          true,
+		QueryParameters: nil,
+	})
+	if err != nil {
+		t.Fatalf("CreateNewUserSavedSearch failed: %v", err)
+	}
+
+	req := ImportUserDataRequest{
+		UserID: userID,
+		SavedSearches: []ImportSavedSearch{
+			{
+				// References the saved searches imported after it.
+				SourceID:           "src-composed",
+				Name:               "Composed",
+				Description:        nil,
+				Query:              `saved:src-search OR (hotlist:"src-hotlist" group:css) OR saved:other`,
+				Tags:               nil,
+				Listed:             false,
+				QueryParameters:    nil,
+				IsHotlist:          false,
+				HotlistFeatureKeys: nil,
+			},
+			{
+				SourceID:           "src-search",
+				Name:               "CSS",
+				Description:        nil,
+				Query:              "group:css",
+				Tags:               []string{"css"},
+				Listed:             false,
+				QueryParameters:    nil,
+				IsHotlist:          false,
+				HotlistFeatureKeys: nil,
+			},
+			{
+				SourceID:           "src-hotlist",
+				Name:               "Hotlist",
+				Description:        nil,
+				Query:              "hotlist:src-hotlist",
+				Tags:               nil,
+				Listed:             false,
+				QueryParameters:    nil,
+				IsHotlist:          true,
+				HotlistFeatureKeys: []string{"import2", "import1"},
+			},
+		},
+		BookmarkedSavedSearchIDs: []string{*otherSearchID},
+		NotificationChannels: []ImportNotificationChannel{
+			{
+				SourceID:     "src-email",
+				Name:         "Email",
+				Type:         NotificationChannelTypeEmail,
+				EmailAddress: "user@example.com",
+				WebhookURL:   "",
+			},
+			{
+				SourceID:     "src-webhook",
+				Name:         "Webhook",
+				Type:         NotificationChannelTypeWebhook,
+				EmailAddress: "",
+				WebhookURL:   "https://hooks.slack.com/services/import",
+			},
+		},
+		Subscriptions: []ImportSavedSearchSubscription{
+			{
+				SourceID:      "src-sub1",
+				SavedSearchID: "src-search",
+				ChannelID:     "src-webhook",
+				Triggers:      []SubscriptionTrigger{SubscriptionTriggerFeatureBaselinePromoteToWidely},
+				Frequency:     SavedSearchSnapshotTypeWeekly,
+			},
+			{
+				SourceID:      "src-sub2",
+				SavedSearchID: *otherSearchID,
+				ChannelID:     "src-email",
+				Triggers:      nil,
+				Frequency:     SavedSearchSnapshotTypeImmediate,
+			},
+		},
+	}
+	results, err := spannerClient.ImportUserData(ctx, req)
+	if err != nil {
+		t.Fatalf("ImportUserData failed: %v", err)
+	}
+	if len(results) != 8 {
+		t.Fatalf("expected 8 results, got %+v", results)
+	}
+	statuses := make(map[string]ImportUserDataItemResult, len(results))
+	for _, r := range results {
+		statuses[r.SourceID] = r
+	}
+	if statuses["src-email"].Status != ImportUserDataItemExisting || statuses["src-email"].ID != *emailChannelID {
+		t.Errorf("unexpected email channel result %+v", statuses["src-email"])
+	}
+	if statuses[*otherSearchID].Status != ImportUserDataItemCreated {
+		t.Errorf("unexpected bookmark result %+v", statuses[*otherSearchID])
+	}
+
+	hotlistID := statuses["src-hotlist"].ID
+	hotlist, err := spannerClient.GetUserHotlist(ctx, hotlistID)
+	if err != nil {
+		t.Fatalf("GetUserHotlist failed: %v", err)
+	}
+	if !slices.Equal(hotlist.FeatureKeys, []string{"import2", "import1"}) {
+		t.Errorf("unexpected hotlist features %v", hotlist.FeatureKeys)
+	}
+	search, err := spannerClient.GetUserSavedSearch(ctx, hotlistID, &userID)
+	if err != nil {
+		t.Fatalf("GetUserSavedSearch failed: %v", err)
+	}
+	if search.Query != UserHotlistQuery(hotlistID) {
+		t.Errorf("unexpected hotlist query %q", search.Query)
+	}
+
+	composed, err := spannerClient.GetUserSavedSearch(ctx, statuses["src-composed"].ID, &userID)
+	if err != nil {
+		t.Fatalf("GetUserSavedSearch failed: %v", err)
+	}
+	expectedQuery := "saved:" + statuses["src-search"].ID + ` OR (hotlist:"` + hotlistID + `" group:css) OR saved:other`
+	if composed.Query != expectedQuery {
+		t.Errorf("unexpected composed query %q, want %q", composed.Query, expectedQuery)
+	}
+
+	sub, err := spannerClient.GetSavedSearchSubscription(ctx, statuses["src-sub1"].ID, userID)
+	if err != nil {
+		t.Fatalf("GetSavedSearchSubscription failed: %v", err)
+	}
+	if sub.SavedSearchID != statuses["src-search"].ID || sub.ChannelID != statuses["src-webhook"].ID {
+		t.Errorf("unexpected subscription %+v", sub)
+	}
+
+	// Importing the same bookmarks and subscriptions again reports them as existing.
+	results, err = spannerClient.ImportUserData(ctx, ImportUserDataRequest{
+		UserID:                   userID,
+		SavedSearches:            nil,
+		BookmarkedSavedSearchIDs: req.BookmarkedSavedSearchIDs,
+		NotificationChannels:     req.NotificationChannels[:1],
+		Subscriptions:            req.Subscriptions[1:],
+	})
+	if err != nil {
+		t.Fatalf("ImportUserData failed: %v", err)
+	}
+	for _, r := range results {
+		if r.Status != ImportUserDataItemExisting {
+			t.Errorf("expected existing result, got %+v", r)
+		}
+	}
+
+	// A failing item rolls back the whole import.
+	_, err = spannerClient.ImportUserData(ctx, ImportUserDataRequest{
+		UserID:                   userID,
+		SavedSearches:            req.SavedSearches[:1],
+		BookmarkedSavedSearchIDs: nil,
+		NotificationChannels: []ImportNotificationChannel{
+			{
+				SourceID:     "src-unknown-email",
+				Name:         "Email",
+				Type:         NotificationChannelTypeEmail,
+				EmailAddress: "unknown@example.com",
+				WebhookURL:   "",
+			},
+		},
+		Subscriptions: nil,
+	})
+	var itemErr *ImportUserDataItemError
+	if !errors.As(err, &itemErr) || itemErr.SourceID != "src-unknown-email" ||
+		!errors.Is(err, ErrImportEmailChannelNotFound) {
+		t.Fatalf("expected ErrImportEmailChannelNotFound item error, got %v", err)
+	}
+	page, err := spannerClient.ListUserSavedSearches(ctx, userID, 100, nil)
+	if err != nil {
+		t.Fatalf("ListUserSavedSearches failed: %v", err)
+	}
+	if len(page.Searches) != 3 {
+		t.Errorf("expected the failed import to be rolled back, got %d searches", len(page.Searches))
+	}
+}
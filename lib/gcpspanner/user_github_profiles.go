@@ -0,0 +1,88 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+const userGitHubProfilesTable = "UserGitHubProfiles"
+
+// ErrUnknownGitHubUsername indicates that no user has synced a profile with the GitHub username.
+var ErrUnknownGitHubUsername = errors.New("no user with the github username")
+
+// spannerUserGitHubProfile represents a row in the UserGitHubProfiles table.
+type spannerUserGitHubProfile struct {
+	UserID         string    `spanner:"UserID"`
+	GitHubUserID   int64     `spanner:"GitHubUserID"`
+	GitHubUsername string    `spanner:"GitHubUsername"`
+	UpdatedAt      time.Time `spanner:"UpdatedAt"`
+}
+
+// upsertUserGitHubProfile stores the GitHub identity of the user.
+// It is a no-op if the profile has no username.
+func upsertUserGitHubProfile(txn *spanner.ReadWriteTransaction, userProfile UserProfile) error {
+	if userProfile.GitHubUsername == "" {
+		return nil
+	}
+	m, err := spanner.InsertOrUpdateStruct(userGitHubProfilesTable, spannerUserGitHubProfile{
+		UserID:         userProfile.UserID,
+		GitHubUserID:   userProfile.GitHubUserID,
+		GitHubUsername: userProfile.GitHubUsername,
+		UpdatedAt:      spanner.CommitTimestamp,
+	})
+	if err != nil {
+		return errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return txn.BufferWrite([]*spanner.Mutation{m})
+}
+
+// getUserIDByGitHubUsername resolves a GitHub username to the ID of the user that last synced it.
+func getUserIDByGitHubUsername(ctx context.Context, txn transaction, username string) (string, error) {
+	stmt := spanner.Statement{
+		SQL: `SELECT UserID
+			FROM UserGitHubProfiles
+			WHERE GitHubUsername_Lowercase = @username
+			ORDER BY UpdatedAt DESC
+			LIMIT 1`,
+		Params: map[string]any{
+			"username": strings.ToLower(username),
+		},
+	}
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		if errors.Is(err, iterator.Done) {
+			return "", ErrUnknownGitHubUsername
+		}
+
+		return "", errors.Join(ErrInternalQueryFailure, err)
+	}
+	var userID string
+	if err := row.Columns(&userID); err != nil {
+		return "", errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return userID, nil
+}
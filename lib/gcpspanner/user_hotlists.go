@@ -0,0 +1,297 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+)
+
+const userHotlistsTable = "UserHotlists"
+
+// maxUserHotlistFeatures is the maximum number of features in a user hotlist.
+const maxUserHotlistFeatures = 500
+
+// hotlistPositionStep is the gap between the positions of consecutive features.
+// It matches the convention used for the curated system global saved searches.
+const hotlistPositionStep = 10
+
+var (
+	// ErrHotlistVersionMismatch indicates the hotlist was changed since the caller last read it.
+	ErrHotlistVersionMismatch = errors.New("hotlist order version mismatch")
+	// ErrUnknownHotlistFeature indicates a feature key does not exist.
+	ErrUnknownHotlistFeature = errors.New("unknown hotlist feature")
+	// ErrDuplicateHotlistFeature indicates a feature key was listed more than once.
+	ErrDuplicateHotlistFeature = errors.New("duplicate hotlist feature")
+	// ErrUserHotlistFeatureLimitExceeded indicates the hotlist would contain too many features.
+	ErrUserHotlistFeatureLimitExceeded = errors.New("hotlist feature limit reached")
+)
+
+// spannerUserHotlist represents a row in the UserHotlists table.
+type spannerUserHotlist struct {
+	SavedSearchID string    `spanner:"SavedSearchID"`
+	OrderVersion  int64     `spanner:"OrderVersion"`
+	CreatedAt     time.Time `spanner:"CreatedAt"`
+	UpdatedAt     time.Time `spanner:"UpdatedAt"`
+}
+
+// UserHotlist is a user owned saved search whose features and their order are curated by hand.
+type UserHotlist struct {
+	SavedSearchID string
+	// OrderVersion is incremented on every change to the features of the hotlist.
+	OrderVersion int64
+	// FeatureKeys are the features of the hotlist, in order.
+	FeatureKeys []string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// CreateUserHotlistRequest is the request to create a new hotlist backed saved search.
+type CreateUserHotlistRequest struct {
+	Name        string
+	Description *string
+	OwnerUserID string
+	FeatureKeys []string
+}
+
+// ReplaceUserHotlistFeaturesRequest replaces the features of a hotlist.
+type ReplaceUserHotlistFeaturesRequest struct {
+	SavedSearchID string
+	UserID        string
+	// ExpectedOrderVersion must match the current version of the hotlist.
+	ExpectedOrderVersion int64
+	FeatureKeys          []string
+}
+
+type userHotlistMapper struct{}
+
+func (m userHotlistMapper) SelectOne(savedSearchID string) spanner.Statement {
+	stmt := spanner.NewStatement(`
+		SELECT SavedSearchID, OrderVersion, CreatedAt, UpdatedAt
+		FROM UserHotlists
+		WHERE SavedSearchID = @savedSearchID`)
+	stmt.Params["savedSearchID"] = savedSearchID
+
+	return stmt
+}
+
+// UserHotlistQuery returns the query of a hotlist backed saved search.
+// The query matches exactly the features of the hotlist.
+func UserHotlistQuery(savedSearchID string) string {
+	return "hotlist:" + savedSearchID
+}
+
+// CreateUserHotlist creates a saved search owned by the user whose features are the given features,
+// in order. It returns the ID of the saved search.
+func (c *Client) CreateUserHotlist(ctx context.Context, req CreateUserHotlistRequest) (*string, error) {
+	id := uuid.NewString()
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		_, err := c.createNewUserSavedSearchWithTransaction(ctx, txn, CreateUserSavedSearchRequest{
+			Name:            req.Name,
+			Query:           UserHotlistQuery(id),
+			OwnerUserID:     req.OwnerUserID,
+			Description:     req.Description,
+			Tags:            nil,
+			Listed:          false,
+			QueryParameters: nil,
+		}, WithID(id))
+		if err != nil {
+			return err
+		}
+
+		return c.createUserHotlistWithTransaction(ctx, txn, id, req.FeatureKeys)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// createUserHotlistWithTransaction turns the newly created saved search into a hotlist with the given features.
+func (c *Client) createUserHotlistWithTransaction(
+	ctx context.Context, txn *spanner.ReadWriteTransaction, savedSearchID string, featureKeys []string) error {
+	m, err := spanner.InsertStruct(userHotlistsTable, spannerUserHotlist{
+		SavedSearchID: savedSearchID,
+		OrderVersion:  1,
+		CreatedAt:     spanner.CommitTimestamp,
+		UpdatedAt:     spanner.CommitTimestamp,
+	})
+	if err != nil {
+		return errors.Join(ErrInternalMutationFailure, err)
+	}
+	if err := txn.BufferWrite([]*spanner.Mutation{m}); err != nil {
+		return errors.Join(ErrInternalMutationFailure, err)
+	}
+
+	return c.writeUserHotlistFeatures(ctx, txn, savedSearchID, featureKeys)
+}
+
+// GetUserHotlist returns the hotlist of a saved search.
+// It returns ErrQueryReturnedNoResults if the saved search is not a user hotlist.
+func (c *Client) GetUserHotlist(ctx context.Context, savedSearchID string) (*UserHotlist, error) {
+	txn := c.ReadOnlyTransaction()
+	defer txn.Close()
+
+	row, err := newEntityReader[userHotlistMapper, spannerUserHotlist, string](c).
+		readRowByKeyWithTransaction(ctx, savedSearchID, txn)
+	if err != nil {
+		return nil, err
+	}
+
+	stmt := spanner.NewStatement(`
+		SELECT FeatureKey
+		FROM SavedSearchFeatureSortOrder
+		WHERE SavedSearchID = @savedSearchID
+		ORDER BY PositionIndex ASC, FeatureKey ASC`)
+	stmt.Params["savedSearchID"] = savedSearchID
+
+	featureKeys := []string{}
+	err = txn.Query(ctx, stmt).Do(func(r *spanner.Row) error {
+		var featureKey string
+		if err := r.Columns(&featureKey); err != nil {
+			return err
+		}
+		featureKeys = append(featureKeys, featureKey)
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	return &UserHotlist{
+		SavedSearchID: row.SavedSearchID,
+		OrderVersion:  row.OrderVersion,
+		FeatureKeys:   featureKeys,
+		CreatedAt:     row.CreatedAt,
+		UpdatedAt:     row.UpdatedAt,
+	}, nil
+}
+
+// ReplaceUserHotlistFeatures replaces the features of a hotlist owned by the user and returns
+// the new order version.
+// It returns ErrHotlistVersionMismatch if the hotlist changed since ExpectedOrderVersion was read.
+func (c *Client) ReplaceUserHotlistFeatures(
+	ctx context.Context, req ReplaceUserHotlistFeaturesRequest) (int64, error) {
+	var newVersion int64
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		err := c.checkForSavedSearchRole(ctx, txn, SavedSearchEditor, req.UserID, req.SavedSearchID)
+		if err != nil {
+			return err
+		}
+
+		hotlist, err := newEntityReader[userHotlistMapper, spannerUserHotlist, string](c).
+			readRowByKeyWithTransaction(ctx, req.SavedSearchID, txn)
+		if err != nil {
+			return err
+		}
+		if hotlist.OrderVersion != req.ExpectedOrderVersion {
+			return fmt.Errorf("%w: expected %d, current %d", ErrHotlistVersionMismatch,
+				req.ExpectedOrderVersion, hotlist.OrderVersion)
+		}
+
+		newVersion = hotlist.OrderVersion + 1
+		m, err := spanner.UpdateStruct(userHotlistsTable, spannerUserHotlist{
+			SavedSearchID: hotlist.SavedSearchID,
+			OrderVersion:  newVersion,
+			CreatedAt:     hotlist.CreatedAt,
+			UpdatedAt:     spanner.CommitTimestamp,
+		})
+		if err != nil {
+			return errors.Join(ErrInternalMutationFailure, err)
+		}
+		// Remove the previous order before writing the new one.
+		mutations := []*spanner.Mutation{
+			m,
+			spanner.Delete(savedSearchFeatureSortOrderTable, spanner.Key{req.SavedSearchID}.AsPrefix()),
+		}
+		if err := txn.BufferWrite(mutations); err != nil {
+			return errors.Join(ErrInternalMutationFailure, err)
+		}
+
+		return c.writeUserHotlistFeatures(ctx, txn, req.SavedSearchID, req.FeatureKeys)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return newVersion, nil
+}
+
+// writeUserHotlistFeatures validates the features and writes them in order to SavedSearchFeatureSortOrder.
+func (c *Client) writeUserHotlistFeatures(
+	ctx context.Context, txn *spanner.ReadWriteTransaction, savedSearchID string, featureKeys []string) error {
+	if len(featureKeys) > maxUserHotlistFeatures {
+		return ErrUserHotlistFeatureLimitExceeded
+	}
+	seen := make(map[string]struct{}, len(featureKeys))
+	for _, key := range featureKeys {
+		if _, found := seen[key]; found {
+			return fmt.Errorf("%w: %s", ErrDuplicateHotlistFeature, key)
+		}
+		seen[key] = struct{}{}
+	}
+	if len(featureKeys) == 0 {
+		return nil
+	}
+
+	stmt := spanner.NewStatement(`
+		SELECT FeatureKey
+		FROM WebFeatures
+		WHERE FeatureKey IN UNNEST(@featureKeys)`)
+	stmt.Params["featureKeys"] = featureKeys
+	err := txn.Query(ctx, stmt).Do(func(r *spanner.Row) error {
+		var featureKey string
+		if err := r.Columns(&featureKey); err != nil {
+			return err
+		}
+		delete(seen, featureKey)
+
+		return nil
+	})
+	if err != nil {
+		return errors.Join(ErrInternalQueryFailure, err)
+	}
+	for _, key := range featureKeys {
+		if _, missing := seen[key]; missing {
+			return fmt.Errorf("%w: %s", ErrUnknownHotlistFeature, key)
+		}
+	}
+
+	var mapper savedSearchFeatureSortOrderMapper
+	mutations := make([]*spanner.Mutation, 0, len(featureKeys))
+	for i, key := range featureKeys {
+		m, err := mapper.InsertOrUpdateMutation(SpannerSavedSearchFeatureSortOrder{
+			SavedSearchID: savedSearchID,
+			FeatureKey:    key,
+			PositionIndex: int64((i + 1) * hotlistPositionStep),
+		})
+		if err != nil {
+			return errors.Join(ErrInternalMutationFailure, err)
+		}
+		mutations = append(mutations, m)
+	}
+	if err := txn.BufferWrite(mutations); err != nil {
+		return errors.Join(ErrInternalMutationFailure, err)
+	}
+
+	return nil
+}
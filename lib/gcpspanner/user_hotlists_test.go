@@ -0,0 +1,183 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/searchtypes"
+	"github.com/google/uuid"
+)
+
+func TestUserHotlists(t *testing.T) {
+	restartDatabaseContainer(t)
+	ctx := context.Background()
+	insertMockFeatures(ctx, t, map[string]bool{
+		"hot1": true,
+		"hot2": true,
+		"hot3": true,
+	})
+	userID := uuid.NewString()
+
+	id, err := spannerClient.CreateUserHotlist(ctx, CreateUserHotlistRequest{
+		Name:        "Interop priorities",
+		Description: nil,
+		OwnerUserID: userID,
+		FeatureKeys: []string{"hot2", "hot1"},
+	})
+	if err != nil {
+		t.Fatalf("CreateUserHotlist failed: %v", err)
+	}
+
+	savedSearch, err := spannerClient.GetUserSavedSearch(ctx, *id, &userID)
+	if err != nil {
+		t.Fatalf("GetUserSavedSearch failed: %v", err)
+	}
+	if savedSearch.Query != UserHotlistQuery(*id) {
+		t.Errorf("unexpected hotlist query %q", savedSearch.Query)
+	}
+
+	hotlist, err := spannerClient.GetUserHotlist(ctx, *id)
+	if err != nil {
+		t.Fatalf("GetUserHotlist failed: %v", err)
+	}
+	if hotlist.OrderVersion != 1 || !slices.Equal(hotlist.FeatureKeys, []string{"hot2", "hot1"}) {
+		t.Errorf("unexpected hotlist %+v", hotlist)
+	}
+
+	// The user order is used by the search ID sort.
+	parser := searchtypes.FeaturesSearchQueryParser{}
+	node, err := parser.Parse("hotlist:" + *id)
+	if err != nil {
+		t.Fatalf("failed to parse query: %v", err)
+	}
+	page, err := spannerClient.FeaturesSearch(ctx, nil, 100, node, NewSearchIDOrderSort(true, *id),
+		WPTSubtestView, []string{})
+	if err != nil {
+		t.Fatalf("FeaturesSearch failed: %v", err)
+	}
+	keys := make([]string, 0, len(page.Features))
+	for _, f := range page.Features {
+		keys = append(keys, f.FeatureKey)
+	}
+	if !slices.Equal(keys, []string{"hot2", "hot1"}) {
+		t.Errorf("unexpected search results %v", keys)
+	}
+
+	version, err := spannerClient.ReplaceUserHotlistFeatures(ctx, ReplaceUserHotlistFeaturesRequest{
+		SavedSearchID:        *id,
+		UserID:               userID,
+		ExpectedOrderVersion: 1,
+		FeatureKeys:          []string{"hot3", "hot1", "hot2"},
+	})
+	if err != nil {
+		t.Fatalf("ReplaceUserHotlistFeatures failed: %v", err)
+	}
+	if version != 2 {
+		t.Errorf("expected version 2, got %d", version)
+	}
+	hotlist, err = spannerClient.GetUserHotlist(ctx, *id)
+	if err != nil {
+		t.Fatalf("GetUserHotlist failed: %v", err)
+	}
+	if hotlist.OrderVersion != 2 || !slices.Equal(hotlist.FeatureKeys, []string{"hot3", "hot1", "hot2"}) {
+		t.Errorf("unexpected hotlist %+v", hotlist)
+	}
+
+	testCases := []struct {
+		name        string
+		userID      string
+		version     int64
+		featureKeys []string
+		expectedErr error
+	}{
+		{
+			name:        "stale version",
+			userID:      userID,
+			version:     1,
+			featureKeys: []string{"hot1"},
+			expectedErr: ErrHotlistVersionMismatch,
+		},
+		{
+			name:        "not the owner",
+			userID:      uuid.NewString(),
+			version:     2,
+			featureKeys: []string{"hot1"},
+			expectedErr: ErrMissingRequiredRole,
+		},
+		{
+			name:        "unknown feature",
+			userID:      userID,
+			version:     2,
+			featureKeys: []string{"hot1", "unknown"},
+			expectedErr: ErrUnknownHotlistFeature,
+		},
+		{
+			name:        "duplicate feature",
+			userID:      userID,
+			version:     2,
+			featureKeys: []string{"hot1", "hot1"},
+			expectedErr: ErrDuplicateHotlistFeature,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := spannerClient.ReplaceUserHotlistFeatures(ctx, ReplaceUserHotlistFeaturesRequest{
+				SavedSearchID:        *id,
+				UserID:               tc.userID,
+				ExpectedOrderVersion: tc.version,
+				FeatureKeys:          tc.featureKeys,
+			})
+			if !errors.Is(err, tc.expectedErr) {
+				t.Errorf("expected %v, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+
+	// Regular saved searches are not hotlists.
+	regularID, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
+		Name:            "Regular",
+		Query:           "is:widely",
+		OwnerUserID:     userID,
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
+	})
+	if err != nil {
+		t.Fatalf("CreateNewUserSavedSearch failed: %v", err)
+	}
+	if _, err := spannerClient.GetUserHotlist(ctx, *regularID); !errors.Is(err, ErrQueryReturnedNoResults) {
+		t.Errorf("expected ErrQueryReturnedNoResults, got %v", err)
+	}
+}
+
+func TestCreateUserHotlist_UnknownFeature(t *testing.T) {
+	restartDatabaseContainer(t)
+	ctx := context.Background()
+
+	_, err := spannerClient.CreateUserHotlist(ctx, CreateUserHotlistRequest{
+		Name:        "Broken",
+		Description: nil,
+		OwnerUserID: uuid.NewString(),
+		FeatureKeys: []string{"does-not-exist"},
+	})
+	if !errors.Is(err, ErrUnknownHotlistFeature) {
+		t.Errorf("expected ErrUnknownHotlistFeature, got %v", err)
+	}
+}
@@ -195,9 +195,10 @@ func generateDisableChannelMutations(
 
 // UserProfile represents a user's profile information.
 type UserProfile struct {
-	UserID       string
-	GitHubUserID int64
-	Emails       []string
+	UserID         string
+	GitHubUserID   int64
+	GitHubUsername string
+	Emails         []string
 }
 
 func (c *Client) SyncUserProfileInfo(
@@ -213,6 +214,11 @@ func (c *Client) SyncUserProfileInfo(
 			return err
 		}
 
+		err = upsertUserGitHubProfile(txn, userProfile)
+		if err != nil {
+			return err
+		}
+
 		return generateDisableChannelMutations(ctx, c, txn, existingChannels, existingChannelStates, newEmailsMap)
 	})
 
@@ -207,9 +207,10 @@ func TestSyncUserProfileInfo(t *testing.T) {
 	initialState2UpdatedAt := initialChannelStates[1].UpdatedAt
 
 	userProfile := UserProfile{
-		UserID:       userID,
-		GitHubUserID: 0,
-		Emails:       []string{email2, email3}, // email1 is removed, email2 should be enabled, email3 is new
+		UserID:         userID,
+		GitHubUserID:   0,
+		GitHubUsername: "",
+		Emails:         []string{email2, email3}, // email1 is removed, email2 should be enabled, email3 is new
 	}
 
 	err = spannerClient.SyncUserProfileInfo(ctx, userProfile)
@@ -41,10 +41,13 @@ func TestUserSearchBookmark(t *testing.T) {
 	spannerClient.searchCfg.maxBookmarksPerUser = 1
 
 	savedSearchID, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-		Name:        "my little search",
-		Query:       "group:css",
-		OwnerUserID: "userID1",
-		Description: nil,
+		Name:            "my little search",
+		Query:           "group:css",
+		OwnerUserID:     "userID1",
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
 	})
 	if err != nil {
 		t.Errorf("expected nil error. received %s", err)
@@ -54,10 +57,13 @@ func TestUserSearchBookmark(t *testing.T) {
 	}
 
 	savedSearchID2, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-		Name:        "my big search",
-		Query:       "group:html",
-		OwnerUserID: "userID1",
-		Description: nil,
+		Name:            "my big search",
+		Query:           "group:html",
+		OwnerUserID:     "userID1",
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
 	})
 	if err != nil {
 		t.Errorf("expected nil error. received %s", err)
@@ -80,8 +86,11 @@ func TestUserSearchBookmark(t *testing.T) {
 			AuthorID:    "userID1",
 			Description: nil,
 			// Don't actually compare the last two values.
-			CreatedAt: spanner.CommitTimestamp,
-			UpdatedAt: spanner.CommitTimestamp,
+			CreatedAt:       spanner.CommitTimestamp,
+			UpdatedAt:       spanner.CommitTimestamp,
+			Tags:            nil,
+			Listed:          false,
+			QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 		},
 	}
 	t.Run("the test user can see they don't have bookmark status", func(t *testing.T) {
@@ -101,8 +110,11 @@ func TestUserSearchBookmark(t *testing.T) {
 				AuthorID:    "userID1",
 				Description: nil,
 				// Don't actually compare the last two values.
-				CreatedAt: spanner.CommitTimestamp,
-				UpdatedAt: spanner.CommitTimestamp,
+				CreatedAt:       spanner.CommitTimestamp,
+				UpdatedAt:       spanner.CommitTimestamp,
+				Tags:            nil,
+				Listed:          false,
+				QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 			},
 		}
 		err = spannerClient.AddUserSearchBookmark(ctx, UserSavedSearchBookmark{
@@ -130,10 +142,13 @@ func TestUserSearchBookmark(t *testing.T) {
 	t.Run("the test user can still make saved searches even after hitting the bookmark limit", func(t *testing.T) {
 		var err error
 		testUserSavedSearchID, err = spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
-			Name:        "my really big search",
-			Query:       "group:html OR group:css",
-			OwnerUserID: testUser,
-			Description: nil,
+			Name:            "my really big search",
+			Query:           "group:html OR group:css",
+			OwnerUserID:     testUser,
+			Description:     nil,
+			Tags:            nil,
+			Listed:          false,
+			QueryParameters: nil,
 		})
 		if err != nil {
 			t.Errorf("expected nil error. received %s", err)
@@ -0,0 +1,292 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+)
+
+// WatchedFeature is a subscription to the system-managed saved search of a single feature.
+type WatchedFeature struct {
+	SavedSearchSubscriptionView
+	FeatureKey  string `spanner:"FeatureKey"`
+	FeatureName string `spanner:"FeatureName"`
+}
+
+// WatchFeatureRequest is the request to watch a feature.
+// Watching a feature subscribes the channel to the system-managed saved search of the feature.
+type WatchFeatureRequest struct {
+	UserID      string
+	FeatureKey  string
+	ChannelID   string
+	ChannelType *NotificationChannelType
+	Triggers    []SubscriptionTrigger
+	Frequency   SavedSearchSnapshotType
+}
+
+// UnwatchFeatureRequest is the request to stop watching a feature.
+type UnwatchFeatureRequest struct {
+	UserID     string
+	FeatureKey string
+	// ChannelID limits the removal to a single channel. If nil, the feature is unwatched on all channels.
+	ChannelID *string
+}
+
+// ListWatchedFeaturesRequest is a request to list the features watched by a user.
+type ListWatchedFeaturesRequest struct {
+	UserID    string
+	PageSize  int
+	PageToken *string
+}
+
+// GetPageSize returns the page size for the request.
+func (r ListWatchedFeaturesRequest) GetPageSize() int {
+	return r.PageSize
+}
+
+type watchedFeatureMapper struct {
+	baseSavedSearchSubscriptionMapper
+}
+
+const watchedFeaturesBaseQuery = `SELECT
+		sc.ID, sc.ChannelID, sc.SavedSearchID, ss.Name AS SavedSearchName,
+		sc.Triggers, sc.Frequency, sc.CreatedAt, sc.UpdatedAt,
+		nc.Type AS ChannelType,
+		wf.FeatureKey, wf.Name AS FeatureName
+	FROM SavedSearchSubscriptions sc
+	JOIN NotificationChannels nc ON sc.ChannelID = nc.ID
+	JOIN SavedSearches ss ON sc.SavedSearchID = ss.ID
+	JOIN SystemManagedSavedSearches sm ON sc.SavedSearchID = sm.SavedSearchID
+	JOIN WebFeatures wf ON sm.FeatureID = wf.ID`
+
+func (m watchedFeatureMapper) SelectOne(key string) spanner.Statement {
+	stmt := spanner.NewStatement(watchedFeaturesBaseQuery + `
+	WHERE sc.ID = @id
+	LIMIT 1`)
+	stmt.Params["id"] = key
+
+	return stmt
+}
+
+func (m watchedFeatureMapper) SelectList(req ListWatchedFeaturesRequest) spanner.Statement {
+	var pageFilter string
+	params := map[string]any{
+		"userID":   req.UserID,
+		"pageSize": req.PageSize,
+	}
+	if req.PageToken != nil {
+		cursor, err := decodeCursor[savedSearchSubscriptionCursor](*req.PageToken)
+		if err == nil {
+			params["lastID"] = cursor.LastID
+			params["lastUpdatedAt"] = cursor.LastUpdatedAt
+			pageFilter = " AND (sc.UpdatedAt < @lastUpdatedAt OR (sc.UpdatedAt = @lastUpdatedAt AND sc.ID > @lastID))"
+		}
+	}
+	query := fmt.Sprintf(`%s
+	WHERE nc.UserID = @userID %s
+	ORDER BY sc.UpdatedAt DESC, sc.ID ASC LIMIT @pageSize`, watchedFeaturesBaseQuery, pageFilter)
+
+	stmt := spanner.NewStatement(query)
+	stmt.Params = params
+
+	return stmt
+}
+
+func (m watchedFeatureMapper) EncodePageToken(item WatchedFeature) string {
+	return encodeCursor(savedSearchSubscriptionCursor{
+		LastID:        item.ID,
+		LastUpdatedAt: item.UpdatedAt,
+	})
+}
+
+// getSystemManagedSavedSearchIDByFeatureKey returns the ID of the system-managed saved search of a feature.
+func (c *Client) getSystemManagedSavedSearchIDByFeatureKey(
+	ctx context.Context, txn transaction, featureKey string) (string, error) {
+	stmt := spanner.NewStatement(`
+		SELECT sm.SavedSearchID
+		FROM WebFeatures wf
+		JOIN SystemManagedSavedSearches sm ON sm.FeatureID = wf.ID
+		WHERE wf.FeatureKey = @featureKey
+		LIMIT 1`)
+	stmt.Params["featureKey"] = featureKey
+
+	iter := txn.Query(ctx, stmt)
+	defer iter.Stop()
+
+	row, err := iter.Next()
+	if err != nil {
+		if errors.Is(err, iterator.Done) {
+			return "", errors.Join(ErrQueryReturnedNoResults, err)
+		}
+
+		return "", errors.Join(ErrInternalQueryFailure, err)
+	}
+
+	var savedSearchID string
+	if err := row.Columns(&savedSearchID); err != nil {
+		return "", err
+	}
+
+	return savedSearchID, nil
+}
+
+// WatchFeature subscribes a channel to the system-managed saved search of a feature.
+// If the channel already watches the feature, the existing subscription is updated with the
+// requested triggers and frequency. It returns the ID of the subscription.
+func (c *Client) WatchFeature(ctx context.Context, req WatchFeatureRequest) (*string, error) {
+	var id *string
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		savedSearchID, err := c.getSystemManagedSavedSearchIDByFeatureKey(ctx, txn, req.FeatureKey)
+		if err != nil {
+			return err
+		}
+
+		createReq := CreateSavedSearchSubscriptionRequest{
+			UserID:        req.UserID,
+			ChannelID:     req.ChannelID,
+			ChannelType:   req.ChannelType,
+			SavedSearchID: savedSearchID,
+			Triggers:      req.Triggers,
+			Frequency:     req.Frequency,
+		}
+		channelID, skipOwnershipCheck, err := c.resolveSubscriptionChannel(ctx, txn, createReq)
+		if err != nil {
+			return err
+		}
+		createReq.ChannelID = channelID
+		createReq.ChannelType = nil
+
+		existingSub, err := c.findSavedSearchSubscriptionByChannel(ctx, txn, channelID, savedSearchID)
+		if err != nil {
+			return err
+		}
+		if existingSub == nil {
+			id, err = c.createSavedSearchSubscriptionWithTransaction(ctx, txn, createReq)
+
+			return err
+		}
+
+		if !skipOwnershipCheck {
+			err = c.checkNotificationChannelOwnership(ctx, channelID, req.UserID, txn, true)
+			if err != nil {
+				return err
+			}
+		}
+		id, err = newEntityWriter[savedSearchSubscriptionMapper](c).updateWithTransaction(ctx, txn,
+			UpdateSavedSearchSubscriptionRequest{
+				ID:     existingSub.ID,
+				UserID: req.UserID,
+				Triggers: OptionallySet[[]SubscriptionTrigger]{
+					IsSet: true,
+					Value: req.Triggers,
+				},
+				Frequency: OptionallySet[SavedSearchSnapshotType]{
+					IsSet: true,
+					Value: req.Frequency,
+				},
+			})
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return id, nil
+}
+
+// UnwatchFeature removes the subscriptions of a user to the system-managed saved search of a feature.
+// It returns ErrQueryReturnedNoResults if the user does not watch the feature.
+func (c *Client) UnwatchFeature(ctx context.Context, req UnwatchFeatureRequest) error {
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		savedSearchID, err := c.getSystemManagedSavedSearchIDByFeatureKey(ctx, txn, req.FeatureKey)
+		if err != nil {
+			return err
+		}
+
+		var channelFilter string
+		params := map[string]any{
+			"userID":        req.UserID,
+			"savedSearchID": savedSearchID,
+		}
+		if req.ChannelID != nil {
+			channelFilter = " AND sc.ChannelID = @channelID"
+			params["channelID"] = *req.ChannelID
+		}
+		stmt := spanner.Statement{
+			SQL: `SELECT sc.ID
+			FROM SavedSearchSubscriptions sc
+			JOIN NotificationChannels nc ON sc.ChannelID = nc.ID
+			WHERE nc.UserID = @userID AND sc.SavedSearchID = @savedSearchID` + channelFilter,
+			Params: params,
+		}
+
+		var mutations []*spanner.Mutation
+		iter := txn.Query(ctx, stmt)
+		defer iter.Stop()
+		err = iter.Do(func(row *spanner.Row) error {
+			var subscriptionID string
+			if err := row.Columns(&subscriptionID); err != nil {
+				return err
+			}
+			mutations = append(mutations, spanner.Delete(savedSearchSubscriptionTable, spanner.Key{subscriptionID}))
+
+			return nil
+		})
+		if err != nil {
+			return errors.Join(ErrInternalQueryFailure, err)
+		}
+		if len(mutations) == 0 {
+			return ErrQueryReturnedNoResults
+		}
+
+		return txn.BufferWrite(mutations)
+	})
+
+	return err
+}
+
+// GetWatchedFeature retrieves a watched feature by its subscription ID if it belongs to the specified user.
+func (c *Client) GetWatchedFeature(
+	ctx context.Context, subscriptionID string, userID string) (*WatchedFeature, error) {
+	var ret *WatchedFeature
+	_, err := c.ReadWriteTransaction(ctx, func(ctx context.Context, txn *spanner.ReadWriteTransaction) error {
+		err := c.checkNotificationChannelOwnershipBySubscriptionID(ctx, subscriptionID, userID, txn)
+		if err != nil {
+			return err
+		}
+		watched, err := newEntityReader[watchedFeatureMapper, WatchedFeature, string](c).
+			readRowByKeyWithTransaction(ctx, subscriptionID, txn)
+		if err != nil {
+			return err
+		}
+		ret = watched
+
+		return nil
+	})
+
+	return ret, err
+}
+
+// ListWatchedFeatures retrieves the features watched by a user with pagination.
+func (c *Client) ListWatchedFeatures(
+	ctx context.Context, req ListWatchedFeaturesRequest) ([]WatchedFeature, *string, error) {
+	return newEntityLister[watchedFeatureMapper](c).list(ctx, req)
+}
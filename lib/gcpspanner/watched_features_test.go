@@ -0,0 +1,295 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcpspanner
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cloud.google.com/go/spanner"
+	"github.com/google/uuid"
+)
+
+func setupWatchedFeaturesTest(ctx context.Context, t *testing.T) (string, string) {
+	t.Helper()
+	_, err := spannerClient.ReadWriteTransaction(ctx, func(_ context.Context, txn *spanner.ReadWriteTransaction) error {
+		m1, _ := spanner.InsertStruct(webFeaturesTable, &SpannerWebFeature{
+			ID: uuid.NewString(),
+			WebFeature: WebFeature{
+				FeatureKey:      "f1",
+				Name:            "Feature 1",
+				Description:     "",
+				DescriptionHTML: "",
+			},
+		})
+		m2, _ := spanner.InsertStruct(webFeaturesTable, &SpannerWebFeature{
+			ID: uuid.NewString(),
+			WebFeature: WebFeature{
+				FeatureKey:      "f2",
+				Name:            "Feature 2",
+				Description:     "",
+				DescriptionHTML: "",
+			},
+		})
+
+		return txn.BufferWrite([]*spanner.Mutation{m1, m2})
+	})
+	if err != nil {
+		t.Fatalf("failed to insert features: %v", err)
+	}
+	if err := spannerClient.SyncSystemManagedSavedQuery(ctx); err != nil {
+		t.Fatalf("SyncSystemManagedSavedQuery failed: %v", err)
+	}
+
+	userID := uuid.NewString()
+	channelIDPtr, err := spannerClient.CreateNotificationChannel(ctx, CreateNotificationChannelRequest{
+		UserID:        userID,
+		Name:          "Test",
+		Type:          NotificationChannelTypeEmail,
+		EmailConfig:   &EmailConfig{Address: "test@example.com", IsVerified: true, VerificationToken: nil},
+		WebhookConfig: nil,
+	})
+	if err != nil {
+		t.Fatalf("failed to create notification channel: %v", err)
+	}
+
+	return userID, *channelIDPtr
+}
+
+func TestWatchFeature(t *testing.T) {
+	ctx := context.Background()
+	restartDatabaseContainer(t)
+	userID, channelID := setupWatchedFeaturesTest(ctx, t)
+
+	req := WatchFeatureRequest{
+		UserID:      userID,
+		FeatureKey:  "f1",
+		ChannelID:   channelID,
+		ChannelType: nil,
+		Triggers:    []SubscriptionTrigger{SubscriptionTriggerFeatureBaselinePromoteToWidely},
+		Frequency:   SavedSearchSnapshotTypeImmediate,
+	}
+	id, err := spannerClient.WatchFeature(ctx, req)
+	if err != nil {
+		t.Fatalf("WatchFeature failed: %v", err)
+	}
+
+	watched, err := spannerClient.GetWatchedFeature(ctx, *id, userID)
+	if err != nil {
+		t.Fatalf("GetWatchedFeature failed: %v", err)
+	}
+	if watched.FeatureKey != "f1" || watched.FeatureName != "Feature 1" {
+		t.Errorf("unexpected feature %q (%q)", watched.FeatureKey, watched.FeatureName)
+	}
+	if watched.ChannelID != channelID || watched.Frequency != SavedSearchSnapshotTypeImmediate {
+		t.Errorf("unexpected subscription %+v", watched.SavedSearchSubscription)
+	}
+	mapping, err := spannerClient.getSystemManagedSavedSearchIDByFeatureKey(ctx, spannerClient.Single(), "f1")
+	if err != nil {
+		t.Fatalf("getSystemManagedSavedSearchIDByFeatureKey failed: %v", err)
+	}
+	if watched.SavedSearchID != mapping {
+		t.Errorf("SavedSearchID = %q, want %q", watched.SavedSearchID, mapping)
+	}
+
+	// Watching the same feature on the same channel replaces the configuration.
+	req.Frequency = SavedSearchSnapshotTypeWeekly
+	req.Triggers = []SubscriptionTrigger{SubscriptionTriggerFeatureBaselineRegressionToLimited}
+	updatedID, err := spannerClient.WatchFeature(ctx, req)
+	if err != nil {
+		t.Fatalf("WatchFeature (update) failed: %v", err)
+	}
+	if *updatedID != *id {
+		t.Errorf("expected the existing subscription %q to be updated, got %q", *id, *updatedID)
+	}
+	watched, err = spannerClient.GetWatchedFeature(ctx, *id, userID)
+	if err != nil {
+		t.Fatalf("GetWatchedFeature failed: %v", err)
+	}
+	if watched.Frequency != SavedSearchSnapshotTypeWeekly ||
+		len(watched.Triggers) != 1 || watched.Triggers[0] != SubscriptionTriggerFeatureBaselineRegressionToLimited {
+		t.Errorf("subscription was not updated: %+v", watched.SavedSearchSubscription)
+	}
+}
+
+func TestWatchFeatureErrors(t *testing.T) {
+	ctx := context.Background()
+	restartDatabaseContainer(t)
+	userID, channelID := setupWatchedFeaturesTest(ctx, t)
+
+	_, err := spannerClient.WatchFeature(ctx, WatchFeatureRequest{
+		UserID:      userID,
+		FeatureKey:  "unknown",
+		ChannelID:   channelID,
+		ChannelType: nil,
+		Triggers:    nil,
+		Frequency:   SavedSearchSnapshotTypeImmediate,
+	})
+	if !errors.Is(err, ErrQueryReturnedNoResults) {
+		t.Errorf("expected ErrQueryReturnedNoResults for unknown feature, got %v", err)
+	}
+
+	_, err = spannerClient.WatchFeature(ctx, WatchFeatureRequest{
+		UserID:      uuid.NewString(),
+		FeatureKey:  "f1",
+		ChannelID:   channelID,
+		ChannelType: nil,
+		Triggers:    nil,
+		Frequency:   SavedSearchSnapshotTypeImmediate,
+	})
+	if !errors.Is(err, ErrMissingRequiredRole) {
+		t.Errorf("expected ErrMissingRequiredRole for another user's channel, got %v", err)
+	}
+}
+
+func TestListAndUnwatchFeatures(t *testing.T) {
+	ctx := context.Background()
+	restartDatabaseContainer(t)
+	userID, channelID := setupWatchedFeaturesTest(ctx, t)
+
+	rss := NotificationChannelTypeRSS
+	for _, req := range []WatchFeatureRequest{
+		{
+			UserID:      userID,
+			FeatureKey:  "f1",
+			ChannelID:   channelID,
+			ChannelType: nil,
+			Triggers:    nil,
+			Frequency:   SavedSearchSnapshotTypeImmediate,
+		},
+		{
+			UserID:      userID,
+			FeatureKey:  "f1",
+			ChannelID:   "",
+			ChannelType: &rss,
+			Triggers:    nil,
+			Frequency:   SavedSearchSnapshotTypeImmediate,
+		},
+		{
+			UserID:      userID,
+			FeatureKey:  "f2",
+			ChannelID:   channelID,
+			ChannelType: nil,
+			Triggers:    nil,
+			Frequency:   SavedSearchSnapshotTypeImmediate,
+		},
+	} {
+		if _, err := spannerClient.WatchFeature(ctx, req); err != nil {
+			t.Fatalf("WatchFeature failed: %v", err)
+		}
+	}
+
+	// A regular saved search subscription is not a watched feature.
+	savedSearchID, err := spannerClient.CreateNewUserSavedSearch(ctx, CreateUserSavedSearchRequest{
+		Name:            "Test Search",
+		Query:           "is:widely",
+		OwnerUserID:     userID,
+		Description:     nil,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
+	})
+	if err != nil {
+		t.Fatalf("failed to create saved search: %v", err)
+	}
+	_, err = spannerClient.CreateSavedSearchSubscription(ctx, CreateSavedSearchSubscriptionRequest{
+		UserID:        userID,
+		ChannelID:     channelID,
+		ChannelType:   nil,
+		SavedSearchID: *savedSearchID,
+		Triggers:      nil,
+		Frequency:     SavedSearchSnapshotTypeImmediate,
+	})
+	if err != nil {
+		t.Fatalf("CreateSavedSearchSubscription failed: %v", err)
+	}
+
+	// Paginate through the results.
+	var keys []string
+	var token *string
+	for {
+		page, next, err := spannerClient.ListWatchedFeatures(ctx, ListWatchedFeaturesRequest{
+			UserID:    userID,
+			PageSize:  2,
+			PageToken: token,
+		})
+		if err != nil {
+			t.Fatalf("ListWatchedFeatures failed: %v", err)
+		}
+		for _, w := range page {
+			keys = append(keys, w.FeatureKey)
+		}
+		if next == nil {
+			break
+		}
+		token = next
+	}
+	if len(keys) != 3 {
+		t.Fatalf("expected 3 watched features, got %v", keys)
+	}
+
+	// Unwatch f1 on the email channel only.
+	err = spannerClient.UnwatchFeature(ctx, UnwatchFeatureRequest{
+		UserID:     userID,
+		FeatureKey: "f1",
+		ChannelID:  &channelID,
+	})
+	if err != nil {
+		t.Fatalf("UnwatchFeature (channel) failed: %v", err)
+	}
+	page, _, err := spannerClient.ListWatchedFeatures(ctx, ListWatchedFeaturesRequest{
+		UserID:    userID,
+		PageSize:  10,
+		PageToken: nil,
+	})
+	if err != nil {
+		t.Fatalf("ListWatchedFeatures failed: %v", err)
+	}
+	if len(page) != 2 {
+		t.Fatalf("expected 2 watched features, got %d", len(page))
+	}
+
+	// Unwatch f1 on the remaining channels.
+	err = spannerClient.UnwatchFeature(ctx, UnwatchFeatureRequest{
+		UserID:     userID,
+		FeatureKey: "f1",
+		ChannelID:  nil,
+	})
+	if err != nil {
+		t.Fatalf("UnwatchFeature (all) failed: %v", err)
+	}
+	page, _, err = spannerClient.ListWatchedFeatures(ctx, ListWatchedFeaturesRequest{
+		UserID:    userID,
+		PageSize:  10,
+		PageToken: nil,
+	})
+	if err != nil {
+		t.Fatalf("ListWatchedFeatures failed: %v", err)
+	}
+	if len(page) != 1 || page[0].FeatureKey != "f2" {
+		t.Fatalf("expected only f2 to be watched, got %+v", page)
+	}
+
+	// Unwatching a feature that is not watched returns an error.
+	err = spannerClient.UnwatchFeature(ctx, UnwatchFeatureRequest{
+		UserID:     userID,
+		FeatureKey: "f1",
+		ChannelID:  nil,
+	})
+	if !errors.Is(err, ErrQueryReturnedNoResults) {
+		t.Errorf("expected ErrQueryReturnedNoResults, got %v", err)
+	}
+}
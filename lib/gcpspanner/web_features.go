@@ -67,14 +67,17 @@ func (m webFeatureSpannerMapper) createSystemManagedSavedSearchMutations(
 	description := fmt.Sprintf("A system-managed saved search for the feature %s", entity.Name)
 	savedSearchID := uuid.NewString()
 	savedSearch := SavedSearch{
-		ID:          savedSearchID,
-		Name:        systemSavedSearchName(entity.Name),
-		Query:       systemSavedSearchQuery(entity.FeatureKey),
-		Description: &description,
-		AuthorID:    systemAuthorID,
-		Scope:       SystemManagedScope,
-		CreatedAt:   spanner.CommitTimestamp,
-		UpdatedAt:   spanner.CommitTimestamp,
+		ID:              savedSearchID,
+		Name:            systemSavedSearchName(entity.Name),
+		Query:           systemSavedSearchQuery(entity.FeatureKey),
+		Description:     &description,
+		AuthorID:        systemAuthorID,
+		Scope:           SystemManagedScope,
+		CreatedAt:       spanner.CommitTimestamp,
+		UpdatedAt:       spanner.CommitTimestamp,
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: spanner.NullJSON{Value: nil, Valid: false},
 	}
 	savedSearchMutation, err := spanner.InsertStruct(savedSearchesTable, &savedSearch)
 	if err != nil {
@@ -110,6 +110,7 @@ func (w *webFeatureForeignKeyTestHelpers) insertBrowserFeatureAvailability(ctx c
 		BrowserName:    "fooBrowser",
 		BrowserVersion: "0.0.0",
 		ReleaseDate:    time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC),
+		ReleaseStatus:  nil,
 	})
 	if err != nil {
 		t.Errorf("unexpected error during insert. %s", err.Error())
@@ -238,6 +239,7 @@ func (w *webFeatureForeignKeyTestHelpers) insertBrowserFeatureSupportEvent(ctx c
 		BrowserName:    "barBrowser",
 		BrowserVersion: "0.0.0",
 		ReleaseDate:    time.Date(2022, time.January, 2, 0, 0, 0, 0, time.UTC),
+		ReleaseStatus:  nil,
 	})
 	if err != nil {
 		t.Errorf("unexpected error during insert. %s", err.Error())
@@ -492,10 +492,13 @@ func setupRedirectDataAndAssert(
 
 	// Insert dummy SavedSearch to satisfy foreign key constraint
 	_, err = spannerClient.CreateNewUserSavedSearchWithUUID(ctx, CreateUserSavedSearchRequest{
-		Name:        "Test Search",
-		Description: nil,
-		Query:       "query",
-		OwnerUserID: "test-author",
+		Name:            "Test Search",
+		Description:     nil,
+		Query:           "query",
+		OwnerUserID:     "test-author",
+		Tags:            nil,
+		Listed:          false,
+		QueryParameters: nil,
 	}, "test-saved-search-id")
 	if err != nil {
 		t.Fatalf("Failed to insert dummy SavedSearch: %v", err)
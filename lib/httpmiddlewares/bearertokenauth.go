@@ -34,12 +34,51 @@ type BearerTokenAuthenticator interface {
 	Authenticate(ctx context.Context, token string) (*auth.User, error)
 }
 
+// prefixedTokenAuthenticator is an authenticator used for the tokens that start with prefix.
+type prefixedTokenAuthenticator struct {
+	prefix        string
+	authenticator BearerTokenAuthenticator
+}
+
+type bearerTokenAuthenticationConfig struct {
+	prefixedAuthenticators []prefixedTokenAuthenticator
+}
+
+// BearerTokenAuthenticationOption customizes the middleware returned by NewBearerTokenAuthenticationMiddleware.
+type BearerTokenAuthenticationOption func(*bearerTokenAuthenticationConfig)
+
+// WithPrefixedTokenAuthenticator authenticates the tokens that start with prefix using the given authenticator
+// instead of the default one. This allows other kinds of tokens, like personal access tokens, to be accepted.
+func WithPrefixedTokenAuthenticator(prefix string,
+	authenticator BearerTokenAuthenticator) BearerTokenAuthenticationOption {
+	return func(c *bearerTokenAuthenticationConfig) {
+		c.prefixedAuthenticators = append(c.prefixedAuthenticators, prefixedTokenAuthenticator{
+			prefix:        prefix,
+			authenticator: authenticator,
+		})
+	}
+}
+
+// authenticatorForToken returns the authenticator for the token.
+func (c bearerTokenAuthenticationConfig) authenticatorForToken(
+	token string, defaultAuthenticator BearerTokenAuthenticator) BearerTokenAuthenticator {
+	for _, p := range c.prefixedAuthenticators {
+		if strings.HasPrefix(token, p.prefix) {
+			return p.authenticator
+		}
+	}
+
+	return defaultAuthenticator
+}
+
 // NewBearerTokenAuthenticationMiddleware returns a middleware that can be used to authenticate requests.
 // It detects if a route requires authentication by checking if a field (authCtxKey) is set in the request context.
 // If the authCtxKey field is set and the Authorization header is present, the middleware authenticates the user and
 // sets the authenticated user in the context. If both authCtxKey and optionalAuthCtxKey fields are set and the
 // Authorization header is not present, it allows the request to proceed without authentication.
 //
+// Additional authenticators can be registered with options, such as WithPrefixedTokenAuthenticator.
+//
 // The errorFn parameter allows the caller to customize the error response returned when authentication fails.
 // This makes the middleware more generic and adaptable to different error handling requirements.
 //
@@ -51,7 +90,13 @@ type BearerTokenAuthenticator interface {
 // support.
 func NewBearerTokenAuthenticationMiddleware(authenticator BearerTokenAuthenticator,
 	authCtxKey any, optionalAuthCtxKey any,
-	errorFn func(context.Context, int, http.ResponseWriter, error)) func(http.Handler) http.Handler {
+	errorFn func(context.Context, int, http.ResponseWriter, error),
+	opts ...BearerTokenAuthenticationOption) func(http.Handler) http.Handler {
+	var cfg bearerTokenAuthenticationConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			value := r.Context().Value(authCtxKey)
@@ -83,7 +128,8 @@ func NewBearerTokenAuthenticationMiddleware(authenticator BearerTokenAuthenticat
 				return
 			}
 
-			u, err := authenticator.Authenticate(r.Context(), strings.TrimPrefix(authHdr, prefix))
+			token := strings.TrimPrefix(authHdr, prefix)
+			u, err := cfg.authenticatorForToken(token, authenticator).Authenticate(r.Context(), token)
 			if err != nil {
 				errorFn(r.Context(), http.StatusUnauthorized, w, err)
 
@@ -181,6 +181,7 @@ func TestBearerTokenAuthenticationMiddleware(t *testing.T) {
 				return &auth.User{
 					ID:           testID,
 					GitHubUserID: nil,
+					TokenScope:   nil,
 				}, nil
 			},
 			mockErrorFn: func(_ context.Context, _ int, _ http.ResponseWriter, _ error) {
@@ -191,6 +192,7 @@ func TestBearerTokenAuthenticationMiddleware(t *testing.T) {
 			expectedUser: &auth.User{
 				ID:           testID,
 				GitHubUserID: nil,
+				TokenScope:   nil,
 			},
 		},
 	}
@@ -228,6 +230,74 @@ func TestBearerTokenAuthenticationMiddleware(t *testing.T) {
 	}
 }
 
+func TestBearerTokenAuthenticationMiddleware_PrefixedTokenAuthenticator(t *testing.T) {
+	defaultUser := &auth.User{ID: "default", GitHubUserID: nil, TokenScope: nil}
+	scope := auth.TokenScopeReadOnly
+	prefixedUser := &auth.User{ID: "prefixed", GitHubUserID: nil, TokenScope: &scope}
+	defaultAuthenticator := &mockBearerTokenAuthenticator{
+		authenticateFn: func(_ context.Context, token string) (*auth.User, error) {
+			if token != "id-token" {
+				t.Errorf("unexpected token for the default authenticator %q", token)
+			}
+
+			return defaultUser, nil
+		},
+	}
+	prefixedAuthenticator := &mockBearerTokenAuthenticator{
+		authenticateFn: func(_ context.Context, token string) (*auth.User, error) {
+			if token != "pat_token" {
+				t.Errorf("unexpected token for the prefixed authenticator %q", token)
+			}
+
+			return prefixedUser, nil
+		},
+	}
+	errorFn := func(_ context.Context, _ int, _ http.ResponseWriter, err error) {
+		t.Fatalf("errorFn should not have been called: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		authHeader   string
+		expectedUser *auth.User
+	}{
+		{
+			name:         "Default authenticator",
+			authHeader:   "Bearer id-token",
+			expectedUser: defaultUser,
+		},
+		{
+			name:         "Prefixed authenticator",
+			authHeader:   "Bearer pat_token",
+			expectedUser: prefixedUser,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			nextHandler := http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				u, _ := AuthenticatedUserFromContext(r.Context())
+				if !reflect.DeepEqual(u, tc.expectedUser) {
+					t.Errorf("expected user %+v, received user %+v", tc.expectedUser, u)
+				}
+			})
+			middleware := NewBearerTokenAuthenticationMiddleware(
+				defaultAuthenticator,
+				authCtxKey{},
+				nil,
+				errorFn,
+				WithPrefixedTokenAuthenticator("pat_", prefixedAuthenticator),
+			)
+
+			req := createTestRequest(t.Context(), tc.authHeader, authCtxKey{}, nil)
+			rr := httptest.NewRecorder()
+			middleware(nextHandler).ServeHTTP(rr, req)
+
+			assertStatusCode(t, rr, http.StatusOK)
+		})
+	}
+}
+
 type mockBearerTokenAuthenticator struct {
 	authenticateFn func(ctx context.Context, token string) (*auth.User, error)
 }
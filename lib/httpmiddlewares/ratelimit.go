@@ -0,0 +1,152 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpmiddlewares
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/cachetypes"
+)
+
+var ErrRateLimitExceeded = errors.New("rate limit exceeded")
+
+// RateLimiter takes tokens from the token bucket of a client.
+type RateLimiter interface {
+	Take(ctx context.Context, key string, cost int64) (*cachetypes.TokenBucketResult, error)
+}
+
+type rateLimitConfig struct {
+	costFn            func(*http.Request) int64
+	trustedProxyCount int
+}
+
+// RateLimitOption customizes the middleware returned by NewRateLimitMiddleware.
+type RateLimitOption func(*rateLimitConfig)
+
+// WithRateLimitCost sets the function that returns the number of tokens a request costs.
+// Requests that cost zero tokens are not rate limited. By default, every request costs one token.
+func WithRateLimitCost(costFn func(*http.Request) int64) RateLimitOption {
+	return func(c *rateLimitConfig) {
+		c.costFn = costFn
+	}
+}
+
+// WithTrustedProxies identifies anonymous clients by the address added to the X-Forwarded-For header by the
+// outermost of count trusted proxies, instead of the address of the connection.
+// The entries before it are set by the client and cannot be trusted.
+func WithTrustedProxies(count int) RateLimitOption {
+	return func(c *rateLimitConfig) {
+		c.trustedProxyCount = count
+	}
+}
+
+// NewRateLimitMiddleware returns a middleware that limits the rate of requests of each client using token buckets.
+// Authenticated users are identified by their ID and anonymous clients by their IP address. As a result, it
+// must run after the authentication middleware to tell users apart.
+//
+// Every response has the RateLimit-Limit, RateLimit-Remaining and RateLimit-Reset headers. When the bucket of the
+// client does not have enough tokens, errorFn is called with http.StatusTooManyRequests and the Retry-After header
+// is set.
+//
+// If the limiter fails, the request is allowed so that an outage of the limiter does not take down the service.
+func NewRateLimitMiddleware(limiter RateLimiter,
+	errorFn func(context.Context, int, http.ResponseWriter, error),
+	opts ...RateLimitOption) func(http.Handler) http.Handler {
+	cfg := rateLimitConfig{
+		costFn:            func(_ *http.Request) int64 { return 1 },
+		trustedProxyCount: 0,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			cost := cfg.costFn(r)
+			if cost <= 0 {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			key := cfg.clientKey(r)
+			result, err := limiter.Take(r.Context(), key, cost)
+			if err != nil {
+				slog.WarnContext(r.Context(), "unable to check rate limit. allowing request", "error", err)
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			setRateLimitHeaders(w.Header(), result)
+			if !result.Allowed {
+				w.Header().Set("Retry-After", strconv.FormatInt(ceilSeconds(result.RetryAfter), 10))
+				errorFn(r.Context(), http.StatusTooManyRequests, w, ErrRateLimitExceeded)
+
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func setRateLimitHeaders(h http.Header, result *cachetypes.TokenBucketResult) {
+	h.Set("RateLimit-Limit", strconv.FormatInt(result.Capacity, 10))
+	h.Set("RateLimit-Remaining", strconv.FormatInt(max(result.Remaining, 0), 10))
+	h.Set("RateLimit-Reset", strconv.FormatInt(ceilSeconds(result.ResetAfter), 10))
+}
+
+func ceilSeconds(d time.Duration) int64 {
+	return int64(math.Ceil(d.Seconds()))
+}
+
+// clientKey returns the key of the token bucket of the client that sent the request.
+func (c rateLimitConfig) clientKey(r *http.Request) string {
+	if u, ok := AuthenticatedUserFromContext(r.Context()); ok && u != nil {
+		return "user:" + u.ID
+	}
+
+	return "ip:" + c.clientIP(r)
+}
+
+func (c rateLimitConfig) clientIP(r *http.Request) string {
+	if c.trustedProxyCount > 0 {
+		var addrs []string
+		for _, value := range r.Header.Values("X-Forwarded-For") {
+			for addr := range strings.SplitSeq(value, ",") {
+				addrs = append(addrs, strings.TrimSpace(addr))
+			}
+		}
+		if len(addrs) >= c.trustedProxyCount {
+			return addrs[len(addrs)-c.trustedProxyCount]
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
@@ -0,0 +1,218 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpmiddlewares
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/auth"
+	"github.com/GoogleChrome/webstatus.dev/lib/cachetypes"
+)
+
+type mockRateLimiter struct {
+	t            *testing.T
+	expectedKey  string
+	expectedCost int64
+	result       *cachetypes.TokenBucketResult
+	err          error
+	callCount    int
+}
+
+func (m *mockRateLimiter) Take(_ context.Context, key string, cost int64) (*cachetypes.TokenBucketResult, error) {
+	m.callCount++
+	if key != m.expectedKey || cost != m.expectedCost {
+		m.t.Errorf("unexpected input key %q cost %d", key, cost)
+	}
+
+	return m.result, m.err
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	allowed := &cachetypes.TokenBucketResult{
+		Allowed:    true,
+		Capacity:   100,
+		Remaining:  95,
+		RetryAfter: 0,
+		ResetAfter: 2500 * time.Millisecond,
+	}
+	limited := &cachetypes.TokenBucketResult{
+		Allowed:    false,
+		Capacity:   100,
+		Remaining:  2,
+		RetryAfter: 1200 * time.Millisecond,
+		ResetAfter: 49 * time.Second,
+	}
+	tests := []struct {
+		name               string
+		user               *auth.User
+		remoteAddr         string
+		forwardedFor       []string
+		opts               []RateLimitOption
+		expectedKey        string
+		expectedCost       int64
+		result             *cachetypes.TokenBucketResult
+		limiterErr         error
+		expectedCallCount  int
+		expectedStatusCode int
+		expectedHeaders    map[string]string
+	}{
+		{
+			name:               "allowed anonymous request",
+			user:               nil,
+			remoteAddr:         "192.0.2.1:1234",
+			forwardedFor:       nil,
+			opts:               nil,
+			expectedKey:        "ip:192.0.2.1",
+			expectedCost:       1,
+			result:             allowed,
+			limiterErr:         nil,
+			expectedCallCount:  1,
+			expectedStatusCode: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"RateLimit-Limit":     "100",
+				"RateLimit-Remaining": "95",
+				"RateLimit-Reset":     "3",
+				"Retry-After":         "",
+			},
+		},
+		{
+			name:               "limited authenticated request",
+			user:               &auth.User{ID: "user1", GitHubUserID: nil, TokenScope: nil},
+			remoteAddr:         "192.0.2.1:1234",
+			forwardedFor:       nil,
+			opts:               nil,
+			expectedKey:        "user:user1",
+			expectedCost:       1,
+			result:             limited,
+			limiterErr:         nil,
+			expectedCallCount:  1,
+			expectedStatusCode: http.StatusTooManyRequests,
+			expectedHeaders: map[string]string{
+				"RateLimit-Limit":     "100",
+				"RateLimit-Remaining": "2",
+				"RateLimit-Reset":     "49",
+				"Retry-After":         "2",
+			},
+		},
+		{
+			name:         "custom cost and trusted proxies",
+			user:         nil,
+			remoteAddr:   "10.0.0.1:1234",
+			forwardedFor: []string{"203.0.113.9, 198.51.100.7", "10.0.0.2"},
+			opts: []RateLimitOption{
+				WithTrustedProxies(2),
+				WithRateLimitCost(func(_ *http.Request) int64 { return 5 }),
+			},
+			expectedKey:        "ip:198.51.100.7",
+			expectedCost:       5,
+			result:             allowed,
+			limiterErr:         nil,
+			expectedCallCount:  1,
+			expectedStatusCode: http.StatusOK,
+			expectedHeaders:    map[string]string{"RateLimit-Remaining": "95"},
+		},
+		{
+			name:               "too few forwarded addresses falls back to the connection address",
+			user:               nil,
+			remoteAddr:         "192.0.2.1:1234",
+			forwardedFor:       []string{"198.51.100.7"},
+			opts:               []RateLimitOption{WithTrustedProxies(2)},
+			expectedKey:        "ip:192.0.2.1",
+			expectedCost:       1,
+			result:             allowed,
+			limiterErr:         nil,
+			expectedCallCount:  1,
+			expectedStatusCode: http.StatusOK,
+			expectedHeaders:    nil,
+		},
+		{
+			name:               "free request",
+			user:               nil,
+			remoteAddr:         "192.0.2.1:1234",
+			forwardedFor:       nil,
+			opts:               []RateLimitOption{WithRateLimitCost(func(_ *http.Request) int64 { return 0 })},
+			expectedKey:        "",
+			expectedCost:       0,
+			result:             nil,
+			limiterErr:         nil,
+			expectedCallCount:  0,
+			expectedStatusCode: http.StatusOK,
+			expectedHeaders:    map[string]string{"RateLimit-Limit": ""},
+		},
+		{
+			name:               "limiter failure allows the request",
+			user:               nil,
+			remoteAddr:         "192.0.2.1:1234",
+			forwardedFor:       nil,
+			opts:               nil,
+			expectedKey:        "ip:192.0.2.1",
+			expectedCost:       1,
+			result:             nil,
+			limiterErr:         errors.New("valkey is down"),
+			expectedCallCount:  1,
+			expectedStatusCode: http.StatusOK,
+			expectedHeaders:    map[string]string{"RateLimit-Limit": ""},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			limiter := &mockRateLimiter{
+				t:            t,
+				expectedKey:  tc.expectedKey,
+				expectedCost: tc.expectedCost,
+				result:       tc.result,
+				err:          tc.limiterErr,
+				callCount:    0,
+			}
+			errorFn := func(_ context.Context, code int, w http.ResponseWriter, err error) {
+				if !errors.Is(err, ErrRateLimitExceeded) {
+					t.Errorf("unexpected error %v", err)
+				}
+				w.WriteHeader(code)
+			}
+			nextHandler := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+			handler := NewRateLimitMiddleware(limiter, errorFn, tc.opts...)(nextHandler)
+
+			req := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil)
+			req.RemoteAddr = tc.remoteAddr
+			for _, value := range tc.forwardedFor {
+				req.Header.Add("X-Forwarded-For", value)
+			}
+			if tc.user != nil {
+				req = req.WithContext(AuthenticatedUserToContext(req.Context(), tc.user))
+			}
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, req)
+
+			assertStatusCode(t, rr, tc.expectedStatusCode)
+			if limiter.callCount != tc.expectedCallCount {
+				t.Errorf("expected %d calls to the limiter, got %d", tc.expectedCallCount, limiter.callCount)
+			}
+			for header, expected := range tc.expectedHeaders {
+				if got := rr.Header().Get(header); got != expected {
+					t.Errorf("expected header %s to be %q, got %q", header, expected, got)
+				}
+			}
+		})
+	}
+}
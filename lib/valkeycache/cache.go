@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/GoogleChrome/webstatus.dev/lib/cachetypes"
@@ -42,6 +43,20 @@ func NewValkeyDataCache[K comparable, V []byte](
 	port string, // Will likely come from the environment variable as a string
 	ttl time.Duration) (*ValkeyDataCache[K, V], error) {
 
+	c, err := newValkeyClient(host, port)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ValkeyDataCache[K, V]{
+		keyPrefix: keyPrefix,
+		client:    c,
+		ttl:       ttl,
+	}, nil
+}
+
+// newValkeyClient connects to the Valkey server, retrying while the server is starting.
+func newValkeyClient(host string, port string) (valkey.Client, error) {
 	addr := fmt.Sprintf("%s:%s", host, port)
 	operation := func() (valkey.Client, error) {
 		// nolint: exhaustruct // No need to use every option of 3rd party struct.
@@ -50,21 +65,12 @@ func NewValkeyDataCache[K comparable, V []byte](
 		})
 	}
 
-	c, err := backoff.Retry(context.TODO(), operation,
+	return backoff.Retry(context.TODO(), operation,
 		backoff.WithBackOff(backoff.NewExponentialBackOff()),
 		// Should be less than the total time in the startup probe for the backend container in
 		// infra/backend/service.tf
 		backoff.WithMaxElapsedTime(25*time.Second),
 	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &ValkeyDataCache[K, V]{
-		keyPrefix: keyPrefix,
-		client:    c,
-		ttl:       ttl,
-	}, nil
 }
 
 func (c *ValkeyDataCache[K, V]) cacheKey(key K) string {
@@ -113,3 +119,38 @@ func (c *ValkeyDataCache[K, V]) Get(
 
 	return msg.AsBytes()
 }
+
+// deleteByPrefixScanCount is the number of keys inspected by each SCAN iteration of DeleteByPrefix.
+const deleteByPrefixScanCount = 1000
+
+// DeleteByPrefix removes every entry whose key starts with the given prefix and returns the number of entries
+// removed. It iterates over the keyspace with SCAN so that it does not block the server, which means entries
+// added while it runs may be missed.
+func (c *ValkeyDataCache[K, V]) DeleteByPrefix(ctx context.Context, prefix string) (int64, error) {
+	// Escape the characters that have a special meaning in the patterns of SCAN MATCH.
+	globEscaper := strings.NewReplacer(`\`, `\\`, "*", `\*`, "?", `\?`, "[", `\[`, "]", `\]`)
+	pattern := globEscaper.Replace(c.keyPrefix+"-"+prefix) + "*"
+	var deleted int64
+	var cursor uint64
+	for {
+		entry, err := c.client.Do(ctx, c.client.B().Scan().Cursor(cursor).Match(pattern).
+			Count(deleteByPrefixScanCount).Build()).AsScanEntry()
+		if err != nil {
+			return deleted, err
+		}
+
+		if len(entry.Elements) > 0 {
+			// UNLINK frees the memory in the background unlike DEL.
+			count, err := c.client.Do(ctx, c.client.B().Unlink().Key(entry.Elements...).Build()).AsInt64()
+			if err != nil {
+				return deleted, err
+			}
+			deleted += count
+		}
+
+		cursor = entry.Cursor
+		if cursor == 0 {
+			return deleted, nil
+		}
+	}
+}
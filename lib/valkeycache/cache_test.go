@@ -70,6 +70,10 @@ func getTestValkey(t testing.TB) *ValkeyDataCache[string, []byte] {
 
 	t.Cleanup(func() {
 		cache.client.Close()
+		// Remove the container so that other tests can start one with the same name.
+		if err := container.Terminate(context.Background()); err != nil {
+			t.Error(err)
+		}
 	})
 
 	return cache
@@ -158,3 +162,43 @@ func TestValkeyDataCache(t *testing.T) {
 
 	})
 }
+
+func TestValkeyDataCacheDeleteByPrefix(t *testing.T) {
+	cache := getTestValkey(t)
+	ctx := context.Background()
+
+	keys := []string{"getFeature-a", "getFeature-b", "getFeatureMetadata-a", "listFeatures-a", "list*Features-a"}
+	for _, key := range keys {
+		if err := cache.Cache(ctx, key, []byte("value"), cachetypes.WithTTL(time.Minute)); err != nil {
+			t.Fatalf("unable to store value %v", err)
+		}
+	}
+
+	deleted, err := cache.DeleteByPrefix(ctx, "getFeature-")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if deleted != 2 {
+		t.Errorf("expected 2 deleted entries, got %d", deleted)
+	}
+
+	// The prefix is matched literally.
+	deleted, err = cache.DeleteByPrefix(ctx, "list*")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if deleted != 1 {
+		t.Errorf("expected 1 deleted entry, got %d", deleted)
+	}
+
+	for _, key := range []string{"getFeature-a", "getFeature-b", "list*Features-a"} {
+		if _, err := cache.Get(ctx, key); !errors.Is(err, cachetypes.ErrCachedDataNotFound) {
+			t.Errorf("expected %s to be deleted, got error %v", key, err)
+		}
+	}
+	for _, key := range []string{"getFeatureMetadata-a", "listFeatures-a"} {
+		if _, err := cache.Get(ctx, key); err != nil {
+			t.Errorf("expected %s to be kept, got error %v", key, err)
+		}
+	}
+}
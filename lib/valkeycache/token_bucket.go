@@ -0,0 +1,159 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package valkeycache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/cachetypes"
+	"github.com/valkey-io/valkey-go"
+)
+
+// tokenBucketScript atomically refills a bucket based on the time elapsed since the last request and takes the
+// cost of the request from it.
+// It uses the server time so that every instance of the service shares the same clock.
+//
+// KEYS[1]: the bucket key.
+// ARGV[1]: the capacity of the bucket.
+// ARGV[2]: the number of tokens added per second.
+// ARGV[3]: the cost of the request.
+//
+// Returns {allowed (0 or 1), remaining tokens, seconds until enough tokens are available, seconds until full}.
+// Fractional values are returned as strings because Lua numbers are truncated to integers in replies.
+const tokenBucketScript = `
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local cost = tonumber(ARGV[3])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) + tonumber(time[2]) / 1000000
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if tokens == nil or ts == nil then
+	tokens = capacity
+	ts = now
+end
+tokens = math.min(capacity, tokens + math.max(0, now - ts) * rate)
+
+local allowed = 0
+local retry_after = 0
+if tokens >= cost then
+	tokens = tokens - cost
+	allowed = 1
+else
+	retry_after = (cost - tokens) / rate
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+-- An idle bucket is full, so it does not need to be kept once it would be refilled.
+redis.call('PEXPIRE', KEYS[1], math.ceil(capacity / rate * 1000))
+
+return {allowed, tostring(tokens), tostring(retry_after), tostring((capacity - tokens) / rate)}
+`
+
+// tokenBucketScriptReplyLength is the number of values returned by tokenBucketScript.
+const tokenBucketScriptReplyLength = 4
+
+var ErrInvalidTokenBucketConfig = errors.New("token bucket capacity and refill rate must be positive")
+
+// ValkeyTokenBucketLimiter stores token buckets in Valkey so that every instance of a service shares them.
+type ValkeyTokenBucketLimiter struct {
+	keyPrefix       string
+	client          valkey.Client
+	script          *valkey.Lua
+	capacity        int64
+	refillPerSecond float64
+}
+
+// NewValkeyTokenBucketLimiter creates a limiter where each bucket holds up to capacity tokens and is refilled with
+// refillPerSecond tokens every second.
+func NewValkeyTokenBucketLimiter(
+	keyPrefix string,
+	host string,
+	port string,
+	capacity int64,
+	refillPerSecond float64) (*ValkeyTokenBucketLimiter, error) {
+	if capacity <= 0 || refillPerSecond <= 0 {
+		return nil, ErrInvalidTokenBucketConfig
+	}
+
+	c, err := newValkeyClient(host, port)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ValkeyTokenBucketLimiter{
+		keyPrefix:       keyPrefix,
+		client:          c,
+		script:          valkey.NewLuaScript(tokenBucketScript),
+		capacity:        capacity,
+		refillPerSecond: refillPerSecond,
+	}, nil
+}
+
+func (l *ValkeyTokenBucketLimiter) bucketKey(key string) string {
+	return fmt.Sprintf("%s-%s", l.keyPrefix, key)
+}
+
+// Take takes cost tokens from the bucket of the key if the bucket has enough tokens.
+func (l *ValkeyTokenBucketLimiter) Take(
+	ctx context.Context, key string, cost int64) (*cachetypes.TokenBucketResult, error) {
+	reply, err := l.script.Exec(ctx, l.client, []string{l.bucketKey(key)}, []string{
+		strconv.FormatInt(l.capacity, 10),
+		strconv.FormatFloat(l.refillPerSecond, 'f', -1, 64),
+		strconv.FormatInt(cost, 10),
+	}).ToArray()
+	if err != nil {
+		return nil, err
+	}
+	if len(reply) != tokenBucketScriptReplyLength {
+		return nil, fmt.Errorf("unexpected token bucket reply length %d", len(reply))
+	}
+
+	allowed, err := reply[0].AsInt64()
+	if err != nil {
+		return nil, err
+	}
+	values := make([]float64, 0, tokenBucketScriptReplyLength-1)
+	for _, msg := range reply[1:] {
+		s, err := msg.ToString()
+		if err != nil {
+			return nil, err
+		}
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+
+	return &cachetypes.TokenBucketResult{
+		Allowed:    allowed == 1,
+		Capacity:   l.capacity,
+		Remaining:  int64(math.Floor(values[0])),
+		RetryAfter: secondsToDuration(values[1]),
+		ResetAfter: secondsToDuration(values[2]),
+	}, nil
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package valkeycache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/valkey-io/valkey-go"
+)
+
+func TestValkeyTokenBucketLimiter(t *testing.T) {
+	cache := getTestValkey(t)
+	ctx := context.Background()
+	limiter := &ValkeyTokenBucketLimiter{
+		keyPrefix:       "testLimiter",
+		client:          cache.client,
+		script:          valkey.NewLuaScript(tokenBucketScript),
+		capacity:        3,
+		refillPerSecond: 1,
+	}
+
+	// A new bucket starts full.
+	result, err := limiter.Take(ctx, "client1", 2)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !result.Allowed || result.Remaining != 1 || result.Capacity != 3 || result.RetryAfter != 0 {
+		t.Errorf("unexpected result %+v", result)
+	}
+
+	// Not enough tokens left.
+	result, err = limiter.Take(ctx, "client1", 2)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if result.Allowed || result.Remaining != 1 {
+		t.Errorf("expected request to be limited %+v", result)
+	}
+	if result.RetryAfter <= 0 || result.RetryAfter > time.Second {
+		t.Errorf("unexpected retry after %s", result.RetryAfter)
+	}
+
+	// Other keys have their own bucket.
+	result, err = limiter.Take(ctx, "client2", 3)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !result.Allowed || result.Remaining != 0 {
+		t.Errorf("unexpected result for other key %+v", result)
+	}
+
+	// The bucket is refilled over time.
+	time.Sleep(2 * time.Second)
+	result, err = limiter.Take(ctx, "client1", 2)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !result.Allowed {
+		t.Errorf("expected request to be allowed after refill %+v", result)
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workertypes
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidQuietHours = errors.New("invalid quiet hours")
+)
+
+// quietHoursLayout is the layout of the local start and end times of quiet hours.
+const quietHoursLayout = "15:04"
+
+// QuietHours is a daily window, in the local time of a time zone, during which a channel
+// receives no deliveries.
+type QuietHours struct {
+	// Start and End are local times in the "HH:MM" format.
+	// If End is before Start, the window spans midnight.
+	Start string
+	End   string
+	// TimeZone is an IANA time zone name (e.g. "America/Los_Angeles").
+	TimeZone string
+}
+
+// ChannelDeliverySettings are the user configured limits of a notification channel.
+// Deliveries that fall outside of these limits are held and coalesced into a single delivery.
+type ChannelDeliverySettings struct {
+	QuietHours *QuietHours
+	// MaxDeliveriesPerHour caps the deliveries of the channel per clock hour.
+	MaxDeliveriesPerHour *int64
+}
+
+// IsEmpty returns true if the settings do not limit the deliveries of the channel.
+func (s *ChannelDeliverySettings) IsEmpty() bool {
+	return s == nil || (s.QuietHours == nil && s.MaxDeliveriesPerHour == nil)
+}
+
+// HeldDeliveryJob is a delivery job that was held back by the delivery settings of its channel.
+// Exactly one of Email and Webhook is set.
+type HeldDeliveryJob struct {
+	Email   *EmailDeliveryJob
+	Webhook *WebhookDeliveryJob
+	// HeldCount is the number of jobs coalesced into this one.
+	HeldCount int64
+	// LeaseID identifies the claim of the job. The job stays held until the claim is completed or
+	// rescheduled, or until the lease expires.
+	LeaseID string
+	// EventIDs are the events coalesced into the job. Completing the claim removes only these events, so
+	// that jobs held while the claim was active are not lost.
+	EventIDs []string
+}
+
+// ChannelID returns the channel of the held job.
+func (j HeldDeliveryJob) ChannelID() string {
+	switch {
+	case j.Email != nil:
+		return j.Email.ChannelID
+	case j.Webhook != nil:
+		return j.Webhook.ChannelID
+	}
+
+	return ""
+}
+
+// SubscriptionID returns the subscription of the held job.
+func (j HeldDeliveryJob) SubscriptionID() string {
+	switch {
+	case j.Email != nil:
+		return j.Email.SubscriptionID
+	case j.Webhook != nil:
+		return j.Webhook.SubscriptionID
+	}
+
+	return ""
+}
+
+func parseQuietHoursTime(value string) (int, error) {
+	t, err := time.Parse(quietHoursLayout, value)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q is not a HH:MM time", ErrInvalidQuietHours, value)
+	}
+
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Validate returns an error if the quiet hours can not be evaluated.
+func (q QuietHours) Validate() error {
+	start, err := parseQuietHoursTime(q.Start)
+	if err != nil {
+		return err
+	}
+	end, err := parseQuietHoursTime(q.End)
+	if err != nil {
+		return err
+	}
+	if start == end {
+		return fmt.Errorf("%w: start and end must differ", ErrInvalidQuietHours)
+	}
+	if _, err := time.LoadLocation(q.TimeZone); err != nil || q.TimeZone == "" {
+		return fmt.Errorf("%w: unknown time zone %q", ErrInvalidQuietHours, q.TimeZone)
+	}
+
+	return nil
+}
+
+// EndsAt returns the time at which the quiet hours that contain t end.
+// The boolean is false if t is not within quiet hours.
+func (q QuietHours) EndsAt(t time.Time) (time.Time, bool, error) {
+	if err := q.Validate(); err != nil {
+		return time.Time{}, false, err
+	}
+	// Validate already checked the values.
+	start, _ := parseQuietHoursTime(q.Start)
+	end, _ := parseQuietHoursTime(q.End)
+	loc, _ := time.LoadLocation(q.TimeZone)
+
+	local := t.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	endOn := func(days int) time.Time {
+		// time.Date normalizes local times that do not exist because of a DST transition.
+		return time.Date(local.Year(), local.Month(), local.Day()+days, end/60, end%60, 0, 0, loc)
+	}
+
+	if start < end {
+		if minute >= start && minute < end {
+			return endOn(0), true, nil
+		}
+
+		return time.Time{}, false, nil
+	}
+
+	// The window spans midnight.
+	switch {
+	case minute >= start:
+		return endOn(1), true, nil
+	case minute < end:
+		return endOn(0), true, nil
+	}
+
+	return time.Time{}, false, nil
+}
+
+// CoalesceEventSummaries merges the summary of a held delivery with the summary of a newer event
+// so that both can be delivered at once.
+// Counts are added up, highlights are concatenated (capped at MaxHighlights) and the query errors
+// of the newer summary win since they describe the current state of the search.
+func CoalesceEventSummaries(held, incoming []byte) ([]byte, error) {
+	var older, newer EventSummary
+	if err := json.Unmarshal(held, &older); err != nil {
+		return nil, fmt.Errorf("invalid held summary json: %w", err)
+	}
+	if err := json.Unmarshal(incoming, &newer); err != nil {
+		return nil, fmt.Errorf("invalid incoming summary json: %w", err)
+	}
+	if older.SchemaVersion != VersionEventSummaryV1 {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownSummaryVersion, older.SchemaVersion)
+	}
+	if newer.SchemaVersion != VersionEventSummaryV1 {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownSummaryVersion, newer.SchemaVersion)
+	}
+
+	merged := newer
+	merged.Categories = addSummaryCategories(older.Categories, newer.Categories)
+	merged.Text = summaryCategoriesText(merged.Categories)
+	merged.Highlights = slices.Concat(older.Highlights, newer.Highlights)
+	merged.Truncated = older.Truncated || newer.Truncated
+	if len(merged.Highlights) > MaxHighlights {
+		merged.Highlights = merged.Highlights[:MaxHighlights]
+		merged.Truncated = true
+	}
+	for _, e := range older.ResolvedQueryErrors {
+		if !slices.Contains(merged.ResolvedQueryErrors, e) && !slices.Contains(merged.QueryErrors, e) {
+			merged.ResolvedQueryErrors = append(merged.ResolvedQueryErrors, e)
+		}
+	}
+
+	b, err := json.Marshal(merged)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToSerializeSummary, err)
+	}
+
+	return b, nil
+}
+
+func addSummaryCategories(a, b SummaryCategories) SummaryCategories {
+	return SummaryCategories{
+		QueryChanged:    a.QueryChanged + b.QueryChanged,
+		Added:           a.Added + b.Added,
+		Removed:         a.Removed + b.Removed,
+		Deleted:         a.Deleted + b.Deleted,
+		Moved:           a.Moved + b.Moved,
+		Split:           a.Split + b.Split,
+		Updated:         a.Updated + b.Updated,
+		UpdatedImpl:     a.UpdatedImpl + b.UpdatedImpl,
+		UpdatedRename:   a.UpdatedRename + b.UpdatedRename,
+		UpdatedBaseline: a.UpdatedBaseline + b.UpdatedBaseline,
+	}
+}
+
+// summaryCategoriesText mirrors the text of FeatureDiffV1SummaryGenerator for coalesced summaries,
+// which only have the counts left.
+func summaryCategoriesText(c SummaryCategories) string {
+	var parts []string
+	if c.QueryChanged > 0 {
+		parts = append(parts, "Search criteria updated")
+	}
+	if c.Added > 0 {
+		parts = append(parts, pluralize(c.Added, "new feature matched your search",
+			"new features matched your search"))
+	}
+	if c.Removed > 0 {
+		parts = append(parts, pluralize(c.Removed, "feature no longer matched your search",
+			"features no longer matched your search"))
+	}
+	if c.Deleted > 0 {
+		parts = append(parts, pluralize(c.Deleted, "feature deleted", "features deleted"))
+	}
+	if c.Moved > 0 {
+		parts = append(parts, pluralize(c.Moved, "feature moved/renamed", "features moved/renamed"))
+	}
+	if c.Split > 0 {
+		parts = append(parts, pluralize(c.Split, "feature split", "features split"))
+	}
+	if c.Updated > 0 {
+		parts = append(parts, pluralize(c.Updated, "feature updated", "features updated"))
+	}
+	if len(parts) == 0 {
+		return "No changes detected"
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,257 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workertypes
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestQuietHoursValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		hours   QuietHours
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			hours:   QuietHours{Start: "22:00", End: "07:30", TimeZone: "Europe/Berlin"},
+			wantErr: false,
+		},
+		{
+			name:    "bad start",
+			hours:   QuietHours{Start: "25:00", End: "07:00", TimeZone: "UTC"},
+			wantErr: true,
+		},
+		{
+			name:    "bad end",
+			hours:   QuietHours{Start: "22:00", End: "7am", TimeZone: "UTC"},
+			wantErr: true,
+		},
+		{
+			name:    "empty window",
+			hours:   QuietHours{Start: "08:00", End: "08:00", TimeZone: "UTC"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown time zone",
+			hours:   QuietHours{Start: "22:00", End: "07:00", TimeZone: "Mars/Olympus_Mons"},
+			wantErr: true,
+		},
+		{
+			name:    "missing time zone",
+			hours:   QuietHours{Start: "22:00", End: "07:00", TimeZone: ""},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.hours.Validate()
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidQuietHours) {
+				t.Errorf("expected ErrInvalidQuietHours, got %v", err)
+			}
+		})
+	}
+}
+
+func TestQuietHoursEndsAt(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+	overnight := QuietHours{Start: "22:00", End: "07:00", TimeZone: "Europe/Berlin"}
+	daytime := QuietHours{Start: "09:00", End: "17:00", TimeZone: "Europe/Berlin"}
+
+	tests := []struct {
+		name   string
+		hours  QuietHours
+		at     time.Time
+		want   time.Time
+		wantIn bool
+	}{
+		{
+			name:   "overnight before start",
+			hours:  overnight,
+			at:     time.Date(2026, 3, 10, 21, 59, 0, 0, berlin),
+			want:   time.Time{},
+			wantIn: false,
+		},
+		{
+			name:   "overnight evening",
+			hours:  overnight,
+			at:     time.Date(2026, 3, 10, 22, 0, 0, 0, berlin),
+			want:   time.Date(2026, 3, 11, 7, 0, 0, 0, berlin),
+			wantIn: true,
+		},
+		{
+			name:   "overnight early morning",
+			hours:  overnight,
+			at:     time.Date(2026, 3, 11, 6, 59, 0, 0, berlin),
+			want:   time.Date(2026, 3, 11, 7, 0, 0, 0, berlin),
+			wantIn: true,
+		},
+		{
+			name:   "overnight at end",
+			hours:  overnight,
+			at:     time.Date(2026, 3, 11, 7, 0, 0, 0, berlin),
+			want:   time.Time{},
+			wantIn: false,
+		},
+		{
+			name:  "overnight evaluated in the channel time zone",
+			hours: overnight,
+			// 23:30 in Berlin (UTC+1 in winter).
+			at:     time.Date(2026, 1, 5, 22, 30, 0, 0, time.UTC),
+			want:   time.Date(2026, 1, 6, 7, 0, 0, 0, berlin),
+			wantIn: true,
+		},
+		{
+			name:  "overnight across a DST change",
+			hours: overnight,
+			// Clocks move forward on 2026-03-29 in Berlin.
+			at:     time.Date(2026, 3, 28, 23, 0, 0, 0, berlin),
+			want:   time.Date(2026, 3, 29, 7, 0, 0, 0, berlin),
+			wantIn: true,
+		},
+		{
+			name:   "daytime inside",
+			hours:  daytime,
+			at:     time.Date(2026, 3, 10, 12, 0, 0, 0, berlin),
+			want:   time.Date(2026, 3, 10, 17, 0, 0, 0, berlin),
+			wantIn: true,
+		},
+		{
+			name:   "daytime outside",
+			hours:  daytime,
+			at:     time.Date(2026, 3, 10, 18, 0, 0, 0, berlin),
+			want:   time.Time{},
+			wantIn: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, in, err := tc.hours.EndsAt(tc.at)
+			if err != nil {
+				t.Fatalf("EndsAt() unexpected error: %v", err)
+			}
+			if in != tc.wantIn {
+				t.Fatalf("EndsAt() in = %v, want %v", in, tc.wantIn)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("EndsAt() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestChannelDeliverySettingsIsEmpty(t *testing.T) {
+	var nilSettings *ChannelDeliverySettings
+	if !nilSettings.IsEmpty() {
+		t.Error("expected nil settings to be empty")
+	}
+	if !(&ChannelDeliverySettings{QuietHours: nil, MaxDeliveriesPerHour: nil}).IsEmpty() {
+		t.Error("expected zero settings to be empty")
+	}
+	if (&ChannelDeliverySettings{QuietHours: nil, MaxDeliveriesPerHour: new(int64(5))}).IsEmpty() {
+		t.Error("expected settings with a limit to not be empty")
+	}
+}
+
+func TestCoalesceEventSummaries(t *testing.T) {
+	older := NewEmptyEventSummary()
+	older.SnapshotOrigin = OriginLive
+	older.Text = "1 new feature matched your search"
+	older.Categories.Added = 1
+	older.ResolvedQueryErrors = []SummaryQueryError{{Code: SummaryQueryErrorCodeInvalidQuery}}
+	older.Highlights = []SummaryHighlight{
+		{Type: SummaryHighlightTypeAdded, FeatureID: "a", FeatureName: "A"},
+	}
+
+	newer := NewEmptyEventSummary()
+	newer.SnapshotOrigin = OriginLive
+	newer.Text = "2 features updated"
+	newer.Categories.Updated = 2
+	newer.Categories.Added = 1
+	newer.Highlights = []SummaryHighlight{
+		{Type: SummaryHighlightTypeChanged, FeatureID: "b", FeatureName: "B"},
+	}
+
+	olderRaw, _ := json.Marshal(older)
+	newerRaw, _ := json.Marshal(newer)
+
+	raw, err := CoalesceEventSummaries(olderRaw, newerRaw)
+	if err != nil {
+		t.Fatalf("CoalesceEventSummaries() unexpected error: %v", err)
+	}
+	var got EventSummary
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatalf("failed to unmarshal coalesced summary: %v", err)
+	}
+
+	want := NewEmptyEventSummary()
+	want.SnapshotOrigin = OriginLive
+	want.Text = "2 new features matched your search, 2 features updated"
+	want.Categories.Added = 2
+	want.Categories.Updated = 2
+	want.ResolvedQueryErrors = []SummaryQueryError{{Code: SummaryQueryErrorCodeInvalidQuery}}
+	want.Highlights = []SummaryHighlight{
+		{Type: SummaryHighlightTypeAdded, FeatureID: "a", FeatureName: "A"},
+		{Type: SummaryHighlightTypeChanged, FeatureID: "b", FeatureName: "B"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("CoalesceEventSummaries() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestCoalesceEventSummaries_Truncates(t *testing.T) {
+	summary := NewEmptyEventSummary()
+	for range MaxHighlights {
+		summary.Highlights = append(summary.Highlights, SummaryHighlight{Type: SummaryHighlightTypeAdded})
+	}
+	raw, _ := json.Marshal(summary)
+
+	merged, err := CoalesceEventSummaries(raw, raw)
+	if err != nil {
+		t.Fatalf("CoalesceEventSummaries() unexpected error: %v", err)
+	}
+	var got EventSummary
+	if err := json.Unmarshal(merged, &got); err != nil {
+		t.Fatalf("failed to unmarshal coalesced summary: %v", err)
+	}
+	if len(got.Highlights) != MaxHighlights || !got.Truncated {
+		t.Errorf("expected %d truncated highlights, got %d (truncated=%v)",
+			MaxHighlights, len(got.Highlights), got.Truncated)
+	}
+}
+
+func TestCoalesceEventSummaries_Errors(t *testing.T) {
+	valid, _ := json.Marshal(NewEmptyEventSummary())
+	if _, err := CoalesceEventSummaries([]byte("{broken"), valid); err == nil {
+		t.Error("expected error for invalid held summary")
+	}
+	if _, err := CoalesceEventSummaries(valid, []byte(`{"schemaVersion":"v99"}`)); !errors.Is(err,
+		ErrUnknownSummaryVersion) {
+		t.Errorf("expected ErrUnknownSummaryVersion, got %v", err)
+	}
+}
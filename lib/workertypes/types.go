@@ -15,6 +15,8 @@
 package workertypes
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -1009,6 +1011,8 @@ type EmailSubscriber struct {
 	Triggers       []JobTrigger
 	EmailAddress   string
 	ChannelID      string
+	// DeliverySettings are the quiet hours and rate limit of the channel, if any.
+	DeliverySettings *ChannelDeliverySettings
 }
 
 // WebhookSubscriber represents a subscriber using a Webhook channel.
@@ -1019,6 +1023,8 @@ type WebhookSubscriber struct {
 	WebhookURL     string
 	WebhookType    WebhookType
 	ChannelID      string
+	// DeliverySettings are the quiet hours and rate limit of the channel, if any.
+	DeliverySettings *ChannelDeliverySettings
 }
 
 // SubscriberSet groups subscribers by channel type to avoid runtime type assertions.
@@ -1088,6 +1094,35 @@ type IncomingWebhookDeliveryJob struct {
 	WebhookEventID string
 }
 
+// NewDeliveryKey derives the deterministic idempotency key for a single delivery.
+// The dispatcher may publish the same job more than once (e.g. when a partially failed
+// event is retried) and Pub/Sub may redeliver a job, but every copy resolves to the same
+// (EventID, SubscriptionID, ChannelID) tuple and therefore the same key.
+// It returns an empty string if any part is missing, since a partial tuple is not unique.
+func NewDeliveryKey(eventID, subscriptionID, channelID string) string {
+	if eventID == "" || subscriptionID == "" || channelID == "" {
+		return ""
+	}
+	h := sha256.New()
+	for _, part := range []string{eventID, subscriptionID, channelID} {
+		// A separator prevents ("ab", "c") and ("a", "bc") from colliding.
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// DeliveryKey returns the idempotency key for the email delivery job.
+func (j EmailDeliveryJob) DeliveryKey() string {
+	return NewDeliveryKey(j.Metadata.EventID, j.SubscriptionID, j.ChannelID)
+}
+
+// DeliveryKey returns the idempotency key for the webhook delivery job.
+func (j WebhookDeliveryJob) DeliveryKey() string {
+	return NewDeliveryKey(j.Metadata.EventID, j.SubscriptionID, j.ChannelID)
+}
+
 var (
 	// ErrUnrecoverableSystemFailureEmailSending indicates that there's a system failure that should not be retried.
 	// Examples: System auth issue.
@@ -1095,6 +1130,9 @@ var (
 	// ErrUnrecoverableUserFailureEmailSending indicates that there's a user failure that should not be retried.
 	// Examples: Bad email address.
 	ErrUnrecoverableUserFailureEmailSending = errors.New("unrecoverable user failure trying to send email")
+	// ErrPermanentWebhook indicates that a webhook delivery failed in a way that should not be retried.
+	// Examples: Invalid webhook URL, 4xx response from the endpoint.
+	ErrPermanentWebhook = errors.New("permanent webhook failure")
 )
 
 // FilterHighlights filters highlights based on triggers.
@@ -865,3 +865,81 @@ func TestEventSummary_AcceptContract(t *testing.T) {
 		t.Errorf("summary.Accept did not dispatch to visitor, addedVisited = %d", mock.addedVisited)
 	}
 }
+
+func TestNewDeliveryKey(t *testing.T) {
+	key := NewDeliveryKey("event-1", "sub-1", "chan-1")
+	if len(key) != 64 {
+		t.Fatalf("expected 64 character hex key, got %q", key)
+	}
+
+	testCases := []struct {
+		name           string
+		eventID        string
+		subscriptionID string
+		channelID      string
+		wantSame       bool
+		wantEmpty      bool
+	}{
+		{"same tuple", "event-1", "sub-1", "chan-1", true, false},
+		{"different event", "event-2", "sub-1", "chan-1", false, false},
+		{"different subscription", "event-1", "sub-2", "chan-1", false, false},
+		{"different channel", "event-1", "sub-1", "chan-2", false, false},
+		{"shifted boundary", "event-1s", "ub-1", "chan-1", false, false},
+		{"missing event", "", "sub-1", "chan-1", false, true},
+		{"missing subscription", "event-1", "", "chan-1", false, true},
+		{"missing channel", "event-1", "sub-1", "", false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := NewDeliveryKey(tc.eventID, tc.subscriptionID, tc.channelID)
+			if tc.wantEmpty {
+				if got != "" {
+					t.Errorf("expected empty key, got %q", got)
+				}
+
+				return
+			}
+			if (got == key) != tc.wantSame {
+				t.Errorf("NewDeliveryKey(%q, %q, %q) = %q, same as base key: %v, want %v",
+					tc.eventID, tc.subscriptionID, tc.channelID, got, got == key, tc.wantSame)
+			}
+		})
+	}
+}
+
+func TestDeliveryJobKeys(t *testing.T) {
+	metadata := DeliveryMetadata{
+		EventID:     "event-1",
+		SearchID:    "search-1",
+		SearchName:  "Search",
+		Query:       "group:css",
+		Frequency:   FrequencyImmediate,
+		GeneratedAt: time.Time{},
+	}
+	emailJob := EmailDeliveryJob{
+		SubscriptionID: "sub-1",
+		RecipientEmail: "user@example.com",
+		ChannelID:      "chan-1",
+		Triggers:       nil,
+		SummaryRaw:     nil,
+		Metadata:       metadata,
+	}
+	webhookJob := WebhookDeliveryJob{
+		SubscriptionID: "sub-1",
+		WebhookURL:     "https://hooks.slack.com/services/1",
+		WebhookType:    WebhookTypeSlack,
+		ChannelID:      "chan-1",
+		Triggers:       nil,
+		SummaryRaw:     nil,
+		Metadata:       metadata,
+	}
+
+	want := NewDeliveryKey("event-1", "sub-1", "chan-1")
+	if got := emailJob.DeliveryKey(); got != want {
+		t.Errorf("EmailDeliveryJob.DeliveryKey() = %q, want %q", got, want)
+	}
+	if got := webhookJob.DeliveryKey(); got != want {
+		t.Errorf("WebhookDeliveryJob.DeliveryKey() = %q, want %q", got, want)
+	}
+}
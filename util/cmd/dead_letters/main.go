@@ -0,0 +1,180 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package main implements a tool to list, inspect and redrive dead-lettered worker messages.
+//
+// Usage against the local Pub/Sub emulator:
+//
+//	PUBSUB_EMULATOR_HOST=localhost:8060 go run ./util/cmd/dead_letters \
+//		-project=local -subscription=delivery-dead-letter-sub-id -action=list
+//
+// Redriving sends the original event back through an event.Router which republishes it to the
+// topic its worker consumes from. Only redriven messages are removed from the dead-letter
+// subscription.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/GoogleChrome/webstatus.dev/lib/gcppubsub"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcppubsub/gcppubsubadapters"
+)
+
+const (
+	actionList    = "list"
+	actionInspect = "inspect"
+	actionRedrive = "redrive"
+)
+
+func main() {
+	var (
+		projectID   = flag.String("project", "local", "Google Cloud project of the Pub/Sub subscription")
+		subID       = flag.String("subscription", "", "Dead-letter subscription to read from")
+		action      = flag.String("action", actionList, "One of: list, inspect, redrive")
+		messageID   = flag.String("message_id", "", "Only inspect or redrive the dead-letter message with this ID")
+		maxMessages = flag.Int("max", 100, "Maximum number of messages to read")
+		timeout     = flag.Duration("timeout", 30*time.Second, "How long to wait for messages")
+
+		ingestionTopicID    = flag.String("ingestion_topic", "ingestion-jobs-topic-id", "Redrive topic for ingestion events")
+		batchTopicID        = flag.String("batch_topic", "batch-updates-topic-id", "Redrive topic for batch events")
+		notificationTopicID = flag.String("notification_topic", "notification-events-topic-id",
+			"Redrive topic for notification events")
+		emailTopicID   = flag.String("email_topic", "chime-delivery-topic-id", "Redrive topic for email jobs")
+		webhookTopicID = flag.String("webhook_topic", "webhook-delivery-topic-id", "Redrive topic for webhook jobs")
+	)
+	flag.Parse()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	if *subID == "" {
+		slog.ErrorContext(ctx, "missing -subscription")
+		os.Exit(1)
+	}
+
+	client, err := gcppubsub.NewClient(ctx, *projectID)
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to create pub sub client", "error", err)
+		os.Exit(1)
+	}
+	defer client.Close()
+
+	var handler func(ctx context.Context, msg gcppubsub.DeadLetterMessage) bool
+	switch *action {
+	case actionList:
+		handler = listMessage
+	case actionInspect:
+		handler = inspectMessage
+	case actionRedrive:
+		redriver := gcppubsubadapters.NewDeadLetterRedriveAdapter(client, gcppubsubadapters.DeadLetterRedriveTopics{
+			IngestionTopicID:    *ingestionTopicID,
+			BatchTopicID:        *batchTopicID,
+			NotificationTopicID: *notificationTopicID,
+			EmailTopicID:        *emailTopicID,
+			WebhookTopicID:      *webhookTopicID,
+		})
+		handler = func(ctx context.Context, msg gcppubsub.DeadLetterMessage) bool {
+			if err := redriver.Redrive(ctx, msg.Event); err != nil {
+				slog.ErrorContext(ctx, "unable to redrive message", "id", msg.ID, "error", err)
+
+				return false
+			}
+			fmt.Printf("redrove %s (original message %s)\n", msg.ID, msg.Event.MessageID)
+
+			return true
+		}
+	default:
+		slog.ErrorContext(ctx, "unknown -action", "action", *action)
+		os.Exit(1)
+	}
+
+	count := 0
+	err = client.ReceiveDeadLetters(ctx, *subID, *maxMessages,
+		func(ctx context.Context, msg gcppubsub.DeadLetterMessage) bool {
+			if *messageID != "" && msg.ID != *messageID {
+				return false
+			}
+			count++
+
+			return handler(ctx, msg)
+		})
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to read dead-letter subscription", "error", err)
+		os.Exit(1)
+	}
+	fmt.Printf("%d message(s) processed\n", count)
+}
+
+// listMessage prints a one line summary of a message. The message stays in the subscription.
+func listMessage(_ context.Context, msg gcppubsub.DeadLetterMessage) bool {
+	fmt.Printf("%s\t%s\t%s\tworker=%s\tattempts=%d\tsubscription=%s\n",
+		msg.ID,
+		msg.PublishTime.Format(time.RFC3339),
+		msg.Event.ErrorClass,
+		msg.Event.WorkerID,
+		msg.Event.AttemptCount,
+		msg.Event.SubscriptionID)
+
+	return false
+}
+
+// inspectMessage prints the full details of a message, including the original payload.
+// The message stays in the subscription.
+func inspectMessage(ctx context.Context, msg gcppubsub.DeadLetterMessage) bool {
+	details := struct {
+		ID             string          `json:"id"`
+		PublishTime    time.Time       `json:"publish_time"`
+		MessageID      string          `json:"message_id"`
+		SubscriptionID string          `json:"subscription_id"`
+		WorkerID       string          `json:"worker_id"`
+		ErrorClass     string          `json:"error_class"`
+		Error          string          `json:"error"`
+		AttemptCount   int             `json:"attempt_count"`
+		FailedAt       time.Time       `json:"failed_at"`
+		Data           json.RawMessage `json:"data"`
+	}{
+		ID:             msg.ID,
+		PublishTime:    msg.PublishTime,
+		MessageID:      msg.Event.MessageID,
+		SubscriptionID: msg.Event.SubscriptionID,
+		WorkerID:       msg.Event.WorkerID,
+		ErrorClass:     string(msg.Event.ErrorClass),
+		Error:          msg.Event.Error,
+		AttemptCount:   msg.Event.AttemptCount,
+		FailedAt:       msg.Event.FailedAt,
+		Data:           nil,
+	}
+	if json.Valid(msg.Event.Data) {
+		details.Data = msg.Event.Data
+	} else {
+		// Keep malformed payloads readable.
+		details.Data, _ = json.Marshal(string(msg.Event.Data))
+	}
+
+	b, err := json.MarshalIndent(details, "", "  ")
+	if err != nil {
+		slog.ErrorContext(ctx, "unable to format message", "id", msg.ID, "error", err)
+
+		return false
+	}
+	fmt.Println(string(b))
+
+	return false
+}
@@ -296,6 +296,7 @@ func resetSavedSearches(ctx context.Context, spannerClient *gcpspanner.Client, u
 				err := spannerClient.DeleteUserSavedSearch(ctx, gcpspanner.DeleteUserSavedSearchRequest{
 					RequestingUserID: userID,
 					SavedSearchID:    savedSearch.ID,
+					Force:            true,
 				})
 				if err != nil {
 					return fmt.Errorf("failed to delete test user saved search: %w", err)
@@ -375,10 +376,20 @@ func generateReleases(ctx context.Context, c *gcpspanner.Client) (int, error) {
 			if i > 1 {
 				baseDate = releases[i-1].ReleaseDate.AddDate(0, 2, r.Intn(90)) // Add 2 months to ~5 months
 			}
+			// Mark the newest release as current and an older Firefox release as esr so that release
+			// channels can be resolved locally.
+			var releaseStatus *string
+			switch {
+			case i == releasesPerBrowser-1:
+				releaseStatus = new("current")
+			case strings.HasPrefix(browser, string(backend.Firefox)) && i == releasesPerBrowser-5:
+				releaseStatus = new("esr")
+			}
 			release := gcpspanner.BrowserRelease{
 				BrowserName:    browser,
 				BrowserVersion: fmt.Sprintf("%d", i+1),
 				ReleaseDate:    baseDate.AddDate(0, 0, r.Intn(30)), // Add up to 1 month
+				ReleaseStatus:  releaseStatus,
 			}
 			releases = append(releases, release)
 
@@ -787,10 +798,13 @@ func generateSavedSearches(ctx context.Context,
 			return 0, err
 		}
 		id, err := spannerClient.CreateNewUserSavedSearchWithUUID(ctx, gcpspanner.CreateUserSavedSearchRequest{
-			OwnerUserID: userID,
-			Name:        savedSearch.Name,
-			Query:       savedSearch.Query,
-			Description: savedSearch.Description,
+			OwnerUserID:     userID,
+			Name:            savedSearch.Name,
+			Query:           savedSearch.Query,
+			Description:     savedSearch.Description,
+			Tags:            nil,
+			Listed:          false,
+			QueryParameters: nil,
 		}, savedSearch.UUID)
 		if err != nil {
 			return 0, err
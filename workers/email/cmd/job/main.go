@@ -93,6 +93,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Optional: Store permanently failed messages instead of dropping them.
+	if deadLetterTopicID := os.Getenv("DEAD_LETTER_TOPIC_ID"); deadLetterTopicID != "" {
+		queueClient.SetDeadLetterTopic(deadLetterTopicID, gcppubsub.WorkerID("email-worker"))
+	}
+
 	renderer, err := digest.NewHTMLRenderer(parsedBaseURL.String())
 	if err != nil {
 		// If the template is not valid, the renderer will fail.
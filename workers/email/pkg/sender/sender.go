@@ -32,6 +32,11 @@ type ChannelStateManager interface {
 	RecordSuccess(ctx context.Context, channelID string, timestamp time.Time, eventID string) error
 	RecordFailure(ctx context.Context, channelID string, err error,
 		timestamp time.Time, isPermanent bool, eventID string) error
+	// ClaimDelivery records the delivery key before sending.
+	// It returns false if the key already completed and the send must be skipped.
+	ClaimDelivery(ctx context.Context, channelID string, deliveryKey string, timestamp time.Time) (bool, error)
+	// CompleteDelivery marks the delivery key as completed after a successful send.
+	CompleteDelivery(ctx context.Context, channelID string, deliveryKey string, timestamp time.Time) error
 }
 
 type TemplateRenderer interface {
@@ -59,6 +64,23 @@ func NewSender(
 }
 
 func (s *Sender) ProcessMessage(ctx context.Context, job workertypes.IncomingEmailDeliveryJob) error {
+	// 0. Deduplicate. Pub/Sub redeliveries and dispatcher retries share the same delivery key.
+	deliveryKey := job.DeliveryKey()
+	if deliveryKey != "" {
+		claimed, err := s.stateManager.ClaimDelivery(ctx, job.ChannelID, deliveryKey, s.now())
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to claim delivery key", "channel_id", job.ChannelID, "error", err)
+
+			return errors.Join(event.ErrTransientFailure, err)
+		}
+		if !claimed {
+			slog.InfoContext(ctx, "skipping email that was already delivered",
+				"subscription_id", job.SubscriptionID, "channel_id", job.ChannelID, "event_id", job.Metadata.EventID)
+
+			return nil
+		}
+	}
+
 	// 1. Render (Parsing happens inside RenderDigest implementation)
 	subject, body, err := s.renderer.RenderDigest(job)
 	if err != nil {
@@ -97,6 +119,12 @@ func (s *Sender) ProcessMessage(ctx context.Context, job workertypes.IncomingEma
 	}
 
 	// 3. Success
+	if deliveryKey != "" {
+		// The email is already sent. Returning an error here would cause a duplicate, so only log.
+		if err := s.stateManager.CompleteDelivery(ctx, job.ChannelID, deliveryKey, s.now()); err != nil {
+			slog.WarnContext(ctx, "failed to complete delivery key", "channel_id", job.ChannelID, "error", err)
+		}
+	}
 	if err := s.stateManager.RecordSuccess(ctx, job.ChannelID, s.now(), job.EmailEventID); err != nil {
 		// Non-critical error, but good to log
 		slog.WarnContext(ctx, "failed to record channel success", "channel_id", job.ChannelID, "error", err)
@@ -52,9 +52,20 @@ type successCall struct {
 }
 
 type mockChannelStateManager struct {
-	successCalls []successCall
-	failureCalls []failureCall
-	recordErr    error
+	successCalls  []successCall
+	failureCalls  []failureCall
+	recordErr     error
+	claimCalls    []deliveryKeyCall
+	completeCalls []deliveryKeyCall
+	// alreadyCompleted simulates a delivery key that was completed by a previous delivery.
+	alreadyCompleted bool
+	claimErr         error
+}
+
+type deliveryKeyCall struct {
+	channelID   string
+	deliveryKey string
+	timestamp   time.Time
 }
 
 type failureCall struct {
@@ -80,6 +91,23 @@ func (m *mockChannelStateManager) RecordFailure(_ context.Context, channelID str
 	return m.recordErr
 }
 
+func (m *mockChannelStateManager) ClaimDelivery(_ context.Context, channelID string,
+	deliveryKey string, timestamp time.Time) (bool, error) {
+	m.claimCalls = append(m.claimCalls, deliveryKeyCall{channelID, deliveryKey, timestamp})
+	if m.claimErr != nil {
+		return false, m.claimErr
+	}
+
+	return !m.alreadyCompleted, nil
+}
+
+func (m *mockChannelStateManager) CompleteDelivery(_ context.Context, channelID string,
+	deliveryKey string, timestamp time.Time) error {
+	m.completeCalls = append(m.completeCalls, deliveryKeyCall{channelID, deliveryKey, timestamp})
+
+	return m.recordErr
+}
+
 type mockTemplateRenderer struct {
 	renderSubject string
 	renderBody    string
@@ -187,6 +215,86 @@ func TestProcessMessage_Success(t *testing.T) {
 	if !stateManager.successCalls[0].timestamp.Equal(fakeNow()) {
 		t.Errorf("Success recorded with wrong timestamp: %v", stateManager.successCalls[0])
 	}
+
+	// Verify Delivery Key
+	expectedKeyCalls := []deliveryKeyCall{{testChannelID, job.DeliveryKey(), fakeNow()}}
+	if diff := cmp.Diff(expectedKeyCalls, stateManager.claimCalls, cmp.AllowUnexported(deliveryKeyCall{})); diff != "" {
+		t.Errorf("ClaimDelivery calls mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(expectedKeyCalls, stateManager.completeCalls,
+		cmp.AllowUnexported(deliveryKeyCall{})); diff != "" {
+		t.Errorf("CompleteDelivery calls mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestProcessMessage_AlreadyDelivered(t *testing.T) {
+	ctx := context.Background()
+	job := workertypes.IncomingEmailDeliveryJob{
+		EmailDeliveryJob: workertypes.EmailDeliveryJob{
+			SubscriptionID: testSubscriptionID,
+			Metadata:       testMetadata(),
+			RecipientEmail: testRecipientEmail,
+			SummaryRaw:     []byte("{}"),
+			ChannelID:      testChannelID,
+			Triggers:       nil,
+		},
+		EmailEventID: testEmailEventID,
+	}
+
+	sender := new(mockEmailSender)
+	stateManager := new(mockChannelStateManager)
+	stateManager.alreadyCompleted = true
+	renderer := new(mockTemplateRenderer)
+
+	h := NewSender(sender, stateManager, renderer)
+	h.now = fakeNow
+
+	if err := h.ProcessMessage(ctx, job); err != nil {
+		t.Fatalf("ProcessMessage failed: %v", err)
+	}
+
+	if len(sender.sentCalls) != 0 {
+		t.Errorf("Expected no email sent for completed delivery key, got %d", len(sender.sentCalls))
+	}
+	if len(stateManager.successCalls) != 0 || len(stateManager.failureCalls) != 0 {
+		t.Errorf("Expected no channel state changes, got %d successes and %d failures",
+			len(stateManager.successCalls), len(stateManager.failureCalls))
+	}
+	if len(stateManager.completeCalls) != 0 {
+		t.Errorf("Expected no CompleteDelivery calls, got %d", len(stateManager.completeCalls))
+	}
+}
+
+func TestProcessMessage_ClaimError(t *testing.T) {
+	ctx := context.Background()
+	job := workertypes.IncomingEmailDeliveryJob{
+		EmailDeliveryJob: workertypes.EmailDeliveryJob{
+			SubscriptionID: testSubscriptionID,
+			Metadata:       testMetadata(),
+			RecipientEmail: testRecipientEmail,
+			SummaryRaw:     []byte("{}"),
+			ChannelID:      testChannelID,
+			Triggers:       nil,
+		},
+		EmailEventID: testEmailEventID,
+	}
+
+	sender := new(mockEmailSender)
+	stateManager := new(mockChannelStateManager)
+	stateManager.claimErr = errors.New("spanner unavailable")
+	renderer := new(mockTemplateRenderer)
+
+	h := NewSender(sender, stateManager, renderer)
+	h.now = fakeNow
+
+	// Should NACK so the message is retried once the database is reachable.
+	err := h.ProcessMessage(ctx, job)
+	if !errors.Is(err, event.ErrTransientFailure) {
+		t.Errorf("Expected transient failure, got %v", err)
+	}
+	if len(sender.sentCalls) != 0 {
+		t.Errorf("Expected no email sent, got %d", len(sender.sentCalls))
+	}
 }
 
 func TestProcessMessage_RenderError(t *testing.T) {
@@ -105,6 +105,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Optional: Store permanently failed messages instead of dropping them.
+	if deadLetterTopicID := os.Getenv("DEAD_LETTER_TOPIC_ID"); deadLetterTopicID != "" {
+		queueClient.SetDeadLetterTopic(deadLetterTopicID, gcppubsub.WorkerID("event-producer"))
+	}
+
 	blobClient, err := gcpgcs.NewClient(ctx, stateBlobBucket)
 	if err != nil {
 		slog.ErrorContext(ctx, "unable to create gcs client", "error", err)
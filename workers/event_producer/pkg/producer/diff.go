@@ -195,10 +195,7 @@ func (s *V1DiffSerializer) Serialize(
 func convertV1SnapshotToComparable(
 	state *featurelistv1.FeatureListSnapshot,
 ) (map[string]comparables.Feature, string, []workertypes.SummaryQueryError) {
-	comparableMap := make(map[string]comparables.Feature, len(state.Data.Features))
-	for id, v1Feature := range state.Data.Features {
-		comparableMap[id] = convertV1FeatureToComparable(v1Feature)
-	}
+	comparableMap := state.ComparableFeatures()
 
 	var qErrs []workertypes.SummaryQueryError
 	if len(state.Metadata.QueryErrors) > 0 {
@@ -249,106 +246,6 @@ func NewDiffer(client FeatureFetcher) *differ.FeatureDiffer[featurelistdiffv1.Fe
 	return differ.NewFeatureDiffer[featurelistdiffv1.FeatureDiff](client, workflowFactory, stateAdapter, diffSerializer)
 }
 
-// convertV1FeatureToComparable maps a V1 feature struct to the canonical comparables.Feature.
-func convertV1FeatureToComparable(v1f featurelistv1.Feature) comparables.Feature {
-	// 1. Convert name and ID
-	name := generic.OptionallySet[string]{Value: v1f.Name.Value, IsSet: true}
-	id := v1f.ID
-
-	// 2. Convert BaselineStatus
-	baselineStatus := generic.UnsetOpt[comparables.BaselineState]()
-	if v1f.BaselineStatus.IsSet {
-		baselineStatus.IsSet = true
-		baselineInfoStatus := generic.UnsetOpt[backend.BaselineInfoStatus]()
-		if v1f.BaselineStatus.Value.Status.IsSet {
-			baselineInfoStatus.IsSet = true
-			var status backend.BaselineInfoStatus
-			switch v1f.BaselineStatus.Value.Status.Value {
-			case featurelistv1.Limited:
-				status = backend.Limited
-			case featurelistv1.Newly:
-				status = backend.Newly
-			case featurelistv1.Widely:
-				status = backend.Widely
-			}
-			baselineInfoStatus.Value = status
-			baselineStatus.Value.Status = baselineInfoStatus
-		}
-		baselineStatus.Value.LowDate = v1f.BaselineStatus.Value.LowDate
-		baselineStatus.Value.HighDate = v1f.BaselineStatus.Value.HighDate
-
-	}
-
-	// 3. Convert BrowserImplementations
-	browserImpls := generic.UnsetOpt[comparables.BrowserImplementations]()
-	if v1f.BrowserImpls.IsSet {
-		browserImpls.IsSet = true
-		browserImpls.Value = comparables.BrowserImplementations{
-			Chrome:         convertV1BrowserStatusToComparableState(v1f.BrowserImpls.Value.Chrome),
-			ChromeAndroid:  convertV1BrowserStatusToComparableState(v1f.BrowserImpls.Value.ChromeAndroid),
-			Edge:           convertV1BrowserStatusToComparableState(v1f.BrowserImpls.Value.Edge),
-			Firefox:        convertV1BrowserStatusToComparableState(v1f.BrowserImpls.Value.Firefox),
-			FirefoxAndroid: convertV1BrowserStatusToComparableState(v1f.BrowserImpls.Value.FirefoxAndroid),
-			Safari:         convertV1BrowserStatusToComparableState(v1f.BrowserImpls.Value.Safari),
-			SafariIos:      convertV1BrowserStatusToComparableState(v1f.BrowserImpls.Value.SafariIos),
-		}
-	}
-
-	// 4. Convert Docs
-	docs := generic.UnsetOpt[comparables.Docs]()
-	if v1f.Docs.IsSet {
-		docs.IsSet = true
-		mdnDocs := generic.UnsetOpt[[]comparables.MdnDoc]()
-		if v1f.Docs.Value.MdnDocs.IsSet {
-			mdnDocs.IsSet = true
-			for _, v1Doc := range v1f.Docs.Value.MdnDocs.Value {
-				mdnDocs.Value = append(mdnDocs.Value, comparables.MdnDoc{
-					URL:   v1Doc.URL,
-					Title: v1Doc.Title,
-					Slug:  v1Doc.Slug,
-				})
-			}
-		}
-		docs.Value.MdnDocs = mdnDocs
-	}
-
-	return comparables.Feature{
-		ID:             id,
-		Name:           name,
-		BaselineStatus: baselineStatus,
-		BrowserImpls:   browserImpls,
-		Docs:           docs,
-	}
-}
-
-// convertV1BrowserStatusToComparableState converts a simple string status from V1
-// into the more detailed comparables.BrowserState.
-func convertV1BrowserStatusToComparableState(
-	state generic.OptionallySet[featurelistv1.BrowserState]) generic.OptionallySet[comparables.BrowserState] {
-	browserState := generic.UnsetOpt[comparables.BrowserState]()
-	if !state.IsSet {
-		return browserState
-	}
-	browserState.IsSet = true
-	browserImplStatus := generic.UnsetOpt[backend.BrowserImplementationStatus]()
-	var status backend.BrowserImplementationStatus
-	if state.Value.Status.IsSet {
-		switch state.Value.Status.Value {
-		case featurelistv1.Available:
-			status = backend.Available
-		case featurelistv1.Unavailable:
-			status = backend.Unavailable
-		}
-		browserImplStatus.IsSet = true
-		browserImplStatus.Value = status
-		browserState.Value.Status = browserImplStatus
-	}
-	browserState.Value.Version = state.Value.Version
-	browserState.Value.Date = state.Value.Date
-
-	return browserState
-}
-
 // convertComparableToV1Feature maps a canonical comparables.Feature to a V1 feature struct.
 func convertComparableToV1Feature(cf comparables.Feature) featurelistv1.Feature {
 	// 1. Convert name and ID
@@ -327,7 +327,7 @@ func TestConversionFunctions(t *testing.T) {
 	}
 
 	// 2. Convert V1 -> Canonical (Round trip)
-	roundTrippedFeature := convertV1FeatureToComparable(v1Feature)
+	roundTrippedFeature := v1Feature.ToComparable()
 
 	// 3. Compare original with round-tripped
 	if diff := cmp.Diff(canonicalFeature, roundTrippedFeature); diff != "" {
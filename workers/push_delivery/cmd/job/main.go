@@ -18,6 +18,7 @@ import (
 	"context"
 	"log/slog"
 	"os"
+	"time"
 
 	"github.com/GoogleChrome/webstatus.dev/lib/gcppubsub"
 	"github.com/GoogleChrome/webstatus.dev/lib/gcppubsub/gcppubsubadapters"
@@ -27,6 +28,9 @@ import (
 	"github.com/GoogleChrome/webstatus.dev/workers/push_delivery/pkg/dispatcher"
 )
 
+// heldDeliveryReleaseInterval is how often the worker looks for held jobs whose window opened.
+const heldDeliveryReleaseInterval = time.Minute
+
 func main() {
 	ctx := context.Background()
 
@@ -90,11 +94,22 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Optional: Store permanently failed messages instead of dropping them.
+	if deadLetterTopicID := os.Getenv("DEAD_LETTER_TOPIC_ID"); deadLetterTopicID != "" {
+		queueClient.SetDeadLetterTopic(deadLetterTopicID, gcppubsub.WorkerID("push-delivery"))
+	}
+
+	d := dispatcher.NewDispatcher(
+		spanneradapters.NewPushDeliverySubscriberFinder(spannerClient),
+		gcppubsubadapters.NewPushDeliveryPublisher(queueClient, emailTopicID, webhookTopicID),
+		spanneradapters.NewPushDeliveryLimiter(spannerClient),
+	)
+
+	// Release the jobs held back by the quiet hours and hourly limits of channels once their window opens.
+	go d.RunReleaseLoop(ctx, heldDeliveryReleaseInterval)
+
 	listener := gcppubsubadapters.NewPushDeliverySubscriberAdapter(
-		dispatcher.NewDispatcher(
-			spanneradapters.NewPushDeliverySubscriberFinder(spannerClient),
-			gcppubsubadapters.NewPushDeliveryPublisher(queueClient, emailTopicID, webhookTopicID),
-		),
+		d,
 		queueClient,
 		notificationSubID,
 	)
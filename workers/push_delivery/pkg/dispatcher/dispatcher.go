@@ -16,8 +16,10 @@ package dispatcher
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/GoogleChrome/webstatus.dev/lib/workertypes"
 )
@@ -34,20 +36,62 @@ type DeliveryPublisher interface {
 	PublishWebhookJob(ctx context.Context, job workertypes.WebhookDeliveryJob) error
 }
 
+// DeliveryLimiter persists the state needed to enforce the quiet hours and hourly limits of channels.
+type DeliveryLimiter interface {
+	// ReserveDelivery counts a delivery against the hourly limit of the channel.
+	// It returns false, without counting the delivery, if the channel already reached the limit in the
+	// window that starts at windowStart. A delivery that was already counted in the window, identified by its
+	// delivery key, is admitted without being counted again.
+	ReserveDelivery(ctx context.Context, channelID string, deliveryKey string, windowStart time.Time,
+		limit int64) (bool, error)
+	// GetChannelDeliverySettings returns the current delivery settings of a channel, or nil if it has none.
+	GetChannelDeliverySettings(ctx context.Context, channelID string) (*workertypes.ChannelDeliverySettings, error)
+	// IsEventHeld returns true if the event is already held for the subscription.
+	IsEventHeld(ctx context.Context, channelID string, subscriptionID string, eventID string) (bool, error)
+	// HoldEmailJob and HoldWebhookJob store a job until releaseAt. If a job is already held for the same
+	// subscription, the two are coalesced into a single job. Holding the same event twice is a no-op.
+	HoldEmailJob(ctx context.Context, job workertypes.EmailDeliveryJob, releaseAt time.Time) error
+	HoldWebhookJob(ctx context.Context, job workertypes.WebhookDeliveryJob, releaseAt time.Time) error
+	// ClaimDueDeliveries leases and returns up to limit held jobs whose release time is at or before now.
+	// A claimed job stays held until it is completed or rescheduled. If neither happens before the lease
+	// expires, the job is claimed again.
+	ClaimDueDeliveries(ctx context.Context, now time.Time, limit int,
+		leaseDuration time.Duration) ([]workertypes.HeldDeliveryJob, error)
+	// CompleteHeldDelivery removes a claimed job once it was published.
+	CompleteHeldDelivery(ctx context.Context, job workertypes.HeldDeliveryJob) error
+	// RescheduleHeldDelivery releases the claim of a job that was not published and keeps it held until
+	// releaseAt.
+	RescheduleHeldDelivery(ctx context.Context, job workertypes.HeldDeliveryJob, releaseAt time.Time) error
+}
+
 // SummaryParser abstracts the logic for parsing the event summary blob.
 type SummaryParser func(data []byte, v workertypes.SummaryVisitor) error
 
+var errEmptyHeldJob = errors.New("held job has neither an email nor a webhook job")
+
+const (
+	// releaseBatchSize is the maximum number of held jobs released by a single ReleaseHeldDeliveries call.
+	releaseBatchSize = 500
+	// releaseLeaseDuration is how long a claimed held job is reserved for the release that claimed it.
+	// It must be long enough to publish a full batch.
+	releaseLeaseDuration = 5 * time.Minute
+)
+
 type Dispatcher struct {
 	finder    SubscriptionFinder
 	publisher DeliveryPublisher
+	limiter   DeliveryLimiter
 	parser    SummaryParser
+	now       func() time.Time
 }
 
-func NewDispatcher(finder SubscriptionFinder, publisher DeliveryPublisher) *Dispatcher {
+func NewDispatcher(finder SubscriptionFinder, publisher DeliveryPublisher, limiter DeliveryLimiter) *Dispatcher {
 	return &Dispatcher{
 		finder:    finder,
 		publisher: publisher,
+		limiter:   limiter,
 		parser:    workertypes.ParseEventSummary,
+		now:       time.Now,
 	}
 }
 
@@ -63,9 +107,11 @@ func (d *Dispatcher) ProcessEvent(ctx context.Context,
 		metadata: metadata,
 		// We pass the raw summary bytes down so it can be attached to the jobs
 		// without needing to re-marshal the struct.
-		rawSummary:  summary,
-		emailJobs:   nil,
-		webhookJobs: nil,
+		rawSummary:      summary,
+		emailJobs:       nil,
+		webhookJobs:     nil,
+		channelSettings: nil,
+		heldCount:       0,
 	}
 
 	if err := d.parser(gen.rawSummary, gen); err != nil {
@@ -73,9 +119,13 @@ func (d *Dispatcher) ProcessEvent(ctx context.Context,
 		return fmt.Errorf("failed to parse event summary: %w", err)
 	}
 
+	if err := gen.applyDeliveryLimits(ctx, d.limiter, d.now()); err != nil {
+		return fmt.Errorf("failed to apply channel delivery limits: %w", err)
+	}
+
 	totalJobs := gen.JobCount()
 	if totalJobs == 0 {
-		slog.InfoContext(ctx, "no delivery jobs generated", "event_id", metadata.EventID)
+		slog.InfoContext(ctx, "no delivery jobs generated", "event_id", metadata.EventID, "held", gen.heldCount)
 
 		return nil
 	}
@@ -112,6 +162,7 @@ func (d *Dispatcher) ProcessEvent(ctx context.Context,
 		"event_id", metadata.EventID,
 		"sent", successCount,
 		"failed", failCount,
+		"held", gen.heldCount,
 		"total_candidates", totalJobs)
 
 	if failCount > 0 {
@@ -128,6 +179,10 @@ type deliveryJobGenerator struct {
 	rawSummary  []byte
 	emailJobs   []workertypes.EmailDeliveryJob
 	webhookJobs []workertypes.WebhookDeliveryJob
+	// channelSettings holds the delivery settings of the channels that limit their deliveries.
+	channelSettings map[string]*workertypes.ChannelDeliverySettings
+	// heldCount is the number of jobs held back by applyDeliveryLimits.
+	heldCount int
 }
 
 func (g *deliveryJobGenerator) VisitV1(s workertypes.EventSummary) error {
@@ -166,6 +221,7 @@ func (g *deliveryJobGenerator) VisitV1(s workertypes.EventSummary) error {
 		if !notify {
 			continue
 		}
+		g.trackDeliverySettings(sub.ChannelID, sub.DeliverySettings)
 		g.emailJobs = append(g.emailJobs, workertypes.EmailDeliveryJob{
 			SubscriptionID: sub.SubscriptionID,
 			RecipientEmail: sub.EmailAddress,
@@ -185,6 +241,7 @@ func (g *deliveryJobGenerator) VisitV1(s workertypes.EventSummary) error {
 		if !notify {
 			continue
 		}
+		g.trackDeliverySettings(sub.ChannelID, sub.DeliverySettings)
 		g.webhookJobs = append(g.webhookJobs, workertypes.WebhookDeliveryJob{
 			SubscriptionID: sub.SubscriptionID,
 			WebhookURL:     sub.WebhookURL,
@@ -199,6 +256,242 @@ func (g *deliveryJobGenerator) VisitV1(s workertypes.EventSummary) error {
 	return nil
 }
 
+func (g *deliveryJobGenerator) trackDeliverySettings(channelID string, settings *workertypes.ChannelDeliverySettings) {
+	if settings.IsEmpty() {
+		return
+	}
+	if g.channelSettings == nil {
+		g.channelSettings = make(map[string]*workertypes.ChannelDeliverySettings)
+	}
+	g.channelSettings[channelID] = settings
+}
+
+// applyDeliveryLimits holds back the jobs of channels that are in their quiet hours or reached their
+// hourly limit. The remaining jobs are counted against the hourly limit of their channel.
+// Held jobs are coalesced per subscription and published later by ReleaseHeldDeliveries.
+// Applying the limits again for the same event, e.g. after a redelivery, neither counts its jobs twice
+// nor holds them twice.
+func (g *deliveryJobGenerator) applyDeliveryLimits(ctx context.Context, limiter DeliveryLimiter, now time.Time) error {
+	if len(g.channelSettings) == 0 {
+		return nil
+	}
+
+	emailJobs := make([]workertypes.EmailDeliveryJob, 0, len(g.emailJobs))
+	for _, job := range g.emailJobs {
+		settings := g.channelSettings[job.ChannelID]
+		held, err := isEventHeld(ctx, limiter, job.ChannelID, job.SubscriptionID, job.Metadata.EventID, settings)
+		if err != nil {
+			return err
+		}
+		if held {
+			g.heldCount++
+
+			continue
+		}
+		releaseAt, admitted, err := admitDelivery(ctx, limiter, job.ChannelID, job.DeliveryKey(), settings, now)
+		if err != nil {
+			return err
+		}
+		if admitted {
+			emailJobs = append(emailJobs, job)
+
+			continue
+		}
+		if err := limiter.HoldEmailJob(ctx, job, releaseAt); err != nil {
+			return fmt.Errorf("failed to hold email job for subscription %s: %w", job.SubscriptionID, err)
+		}
+		g.heldCount++
+	}
+	g.emailJobs = emailJobs
+
+	webhookJobs := make([]workertypes.WebhookDeliveryJob, 0, len(g.webhookJobs))
+	for _, job := range g.webhookJobs {
+		settings := g.channelSettings[job.ChannelID]
+		held, err := isEventHeld(ctx, limiter, job.ChannelID, job.SubscriptionID, job.Metadata.EventID, settings)
+		if err != nil {
+			return err
+		}
+		if held {
+			g.heldCount++
+
+			continue
+		}
+		releaseAt, admitted, err := admitDelivery(ctx, limiter, job.ChannelID, job.DeliveryKey(), settings, now)
+		if err != nil {
+			return err
+		}
+		if admitted {
+			webhookJobs = append(webhookJobs, job)
+
+			continue
+		}
+		if err := limiter.HoldWebhookJob(ctx, job, releaseAt); err != nil {
+			return fmt.Errorf("failed to hold webhook job for subscription %s: %w", job.SubscriptionID, err)
+		}
+		g.heldCount++
+	}
+	g.webhookJobs = webhookJobs
+
+	return nil
+}
+
+// isEventHeld returns true if the job of the event is already held for the subscription.
+// An event may be processed more than once, e.g. when Pub/Sub redelivers it. A job that was already held is
+// only delivered with the held job.
+func isEventHeld(ctx context.Context, limiter DeliveryLimiter, channelID, subscriptionID, eventID string,
+	settings *workertypes.ChannelDeliverySettings) (bool, error) {
+	// Only channels with settings hold jobs.
+	if settings.IsEmpty() {
+		return false, nil
+	}
+	held, err := limiter.IsEventHeld(ctx, channelID, subscriptionID, eventID)
+	if err != nil {
+		return false, fmt.Errorf("failed to look up held deliveries of subscription %s: %w", subscriptionID, err)
+	}
+
+	return held, nil
+}
+
+// admitDelivery checks the delivery settings of a channel.
+// If the channel accepts a delivery now, the delivery is counted against its hourly limit and true is
+// returned. Otherwise, it returns the time at which the channel accepts deliveries again.
+func admitDelivery(ctx context.Context, limiter DeliveryLimiter, channelID string, deliveryKey string,
+	settings *workertypes.ChannelDeliverySettings, now time.Time) (time.Time, bool, error) {
+	if settings.IsEmpty() {
+		return now, true, nil
+	}
+
+	if settings.QuietHours != nil {
+		end, inQuietHours, err := settings.QuietHours.EndsAt(now)
+		if err != nil {
+			// The API validates the settings. Deliver rather than drop if they are broken anyway.
+			slog.WarnContext(ctx, "ignoring invalid quiet hours", "channel_id", channelID, "error", err)
+		} else if inQuietHours {
+			return end, false, nil
+		}
+	}
+
+	if settings.MaxDeliveriesPerHour != nil {
+		windowStart := now.UTC().Truncate(time.Hour)
+		reserved, err := limiter.ReserveDelivery(ctx, channelID, deliveryKey, windowStart,
+			*settings.MaxDeliveriesPerHour)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("failed to reserve delivery for channel %s: %w", channelID, err)
+		}
+		if !reserved {
+			return windowStart.Add(time.Hour), false, nil
+		}
+	}
+
+	return now, true, nil
+}
+
+// ReleaseHeldDeliveries publishes the held jobs whose window opened.
+// The jobs are checked against the current settings of their channel. Jobs that still fall outside the limits
+// of their channel, e.g. because many held jobs of the same channel are released in the same hour, are
+// rescheduled. Jobs that fail to publish stay held for the next call.
+func (d *Dispatcher) ReleaseHeldDeliveries(ctx context.Context) error {
+	now := d.now()
+	jobs, err := d.limiter.ClaimDueDeliveries(ctx, now, releaseBatchSize, releaseLeaseDuration)
+	if err != nil {
+		return fmt.Errorf("failed to claim held deliveries: %w", err)
+	}
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	sent, rescheduled, failCount := 0, 0, 0
+	for _, job := range jobs {
+		released, err := d.releaseHeldDelivery(ctx, job, now)
+		switch {
+		case err != nil:
+			slog.ErrorContext(ctx, "failed to release held delivery", "error", err)
+			failCount++
+		case released:
+			sent++
+		default:
+			rescheduled++
+		}
+	}
+
+	slog.InfoContext(ctx, "released held deliveries",
+		"sent", sent,
+		"rescheduled", rescheduled,
+		"failed", failCount,
+		"total_candidates", len(jobs))
+
+	if failCount > 0 {
+		return fmt.Errorf("partial failure: %d/%d held jobs failed to release", failCount, len(jobs))
+	}
+
+	return nil
+}
+
+// releaseHeldDelivery publishes a single held job and removes it once it is published.
+// It returns false if the job was rescheduled.
+func (d *Dispatcher) releaseHeldDelivery(ctx context.Context, job workertypes.HeldDeliveryJob,
+	now time.Time) (bool, error) {
+	var deliveryKey string
+	switch {
+	case job.Email != nil:
+		deliveryKey = job.Email.DeliveryKey()
+	case job.Webhook != nil:
+		deliveryKey = job.Webhook.DeliveryKey()
+	default:
+		return false, errEmptyHeldJob
+	}
+	channelID := job.ChannelID()
+
+	settings, err := d.limiter.GetChannelDeliverySettings(ctx, channelID)
+	if err != nil {
+		err = fmt.Errorf("failed to get delivery settings for channel %s: %w", channelID, err)
+
+		// Keep the job so that the next call tries again.
+		return false, errors.Join(err, d.limiter.RescheduleHeldDelivery(ctx, job, now))
+	}
+	releaseAt, admitted, err := admitDelivery(ctx, d.limiter, channelID, deliveryKey, settings, now)
+	if err != nil {
+		return false, errors.Join(err, d.limiter.RescheduleHeldDelivery(ctx, job, now))
+	}
+	if !admitted {
+		return false, d.limiter.RescheduleHeldDelivery(ctx, job, releaseAt)
+	}
+
+	if job.Email != nil {
+		err = d.publisher.PublishEmailJob(ctx, *job.Email)
+	} else {
+		err = d.publisher.PublishWebhookJob(ctx, *job.Webhook)
+	}
+	if err != nil {
+		return false, errors.Join(err, d.limiter.RescheduleHeldDelivery(ctx, job, now))
+	}
+
+	// If this fails, the job is published again once the lease expires. The delivery workers drop the
+	// duplicate by its delivery key.
+	if err := d.limiter.CompleteHeldDelivery(ctx, job); err != nil {
+		return false, fmt.Errorf("failed to complete published held job: %w", err)
+	}
+
+	return true, nil
+}
+
+// RunReleaseLoop calls ReleaseHeldDeliveries every interval until ctx is done.
+func (d *Dispatcher) RunReleaseLoop(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := d.ReleaseHeldDeliveries(ctx); err != nil {
+				slog.ErrorContext(ctx, "failed to release held deliveries", "error", err)
+			}
+		}
+	}
+}
+
 // JobCount returns the total number of delivery jobs generated.
 func (g *deliveryJobGenerator) JobCount() int {
 	return len(g.emailJobs) + len(g.webhookJobs)
@@ -17,6 +17,7 @@ package dispatcher
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 	"time"
 
@@ -155,19 +156,21 @@ func TestProcessEvent_Success(t *testing.T) {
 	subSet := &workertypes.SubscriberSet{
 		Emails: []workertypes.EmailSubscriber{
 			{
-				SubscriptionID: "sub-1",
-				UserID:         "user-1",
-				Triggers:       []workertypes.JobTrigger{workertypes.FeaturePromotedToNewly}, // Matches
-				EmailAddress:   "user1@example.com",
-				ChannelID:      "chan-1",
+				SubscriptionID:   "sub-1",
+				UserID:           "user-1",
+				Triggers:         []workertypes.JobTrigger{workertypes.FeaturePromotedToNewly}, // Matches
+				EmailAddress:     "user1@example.com",
+				ChannelID:        "chan-1",
+				DeliverySettings: nil,
 			},
 			{
 				SubscriptionID: "sub-2",
 				UserID:         "user-2",
 				// Does not match (summary is Newly)
-				Triggers:     []workertypes.JobTrigger{workertypes.FeaturePromotedToWidely},
-				EmailAddress: "user2@example.com",
-				ChannelID:    "chan-2",
+				Triggers:         []workertypes.JobTrigger{workertypes.FeaturePromotedToWidely},
+				EmailAddress:     "user2@example.com",
+				ChannelID:        "chan-2",
+				DeliverySettings: nil,
 			},
 		},
 		Webhooks: []workertypes.WebhookSubscriber{},
@@ -200,7 +203,7 @@ func TestProcessEvent_Success(t *testing.T) {
 	})
 	parser := mockParserFactory(summary, nil)
 
-	d := NewDispatcher(finder, publisher)
+	d := NewDispatcher(finder, publisher, nil)
 	d.parser = parser
 
 	if err := d.ProcessEvent(ctx, metadata, summaryBytes); err != nil {
@@ -262,21 +265,23 @@ func TestProcessEvent_Webhook_Success(t *testing.T) {
 		Emails: []workertypes.EmailSubscriber{},
 		Webhooks: []workertypes.WebhookSubscriber{
 			{
-				SubscriptionID: "sub-1",
-				UserID:         "user-1",
-				Triggers:       []workertypes.JobTrigger{workertypes.FeaturePromotedToNewly}, // Matches
-				WebhookURL:     "https://hooks.slack.com/services/123",
-				WebhookType:    workertypes.WebhookTypeSlack,
-				ChannelID:      "chan-1",
+				SubscriptionID:   "sub-1",
+				UserID:           "user-1",
+				Triggers:         []workertypes.JobTrigger{workertypes.FeaturePromotedToNewly}, // Matches
+				WebhookURL:       "https://hooks.slack.com/services/123",
+				WebhookType:      workertypes.WebhookTypeSlack,
+				ChannelID:        "chan-1",
+				DeliverySettings: nil,
 			},
 			{
 				SubscriptionID: "sub-2",
 				UserID:         "user-2",
 				// Does not match (summary is Newly)
-				Triggers:    []workertypes.JobTrigger{workertypes.FeaturePromotedToWidely},
-				WebhookURL:  "https://hooks.slack.com/services/456",
-				WebhookType: workertypes.WebhookTypeSlack,
-				ChannelID:   "chan-2",
+				Triggers:         []workertypes.JobTrigger{workertypes.FeaturePromotedToWidely},
+				WebhookURL:       "https://hooks.slack.com/services/456",
+				WebhookType:      workertypes.WebhookTypeSlack,
+				ChannelID:        "chan-2",
+				DeliverySettings: nil,
 			},
 		},
 	}
@@ -308,7 +313,7 @@ func TestProcessEvent_Webhook_Success(t *testing.T) {
 	})
 	parser := mockParserFactory(summary, nil)
 
-	d := NewDispatcher(finder, publisher)
+	d := NewDispatcher(finder, publisher, nil)
 	d.parser = parser
 
 	if err := d.ProcessEvent(ctx, metadata, summaryBytes); err != nil {
@@ -370,11 +375,12 @@ func TestProcessEvent_NoChanges_FiltersAll(t *testing.T) {
 	subSet := &workertypes.SubscriberSet{
 		Emails: []workertypes.EmailSubscriber{
 			{
-				SubscriptionID: "sub-1",
-				UserID:         "user-1",
-				Triggers:       []workertypes.JobTrigger{"any_change"},
-				EmailAddress:   "user1@example.com",
-				ChannelID:      "chan-1",
+				SubscriptionID:   "sub-1",
+				UserID:           "user-1",
+				Triggers:         []workertypes.JobTrigger{"any_change"},
+				EmailAddress:     "user1@example.com",
+				ChannelID:        "chan-1",
+				DeliverySettings: nil,
 			},
 		},
 		Webhooks: []workertypes.WebhookSubscriber{},
@@ -391,7 +397,7 @@ func TestProcessEvent_NoChanges_FiltersAll(t *testing.T) {
 	summary := createTestSummary(false)
 	parser := mockParserFactory(summary, nil)
 
-	d := NewDispatcher(finder, publisher)
+	d := NewDispatcher(finder, publisher, nil)
 	d.parser = parser
 
 	if err := d.ProcessEvent(ctx, metadata, []byte("{}")); err != nil {
@@ -404,7 +410,7 @@ func TestProcessEvent_NoChanges_FiltersAll(t *testing.T) {
 }
 
 func TestProcessEvent_ParserError(t *testing.T) {
-	d := NewDispatcher(nil, nil)
+	d := NewDispatcher(nil, nil, nil)
 	var summary workertypes.EventSummary
 	d.parser = mockParserFactory(summary, errors.New("parse error"))
 
@@ -430,7 +436,7 @@ func TestProcessEvent_FinderError(t *testing.T) {
 		findCalledWith: nil,
 	}
 
-	d := NewDispatcher(finder, nil)
+	d := NewDispatcher(finder, nil, nil)
 	// Provide a valid summary struct so parser succeeds
 	var summary workertypes.EventSummary
 	d.parser = mockParserFactory(summary, nil)
@@ -457,9 +463,9 @@ func TestProcessEvent_PublisherPartialFailure(t *testing.T) {
 	subSet := &workertypes.SubscriberSet{
 		Emails: []workertypes.EmailSubscriber{
 			{SubscriptionID: "sub-1", Triggers: []workertypes.JobTrigger{workertypes.FeaturePromotedToNewly},
-				UserID: "u1", EmailAddress: "e1", ChannelID: "chan-1"},
+				UserID: "u1", EmailAddress: "e1", ChannelID: "chan-1", DeliverySettings: nil},
 			{SubscriptionID: "sub-2", Triggers: []workertypes.JobTrigger{workertypes.FeaturePromotedToNewly},
-				UserID: "u2", EmailAddress: "e2", ChannelID: "chan-2"},
+				UserID: "u2", EmailAddress: "e2", ChannelID: "chan-2", DeliverySettings: nil},
 		},
 		Webhooks: []workertypes.WebhookSubscriber{},
 	}
@@ -486,7 +492,7 @@ func TestProcessEvent_PublisherPartialFailure(t *testing.T) {
 
 	summaryWithNewly := withBaselineHighlight(createTestSummary(false),
 		workertypes.BaselineStatusLimited, workertypes.BaselineStatusNewly)
-	d := NewDispatcher(finder, publisher)
+	d := NewDispatcher(finder, publisher, nil)
 	d.parser = mockParserFactory(summaryWithNewly, nil)
 
 	metadata := workertypes.DispatchEventMetadata{
@@ -520,7 +526,7 @@ func TestProcessEvent_JobCount(t *testing.T) {
 	subSet := &workertypes.SubscriberSet{
 		Emails: []workertypes.EmailSubscriber{
 			{SubscriptionID: "sub-1", Triggers: []workertypes.JobTrigger{}, EmailAddress: "e1", UserID: "u1",
-				ChannelID: "chan-1"}, // No match
+				ChannelID: "chan-1", DeliverySettings: nil}, // No match
 		},
 		Webhooks: []workertypes.WebhookSubscriber{},
 	}
@@ -530,7 +536,7 @@ func TestProcessEvent_JobCount(t *testing.T) {
 		findCalledWith: nil,
 	}
 	publisher := new(mockDeliveryPublisher)
-	d := NewDispatcher(finder, publisher)
+	d := NewDispatcher(finder, publisher, nil)
 	d.parser = mockParserFactory(createTestSummary(true), nil)
 
 	metadata := workertypes.DispatchEventMetadata{
@@ -823,7 +829,7 @@ func TestProcessEvent_ResolvedQueryErrors_AllChannels(t *testing.T) {
 	finder.findReturnSet = subSet
 
 	publisher := new(mockDeliveryPublisher)
-	d := NewDispatcher(finder, publisher)
+	d := NewDispatcher(finder, publisher, nil)
 
 	recoveredSummary := createRecoveredSummary()
 	d.parser = mockParserFactory(recoveredSummary, nil)
@@ -866,3 +872,459 @@ func TestShouldNotifyV1_NilSummary(t *testing.T) {
 		t.Errorf("shouldNotifyV1(triggers, nil) = %v, want false", got)
 	}
 }
+
+// --- Delivery Limits ---
+
+type heldJobCall struct {
+	job       workertypes.HeldDeliveryJob
+	releaseAt time.Time
+}
+
+type mockDeliveryLimiter struct {
+	// counts holds the number of reserved deliveries per channel and window.
+	counts map[string]int64
+	// reservations holds the delivery keys counted per channel and window.
+	reservations map[string]bool
+	reserveErr   error
+	// settings holds the current delivery settings per channel.
+	settings map[string]*workertypes.ChannelDeliverySettings
+	// heldEvents holds the IDs of the held events per subscription.
+	heldEvents  map[string][]string
+	held        []heldJobCall
+	due         []workertypes.HeldDeliveryJob
+	claimedAt   time.Time
+	completed   []workertypes.HeldDeliveryJob
+	rescheduled []heldJobCall
+}
+
+func newMockDeliveryLimiter() *mockDeliveryLimiter {
+	return &mockDeliveryLimiter{
+		counts:       make(map[string]int64),
+		reservations: make(map[string]bool),
+		reserveErr:   nil,
+		settings:     make(map[string]*workertypes.ChannelDeliverySettings),
+		heldEvents:   make(map[string][]string),
+		held:         nil,
+		due:          nil,
+		claimedAt:    time.Time{},
+		completed:    nil,
+		rescheduled:  nil,
+	}
+}
+
+func (m *mockDeliveryLimiter) ReserveDelivery(
+	_ context.Context, channelID string, deliveryKey string, windowStart time.Time, limit int64) (bool, error) {
+	if m.reserveErr != nil {
+		return false, m.reserveErr
+	}
+	key := channelID + "/" + windowStart.Format(time.RFC3339)
+	if m.reservations[key+"/"+deliveryKey] {
+		return true, nil
+	}
+	if m.counts[key] >= limit {
+		return false, nil
+	}
+	m.counts[key]++
+	m.reservations[key+"/"+deliveryKey] = true
+
+	return true, nil
+}
+
+func (m *mockDeliveryLimiter) GetChannelDeliverySettings(
+	_ context.Context, channelID string) (*workertypes.ChannelDeliverySettings, error) {
+	return m.settings[channelID], nil
+}
+
+func (m *mockDeliveryLimiter) IsEventHeld(
+	_ context.Context, _ string, subscriptionID string, eventID string) (bool, error) {
+	return slices.Contains(m.heldEvents[subscriptionID], eventID), nil
+}
+
+func (m *mockDeliveryLimiter) HoldEmailJob(_ context.Context, job workertypes.EmailDeliveryJob,
+	releaseAt time.Time) error {
+	m.heldEvents[job.SubscriptionID] = append(m.heldEvents[job.SubscriptionID], job.Metadata.EventID)
+	m.held = append(m.held, heldJobCall{
+		job: workertypes.HeldDeliveryJob{
+			Email: &job, Webhook: nil, HeldCount: 1, LeaseID: "", EventIDs: []string{job.Metadata.EventID}},
+		releaseAt: releaseAt,
+	})
+
+	return nil
+}
+
+func (m *mockDeliveryLimiter) HoldWebhookJob(_ context.Context, job workertypes.WebhookDeliveryJob,
+	releaseAt time.Time) error {
+	m.heldEvents[job.SubscriptionID] = append(m.heldEvents[job.SubscriptionID], job.Metadata.EventID)
+	m.held = append(m.held, heldJobCall{
+		job: workertypes.HeldDeliveryJob{
+			Email: nil, Webhook: &job, HeldCount: 1, LeaseID: "", EventIDs: []string{job.Metadata.EventID}},
+		releaseAt: releaseAt,
+	})
+
+	return nil
+}
+
+func (m *mockDeliveryLimiter) ClaimDueDeliveries(
+	_ context.Context, now time.Time, _ int, _ time.Duration) ([]workertypes.HeldDeliveryJob, error) {
+	m.claimedAt = now
+	due := m.due
+	m.due = nil
+
+	return due, nil
+}
+
+func (m *mockDeliveryLimiter) CompleteHeldDelivery(_ context.Context, job workertypes.HeldDeliveryJob) error {
+	m.completed = append(m.completed, job)
+
+	return nil
+}
+
+func (m *mockDeliveryLimiter) RescheduleHeldDelivery(
+	_ context.Context, job workertypes.HeldDeliveryJob, releaseAt time.Time) error {
+	m.rescheduled = append(m.rescheduled, heldJobCall{job: job, releaseAt: releaseAt})
+
+	return nil
+}
+
+func newLimitedEmailSubscriber(id, channelID string,
+	settings *workertypes.ChannelDeliverySettings) workertypes.EmailSubscriber {
+	return workertypes.EmailSubscriber{
+		SubscriptionID:   id,
+		UserID:           "user-1",
+		Triggers:         []workertypes.JobTrigger{workertypes.FeaturePromotedToNewly},
+		EmailAddress:     id + "@example.com",
+		ChannelID:        channelID,
+		DeliverySettings: settings,
+	}
+}
+
+func newLimitedDispatcher(subSet *workertypes.SubscriberSet, now time.Time) (
+	*Dispatcher, *mockDeliveryPublisher, *mockDeliveryLimiter) {
+	finder := &mockSubscriptionFinder{
+		findReturnSet:  subSet,
+		findReturnErr:  nil,
+		findCalledWith: nil,
+	}
+	publisher := new(mockDeliveryPublisher)
+	limiter := newMockDeliveryLimiter()
+	d := NewDispatcher(finder, publisher, limiter)
+	d.parser = mockParserFactory(withBaselineHighlight(createTestSummary(false),
+		workertypes.BaselineStatusLimited, workertypes.BaselineStatusNewly), nil)
+	d.now = func() time.Time { return now }
+
+	return d, publisher, limiter
+}
+
+func TestProcessEvent_QuietHoursHoldJobs(t *testing.T) {
+	// 23:30 in UTC, within the quiet hours.
+	now := time.Date(2026, 3, 10, 23, 30, 0, 0, time.UTC)
+	quiet := &workertypes.ChannelDeliverySettings{
+		QuietHours:           &workertypes.QuietHours{Start: "22:00", End: "07:00", TimeZone: "UTC"},
+		MaxDeliveriesPerHour: nil,
+	}
+	subSet := &workertypes.SubscriberSet{
+		Emails: []workertypes.EmailSubscriber{
+			newLimitedEmailSubscriber("sub-quiet", "chan-quiet", quiet),
+			newLimitedEmailSubscriber("sub-open", "chan-open", nil),
+		},
+		Webhooks: []workertypes.WebhookSubscriber{
+			{
+				SubscriptionID:   "sub-webhook",
+				UserID:           "user-1",
+				Triggers:         []workertypes.JobTrigger{workertypes.FeaturePromotedToNewly},
+				WebhookURL:       "https://hooks.slack.com/services/123",
+				WebhookType:      workertypes.WebhookTypeSlack,
+				ChannelID:        "chan-webhook",
+				DeliverySettings: quiet,
+			},
+		},
+	}
+	d, publisher, limiter := newLimitedDispatcher(subSet, now)
+
+	if err := d.ProcessEvent(context.Background(), workertypes.DispatchEventMetadata{
+		EventID:     "evt-1",
+		SearchID:    "search-1",
+		SearchName:  "",
+		Frequency:   workertypes.FrequencyImmediate,
+		Query:       "",
+		GeneratedAt: now,
+	}, []byte("{}")); err != nil {
+		t.Fatalf("ProcessEvent unexpected error: %v", err)
+	}
+
+	if len(publisher.emailJobs) != 1 || publisher.emailJobs[0].SubscriptionID != "sub-open" {
+		t.Errorf("expected only sub-open to be published, got %+v", publisher.emailJobs)
+	}
+	if len(publisher.webhookJobs) != 0 {
+		t.Errorf("expected no webhook jobs to be published, got %d", len(publisher.webhookJobs))
+	}
+	if len(limiter.held) != 2 {
+		t.Fatalf("expected 2 held jobs, got %d", len(limiter.held))
+	}
+	wantRelease := time.Date(2026, 3, 11, 7, 0, 0, 0, time.UTC)
+	for _, h := range limiter.held {
+		if !h.releaseAt.Equal(wantRelease) {
+			t.Errorf("releaseAt = %v, want %v", h.releaseAt, wantRelease)
+		}
+	}
+	if limiter.held[0].job.Email == nil || limiter.held[0].job.Email.SubscriptionID != "sub-quiet" {
+		t.Errorf("expected sub-quiet email job to be held, got %+v", limiter.held[0].job)
+	}
+	if limiter.held[1].job.Webhook == nil || limiter.held[1].job.Webhook.SubscriptionID != "sub-webhook" {
+		t.Errorf("expected sub-webhook job to be held, got %+v", limiter.held[1].job)
+	}
+}
+
+func TestProcessEvent_HourlyLimitHoldsJobs(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 15, 0, 0, time.UTC)
+	limited := &workertypes.ChannelDeliverySettings{
+		QuietHours:           nil,
+		MaxDeliveriesPerHour: new(int64(2)),
+	}
+	subSet := &workertypes.SubscriberSet{
+		Emails: []workertypes.EmailSubscriber{
+			newLimitedEmailSubscriber("sub-1", "chan-1", limited),
+			newLimitedEmailSubscriber("sub-2", "chan-1", limited),
+			newLimitedEmailSubscriber("sub-3", "chan-1", limited),
+		},
+		Webhooks: []workertypes.WebhookSubscriber{},
+	}
+	d, publisher, limiter := newLimitedDispatcher(subSet, now)
+
+	if err := d.ProcessEvent(context.Background(), workertypes.DispatchEventMetadata{
+		EventID:     "evt-1",
+		SearchID:    "search-1",
+		SearchName:  "",
+		Frequency:   workertypes.FrequencyImmediate,
+		Query:       "",
+		GeneratedAt: now,
+	}, []byte("{}")); err != nil {
+		t.Fatalf("ProcessEvent unexpected error: %v", err)
+	}
+
+	if len(publisher.emailJobs) != 2 {
+		t.Errorf("expected 2 published jobs, got %d", len(publisher.emailJobs))
+	}
+	if len(limiter.held) != 1 {
+		t.Fatalf("expected 1 held job, got %d", len(limiter.held))
+	}
+	if got := limiter.held[0].job.Email.SubscriptionID; got != "sub-3" {
+		t.Errorf("held subscription = %q, want sub-3", got)
+	}
+	wantRelease := time.Date(2026, 3, 10, 13, 0, 0, 0, time.UTC)
+	if !limiter.held[0].releaseAt.Equal(wantRelease) {
+		t.Errorf("releaseAt = %v, want %v", limiter.held[0].releaseAt, wantRelease)
+	}
+}
+
+func TestProcessEvent_RedeliveredEventIsNotCountedTwice(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 15, 0, 0, time.UTC)
+	limited := &workertypes.ChannelDeliverySettings{
+		QuietHours:           nil,
+		MaxDeliveriesPerHour: new(int64(2)),
+	}
+	subSet := &workertypes.SubscriberSet{
+		Emails: []workertypes.EmailSubscriber{
+			newLimitedEmailSubscriber("sub-1", "chan-1", limited),
+			newLimitedEmailSubscriber("sub-2", "chan-1", limited),
+			newLimitedEmailSubscriber("sub-3", "chan-1", limited),
+		},
+		Webhooks: []workertypes.WebhookSubscriber{},
+	}
+	d, publisher, limiter := newLimitedDispatcher(subSet, now)
+	metadata := workertypes.DispatchEventMetadata{
+		EventID:     "evt-1",
+		SearchID:    "search-1",
+		SearchName:  "",
+		Frequency:   workertypes.FrequencyImmediate,
+		Query:       "",
+		GeneratedAt: now,
+	}
+
+	// Pub/Sub may deliver the same event more than once.
+	for range 2 {
+		if err := d.ProcessEvent(context.Background(), metadata, []byte("{}")); err != nil {
+			t.Fatalf("ProcessEvent unexpected error: %v", err)
+		}
+	}
+
+	// The admitted jobs are published again (the delivery workers drop the duplicates) without using up
+	// more of the hourly limit.
+	if len(publisher.emailJobs) != 4 {
+		t.Errorf("expected 4 published jobs, got %d", len(publisher.emailJobs))
+	}
+	for _, job := range publisher.emailJobs {
+		if job.SubscriptionID == "sub-3" {
+			t.Errorf("expected the held job not to be published, got %+v", job)
+		}
+	}
+	if got := limiter.counts["chan-1/"+now.Truncate(time.Hour).Format(time.RFC3339)]; got != 2 {
+		t.Errorf("expected 2 counted deliveries, got %d", got)
+	}
+	// The held job is not held a second time.
+	if len(limiter.held) != 1 {
+		t.Fatalf("expected 1 held job, got %d", len(limiter.held))
+	}
+}
+
+func TestProcessEvent_LimiterError(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 15, 0, 0, time.UTC)
+	limited := &workertypes.ChannelDeliverySettings{
+		QuietHours:           nil,
+		MaxDeliveriesPerHour: new(int64(2)),
+	}
+	subSet := &workertypes.SubscriberSet{
+		Emails:   []workertypes.EmailSubscriber{newLimitedEmailSubscriber("sub-1", "chan-1", limited)},
+		Webhooks: []workertypes.WebhookSubscriber{},
+	}
+	d, publisher, limiter := newLimitedDispatcher(subSet, now)
+	limiter.reserveErr = errors.New("spanner down")
+
+	err := d.ProcessEvent(context.Background(), workertypes.DispatchEventMetadata{
+		EventID:     "evt-1",
+		SearchID:    "search-1",
+		SearchName:  "",
+		Frequency:   workertypes.FrequencyImmediate,
+		Query:       "",
+		GeneratedAt: now,
+	}, []byte("{}"))
+	if !errors.Is(err, limiter.reserveErr) {
+		t.Errorf("expected limiter error, got %v", err)
+	}
+	if len(publisher.emailJobs) != 0 {
+		t.Errorf("expected no jobs to be published, got %d", len(publisher.emailJobs))
+	}
+}
+
+func TestReleaseHeldDeliveries(t *testing.T) {
+	now := time.Date(2026, 3, 11, 7, 1, 0, 0, time.UTC)
+	limited := &workertypes.ChannelDeliverySettings{
+		QuietHours:           &workertypes.QuietHours{Start: "22:00", End: "07:00", TimeZone: "UTC"},
+		MaxDeliveriesPerHour: new(int64(1)),
+	}
+	emailJob := func(subID string) *workertypes.EmailDeliveryJob {
+		return &workertypes.EmailDeliveryJob{
+			SubscriptionID: subID,
+			RecipientEmail: subID + "@example.com",
+			ChannelID:      "chan-1",
+			Triggers:       nil,
+			SummaryRaw:     []byte("{}"),
+			Metadata: workertypes.DeliveryMetadata{
+				EventID:     "evt-" + subID,
+				SearchID:    "search-1",
+				SearchName:  "",
+				Query:       "",
+				Frequency:   workertypes.FrequencyImmediate,
+				GeneratedAt: now,
+			},
+		}
+	}
+	webhookJob := &workertypes.WebhookDeliveryJob{
+		SubscriptionID: "sub-webhook",
+		WebhookURL:     "https://hooks.slack.com/services/123",
+		WebhookType:    workertypes.WebhookTypeSlack,
+		ChannelID:      "chan-webhook",
+		Triggers:       nil,
+		SummaryRaw:     []byte("{}"),
+		Metadata:       emailJob("sub-webhook").Metadata,
+	}
+
+	d, publisher, limiter := newLimitedDispatcher(nil, now)
+	// The jobs are released against the current settings of their channel.
+	limiter.settings["chan-1"] = limited
+	limiter.due = []workertypes.HeldDeliveryJob{
+		{Email: emailJob("sub-1"), Webhook: nil, HeldCount: 3, LeaseID: "lease-1", EventIDs: nil},
+		// Same channel, over the hourly limit.
+		{Email: emailJob("sub-2"), Webhook: nil, HeldCount: 1, LeaseID: "lease-1", EventIDs: nil},
+		// The channel no longer limits its deliveries.
+		{Email: nil, Webhook: webhookJob, HeldCount: 2, LeaseID: "lease-1", EventIDs: nil},
+	}
+
+	if err := d.ReleaseHeldDeliveries(context.Background()); err != nil {
+		t.Fatalf("ReleaseHeldDeliveries unexpected error: %v", err)
+	}
+
+	if !limiter.claimedAt.Equal(now) {
+		t.Errorf("claimed at %v, want %v", limiter.claimedAt, now)
+	}
+	if len(publisher.emailJobs) != 1 || publisher.emailJobs[0].SubscriptionID != "sub-1" {
+		t.Errorf("expected sub-1 to be published, got %+v", publisher.emailJobs)
+	}
+	if len(publisher.webhookJobs) != 1 || publisher.webhookJobs[0].SubscriptionID != "sub-webhook" {
+		t.Errorf("expected sub-webhook to be published, got %+v", publisher.webhookJobs)
+	}
+	// Only published jobs are removed.
+	if len(limiter.completed) != 2 || limiter.completed[0].SubscriptionID() != "sub-1" ||
+		limiter.completed[1].SubscriptionID() != "sub-webhook" {
+		t.Errorf("expected sub-1 and sub-webhook to be completed, got %+v", limiter.completed)
+	}
+	if len(limiter.rescheduled) != 1 {
+		t.Fatalf("expected 1 job to be rescheduled, got %d", len(limiter.rescheduled))
+	}
+	if got := limiter.rescheduled[0].job.SubscriptionID(); got != "sub-2" {
+		t.Errorf("rescheduled subscription = %q, want sub-2", got)
+	}
+	wantRelease := time.Date(2026, 3, 11, 8, 0, 0, 0, time.UTC)
+	if !limiter.rescheduled[0].releaseAt.Equal(wantRelease) {
+		t.Errorf("releaseAt = %v, want %v", limiter.rescheduled[0].releaseAt, wantRelease)
+	}
+	if len(limiter.held) != 0 {
+		t.Errorf("expected no job to be held again, got %d", len(limiter.held))
+	}
+}
+
+func TestReleaseHeldDeliveries_PublishFailure(t *testing.T) {
+	now := time.Date(2026, 3, 11, 7, 1, 0, 0, time.UTC)
+	d, publisher, limiter := newLimitedDispatcher(nil, now)
+	publisher.emailJobErr = func(_ workertypes.EmailDeliveryJob) error {
+		return errors.New("pubsub down")
+	}
+	limiter.due = []workertypes.HeldDeliveryJob{
+		{
+			Email: &workertypes.EmailDeliveryJob{
+				SubscriptionID: "sub-1",
+				RecipientEmail: "sub-1@example.com",
+				ChannelID:      "chan-1",
+				Triggers:       nil,
+				SummaryRaw:     []byte("{}"),
+				Metadata: workertypes.DeliveryMetadata{
+					EventID:     "evt-1",
+					SearchID:    "search-1",
+					SearchName:  "",
+					Query:       "",
+					Frequency:   workertypes.FrequencyImmediate,
+					GeneratedAt: now,
+				},
+			},
+			Webhook:   nil,
+			HeldCount: 1,
+			LeaseID:   "lease-1",
+			EventIDs:  []string{"evt-1"},
+		},
+	}
+
+	if err := d.ReleaseHeldDeliveries(context.Background()); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if len(limiter.completed) != 0 {
+		t.Errorf("expected the job to stay held, got %+v", limiter.completed)
+	}
+	if len(limiter.rescheduled) != 1 || !limiter.rescheduled[0].releaseAt.Equal(now) {
+		t.Fatalf("expected the job to be rescheduled for the next release, got %+v", limiter.rescheduled)
+	}
+}
+
+func TestAdmitDelivery_InvalidQuietHours(t *testing.T) {
+	now := time.Date(2026, 3, 10, 23, 30, 0, 0, time.UTC)
+	settings := &workertypes.ChannelDeliverySettings{
+		QuietHours:           &workertypes.QuietHours{Start: "22:00", End: "07:00", TimeZone: "Not/AZone"},
+		MaxDeliveriesPerHour: nil,
+	}
+	_, admitted, err := admitDelivery(context.Background(), newMockDeliveryLimiter(), "chan-1", "key-1", settings, now)
+	if err != nil {
+		t.Fatalf("admitDelivery unexpected error: %v", err)
+	}
+	if !admitted {
+		t.Error("expected invalid quiet hours to be ignored")
+	}
+}
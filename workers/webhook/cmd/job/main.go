@@ -19,6 +19,7 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/GoogleChrome/webstatus.dev/lib/gcppubsub"
@@ -84,16 +85,31 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Optional: Store permanently failed messages instead of dropping them.
+	if deadLetterTopicID := os.Getenv("DEAD_LETTER_TOPIC_ID"); deadLetterTopicID != "" {
+		queueClient.SetDeadLetterTopic(deadLetterTopicID, gcppubsub.WorkerID("webhook-worker"))
+	}
+
 	httpClient := &http.Client{
 		Transport:     nil,
 		CheckRedirect: nil,
 		Jar:           nil,
 		Timeout:       30 * time.Second,
 	}
+	retryPolicy := webhook.DefaultRetryPolicy()
+	if maxAttempts := os.Getenv("WEBHOOK_MAX_ATTEMPTS"); maxAttempts != "" {
+		retryPolicy.MaxAttempts, err = strconv.Atoi(maxAttempts)
+		if err != nil {
+			slog.ErrorContext(ctx, "invalid WEBHOOK_MAX_ATTEMPTS", "error", err)
+			os.Exit(1)
+		}
+	}
+
 	webhookSender := webhook.NewSender(
 		httpClient,
 		spanneradapters.NewNotificationChannelStateManager(spannerClient),
 		frontendBaseURL,
+		retryPolicy,
 	)
 
 	listener := gcppubsubadapters.NewWebhookWorkerSubscriberAdapter(
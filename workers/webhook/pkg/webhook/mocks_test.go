@@ -36,6 +36,10 @@ type mockChannelStateManager struct {
 	successCalls []successCall
 	failureCalls []failureCall
 	recordErr    error
+	// completedKeys simulates delivery keys that were completed by a previous delivery.
+	completedKeys map[string]bool
+	claimErr      error
+	retriedCalls  []retriedCall
 }
 
 type successCall struct {
@@ -52,6 +56,13 @@ type failureCall struct {
 	eventID     string
 }
 
+type retriedCall struct {
+	channelID string
+	err       error
+	attempt   int
+	eventID   string
+}
+
 func (m *mockChannelStateManager) RecordSuccess(_ context.Context, channelID string,
 	timestamp time.Time, eventID string) error {
 	m.successCalls = append(m.successCalls, successCall{channelID, timestamp, eventID})
@@ -66,6 +77,31 @@ func (m *mockChannelStateManager) RecordFailure(_ context.Context, channelID str
 	return m.recordErr
 }
 
+func (m *mockChannelStateManager) RecordRetriedFailure(_ context.Context, channelID string,
+	err error, _ time.Time, attempt int, eventID string) error {
+	m.retriedCalls = append(m.retriedCalls, retriedCall{channelID, err, attempt, eventID})
+
+	return m.recordErr
+}
+
+// newTestSender creates a sender with the default retry policy that does not actually sleep.
+// The delays it would have slept for are appended to sleeps, if not nil.
+func newTestSender(httpClient HTTPClient, stateManager ChannelStateManager,
+	sleeps *[]time.Duration) *Sender {
+	s := NewSender(httpClient, stateManager, "https://webstatus.dev", DefaultRetryPolicy())
+	s.sleep = func(_ context.Context, d time.Duration) error {
+		if sleeps != nil {
+			*sleeps = append(*sleeps, d)
+		}
+
+		return nil
+	}
+	// No randomness: always use the fixed half of the backoff.
+	s.jitter = func(_ time.Duration) time.Duration { return 0 }
+
+	return s
+}
+
 func newTestIncomingWebhookDeliveryJob(url string, wType workertypes.WebhookType,
 	query string, summary []byte) workertypes.IncomingWebhookDeliveryJob {
 	return workertypes.IncomingWebhookDeliveryJob{
@@ -111,3 +147,22 @@ func newTestResponse(status int, body string) *http.Response {
 func testGeneratedAt() time.Time {
 	return time.Date(2026, 3, 12, 0, 0, 0, 0, time.UTC)
 }
+
+func (m *mockChannelStateManager) ClaimDelivery(_ context.Context, _ string,
+	deliveryKey string, _ time.Time) (bool, error) {
+	if m.claimErr != nil {
+		return false, m.claimErr
+	}
+
+	return !m.completedKeys[deliveryKey], nil
+}
+
+func (m *mockChannelStateManager) CompleteDelivery(_ context.Context, _ string,
+	deliveryKey string, _ time.Time) error {
+	if m.completedKeys == nil {
+		m.completedKeys = make(map[string]bool)
+	}
+	m.completedKeys[deliveryKey] = true
+
+	return m.recordErr
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     5 * time.Second,
+	}
+	noJitter := func(_ time.Duration) time.Duration { return 0 }
+	fullJitter := func(d time.Duration) time.Duration { return d }
+
+	tests := []struct {
+		name    string
+		attempt int
+		jitter  func(time.Duration) time.Duration
+		want    time.Duration
+	}{
+		{name: "first retry without jitter", attempt: 1, jitter: noJitter, want: 500 * time.Millisecond},
+		{name: "first retry with full jitter", attempt: 1, jitter: fullJitter, want: time.Second},
+		{name: "second retry doubles", attempt: 2, jitter: fullJitter, want: 2 * time.Second},
+		{name: "third retry doubles", attempt: 3, jitter: fullJitter, want: 4 * time.Second},
+		{name: "capped at max backoff", attempt: 4, jitter: fullJitter, want: 5 * time.Second},
+		{name: "capped half without jitter", attempt: 10, jitter: noJitter, want: 2500 * time.Millisecond},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := policy.backoff(tc.attempt, tc.jitter); got != tc.want {
+				t.Errorf("backoff(%d) = %s, want %s", tc.attempt, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRandomJitter(t *testing.T) {
+	for range 100 {
+		if got := randomJitter(time.Second); got < 0 || got > time.Second {
+			t.Fatalf("randomJitter() = %s, want within [0, 1s]", got)
+		}
+	}
+	if got := randomJitter(0); got != 0 {
+		t.Errorf("randomJitter(0) = %s, want 0", got)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 3, 12, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{name: "empty", value: "", want: 0, wantOK: false},
+		{name: "seconds", value: "7", want: 7 * time.Second, wantOK: true},
+		{name: "negative seconds", value: "-1", want: 0, wantOK: false},
+		{name: "http date", value: "Thu, 12 Mar 2026 10:00:30 GMT", want: 30 * time.Second, wantOK: true},
+		{name: "http date in the past", value: "Thu, 12 Mar 2026 09:00:00 GMT", want: 0, wantOK: true},
+		{name: "garbage", value: "soon", want: 0, wantOK: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tc.value, now)
+			if got != tc.want || ok != tc.wantOK {
+				t.Errorf("parseRetryAfter(%q) = (%s, %t), want (%s, %t)", tc.value, got, ok, tc.want, tc.wantOK)
+			}
+		})
+	}
+}
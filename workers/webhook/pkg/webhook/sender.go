@@ -30,6 +30,15 @@ type ChannelStateManager interface {
 	RecordSuccess(ctx context.Context, channelID string, timestamp time.Time, eventID string) error
 	RecordFailure(ctx context.Context, channelID string, err error, timestamp time.Time,
 		isPermanent bool, eventID string) error
+	// RecordRetriedFailure records a failed attempt that is going to be retried.
+	// It does not count toward the channel's consecutive failures.
+	RecordRetriedFailure(ctx context.Context, channelID string, err error, timestamp time.Time,
+		attempt int, eventID string) error
+	// ClaimDelivery records the delivery key before sending.
+	// It returns false if the key already completed and the send must be skipped.
+	ClaimDelivery(ctx context.Context, channelID string, deliveryKey string, timestamp time.Time) (bool, error)
+	// CompleteDelivery marks the delivery key as completed after a successful send.
+	CompleteDelivery(ctx context.Context, channelID string, deliveryKey string, timestamp time.Time) error
 }
 
 type HTTPClient interface {
@@ -40,21 +49,27 @@ type Sender struct {
 	httpClient      HTTPClient
 	stateManager    ChannelStateManager
 	frontendBaseURL string
+	retryPolicy     RetryPolicy
+	// sleep and jitter are swapped out in tests.
+	sleep  func(ctx context.Context, d time.Duration) error
+	jitter func(d time.Duration) time.Duration
 }
 
-func NewSender(httpClient HTTPClient, stateManager ChannelStateManager, frontendBaseURL string) *Sender {
+func NewSender(httpClient HTTPClient, stateManager ChannelStateManager, frontendBaseURL string,
+	retryPolicy RetryPolicy) *Sender {
 	return &Sender{
 		httpClient:      httpClient,
 		stateManager:    stateManager,
 		frontendBaseURL: frontendBaseURL,
+		retryPolicy:     retryPolicy,
+		sleep:           sleepContext,
+		jitter:          randomJitter,
 	}
 }
 
 var (
 	// ErrTransientWebhook is a transient failure that should be retried.
 	ErrTransientWebhook = errors.New("transient webhook failure")
-	// ErrPermanentWebhook is a permanent failure that should not be retried.
-	ErrPermanentWebhook = errors.New("permanent webhook failure")
 )
 
 type webhookSender interface {
@@ -76,13 +91,30 @@ func (s *Sender) getManager(_ context.Context, job workertypes.IncomingWebhookDe
 
 		return &Manager{sender: slack}, nil
 	default:
-		return nil, fmt.Errorf("%w: unsupported type %v", ErrPermanentWebhook, job.WebhookType)
+		return nil, fmt.Errorf("%w: unsupported type %v", workertypes.ErrPermanentWebhook, job.WebhookType)
 	}
 }
 
 func (s *Sender) SendWebhook(ctx context.Context, job workertypes.IncomingWebhookDeliveryJob) error {
 	slog.InfoContext(ctx, "sending webhook", "channelID", job.ChannelID)
 
+	// Pub/Sub redeliveries and dispatcher retries share the same delivery key.
+	deliveryKey := job.DeliveryKey()
+	if deliveryKey != "" {
+		claimed, err := s.stateManager.ClaimDelivery(ctx, job.ChannelID, deliveryKey, time.Now())
+		if err != nil {
+			slog.ErrorContext(ctx, "failed to claim delivery key", "channelID", job.ChannelID, "error", err)
+
+			return errors.Join(event.ErrTransientFailure, err)
+		}
+		if !claimed {
+			slog.InfoContext(ctx, "skipping webhook that was already delivered",
+				"channelID", job.ChannelID, "subscriptionID", job.SubscriptionID, "eventID", job.Metadata.EventID)
+
+			return nil
+		}
+	}
+
 	mgr, err := s.getManager(ctx, job)
 	if err != nil {
 		// If we fail here, it's permanent when trying to get the manager.
@@ -91,17 +123,25 @@ func (s *Sender) SendWebhook(ctx context.Context, job workertypes.IncomingWebhoo
 		return fmt.Errorf("failed to prepare webhook: %w", err)
 	}
 
-	if err := mgr.sender.Send(ctx); err != nil {
-		isTransient := errors.Is(err, ErrTransientWebhook)
-		s.recordFailure(ctx, job, err, !isTransient)
+	if err := s.sendWithRetry(ctx, job, mgr); err != nil {
+		// Only the final outcome of the delivery is recorded, and only a permanent one counts toward the
+		// channel health. Transient failures are redelivered by Pub/Sub.
+		s.recordFailure(ctx, job, err, !errors.Is(err, ErrTransientWebhook))
 
-		if isTransient {
+		if errors.Is(err, ErrTransientWebhook) {
 			return errors.Join(event.ErrTransientFailure, err)
 		}
 
 		return fmt.Errorf("failed to send webhook: %w", err)
 	}
 
+	if deliveryKey != "" {
+		// The webhook is already sent. Returning an error here would cause a duplicate, so only log.
+		if err := s.stateManager.CompleteDelivery(ctx, job.ChannelID, deliveryKey, time.Now()); err != nil {
+			slog.WarnContext(ctx, "failed to complete delivery key", "error", err)
+		}
+	}
+
 	if err := s.stateManager.RecordSuccess(ctx, job.ChannelID, time.Now(), job.WebhookEventID); err != nil {
 		slog.WarnContext(ctx, "failed to record success", "error", err)
 	}
@@ -109,6 +149,44 @@ func (s *Sender) SendWebhook(ctx context.Context, job workertypes.IncomingWebhoo
 	return nil
 }
 
+// sendWithRetry sends the webhook, retrying transient failures according to the retry policy.
+// Every failed attempt that is retried gets logged. The error of the last attempt is returned.
+func (s *Sender) sendWithRetry(ctx context.Context, job workertypes.IncomingWebhookDeliveryJob,
+	mgr *Manager) error {
+	maxAttempts := s.retryPolicy.maxAttempts()
+	for attempt := 1; ; attempt++ {
+		err := mgr.sender.Send(ctx)
+		if err == nil || !errors.Is(err, ErrTransientWebhook) || attempt >= maxAttempts {
+			return err
+		}
+
+		delay := s.retryPolicy.backoff(attempt, s.jitter)
+		var retryAfter *RetryAfterError
+		if errors.As(err, &retryAfter) {
+			if retryAfter.Delay > s.retryPolicy.MaxBackoff {
+				// The endpoint wants us to back off longer than we are willing to wait in the worker.
+				// Hand the message back to Pub/Sub instead.
+				slog.WarnContext(ctx, "webhook retry-after exceeds max backoff, not retrying",
+					"channelID", job.ChannelID, "retryAfter", retryAfter.Delay)
+
+				return err
+			}
+			delay = max(delay, retryAfter.Delay)
+		}
+
+		slog.WarnContext(ctx, "webhook attempt failed, retrying", "channelID", job.ChannelID,
+			"attempt", attempt, "delay", delay, "error", err)
+		if dbErr := s.stateManager.RecordRetriedFailure(ctx, job.ChannelID, err, time.Now(),
+			attempt, job.WebhookEventID); dbErr != nil {
+			slog.ErrorContext(ctx, "failed to record retried failure", "error", dbErr)
+		}
+
+		if sleepErr := s.sleep(ctx, delay); sleepErr != nil {
+			return errors.Join(err, sleepErr)
+		}
+	}
+}
+
 func (s *Sender) recordFailure(ctx context.Context, job workertypes.IncomingWebhookDeliveryJob,
 	err error, permanent bool) {
 	if dbErr := s.stateManager.RecordFailure(ctx, job.ChannelID, err, time.Now(),
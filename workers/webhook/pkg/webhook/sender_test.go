@@ -21,9 +21,11 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/GoogleChrome/webstatus.dev/lib/event"
 	"github.com/GoogleChrome/webstatus.dev/lib/workertypes"
+	"github.com/google/go-cmp/cmp"
 )
 
 func TestSender_SendWebhook_Success(t *testing.T) {
@@ -40,11 +42,14 @@ func TestSender_SendWebhook_Success(t *testing.T) {
 	}
 
 	mockState := &mockChannelStateManager{
-		successCalls: nil,
-		failureCalls: nil,
-		recordErr:    nil,
+		successCalls:  nil,
+		failureCalls:  nil,
+		recordErr:     nil,
+		completedKeys: nil,
+		claimErr:      nil,
+		retriedCalls:  nil,
 	}
-	sender := NewSender(mockHTTP, mockState, "https://webstatus.dev")
+	sender := newTestSender(mockHTTP, mockState, nil)
 
 	job := newTestIncomingWebhookDeliveryJob(
 		"https://hooks.slack.com/services/123", workertypes.WebhookTypeSlack, "group:css", []byte(`{"text":"test"}`))
@@ -64,11 +69,14 @@ func TestSender_SendWebhook_TransientFailure(t *testing.T) {
 		},
 	}
 	mockState := &mockChannelStateManager{
-		successCalls: nil,
-		failureCalls: nil,
-		recordErr:    nil,
+		successCalls:  nil,
+		failureCalls:  nil,
+		recordErr:     nil,
+		completedKeys: nil,
+		claimErr:      nil,
+		retriedCalls:  nil,
 	}
-	sender := NewSender(mockHTTP, mockState, "https://webstatus.dev")
+	sender := newTestSender(mockHTTP, mockState, nil)
 
 	job := newTestIncomingWebhookDeliveryJob(
 		"https://hooks.slack.com/services/123", workertypes.WebhookTypeSlack, "group:css", []byte(`{"text":"test"}`))
@@ -82,12 +90,7 @@ func TestSender_SendWebhook_TransientFailure(t *testing.T) {
 		t.Errorf("expected transient failure error, got %v", err)
 	}
 
-	if len(mockState.failureCalls) != 1 {
-		t.Errorf("expected 1 failure call, got %d", len(mockState.failureCalls))
-	}
-	if mockState.failureCalls[0].isPermanent {
-		t.Error("expected transient failure recorded")
-	}
+	verifyRetriesExhausted(t, mockState)
 }
 
 func TestSender_SendWebhook_FeatureDeepLink_Success(t *testing.T) {
@@ -104,11 +107,14 @@ func TestSender_SendWebhook_FeatureDeepLink_Success(t *testing.T) {
 	}
 
 	mockState := &mockChannelStateManager{
-		successCalls: nil,
-		failureCalls: nil,
-		recordErr:    nil,
+		successCalls:  nil,
+		failureCalls:  nil,
+		recordErr:     nil,
+		completedKeys: nil,
+		claimErr:      nil,
+		retriedCalls:  nil,
 	}
-	sender := NewSender(mockHTTP, mockState, "https://webstatus.dev")
+	sender := newTestSender(mockHTTP, mockState, nil)
 
 	job := newTestIncomingWebhookDeliveryJob(
 		"https://hooks.slack.com/services/123", workertypes.WebhookTypeSlack,
@@ -129,11 +135,14 @@ func TestSender_SendWebhook_HTTPFailure(t *testing.T) {
 		},
 	}
 	mockState := &mockChannelStateManager{
-		successCalls: nil,
-		failureCalls: nil,
-		recordErr:    nil,
+		successCalls:  nil,
+		failureCalls:  nil,
+		recordErr:     nil,
+		completedKeys: nil,
+		claimErr:      nil,
+		retriedCalls:  nil,
 	}
-	sender := NewSender(mockHTTP, mockState, "https://webstatus.dev")
+	sender := newTestSender(mockHTTP, mockState, nil)
 
 	job := newTestIncomingWebhookDeliveryJob(
 		"https://hooks.slack.com/services/123", workertypes.WebhookTypeSlack, "group:css", []byte(`{"text":"test"}`))
@@ -147,8 +156,124 @@ func TestSender_SendWebhook_HTTPFailure(t *testing.T) {
 		t.Errorf("expected transient failure error, got %v", err)
 	}
 
+	verifyRetriesExhausted(t, mockState)
+}
+
+func newTestMockState() *mockChannelStateManager {
+	return &mockChannelStateManager{
+		successCalls:  nil,
+		failureCalls:  nil,
+		recordErr:     nil,
+		completedKeys: nil,
+		claimErr:      nil,
+		retriedCalls:  nil,
+	}
+}
+
+func TestSender_SendWebhook_RetryThenSuccess(t *testing.T) {
+	tests := []struct {
+		name          string
+		firstResponse func() *http.Response
+		expectedSleep time.Duration
+	}{
+		{
+			name: "429 honors Retry-After",
+			firstResponse: func() *http.Response {
+				resp := newTestResponse(http.StatusTooManyRequests, "rate limited")
+				resp.Header.Set("Retry-After", "10")
+
+				return resp
+			},
+			expectedSleep: 10 * time.Second,
+		},
+		{
+			name: "429 without Retry-After uses backoff",
+			firstResponse: func() *http.Response {
+				return newTestResponse(http.StatusTooManyRequests, "rate limited")
+			},
+			expectedSleep: DefaultRetryPolicy().InitialBackoff / 2,
+		},
+		{
+			name: "5xx uses backoff",
+			firstResponse: func() *http.Response {
+				return newTestResponse(http.StatusServiceUnavailable, "unavailable")
+			},
+			expectedSleep: DefaultRetryPolicy().InitialBackoff / 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			requests := 0
+			mockHTTP := &mockHTTPClient{
+				doFunc: func(_ *http.Request) (*http.Response, error) {
+					requests++
+					if requests == 1 {
+						return tc.firstResponse(), nil
+					}
+
+					return newTestResponse(http.StatusOK, "ok"), nil
+				},
+			}
+			mockState := newTestMockState()
+			var sleeps []time.Duration
+			sender := newTestSender(mockHTTP, mockState, &sleeps)
+
+			job := newTestIncomingWebhookDeliveryJob(
+				"https://hooks.slack.com/services/123", workertypes.WebhookTypeSlack, "group:css",
+				[]byte(`{"text":"test"}`))
+
+			if err := sender.SendWebhook(context.Background(), job); err != nil {
+				t.Fatalf("SendWebhook failed: %v", err)
+			}
+
+			if requests != 2 {
+				t.Errorf("expected 2 requests, got %d", requests)
+			}
+			if diff := cmp.Diff([]time.Duration{tc.expectedSleep}, sleeps); diff != "" {
+				t.Errorf("sleeps mismatch (-want +got):\n%s", diff)
+			}
+			if len(mockState.retriedCalls) != 1 || mockState.retriedCalls[0].attempt != 1 {
+				t.Errorf("expected one retried attempt, got %+v", mockState.retriedCalls)
+			}
+			if len(mockState.failureCalls) != 0 {
+				t.Errorf("expected no failure calls, got %d", len(mockState.failureCalls))
+			}
+			verifySuccess(t, mockState)
+		})
+	}
+}
+
+func TestSender_SendWebhook_RetryAfterTooLong(t *testing.T) {
+	requests := 0
+	mockHTTP := &mockHTTPClient{
+		doFunc: func(_ *http.Request) (*http.Response, error) {
+			requests++
+			resp := newTestResponse(http.StatusTooManyRequests, "rate limited")
+			resp.Header.Set("Retry-After", "3600")
+
+			return resp, nil
+		},
+	}
+	mockState := newTestMockState()
+	var sleeps []time.Duration
+	sender := newTestSender(mockHTTP, mockState, &sleeps)
+
+	job := newTestIncomingWebhookDeliveryJob(
+		"https://hooks.slack.com/services/123", workertypes.WebhookTypeSlack, "group:css", []byte(`{"text":"test"}`))
+
+	err := sender.SendWebhook(context.Background(), job)
+	if !errors.Is(err, event.ErrTransientFailure) {
+		t.Fatalf("expected transient failure error, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+	if len(sleeps) != 0 {
+		t.Errorf("expected no sleeps, got %v", sleeps)
+	}
 	if len(mockState.failureCalls) != 1 {
-		t.Errorf("expected 1 failure call, got %d", len(mockState.failureCalls))
+		t.Fatalf("expected 1 failure call, got %d", len(mockState.failureCalls))
 	}
 	if mockState.failureCalls[0].isPermanent {
 		t.Error("expected transient failure recorded")
@@ -162,11 +287,14 @@ func TestSender_SendWebhook_PermanentFailure(t *testing.T) {
 		},
 	}
 	mockState := &mockChannelStateManager{
-		successCalls: nil,
-		failureCalls: nil,
-		recordErr:    nil,
+		successCalls:  nil,
+		failureCalls:  nil,
+		recordErr:     nil,
+		completedKeys: nil,
+		claimErr:      nil,
+		retriedCalls:  nil,
 	}
-	sender := NewSender(mockHTTP, mockState, "https://webstatus.dev")
+	sender := newTestSender(mockHTTP, mockState, nil)
 
 	job := newTestIncomingWebhookDeliveryJob(
 		"https://hooks.slack.com/services/123", workertypes.WebhookTypeSlack, "group:css", []byte(`{"text":"test"}`))
@@ -186,15 +314,21 @@ func TestSender_SendWebhook_PermanentFailure(t *testing.T) {
 	if !mockState.failureCalls[0].isPermanent {
 		t.Error("expected permanent failure recorded")
 	}
+	if len(mockState.retriedCalls) != 0 {
+		t.Errorf("expected permanent failures not to be retried, got %d retries", len(mockState.retriedCalls))
+	}
 }
 
 func TestSender_SendWebhook_UnsupportedType(t *testing.T) {
 	mockState := &mockChannelStateManager{
-		successCalls: nil,
-		failureCalls: nil,
-		recordErr:    nil,
+		successCalls:  nil,
+		failureCalls:  nil,
+		recordErr:     nil,
+		completedKeys: nil,
+		claimErr:      nil,
+		retriedCalls:  nil,
 	}
-	sender := NewSender(nil, mockState, "https://webstatus.dev")
+	sender := newTestSender(nil, mockState, nil)
 
 	job := newTestIncomingWebhookDeliveryJob(
 		"https://example.com/webhook", "unknown", "group:css", nil)
@@ -217,11 +351,14 @@ func TestSender_SendWebhook_UnsupportedType(t *testing.T) {
 
 func TestSender_SendWebhook_InvalidSlackURL(t *testing.T) {
 	mockState := &mockChannelStateManager{
-		successCalls: nil,
-		failureCalls: nil,
-		recordErr:    nil,
+		successCalls:  nil,
+		failureCalls:  nil,
+		recordErr:     nil,
+		completedKeys: nil,
+		claimErr:      nil,
+		retriedCalls:  nil,
 	}
-	sender := NewSender(nil, mockState, "https://webstatus.dev")
+	sender := newTestSender(nil, mockState, nil)
 
 	job := newTestIncomingWebhookDeliveryJob(
 		"https://not-slack.com/hook", workertypes.WebhookTypeSlack, "group:css", nil)
@@ -241,11 +378,14 @@ func TestSender_SendWebhook_InvalidSlackURL(t *testing.T) {
 
 func TestSender_SendWebhook_InvalidSummary(t *testing.T) {
 	mockState := &mockChannelStateManager{
-		successCalls: nil,
-		failureCalls: nil,
-		recordErr:    nil,
+		successCalls:  nil,
+		failureCalls:  nil,
+		recordErr:     nil,
+		completedKeys: nil,
+		claimErr:      nil,
+		retriedCalls:  nil,
 	}
-	sender := NewSender(nil, mockState, "https://webstatus.dev")
+	sender := newTestSender(nil, mockState, nil)
 
 	job := newTestIncomingWebhookDeliveryJob(
 		"https://hooks.slack.com/services/123", workertypes.WebhookTypeSlack, "group:css", nil)
@@ -260,6 +400,92 @@ func TestSender_SendWebhook_InvalidSummary(t *testing.T) {
 	}
 }
 
+func TestSender_SendWebhook_SkipsCompletedDelivery(t *testing.T) {
+	requests := 0
+	mockHTTP := &mockHTTPClient{
+		doFunc: func(_ *http.Request) (*http.Response, error) {
+			requests++
+
+			return newTestResponse(http.StatusOK, "ok"), nil
+		},
+	}
+	mockState := &mockChannelStateManager{
+		successCalls:  nil,
+		failureCalls:  nil,
+		recordErr:     nil,
+		completedKeys: nil,
+		claimErr:      nil,
+		retriedCalls:  nil,
+	}
+	sender := newTestSender(mockHTTP, mockState, nil)
+
+	job := newTestIncomingWebhookDeliveryJob(
+		"https://hooks.slack.com/services/123", workertypes.WebhookTypeSlack, "group:css", []byte(`{"text":"test"}`))
+
+	// The first delivery sends and completes the key.
+	if err := sender.SendWebhook(context.Background(), job); err != nil {
+		t.Fatalf("SendWebhook failed: %v", err)
+	}
+	// A redelivery of the same job, even with a new Pub/Sub message ID, must be skipped.
+	job.WebhookEventID = "evt-redelivered"
+	if err := sender.SendWebhook(context.Background(), job); err != nil {
+		t.Fatalf("SendWebhook redelivery failed: %v", err)
+	}
+
+	if requests != 1 {
+		t.Errorf("expected 1 HTTP request, got %d", requests)
+	}
+	verifySuccess(t, mockState)
+}
+
+func TestSender_SendWebhook_ClaimError(t *testing.T) {
+	mockHTTP := &mockHTTPClient{
+		doFunc: func(_ *http.Request) (*http.Response, error) {
+			t.Error("webhook should not be sent when the delivery key cannot be claimed")
+
+			return newTestResponse(http.StatusOK, "ok"), nil
+		},
+	}
+	mockState := &mockChannelStateManager{
+		successCalls:  nil,
+		failureCalls:  nil,
+		recordErr:     nil,
+		completedKeys: nil,
+		claimErr:      errors.New("spanner unavailable"),
+		retriedCalls:  nil,
+	}
+	sender := newTestSender(mockHTTP, mockState, nil)
+
+	job := newTestIncomingWebhookDeliveryJob(
+		"https://hooks.slack.com/services/123", workertypes.WebhookTypeSlack, "group:css", []byte(`{"text":"test"}`))
+
+	err := sender.SendWebhook(context.Background(), job)
+	if !errors.Is(err, event.ErrTransientFailure) {
+		t.Errorf("expected transient failure error, got %v", err)
+	}
+}
+
+// verifyRetriesExhausted checks that every attempt was logged and that the final one was recorded as transient,
+// so that it does not count toward the channel health.
+func verifyRetriesExhausted(t *testing.T, mockState *mockChannelStateManager) {
+	t.Helper()
+	maxAttempts := DefaultRetryPolicy().MaxAttempts
+	if len(mockState.retriedCalls) != maxAttempts-1 {
+		t.Fatalf("expected %d retried attempts, got %d", maxAttempts-1, len(mockState.retriedCalls))
+	}
+	for i, call := range mockState.retriedCalls {
+		if call.attempt != i+1 {
+			t.Errorf("expected attempt %d, got %d", i+1, call.attempt)
+		}
+	}
+	if len(mockState.failureCalls) != 1 {
+		t.Fatalf("expected 1 failure call, got %d", len(mockState.failureCalls))
+	}
+	if mockState.failureCalls[0].isPermanent {
+		t.Error("expected transient failure recorded")
+	}
+}
+
 func verifySuccess(t *testing.T, mockState *mockChannelStateManager) {
 	t.Helper()
 	if len(mockState.successCalls) != 1 {
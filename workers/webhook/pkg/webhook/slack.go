@@ -55,7 +55,7 @@ type slackSender struct {
 func newSlackSender(frontendBaseURL string, httpClient HTTPClient,
 	job workertypes.IncomingWebhookDeliveryJob) (*slackSender, error) {
 	if err := httputils.ValidateSlackWebhookURL(job.WebhookURL); err != nil {
-		return nil, fmt.Errorf("%w: invalid webhook URL: %w", ErrPermanentWebhook, err)
+		return nil, fmt.Errorf("%w: invalid webhook URL: %w", workertypes.ErrPermanentWebhook, err)
 	}
 
 	return &slackSender{
@@ -68,7 +68,7 @@ func newSlackSender(frontendBaseURL string, httpClient HTTPClient,
 func (s *slackSender) Send(ctx context.Context) error {
 	var summary workertypes.EventSummary
 	if err := json.Unmarshal(s.job.SummaryRaw, &summary); err != nil {
-		return fmt.Errorf("%w: failed to unmarshal summary: %w", ErrPermanentWebhook, err)
+		return fmt.Errorf("%w: failed to unmarshal summary: %w", workertypes.ErrPermanentWebhook, err)
 	}
 
 	// Determine the correct results URL.
@@ -97,7 +97,7 @@ func (s *slackSender) Send(ctx context.Context) error {
 		builder := newSlackPayloadBuilder(s.frontendBaseURL, query, resultsURL, s.job.SubscriptionID, s.job.Triggers)
 
 		if err := workertypes.ParseEventSummary(s.job.SummaryRaw, builder); err != nil {
-			return fmt.Errorf("%w: failed to parse event summary: %w", ErrPermanentWebhook, err)
+			return fmt.Errorf("%w: failed to parse event summary: %w", workertypes.ErrPermanentWebhook, err)
 		}
 
 		payload = builder.buildPayload(s.job.Metadata.SearchName)
@@ -105,12 +105,12 @@ func (s *slackSender) Send(ctx context.Context) error {
 
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
-		return fmt.Errorf("%w: failed to marshal slack payload: %w", ErrPermanentWebhook, err)
+		return fmt.Errorf("%w: failed to marshal slack payload: %w", workertypes.ErrPermanentWebhook, err)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.job.WebhookURL, bytes.NewBuffer(payloadBytes))
 	if err != nil {
-		return fmt.Errorf("%w: failed to create request: %w", ErrPermanentWebhook, err)
+		return fmt.Errorf("%w: failed to create request: %w", workertypes.ErrPermanentWebhook, err)
 	}
 	req.Header.Set("Content-Type", "application/json")
 
@@ -129,10 +129,17 @@ func (s *slackSender) Send(ctx context.Context) error {
 		resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden
 
 	if !isPermanent {
+		// Rate limited and unavailable endpoints may tell us when to come back.
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+			if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+				return errors.Join(ErrTransientWebhook, webhookErr, &RetryAfterError{Delay: delay})
+			}
+		}
+
 		return errors.Join(ErrTransientWebhook, webhookErr)
 	}
 
-	return errors.Join(ErrPermanentWebhook, webhookErr)
+	return errors.Join(workertypes.ErrPermanentWebhook, webhookErr)
 }
 
 type slackPayloadBuilder struct {
@@ -102,7 +102,7 @@ func TestSlackSender_Send(t *testing.T) {
 					"View Results: https://webstatus.dev/?q=",
 				Blocks: nil,
 			},
-			expectedErr: ErrPermanentWebhook,
+			expectedErr: workertypes.ErrPermanentWebhook,
 		},
 		{
 			name: "transient error (500)",
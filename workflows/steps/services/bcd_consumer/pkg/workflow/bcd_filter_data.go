@@ -103,6 +103,7 @@ func (f BCDDataFilter) FilterData(
 				BrowserName:    bcdconsumertypes.BrowserName(browser),
 				BrowserVersion: release,
 				ReleaseDate:    releaseDate,
+				ReleaseStatus:  string(releaseData.Status),
 			})
 		}
 	}
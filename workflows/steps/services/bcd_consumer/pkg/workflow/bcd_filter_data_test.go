@@ -85,13 +85,13 @@ func TestFilterData(t *testing.T) {
 					Browsers: map[string]mdn__browser_compat_data.BrowserStatement{
 						"chrome": {
 							Releases: map[string]mdn__browser_compat_data.ReleaseStatement{
-								"100.0.0": {ReleaseDate: new("2024-04-09")},
-								"101.0.0": {ReleaseDate: new("2024-05-12")},
+								"100.0.0": {ReleaseDate: new("2024-04-09"), Status: "retired"},
+								"101.0.0": {ReleaseDate: new("2024-05-12"), Status: "current"},
 							},
 						},
 						"firefox": {
 							Releases: map[string]mdn__browser_compat_data.ReleaseStatement{
-								"110.0": {ReleaseDate: new("2024-04-20")},
+								"110.0": {ReleaseDate: new("2024-04-20"), Status: "esr"},
 							},
 						},
 					},
@@ -103,16 +103,19 @@ func TestFilterData(t *testing.T) {
 					BrowserName:    "chrome",
 					BrowserVersion: "100.0.0",
 					ReleaseDate:    time.Date(2024, 4, 9, 0, 0, 0, 0, time.UTC),
+					ReleaseStatus:  "retired",
 				},
 				{
 					BrowserName:    "chrome",
 					BrowserVersion: "101.0.0",
 					ReleaseDate:    time.Date(2024, 5, 12, 0, 0, 0, 0, time.UTC),
+					ReleaseStatus:  "current",
 				},
 				{
 					BrowserName:    "firefox",
 					BrowserVersion: "110.0",
 					ReleaseDate:    time.Date(2024, 4, 20, 0, 0, 0, 0, time.UTC),
+					ReleaseStatus:  "esr",
 				},
 			},
 			expectedError: nil,
@@ -175,6 +178,7 @@ func TestFilterData(t *testing.T) {
 					BrowserName:    "firefox",
 					BrowserVersion: "100.0.0",
 					ReleaseDate:    time.Date(2024, 4, 9, 0, 0, 0, 0, time.UTC),
+					ReleaseStatus:  "",
 				},
 			},
 			expectedError: nil,
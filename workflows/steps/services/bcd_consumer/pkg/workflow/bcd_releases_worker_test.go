@@ -195,11 +195,13 @@ func getSampleReleases() []bcdconsumertypes.BrowserRelease {
 			BrowserName:    "fooBrowser",
 			BrowserVersion: "0",
 			ReleaseDate:    time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			ReleaseStatus:  "",
 		},
 		{
 			BrowserName:    "barBrowser",
 			BrowserVersion: "0",
 			ReleaseDate:    time.Date(2000, time.January, 2, 0, 0, 0, 0, time.UTC),
+			ReleaseStatus:  "",
 		},
 	}
 }
@@ -24,6 +24,9 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/GoogleChrome/webstatus.dev/lib/cachetypes"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcppubsub"
+	"github.com/GoogleChrome/webstatus.dev/lib/gcppubsub/gcppubsubadapters"
 	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner"
 	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/spanneradapters"
 	"github.com/GoogleChrome/webstatus.dev/lib/gcpspanner/spanneradapters/wptconsumertypes"
@@ -89,6 +92,17 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Optional: Announce new data to its consumers, like the backend which drops the stale cached responses.
+	var dataUpdatedPublisher *gcppubsubadapters.DataUpdatedPublisherAdapter
+	if dataUpdatedTopicID := os.Getenv("DATA_UPDATED_TOPIC_ID"); dataUpdatedTopicID != "" {
+		queueClient, err := gcppubsub.NewClient(ctx, os.Getenv("PUBSUB_PROJECT_ID"))
+		if err != nil {
+			slog.ErrorContext(ctx, "unable to create pub sub client", "error", err)
+			os.Exit(1)
+		}
+		dataUpdatedPublisher = gcppubsubadapters.NewDataUpdatedPublisherAdapter(queueClient, dataUpdatedTopicID)
+	}
+
 	ghClient, err := github.NewClient()
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to create GitHub client", "error", err.Error())
@@ -162,4 +176,12 @@ func main() {
 		slog.ErrorContext(ctx, "workflow returned errors", "error", errors.Join(errs...))
 		os.Exit(1)
 	}
+
+	if dataUpdatedPublisher != nil {
+		err := dataUpdatedPublisher.PublishDataUpdated(ctx, cachetypes.DataSourceWPT, time.Now().UTC())
+		if err != nil {
+			// The data is stored. The cached responses will still expire on their own.
+			slog.WarnContext(ctx, "unable to publish data updated event", "error", err)
+		}
+	}
 }